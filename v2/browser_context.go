@@ -0,0 +1,173 @@
+package gcd
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/wirepair/gcd/v2/gcdapi"
+)
+
+var ErrBrowserContextDisposed = errors.New("browser context is disposed")
+
+// BrowserContextOptions for NewBrowserContext
+type BrowserContextOptions struct {
+	ProxyServer                       string   // proxy for the context's tabs, similar to --proxy-server
+	ProxyBypassList                   string   // similar to --proxy-bypass-list
+	OriginsWithUniversalNetworkAccess []string // origins granted unlimited cross-origin access
+	DisposeOnDetach                   bool     // dispose the context if the browser connection goes away
+}
+
+// BrowserContext is an isolated, incognito like, profile of the browser. Its tabs don't share
+// cookies, storage or cache with the default profile or with other browser contexts. Commands
+// are sent over the shared browser target, see Gcd.Browser.
+type BrowserContext struct {
+	Id       string // the browserContextId
+	debugger *Gcd
+	browser  *ChromeTarget
+
+	lock     sync.Mutex
+	disposed bool
+}
+
+// NewBrowserContext creates a browser context with Target.createBrowserContext
+func (c *Gcd) NewBrowserContext(ctx context.Context, opts BrowserContextOptions) (*BrowserContext, error) {
+	browser, err := c.browser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := browser.TargetApi.CreateBrowserContext(ctx, opts.DisposeOnDetach, opts.ProxyServer, opts.ProxyBypassList, opts.OriginsWithUniversalNetworkAccess)
+	if err != nil {
+		return nil, err
+	}
+	return &BrowserContext{Id: id, debugger: c, browser: browser}, nil
+}
+
+// NewTab opens a tab at pageUrl, or about:blank if empty, in the browser context. The tab is
+// attached to as a flattened session over the browser connection, if that fails it is closed.
+func (b *BrowserContext) NewTab(ctx context.Context, pageUrl string) (*ChromeTarget, error) {
+	if err := b.checkDisposed(); err != nil {
+		return nil, err
+	}
+
+	if pageUrl == "" {
+		pageUrl = "about:blank"
+	}
+
+	targetId, err := b.browser.TargetApi.CreateTargetWithParams(ctx, &gcdapi.TargetCreateTargetParams{Url: pageUrl, BrowserContextId: b.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := b.browser.AttachToTarget(ctx, targetId)
+	if err != nil {
+		// don't leave an orphaned tab behind, even if ctx is what made attaching fail
+		closeCtx, cancel := context.WithTimeout(b.debugger.ctx, b.debugger.timeout)
+		b.browser.TargetApi.CloseTarget(closeCtx, targetId)
+		cancel()
+		return nil, err
+	}
+	return target, nil
+}
+
+// Targets returns the targets, such as tabs and workers, of the browser context
+func (b *BrowserContext) Targets(ctx context.Context) ([]*gcdapi.TargetTargetInfo, error) {
+	if err := b.checkDisposed(); err != nil {
+		return nil, err
+	}
+
+	targets, err := b.browser.TargetApi.GetTargets(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	contextTargets := make([]*gcdapi.TargetTargetInfo, 0)
+	for _, target := range targets {
+		if target.BrowserContextId == b.Id {
+			contextTargets = append(contextTargets, target)
+		}
+	}
+	return contextTargets, nil
+}
+
+// storageCookiesParams for Storage.getCookies, Storage.setCookies and Storage.clearCookies. The Storage
+// domain is experimental, so it is called by name to build with the gcd_stable tag.
+type storageCookiesParams struct {
+	Cookies          []*gcdapi.NetworkCookieParam `json:"cookies,omitempty"`
+	BrowserContextId string                       `json:"browserContextId,omitempty"`
+}
+
+// Cookies returns all cookies of the browser context
+func (b *BrowserContext) Cookies(ctx context.Context) ([]*gcdapi.NetworkCookie, error) {
+	if err := b.checkDisposed(); err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Cookies []*gcdapi.NetworkCookie `json:"cookies"`
+	}
+	err := b.browser.CallInto(ctx, "Storage.getCookies", &storageCookiesParams{BrowserContextId: b.Id}, &result)
+	return result.Cookies, err
+}
+
+// SetCookies sets the cookies in the browser context
+func (b *BrowserContext) SetCookies(ctx context.Context, cookies []*gcdapi.NetworkCookieParam) error {
+	if err := b.checkDisposed(); err != nil {
+		return err
+	}
+	return b.browser.CallInto(ctx, "Storage.setCookies", &storageCookiesParams{Cookies: cookies, BrowserContextId: b.Id}, nil)
+}
+
+// ClearCookies removes all cookies of the browser context
+func (b *BrowserContext) ClearCookies(ctx context.Context) error {
+	if err := b.checkDisposed(); err != nil {
+		return err
+	}
+	return b.browser.CallInto(ctx, "Storage.clearCookies", &storageCookiesParams{BrowserContextId: b.Id}, nil)
+}
+
+// GrantPermissions grants the permissions to origin, or to every origin if empty, in the browser context
+func (b *BrowserContext) GrantPermissions(ctx context.Context, permissions []gcdapi.BrowserPermissionType, origin string) error {
+	if err := b.checkDisposed(); err != nil {
+		return err
+	}
+	_, err := b.browser.Browser.GrantPermissions(ctx, permissions, origin, b.Id)
+	return err
+}
+
+// ResetPermissions resets all permission overrides of the browser context
+func (b *BrowserContext) ResetPermissions(ctx context.Context) error {
+	if err := b.checkDisposed(); err != nil {
+		return err
+	}
+	_, err := b.browser.Browser.ResetPermissions(ctx, b.Id)
+	return err
+}
+
+// Dispose closes the browser context's tabs and deletes its cookies, storage and cache.
+// Calling it again does nothing.
+func (b *BrowserContext) Dispose(ctx context.Context) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.disposed {
+		return nil
+	}
+
+	if _, err := b.browser.TargetApi.DisposeBrowserContext(ctx, b.Id); err != nil {
+		return err
+	}
+	b.disposed = true
+	return nil
+}
+
+func (b *BrowserContext) checkDisposed() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.disposed {
+		return ErrBrowserContextDisposed
+	}
+	return nil
+}
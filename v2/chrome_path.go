@@ -0,0 +1,160 @@
+/*
+The MIT License (MIT)
+
+Copyright (c) 2020 isaac dawson
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package gcd
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ChromePathEnv is the environment variable checked first by FindChrome
+const ChromePathEnv = "CHROME_PATH"
+
+// Returned from FindChrome when no chrome executable could be found
+type GcdChromeNotFoundErr struct {
+	Tried []string // every environment variable, executable name and path that was checked
+}
+
+func (g *GcdChromeNotFoundErr) Error() string {
+	return "unable to find a chrome executable, tried: " + strings.Join(g.Tried, ", ")
+}
+
+// Returned from FindChrome when CHROME_PATH is set but isn't an executable
+type GcdChromePathErr struct {
+	Path string // the value of CHROME_PATH
+	Err  error  // why it can't be used
+}
+
+func (g *GcdChromePathErr) Error() string {
+	return "$" + ChromePathEnv + "=" + g.Path + " is not a chrome executable: " + g.Err.Error()
+}
+
+func (g *GcdChromePathErr) Unwrap() error {
+	return g.Err
+}
+
+// FindChrome looks for a Chrome or Chromium executable, checking the CHROME_PATH
+// environment variable, then the common executable names in $PATH and finally the
+// well-known install locations of the current OS. If CHROME_PATH is set it must be
+// an executable, otherwise a *GcdChromePathErr is returned.
+func FindChrome() (string, error) {
+	if envPath := os.Getenv(ChromePathEnv); envPath != "" {
+		if err := checkExecutable(envPath); err != nil {
+			return "", &GcdChromePathErr{Path: envPath, Err: err}
+		}
+		return envPath, nil
+	}
+	tried := []string{"$" + ChromePathEnv + " (not set)"}
+
+	for _, name := range chromeExecutableNames() {
+		if exePath, err := exec.LookPath(name); err == nil {
+			return exePath, nil
+		}
+		tried = append(tried, name+" in $PATH")
+	}
+
+	for _, exePath := range chromeInstallPaths() {
+		if isExecutable(exePath) {
+			return exePath, nil
+		}
+		tried = append(tried, exePath)
+	}
+
+	return "", &GcdChromeNotFoundErr{Tried: tried}
+}
+
+// chromeExecutableNames to look up in $PATH
+func chromeExecutableNames() []string {
+	if runtime.GOOS == "windows" {
+		return []string{"chrome.exe", "chromium.exe"}
+	}
+	return []string{"google-chrome", "google-chrome-stable", "chromium", "chromium-browser", "headless_shell"}
+}
+
+// chromeInstallPaths returns the well-known install locations for the current OS
+func chromeInstallPaths() []string {
+	switch runtime.GOOS {
+	case "windows":
+		var paths []string
+		for _, env := range []string{"ProgramFiles", "ProgramFiles(x86)", "LocalAppData"} {
+			dir := os.Getenv(env)
+			if dir == "" {
+				continue
+			}
+			paths = append(paths,
+				filepath.Join(dir, "Google", "Chrome", "Application", "chrome.exe"),
+				filepath.Join(dir, "Chromium", "Application", "chrome.exe"),
+			)
+		}
+		return paths
+	case "darwin":
+		paths := []string{
+			"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+			"/Applications/Chromium.app/Contents/MacOS/Chromium",
+			"/Applications/Google Chrome Canary.app/Contents/MacOS/Google Chrome Canary",
+		}
+		if home, err := os.UserHomeDir(); err == nil {
+			paths = append(paths, filepath.Join(home, paths[0]), filepath.Join(home, paths[1]))
+		}
+		return paths
+	default:
+		return []string{
+			"/usr/bin/google-chrome",
+			"/usr/bin/google-chrome-stable",
+			"/usr/bin/chromium",
+			"/usr/bin/chromium-browser",
+			"/snap/bin/chromium",
+			"/opt/google/chrome/chrome",
+			"/usr/lib/chromium/chromium",
+			"/usr/lib/chromium-browser/chromium-browser",
+		}
+	}
+}
+
+// isExecutable returns true if the path is a regular file which can be executed
+func isExecutable(exePath string) bool {
+	return checkExecutable(exePath) == nil
+}
+
+// checkExecutable returns why the path isn't a regular file which can be executed, if it isn't
+func checkExecutable(exePath string) error {
+	info, err := os.Stat(exePath)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		return errors.New("is a directory")
+	}
+
+	if runtime.GOOS != "windows" && info.Mode()&0111 == 0 {
+		return errors.New("is not executable")
+	}
+	return nil
+}
@@ -27,6 +27,7 @@ package gcd
 import (
 	"context"
 	"github.com/goccy/go-json"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -56,71 +57,56 @@ type devtoolsEventResponse struct {
 	Msg    []byte `json:"msg"`
 }
 
+// eventSubscription is a callback bound to an event method
+type eventSubscription struct {
+	callback func(*ChromeTarget, []byte)
+}
+
 // ChromeTarget (Tab/Process). Messages are returned to callers via non-buffered channels. Helpfully,
 // the remote debugger service uses id's so we can correlate which request should match which response.
 // We use a map to store the id of the request which contains a reference to a gcdmessage.Message that holds the
 // reply channel for the ChromeTarget to return the response to.
 // Events are handled by mapping the method name to a function which takes a target and byte output.
 // For now, callers will need to unmarshall the types themselves.
+// A ChromeTarget may also be a flattened session (see AttachToTarget) in which case it shares the
+// connection of the target it was attached from and its messages are tagged with its sessionId.
 type ChromeTarget struct {
 	ctx    context.Context
 	sendId int64 // An Id which is atomically incremented per request.
 	// must be at top because of alignement and atomic usage
-	replyLock       sync.RWMutex                           // lock for dispatching responses
-	replyDispatcher map[int64]chan *gcdmessage.Message     // Replies to synch methods using a non-buffered channel
-	eventLock       sync.RWMutex                           // lock for dispatching events
-	eventDispatcher map[string]func(*ChromeTarget, []byte) // calls the function when events match the subscribed method
-	conn            *WebSocket                             // the connection to the chrome debugger service for this tab/process
-
-	// Chrome Debugger Domains
-	Accessibility        *gcdapi.Accessibility
-	Animation            *gcdapi.Animation
-	Audits               *gcdapi.Audits
-	BackgroundService    *gcdapi.BackgroundService
-	Browser              *gcdapi.Browser
-	CacheStorage         *gcdapi.CacheStorage
-	Cast                 *gcdapi.Cast
-	Console              *gcdapi.Console           // console API
-	CSS                  *gcdapi.CSS               // CSS API
-	Database             *gcdapi.Database          // Database API
-	Debugger             *gcdapi.Debugger          // JS Debugger API
-	DeviceOrientation    *gcdapi.DeviceOrientation // Device Orientation API
-	DOM                  *gcdapi.DOM               // DOM API
-	DOMDebugger          *gcdapi.DOMDebugger       // DOM Debugger API
-	DOMSnapshot          *gcdapi.DOMSnapshot
-	DOMStorage           *gcdapi.DOMStorage // DOM Storage API
-	Emulation            *gcdapi.Emulation
-	EventBreakpoints     *gcdapi.EventBreakpoints
-	FedCm                *gcdapi.FedCm
-	Fetch                *gcdapi.Fetch
-	HeadlessExperimental *gcdapi.HeadlessExperimental
-	HeapProfiler         *gcdapi.HeapProfiler // HeapProfiler API
-	IndexedDB            *gcdapi.IndexedDB    // IndexedDB API
-	Input                *gcdapi.Input        // Why am i doing this, it's obvious what they are, I quit.
-	Inspector            *gcdapi.Inspector
-	IO                   *gcdapi.IO
-	LayerTree            *gcdapi.LayerTree
-	Log                  *gcdapi.Log
-	Media                *gcdapi.Media
-	Memory               *gcdapi.Memory
-	Network              *gcdapi.Network
-	Overlay              *gcdapi.Overlay
-	Page                 *gcdapi.Page
-	Performance          *gcdapi.Performance // if stable channel you'll need to uncomment
-	PerformanceTimeline  *gcdapi.PerformanceTimeline
-	Preload              *gcdapi.Preload
-	Profiler             *gcdapi.Profiler
-	Runtime              *gcdapi.Runtime
-	Schema               *gcdapi.Schema
-	Security             *gcdapi.Security
-	ServiceWorker        *gcdapi.ServiceWorker
-	Storage              *gcdapi.Storage
-	SystemInfo           *gcdapi.SystemInfo
-	TargetApi            *gcdapi.Target // buh name collision
-	Tracing              *gcdapi.Tracing
-	Tethering            *gcdapi.Tethering
-	WebAudio             *gcdapi.WebAudio
-	WebAuthn             *gcdapi.WebAuthn
+	replyLock       sync.RWMutex                       // lock for dispatching responses
+	replyDispatcher map[int64]chan *gcdmessage.Message // Replies to synch methods using a non-buffered channel
+	eventLock       sync.RWMutex                       // lock for dispatching events
+	eventDispatcher map[string][]*eventSubscription    // calls the functions when events match the subscribed method or pattern
+	connLock        sync.Mutex                         // lock for conn, which is replaced when reconnecting
+	conn            Transport                          // the connection to the chrome debugger service for this tab/process
+	writeLock       sync.Mutex                         // serializes writes to conn from this target and its sessions
+	sessionId       string                             // the flattened session id, empty if this target owns its connection
+	parent          *ChromeTarget                      // the target which owns the connection this session is multiplexed over
+	sessionLock     sync.RWMutex                       // lock for sessions
+	sessions        map[string]*ChromeTarget           // flattened sessions attached over this target's connection
+
+	// Chrome Debugger Domains, the experimental ones are left out with the gcd_stable build tag
+	experimentalDomains
+	Browser     *gcdapi.Browser
+	Console     *gcdapi.Console     // console API
+	Debugger    *gcdapi.Debugger    // JS Debugger API
+	DOM         *gcdapi.DOM         // DOM API
+	DOMDebugger *gcdapi.DOMDebugger // DOM Debugger API
+	Emulation   *gcdapi.Emulation
+	Fetch       *gcdapi.Fetch
+	Input       *gcdapi.Input // Why am i doing this, it's obvious what they are, I quit.
+	IO          *gcdapi.IO
+	Log         *gcdapi.Log
+	Network     *gcdapi.Network
+	Page        *gcdapi.Page
+	Performance *gcdapi.Performance // if stable channel you'll need to uncomment
+	Profiler    *gcdapi.Profiler
+	Runtime     *gcdapi.Runtime
+	Schema      *gcdapi.Schema
+	Security    *gcdapi.Security
+	TargetApi   *gcdapi.Target // buh name collision
+	Tracing     *gcdapi.Tracing
 
 	Target          *TargetInfo                 // The target information see, TargetInfo
 	sendCh          chan *gcdmessage.Message    // The channel used for API components to send back to use
@@ -129,17 +115,59 @@ type ChromeTarget struct {
 	apiTimeout      time.Duration               // A customizable timeout for waiting on Chrome to respond to us
 	logger          Log
 	debugger        *Gcd
-	stopped         bool // we are/have shutdown
+	stopLock        sync.Mutex
+	stopped         bool  // we are/have shutdown
+	closeErr        error // why we shutdown, see Err
 	messageObserver observer.MessageObserver
+
+	lifecycleLock        sync.Mutex       // lock for the lifecycle handlers, enabledDomains and gone
+	disconnectedHandlers []func(error)    // see OnDisconnected
+	reconnectedHandlers  []func()         // see OnReconnected
+	crashHandlers        []func()         // see OnCrash
+	detachHandlers       []func(string)   // see OnDetach
+	closeHandlers        []func(error)    // see OnClose
+	enabledDomains       []*enabledDomain // domains to enable again after reconnecting
+	gone                 bool             // the target was closed or detached, it can't be reconnected to
 }
 
 // openChromeTarget creates a new Chrome Target by connecting to the service given the URL taken from initial connection.
 func openChromeTarget(debugger *Gcd, target *TargetInfo, observer observer.MessageObserver) (*ChromeTarget, error) {
-	conn, err := wsConnection(debugger.ctx, target.WebSocketDebuggerUrl)
+	conn, err := debugger.dialer(debugger.ctx, target.WebSocketDebuggerUrl)
 	if err != nil {
 		return nil, err
 	}
+	return newChromeTarget(debugger, target, conn, observer), nil
+}
+
+// openChromeTargetCtx is openChromeTarget giving up once ctx is done. The connection belongs to the
+// debugger's context rather than ctx, so a connection made after giving up is closed.
+func openChromeTargetCtx(ctx context.Context, debugger *Gcd, target *TargetInfo, observer observer.MessageObserver) (*ChromeTarget, error) {
+	type result struct {
+		target *ChromeTarget
+		err    error
+	}
+
+	resultCh := make(chan result, 1)
+	go func() {
+		chromeTarget, err := openChromeTarget(debugger, target, observer)
+		resultCh <- result{chromeTarget, err}
+	}()
 
+	select {
+	case r := <-resultCh:
+		return r.target, r.err
+	case <-ctx.Done():
+		go func() {
+			if r := <-resultCh; r.target != nil {
+				r.target.shutdown()
+			}
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// newChromeTarget creates a new Chrome Target which owns the connection conn.
+func newChromeTarget(debugger *Gcd, target *TargetInfo, conn Transport, observer observer.MessageObserver) *ChromeTarget {
 	chromeTarget := &ChromeTarget{
 		conn:            conn,
 		ctx:             debugger.ctx,
@@ -147,9 +175,10 @@ func openChromeTarget(debugger *Gcd, target *TargetInfo, observer observer.Messa
 		apiTimeout:      120 * time.Second, // default 120 seconds to wait for chrome to respond to us
 		sendCh:          make(chan *gcdmessage.Message),
 		replyDispatcher: make(map[int64]chan *gcdmessage.Message),
-		eventDispatcher: make(map[string]func(*ChromeTarget, []byte)),
+		eventDispatcher: make(map[string][]*eventSubscription),
 		eventCh:         make(chan *devtoolsEventResponse, debugger.eventQueueSize), // allow enough events to buffer up
 		doneCh:          make(chan struct{}),
+		sessions:        make(map[string]*ChromeTarget),
 		logger:          debugger.logger,
 		debugger:        debugger,
 		sendId:          0,
@@ -158,73 +187,214 @@ func openChromeTarget(debugger *Gcd, target *TargetInfo, observer observer.Messa
 
 	chromeTarget.Init()
 	chromeTarget.listen()
-	return chromeTarget, nil
+	return chromeTarget
+}
+
+// newSessionTarget creates a ChromeTarget for a flattened session which is multiplexed over the
+// connection owned by parent.
+func newSessionTarget(parent *ChromeTarget, sessionId string, target *TargetInfo) *ChromeTarget {
+	chromeTarget := &ChromeTarget{
+		ctx:             parent.ctx,
+		Target:          target,
+		apiTimeout:      parent.apiTimeout,
+		sessionId:       sessionId,
+		parent:          parent,
+		sendCh:          make(chan *gcdmessage.Message),
+		replyDispatcher: make(map[int64]chan *gcdmessage.Message),
+		eventDispatcher: make(map[string][]*eventSubscription),
+		eventCh:         make(chan *devtoolsEventResponse, parent.debugger.eventQueueSize),
+		doneCh:          make(chan struct{}),
+		sessions:        make(map[string]*ChromeTarget),
+		logger:          parent.logger,
+		debugger:        parent.debugger,
+		sendId:          0,
+		messageObserver: parent.messageObserver,
+	}
+
+	chromeTarget.Init()
+	go chromeTarget.listenWrite()
+	go chromeTarget.dispatchEvents()
+	return chromeTarget
 }
 
 // Init all api objects
 func (c *ChromeTarget) Init() {
-	c.Accessibility = gcdapi.NewAccessibility(c)
-	c.Animation = gcdapi.NewAnimation(c)
-	c.Audits = gcdapi.NewAudits(c)
-	c.BackgroundService = gcdapi.NewBackgroundService(c)
 	c.Browser = gcdapi.NewBrowser(c)
-	c.CacheStorage = gcdapi.NewCacheStorage(c)
-	c.Cast = gcdapi.NewCast(c)
 	c.Console = gcdapi.NewConsole(c)
-	c.CSS = gcdapi.NewCSS(c)
-	c.Database = gcdapi.NewDatabase(c)
 	c.Debugger = gcdapi.NewDebugger(c)
-	c.DeviceOrientation = gcdapi.NewDeviceOrientation(c)
 	c.DOM = gcdapi.NewDOM(c)
 	c.DOMDebugger = gcdapi.NewDOMDebugger(c)
-	c.DOMSnapshot = gcdapi.NewDOMSnapshot(c)
-	c.DOMStorage = gcdapi.NewDOMStorage(c)
 	c.Emulation = gcdapi.NewEmulation(c)
-	c.EventBreakpoints = gcdapi.NewEventBreakpoints(c)
-	c.FedCm = gcdapi.NewFedCm(c)
 	c.Fetch = gcdapi.NewFetch(c)
-	c.HeadlessExperimental = gcdapi.NewHeadlessExperimental(c)
-	c.HeapProfiler = gcdapi.NewHeapProfiler(c)
-	c.IndexedDB = gcdapi.NewIndexedDB(c)
 	c.Input = gcdapi.NewInput(c)
-	c.Inspector = gcdapi.NewInspector(c)
 	c.IO = gcdapi.NewIO(c)
-	c.LayerTree = gcdapi.NewLayerTree(c)
 	c.Log = gcdapi.NewLog(c)
-	c.Media = gcdapi.NewMedia(c)
-	c.Memory = gcdapi.NewMemory(c)
 	c.Network = gcdapi.NewNetwork(c)
-	c.Overlay = gcdapi.NewOverlay(c)
 	c.Page = gcdapi.NewPage(c)
 	c.Performance = gcdapi.NewPerformance(c)
-	c.PerformanceTimeline = gcdapi.NewPerformanceTimeline(c)
-	c.Preload = gcdapi.NewPreload(c)
 	c.Profiler = gcdapi.NewProfiler(c)
 	c.Runtime = gcdapi.NewRuntime(c)
 	c.Schema = gcdapi.NewSchema(c)
 	c.Security = gcdapi.NewSecurity(c)
-	c.ServiceWorker = gcdapi.NewServiceWorker(c)
-	c.Storage = gcdapi.NewStorage(c)
-	c.SystemInfo = gcdapi.NewSystemInfo(c)
 	c.TargetApi = gcdapi.NewTarget(c)
 	c.Tracing = gcdapi.NewTracing(c)
-	c.Tethering = gcdapi.NewTethering(c)
-	c.WebAudio = gcdapi.NewWebAudio(c)
-	c.WebAuthn = gcdapi.NewWebAuthn(c)
+	c.initExperimentalDomains()
 }
 
 // clean up this target
 func (c *ChromeTarget) shutdown() {
+	c.stop(&gcdmessage.ChromeDoneErr{})
+}
+
+// stop the target, cause is why, returned wrapped in an ErrConnectionClosed from calls and Err
+func (c *ChromeTarget) stop(cause error) {
+	c.stopLock.Lock()
 	if c.stopped == true {
+		c.stopLock.Unlock()
 		return
 	}
 	c.stopped = true
+	c.closeErr = &ErrConnectionClosed{Cause: cause}
+	c.stopLock.Unlock()
 
 	// close websocket read/write goroutines
 	close(c.doneCh)
 
-	// close websocket connection
-	c.conn.close()
+	c.closeSessions(cause)
+
+	if c.parent != nil {
+		c.connOwner().removeSession(c.sessionId)
+	} else {
+		// close websocket connection
+		c.getConn().Close()
+	}
+
+	c.closed(c.Err())
+}
+
+// closeSessions shuts down the flattened sessions, they can not outlive the connection
+// they are multiplexed over.
+func (c *ChromeTarget) closeSessions(cause error) {
+	c.sessionLock.Lock()
+	sessions := c.sessions
+	c.sessions = make(map[string]*ChromeTarget)
+	c.sessionLock.Unlock()
+
+	for _, session := range sessions {
+		session.stop(cause)
+	}
+}
+
+func (c *ChromeTarget) getConn() Transport {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	return c.conn
+}
+
+func (c *ChromeTarget) setConn(conn Transport) {
+	c.connLock.Lock()
+	c.conn = conn
+	c.connLock.Unlock()
+}
+
+func (c *ChromeTarget) isStopped() bool {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	return c.stopped
+}
+
+// SessionId of this target if it is a flattened session, otherwise empty.
+func (c *ChromeTarget) SessionId() string {
+	return c.sessionId
+}
+
+// AttachToTarget attaches to the target with the given targetId (an iframe, worker, service worker or
+// any other target returned by TargetApi.GetTargets) using a flattened session. The returned ChromeTarget
+// shares this target's connection, requests are tagged with its sessionId and replies/events are
+// dispatched to it.
+func (c *ChromeTarget) AttachToTarget(ctx context.Context, targetId string) (*ChromeTarget, error) {
+	info, err := c.TargetApi.GetTargetInfo(ctx, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionId, err := c.TargetApi.AttachToTarget(ctx, targetId, true)
+	if err != nil {
+		return nil, err
+	}
+	return c.NewSession(sessionId, info), nil
+}
+
+// NewSession returns the ChromeTarget for an already attached flattened session, such as those reported
+// by Target.attachedToTarget events after calling TargetApi.SetAutoAttach with flatten set to true.
+// If the session is already known, the existing ChromeTarget is returned.
+func (c *ChromeTarget) NewSession(sessionId string, info *gcdapi.TargetTargetInfo) *ChromeTarget {
+	owner := c.connOwner()
+
+	owner.sessionLock.Lock()
+	defer owner.sessionLock.Unlock()
+
+	if session, ok := owner.sessions[sessionId]; ok {
+		return session
+	}
+
+	target := &TargetInfo{}
+	if info != nil {
+		target.Id = info.TargetId
+		target.Title = info.Title
+		target.Type = info.Type
+		target.Url = info.Url
+	}
+
+	session := newSessionTarget(owner, sessionId, target)
+	owner.sessions[sessionId] = session
+	return session
+}
+
+// Detach this flattened session from its target, the ChromeTarget can no longer be used afterwards.
+func (c *ChromeTarget) Detach(ctx context.Context) error {
+	if c.parent == nil {
+		return ErrNotSession
+	}
+
+	_, err := c.parent.TargetApi.DetachFromTarget(ctx, c.sessionId, "")
+	c.shutdown()
+	return err
+}
+
+// connOwner returns the target which owns the underlying connection.
+func (c *ChromeTarget) connOwner() *ChromeTarget {
+	if c.parent != nil {
+		return c.parent
+	}
+	return c
+}
+
+func (c *ChromeTarget) lookupSession(sessionId string) *ChromeTarget {
+	c.sessionLock.RLock()
+	session := c.sessions[sessionId]
+	c.sessionLock.RUnlock()
+	return session
+}
+
+func (c *ChromeTarget) removeSession(sessionId string) {
+	c.sessionLock.Lock()
+	delete(c.sessions, sessionId)
+	c.sessionLock.Unlock()
+}
+
+// write sends data over the connection, sessions share the connection with their owner so writes
+// must be serialized.
+func (c *ChromeTarget) write(data []byte) error {
+	owner := c.connOwner()
+	owner.writeLock.Lock()
+	defer owner.writeLock.Unlock()
+
+	// observed before the write so the reply can't be observed first
+	if frames, ok := c.messageObserver.(observer.FrameObserver); ok {
+		frames.Sent(owner.Target.Id, data)
+	}
+	return owner.getConn().Send(data)
 }
 
 // SetApiTimeout for how long we should wait before giving up gcdmessages.
@@ -243,17 +413,82 @@ func (c *ChromeTarget) GetApiTimeout() time.Duration {
 }
 
 // Subscribe Events, you must know the method name, such as Page.loadFiredEvent, and bind a function
-// which takes a ChromeTarget (us) and the raw JSON byte data for that event.
-func (c *ChromeTarget) Subscribe(method string, callback func(*ChromeTarget, []byte)) {
-	c.eventLock.Lock()
-	c.eventDispatcher[method] = func(chromeTarget *ChromeTarget, data []byte) {
-		c.messageObserver.Event(method, data)
-		callback(chromeTarget, data)
+// which takes a ChromeTarget (us) and the raw JSON byte data for that event. Any number of callbacks
+// may be subscribed to the same method. The method may also be a wildcard pattern, either a whole
+// domain ("Network.*") or every event ("*"). Returns a function which removes only this subscription.
+func (c *ChromeTarget) Subscribe(method string, callback func(*ChromeTarget, []byte)) func() {
+	sub := c.subscribe(method, callback)
+	return func() {
+		c.removeSubscription(method, sub)
+	}
+}
+
+// SubscribeEvent implements gcdmessage.EventSubscriber for the typed gcdapi On<Event> helpers, callback is given the raw JSON
+// event data and any error it returns (failing to decode) is logged. Returns a function which
+// removes this subscription.
+func (c *ChromeTarget) SubscribeEvent(method string, callback func(payload []byte) error) func() {
+	sub := c.subscribe(method, func(chromeTarget *ChromeTarget, data []byte) {
+		if err := callback(data); err != nil {
+			c.logger.Println("error decoding event", method, ":", err)
+		}
+	})
+
+	return func() {
+		c.removeSubscription(method, sub)
 	}
+}
+
+func (c *ChromeTarget) subscribe(method string, callback func(*ChromeTarget, []byte)) *eventSubscription {
+	sub := &eventSubscription{callback: callback}
+	c.eventLock.Lock()
+	c.eventDispatcher[method] = append(c.eventDispatcher[method], sub)
 	c.eventLock.Unlock()
+	return sub
 }
 
-// Unsubscribe the handler for no longer receiving events.
+// removeSubscription removes a single subscription, leaving any others for the method in place.
+func (c *ChromeTarget) removeSubscription(method string, sub *eventSubscription) {
+	c.eventLock.Lock()
+	defer c.eventLock.Unlock()
+
+	subs := c.eventDispatcher[method]
+	remaining := make([]*eventSubscription, 0, len(subs))
+	for _, s := range subs {
+		if s != sub {
+			remaining = append(remaining, s)
+		}
+	}
+
+	if len(remaining) == 0 {
+		delete(c.eventDispatcher, method)
+		return
+	}
+	c.eventDispatcher[method] = remaining
+}
+
+// subscribers returns all subscriptions matching the event method, including wildcard patterns.
+func (c *ChromeTarget) subscribers(method string) []*eventSubscription {
+	c.eventLock.RLock()
+	defer c.eventLock.RUnlock()
+
+	var subs []*eventSubscription
+	for _, pattern := range eventPatterns(method) {
+		subs = append(subs, c.eventDispatcher[pattern]...)
+	}
+	return subs
+}
+
+// eventPatterns returns the subscription keys which match an event method:
+// the method itself, its domain wildcard and the global wildcard.
+func eventPatterns(method string) []string {
+	patterns := []string{method}
+	if i := strings.Index(method, "."); i > 0 {
+		patterns = append(patterns, method[:i]+".*")
+	}
+	return append(patterns, "*")
+}
+
+// Unsubscribe all handlers for the method (or wildcard pattern) for no longer receiving events.
 func (c *ChromeTarget) Unsubscribe(method string) {
 	c.eventLock.Lock()
 	delete(c.eventDispatcher, method)
@@ -278,9 +513,19 @@ func (c *ChromeTarget) listenWrite() {
 			c.replyLock.Unlock()
 
 			c.logger.Println(msg.Id, " sending to chrome: ", string(msg.Data))
-			err := c.conn.Send(msg.Data)
+			err := c.write(msg.Data)
 			if err != nil {
 				c.logger.Println("error sending websocket message: ", err)
+				// the connection may come back, fail only this message
+				if c.connOwner().canReconnect() {
+					c.replyLock.Lock()
+					if replyCh, ok := c.replyDispatcher[msg.Id]; ok {
+						delete(c.replyDispatcher, msg.Id)
+						failReply(replyCh, msg.Id, &ErrConnectionClosed{Cause: err})
+					}
+					c.replyLock.Unlock()
+					continue
+				}
 				return
 			}
 		// receive done from listenRead
@@ -295,19 +540,29 @@ func (c *ChromeTarget) listenWrite() {
 
 // Listens for responses coming in from the Chrome Debugger Service.
 func (c *ChromeTarget) listenRead() {
+	conn := c.getConn()
 	for {
-		msg, err := c.conn.Read()
+		msg, err := conn.Read()
 		if err != nil {
-			c.shutdown()
+			if c.canReconnect() && !c.isStopped() {
+				c.reconnect(err)
+				return
+			}
+			c.stop(err)
 			return
 		}
+
+		if frames, ok := c.messageObserver.(observer.FrameObserver); ok {
+			frames.Received(c.Target.Id, msg)
+		}
 		c.dispatchResponse(msg)
 	}
 }
 
 type responseHeader struct {
-	Id     int64  `json:"id"`
-	Method string `json:"method"`
+	Id        int64  `json:"id"`
+	Method    string `json:"method"`
+	SessionId string `json:"sessionId"`
 }
 
 // dispatchResponse takes in the json message and extracts
@@ -320,24 +575,35 @@ func (c *ChromeTarget) dispatchResponse(msg []byte) {
 		c.logger.Println("error reading response data from chrome target", err)
 	}
 
+	// flattened sessions share our connection, hand their messages off to them
+	if f.SessionId != c.sessionId {
+		if session := c.lookupSession(f.SessionId); session != nil {
+			session.dispatchResponse(msg)
+			return
+		}
+		c.logDebug("no session found for message: ", f.SessionId, " data: ", string(msg))
+		return
+	}
+
 	c.replyLock.Lock()
 
 	if r, ok := c.replyDispatcher[f.Id]; ok {
 		delete(c.replyDispatcher, f.Id)
 		c.replyLock.Unlock()
 		c.logDebug("dispatching response id:", f.Id)
-		go c.dispatchWithTimeout(r, f.Id, msg)
+		// deliver the reply before handling any detach that follows it, sendData's channel is buffered
+		select {
+		case r <- &gcdmessage.Message{Id: f.Id, Data: msg}:
+		default:
+			go c.dispatchWithTimeout(r, f.Id, msg)
+		}
 		return
 	}
 	c.replyLock.Unlock()
 
-	c.checkTargetDisconnected(f.Method)
-
-	c.eventLock.RLock()
-	_, ok := c.eventDispatcher[f.Method]
-	c.eventLock.RUnlock()
+	c.checkTargetDisconnected(f.Method, msg)
 
-	if ok {
+	if len(c.subscribers(f.Method)) > 0 {
 		c.eventCh <- &devtoolsEventResponse{Method: f.Method, Msg: msg}
 		return
 	}
@@ -356,13 +622,14 @@ func (c *ChromeTarget) dispatchEvents() {
 			return
 		case m := <-c.eventCh:
 			c.logDebug("dispatching", m.Method, "event: ", string(m.Msg))
-			c.eventLock.Lock()
-			cb, ok := c.eventDispatcher[m.Method]
-			c.eventLock.Unlock()
-			if !ok {
+			subs := c.subscribers(m.Method)
+			if len(subs) == 0 {
 				break
 			}
-			cb(c, m.Msg)
+			c.messageObserver.Event(m.Method, m.Msg)
+			for _, sub := range subs {
+				sub.callback(c, m.Msg)
+			}
 		}
 	}
 }
@@ -384,27 +651,38 @@ func (c *ChromeTarget) dispatchWithTimeout(r chan<- *gcdmessage.Message, id int6
 
 // check target detached/crashed
 // close any replier channels that are open
+// shutdown any flattened sessions that were detached
 // dispatch detached / crashed event as usual
-func (c *ChromeTarget) checkTargetDisconnected(method string) {
+func (c *ChromeTarget) checkTargetDisconnected(method string, msg []byte) {
 	switch method {
-	case "Inspector.targetCrashed", "Inspector.detached":
-		c.replyLock.Lock()
-		for _, replyCh := range c.replyDispatcher {
-			close(replyCh)
+	case gcdapi.EventTargetDetachedFromTarget:
+		detached := &gcdapi.TargetDetachedFromTargetEvent{}
+		if err := json.Unmarshal(msg, detached); err != nil {
+			c.logger.Println("error decoding detachedFromTarget event", err)
+			return
 		}
-		// empty out the dispatcher
-		c.replyDispatcher = make(map[int64]chan *gcdmessage.Message)
-		c.replyLock.Unlock()
-	}
-}
-
-// Connects to the tab/process for sending/recv'ing debug events
-func wsConnection(ctx context.Context, url string) (*WebSocket, error) {
-	client := &WebSocket{}
-	if err := client.Connect(ctx, url, nil); err != nil {
-		return nil, err
+		if detached.Params.TargetId != "" && detached.Params.TargetId == c.Target.Id {
+			c.targetGone()
+		}
+		c.sessionDetached(detached)
+	case gcdapi.EventTargetTargetDestroyed:
+		destroyed := &gcdapi.TargetTargetDestroyedEvent{}
+		if err := json.Unmarshal(msg, destroyed); err != nil {
+			c.logger.Println("error decoding targetDestroyed event", err)
+			return
+		}
+		if destroyed.Params.TargetId == c.Target.Id {
+			c.targetGone()
+		}
+	case gcdapi.EventInspectorTargetCrashed:
+		c.targetCrashed()
+	case gcdapi.EventInspectorDetached:
+		detached := &gcdapi.InspectorDetachedEvent{}
+		if err := json.Unmarshal(msg, detached); err != nil {
+			c.logger.Println("error decoding Inspector.detached event", err)
+		}
+		c.targetDetached(detached.Params.Reason)
 	}
-	return client, nil
 }
 
 // gcdmessage.ChromeTargeter interface methods
@@ -433,6 +711,7 @@ func (c *ChromeTarget) logDebug(args ...interface{}) {
 
 // SendCustomReturn takes in a ParamRequest and gives back a response channel so the caller can decode as necessary.
 func (c *ChromeTarget) SendCustomReturn(ctx context.Context, paramRequest *gcdmessage.ParamRequest) (*gcdmessage.Message, error) {
+	paramRequest.SessionId = c.sessionId
 	data, err := json.Marshal(paramRequest)
 
 	if err != nil {
@@ -444,13 +723,16 @@ func (c *ChromeTarget) SendCustomReturn(ctx context.Context, paramRequest *gcdme
 	response, err := c.sendData(ctx, paramRequest.Id, data)
 
 	c.messageObserver.Response(paramRequest.Id, paramRequest.Method, observer.DigResponseData(response), err)
+	if err == nil {
+		c.trackDomain(paramRequest.Method, paramRequest.Params, response.Data)
+	}
 	return response, err
 }
 
 // SendDefaultRequest sends a generic request that gets back a generic response, or error. This returns a ChromeResponse
 // object.
 func (c *ChromeTarget) SendDefaultRequest(ctx context.Context, paramRequest *gcdmessage.ParamRequest) (*gcdmessage.ChromeResponse, error) {
-	data, err := json.Marshal(&gcdmessage.ChromeRequest{Id: paramRequest.Id, Method: paramRequest.Method, Params: paramRequest.Params})
+	data, err := json.Marshal(&gcdmessage.ChromeRequest{Id: paramRequest.Id, Method: paramRequest.Method, Params: paramRequest.Params, SessionId: c.sessionId})
 
 	if err != nil {
 		return nil, err
@@ -480,9 +762,51 @@ func (c *ChromeTarget) SendDefaultRequest(ctx context.Context, paramRequest *gcd
 		return nil, err
 	}
 
+	c.trackDomain(paramRequest.Method, paramRequest.Params, nil)
 	return chromeResponse, nil
 }
 
+// CallInto sends a command by method name, such as "Page.navigate", and decodes its result into out.
+// params is marshaled as the request's params and may be nil, as may out if the result is not needed.
+// Protocol errors are returned as *gcdmessage.ChromeRequestErr. Useful for commands newer than the
+// generated gcdapi package.
+func (c *ChromeTarget) CallInto(ctx context.Context, method string, params interface{}, out interface{}) error {
+	resp, err := c.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.GetId(), Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+
+	if resp == nil {
+		return &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result json.RawMessage `json:"result"`
+	}
+
+	if err := json.Unmarshal(resp.Data, &chromeData); err != nil {
+		return err
+	}
+
+	if chromeData.Error != nil {
+		return &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	if out == nil || len(chromeData.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(chromeData.Result, out)
+}
+
+// Call sends a command by method name to the target and returns its result decoded as T,
+// see ChromeTarget.CallInto.
+func Call[T any](ctx context.Context, target *ChromeTarget, method string, params interface{}) (T, error) {
+	var result T
+	err := target.CallInto(ctx, method, params, &result)
+	return result, err
+}
+
 func (c *ChromeTarget) sendData(ctx context.Context, ID int64, data []byte) (*gcdmessage.Message, error) {
 	recvCh := make(chan *gcdmessage.Message, 1)
 
@@ -493,7 +817,7 @@ func (c *ChromeTarget) sendData(ctx context.Context, ID int64, data []byte) (*gc
 	case <-time.After(c.GetApiTimeout()):
 		return nil, &gcdmessage.ChromeApiTimeoutErr{}
 	case <-c.GetDoneCh():
-		return nil, &gcdmessage.ChromeDoneErr{}
+		return nil, c.Err()
 	}
 
 	var resp *gcdmessage.Message
@@ -504,7 +828,16 @@ func (c *ChromeTarget) sendData(ctx context.Context, ID int64, data []byte) (*gc
 		return nil, &gcdmessage.ChromeApiTimeoutErr{}
 	case resp = <-recvCh:
 	case <-c.GetDoneCh():
-		return nil, &gcdmessage.ChromeDoneErr{}
+		// the reply may have arrived just before the target stopped
+		select {
+		case resp = <-recvCh:
+		default:
+			return nil, c.Err()
+		}
+	}
+
+	if resp != nil && resp.Err != nil {
+		return nil, resp.Err
 	}
 
 	if resp == nil || resp.Data == nil {
@@ -0,0 +1,81 @@
+//go:build !gcd_stable
+
+package gcd
+
+import "github.com/wirepair/gcd/v2/gcdapi"
+
+// experimentalDomains are the domains chrome marks as experimental, they may change or be
+// removed in any release. Build with the gcd_stable tag to leave them out.
+type experimentalDomains struct {
+	Accessibility        *gcdapi.Accessibility
+	Animation            *gcdapi.Animation
+	Audits               *gcdapi.Audits
+	Autofill             *gcdapi.Autofill
+	BackgroundService    *gcdapi.BackgroundService
+	BluetoothEmulation   *gcdapi.BluetoothEmulation
+	CacheStorage         *gcdapi.CacheStorage
+	Cast                 *gcdapi.Cast
+	CSS                  *gcdapi.CSS
+	DeviceAccess         *gcdapi.DeviceAccess
+	DeviceOrientation    *gcdapi.DeviceOrientation
+	DOMSnapshot          *gcdapi.DOMSnapshot
+	DOMStorage           *gcdapi.DOMStorage
+	EventBreakpoints     *gcdapi.EventBreakpoints
+	Extensions           *gcdapi.Extensions
+	FedCm                *gcdapi.FedCm
+	FileSystem           *gcdapi.FileSystem
+	HeadlessExperimental *gcdapi.HeadlessExperimental
+	HeapProfiler         *gcdapi.HeapProfiler
+	IndexedDB            *gcdapi.IndexedDB
+	Inspector            *gcdapi.Inspector
+	LayerTree            *gcdapi.LayerTree
+	Media                *gcdapi.Media
+	Memory               *gcdapi.Memory
+	Overlay              *gcdapi.Overlay
+	PerformanceTimeline  *gcdapi.PerformanceTimeline
+	Preload              *gcdapi.Preload
+	PWA                  *gcdapi.PWA
+	ServiceWorker        *gcdapi.ServiceWorker
+	Storage              *gcdapi.Storage
+	SystemInfo           *gcdapi.SystemInfo
+	Tethering            *gcdapi.Tethering
+	WebAudio             *gcdapi.WebAudio
+	WebAuthn             *gcdapi.WebAuthn
+}
+
+func (c *ChromeTarget) initExperimentalDomains() {
+	c.Accessibility = gcdapi.NewAccessibility(c)
+	c.Animation = gcdapi.NewAnimation(c)
+	c.Audits = gcdapi.NewAudits(c)
+	c.Autofill = gcdapi.NewAutofill(c)
+	c.BackgroundService = gcdapi.NewBackgroundService(c)
+	c.BluetoothEmulation = gcdapi.NewBluetoothEmulation(c)
+	c.CacheStorage = gcdapi.NewCacheStorage(c)
+	c.Cast = gcdapi.NewCast(c)
+	c.CSS = gcdapi.NewCSS(c)
+	c.DeviceAccess = gcdapi.NewDeviceAccess(c)
+	c.DeviceOrientation = gcdapi.NewDeviceOrientation(c)
+	c.DOMSnapshot = gcdapi.NewDOMSnapshot(c)
+	c.DOMStorage = gcdapi.NewDOMStorage(c)
+	c.EventBreakpoints = gcdapi.NewEventBreakpoints(c)
+	c.Extensions = gcdapi.NewExtensions(c)
+	c.FedCm = gcdapi.NewFedCm(c)
+	c.FileSystem = gcdapi.NewFileSystem(c)
+	c.HeadlessExperimental = gcdapi.NewHeadlessExperimental(c)
+	c.HeapProfiler = gcdapi.NewHeapProfiler(c)
+	c.IndexedDB = gcdapi.NewIndexedDB(c)
+	c.Inspector = gcdapi.NewInspector(c)
+	c.LayerTree = gcdapi.NewLayerTree(c)
+	c.Media = gcdapi.NewMedia(c)
+	c.Memory = gcdapi.NewMemory(c)
+	c.Overlay = gcdapi.NewOverlay(c)
+	c.PerformanceTimeline = gcdapi.NewPerformanceTimeline(c)
+	c.Preload = gcdapi.NewPreload(c)
+	c.PWA = gcdapi.NewPWA(c)
+	c.ServiceWorker = gcdapi.NewServiceWorker(c)
+	c.Storage = gcdapi.NewStorage(c)
+	c.SystemInfo = gcdapi.NewSystemInfo(c)
+	c.Tethering = gcdapi.NewTethering(c)
+	c.WebAudio = gcdapi.NewWebAudio(c)
+	c.WebAuthn = gcdapi.NewWebAuthn(c)
+}
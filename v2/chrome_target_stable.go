@@ -0,0 +1,8 @@
+//go:build gcd_stable
+
+package gcd
+
+// experimentalDomains is empty with the gcd_stable build tag, see chrome_target_experimental.go
+type experimentalDomains struct{}
+
+func (c *ChromeTarget) initExperimentalDomains() {}
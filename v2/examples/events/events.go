@@ -32,7 +32,7 @@ var (
 var testStartupFlags = []string{"--disable-new-tab-first-run", "--no-first-run", "--disable-popup-blocking"}
 
 func init() {
-	flag.StringVar(&testPath, "chrome", "C:\\Program Files (x86)\\Google\\Chrome\\Application\\chrome.exe", "path to chrome")
+	flag.StringVar(&testPath, "chrome", "", "path to chrome, searched for with gcd.FindChrome if empty")
 	flag.StringVar(&testDir, "dir", "C:\\temp\\", "user directory")
 	flag.StringVar(&testPort, "port", "9222", "Debugger port")
 }
@@ -52,7 +52,7 @@ func main() {
 
 	// navigate
 	navigateParams := &gcdapi.PageNavigateParams{Url: testServerAddr + "top.html"}
-	_, _, _, err := target.Page.NavigateWithParams(ctx, navigateParams)
+	_, _, _, _, err := target.Page.NavigateWithParams(ctx, navigateParams)
 	if err != nil {
 		log.Fatalf("error: %s\n", err)
 	}
@@ -138,7 +138,7 @@ func startTarget(debugger *gcd.Gcd) *gcd.ChromeTarget {
 	ctx := context.Background()
 	target.DOM.Enable(ctx, "false")
 	target.Console.Enable(ctx)
-	target.Page.Enable(ctx)
+	target.Page.Enable(ctx, false)
 	//target.Debugger.Enable()
 	return target
 
@@ -16,16 +16,14 @@ var port string
 func init() {
 	switch runtime.GOOS {
 	case "windows":
-		flag.StringVar(&path, "chrome", "C:\\Program Files (x86)\\Google\\Chrome\\Application\\chrome.exe", "path to chrome")
 		flag.StringVar(&dir, "dir", "C:\\temp\\", "user directory")
 	case "darwin":
-		flag.StringVar(&path, "chrome", "/Applications/Google Chrome.app/Contents/MacOS/Google Chrome", "path to chrome")
 		flag.StringVar(&dir, "dir", "/tmp/", "user directory")
 	case "linux":
-		flag.StringVar(&path, "chrome", "/usr/bin/chromium-browser", "path to chrome")
 		flag.StringVar(&dir, "dir", "/tmp/", "user directory")
 	}
 
+	flag.StringVar(&path, "chrome", "", "path to chrome, searched for with gcd.FindChrome if empty")
 	flag.StringVar(&port, "port", "9222", "Debugger port")
 }
 
@@ -0,0 +1,20 @@
+//go:build !gcd_stable
+
+package gcd
+
+import "testing"
+
+func TestSimpleReturnReturnsGoError(t *testing.T) {
+	testDefaultStartup(t)
+	defer debugger.ExitProcess()
+
+	target, err := debugger.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	css := target.CSS
+	if _, err := css.Enable(testCtx); err == nil {
+		t.Fatalf("expected a go error since css.Enable requires DOM.Enable first.")
+	}
+}
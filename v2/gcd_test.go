@@ -1,21 +1,43 @@
 package gcd
 
 import (
+	"bufio"
+	"bytes"
+	"compress/flate"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
 	"flag"
 	"fmt"
 	"github.com/goccy/go-json"
+	"io"
 	"io/ioutil"
+	"math/big"
 	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"runtime"
 	"runtime/pprof"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/wirepair/gcd/v2/gcdapi"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+	"github.com/wirepair/gcd/v2/gcdtest"
+	"github.com/wirepair/gcd/v2/observer"
 )
 
 var (
@@ -32,15 +54,13 @@ var (
 func init() {
 	switch runtime.GOOS {
 	case "windows":
-		flag.StringVar(&testPath, "chrome", "C:\\Program Files\\Google\\Chrome\\Application\\chrome.exe", "path to chrome")
 		flag.StringVar(&testDir, "dir", "C:\\temp\\gcd\\", "user directory")
 	case "darwin":
-		flag.StringVar(&testPath, "chrome", "/Applications/Google Chrome.app/Contents/MacOS/Google Chrome", "path to chrome")
 		flag.StringVar(&testDir, "dir", "/tmp/gcd/", "user directory")
 	case "linux":
-		flag.StringVar(&testPath, "chrome", "/usr/bin/chromium-browser", "path to chrome")
 		flag.StringVar(&testDir, "dir", "/tmp/gcd/", "user directory")
 	}
+	flag.StringVar(&testPath, "chrome", "", "path to chrome, searched for with FindChrome if empty")
 	flag.StringVar(&testPort, "port", "9222", "Debugger port")
 
 }
@@ -80,6 +100,1705 @@ func TestDeleteProfileOnExit(t *testing.T) {
 	}
 }
 
+func TestFindChrome(t *testing.T) {
+	dir := t.TempDir()
+	exePath := filepath.Join(dir, "chrome")
+	if err := ioutil.WriteFile(exePath, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatalf("error writing fake chrome: %s\n", err)
+	}
+
+	t.Setenv(ChromePathEnv, exePath)
+	found, err := FindChrome()
+	if err != nil {
+		t.Fatalf("error finding chrome from %s: %s\n", ChromePathEnv, err)
+	}
+
+	if found != exePath {
+		t.Fatalf("expected %s got %s\n", exePath, found)
+	}
+
+	notExecutable := filepath.Join(dir, "notexecutable")
+	if err := ioutil.WriteFile(notExecutable, []byte("#!/bin/sh\n"), 0644); err != nil {
+		t.Fatalf("error writing fake chrome: %s\n", err)
+	}
+
+	// a bad CHROME_PATH is reported rather than skipped for a chrome installed elsewhere
+	for _, badPath := range []string{filepath.Join(dir, "missing"), dir, notExecutable} {
+		t.Setenv(ChromePathEnv, badPath)
+		_, err := FindChrome()
+		pathErr, ok := err.(*GcdChromePathErr)
+		if !ok || pathErr.Path != badPath || !strings.Contains(err.Error(), badPath) {
+			t.Fatalf("expected GcdChromePathErr naming %s got %v\n", badPath, err)
+		}
+	}
+
+	t.Setenv(ChromePathEnv, "")
+	t.Setenv("PATH", dir)
+	// a chrome installed in a well-known location may still be found
+	if _, err := FindChrome(); err != nil {
+		notFound, ok := err.(*GcdChromeNotFoundErr)
+		if !ok {
+			t.Fatalf("expected GcdChromeNotFoundErr got %T\n", err)
+		}
+
+		if !strings.Contains(notFound.Error(), ChromePathEnv+" (not set)") {
+			t.Fatalf("expected %s in list of tried paths: %s\n", ChromePathEnv, notFound)
+		}
+	}
+}
+
+func TestEphemeralPort(t *testing.T) {
+	debugger = NewChromeDebugger(WithDeleteProfileOnExit(), WithFlags([]string{"--headless"}))
+	if err := debugger.StartProcess(testPath, testRandomTempDir(t), ""); err != nil {
+		t.Fatalf("error starting chrome: %s\n", err)
+	}
+	defer debugger.ExitProcess()
+
+	if debugger.Port() == "" || debugger.Port() == "0" {
+		t.Fatalf("expected the port chrome chose, got %s\n", debugger.Port())
+	}
+
+	if !strings.HasPrefix(debugger.BrowserWebSocketUrl(), "ws://") {
+		t.Fatalf("expected browser websocket url, got %s\n", debugger.BrowserWebSocketUrl())
+	}
+
+	if _, err := debugger.NewTab(); err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+}
+
+func TestReadDevToolsActivePort(t *testing.T) {
+	dir := t.TempDir()
+	d := NewChromeDebugger()
+	d.profileDir = dir
+	d.usePort("")
+
+	if d.readDevToolsActivePort() {
+		t.Fatalf("expected false before DevToolsActivePort is written\n")
+	}
+
+	portFile := filepath.Join(dir, devToolsActivePortFile)
+	if err := ioutil.WriteFile(portFile, []byte("45678\n/devtools/browser/abc-123"), 0644); err != nil {
+		t.Fatalf("error writing port file: %s\n", err)
+	}
+
+	if !d.readDevToolsActivePort() {
+		t.Fatalf("expected DevToolsActivePort to be read\n")
+	}
+
+	if d.Port() != "45678" || d.apiEndpoint != "http://localhost:45678/json" {
+		t.Fatalf("expected port 45678 got %s (%s)\n", d.Port(), d.apiEndpoint)
+	}
+
+	if d.BrowserWebSocketUrl() != "ws://localhost:45678/devtools/browser/abc-123" {
+		t.Fatalf("unexpected browser url %s\n", d.BrowserWebSocketUrl())
+	}
+}
+
+func TestStartProcessPipe(t *testing.T) {
+	debugger = NewChromeDebugger(WithDeleteProfileOnExit(), WithFlags([]string{"--headless"}))
+	if err := debugger.StartProcessPipe(testPath, testRandomTempDir(t)); err != nil {
+		t.Fatalf("error starting chrome: %s\n", err)
+	}
+	defer debugger.ExitProcess()
+
+	if _, err := debugger.GetVersion(); err != nil {
+		t.Fatalf("error getting version over pipe: %s\n", err)
+	}
+
+	target, err := debugger.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	if target.SessionId() == "" {
+		t.Fatalf("expected tab to be a flattened session\n")
+	}
+
+	if _, _, _, _, err := target.Page.Navigate(testCtx, testServerAddr+"cookie.html", "", "", "", ""); err != nil {
+		t.Fatalf("error navigating over pipe: %s\n", err)
+	}
+
+	if err := debugger.CloseTab(target); err != nil {
+		t.Fatalf("error closing tab: %s\n", err)
+	}
+}
+
+func TestPipeConn(t *testing.T) {
+	conn, chromeFiles, err := newPipes()
+	if err != nil {
+		t.Fatalf("error creating pipes: %s\n", err)
+	}
+	defer conn.Close()
+
+	// act as chrome, reply to every request on fd 4 with an empty result
+	go func() {
+		chromeIn := bufio.NewReader(chromeFiles[0])
+		for {
+			msg, err := chromeIn.ReadBytes(0)
+			if err != nil {
+				return
+			}
+
+			req := &gcdmessage.ChromeRequest{}
+			if err := json.Unmarshal(msg[:len(msg)-1], req); err != nil {
+				return
+			}
+			fmt.Fprintf(chromeFiles[1], `{"id":%d,"result":{"method":"%s"}}`+"\x00", req.Id, req.Method)
+		}
+	}()
+
+	target := newChromeTarget(NewChromeDebugger(), &TargetInfo{Type: "browser"}, conn, observer.NewIgnoreMessagesObserver())
+	defer target.shutdown()
+
+	for i := 0; i < 3; i++ {
+		var result struct {
+			Method string `json:"method"`
+		}
+		if err := target.CallInto(testCtx, "Browser.getVersion", nil, &result); err != nil {
+			t.Fatalf("error calling over pipe: %s\n", err)
+		}
+
+		if result.Method != "Browser.getVersion" {
+			t.Fatalf("unexpected reply over pipe: %#v\n", result)
+		}
+	}
+}
+
+// testMemTransport is an in memory Transport which replies to every request with an empty result
+type testMemTransport struct {
+	replies chan []byte
+	closed  chan struct{}
+	once    sync.Once
+}
+
+func newTestMemTransport() *testMemTransport {
+	return &testMemTransport{replies: make(chan []byte, 10), closed: make(chan struct{})}
+}
+
+func (m *testMemTransport) Send(msg []byte) error {
+	req := &gcdmessage.ChromeRequest{}
+	if err := json.Unmarshal(msg, req); err != nil {
+		return err
+	}
+	m.replies <- []byte(fmt.Sprintf(`{"id":%d,"result":{}}`, req.Id))
+	return nil
+}
+
+func (m *testMemTransport) Read() ([]byte, error) {
+	select {
+	case msg := <-m.replies:
+		return msg, nil
+	case <-m.closed:
+		return nil, io.EOF
+	}
+}
+
+func (m *testMemTransport) Close() error {
+	m.once.Do(func() { close(m.closed) })
+	return nil
+}
+
+func TestTransportDialer(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"tab1","type":"page","webSocketDebuggerUrl":"mem://tab1"}]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var dialed []string
+	transport := newTestMemTransport()
+	d := NewChromeDebugger(WithTransportDialer(func(ctx context.Context, url string) (Transport, error) {
+		dialed = append(dialed, url)
+		return transport, nil
+	}))
+
+	host, port, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	if err := d.ConnectToInstance(host, port); err != nil {
+		t.Fatalf("error connecting to fake instance: %s\n", err)
+	}
+
+	targets, err := d.GetTargets()
+	if err != nil {
+		t.Fatalf("error getting targets: %s\n", err)
+	}
+
+	if len(targets) != 1 || len(dialed) != 1 || dialed[0] != "mem://tab1" {
+		t.Fatalf("expected one target dialed with the custom dialer, got %d %v\n", len(targets), dialed)
+	}
+
+	if _, err := targets[0].Page.Enable(testCtx, false); err != nil {
+		t.Fatalf("error sending over custom transport: %s\n", err)
+	}
+}
+
+func TestFakeServer(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	srv.OnMethod("Page.navigate", func(req *gcdtest.Request) (interface{}, error) {
+		srv.EmitTo(req.TargetId, "Page.loadEventFired", map[string]interface{}{"timestamp": 1.5})
+		return map[string]interface{}{"frameId": "frame1"}, nil
+	})
+	srv.OnMethod("Runtime.evaluate", func(req *gcdtest.Request) (interface{}, error) {
+		return nil, &gcdtest.Error{Code: -32000, Message: "boom"}
+	})
+
+	d := NewChromeDebugger()
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting to fake server: %s\n", err)
+	}
+
+	version, err := d.GetVersion()
+	if err != nil || version.Browser == "" {
+		t.Fatalf("error getting version: %v %#v\n", err, version)
+	}
+
+	if _, err := d.Browser(); err != nil {
+		t.Fatalf("error connecting to fake browser target: %s\n", err)
+	}
+
+	target, err := d.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	loadedCh := make(chan float64, 1)
+	target.Page.OnLoadEventFired(func(event *gcdapi.PageLoadEventFiredEvent) {
+		loadedCh <- event.Params.Timestamp
+	})
+
+	frameId, _, _, _, err := target.Page.Navigate(testCtx, "http://example.com", "", "", "", "")
+	if err != nil {
+		t.Fatalf("error navigating: %s\n", err)
+	}
+
+	if frameId != "frame1" {
+		t.Fatalf("expected scripted frameId got %s\n", frameId)
+	}
+
+	select {
+	case timestamp := <-loadedCh:
+		if timestamp != 1.5 {
+			t.Fatalf("expected emitted timestamp got %v\n", timestamp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for emitted event\n")
+	}
+
+	err = target.CallInto(testCtx, "Runtime.evaluate", nil, nil)
+	if _, ok := err.(*gcdmessage.ChromeRequestErr); !ok {
+		t.Fatalf("expected scripted error, got %v\n", err)
+	}
+
+	if err := d.CloseTab(target); err != nil {
+		t.Fatalf("error closing tab: %s\n", err)
+	}
+
+	for _, info := range srv.Targets() {
+		if info.Id == target.Target.Id {
+			t.Fatalf("expected target to be closed\n")
+		}
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	srv.OnMethod("Page.navigate", func(req *gcdtest.Request) (interface{}, error) {
+		req.After(func() {
+			// nothing subscribes to frameNavigated, it is still recorded
+			srv.EmitTo(req.TargetId, "Page.frameNavigated", map[string]interface{}{"frame": map[string]string{"id": "recorded"}})
+			srv.EmitTo(req.TargetId, "Page.loadEventFired", map[string]interface{}{"timestamp": 2.5})
+		})
+		return map[string]interface{}{"frameId": "recorded"}, nil
+	})
+
+	transcript := &bytes.Buffer{}
+	recorder := observer.NewRecordingObserver(transcript)
+	d := NewChromeDebugger(WithMessageObserver(recorder))
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting to fake server: %s\n", err)
+	}
+
+	target, err := d.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+	navigateAndWait(t, target, "recorded")
+	if err := recorder.Err(); err != nil {
+		t.Fatalf("error recording: %s\n", err)
+	}
+
+	records, err := observer.ReadRecords(transcript)
+	if err != nil {
+		t.Fatalf("error reading records: %s\n", err)
+	}
+
+	// in the order the messages were sent and arrived
+	expected := []struct{ recordType, method string }{
+		{observer.RecordRequest, "Page.navigate"},
+		{observer.RecordResponse, "Page.navigate"},
+		{observer.RecordEvent, "Page.frameNavigated"},
+		{observer.RecordEvent, "Page.loadEventFired"},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records got %d\n", len(expected), len(records))
+	}
+
+	for i, record := range records {
+		if record.Type != expected[i].recordType || record.Method != expected[i].method || record.Target != target.Target.Id {
+			t.Fatalf("expected record %d to be a %s of %s got %#v\n", i, expected[i].recordType, expected[i].method, record)
+		}
+	}
+
+	// replay from a server with no handlers
+	replaySrv := gcdtest.NewServer()
+	defer replaySrv.Close()
+	replaySrv.Replay(records)
+
+	replayDebugger := NewChromeDebugger()
+	if err := replayDebugger.ConnectToInstance(replaySrv.Host(), replaySrv.Port()); err != nil {
+		t.Fatalf("error connecting to replay server: %s\n", err)
+	}
+
+	replayed, err := replayDebugger.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+	navigateAndWait(t, replayed, "recorded")
+
+	if _, err := replayed.Page.Enable(testCtx, false); err == nil {
+		t.Fatalf("expected error for a method that was not recorded\n")
+	}
+
+	// and without a server at all
+	transport := gcdtest.NewReplayTransport(records)
+	defer transport.Close()
+	navigateAndWait(t, d.OpenTarget(&TargetInfo{Id: "replay", Type: "page"}, transport), "recorded")
+}
+
+func navigateAndWait(t *testing.T, target *ChromeTarget, expectedFrameId string) {
+	loadedCh := make(chan float64, 1)
+	target.Page.OnLoadEventFired(func(event *gcdapi.PageLoadEventFiredEvent) {
+		loadedCh <- event.Params.Timestamp
+	})
+
+	frameId, _, _, _, err := target.Page.Navigate(testCtx, "http://example.com", "", "", "", "")
+	if err != nil {
+		t.Fatalf("error navigating: %s\n", err)
+	}
+
+	if frameId != expectedFrameId {
+		t.Fatalf("expected frameId %s got %s\n", expectedFrameId, frameId)
+	}
+
+	select {
+	case timestamp := <-loadedCh:
+		if timestamp != 2.5 {
+			t.Fatalf("expected recorded timestamp got %v\n", timestamp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for load event\n")
+	}
+}
+
+func TestWebSocketFraming(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s\n", err)
+	}
+	defer listener.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- testWebSocketPeer(listener)
+	}()
+
+	ws := &WebSocket{MaxFrameSize: 4}
+	if err := ws.Connect(testCtx, "ws://"+listener.Addr().String()+"/devtools/page/1", nil); err != nil {
+		t.Fatalf("error connecting: %s\n", err)
+	}
+	defer ws.Close()
+
+	msg, err := ws.Read()
+	if err != nil {
+		t.Fatalf("error reading fragmented message: %s\n", err)
+	}
+
+	if string(msg) != "hello world" {
+		t.Fatalf("expected reassembled message got %q\n", msg)
+	}
+
+	if err := ws.Send([]byte("fragmented send")); err != nil {
+		t.Fatalf("error sending: %s\n", err)
+	}
+
+	_, err = ws.Read()
+	closeErr, ok := err.(*ErrWebSocketClosed)
+	if !ok || closeErr.Code != 1001 || closeErr.Reason != "going away" {
+		t.Fatalf("expected close error got %v\n", err)
+	}
+
+	if err := <-serverErr; err != nil {
+		t.Fatalf("peer error: %s\n", err)
+	}
+}
+
+// testWebSocketPeer accepts a single WebSocket client and plays chrome's side of TestWebSocketFraming
+func testWebSocketPeer(listener net.Listener) error {
+	conn, r, _, err := testAcceptWebSocket(listener, "")
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	writeFrame := func(b0 byte, payload string) {
+		testWriteFrame(conn, b0, payload)
+	}
+	readFrame := func() (byte, string, error) {
+		return testReadFrame(r)
+	}
+
+	// a text message split in three with a ping in the middle
+	writeFrame(0x01, "hello")
+	writeFrame(0x89, "are you there")
+	writeFrame(0x00, " wor")
+	writeFrame(0x80, "ld")
+
+	b0, payload, err := readFrame()
+	if err != nil || b0 != 0x8a || payload != "are you there" {
+		return fmt.Errorf("expected pong got %x %q %v", b0, payload, err)
+	}
+
+	var message string
+	for {
+		b0, payload, err := readFrame()
+		if err != nil {
+			return err
+		}
+		if b0&0x0f != 0x01 && b0&0x0f != 0x00 {
+			return fmt.Errorf("unexpected opcode %x", b0)
+		}
+		message += payload
+		if b0&0x80 != 0 {
+			break
+		}
+	}
+	if message != "fragmented send" {
+		return fmt.Errorf("expected fragmented send got %q", message)
+	}
+
+	writeFrame(0x88, "\x03\xe9going away")
+	b0, payload, err = readFrame()
+	if err != nil || b0 != 0x88 || payload != "\x03\xe9" {
+		return fmt.Errorf("expected close reply got %x %q %v", b0, payload, err)
+	}
+	return nil
+}
+
+func TestWebSocketCompression(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s\n", err)
+	}
+	defer listener.Close()
+
+	message := strings.Repeat(`{"method":"DOM.setChildNodes","params":{"nodes":[]}}`, 20)
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, r, req, err := testAcceptWebSocket(listener, "permessage-deflate")
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+
+		if !strings.HasPrefix(req.Header.Get("Sec-WebSocket-Extensions"), "permessage-deflate") {
+			serverErr <- fmt.Errorf("compression not offered: %q", req.Header.Get("Sec-WebSocket-Extensions"))
+			return
+		}
+
+		// keep the compression context, the second message refers back to the first
+		buf := &bytes.Buffer{}
+		w, _ := flate.NewWriter(buf, flate.BestCompression)
+		for i := 0; i < 2; i++ {
+			buf.Reset()
+			w.Write([]byte(message))
+			w.Flush()
+			testWriteFrame(conn, 0xc1, string(bytes.TrimSuffix(buf.Bytes(), []byte{0, 0, 0xff, 0xff})))
+		}
+
+		b0, payload, err := testReadFrame(r)
+		if err != nil || b0 != 0xc1 {
+			serverErr <- fmt.Errorf("expected compressed frame got %x %v", b0, err)
+			return
+		}
+
+		inflated, err := io.ReadAll(flate.NewReader(strings.NewReader(payload + "\x00\x00\xff\xff\x01\x00\x00\xff\xff")))
+		if err != nil || string(inflated) != message {
+			serverErr <- fmt.Errorf("error inflating client message: %v", err)
+			return
+		}
+		serverErr <- nil
+	}()
+
+	ws := &WebSocket{Compression: true}
+	if err := ws.Connect(testCtx, "ws://"+listener.Addr().String()+"/devtools/page/1", nil); err != nil {
+		t.Fatalf("error connecting: %s\n", err)
+	}
+	defer ws.Close()
+
+	for i := 0; i < 2; i++ {
+		msg, err := ws.Read()
+		if err != nil {
+			t.Fatalf("error reading compressed message: %s\n", err)
+		}
+
+		if string(msg) != message {
+			t.Fatalf("expected decompressed message got %q\n", msg)
+		}
+	}
+
+	if err := ws.Send([]byte(message)); err != nil {
+		t.Fatalf("error sending: %s\n", err)
+	}
+
+	if err := <-serverErr; err != nil {
+		t.Fatalf("peer error: %s\n", err)
+	}
+}
+
+// testAcceptWebSocket accepts a single WebSocket client, accepting the extensions if not empty
+func testAcceptWebSocket(listener net.Listener, extensions string) (net.Conn, *bufio.Reader, *http.Request, error) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		conn.Close()
+		return nil, nil, nil, err
+	}
+
+	fmt.Fprintf(conn, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n", webSocketAccept(req.Header.Get("Sec-WebSocket-Key")))
+	if extensions != "" {
+		fmt.Fprintf(conn, "Sec-WebSocket-Extensions: %s\r\n", extensions)
+	}
+	fmt.Fprint(conn, "\r\n")
+	return conn, r, req, nil
+}
+
+// testWriteFrame writes an unmasked frame of up to 64k
+func testWriteFrame(conn net.Conn, b0 byte, payload string) {
+	header := []byte{b0, byte(len(payload))}
+	if len(payload) > 125 {
+		header = []byte{b0, 126, byte(len(payload) >> 8), byte(len(payload))}
+	}
+	conn.Write(append(header, payload...))
+}
+
+// testReadFrame reads a masked client frame of up to 64k
+func testReadFrame(r *bufio.Reader) (byte, string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, "", err
+	}
+
+	if header[1]&0x80 == 0 {
+		return 0, "", fmt.Errorf("client frame not masked")
+	}
+
+	size := int(header[1] & 0x7f)
+	if size == 126 {
+		ext := make([]byte, 2)
+		if _, err := io.ReadFull(r, ext); err != nil {
+			return 0, "", err
+		}
+		size = int(ext[0])<<8 | int(ext[1])
+	}
+
+	mask := make([]byte, 4)
+	if _, err := io.ReadFull(r, mask); err != nil {
+		return 0, "", err
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, "", err
+	}
+
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return header[0], string(payload), nil
+}
+
+func TestRemoteConnection(t *testing.T) {
+	srv := gcdtest.NewTLSServer()
+	defer srv.Close()
+	srv.Authorize = func(r *http.Request) bool {
+		return r.Header.Get("Authorization") == "Bearer secret"
+	}
+
+	// the https proxy's certificate is signed by a private CA, only trusted through WithTLSConfig
+	proxyCert, proxyCA := testCertificate(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+	roots.AddCert(proxyCA)
+
+	for _, scheme := range []string{"http", "https"} {
+		var tunnels int32
+		var proxyConfig *tls.Config
+		if scheme == "https" {
+			proxyConfig = &tls.Config{Certificates: []tls.Certificate{proxyCert}}
+		}
+
+		proxy := testProxy(t, proxyConfig, func(conn net.Conn, r *bufio.Reader) (string, error) {
+			req, err := http.ReadRequest(r)
+			if err != nil {
+				return "", err
+			}
+
+			if req.Method != http.MethodConnect || req.Header.Get("Proxy-Authorization") != "Basic dXNlcjpwYXNz" {
+				fmt.Fprint(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+				return "", fmt.Errorf("unauthorized proxy request")
+			}
+			atomic.AddInt32(&tunnels, 1)
+			fmt.Fprint(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+			return req.Host, nil
+		})
+		defer proxy.Close()
+
+		proxyURL, _ := url.Parse(scheme + "://user:pass@" + proxy.Addr().String())
+		d := NewChromeDebugger(
+			WithTLSConfig(&tls.Config{RootCAs: roots}),
+			WithHeaders(http.Header{"Authorization": {"Bearer secret"}}),
+			WithProxy(proxyURL),
+		)
+
+		if err := d.ConnectToURL(srv.URL()); err != nil {
+			t.Fatalf("error connecting to https server through %s proxy: %s\n", scheme, err)
+		}
+
+		target, err := d.NewTab()
+		if err != nil {
+			t.Fatalf("error getting new tab through %s proxy: %s\n", scheme, err)
+		}
+
+		if _, err := target.Page.Enable(testCtx, false); err != nil {
+			t.Fatalf("error sending over wss through %s proxy: %s\n", scheme, err)
+		}
+
+		if atomic.LoadInt32(&tunnels) < 2 {
+			t.Fatalf("expected the http and websocket connections to be proxied by the %s proxy, got %d tunnels\n", scheme, tunnels)
+		}
+	}
+}
+
+// testCertificate returns a certificate for 127.0.0.1 and the self signed CA certificate it is
+// signed with
+func testCertificate(t *testing.T) (tls.Certificate, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %s\n", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gcd test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error creating certificate: %s\n", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("error parsing certificate: %s\n", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, cert
+}
+
+func TestSocks5Proxy(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	proxy := testProxy(t, nil, func(conn net.Conn, r *bufio.Reader) (string, error) {
+		// no authentication
+		greeting := make([]byte, 3)
+		if _, err := io.ReadFull(r, greeting); err != nil {
+			return "", err
+		}
+		conn.Write([]byte{5, 0})
+
+		header := make([]byte, 5)
+		if _, err := io.ReadFull(r, header); err != nil {
+			return "", err
+		}
+
+		// ipv4 (1) or domain (3) addresses
+		host := make([]byte, 3)
+		if header[3] == 3 {
+			host = make([]byte, header[4])
+		}
+		io.ReadFull(r, host)
+		port := make([]byte, 2)
+		io.ReadFull(r, port)
+
+		addr := string(host)
+		if header[3] == 1 {
+			addr = net.IP(append([]byte{header[4]}, host...)).String()
+		}
+		conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+		return net.JoinHostPort(addr, fmt.Sprint(int(port[0])<<8|int(port[1]))), nil
+	})
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse("socks5://" + proxy.Addr().String())
+	d := NewChromeDebugger(WithProxy(proxyURL))
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting through socks5: %s\n", err)
+	}
+
+	target, err := d.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	if _, err := target.Page.Enable(testCtx, false); err != nil {
+		t.Fatalf("error sending through socks5: %s\n", err)
+	}
+}
+
+// testProxy accepts connections, handshake reads the proxy protocol and returns the address
+// to tunnel to.
+func testProxy(t *testing.T, config *tls.Config, handshake func(conn net.Conn, r *bufio.Reader) (string, error)) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s\n", err)
+	}
+
+	if config != nil {
+		listener = tls.NewListener(listener, config)
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				addr, err := handshake(conn, r)
+				if err != nil {
+					return
+				}
+
+				upstream, err := net.Dial("tcp", addr)
+				if err != nil {
+					return
+				}
+				defer upstream.Close()
+
+				go io.Copy(upstream, r)
+				io.Copy(conn, upstream)
+			}()
+		}
+	}()
+	return listener
+}
+
+func TestReconnect(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	d := NewChromeDebugger(WithReconnect(ReconnectPolicy{InitialBackoff: 10 * time.Millisecond, MaxAttempts: 5}))
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting to fake server: %s\n", err)
+	}
+
+	target, err := d.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	// handlers which block and call back into the target don't hold up reconnecting
+	disconnectedCh := make(chan error, 1)
+	reconnectedCh := make(chan error, 1)
+	target.OnDisconnected(func(err error) {
+		<-reconnectedCh
+		reconnectedCh <- nil
+		disconnectedCh <- err
+	})
+	target.OnReconnected(func() {
+		reconnectedCh <- target.CallInto(testCtx, "Runtime.evaluate", map[string]interface{}{"expression": "1"}, nil)
+	})
+
+	loadedCh := make(chan struct{}, 1)
+	target.Page.OnLoadEventFired(func(event *gcdapi.PageLoadEventFiredEvent) {
+		loadedCh <- struct{}{}
+	})
+
+	if _, err := target.Page.Enable(testCtx, false); err != nil {
+		t.Fatalf("error enabling page: %s\n", err)
+	}
+
+	srv.Disconnect(target.Target.Id)
+
+	select {
+	case <-disconnectedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for disconnect and reconnect\n")
+	}
+
+	if err := <-reconnectedCh; err != nil {
+		t.Fatalf("error calling from the reconnect handler: %s\n", err)
+	}
+
+	enables := 0
+	for _, req := range srv.Requests() {
+		if req.Method == "Page.enable" {
+			enables++
+		}
+	}
+
+	if enables != 2 {
+		t.Fatalf("expected Page.enable to be sent again after reconnecting, got %d\n", enables)
+	}
+
+	srv.EmitTo(target.Target.Id, "Page.loadEventFired", map[string]interface{}{"timestamp": 1})
+	select {
+	case <-loadedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for event after reconnecting\n")
+	}
+
+	if err := target.CallInto(testCtx, "Runtime.evaluate", map[string]interface{}{"expression": "1"}, nil); err != nil {
+		t.Fatalf("error calling after reconnecting: %s\n", err)
+	}
+}
+
+func TestReconnectTargetGone(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	d := NewChromeDebugger(WithReconnect(ReconnectPolicy{InitialBackoff: 10 * time.Millisecond, MaxAttempts: -1}))
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting to fake server: %s\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 5*time.Second)
+	defer cancel()
+
+	closed, err := d.NewTabCtx(ctx, "")
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	if err := d.CloseTabCtx(ctx, closed); err != nil {
+		t.Fatalf("error closing tab: %s\n", err)
+	}
+
+	var detached *ErrTargetDetached
+	if !errors.As(closed.Err(), &detached) {
+		t.Fatalf("expected a closed tab to be detached got %v\n", closed.Err())
+	}
+
+	// closed by another client, the url is gone
+	removed, err := d.NewTabCtx(ctx, "")
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	req, _ := http.NewRequest("PUT", srv.URL()+"/json/close/"+removed.Target.Id, nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("error closing tab: %s\n", err)
+	}
+	resp.Body.Close()
+
+	select {
+	case <-removed.Done():
+	case <-ctx.Done():
+		t.Fatalf("expected reconnecting to a removed tab to give up\n")
+	}
+
+	var handshakeErr *ErrBadHandshake
+	if !errors.As(removed.Err(), &handshakeErr) || handshakeErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a not found handshake error got %v\n", removed.Err())
+	}
+
+	policy := &ReconnectPolicy{}
+	if policy.maxAttempts() != defaultReconnectAttempts {
+		t.Fatalf("expected a finite number of attempts by default got %d\n", policy.maxAttempts())
+	}
+}
+
+func TestTargetLifecycle(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	srv.OnMethod("Page.crash", func(req *gcdtest.Request) (interface{}, error) {
+		srv.EmitTo(req.TargetId, "Inspector.targetCrashed", map[string]interface{}{})
+		return nil, nil
+	})
+	srv.OnMethod("Page.close", func(req *gcdtest.Request) (interface{}, error) {
+		srv.EmitTo(req.TargetId, "Inspector.detached", map[string]interface{}{"reason": "target_closed"})
+		return nil, nil
+	})
+
+	d := NewChromeDebugger()
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting to fake server: %s\n", err)
+	}
+
+	target, err := d.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	crashedCh := make(chan struct{}, 1)
+	detachedCh := make(chan string, 1)
+	closedCh := make(chan error, 1)
+	target.OnCrash(func() { crashedCh <- struct{}{} })
+	target.OnDetach(func(reason string) { detachedCh <- reason })
+	target.OnClose(func(err error) { closedCh <- err })
+
+	if _, err := target.Page.Crash(testCtx); err != ErrTargetCrashed {
+		t.Fatalf("expected ErrTargetCrashed got %v\n", err)
+	}
+
+	select {
+	case <-crashedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for OnCrash\n")
+	}
+
+	_, err = target.Page.Close(testCtx)
+	if detachedErr, ok := err.(*ErrTargetDetached); !ok || detachedErr.Reason != "target_closed" {
+		t.Fatalf("expected ErrTargetDetached got %v\n", err)
+	}
+
+	select {
+	case reason := <-detachedCh:
+		if reason != "target_closed" {
+			t.Fatalf("expected detach reason got %s\n", reason)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for OnDetach\n")
+	}
+
+	if target.Err() != nil {
+		t.Fatalf("expected no error while running got %s\n", target.Err())
+	}
+
+	srv.Disconnect(target.Target.Id)
+
+	select {
+	case <-target.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for Done\n")
+	}
+
+	closedErr, ok := target.Err().(*ErrConnectionClosed)
+	if !ok || closedErr.Cause == nil {
+		t.Fatalf("expected ErrConnectionClosed with a cause got %v\n", target.Err())
+	}
+
+	var doneErr *gcdmessage.ChromeDoneErr
+	if !errors.As(target.Err(), &doneErr) {
+		t.Fatalf("expected Err to match ChromeDoneErr with errors.As got %v\n", target.Err())
+	}
+
+	if err := <-closedCh; err != target.Err() {
+		t.Fatalf("expected OnClose to get Err got %v\n", err)
+	}
+
+	if _, err := target.Page.Enable(testCtx, false); err != target.Err() {
+		t.Fatalf("expected calls to fail with Err got %v\n", err)
+	}
+}
+
+func TestSessionReplyBeforeDetach(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	// chrome replies and then reports the session detached, such as when the command closed it
+	srv.OnMethod("Runtime.evaluate", func(req *gcdtest.Request) (interface{}, error) {
+		req.After(func() {
+			srv.EmitTo("browser", "Target.detachedFromTarget", map[string]string{"sessionId": req.SessionId})
+		})
+		return map[string]interface{}{"result": map[string]string{"type": "undefined"}}, nil
+	})
+
+	d := NewChromeDebugger()
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting to fake server: %s\n", err)
+	}
+
+	browser, err := d.Browser()
+	if err != nil {
+		t.Fatalf("error connecting to browser: %s\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 5*time.Second)
+	defer cancel()
+
+	for i := 0; i < 30; i++ {
+		session := browser.NewSession(fmt.Sprintf("SESSION%d", i), nil)
+		if err := session.CallInto(ctx, "Runtime.evaluate", map[string]string{"expression": "window.close()"}, nil); err != nil {
+			t.Fatalf("expected the reply sent before the detach got %s\n", err)
+		}
+
+		select {
+		case <-session.Done():
+		case <-ctx.Done():
+			t.Fatalf("expected the session to be detached\n")
+		}
+
+		var detached *ErrTargetDetached
+		if !errors.As(session.Err(), &detached) || detached.Reason != fmt.Sprintf("session SESSION%d", i) {
+			t.Fatalf("expected the detached session in the reason got %v\n", session.Err())
+		}
+	}
+}
+
+func TestDiscoveryCtx(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+	srv.Protocol = map[string]interface{}{
+		"version": map[string]string{"major": "1", "minor": "3"},
+		"domains": []interface{}{
+			map[string]interface{}{
+				"domain":   "Page",
+				"commands": []interface{}{map[string]interface{}{"name": "navigate", "parameters": []interface{}{map[string]interface{}{"name": "url", "type": "string"}}}},
+			},
+		},
+	}
+
+	d := NewChromeDebugger()
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting to fake server: %s\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 5*time.Second)
+	defer cancel()
+
+	target, err := d.NewTabCtx(ctx, "http://example.com/?a=1&b=2")
+	if err != nil {
+		t.Fatalf("error opening tab: %s\n", err)
+	}
+
+	if target.Target.Url != "http://example.com/?a=1&b=2" {
+		t.Fatalf("expected tab at the url got %s\n", target.Target.Url)
+	}
+
+	targets, err := d.ListTargets(ctx)
+	if err != nil || len(targets) != 2 {
+		t.Fatalf("expected 2 targets got %d %v\n", len(targets), err)
+	}
+
+	version, err := d.Version(ctx)
+	if err != nil || version.ProtocolVersion != "1.3" {
+		t.Fatalf("error getting version: %v %#v\n", err, version)
+	}
+
+	protocol, err := d.Protocol(ctx)
+	if err != nil {
+		t.Fatalf("error getting protocol: %s\n", err)
+	}
+
+	if len(protocol.Domains) != 1 || protocol.Domains[0].Commands[0].Parameters[0].Name != "url" {
+		t.Fatalf("expected decoded protocol got %#v\n", protocol)
+	}
+
+	if err := d.CloseTabCtx(ctx, target); err != nil {
+		t.Fatalf("error closing tab: %s\n", err)
+	}
+
+	srv.Authorize = func(r *http.Request) bool { return false }
+	_, err = d.Version(ctx)
+	if statusErr, ok := err.(*GcdHTTPStatusErr); !ok || statusErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected http status error got %v\n", err)
+	}
+
+	// a browser which never responds
+	hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer hung.Close()
+
+	hungDebugger := NewChromeDebugger()
+	host, port, _ := net.SplitHostPort(strings.TrimPrefix(hung.URL, "http://"))
+	hungDebugger.host = host
+	hungDebugger.setPort(port)
+
+	shortCtx, shortCancel := context.WithTimeout(testCtx, 100*time.Millisecond)
+	defer shortCancel()
+	if _, err := hungDebugger.NewTabCtx(shortCtx, ""); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded got %v\n", err)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("process groups and /proc are only checked on linux")
+	}
+
+	d := NewChromeDebugger()
+	if err := d.ExitProcess(); err != ErrNoProcess {
+		t.Fatalf("expected ExitProcess without a process to fail got %v\n", err)
+	}
+
+	if d.PID() != 0 {
+		t.Fatalf("expected no PID got %d\n", d.PID())
+	}
+
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	// a browser which ignores Browser.close and SIGTERM, as do the children it leaves behind
+	profileDir := testRandomTempDir(t)
+	cmd := exec.Command("sh", "-c", `trap "" TERM; sleep 60 & echo $! > "$0/child"; wait`, profileDir)
+
+	d = NewChromeDebugger(WithDeleteProfileOnExit(), WithShutdownGracePeriod(100*time.Millisecond))
+	d.host = srv.Host()
+	if err := d.StartProcessCustom(cmd, profileDir, srv.Port()); err != nil {
+		t.Fatalf("error starting process: %s\n", err)
+	}
+
+	var childPid int
+	for i := 0; i < 50 && childPid == 0; i++ {
+		data, _ := ioutil.ReadFile(filepath.Join(profileDir, "child"))
+		childPid, _ = strconv.Atoi(strings.TrimSpace(string(data)))
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if childPid == 0 {
+		t.Fatalf("child process did not start\n")
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 10*time.Second)
+	defer cancel()
+
+	if err := d.Shutdown(ctx); err != nil {
+		t.Fatalf("error shutting down: %s\n", err)
+	}
+
+	closed := false
+	for _, req := range srv.Requests() {
+		closed = closed || req.Method == "Browser.close"
+	}
+
+	if !closed {
+		t.Fatalf("expected Browser.close to be sent\n")
+	}
+
+	if _, err := os.Stat(profileDir); !os.IsNotExist(err) {
+		t.Fatalf("expected profile to be removed got %v\n", err)
+	}
+
+	for i := 0; testProcessRunning(childPid); i++ {
+		if i == 100 {
+			t.Fatalf("expected child process %d to be killed with the process group\n", childPid)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if err := d.Shutdown(ctx); err != nil {
+		t.Fatalf("expected Shutdown of an exited process to succeed got %s\n", err)
+	}
+}
+
+// testProcessRunning checks /proc as killed orphans may linger as zombies
+func testProcessRunning(pid int) bool {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return false
+	}
+	fields := strings.Fields(string(data[bytes.LastIndexByte(data, ')')+1:]))
+	return len(fields) > 0 && fields[0] != "Z"
+}
+
+func TestLauncher(t *testing.T) {
+	launcher := NewAutomationLauncher()
+	launcher.WindowWidth = 1280
+	launcher.WindowHeight = 720
+	launcher.NoSandbox = true
+	launcher.Extensions = []string{"/ext/a", "/ext/b"}
+	launcher.Lang = "en-US"
+	launcher.Remove = []string{"disable-sync", "--mute-audio"}
+	launcher.Flags = []string{"--headless", "--disable-features=Translate,AutofillServerCommunication", "--lang=fr-FR"}
+
+	args := launcher.Args()
+	flags := make(map[string]string)
+	for _, arg := range args {
+		name, value, _ := strings.Cut(arg, "=")
+		if _, ok := flags[name]; ok {
+			t.Fatalf("expected %s once got %v\n", name, args)
+		}
+		flags[name] = value
+	}
+
+	for _, removed := range []string{"--disable-sync", "--mute-audio", "--disable-extensions"} {
+		if _, ok := flags[removed]; ok {
+			t.Fatalf("expected %s to be removed got %v\n", removed, args)
+		}
+	}
+
+	expected := map[string]string{
+		"--headless":          "",
+		"--window-size":       "1280,720",
+		"--no-sandbox":        "",
+		"--load-extension":    "/ext/a,/ext/b",
+		"--lang":              "fr-FR",
+		"--disable-features":  "Translate,MediaRouter,OptimizationHints,AutofillServerCommunication",
+		"--enable-automation": "",
+	}
+	for name, value := range expected {
+		if got, ok := flags[name]; !ok || got != value {
+			t.Fatalf("expected %s=%s got %v\n", name, value, args)
+		}
+	}
+
+	d := NewChromeDebugger(WithLauncher(launcher), WithFlags([]string{"--no-first-run", "about:blank", "--lang=de-DE"}))
+	args = dedupeFlags(d.flags)
+	if len(args) != len(d.flags)-2 || args[len(args)-1] != "about:blank" {
+		t.Fatalf("expected repeated flags to be removed got %v\n", args)
+	}
+}
+
+func TestPool(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	var launched int32
+	config := PoolConfig{
+		Browsers:       2,
+		TabsPerBrowser: 2,
+		MaxUses:        2,
+		Launch: func(ctx context.Context) (*Gcd, error) {
+			atomic.AddInt32(&launched, 1)
+			d := NewChromeDebugger()
+			return d, d.ConnectToInstance(srv.Host(), srv.Port())
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 10*time.Second)
+	defer cancel()
+
+	pool, err := NewPool(ctx, config)
+	if err != nil {
+		t.Fatalf("error starting pool: %s\n", err)
+	}
+
+	targets := make([]*ChromeTarget, 4)
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			targets[i], _ = pool.Acquire(ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, target := range targets {
+		if target == nil {
+			t.Fatalf("error acquiring tab %d\n", i)
+		}
+	}
+
+	if stats := pool.Stats(); stats.Browsers != 2 || stats.Tabs != 4 || stats.Leased != 4 {
+		t.Fatalf("expected 2 browsers with 4 leased tabs got %#v\n", stats)
+	}
+
+	shortCtx, shortCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer shortCancel()
+	if _, err := pool.Acquire(shortCtx); err != context.DeadlineExceeded {
+		t.Fatalf("expected a full pool to time out got %v\n", err)
+	}
+
+	if err := pool.Release(targets[0]); err != nil {
+		t.Fatalf("error releasing tab: %s\n", err)
+	}
+
+	if err := pool.Release(targets[0]); err != ErrNotLeased {
+		t.Fatalf("expected releasing twice to fail got %v\n", err)
+	}
+
+	reused, err := pool.Acquire(ctx)
+	if err != nil || reused != targets[0] {
+		t.Fatalf("expected the released tab to be reused got %v\n", err)
+	}
+
+	navigated := false
+	for _, req := range srv.Requests() {
+		navigated = navigated || req.Method == "Page.navigate"
+	}
+
+	if !navigated {
+		t.Fatalf("expected the released tab to be reset\n")
+	}
+
+	// a second release reaches MaxUses
+	pool.Release(reused)
+	replacement, err := pool.Acquire(ctx)
+	if err != nil || replacement == reused {
+		t.Fatalf("expected a new tab after MaxUses got %v\n", err)
+	}
+	targets[0] = replacement
+
+	// both browsers go away and are replaced
+	srv.Disconnect("browser")
+	for pool.Stats().Replaced != 2 {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for browsers to be replaced: %#v\n", pool.Stats())
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+
+	if n := atomic.LoadInt32(&launched); n != 4 {
+		t.Fatalf("expected 4 browsers to be launched got %d\n", n)
+	}
+
+	for _, target := range targets {
+		pool.Release(target)
+	}
+
+	// released tabs are recycled in the background
+	for pool.Stats().Recycled != 5 {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for tabs to be recycled: %#v\n", pool.Stats())
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+
+	target, err := pool.Acquire(ctx)
+	if err != nil {
+		t.Fatalf("error acquiring tab from replaced browser: %s\n", err)
+	}
+
+	if stats := pool.Stats(); stats.Leased != 1 || stats.Tabs != 1 || stats.Browsers != 2 {
+		t.Fatalf("expected a tab of a replaced browser got %#v\n", stats)
+	}
+
+	if err := pool.Close(ctx); err != nil {
+		t.Fatalf("error closing pool: %s\n", err)
+	}
+
+	if _, err := pool.Acquire(ctx); err != ErrPoolClosed {
+		t.Fatalf("expected Acquire to fail once closed got %v\n", err)
+	}
+	pool.Release(target)
+}
+
+func TestPoolUnhealthyTab(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	config := PoolConfig{
+		Launch: func(ctx context.Context) (*Gcd, error) {
+			d := NewChromeDebugger()
+			return d, d.ConnectToInstance(srv.Host(), srv.Port())
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 10*time.Second)
+	defer cancel()
+
+	pool, err := NewPool(ctx, config)
+	if err != nil {
+		t.Fatalf("error starting pool: %s\n", err)
+	}
+	defer pool.Close(ctx)
+
+	for i, event := range []string{"Inspector.targetCrashed", "Inspector.detached"} {
+		target, err := pool.Acquire(ctx)
+		if err != nil {
+			t.Fatalf("error acquiring tab: %s\n", err)
+		}
+
+		inspecting := false
+		for _, req := range srv.Requests() {
+			inspecting = inspecting || (req.Method == "Inspector.enable" && req.TargetId == target.Target.Id)
+		}
+
+		if !inspecting {
+			t.Fatalf("expected Inspector to be enabled for the tab\n")
+		}
+
+		detachedCh := make(chan struct{}, 2)
+		target.OnCrash(func() { detachedCh <- struct{}{} })
+		target.OnDetach(func(reason string) { detachedCh <- struct{}{} })
+		srv.EmitTo(target.Target.Id, event, map[string]string{"reason": "render_process_gone"})
+
+		select {
+		case <-detachedCh:
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for %s\n", event)
+		}
+
+		pool.Release(target)
+		for pool.Stats().Recycled != uint64(i+1) {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("timed out waiting for the tab to be recycled after %s: %#v\n", event, pool.Stats())
+			case <-time.After(20 * time.Millisecond):
+			}
+		}
+
+		replacement, err := pool.Acquire(ctx)
+		if err != nil || replacement == target {
+			t.Fatalf("expected a new tab after %s got %v\n", event, err)
+		}
+		pool.Release(replacement)
+	}
+}
+
+func TestStartProcessCtx(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("/proc is only checked on linux")
+	}
+
+	// a browser which never opens its debugger port
+	profileDir := testRandomTempDir(t)
+	exePath := filepath.Join(profileDir, "chrome")
+	if err := ioutil.WriteFile(exePath, []byte("#!/bin/sh\nexec sleep 60\n"), 0755); err != nil {
+		t.Fatalf("error writing fake chrome: %s\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 200*time.Millisecond)
+	defer cancel()
+
+	d := NewChromeDebugger(WithDebugPortTimeout(10 * time.Second))
+	start := time.Now()
+	if err := d.StartProcessCtx(ctx, exePath, profileDir, testRandomPort(t)); err != context.DeadlineExceeded {
+		t.Fatalf("expected StartProcessCtx to give up with ctx got %v\n", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected StartProcessCtx to return once ctx was done, took %s\n", elapsed)
+	}
+
+	pid := d.PID()
+	for i := 0; i < 50 && testProcessRunning(pid); i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if testProcessRunning(pid) {
+		t.Fatalf("expected the process to be killed once ctx was done\n")
+	}
+}
+
+func TestStartProcessPipeCtx(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("/proc is only checked on linux")
+	}
+
+	// a browser which never answers over the pipe
+	profileDir := testRandomTempDir(t)
+	exePath := filepath.Join(profileDir, "chrome")
+	if err := ioutil.WriteFile(exePath, []byte("#!/bin/sh\nexec sleep 60\n"), 0755); err != nil {
+		t.Fatalf("error writing fake chrome: %s\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 200*time.Millisecond)
+	defer cancel()
+
+	d := NewChromeDebugger(WithDebugPortTimeout(10 * time.Second))
+	start := time.Now()
+	if err := d.StartProcessPipeCtx(ctx, exePath, profileDir); err == nil {
+		t.Fatalf("expected StartProcessPipeCtx to give up with ctx\n")
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected StartProcessPipeCtx to return once ctx was done, took %s\n", elapsed)
+	}
+
+	if d.pipe || d.browserTarget != nil {
+		t.Fatalf("expected the pipe browser target to be reset\n")
+	}
+
+	pid := d.PID()
+	for i := 0; i < 50 && testProcessRunning(pid); i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if testProcessRunning(pid) {
+		t.Fatalf("expected the process to be killed once the pipe probe failed\n")
+	}
+}
+
+func TestBrowserContext(t *testing.T) {
+	srv := gcdtest.NewServer()
+	defer srv.Close()
+
+	var contextTargetId string
+	srv.OnMethod("Target.createBrowserContext", func(req *gcdtest.Request) (interface{}, error) {
+		return map[string]string{"browserContextId": "CONTEXT1"}, nil
+	})
+
+	srv.OnMethod("Target.createTarget", func(req *gcdtest.Request) (interface{}, error) {
+		params := &gcdapi.TargetCreateTargetParams{}
+		req.Decode(params)
+		if params.BrowserContextId != "CONTEXT1" {
+			return nil, &gcdtest.Error{Code: -32602, Message: "unknown browser context"}
+		}
+		contextTargetId = srv.AddTarget(params.Url).Id
+		return map[string]string{"targetId": contextTargetId}, nil
+	})
+
+	srv.OnMethod("Target.getTargets", func(req *gcdtest.Request) (interface{}, error) {
+		return map[string]interface{}{"targetInfos": []map[string]interface{}{
+			{"targetId": "DEFAULT", "type": "page", "browserContextId": "DEFAULTCONTEXT"},
+			{"targetId": contextTargetId, "type": "page", "browserContextId": "CONTEXT1"},
+		}}, nil
+	})
+
+	srv.OnMethod("Target.getTargetInfo", func(req *gcdtest.Request) (interface{}, error) {
+		params := &gcdapi.TargetGetTargetInfoParams{}
+		req.Decode(params)
+		if params.TargetId != contextTargetId {
+			return nil, &gcdtest.Error{Code: -32602, Message: "no target with given id found"}
+		}
+		return map[string]interface{}{"targetInfo": map[string]interface{}{"targetId": contextTargetId, "type": "page", "url": "http://example.com/", "browserContextId": "CONTEXT1"}}, nil
+	})
+
+	var failAttach int32
+	srv.OnMethod("Target.attachToTarget", func(req *gcdtest.Request) (interface{}, error) {
+		if atomic.LoadInt32(&failAttach) == 1 {
+			return nil, &gcdtest.Error{Code: -32000, Message: "unable to attach"}
+		}
+		return map[string]string{"sessionId": "SESSION1"}, nil
+	})
+
+	srv.OnMethod("Storage.getCookies", func(req *gcdtest.Request) (interface{}, error) {
+		params := &storageCookiesParams{}
+		req.Decode(params)
+		if params.BrowserContextId != "CONTEXT1" {
+			return map[string]interface{}{"cookies": []interface{}{}}, nil
+		}
+		return map[string]interface{}{"cookies": []map[string]interface{}{{"name": "session", "value": "1"}}}, nil
+	})
+
+	d := NewChromeDebugger()
+	if err := d.ConnectToInstance(srv.Host(), srv.Port()); err != nil {
+		t.Fatalf("error connecting to fake server: %s\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(testCtx, 5*time.Second)
+	defer cancel()
+
+	browserContext, err := d.NewBrowserContext(ctx, BrowserContextOptions{ProxyServer: "socks5://localhost:1080"})
+	if err != nil {
+		t.Fatalf("error creating browser context: %s\n", err)
+	}
+
+	tab, err := browserContext.NewTab(ctx, "http://example.com/")
+	if err != nil {
+		t.Fatalf("error opening tab in browser context: %s\n", err)
+	}
+
+	if tab.Target.Id != contextTargetId || tab.Target.Url != "http://example.com/" {
+		t.Fatalf("expected the created target got %#v\n", tab.Target)
+	}
+
+	if err := tab.CallInto(ctx, "Page.enable", nil, nil); err != nil {
+		t.Fatalf("error calling the tab's session: %s\n", err)
+	}
+
+	atomic.StoreInt32(&failAttach, 1)
+	if _, err := browserContext.NewTab(ctx, "http://example.com/"); err == nil {
+		t.Fatalf("expected NewTab to fail when attaching fails\n")
+	}
+	atomic.StoreInt32(&failAttach, 0)
+
+	var closed map[string]interface{}
+	for _, req := range srv.Requests() {
+		if req.Method == "Page.enable" && req.SessionId != "SESSION1" {
+			t.Fatalf("expected the tab's calls to use its session got %q\n", req.SessionId)
+		}
+		if req.Method == "Target.closeTarget" {
+			req.Decode(&closed)
+		}
+	}
+
+	if closed == nil || closed["targetId"] != contextTargetId {
+		t.Fatalf("expected the tab which couldn't be attached to be closed got %v\n", closed)
+	}
+
+	targets, err := browserContext.Targets(ctx)
+	if err != nil || len(targets) != 1 || targets[0].TargetId != contextTargetId {
+		t.Fatalf("expected only the browser context's target got %v\n", err)
+	}
+
+	cookies, err := browserContext.Cookies(ctx)
+	if err != nil || len(cookies) != 1 || cookies[0].Name != "session" {
+		t.Fatalf("expected the browser context's cookies got %v\n", err)
+	}
+
+	if err := browserContext.SetCookies(ctx, []*gcdapi.NetworkCookieParam{{Name: "a", Value: "b", Url: "http://example.com/"}}); err != nil {
+		t.Fatalf("error setting cookies: %s\n", err)
+	}
+
+	if err := browserContext.GrantPermissions(ctx, []gcdapi.BrowserPermissionType{gcdapi.BrowserPermissionTypeGeolocation}, ""); err != nil {
+		t.Fatalf("error granting permissions: %s\n", err)
+	}
+
+	for _, method := range []string{"Target.createBrowserContext", "Storage.setCookies", "Browser.grantPermissions"} {
+		var params map[string]interface{}
+		for _, req := range srv.Requests() {
+			if req.Method == method {
+				req.Decode(&params)
+			}
+		}
+
+		if params == nil {
+			t.Fatalf("expected %s to be sent\n", method)
+		}
+
+		if method == "Target.createBrowserContext" && params["proxyServer"] != "socks5://localhost:1080" {
+			t.Fatalf("expected the browser context's proxy got %v\n", params)
+		}
+
+		if method != "Target.createBrowserContext" && params["browserContextId"] != "CONTEXT1" {
+			t.Fatalf("expected %s for the browser context got %v\n", method, params)
+		}
+	}
+
+	if err := browserContext.Dispose(ctx); err != nil {
+		t.Fatalf("error disposing browser context: %s\n", err)
+	}
+
+	if err := browserContext.Dispose(ctx); err != nil {
+		t.Fatalf("expected disposing twice to succeed got %s\n", err)
+	}
+
+	if _, err := browserContext.NewTab(ctx, ""); err != ErrBrowserContextDisposed {
+		t.Fatalf("expected a disposed browser context to fail got %v\n", err)
+	}
+}
+
 func TestGetPages(t *testing.T) {
 	testDefaultStartup(t)
 	defer debugger.ExitProcess()
@@ -186,7 +1905,7 @@ func TestTargetCrashed(t *testing.T) {
 		t.Fatalf("error creating new tab")
 	}
 
-	tab.Inspector.Enable(testCtx)
+	tab.CallInto(testCtx, "Inspector.enable", nil, nil)
 	tab.Subscribe("Inspector.targetCrashed", targetCrashedFn)
 
 	navParams := &gcdapi.PageNavigateParams{Url: "chrome://crash", TransitionType: "typed"}
@@ -228,7 +1947,7 @@ func TestEvents(t *testing.T) {
 	}
 
 	navParams := &gcdapi.PageNavigateParams{Url: testServerAddr + "console_log.html", TransitionType: "typed"}
-	if _, _, _, err := target.Page.NavigateWithParams(testCtx, navParams); err != nil {
+	if _, _, _, _, err := target.Page.NavigateWithParams(testCtx, navParams); err != nil {
 		t.Fatalf("error attempting to navigate: %s\n", err)
 	}
 
@@ -240,6 +1959,151 @@ func TestEvents(t *testing.T) {
 	}
 }
 
+func TestTypedEvents(t *testing.T) {
+	testDefaultStartup(t)
+	defer debugger.ExitProcess()
+
+	target, err := debugger.NewTab()
+	if err != nil {
+		t.Fatalf("error getting new tab: %s\n", err)
+	}
+
+	doneCh := make(chan error, 1)
+	var unsubscribe func()
+	unsubscribe = target.Console.OnMessageAdded(func(msg *gcdapi.ConsoleMessageAddedEvent) {
+		unsubscribe()
+		t.Logf("Got event: %v\n", msg.Params.Message)
+		close(doneCh)
+	})
+
+	if _, err := target.Console.Enable(testCtx); err != nil {
+		t.Fatalf("error sending enable: %s\n", err)
+	}
+
+	navParams := &gcdapi.PageNavigateParams{Url: testServerAddr + "console_log.html", TransitionType: "typed"}
+	if _, _, _, _, err := target.Page.NavigateWithParams(testCtx, navParams); err != nil {
+		t.Fatalf("error attempting to navigate: %s\n", err)
+	}
+
+	go testTimeoutListener(doneCh, 5, "console message")
+
+	if err := <-doneCh; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testTargeter implements gcdmessage.ChromeTargeter without SubscribeEvent, like targets written
+// before the typed event helpers were added.
+type testTargeter struct{}
+
+func (testTargeter) GetId() int64                        { return 0 }
+func (testTargeter) GetApiTimeout() time.Duration        { return time.Second }
+func (testTargeter) GetSendCh() chan *gcdmessage.Message { return nil }
+func (testTargeter) GetDoneCh() chan struct{}            { return nil }
+func (testTargeter) SendCustomReturn(ctx context.Context, paramRequest *gcdmessage.ParamRequest) (*gcdmessage.Message, error) {
+	return nil, nil
+}
+func (testTargeter) SendDefaultRequest(ctx context.Context, paramRequest *gcdmessage.ParamRequest) (*gcdmessage.ChromeResponse, error) {
+	return nil, nil
+}
+
+func TestTypedEventsWithoutSubscriber(t *testing.T) {
+	var target gcdmessage.ChromeTargeter = testTargeter{}
+	if _, ok := target.(gcdmessage.EventSubscriber); ok {
+		t.Fatalf("expected testTargeter not to be an EventSubscriber\n")
+	}
+
+	unsubscribe := gcdapi.NewPage(target).OnLoadEventFired(func(*gcdapi.PageLoadEventFiredEvent) {
+		t.Fatalf("expected no events without an EventSubscriber\n")
+	})
+	unsubscribe()
+}
+
+func TestMultipleSubscribers(t *testing.T) {
+	target := &ChromeTarget{eventDispatcher: make(map[string][]*eventSubscription)}
+
+	calls := make(map[string]int)
+	subscriber := func(name string) func(*ChromeTarget, []byte) {
+		return func(*ChromeTarget, []byte) {
+			calls[name]++
+		}
+	}
+
+	cancelFirst := target.Subscribe("Network.responseReceived", subscriber("first"))
+	target.Subscribe("Network.responseReceived", subscriber("second"))
+	target.Subscribe("Network.*", subscriber("domain"))
+	target.Subscribe("*", subscriber("all"))
+
+	dispatch := func(method string) {
+		for _, sub := range target.subscribers(method) {
+			sub.callback(target, nil)
+		}
+	}
+
+	dispatch("Network.responseReceived")
+	dispatch("Network.requestWillBeSent")
+	dispatch("Page.loadEventFired")
+
+	expected := map[string]int{"first": 1, "second": 1, "domain": 2, "all": 3}
+	for name, count := range expected {
+		if calls[name] != count {
+			t.Fatalf("expected %s to be called %d times, got %d\n", name, count, calls[name])
+		}
+	}
+
+	cancelFirst()
+	if subs := target.subscribers("Network.responseReceived"); len(subs) != 3 {
+		t.Fatalf("expected 3 subscribers after cancel, got %d\n", len(subs))
+	}
+
+	target.Unsubscribe("Network.responseReceived")
+	target.Unsubscribe("*")
+	if subs := target.subscribers("Network.responseReceived"); len(subs) != 1 {
+		t.Fatalf("expected only the domain subscriber to remain, got %d\n", len(subs))
+	}
+}
+
+func TestProtocolRegistry(t *testing.T) {
+	cmd, ok := gcdapi.LookupCommand("Page.navigate")
+	if !ok {
+		t.Fatalf("Page.navigate was not found in the registry\n")
+	}
+
+	if url := cmd.Param("url"); url == nil || url.Optional || url.GoType.Kind().String() != "string" {
+		t.Fatalf("expected a required string url param, got %#v\n", url)
+	}
+
+	if err := cmd.Validate(&gcdapi.PageNavigateParams{Url: "http://example.com"}); err != nil {
+		t.Fatalf("error validating params: %s\n", err)
+	}
+
+	if err := cmd.Validate(map[string]interface{}{"referrer": "http://example.com"}); err == nil {
+		t.Fatalf("expected error validating params without url\n")
+	}
+
+	if err := cmd.Validate([]byte(`{"url": 1}`)); err == nil {
+		t.Fatalf("expected error validating url of wrong type\n")
+	}
+
+	if err := cmd.Validate([]byte(`{"url": "http://example.com", "bogus": true}`)); err == nil {
+		t.Fatalf("expected error validating unknown param\n")
+	}
+
+	event, err := gcdapi.DecodeEvent([]byte(`{"method": "Page.loadEventFired", "params": {"timestamp": 1.5}}`))
+	if err != nil {
+		t.Fatalf("error decoding event: %s\n", err)
+	}
+
+	loadEvent, ok := event.(*gcdapi.PageLoadEventFiredEvent)
+	if !ok || loadEvent.Params.Timestamp != 1.5 {
+		t.Fatalf("expected decoded PageLoadEventFiredEvent, got %#v\n", event)
+	}
+
+	if _, err := gcdapi.DecodeEvent([]byte(`{"method": "Bogus.event"}`)); err == nil {
+		t.Fatalf("expected error decoding unknown event\n")
+	}
+}
+
 func TestEvaluate(t *testing.T) {
 	testDefaultStartup(t)
 	defer debugger.ExitProcess()
@@ -301,7 +2165,7 @@ func TestSimpleReturn(t *testing.T) {
 		t.Fatalf("error getting new tab: %s\n", err)
 	}
 	network := target.Network
-	if _, err := network.Enable(testCtx, -1, -1, -1); err != nil {
+	if _, err := network.Enable(testCtx, -1, -1, -1, false); err != nil {
 		t.Fatalf("error enabling network")
 	}
 	ret, err = network.CanClearBrowserCache(testCtx)
@@ -313,7 +2177,7 @@ func TestSimpleReturn(t *testing.T) {
 	}
 }
 
-func TestSimpleReturnReturnsGoError(t *testing.T) {
+func TestCallInto(t *testing.T) {
 	testDefaultStartup(t)
 	defer debugger.ExitProcess()
 
@@ -322,9 +2186,31 @@ func TestSimpleReturnReturnsGoError(t *testing.T) {
 		t.Fatalf("error getting new tab: %s\n", err)
 	}
 
-	css := target.CSS
-	if _, err := css.Enable(testCtx); err == nil {
-		t.Fatalf("expected a go error since css.Enable requires DOM.Enable first.")
+	var canClear struct {
+		Result bool `json:"result"`
+	}
+	if err := target.CallInto(testCtx, "Network.canClearBrowserCache", nil, &canClear); err != nil {
+		t.Fatalf("error calling Network.canClearBrowserCache: %s\n", err)
+	}
+
+	if !canClear.Result {
+		t.Fatalf("we should have got true for can clear browser cache\n")
+	}
+
+	evaluated, err := Call[struct {
+		Result *gcdapi.RuntimeRemoteObject `json:"result"`
+	}](testCtx, target, "Runtime.evaluate", map[string]interface{}{"expression": "1 + 1", "returnByValue": true})
+	if err != nil {
+		t.Fatalf("error calling Runtime.evaluate: %s\n", err)
+	}
+
+	if evaluated.Result == nil || fmt.Sprintf("%v", evaluated.Result.Value) != "2" {
+		t.Fatalf("expected 2 from evaluate, got %#v\n", evaluated.Result)
+	}
+
+	err = target.CallInto(testCtx, "Bogus.method", nil, nil)
+	if _, ok := err.(*gcdmessage.ChromeRequestErr); !ok {
+		t.Fatalf("expected a ChromeRequestErr calling an unknown method, got %v\n", err)
 	}
 }
 
@@ -338,7 +2224,7 @@ func TestDOMEnableWithWhiteSpace(t *testing.T) {
 	}
 
 	page := target.Page
-	if _, err := page.Enable(ctx); err != nil {
+	if _, err := page.Enable(ctx, false); err != nil {
 		t.Fatalf("failed to enable page: %s\n", err)
 	}
 
@@ -362,7 +2248,7 @@ func TestDOMEnableWithWhiteSpace(t *testing.T) {
 	})
 
 	navParams := &gcdapi.PageNavigateParams{Url: testServerAddr + "cookie.html", TransitionType: "typed"}
-	_, _, _, err = target.Page.NavigateWithParams(testCtx, navParams)
+	_, _, _, _, err = target.Page.NavigateWithParams(testCtx, navParams)
 	if err != nil {
 		t.Fatalf("failed to load test page: %s\n", err)
 	}
@@ -381,7 +2267,7 @@ func TestCtxCancel(t *testing.T) {
 	}
 
 	network := target.Network
-	if _, err := network.Enable(testCtx, -1, -1, -1); err != nil {
+	if _, err := network.Enable(testCtx, -1, -1, -1, false); err != nil {
 		t.Fatalf("error enabling network")
 	}
 	ctx, cancel := context.WithCancel(testCtx)
@@ -435,11 +2321,11 @@ func TestComplexReturn(t *testing.T) {
 	if err != nil {
 		t.Fatalf("error getting new tab: %s\n", err)
 	}
-	if _, err := target.Network.Enable(testCtx, -1, -1, -1); err != nil {
+	if _, err := target.Network.Enable(testCtx, -1, -1, -1, false); err != nil {
 		t.Fatalf("error enabling network %s\n", err)
 	}
 
-	if _, err := target.Page.Enable(testCtx); err != nil {
+	if _, err := target.Page.Enable(testCtx, false); err != nil {
 		t.Fatalf("error enabling page: %s\n", err)
 	}
 
@@ -464,7 +2350,7 @@ func TestComplexReturn(t *testing.T) {
 	})
 
 	navParams := &gcdapi.PageNavigateParams{Url: testServerAddr + "cookie.html", TransitionType: "typed"}
-	_, _, _, err = target.Page.NavigateWithParams(testCtx, navParams)
+	_, _, _, _, err = target.Page.NavigateWithParams(testCtx, navParams)
 	if err != nil {
 		t.Fatalf("error navigating to cookie page: %s\n", err)
 	}
@@ -527,7 +2413,7 @@ func TestLocalExtension(t *testing.T) {
 		t.Fatalf("error creating new tab")
 	}
 
-	if _, err := target.Page.Enable(testCtx); err != nil {
+	if _, err := target.Page.Enable(testCtx, false); err != nil {
 		t.Fatalf("error enabling page: %s\n", err)
 	}
 
@@ -536,12 +2422,12 @@ func TestLocalExtension(t *testing.T) {
 		close(doneCh)
 	})
 
-	if _, err := target.Network.Enable(testCtx, -1, -1, -1); err != nil {
+	if _, err := target.Network.Enable(testCtx, -1, -1, -1, false); err != nil {
 		t.Fatalf("error enabling network: %s\n", err)
 	}
 
 	params := &gcdapi.PageNavigateParams{Url: "http://www.google.com"}
-	_, _, _, err = target.Page.NavigateWithParams(testCtx, params)
+	_, _, _, _, err = target.Page.NavigateWithParams(testCtx, params)
 	if err != nil {
 		t.Fatalf("error navigating: %s\n", err)
 	}
@@ -565,7 +2451,7 @@ func TestContextCancel(t *testing.T) {
 		t.Fatalf("error getting first tab")
 	}
 
-	if _, err := target.Page.Enable(testCtx); err != nil {
+	if _, err := target.Page.Enable(testCtx, false); err != nil {
 		t.Fatalf("error enabling page: %s\n", err)
 	}
 
@@ -628,7 +2514,7 @@ func TestNetworkIntercept(t *testing.T) {
 	})
 
 	params := &gcdapi.PageNavigateParams{Url: "http://www.example.com"}
-	_, _, _, err = target.Page.NavigateWithParams(testCtx, params)
+	_, _, _, _, err = target.Page.NavigateWithParams(testCtx, params)
 	if err != nil {
 		t.Fatalf("error navigating: %s\n", err)
 	}
@@ -651,6 +2537,82 @@ func TestCloseTab(t *testing.T) {
 	}
 }
 
+func TestBrowser(t *testing.T) {
+	testDefaultStartup(t)
+	defer debugger.ExitProcess()
+
+	version, err := debugger.GetVersion()
+	if err != nil {
+		t.Fatalf("error getting version: %s\n", err)
+	}
+
+	if version.WebSocketDebuggerUrl == "" || version.ProtocolVersion == "" {
+		t.Fatalf("invalid version info returned: %#v\n", version)
+	}
+
+	browser, err := debugger.Browser()
+	if err != nil {
+		t.Fatalf("error connecting to browser: %s\n", err)
+	}
+
+	_, product, _, _, _, err := browser.Browser.GetVersion(testCtx)
+	if err != nil {
+		t.Fatalf("error calling Browser.getVersion: %s\n", err)
+	}
+
+	if product != version.Browser {
+		t.Fatalf("expected product %s got %s\n", version.Browser, product)
+	}
+}
+
+func TestAttachToTarget(t *testing.T) {
+	testDefaultStartup(t)
+	defer debugger.ExitProcess()
+
+	browser, err := debugger.Browser()
+	if err != nil {
+		t.Fatalf("error connecting to browser: %s\n", err)
+	}
+
+	targetId, err := browser.TargetApi.CreateTargetWithParams(testCtx, &gcdapi.TargetCreateTargetParams{Url: "about:blank"})
+	if err != nil {
+		t.Fatalf("error creating target: %s\n", err)
+	}
+
+	session, err := browser.AttachToTarget(testCtx, targetId)
+	if err != nil {
+		t.Fatalf("error attaching to target: %s\n", err)
+	}
+
+	if session.SessionId() == "" {
+		t.Fatalf("expected session id to be set")
+	}
+
+	doneCh := make(chan error, 1)
+	go testTimeoutListener(doneCh, 10, "waiting for session page load")
+
+	session.Subscribe("Page.loadEventFired", func(target *ChromeTarget, payload []byte) {
+		close(doneCh)
+	})
+
+	if _, err := session.Page.Enable(testCtx, false); err != nil {
+		t.Fatalf("error enabling page over session: %s\n", err)
+	}
+
+	navParams := &gcdapi.PageNavigateParams{Url: testServerAddr + "cookie.html", TransitionType: "typed"}
+	if _, _, _, _, err := session.Page.NavigateWithParams(testCtx, navParams); err != nil {
+		t.Fatalf("error navigating session: %s\n", err)
+	}
+
+	if err := <-doneCh; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := session.Detach(testCtx); err != nil {
+		t.Fatalf("error detaching session: %s\n", err)
+	}
+}
+
 type testLogger struct {
 	called bool
 }
@@ -668,7 +2630,7 @@ func TestCustomLogger(t *testing.T) {
 		t.Fatalf("error getting new tab: %v\n", err)
 	}
 
-	if _, err = tab.Page.Enable(context.TODO()); err != nil {
+	if _, err = tab.Page.Enable(context.TODO(), false); err != nil {
 		t.Fatalf("error using custom logger")
 	}
 
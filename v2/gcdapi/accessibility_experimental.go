@@ -0,0 +1,384 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Accessibility functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnLoadComplete subscribes to Accessibility.loadComplete events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) OnLoadComplete(callback func(*AccessibilityLoadCompleteEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventAccessibilityLoadComplete, func(payload []byte) error {
+		event := &AccessibilityLoadCompleteEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnNodesUpdated subscribes to Accessibility.nodesUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) OnNodesUpdated(callback func(*AccessibilityNodesUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventAccessibilityNodesUpdated, func(payload []byte) error {
+		event := &AccessibilityNodesUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// Disables the accessibility domain.
+func (c *Accessibility) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Accessibility.disable"})
+}
+
+// Enables the accessibility domain which causes `AXNodeId`s to remain consistent between method calls. This turns on accessibility for the page, which can impact performance until accessibility is disabled.
+func (c *Accessibility) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Accessibility.enable"})
+}
+
+type AccessibilityGetPartialAXTreeParams struct {
+	// Identifier of the node to get the partial accessibility tree for.
+	NodeId int `json:"nodeId,omitempty"`
+	// Identifier of the backend node to get the partial accessibility tree for.
+	BackendNodeId int `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper to get the partial accessibility tree for.
+	ObjectId string `json:"objectId,omitempty"`
+	// Whether to fetch this node's ancestors, siblings and children. Defaults to true.
+	FetchRelatives bool `json:"fetchRelatives,omitempty"`
+}
+
+// GetPartialAXTreeWithParams - Fetches the accessibility node and partial accessibility tree for this DOM node, if it exists.
+// Returns -  nodes - The `Accessibility.AXNode` for this DOM node, if it exists, plus its ancestors, siblings and children, if requested.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetPartialAXTreeWithParams(ctx context.Context, v *AccessibilityGetPartialAXTreeParams) ([]*AccessibilityAXNode, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Accessibility.getPartialAXTree", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Nodes []*AccessibilityAXNode
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Nodes, nil
+}
+
+// GetPartialAXTree - Fetches the accessibility node and partial accessibility tree for this DOM node, if it exists.
+// nodeId - Identifier of the node to get the partial accessibility tree for.
+// backendNodeId - Identifier of the backend node to get the partial accessibility tree for.
+// objectId - JavaScript object id of the node wrapper to get the partial accessibility tree for.
+// fetchRelatives - Whether to fetch this node's ancestors, siblings and children. Defaults to true.
+// Returns -  nodes - The `Accessibility.AXNode` for this DOM node, if it exists, plus its ancestors, siblings and children, if requested.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetPartialAXTree(ctx context.Context, nodeId int, backendNodeId int, objectId string, fetchRelatives bool) ([]*AccessibilityAXNode, error) {
+	var v AccessibilityGetPartialAXTreeParams
+	v.NodeId = nodeId
+	v.BackendNodeId = backendNodeId
+	v.ObjectId = objectId
+	v.FetchRelatives = fetchRelatives
+	return c.GetPartialAXTreeWithParams(ctx, &v)
+}
+
+type AccessibilityGetFullAXTreeParams struct {
+	// The maximum depth at which descendants of the root node should be retrieved. If omitted, the full tree is returned.
+	Depth int `json:"depth,omitempty"`
+	// The frame for whose document the AX tree should be retrieved. If omitted, the root frame is used.
+	FrameId string `json:"frameId,omitempty"`
+}
+
+// GetFullAXTreeWithParams - Fetches the entire accessibility tree for the root Document
+// Returns -  nodes -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetFullAXTreeWithParams(ctx context.Context, v *AccessibilityGetFullAXTreeParams) ([]*AccessibilityAXNode, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Accessibility.getFullAXTree", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Nodes []*AccessibilityAXNode
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Nodes, nil
+}
+
+// GetFullAXTree - Fetches the entire accessibility tree for the root Document
+// depth - The maximum depth at which descendants of the root node should be retrieved. If omitted, the full tree is returned.
+// frameId - The frame for whose document the AX tree should be retrieved. If omitted, the root frame is used.
+// Returns -  nodes -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetFullAXTree(ctx context.Context, depth int, frameId string) ([]*AccessibilityAXNode, error) {
+	var v AccessibilityGetFullAXTreeParams
+	v.Depth = depth
+	v.FrameId = frameId
+	return c.GetFullAXTreeWithParams(ctx, &v)
+}
+
+type AccessibilityGetRootAXNodeParams struct {
+	// The frame in whose document the node resides. If omitted, the root frame is used.
+	FrameId string `json:"frameId,omitempty"`
+}
+
+// GetRootAXNodeWithParams - Fetches the root node. Requires `enable()` to have been called previously.
+// Returns -  node -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetRootAXNodeWithParams(ctx context.Context, v *AccessibilityGetRootAXNodeParams) (*AccessibilityAXNode, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Accessibility.getRootAXNode", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Node *AccessibilityAXNode
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Node, nil
+}
+
+// GetRootAXNode - Fetches the root node. Requires `enable()` to have been called previously.
+// frameId - The frame in whose document the node resides. If omitted, the root frame is used.
+// Returns -  node -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetRootAXNode(ctx context.Context, frameId string) (*AccessibilityAXNode, error) {
+	var v AccessibilityGetRootAXNodeParams
+	v.FrameId = frameId
+	return c.GetRootAXNodeWithParams(ctx, &v)
+}
+
+type AccessibilityGetAXNodeAndAncestorsParams struct {
+	// Identifier of the node to get.
+	NodeId int `json:"nodeId,omitempty"`
+	// Identifier of the backend node to get.
+	BackendNodeId int `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper to get.
+	ObjectId string `json:"objectId,omitempty"`
+}
+
+// GetAXNodeAndAncestorsWithParams - Fetches a node and all ancestors up to and including the root. Requires `enable()` to have been called previously.
+// Returns -  nodes -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetAXNodeAndAncestorsWithParams(ctx context.Context, v *AccessibilityGetAXNodeAndAncestorsParams) ([]*AccessibilityAXNode, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Accessibility.getAXNodeAndAncestors", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Nodes []*AccessibilityAXNode
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Nodes, nil
+}
+
+// GetAXNodeAndAncestors - Fetches a node and all ancestors up to and including the root. Requires `enable()` to have been called previously.
+// nodeId - Identifier of the node to get.
+// backendNodeId - Identifier of the backend node to get.
+// objectId - JavaScript object id of the node wrapper to get.
+// Returns -  nodes -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetAXNodeAndAncestors(ctx context.Context, nodeId int, backendNodeId int, objectId string) ([]*AccessibilityAXNode, error) {
+	var v AccessibilityGetAXNodeAndAncestorsParams
+	v.NodeId = nodeId
+	v.BackendNodeId = backendNodeId
+	v.ObjectId = objectId
+	return c.GetAXNodeAndAncestorsWithParams(ctx, &v)
+}
+
+type AccessibilityGetChildAXNodesParams struct {
+	//
+	Id string `json:"id"`
+	// The frame in whose document the node resides. If omitted, the root frame is used.
+	FrameId string `json:"frameId,omitempty"`
+}
+
+// GetChildAXNodesWithParams - Fetches a particular accessibility node by AXNodeId. Requires `enable()` to have been called previously.
+// Returns -  nodes -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetChildAXNodesWithParams(ctx context.Context, v *AccessibilityGetChildAXNodesParams) ([]*AccessibilityAXNode, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Accessibility.getChildAXNodes", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Nodes []*AccessibilityAXNode
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Nodes, nil
+}
+
+// GetChildAXNodes - Fetches a particular accessibility node by AXNodeId. Requires `enable()` to have been called previously.
+// id -
+// frameId - The frame in whose document the node resides. If omitted, the root frame is used.
+// Returns -  nodes -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) GetChildAXNodes(ctx context.Context, id string, frameId string) ([]*AccessibilityAXNode, error) {
+	var v AccessibilityGetChildAXNodesParams
+	v.Id = id
+	v.FrameId = frameId
+	return c.GetChildAXNodesWithParams(ctx, &v)
+}
+
+type AccessibilityQueryAXTreeParams struct {
+	// Identifier of the node for the root to query.
+	NodeId int `json:"nodeId,omitempty"`
+	// Identifier of the backend node for the root to query.
+	BackendNodeId int `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node wrapper for the root to query.
+	ObjectId string `json:"objectId,omitempty"`
+	// Find nodes with this computed name.
+	AccessibleName string `json:"accessibleName,omitempty"`
+	// Find nodes with this computed role.
+	Role string `json:"role,omitempty"`
+}
+
+// QueryAXTreeWithParams - Query a DOM node's accessibility subtree for accessible name and role. This command computes the name and role for all nodes in the subtree, including those that are ignored for accessibility, and returns those that match the specified name and role. If no DOM node is specified, or the DOM node does not exist, the command returns an error. If neither `accessibleName` or `role` is specified, it returns all the accessibility nodes in the subtree.
+// Returns -  nodes - A list of `Accessibility.AXNode` matching the specified attributes, including nodes that are ignored for accessibility.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) QueryAXTreeWithParams(ctx context.Context, v *AccessibilityQueryAXTreeParams) ([]*AccessibilityAXNode, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Accessibility.queryAXTree", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Nodes []*AccessibilityAXNode
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Nodes, nil
+}
+
+// QueryAXTree - Query a DOM node's accessibility subtree for accessible name and role. This command computes the name and role for all nodes in the subtree, including those that are ignored for accessibility, and returns those that match the specified name and role. If no DOM node is specified, or the DOM node does not exist, the command returns an error. If neither `accessibleName` or `role` is specified, it returns all the accessibility nodes in the subtree.
+// nodeId - Identifier of the node for the root to query.
+// backendNodeId - Identifier of the backend node for the root to query.
+// objectId - JavaScript object id of the node wrapper for the root to query.
+// accessibleName - Find nodes with this computed name.
+// role - Find nodes with this computed role.
+// Returns -  nodes - A list of `Accessibility.AXNode` matching the specified attributes, including nodes that are ignored for accessibility.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Accessibility) QueryAXTree(ctx context.Context, nodeId int, backendNodeId int, objectId string, accessibleName string, role string) ([]*AccessibilityAXNode, error) {
+	var v AccessibilityQueryAXTreeParams
+	v.NodeId = nodeId
+	v.BackendNodeId = backendNodeId
+	v.ObjectId = objectId
+	v.AccessibleName = accessibleName
+	v.Role = role
+	return c.QueryAXTreeWithParams(ctx, &v)
+}
@@ -0,0 +1,298 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Animation functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnAnimationCanceled subscribes to Animation.animationCanceled events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Animation) OnAnimationCanceled(callback func(*AnimationAnimationCanceledEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventAnimationAnimationCanceled, func(payload []byte) error {
+		event := &AnimationAnimationCanceledEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAnimationCreated subscribes to Animation.animationCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Animation) OnAnimationCreated(callback func(*AnimationAnimationCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventAnimationAnimationCreated, func(payload []byte) error {
+		event := &AnimationAnimationCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAnimationStarted subscribes to Animation.animationStarted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Animation) OnAnimationStarted(callback func(*AnimationAnimationStartedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventAnimationAnimationStarted, func(payload []byte) error {
+		event := &AnimationAnimationStartedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAnimationUpdated subscribes to Animation.animationUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Animation) OnAnimationUpdated(callback func(*AnimationAnimationUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventAnimationAnimationUpdated, func(payload []byte) error {
+		event := &AnimationAnimationUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// Disables animation domain notifications.
+func (c *Animation) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.disable"})
+}
+
+// Enables animation domain notifications.
+func (c *Animation) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.enable"})
+}
+
+type AnimationGetCurrentTimeParams struct {
+	// Id of animation.
+	Id string `json:"id"`
+}
+
+// GetCurrentTimeWithParams - Returns the current time of the an animation.
+// Returns -  currentTime - Current time of the page.
+func (c *Animation) GetCurrentTimeWithParams(ctx context.Context, v *AnimationGetCurrentTimeParams) (float64, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.getCurrentTime", Params: v})
+	if err != nil {
+		return 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			CurrentTime float64
+		}
+	}
+
+	if resp == nil {
+		return 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return 0, err
+	}
+
+	if chromeData.Error != nil {
+		return 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.CurrentTime, nil
+}
+
+// GetCurrentTime - Returns the current time of the an animation.
+// id - Id of animation.
+// Returns -  currentTime - Current time of the page.
+func (c *Animation) GetCurrentTime(ctx context.Context, id string) (float64, error) {
+	var v AnimationGetCurrentTimeParams
+	v.Id = id
+	return c.GetCurrentTimeWithParams(ctx, &v)
+}
+
+// GetPlaybackRate - Gets the playback rate of the document timeline.
+// Returns -  playbackRate - Playback rate for animations on page.
+func (c *Animation) GetPlaybackRate(ctx context.Context) (float64, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.getPlaybackRate"})
+	if err != nil {
+		return 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			PlaybackRate float64
+		}
+	}
+
+	if resp == nil {
+		return 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return 0, err
+	}
+
+	if chromeData.Error != nil {
+		return 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.PlaybackRate, nil
+}
+
+type AnimationReleaseAnimationsParams struct {
+	// List of animation ids to seek.
+	Animations []string `json:"animations"`
+}
+
+// ReleaseAnimationsWithParams - Releases a set of animations to no longer be manipulated.
+func (c *Animation) ReleaseAnimationsWithParams(ctx context.Context, v *AnimationReleaseAnimationsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.releaseAnimations", Params: v})
+}
+
+// ReleaseAnimations - Releases a set of animations to no longer be manipulated.
+// animations - List of animation ids to seek.
+func (c *Animation) ReleaseAnimations(ctx context.Context, animations []string) (*gcdmessage.ChromeResponse, error) {
+	var v AnimationReleaseAnimationsParams
+	v.Animations = animations
+	return c.ReleaseAnimationsWithParams(ctx, &v)
+}
+
+type AnimationResolveAnimationParams struct {
+	// Animation id.
+	AnimationId string `json:"animationId"`
+}
+
+// ResolveAnimationWithParams - Gets the remote object of the Animation.
+// Returns -  remoteObject - Corresponding remote object.
+func (c *Animation) ResolveAnimationWithParams(ctx context.Context, v *AnimationResolveAnimationParams) (*RuntimeRemoteObject, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.resolveAnimation", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			RemoteObject *RuntimeRemoteObject
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.RemoteObject, nil
+}
+
+// ResolveAnimation - Gets the remote object of the Animation.
+// animationId - Animation id.
+// Returns -  remoteObject - Corresponding remote object.
+func (c *Animation) ResolveAnimation(ctx context.Context, animationId string) (*RuntimeRemoteObject, error) {
+	var v AnimationResolveAnimationParams
+	v.AnimationId = animationId
+	return c.ResolveAnimationWithParams(ctx, &v)
+}
+
+type AnimationSeekAnimationsParams struct {
+	// List of animation ids to seek.
+	Animations []string `json:"animations"`
+	// Set the current time of each animation.
+	CurrentTime float64 `json:"currentTime"`
+}
+
+// SeekAnimationsWithParams - Seek a set of animations to a particular time within each animation.
+func (c *Animation) SeekAnimationsWithParams(ctx context.Context, v *AnimationSeekAnimationsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.seekAnimations", Params: v})
+}
+
+// SeekAnimations - Seek a set of animations to a particular time within each animation.
+// animations - List of animation ids to seek.
+// currentTime - Set the current time of each animation.
+func (c *Animation) SeekAnimations(ctx context.Context, animations []string, currentTime float64) (*gcdmessage.ChromeResponse, error) {
+	var v AnimationSeekAnimationsParams
+	v.Animations = animations
+	v.CurrentTime = currentTime
+	return c.SeekAnimationsWithParams(ctx, &v)
+}
+
+type AnimationSetPausedParams struct {
+	// Animations to set the pause state of.
+	Animations []string `json:"animations"`
+	// Paused state to set to.
+	Paused bool `json:"paused"`
+}
+
+// SetPausedWithParams - Sets the paused state of a set of animations.
+func (c *Animation) SetPausedWithParams(ctx context.Context, v *AnimationSetPausedParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.setPaused", Params: v})
+}
+
+// SetPaused - Sets the paused state of a set of animations.
+// animations - Animations to set the pause state of.
+// paused - Paused state to set to.
+func (c *Animation) SetPaused(ctx context.Context, animations []string, paused bool) (*gcdmessage.ChromeResponse, error) {
+	var v AnimationSetPausedParams
+	v.Animations = animations
+	v.Paused = paused
+	return c.SetPausedWithParams(ctx, &v)
+}
+
+type AnimationSetPlaybackRateParams struct {
+	// Playback rate for animations on page
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// SetPlaybackRateWithParams - Sets the playback rate of the document timeline.
+func (c *Animation) SetPlaybackRateWithParams(ctx context.Context, v *AnimationSetPlaybackRateParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.setPlaybackRate", Params: v})
+}
+
+// SetPlaybackRate - Sets the playback rate of the document timeline.
+// playbackRate - Playback rate for animations on page
+func (c *Animation) SetPlaybackRate(ctx context.Context, playbackRate float64) (*gcdmessage.ChromeResponse, error) {
+	var v AnimationSetPlaybackRateParams
+	v.PlaybackRate = playbackRate
+	return c.SetPlaybackRateWithParams(ctx, &v)
+}
+
+type AnimationSetTimingParams struct {
+	// Animation id.
+	AnimationId string `json:"animationId"`
+	// Duration of the animation.
+	Duration float64 `json:"duration"`
+	// Delay of the animation.
+	Delay float64 `json:"delay"`
+}
+
+// SetTimingWithParams - Sets the timing of an animation node.
+func (c *Animation) SetTimingWithParams(ctx context.Context, v *AnimationSetTimingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Animation.setTiming", Params: v})
+}
+
+// SetTiming - Sets the timing of an animation node.
+// animationId - Animation id.
+// duration - Duration of the animation.
+// delay - Delay of the animation.
+func (c *Animation) SetTiming(ctx context.Context, animationId string, duration float64, delay float64) (*gcdmessage.ChromeResponse, error) {
+	var v AnimationSetTimingParams
+	v.AnimationId = animationId
+	v.Duration = duration
+	v.Delay = delay
+	return c.SetTimingWithParams(ctx, &v)
+}
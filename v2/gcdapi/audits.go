@@ -5,10 +5,909 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// No Description.
+type AuditsCookieExclusionReason string
+
+const (
+	AuditsCookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax        AuditsCookieExclusionReason = "ExcludeSameSiteUnspecifiedTreatedAsLax"
+	AuditsCookieExclusionReasonExcludeSameSiteNoneInsecure                   AuditsCookieExclusionReason = "ExcludeSameSiteNoneInsecure"
+	AuditsCookieExclusionReasonExcludeSameSiteLax                            AuditsCookieExclusionReason = "ExcludeSameSiteLax"
+	AuditsCookieExclusionReasonExcludeSameSiteStrict                         AuditsCookieExclusionReason = "ExcludeSameSiteStrict"
+	AuditsCookieExclusionReasonExcludeInvalidSameParty                       AuditsCookieExclusionReason = "ExcludeInvalidSameParty"
+	AuditsCookieExclusionReasonExcludeSamePartyCrossPartyContext             AuditsCookieExclusionReason = "ExcludeSamePartyCrossPartyContext"
+	AuditsCookieExclusionReasonExcludeDomainNonASCII                         AuditsCookieExclusionReason = "ExcludeDomainNonASCII"
+	AuditsCookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet AuditsCookieExclusionReason = "ExcludeThirdPartyCookieBlockedInFirstPartySet"
+	AuditsCookieExclusionReasonExcludeThirdPartyPhaseout                     AuditsCookieExclusionReason = "ExcludeThirdPartyPhaseout"
+	AuditsCookieExclusionReasonExcludePortMismatch                           AuditsCookieExclusionReason = "ExcludePortMismatch"
+	AuditsCookieExclusionReasonExcludeSchemeMismatch                         AuditsCookieExclusionReason = "ExcludeSchemeMismatch"
+)
+
+// Valid returns true if the value is one of the AuditsCookieExclusionReason enum values.
+func (e AuditsCookieExclusionReason) Valid() bool {
+	switch e {
+	case AuditsCookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax,
+		AuditsCookieExclusionReasonExcludeSameSiteNoneInsecure,
+		AuditsCookieExclusionReasonExcludeSameSiteLax,
+		AuditsCookieExclusionReasonExcludeSameSiteStrict,
+		AuditsCookieExclusionReasonExcludeInvalidSameParty,
+		AuditsCookieExclusionReasonExcludeSamePartyCrossPartyContext,
+		AuditsCookieExclusionReasonExcludeDomainNonASCII,
+		AuditsCookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet,
+		AuditsCookieExclusionReasonExcludeThirdPartyPhaseout,
+		AuditsCookieExclusionReasonExcludePortMismatch,
+		AuditsCookieExclusionReasonExcludeSchemeMismatch:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsCookieWarningReason string
+
+const (
+	AuditsCookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext        AuditsCookieWarningReason = "WarnSameSiteUnspecifiedCrossSiteContext"
+	AuditsCookieWarningReasonWarnSameSiteNoneInsecure                       AuditsCookieWarningReason = "WarnSameSiteNoneInsecure"
+	AuditsCookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe          AuditsCookieWarningReason = "WarnSameSiteUnspecifiedLaxAllowUnsafe"
+	AuditsCookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict           AuditsCookieWarningReason = "WarnSameSiteStrictLaxDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict         AuditsCookieWarningReason = "WarnSameSiteStrictCrossDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeLax            AuditsCookieWarningReason = "WarnSameSiteStrictCrossDowngradeLax"
+	AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict            AuditsCookieWarningReason = "WarnSameSiteLaxCrossDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeLax               AuditsCookieWarningReason = "WarnSameSiteLaxCrossDowngradeLax"
+	AuditsCookieWarningReasonWarnAttributeValueExceedsMaxSize               AuditsCookieWarningReason = "WarnAttributeValueExceedsMaxSize"
+	AuditsCookieWarningReasonWarnDomainNonASCII                             AuditsCookieWarningReason = "WarnDomainNonASCII"
+	AuditsCookieWarningReasonWarnThirdPartyPhaseout                         AuditsCookieWarningReason = "WarnThirdPartyPhaseout"
+	AuditsCookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion AuditsCookieWarningReason = "WarnCrossSiteRedirectDowngradeChangesInclusion"
+	AuditsCookieWarningReasonWarnDeprecationTrialMetadata                   AuditsCookieWarningReason = "WarnDeprecationTrialMetadata"
+	AuditsCookieWarningReasonWarnThirdPartyCookieHeuristic                  AuditsCookieWarningReason = "WarnThirdPartyCookieHeuristic"
+)
+
+// Valid returns true if the value is one of the AuditsCookieWarningReason enum values.
+func (e AuditsCookieWarningReason) Valid() bool {
+	switch e {
+	case AuditsCookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext,
+		AuditsCookieWarningReasonWarnSameSiteNoneInsecure,
+		AuditsCookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe,
+		AuditsCookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict,
+		AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict,
+		AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeLax,
+		AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict,
+		AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeLax,
+		AuditsCookieWarningReasonWarnAttributeValueExceedsMaxSize,
+		AuditsCookieWarningReasonWarnDomainNonASCII,
+		AuditsCookieWarningReasonWarnThirdPartyPhaseout,
+		AuditsCookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion,
+		AuditsCookieWarningReasonWarnDeprecationTrialMetadata,
+		AuditsCookieWarningReasonWarnThirdPartyCookieHeuristic:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsCookieOperation string
+
+const (
+	AuditsCookieOperationSetCookie  AuditsCookieOperation = "SetCookie"
+	AuditsCookieOperationReadCookie AuditsCookieOperation = "ReadCookie"
+)
+
+// Valid returns true if the value is one of the AuditsCookieOperation enum values.
+func (e AuditsCookieOperation) Valid() bool {
+	switch e {
+	case AuditsCookieOperationSetCookie,
+		AuditsCookieOperationReadCookie:
+		return true
+	}
+	return false
+}
+
+// Represents the category of insight that a cookie issue falls under.
+type AuditsInsightType string
+
+const (
+	AuditsInsightTypeGitHubResource AuditsInsightType = "GitHubResource"
+	AuditsInsightTypeGracePeriod    AuditsInsightType = "GracePeriod"
+	AuditsInsightTypeHeuristics     AuditsInsightType = "Heuristics"
+)
+
+// Valid returns true if the value is one of the AuditsInsightType enum values.
+func (e AuditsInsightType) Valid() bool {
+	switch e {
+	case AuditsInsightTypeGitHubResource,
+		AuditsInsightTypeGracePeriod,
+		AuditsInsightTypeHeuristics:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsMixedContentResolutionStatus string
+
+const (
+	AuditsMixedContentResolutionStatusMixedContentBlocked               AuditsMixedContentResolutionStatus = "MixedContentBlocked"
+	AuditsMixedContentResolutionStatusMixedContentAutomaticallyUpgraded AuditsMixedContentResolutionStatus = "MixedContentAutomaticallyUpgraded"
+	AuditsMixedContentResolutionStatusMixedContentWarning               AuditsMixedContentResolutionStatus = "MixedContentWarning"
+)
+
+// Valid returns true if the value is one of the AuditsMixedContentResolutionStatus enum values.
+func (e AuditsMixedContentResolutionStatus) Valid() bool {
+	switch e {
+	case AuditsMixedContentResolutionStatusMixedContentBlocked,
+		AuditsMixedContentResolutionStatusMixedContentAutomaticallyUpgraded,
+		AuditsMixedContentResolutionStatusMixedContentWarning:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsMixedContentResourceType string
+
+const (
+	AuditsMixedContentResourceTypeAttributionSrc   AuditsMixedContentResourceType = "AttributionSrc"
+	AuditsMixedContentResourceTypeAudio            AuditsMixedContentResourceType = "Audio"
+	AuditsMixedContentResourceTypeBeacon           AuditsMixedContentResourceType = "Beacon"
+	AuditsMixedContentResourceTypeCSPReport        AuditsMixedContentResourceType = "CSPReport"
+	AuditsMixedContentResourceTypeDownload         AuditsMixedContentResourceType = "Download"
+	AuditsMixedContentResourceTypeEventSource      AuditsMixedContentResourceType = "EventSource"
+	AuditsMixedContentResourceTypeFavicon          AuditsMixedContentResourceType = "Favicon"
+	AuditsMixedContentResourceTypeFont             AuditsMixedContentResourceType = "Font"
+	AuditsMixedContentResourceTypeForm             AuditsMixedContentResourceType = "Form"
+	AuditsMixedContentResourceTypeFrame            AuditsMixedContentResourceType = "Frame"
+	AuditsMixedContentResourceTypeImage            AuditsMixedContentResourceType = "Image"
+	AuditsMixedContentResourceTypeImport           AuditsMixedContentResourceType = "Import"
+	AuditsMixedContentResourceTypeJSON             AuditsMixedContentResourceType = "JSON"
+	AuditsMixedContentResourceTypeManifest         AuditsMixedContentResourceType = "Manifest"
+	AuditsMixedContentResourceTypePing             AuditsMixedContentResourceType = "Ping"
+	AuditsMixedContentResourceTypePluginData       AuditsMixedContentResourceType = "PluginData"
+	AuditsMixedContentResourceTypePluginResource   AuditsMixedContentResourceType = "PluginResource"
+	AuditsMixedContentResourceTypePrefetch         AuditsMixedContentResourceType = "Prefetch"
+	AuditsMixedContentResourceTypeResource         AuditsMixedContentResourceType = "Resource"
+	AuditsMixedContentResourceTypeScript           AuditsMixedContentResourceType = "Script"
+	AuditsMixedContentResourceTypeServiceWorker    AuditsMixedContentResourceType = "ServiceWorker"
+	AuditsMixedContentResourceTypeSharedWorker     AuditsMixedContentResourceType = "SharedWorker"
+	AuditsMixedContentResourceTypeSpeculationRules AuditsMixedContentResourceType = "SpeculationRules"
+	AuditsMixedContentResourceTypeStylesheet       AuditsMixedContentResourceType = "Stylesheet"
+	AuditsMixedContentResourceTypeTrack            AuditsMixedContentResourceType = "Track"
+	AuditsMixedContentResourceTypeVideo            AuditsMixedContentResourceType = "Video"
+	AuditsMixedContentResourceTypeWorker           AuditsMixedContentResourceType = "Worker"
+	AuditsMixedContentResourceTypeXMLHttpRequest   AuditsMixedContentResourceType = "XMLHttpRequest"
+	AuditsMixedContentResourceTypeXSLT             AuditsMixedContentResourceType = "XSLT"
+)
+
+// Valid returns true if the value is one of the AuditsMixedContentResourceType enum values.
+func (e AuditsMixedContentResourceType) Valid() bool {
+	switch e {
+	case AuditsMixedContentResourceTypeAttributionSrc,
+		AuditsMixedContentResourceTypeAudio,
+		AuditsMixedContentResourceTypeBeacon,
+		AuditsMixedContentResourceTypeCSPReport,
+		AuditsMixedContentResourceTypeDownload,
+		AuditsMixedContentResourceTypeEventSource,
+		AuditsMixedContentResourceTypeFavicon,
+		AuditsMixedContentResourceTypeFont,
+		AuditsMixedContentResourceTypeForm,
+		AuditsMixedContentResourceTypeFrame,
+		AuditsMixedContentResourceTypeImage,
+		AuditsMixedContentResourceTypeImport,
+		AuditsMixedContentResourceTypeJSON,
+		AuditsMixedContentResourceTypeManifest,
+		AuditsMixedContentResourceTypePing,
+		AuditsMixedContentResourceTypePluginData,
+		AuditsMixedContentResourceTypePluginResource,
+		AuditsMixedContentResourceTypePrefetch,
+		AuditsMixedContentResourceTypeResource,
+		AuditsMixedContentResourceTypeScript,
+		AuditsMixedContentResourceTypeServiceWorker,
+		AuditsMixedContentResourceTypeSharedWorker,
+		AuditsMixedContentResourceTypeSpeculationRules,
+		AuditsMixedContentResourceTypeStylesheet,
+		AuditsMixedContentResourceTypeTrack,
+		AuditsMixedContentResourceTypeVideo,
+		AuditsMixedContentResourceTypeWorker,
+		AuditsMixedContentResourceTypeXMLHttpRequest,
+		AuditsMixedContentResourceTypeXSLT:
+		return true
+	}
+	return false
+}
+
+// Enum indicating the reason a response has been blocked. These reasons are refinements of the net error BLOCKED_BY_RESPONSE.
+type AuditsBlockedByResponseReason string
+
+const (
+	AuditsBlockedByResponseReasonCoepFrameResourceNeedsCoepHeader                        AuditsBlockedByResponseReason = "CoepFrameResourceNeedsCoepHeader"
+	AuditsBlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage             AuditsBlockedByResponseReason = "CoopSandboxedIFrameCannotNavigateToCoopPage"
+	AuditsBlockedByResponseReasonCorpNotSameOrigin                                       AuditsBlockedByResponseReason = "CorpNotSameOrigin"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoep"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByDip"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip"
+	AuditsBlockedByResponseReasonCorpNotSameSite                                         AuditsBlockedByResponseReason = "CorpNotSameSite"
+	AuditsBlockedByResponseReasonSRIMessageSignatureMismatch                             AuditsBlockedByResponseReason = "SRIMessageSignatureMismatch"
+)
+
+// Valid returns true if the value is one of the AuditsBlockedByResponseReason enum values.
+func (e AuditsBlockedByResponseReason) Valid() bool {
+	switch e {
+	case AuditsBlockedByResponseReasonCoepFrameResourceNeedsCoepHeader,
+		AuditsBlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage,
+		AuditsBlockedByResponseReasonCorpNotSameOrigin,
+		AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep,
+		AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip,
+		AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip,
+		AuditsBlockedByResponseReasonCorpNotSameSite,
+		AuditsBlockedByResponseReasonSRIMessageSignatureMismatch:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsHeavyAdResolutionStatus string
+
+const (
+	AuditsHeavyAdResolutionStatusHeavyAdBlocked AuditsHeavyAdResolutionStatus = "HeavyAdBlocked"
+	AuditsHeavyAdResolutionStatusHeavyAdWarning AuditsHeavyAdResolutionStatus = "HeavyAdWarning"
+)
+
+// Valid returns true if the value is one of the AuditsHeavyAdResolutionStatus enum values.
+func (e AuditsHeavyAdResolutionStatus) Valid() bool {
+	switch e {
+	case AuditsHeavyAdResolutionStatusHeavyAdBlocked,
+		AuditsHeavyAdResolutionStatusHeavyAdWarning:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsHeavyAdReason string
+
+const (
+	AuditsHeavyAdReasonNetworkTotalLimit AuditsHeavyAdReason = "NetworkTotalLimit"
+	AuditsHeavyAdReasonCpuTotalLimit     AuditsHeavyAdReason = "CpuTotalLimit"
+	AuditsHeavyAdReasonCpuPeakLimit      AuditsHeavyAdReason = "CpuPeakLimit"
+)
+
+// Valid returns true if the value is one of the AuditsHeavyAdReason enum values.
+func (e AuditsHeavyAdReason) Valid() bool {
+	switch e {
+	case AuditsHeavyAdReasonNetworkTotalLimit,
+		AuditsHeavyAdReasonCpuTotalLimit,
+		AuditsHeavyAdReasonCpuPeakLimit:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsContentSecurityPolicyViolationType string
+
+const (
+	AuditsContentSecurityPolicyViolationTypeKInlineViolation             AuditsContentSecurityPolicyViolationType = "kInlineViolation"
+	AuditsContentSecurityPolicyViolationTypeKEvalViolation               AuditsContentSecurityPolicyViolationType = "kEvalViolation"
+	AuditsContentSecurityPolicyViolationTypeKURLViolation                AuditsContentSecurityPolicyViolationType = "kURLViolation"
+	AuditsContentSecurityPolicyViolationTypeKSRIViolation                AuditsContentSecurityPolicyViolationType = "kSRIViolation"
+	AuditsContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation   AuditsContentSecurityPolicyViolationType = "kTrustedTypesSinkViolation"
+	AuditsContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation AuditsContentSecurityPolicyViolationType = "kTrustedTypesPolicyViolation"
+	AuditsContentSecurityPolicyViolationTypeKWasmEvalViolation           AuditsContentSecurityPolicyViolationType = "kWasmEvalViolation"
+)
+
+// Valid returns true if the value is one of the AuditsContentSecurityPolicyViolationType enum values.
+func (e AuditsContentSecurityPolicyViolationType) Valid() bool {
+	switch e {
+	case AuditsContentSecurityPolicyViolationTypeKInlineViolation,
+		AuditsContentSecurityPolicyViolationTypeKEvalViolation,
+		AuditsContentSecurityPolicyViolationTypeKURLViolation,
+		AuditsContentSecurityPolicyViolationTypeKSRIViolation,
+		AuditsContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation,
+		AuditsContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation,
+		AuditsContentSecurityPolicyViolationTypeKWasmEvalViolation:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsSharedArrayBufferIssueType string
+
+const (
+	AuditsSharedArrayBufferIssueTypeTransferIssue AuditsSharedArrayBufferIssueType = "TransferIssue"
+	AuditsSharedArrayBufferIssueTypeCreationIssue AuditsSharedArrayBufferIssueType = "CreationIssue"
+)
+
+// Valid returns true if the value is one of the AuditsSharedArrayBufferIssueType enum values.
+func (e AuditsSharedArrayBufferIssueType) Valid() bool {
+	switch e {
+	case AuditsSharedArrayBufferIssueTypeTransferIssue,
+		AuditsSharedArrayBufferIssueTypeCreationIssue:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsAttributionReportingIssueType string
+
+const (
+	AuditsAttributionReportingIssueTypePermissionPolicyDisabled                             AuditsAttributionReportingIssueType = "PermissionPolicyDisabled"
+	AuditsAttributionReportingIssueTypeUntrustworthyReportingOrigin                         AuditsAttributionReportingIssueType = "UntrustworthyReportingOrigin"
+	AuditsAttributionReportingIssueTypeInsecureContext                                      AuditsAttributionReportingIssueType = "InsecureContext"
+	AuditsAttributionReportingIssueTypeInvalidHeader                                        AuditsAttributionReportingIssueType = "InvalidHeader"
+	AuditsAttributionReportingIssueTypeInvalidRegisterTriggerHeader                         AuditsAttributionReportingIssueType = "InvalidRegisterTriggerHeader"
+	AuditsAttributionReportingIssueTypeSourceAndTriggerHeaders                              AuditsAttributionReportingIssueType = "SourceAndTriggerHeaders"
+	AuditsAttributionReportingIssueTypeSourceIgnored                                        AuditsAttributionReportingIssueType = "SourceIgnored"
+	AuditsAttributionReportingIssueTypeTriggerIgnored                                       AuditsAttributionReportingIssueType = "TriggerIgnored"
+	AuditsAttributionReportingIssueTypeOsSourceIgnored                                      AuditsAttributionReportingIssueType = "OsSourceIgnored"
+	AuditsAttributionReportingIssueTypeOsTriggerIgnored                                     AuditsAttributionReportingIssueType = "OsTriggerIgnored"
+	AuditsAttributionReportingIssueTypeInvalidRegisterOsSourceHeader                        AuditsAttributionReportingIssueType = "InvalidRegisterOsSourceHeader"
+	AuditsAttributionReportingIssueTypeInvalidRegisterOsTriggerHeader                       AuditsAttributionReportingIssueType = "InvalidRegisterOsTriggerHeader"
+	AuditsAttributionReportingIssueTypeWebAndOsHeaders                                      AuditsAttributionReportingIssueType = "WebAndOsHeaders"
+	AuditsAttributionReportingIssueTypeNoWebOrOsSupport                                     AuditsAttributionReportingIssueType = "NoWebOrOsSupport"
+	AuditsAttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation AuditsAttributionReportingIssueType = "NavigationRegistrationWithoutTransientUserActivation"
+	AuditsAttributionReportingIssueTypeInvalidInfoHeader                                    AuditsAttributionReportingIssueType = "InvalidInfoHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterSourceHeader                               AuditsAttributionReportingIssueType = "NoRegisterSourceHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterTriggerHeader                              AuditsAttributionReportingIssueType = "NoRegisterTriggerHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterOsSourceHeader                             AuditsAttributionReportingIssueType = "NoRegisterOsSourceHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterOsTriggerHeader                            AuditsAttributionReportingIssueType = "NoRegisterOsTriggerHeader"
+	AuditsAttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet          AuditsAttributionReportingIssueType = "NavigationRegistrationUniqueScopeAlreadySet"
+)
+
+// Valid returns true if the value is one of the AuditsAttributionReportingIssueType enum values.
+func (e AuditsAttributionReportingIssueType) Valid() bool {
+	switch e {
+	case AuditsAttributionReportingIssueTypePermissionPolicyDisabled,
+		AuditsAttributionReportingIssueTypeUntrustworthyReportingOrigin,
+		AuditsAttributionReportingIssueTypeInsecureContext,
+		AuditsAttributionReportingIssueTypeInvalidHeader,
+		AuditsAttributionReportingIssueTypeInvalidRegisterTriggerHeader,
+		AuditsAttributionReportingIssueTypeSourceAndTriggerHeaders,
+		AuditsAttributionReportingIssueTypeSourceIgnored,
+		AuditsAttributionReportingIssueTypeTriggerIgnored,
+		AuditsAttributionReportingIssueTypeOsSourceIgnored,
+		AuditsAttributionReportingIssueTypeOsTriggerIgnored,
+		AuditsAttributionReportingIssueTypeInvalidRegisterOsSourceHeader,
+		AuditsAttributionReportingIssueTypeInvalidRegisterOsTriggerHeader,
+		AuditsAttributionReportingIssueTypeWebAndOsHeaders,
+		AuditsAttributionReportingIssueTypeNoWebOrOsSupport,
+		AuditsAttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation,
+		AuditsAttributionReportingIssueTypeInvalidInfoHeader,
+		AuditsAttributionReportingIssueTypeNoRegisterSourceHeader,
+		AuditsAttributionReportingIssueTypeNoRegisterTriggerHeader,
+		AuditsAttributionReportingIssueTypeNoRegisterOsSourceHeader,
+		AuditsAttributionReportingIssueTypeNoRegisterOsTriggerHeader,
+		AuditsAttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsSharedDictionaryError string
+
+const (
+	AuditsSharedDictionaryErrorUseErrorCrossOriginNoCorsRequest          AuditsSharedDictionaryError = "UseErrorCrossOriginNoCorsRequest"
+	AuditsSharedDictionaryErrorUseErrorDictionaryLoadFailure             AuditsSharedDictionaryError = "UseErrorDictionaryLoadFailure"
+	AuditsSharedDictionaryErrorUseErrorMatchingDictionaryNotUsed         AuditsSharedDictionaryError = "UseErrorMatchingDictionaryNotUsed"
+	AuditsSharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader AuditsSharedDictionaryError = "UseErrorUnexpectedContentDictionaryHeader"
+	AuditsSharedDictionaryErrorWriteErrorCossOriginNoCorsRequest         AuditsSharedDictionaryError = "WriteErrorCossOriginNoCorsRequest"
+	AuditsSharedDictionaryErrorWriteErrorDisallowedBySettings            AuditsSharedDictionaryError = "WriteErrorDisallowedBySettings"
+	AuditsSharedDictionaryErrorWriteErrorExpiredResponse                 AuditsSharedDictionaryError = "WriteErrorExpiredResponse"
+	AuditsSharedDictionaryErrorWriteErrorFeatureDisabled                 AuditsSharedDictionaryError = "WriteErrorFeatureDisabled"
+	AuditsSharedDictionaryErrorWriteErrorInsufficientResources           AuditsSharedDictionaryError = "WriteErrorInsufficientResources"
+	AuditsSharedDictionaryErrorWriteErrorInvalidMatchField               AuditsSharedDictionaryError = "WriteErrorInvalidMatchField"
+	AuditsSharedDictionaryErrorWriteErrorInvalidStructuredHeader         AuditsSharedDictionaryError = "WriteErrorInvalidStructuredHeader"
+	AuditsSharedDictionaryErrorWriteErrorNavigationRequest               AuditsSharedDictionaryError = "WriteErrorNavigationRequest"
+	AuditsSharedDictionaryErrorWriteErrorNoMatchField                    AuditsSharedDictionaryError = "WriteErrorNoMatchField"
+	AuditsSharedDictionaryErrorWriteErrorNonListMatchDestField           AuditsSharedDictionaryError = "WriteErrorNonListMatchDestField"
+	AuditsSharedDictionaryErrorWriteErrorNonSecureContext                AuditsSharedDictionaryError = "WriteErrorNonSecureContext"
+	AuditsSharedDictionaryErrorWriteErrorNonStringIdField                AuditsSharedDictionaryError = "WriteErrorNonStringIdField"
+	AuditsSharedDictionaryErrorWriteErrorNonStringInMatchDestList        AuditsSharedDictionaryError = "WriteErrorNonStringInMatchDestList"
+	AuditsSharedDictionaryErrorWriteErrorNonStringMatchField             AuditsSharedDictionaryError = "WriteErrorNonStringMatchField"
+	AuditsSharedDictionaryErrorWriteErrorNonTokenTypeField               AuditsSharedDictionaryError = "WriteErrorNonTokenTypeField"
+	AuditsSharedDictionaryErrorWriteErrorRequestAborted                  AuditsSharedDictionaryError = "WriteErrorRequestAborted"
+	AuditsSharedDictionaryErrorWriteErrorShuttingDown                    AuditsSharedDictionaryError = "WriteErrorShuttingDown"
+	AuditsSharedDictionaryErrorWriteErrorTooLongIdField                  AuditsSharedDictionaryError = "WriteErrorTooLongIdField"
+	AuditsSharedDictionaryErrorWriteErrorUnsupportedType                 AuditsSharedDictionaryError = "WriteErrorUnsupportedType"
+)
+
+// Valid returns true if the value is one of the AuditsSharedDictionaryError enum values.
+func (e AuditsSharedDictionaryError) Valid() bool {
+	switch e {
+	case AuditsSharedDictionaryErrorUseErrorCrossOriginNoCorsRequest,
+		AuditsSharedDictionaryErrorUseErrorDictionaryLoadFailure,
+		AuditsSharedDictionaryErrorUseErrorMatchingDictionaryNotUsed,
+		AuditsSharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader,
+		AuditsSharedDictionaryErrorWriteErrorCossOriginNoCorsRequest,
+		AuditsSharedDictionaryErrorWriteErrorDisallowedBySettings,
+		AuditsSharedDictionaryErrorWriteErrorExpiredResponse,
+		AuditsSharedDictionaryErrorWriteErrorFeatureDisabled,
+		AuditsSharedDictionaryErrorWriteErrorInsufficientResources,
+		AuditsSharedDictionaryErrorWriteErrorInvalidMatchField,
+		AuditsSharedDictionaryErrorWriteErrorInvalidStructuredHeader,
+		AuditsSharedDictionaryErrorWriteErrorNavigationRequest,
+		AuditsSharedDictionaryErrorWriteErrorNoMatchField,
+		AuditsSharedDictionaryErrorWriteErrorNonListMatchDestField,
+		AuditsSharedDictionaryErrorWriteErrorNonSecureContext,
+		AuditsSharedDictionaryErrorWriteErrorNonStringIdField,
+		AuditsSharedDictionaryErrorWriteErrorNonStringInMatchDestList,
+		AuditsSharedDictionaryErrorWriteErrorNonStringMatchField,
+		AuditsSharedDictionaryErrorWriteErrorNonTokenTypeField,
+		AuditsSharedDictionaryErrorWriteErrorRequestAborted,
+		AuditsSharedDictionaryErrorWriteErrorShuttingDown,
+		AuditsSharedDictionaryErrorWriteErrorTooLongIdField,
+		AuditsSharedDictionaryErrorWriteErrorUnsupportedType:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsSRIMessageSignatureError string
+
+const (
+	AuditsSRIMessageSignatureErrorMissingSignatureHeader                               AuditsSRIMessageSignatureError = "MissingSignatureHeader"
+	AuditsSRIMessageSignatureErrorMissingSignatureInputHeader                          AuditsSRIMessageSignatureError = "MissingSignatureInputHeader"
+	AuditsSRIMessageSignatureErrorInvalidSignatureHeader                               AuditsSRIMessageSignatureError = "InvalidSignatureHeader"
+	AuditsSRIMessageSignatureErrorInvalidSignatureInputHeader                          AuditsSRIMessageSignatureError = "InvalidSignatureInputHeader"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence                AuditsSRIMessageSignatureError = "SignatureHeaderValueIsNotByteSequence"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsParameterized                  AuditsSRIMessageSignatureError = "SignatureHeaderValueIsParameterized"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength                AuditsSRIMessageSignatureError = "SignatureHeaderValueIsIncorrectLength"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingLabel                     AuditsSRIMessageSignatureError = "SignatureInputHeaderMissingLabel"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList                AuditsSRIMessageSignatureError = "SignatureInputHeaderValueNotInnerList"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents           AuditsSRIMessageSignatureError = "SignatureInputHeaderValueMissingComponents"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType             AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidComponentType"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName             AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidComponentName"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter  AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidHeaderComponentParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidDerivedComponentParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderKeyIdLength                      AuditsSRIMessageSignatureError = "SignatureInputHeaderKeyIdLength"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidParameter                 AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters        AuditsSRIMessageSignatureError = "SignatureInputHeaderMissingRequiredParameters"
+	AuditsSRIMessageSignatureErrorValidationFailedSignatureExpired                     AuditsSRIMessageSignatureError = "ValidationFailedSignatureExpired"
+	AuditsSRIMessageSignatureErrorValidationFailedInvalidLength                        AuditsSRIMessageSignatureError = "ValidationFailedInvalidLength"
+	AuditsSRIMessageSignatureErrorValidationFailedSignatureMismatch                    AuditsSRIMessageSignatureError = "ValidationFailedSignatureMismatch"
+	AuditsSRIMessageSignatureErrorValidationFailedIntegrityMismatch                    AuditsSRIMessageSignatureError = "ValidationFailedIntegrityMismatch"
+)
+
+// Valid returns true if the value is one of the AuditsSRIMessageSignatureError enum values.
+func (e AuditsSRIMessageSignatureError) Valid() bool {
+	switch e {
+	case AuditsSRIMessageSignatureErrorMissingSignatureHeader,
+		AuditsSRIMessageSignatureErrorMissingSignatureInputHeader,
+		AuditsSRIMessageSignatureErrorInvalidSignatureHeader,
+		AuditsSRIMessageSignatureErrorInvalidSignatureInputHeader,
+		AuditsSRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence,
+		AuditsSRIMessageSignatureErrorSignatureHeaderValueIsParameterized,
+		AuditsSRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingLabel,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderKeyIdLength,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidParameter,
+		AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters,
+		AuditsSRIMessageSignatureErrorValidationFailedSignatureExpired,
+		AuditsSRIMessageSignatureErrorValidationFailedInvalidLength,
+		AuditsSRIMessageSignatureErrorValidationFailedSignatureMismatch,
+		AuditsSRIMessageSignatureErrorValidationFailedIntegrityMismatch:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsUnencodedDigestError string
+
+const (
+	AuditsUnencodedDigestErrorMalformedDictionary   AuditsUnencodedDigestError = "MalformedDictionary"
+	AuditsUnencodedDigestErrorUnknownAlgorithm      AuditsUnencodedDigestError = "UnknownAlgorithm"
+	AuditsUnencodedDigestErrorIncorrectDigestType   AuditsUnencodedDigestError = "IncorrectDigestType"
+	AuditsUnencodedDigestErrorIncorrectDigestLength AuditsUnencodedDigestError = "IncorrectDigestLength"
+)
+
+// Valid returns true if the value is one of the AuditsUnencodedDigestError enum values.
+func (e AuditsUnencodedDigestError) Valid() bool {
+	switch e {
+	case AuditsUnencodedDigestErrorMalformedDictionary,
+		AuditsUnencodedDigestErrorUnknownAlgorithm,
+		AuditsUnencodedDigestErrorIncorrectDigestType,
+		AuditsUnencodedDigestErrorIncorrectDigestLength:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsGenericIssueErrorType string
+
+const (
+	AuditsGenericIssueErrorTypeFormLabelForNameError                                      AuditsGenericIssueErrorType = "FormLabelForNameError"
+	AuditsGenericIssueErrorTypeFormDuplicateIdForInputError                               AuditsGenericIssueErrorType = "FormDuplicateIdForInputError"
+	AuditsGenericIssueErrorTypeFormInputWithNoLabelError                                  AuditsGenericIssueErrorType = "FormInputWithNoLabelError"
+	AuditsGenericIssueErrorTypeFormAutocompleteAttributeEmptyError                        AuditsGenericIssueErrorType = "FormAutocompleteAttributeEmptyError"
+	AuditsGenericIssueErrorTypeFormEmptyIdAndNameAttributesForInputError                  AuditsGenericIssueErrorType = "FormEmptyIdAndNameAttributesForInputError"
+	AuditsGenericIssueErrorTypeFormAriaLabelledByToNonExistingId                          AuditsGenericIssueErrorType = "FormAriaLabelledByToNonExistingId"
+	AuditsGenericIssueErrorTypeFormInputAssignedAutocompleteValueToIdOrNameAttributeError AuditsGenericIssueErrorType = "FormInputAssignedAutocompleteValueToIdOrNameAttributeError"
+	AuditsGenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput                       AuditsGenericIssueErrorType = "FormLabelHasNeitherForNorNestedInput"
+	AuditsGenericIssueErrorTypeFormLabelForMatchesNonExistingIdError                      AuditsGenericIssueErrorType = "FormLabelForMatchesNonExistingIdError"
+	AuditsGenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError     AuditsGenericIssueErrorType = "FormInputHasWrongButWellIntendedAutocompleteValueError"
+	AuditsGenericIssueErrorTypeResponseWasBlockedByORB                                    AuditsGenericIssueErrorType = "ResponseWasBlockedByORB"
+)
+
+// Valid returns true if the value is one of the AuditsGenericIssueErrorType enum values.
+func (e AuditsGenericIssueErrorType) Valid() bool {
+	switch e {
+	case AuditsGenericIssueErrorTypeFormLabelForNameError,
+		AuditsGenericIssueErrorTypeFormDuplicateIdForInputError,
+		AuditsGenericIssueErrorTypeFormInputWithNoLabelError,
+		AuditsGenericIssueErrorTypeFormAutocompleteAttributeEmptyError,
+		AuditsGenericIssueErrorTypeFormEmptyIdAndNameAttributesForInputError,
+		AuditsGenericIssueErrorTypeFormAriaLabelledByToNonExistingId,
+		AuditsGenericIssueErrorTypeFormInputAssignedAutocompleteValueToIdOrNameAttributeError,
+		AuditsGenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput,
+		AuditsGenericIssueErrorTypeFormLabelForMatchesNonExistingIdError,
+		AuditsGenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError,
+		AuditsGenericIssueErrorTypeResponseWasBlockedByORB:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsClientHintIssueReason string
+
+const (
+	AuditsClientHintIssueReasonMetaTagAllowListInvalidOrigin AuditsClientHintIssueReason = "MetaTagAllowListInvalidOrigin"
+	AuditsClientHintIssueReasonMetaTagModifiedHTML           AuditsClientHintIssueReason = "MetaTagModifiedHTML"
+)
+
+// Valid returns true if the value is one of the AuditsClientHintIssueReason enum values.
+func (e AuditsClientHintIssueReason) Valid() bool {
+	switch e {
+	case AuditsClientHintIssueReasonMetaTagAllowListInvalidOrigin,
+		AuditsClientHintIssueReasonMetaTagModifiedHTML:
+		return true
+	}
+	return false
+}
+
+// Represents the failure reason when a federated authentication reason fails. Should be updated alongside RequestIdTokenStatus in third_party/blink/public/mojom/devtools/inspector_issue.mojom to include all cases except for success.
+type AuditsFederatedAuthRequestIssueReason string
+
+const (
+	AuditsFederatedAuthRequestIssueReasonShouldEmbargo                    AuditsFederatedAuthRequestIssueReason = "ShouldEmbargo"
+	AuditsFederatedAuthRequestIssueReasonTooManyRequests                  AuditsFederatedAuthRequestIssueReason = "TooManyRequests"
+	AuditsFederatedAuthRequestIssueReasonWellKnownHttpNotFound            AuditsFederatedAuthRequestIssueReason = "WellKnownHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonWellKnownNoResponse              AuditsFederatedAuthRequestIssueReason = "WellKnownNoResponse"
+	AuditsFederatedAuthRequestIssueReasonWellKnownInvalidResponse         AuditsFederatedAuthRequestIssueReason = "WellKnownInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonWellKnownListEmpty               AuditsFederatedAuthRequestIssueReason = "WellKnownListEmpty"
+	AuditsFederatedAuthRequestIssueReasonWellKnownInvalidContentType      AuditsFederatedAuthRequestIssueReason = "WellKnownInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonConfigNotInWellKnown             AuditsFederatedAuthRequestIssueReason = "ConfigNotInWellKnown"
+	AuditsFederatedAuthRequestIssueReasonWellKnownTooBig                  AuditsFederatedAuthRequestIssueReason = "WellKnownTooBig"
+	AuditsFederatedAuthRequestIssueReasonConfigHttpNotFound               AuditsFederatedAuthRequestIssueReason = "ConfigHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonConfigNoResponse                 AuditsFederatedAuthRequestIssueReason = "ConfigNoResponse"
+	AuditsFederatedAuthRequestIssueReasonConfigInvalidResponse            AuditsFederatedAuthRequestIssueReason = "ConfigInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonConfigInvalidContentType         AuditsFederatedAuthRequestIssueReason = "ConfigInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataHttpNotFound       AuditsFederatedAuthRequestIssueReason = "ClientMetadataHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataNoResponse         AuditsFederatedAuthRequestIssueReason = "ClientMetadataNoResponse"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidResponse    AuditsFederatedAuthRequestIssueReason = "ClientMetadataInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidContentType AuditsFederatedAuthRequestIssueReason = "ClientMetadataInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy     AuditsFederatedAuthRequestIssueReason = "IdpNotPotentiallyTrustworthy"
+	AuditsFederatedAuthRequestIssueReasonDisabledInSettings               AuditsFederatedAuthRequestIssueReason = "DisabledInSettings"
+	AuditsFederatedAuthRequestIssueReasonDisabledInFlags                  AuditsFederatedAuthRequestIssueReason = "DisabledInFlags"
+	AuditsFederatedAuthRequestIssueReasonErrorFetchingSignin              AuditsFederatedAuthRequestIssueReason = "ErrorFetchingSignin"
+	AuditsFederatedAuthRequestIssueReasonInvalidSigninResponse            AuditsFederatedAuthRequestIssueReason = "InvalidSigninResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsHttpNotFound             AuditsFederatedAuthRequestIssueReason = "AccountsHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonAccountsNoResponse               AuditsFederatedAuthRequestIssueReason = "AccountsNoResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsInvalidResponse          AuditsFederatedAuthRequestIssueReason = "AccountsInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsListEmpty                AuditsFederatedAuthRequestIssueReason = "AccountsListEmpty"
+	AuditsFederatedAuthRequestIssueReasonAccountsInvalidContentType       AuditsFederatedAuthRequestIssueReason = "AccountsInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonIdTokenHttpNotFound              AuditsFederatedAuthRequestIssueReason = "IdTokenHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonIdTokenNoResponse                AuditsFederatedAuthRequestIssueReason = "IdTokenNoResponse"
+	AuditsFederatedAuthRequestIssueReasonIdTokenInvalidResponse           AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonIdTokenIdpErrorResponse          AuditsFederatedAuthRequestIssueReason = "IdTokenIdpErrorResponse"
+	AuditsFederatedAuthRequestIssueReasonIdTokenCrossSiteIdpErrorResponse AuditsFederatedAuthRequestIssueReason = "IdTokenCrossSiteIdpErrorResponse"
+	AuditsFederatedAuthRequestIssueReasonIdTokenInvalidRequest            AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidRequest"
+	AuditsFederatedAuthRequestIssueReasonIdTokenInvalidContentType        AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonErrorIdToken                     AuditsFederatedAuthRequestIssueReason = "ErrorIdToken"
+	AuditsFederatedAuthRequestIssueReasonCanceled                         AuditsFederatedAuthRequestIssueReason = "Canceled"
+	AuditsFederatedAuthRequestIssueReasonRpPageNotVisible                 AuditsFederatedAuthRequestIssueReason = "RpPageNotVisible"
+	AuditsFederatedAuthRequestIssueReasonSilentMediationFailure           AuditsFederatedAuthRequestIssueReason = "SilentMediationFailure"
+	AuditsFederatedAuthRequestIssueReasonThirdPartyCookiesBlocked         AuditsFederatedAuthRequestIssueReason = "ThirdPartyCookiesBlocked"
+	AuditsFederatedAuthRequestIssueReasonNotSignedInWithIdp               AuditsFederatedAuthRequestIssueReason = "NotSignedInWithIdp"
+	AuditsFederatedAuthRequestIssueReasonMissingTransientUserActivation   AuditsFederatedAuthRequestIssueReason = "MissingTransientUserActivation"
+	AuditsFederatedAuthRequestIssueReasonReplacedByActiveMode             AuditsFederatedAuthRequestIssueReason = "ReplacedByActiveMode"
+	AuditsFederatedAuthRequestIssueReasonInvalidFieldsSpecified           AuditsFederatedAuthRequestIssueReason = "InvalidFieldsSpecified"
+	AuditsFederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque       AuditsFederatedAuthRequestIssueReason = "RelyingPartyOriginIsOpaque"
+	AuditsFederatedAuthRequestIssueReasonTypeNotMatching                  AuditsFederatedAuthRequestIssueReason = "TypeNotMatching"
+	AuditsFederatedAuthRequestIssueReasonUiDismissedNoEmbargo             AuditsFederatedAuthRequestIssueReason = "UiDismissedNoEmbargo"
+	AuditsFederatedAuthRequestIssueReasonCorsError                        AuditsFederatedAuthRequestIssueReason = "CorsError"
+	AuditsFederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform AuditsFederatedAuthRequestIssueReason = "SuppressedBySegmentationPlatform"
+)
+
+// Valid returns true if the value is one of the AuditsFederatedAuthRequestIssueReason enum values.
+func (e AuditsFederatedAuthRequestIssueReason) Valid() bool {
+	switch e {
+	case AuditsFederatedAuthRequestIssueReasonShouldEmbargo,
+		AuditsFederatedAuthRequestIssueReasonTooManyRequests,
+		AuditsFederatedAuthRequestIssueReasonWellKnownHttpNotFound,
+		AuditsFederatedAuthRequestIssueReasonWellKnownNoResponse,
+		AuditsFederatedAuthRequestIssueReasonWellKnownInvalidResponse,
+		AuditsFederatedAuthRequestIssueReasonWellKnownListEmpty,
+		AuditsFederatedAuthRequestIssueReasonWellKnownInvalidContentType,
+		AuditsFederatedAuthRequestIssueReasonConfigNotInWellKnown,
+		AuditsFederatedAuthRequestIssueReasonWellKnownTooBig,
+		AuditsFederatedAuthRequestIssueReasonConfigHttpNotFound,
+		AuditsFederatedAuthRequestIssueReasonConfigNoResponse,
+		AuditsFederatedAuthRequestIssueReasonConfigInvalidResponse,
+		AuditsFederatedAuthRequestIssueReasonConfigInvalidContentType,
+		AuditsFederatedAuthRequestIssueReasonClientMetadataHttpNotFound,
+		AuditsFederatedAuthRequestIssueReasonClientMetadataNoResponse,
+		AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidResponse,
+		AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidContentType,
+		AuditsFederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy,
+		AuditsFederatedAuthRequestIssueReasonDisabledInSettings,
+		AuditsFederatedAuthRequestIssueReasonDisabledInFlags,
+		AuditsFederatedAuthRequestIssueReasonErrorFetchingSignin,
+		AuditsFederatedAuthRequestIssueReasonInvalidSigninResponse,
+		AuditsFederatedAuthRequestIssueReasonAccountsHttpNotFound,
+		AuditsFederatedAuthRequestIssueReasonAccountsNoResponse,
+		AuditsFederatedAuthRequestIssueReasonAccountsInvalidResponse,
+		AuditsFederatedAuthRequestIssueReasonAccountsListEmpty,
+		AuditsFederatedAuthRequestIssueReasonAccountsInvalidContentType,
+		AuditsFederatedAuthRequestIssueReasonIdTokenHttpNotFound,
+		AuditsFederatedAuthRequestIssueReasonIdTokenNoResponse,
+		AuditsFederatedAuthRequestIssueReasonIdTokenInvalidResponse,
+		AuditsFederatedAuthRequestIssueReasonIdTokenIdpErrorResponse,
+		AuditsFederatedAuthRequestIssueReasonIdTokenCrossSiteIdpErrorResponse,
+		AuditsFederatedAuthRequestIssueReasonIdTokenInvalidRequest,
+		AuditsFederatedAuthRequestIssueReasonIdTokenInvalidContentType,
+		AuditsFederatedAuthRequestIssueReasonErrorIdToken,
+		AuditsFederatedAuthRequestIssueReasonCanceled,
+		AuditsFederatedAuthRequestIssueReasonRpPageNotVisible,
+		AuditsFederatedAuthRequestIssueReasonSilentMediationFailure,
+		AuditsFederatedAuthRequestIssueReasonThirdPartyCookiesBlocked,
+		AuditsFederatedAuthRequestIssueReasonNotSignedInWithIdp,
+		AuditsFederatedAuthRequestIssueReasonMissingTransientUserActivation,
+		AuditsFederatedAuthRequestIssueReasonReplacedByActiveMode,
+		AuditsFederatedAuthRequestIssueReasonInvalidFieldsSpecified,
+		AuditsFederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque,
+		AuditsFederatedAuthRequestIssueReasonTypeNotMatching,
+		AuditsFederatedAuthRequestIssueReasonUiDismissedNoEmbargo,
+		AuditsFederatedAuthRequestIssueReasonCorsError,
+		AuditsFederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform:
+		return true
+	}
+	return false
+}
+
+// Represents the failure reason when a getUserInfo() call fails. Should be updated alongside FederatedAuthUserInfoRequestResult in third_party/blink/public/mojom/devtools/inspector_issue.mojom.
+type AuditsFederatedAuthUserInfoRequestIssueReason string
+
+const (
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotSameOrigin                      AuditsFederatedAuthUserInfoRequestIssueReason = "NotSameOrigin"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotIframe                          AuditsFederatedAuthUserInfoRequestIssueReason = "NotIframe"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy          AuditsFederatedAuthUserInfoRequestIssueReason = "NotPotentiallyTrustworthy"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoApiPermission                    AuditsFederatedAuthUserInfoRequestIssueReason = "NoApiPermission"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp                 AuditsFederatedAuthUserInfoRequestIssueReason = "NotSignedInWithIdp"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission         AuditsFederatedAuthUserInfoRequestIssueReason = "NoAccountSharingPermission"
+	AuditsFederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown           AuditsFederatedAuthUserInfoRequestIssueReason = "InvalidConfigOrWellKnown"
+	AuditsFederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse            AuditsFederatedAuthUserInfoRequestIssueReason = "InvalidAccountsResponse"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts AuditsFederatedAuthUserInfoRequestIssueReason = "NoReturningUserFromFetchedAccounts"
+)
+
+// Valid returns true if the value is one of the AuditsFederatedAuthUserInfoRequestIssueReason enum values.
+func (e AuditsFederatedAuthUserInfoRequestIssueReason) Valid() bool {
+	switch e {
+	case AuditsFederatedAuthUserInfoRequestIssueReasonNotSameOrigin,
+		AuditsFederatedAuthUserInfoRequestIssueReasonNotIframe,
+		AuditsFederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy,
+		AuditsFederatedAuthUserInfoRequestIssueReasonNoApiPermission,
+		AuditsFederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp,
+		AuditsFederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission,
+		AuditsFederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown,
+		AuditsFederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse,
+		AuditsFederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsPartitioningBlobURLInfo string
+
+const (
+	AuditsPartitioningBlobURLInfoBlockedCrossPartitionFetching AuditsPartitioningBlobURLInfo = "BlockedCrossPartitionFetching"
+	AuditsPartitioningBlobURLInfoEnforceNoopenerForNavigation  AuditsPartitioningBlobURLInfo = "EnforceNoopenerForNavigation"
+)
+
+// Valid returns true if the value is one of the AuditsPartitioningBlobURLInfo enum values.
+func (e AuditsPartitioningBlobURLInfo) Valid() bool {
+	switch e {
+	case AuditsPartitioningBlobURLInfoBlockedCrossPartitionFetching,
+		AuditsPartitioningBlobURLInfoEnforceNoopenerForNavigation:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsElementAccessibilityIssueReason string
+
+const (
+	AuditsElementAccessibilityIssueReasonDisallowedSelectChild               AuditsElementAccessibilityIssueReason = "DisallowedSelectChild"
+	AuditsElementAccessibilityIssueReasonDisallowedOptGroupChild             AuditsElementAccessibilityIssueReason = "DisallowedOptGroupChild"
+	AuditsElementAccessibilityIssueReasonNonPhrasingContentOptionChild       AuditsElementAccessibilityIssueReason = "NonPhrasingContentOptionChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentOptionChild       AuditsElementAccessibilityIssueReason = "InteractiveContentOptionChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentLegendChild       AuditsElementAccessibilityIssueReason = "InteractiveContentLegendChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentSummaryDescendant AuditsElementAccessibilityIssueReason = "InteractiveContentSummaryDescendant"
+)
+
+// Valid returns true if the value is one of the AuditsElementAccessibilityIssueReason enum values.
+func (e AuditsElementAccessibilityIssueReason) Valid() bool {
+	switch e {
+	case AuditsElementAccessibilityIssueReasonDisallowedSelectChild,
+		AuditsElementAccessibilityIssueReasonDisallowedOptGroupChild,
+		AuditsElementAccessibilityIssueReasonNonPhrasingContentOptionChild,
+		AuditsElementAccessibilityIssueReasonInteractiveContentOptionChild,
+		AuditsElementAccessibilityIssueReasonInteractiveContentLegendChild,
+		AuditsElementAccessibilityIssueReasonInteractiveContentSummaryDescendant:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsStyleSheetLoadingIssueReason string
+
+const (
+	AuditsStyleSheetLoadingIssueReasonLateImportRule AuditsStyleSheetLoadingIssueReason = "LateImportRule"
+	AuditsStyleSheetLoadingIssueReasonRequestFailed  AuditsStyleSheetLoadingIssueReason = "RequestFailed"
+)
+
+// Valid returns true if the value is one of the AuditsStyleSheetLoadingIssueReason enum values.
+func (e AuditsStyleSheetLoadingIssueReason) Valid() bool {
+	switch e {
+	case AuditsStyleSheetLoadingIssueReasonLateImportRule,
+		AuditsStyleSheetLoadingIssueReasonRequestFailed:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsPropertyRuleIssueReason string
+
+const (
+	AuditsPropertyRuleIssueReasonInvalidSyntax       AuditsPropertyRuleIssueReason = "InvalidSyntax"
+	AuditsPropertyRuleIssueReasonInvalidInitialValue AuditsPropertyRuleIssueReason = "InvalidInitialValue"
+	AuditsPropertyRuleIssueReasonInvalidInherits     AuditsPropertyRuleIssueReason = "InvalidInherits"
+	AuditsPropertyRuleIssueReasonInvalidName         AuditsPropertyRuleIssueReason = "InvalidName"
+)
+
+// Valid returns true if the value is one of the AuditsPropertyRuleIssueReason enum values.
+func (e AuditsPropertyRuleIssueReason) Valid() bool {
+	switch e {
+	case AuditsPropertyRuleIssueReasonInvalidSyntax,
+		AuditsPropertyRuleIssueReasonInvalidInitialValue,
+		AuditsPropertyRuleIssueReasonInvalidInherits,
+		AuditsPropertyRuleIssueReasonInvalidName:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AuditsUserReidentificationIssueType string
+
+const (
+	AuditsUserReidentificationIssueTypeBlockedFrameNavigation AuditsUserReidentificationIssueType = "BlockedFrameNavigation"
+	AuditsUserReidentificationIssueTypeBlockedSubresource     AuditsUserReidentificationIssueType = "BlockedSubresource"
+)
+
+// Valid returns true if the value is one of the AuditsUserReidentificationIssueType enum values.
+func (e AuditsUserReidentificationIssueType) Valid() bool {
+	switch e {
+	case AuditsUserReidentificationIssueTypeBlockedFrameNavigation,
+		AuditsUserReidentificationIssueTypeBlockedSubresource:
+		return true
+	}
+	return false
+}
+
+// A unique identifier for the type of issue. Each type may use one of the optional fields in InspectorIssueDetails to convey more specific information about the kind of issue.
+type AuditsInspectorIssueCode string
+
+const (
+	AuditsInspectorIssueCodeCookieIssue                       AuditsInspectorIssueCode = "CookieIssue"
+	AuditsInspectorIssueCodeMixedContentIssue                 AuditsInspectorIssueCode = "MixedContentIssue"
+	AuditsInspectorIssueCodeBlockedByResponseIssue            AuditsInspectorIssueCode = "BlockedByResponseIssue"
+	AuditsInspectorIssueCodeHeavyAdIssue                      AuditsInspectorIssueCode = "HeavyAdIssue"
+	AuditsInspectorIssueCodeContentSecurityPolicyIssue        AuditsInspectorIssueCode = "ContentSecurityPolicyIssue"
+	AuditsInspectorIssueCodeSharedArrayBufferIssue            AuditsInspectorIssueCode = "SharedArrayBufferIssue"
+	AuditsInspectorIssueCodeLowTextContrastIssue              AuditsInspectorIssueCode = "LowTextContrastIssue"
+	AuditsInspectorIssueCodeCorsIssue                         AuditsInspectorIssueCode = "CorsIssue"
+	AuditsInspectorIssueCodeAttributionReportingIssue         AuditsInspectorIssueCode = "AttributionReportingIssue"
+	AuditsInspectorIssueCodeQuirksModeIssue                   AuditsInspectorIssueCode = "QuirksModeIssue"
+	AuditsInspectorIssueCodePartitioningBlobURLIssue          AuditsInspectorIssueCode = "PartitioningBlobURLIssue"
+	AuditsInspectorIssueCodeNavigatorUserAgentIssue           AuditsInspectorIssueCode = "NavigatorUserAgentIssue"
+	AuditsInspectorIssueCodeGenericIssue                      AuditsInspectorIssueCode = "GenericIssue"
+	AuditsInspectorIssueCodeDeprecationIssue                  AuditsInspectorIssueCode = "DeprecationIssue"
+	AuditsInspectorIssueCodeClientHintIssue                   AuditsInspectorIssueCode = "ClientHintIssue"
+	AuditsInspectorIssueCodeFederatedAuthRequestIssue         AuditsInspectorIssueCode = "FederatedAuthRequestIssue"
+	AuditsInspectorIssueCodeBounceTrackingIssue               AuditsInspectorIssueCode = "BounceTrackingIssue"
+	AuditsInspectorIssueCodeCookieDeprecationMetadataIssue    AuditsInspectorIssueCode = "CookieDeprecationMetadataIssue"
+	AuditsInspectorIssueCodeStylesheetLoadingIssue            AuditsInspectorIssueCode = "StylesheetLoadingIssue"
+	AuditsInspectorIssueCodeFederatedAuthUserInfoRequestIssue AuditsInspectorIssueCode = "FederatedAuthUserInfoRequestIssue"
+	AuditsInspectorIssueCodePropertyRuleIssue                 AuditsInspectorIssueCode = "PropertyRuleIssue"
+	AuditsInspectorIssueCodeSharedDictionaryIssue             AuditsInspectorIssueCode = "SharedDictionaryIssue"
+	AuditsInspectorIssueCodeElementAccessibilityIssue         AuditsInspectorIssueCode = "ElementAccessibilityIssue"
+	AuditsInspectorIssueCodeSRIMessageSignatureIssue          AuditsInspectorIssueCode = "SRIMessageSignatureIssue"
+	AuditsInspectorIssueCodeUnencodedDigestIssue              AuditsInspectorIssueCode = "UnencodedDigestIssue"
+	AuditsInspectorIssueCodeUserReidentificationIssue         AuditsInspectorIssueCode = "UserReidentificationIssue"
+)
+
+// Valid returns true if the value is one of the AuditsInspectorIssueCode enum values.
+func (e AuditsInspectorIssueCode) Valid() bool {
+	switch e {
+	case AuditsInspectorIssueCodeCookieIssue,
+		AuditsInspectorIssueCodeMixedContentIssue,
+		AuditsInspectorIssueCodeBlockedByResponseIssue,
+		AuditsInspectorIssueCodeHeavyAdIssue,
+		AuditsInspectorIssueCodeContentSecurityPolicyIssue,
+		AuditsInspectorIssueCodeSharedArrayBufferIssue,
+		AuditsInspectorIssueCodeLowTextContrastIssue,
+		AuditsInspectorIssueCodeCorsIssue,
+		AuditsInspectorIssueCodeAttributionReportingIssue,
+		AuditsInspectorIssueCodeQuirksModeIssue,
+		AuditsInspectorIssueCodePartitioningBlobURLIssue,
+		AuditsInspectorIssueCodeNavigatorUserAgentIssue,
+		AuditsInspectorIssueCodeGenericIssue,
+		AuditsInspectorIssueCodeDeprecationIssue,
+		AuditsInspectorIssueCodeClientHintIssue,
+		AuditsInspectorIssueCodeFederatedAuthRequestIssue,
+		AuditsInspectorIssueCodeBounceTrackingIssue,
+		AuditsInspectorIssueCodeCookieDeprecationMetadataIssue,
+		AuditsInspectorIssueCodeStylesheetLoadingIssue,
+		AuditsInspectorIssueCodeFederatedAuthUserInfoRequestIssue,
+		AuditsInspectorIssueCodePropertyRuleIssue,
+		AuditsInspectorIssueCodeSharedDictionaryIssue,
+		AuditsInspectorIssueCodeElementAccessibilityIssue,
+		AuditsInspectorIssueCodeSRIMessageSignatureIssue,
+		AuditsInspectorIssueCodeUnencodedDigestIssue,
+		AuditsInspectorIssueCodeUserReidentificationIssue:
+		return true
+	}
+	return false
+}
+
+// The encoding to use.
+type AuditsGetEncodedResponseEncoding string
+
+const (
+	AuditsGetEncodedResponseEncodingWebp AuditsGetEncodedResponseEncoding = "webp"
+	AuditsGetEncodedResponseEncodingJpeg AuditsGetEncodedResponseEncoding = "jpeg"
+	AuditsGetEncodedResponseEncodingPng  AuditsGetEncodedResponseEncoding = "png"
+)
+
+// Valid returns true if the value is one of the AuditsGetEncodedResponseEncoding enum values.
+func (e AuditsGetEncodedResponseEncoding) Valid() bool {
+	switch e {
+	case AuditsGetEncodedResponseEncodingWebp,
+		AuditsGetEncodedResponseEncodingJpeg,
+		AuditsGetEncodedResponseEncodingPng:
+		return true
+	}
+	return false
+}
+
 // Information about a cookie that is affected by an inspector issue.
 type AuditsAffectedCookie struct {
 	Name   string `json:"name"`   // The following three properties uniquely identify a cookie
@@ -18,8 +917,8 @@ type AuditsAffectedCookie struct {
 
 // Information about a request that is affected by an inspector issue.
 type AuditsAffectedRequest struct {
-	RequestId string `json:"requestId"`     // The unique request id.
-	Url       string `json:"url,omitempty"` //
+	RequestId string `json:"requestId,omitempty"` // The unique request id.
+	Url       string `json:"url"`                 //
 }
 
 // Information about the frame affected by an inspector issue.
@@ -27,41 +926,48 @@ type AuditsAffectedFrame struct {
 	FrameId string `json:"frameId"` //
 }
 
+// Information about the suggested solution to a cookie issue.
+type AuditsCookieIssueInsight struct {
+	Type          AuditsInsightType `json:"type"`                    //  enum values: GitHubResource, GracePeriod, Heuristics
+	TableEntryUrl string            `json:"tableEntryUrl,omitempty"` // Link to table entry in third-party cookie migration readiness list.
+}
+
 // This information is currently necessary, as the front-end has a difficult time finding a specific cookie. With this, we can convey specific error information without the cookie.
 type AuditsCookieIssueDetails struct {
-	Cookie                 *AuditsAffectedCookie  `json:"cookie,omitempty"`         // If AffectedCookie is not set then rawCookieLine contains the raw Set-Cookie header string. This hints at a problem where the cookie line is syntactically or semantically malformed in a way that no valid cookie could be created.
-	RawCookieLine          string                 `json:"rawCookieLine,omitempty"`  //
-	CookieWarningReasons   []string               `json:"cookieWarningReasons"`     //  enum values: WarnSameSiteUnspecifiedCrossSiteContext, WarnSameSiteNoneInsecure, WarnSameSiteUnspecifiedLaxAllowUnsafe, WarnSameSiteStrictLaxDowngradeStrict, WarnSameSiteStrictCrossDowngradeStrict, WarnSameSiteStrictCrossDowngradeLax, WarnSameSiteLaxCrossDowngradeStrict, WarnSameSiteLaxCrossDowngradeLax, WarnAttributeValueExceedsMaxSize, WarnDomainNonASCII
-	CookieExclusionReasons []string               `json:"cookieExclusionReasons"`   //  enum values: ExcludeSameSiteUnspecifiedTreatedAsLax, ExcludeSameSiteNoneInsecure, ExcludeSameSiteLax, ExcludeSameSiteStrict, ExcludeInvalidSameParty, ExcludeSamePartyCrossPartyContext, ExcludeDomainNonASCII, ExcludeThirdPartyCookieBlockedInFirstPartySet
-	Operation              string                 `json:"operation"`                // Optionally identifies the site-for-cookies and the cookie url, which may be used by the front-end as additional context. enum values: SetCookie, ReadCookie
-	SiteForCookies         string                 `json:"siteForCookies,omitempty"` //
-	CookieUrl              string                 `json:"cookieUrl,omitempty"`      //
-	Request                *AuditsAffectedRequest `json:"request,omitempty"`        //
+	Cookie                 *AuditsAffectedCookie         `json:"cookie,omitempty"`         // If AffectedCookie is not set then rawCookieLine contains the raw Set-Cookie header string. This hints at a problem where the cookie line is syntactically or semantically malformed in a way that no valid cookie could be created.
+	RawCookieLine          string                        `json:"rawCookieLine,omitempty"`  //
+	CookieWarningReasons   []AuditsCookieWarningReason   `json:"cookieWarningReasons"`     //  enum values: WarnSameSiteUnspecifiedCrossSiteContext, WarnSameSiteNoneInsecure, WarnSameSiteUnspecifiedLaxAllowUnsafe, WarnSameSiteStrictLaxDowngradeStrict, WarnSameSiteStrictCrossDowngradeStrict, WarnSameSiteStrictCrossDowngradeLax, WarnSameSiteLaxCrossDowngradeStrict, WarnSameSiteLaxCrossDowngradeLax, WarnAttributeValueExceedsMaxSize, WarnDomainNonASCII, WarnThirdPartyPhaseout, WarnCrossSiteRedirectDowngradeChangesInclusion, WarnDeprecationTrialMetadata, WarnThirdPartyCookieHeuristic
+	CookieExclusionReasons []AuditsCookieExclusionReason `json:"cookieExclusionReasons"`   //  enum values: ExcludeSameSiteUnspecifiedTreatedAsLax, ExcludeSameSiteNoneInsecure, ExcludeSameSiteLax, ExcludeSameSiteStrict, ExcludeInvalidSameParty, ExcludeSamePartyCrossPartyContext, ExcludeDomainNonASCII, ExcludeThirdPartyCookieBlockedInFirstPartySet, ExcludeThirdPartyPhaseout, ExcludePortMismatch, ExcludeSchemeMismatch
+	Operation              AuditsCookieOperation         `json:"operation"`                // Optionally identifies the site-for-cookies and the cookie url, which may be used by the front-end as additional context. enum values: SetCookie, ReadCookie
+	SiteForCookies         string                        `json:"siteForCookies,omitempty"` //
+	CookieUrl              string                        `json:"cookieUrl,omitempty"`      //
+	Request                *AuditsAffectedRequest        `json:"request,omitempty"`        //
+	Insight                *AuditsCookieIssueInsight     `json:"insight,omitempty"`        // The recommended solution to the issue.
 }
 
 // No Description.
 type AuditsMixedContentIssueDetails struct {
-	ResourceType     string                 `json:"resourceType,omitempty"` // The type of resource causing the mixed content issue (css, js, iframe, form,...). Marked as optional because it is mapped to from blink::mojom::RequestContextType, which will be replaced by network::mojom::RequestDestination enum values: AttributionSrc, Audio, Beacon, CSPReport, Download, EventSource, Favicon, Font, Form, Frame, Image, Import, Manifest, Ping, PluginData, PluginResource, Prefetch, Resource, Script, ServiceWorker, SharedWorker, Stylesheet, Track, Video, Worker, XMLHttpRequest, XSLT
-	ResolutionStatus string                 `json:"resolutionStatus"`       // The way the mixed content issue is being resolved. enum values: MixedContentBlocked, MixedContentAutomaticallyUpgraded, MixedContentWarning
-	InsecureURL      string                 `json:"insecureURL"`            // The unsafe http url causing the mixed content issue.
-	MainResourceURL  string                 `json:"mainResourceURL"`        // The url responsible for the call to an unsafe url.
-	Request          *AuditsAffectedRequest `json:"request,omitempty"`      // The mixed content request. Does not always exist (e.g. for unsafe form submission urls).
-	Frame            *AuditsAffectedFrame   `json:"frame,omitempty"`        // Optional because not every mixed content issue is necessarily linked to a frame.
+	ResourceType     AuditsMixedContentResourceType     `json:"resourceType,omitempty"` // The type of resource causing the mixed content issue (css, js, iframe, form,...). Marked as optional because it is mapped to from blink::mojom::RequestContextType, which will be replaced by network::mojom::RequestDestination enum values: AttributionSrc, Audio, Beacon, CSPReport, Download, EventSource, Favicon, Font, Form, Frame, Image, Import, JSON, Manifest, Ping, PluginData, PluginResource, Prefetch, Resource, Script, ServiceWorker, SharedWorker, SpeculationRules, Stylesheet, Track, Video, Worker, XMLHttpRequest, XSLT
+	ResolutionStatus AuditsMixedContentResolutionStatus `json:"resolutionStatus"`       // The way the mixed content issue is being resolved. enum values: MixedContentBlocked, MixedContentAutomaticallyUpgraded, MixedContentWarning
+	InsecureURL      string                             `json:"insecureURL"`            // The unsafe http url causing the mixed content issue.
+	MainResourceURL  string                             `json:"mainResourceURL"`        // The url responsible for the call to an unsafe url.
+	Request          *AuditsAffectedRequest             `json:"request,omitempty"`      // The mixed content request. Does not always exist (e.g. for unsafe form submission urls).
+	Frame            *AuditsAffectedFrame               `json:"frame,omitempty"`        // Optional because not every mixed content issue is necessarily linked to a frame.
 }
 
 // Details for a request that has been blocked with the BLOCKED_BY_RESPONSE code. Currently only used for COEP/COOP, but may be extended to include some CSP errors in the future.
 type AuditsBlockedByResponseIssueDetails struct {
-	Request      *AuditsAffectedRequest `json:"request"`                //
-	ParentFrame  *AuditsAffectedFrame   `json:"parentFrame,omitempty"`  //
-	BlockedFrame *AuditsAffectedFrame   `json:"blockedFrame,omitempty"` //
-	Reason       string                 `json:"reason"`                 //  enum values: CoepFrameResourceNeedsCoepHeader, CoopSandboxedIFrameCannotNavigateToCoopPage, CorpNotSameOrigin, CorpNotSameOriginAfterDefaultedToSameOriginByCoep, CorpNotSameSite
+	Request      *AuditsAffectedRequest        `json:"request"`                //
+	ParentFrame  *AuditsAffectedFrame          `json:"parentFrame,omitempty"`  //
+	BlockedFrame *AuditsAffectedFrame          `json:"blockedFrame,omitempty"` //
+	Reason       AuditsBlockedByResponseReason `json:"reason"`                 //  enum values: CoepFrameResourceNeedsCoepHeader, CoopSandboxedIFrameCannotNavigateToCoopPage, CorpNotSameOrigin, CorpNotSameOriginAfterDefaultedToSameOriginByCoep, CorpNotSameOriginAfterDefaultedToSameOriginByDip, CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip, CorpNotSameSite, SRIMessageSignatureMismatch
 }
 
 // No Description.
 type AuditsHeavyAdIssueDetails struct {
-	Resolution string               `json:"resolution"` // The resolution status, either blocking the content or warning. enum values: HeavyAdBlocked, HeavyAdWarning
-	Reason     string               `json:"reason"`     // The reason the ad was blocked, total network or cpu or peak cpu. enum values: NetworkTotalLimit, CpuTotalLimit, CpuPeakLimit
-	Frame      *AuditsAffectedFrame `json:"frame"`      // The frame that was blocked.
+	Resolution AuditsHeavyAdResolutionStatus `json:"resolution"` // The resolution status, either blocking the content or warning. enum values: HeavyAdBlocked, HeavyAdWarning
+	Reason     AuditsHeavyAdReason           `json:"reason"`     // The reason the ad was blocked, total network or cpu or peak cpu. enum values: NetworkTotalLimit, CpuTotalLimit, CpuPeakLimit
+	Frame      *AuditsAffectedFrame          `json:"frame"`      // The frame that was blocked.
 }
 
 // No Description.
@@ -74,29 +980,20 @@ type AuditsSourceCodeLocation struct {
 
 // No Description.
 type AuditsContentSecurityPolicyIssueDetails struct {
-	BlockedURL                         string                    `json:"blockedURL,omitempty"`               // The url not included in allowed sources.
-	ViolatedDirective                  string                    `json:"violatedDirective"`                  // Specific directive that is violated, causing the CSP issue.
-	IsReportOnly                       bool                      `json:"isReportOnly"`                       //
-	ContentSecurityPolicyViolationType string                    `json:"contentSecurityPolicyViolationType"` //  enum values: kInlineViolation, kEvalViolation, kURLViolation, kTrustedTypesSinkViolation, kTrustedTypesPolicyViolation, kWasmEvalViolation
-	FrameAncestor                      *AuditsAffectedFrame      `json:"frameAncestor,omitempty"`            //
-	SourceCodeLocation                 *AuditsSourceCodeLocation `json:"sourceCodeLocation,omitempty"`       //
-	ViolatingNodeId                    int                       `json:"violatingNodeId,omitempty"`          //
+	BlockedURL                         string                                   `json:"blockedURL,omitempty"`               // The url not included in allowed sources.
+	ViolatedDirective                  string                                   `json:"violatedDirective"`                  // Specific directive that is violated, causing the CSP issue.
+	IsReportOnly                       bool                                     `json:"isReportOnly"`                       //
+	ContentSecurityPolicyViolationType AuditsContentSecurityPolicyViolationType `json:"contentSecurityPolicyViolationType"` //  enum values: kInlineViolation, kEvalViolation, kURLViolation, kSRIViolation, kTrustedTypesSinkViolation, kTrustedTypesPolicyViolation, kWasmEvalViolation
+	FrameAncestor                      *AuditsAffectedFrame                     `json:"frameAncestor,omitempty"`            //
+	SourceCodeLocation                 *AuditsSourceCodeLocation                `json:"sourceCodeLocation,omitempty"`       //
+	ViolatingNodeId                    int                                      `json:"violatingNodeId,omitempty"`          //
 }
 
 // Details for a issue arising from an SAB being instantiated in, or transferred to a context that is not cross-origin isolated.
 type AuditsSharedArrayBufferIssueDetails struct {
-	SourceCodeLocation *AuditsSourceCodeLocation `json:"sourceCodeLocation"` //
-	IsWarning          bool                      `json:"isWarning"`          //
-	Type               string                    `json:"type"`               //  enum values: TransferIssue, CreationIssue
-}
-
-// No Description.
-type AuditsTrustedWebActivityIssueDetails struct {
-	Url            string `json:"url"`                      // The url that triggers the violation.
-	ViolationType  string `json:"violationType"`            //  enum values: kHttpError, kUnavailableOffline, kDigitalAssetLinks
-	HttpStatusCode int    `json:"httpStatusCode,omitempty"` //
-	PackageName    string `json:"packageName,omitempty"`    // The package name of the Trusted Web Activity client app. This field is only used when violation type is kDigitalAssetLinks.
-	Signature      string `json:"signature,omitempty"`      // The signature of the Trusted Web Activity client app. This field is only used when violation type is kDigitalAssetLinks.
+	SourceCodeLocation *AuditsSourceCodeLocation        `json:"sourceCodeLocation"` //
+	IsWarning          bool                             `json:"isWarning"`          //
+	Type               AuditsSharedArrayBufferIssueType `json:"type"`               //  enum values: TransferIssue, CreationIssue
 }
 
 // No Description.
@@ -117,16 +1014,16 @@ type AuditsCorsIssueDetails struct {
 	Request                *AuditsAffectedRequest      `json:"request"`                          //
 	Location               *AuditsSourceCodeLocation   `json:"location,omitempty"`               //
 	InitiatorOrigin        string                      `json:"initiatorOrigin,omitempty"`        //
-	ResourceIPAddressSpace string                      `json:"resourceIPAddressSpace,omitempty"` //  enum values: Local, Private, Public, Unknown
+	ResourceIPAddressSpace NetworkIPAddressSpace       `json:"resourceIPAddressSpace,omitempty"` //  enum values: Loopback, Local, Public, Unknown
 	ClientSecurityState    *NetworkClientSecurityState `json:"clientSecurityState,omitempty"`    //
 }
 
 // Details for issues around "Attribution Reporting API" usage. Explainer: https://github.com/WICG/attribution-reporting-api
 type AuditsAttributionReportingIssueDetails struct {
-	ViolationType    string                 `json:"violationType"`              //  enum values: PermissionPolicyDisabled, UntrustworthyReportingOrigin, InsecureContext, InvalidHeader, InvalidRegisterTriggerHeader, InvalidEligibleHeader, SourceAndTriggerHeaders, SourceIgnored, TriggerIgnored, OsSourceIgnored, OsTriggerIgnored, InvalidRegisterOsSourceHeader, InvalidRegisterOsTriggerHeader, WebAndOsHeaders, NoWebOrOsSupport
-	Request          *AuditsAffectedRequest `json:"request,omitempty"`          //
-	ViolatingNodeId  int                    `json:"violatingNodeId,omitempty"`  //
-	InvalidParameter string                 `json:"invalidParameter,omitempty"` //
+	ViolationType    AuditsAttributionReportingIssueType `json:"violationType"`              //  enum values: PermissionPolicyDisabled, UntrustworthyReportingOrigin, InsecureContext, InvalidHeader, InvalidRegisterTriggerHeader, SourceAndTriggerHeaders, SourceIgnored, TriggerIgnored, OsSourceIgnored, OsTriggerIgnored, InvalidRegisterOsSourceHeader, InvalidRegisterOsTriggerHeader, WebAndOsHeaders, NoWebOrOsSupport, NavigationRegistrationWithoutTransientUserActivation, InvalidInfoHeader, NoRegisterSourceHeader, NoRegisterTriggerHeader, NoRegisterOsSourceHeader, NoRegisterOsTriggerHeader, NavigationRegistrationUniqueScopeAlreadySet
+	Request          *AuditsAffectedRequest              `json:"request,omitempty"`          //
+	ViolatingNodeId  int                                 `json:"violatingNodeId,omitempty"`  //
+	InvalidParameter string                              `json:"invalidParameter,omitempty"` //
 }
 
 // Details for issues about documents in Quirks Mode or Limited Quirks Mode that affects page layouting.
@@ -139,17 +1036,40 @@ type AuditsQuirksModeIssueDetails struct {
 }
 
 // No Description.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type AuditsNavigatorUserAgentIssueDetails struct {
 	Url      string                    `json:"url"`                //
 	Location *AuditsSourceCodeLocation `json:"location,omitempty"` //
 }
 
+// No Description.
+type AuditsSharedDictionaryIssueDetails struct {
+	SharedDictionaryError AuditsSharedDictionaryError `json:"sharedDictionaryError"` //  enum values: UseErrorCrossOriginNoCorsRequest, UseErrorDictionaryLoadFailure, UseErrorMatchingDictionaryNotUsed, UseErrorUnexpectedContentDictionaryHeader, WriteErrorCossOriginNoCorsRequest, WriteErrorDisallowedBySettings, WriteErrorExpiredResponse, WriteErrorFeatureDisabled, WriteErrorInsufficientResources, WriteErrorInvalidMatchField, WriteErrorInvalidStructuredHeader, WriteErrorNavigationRequest, WriteErrorNoMatchField, WriteErrorNonListMatchDestField, WriteErrorNonSecureContext, WriteErrorNonStringIdField, WriteErrorNonStringInMatchDestList, WriteErrorNonStringMatchField, WriteErrorNonTokenTypeField, WriteErrorRequestAborted, WriteErrorShuttingDown, WriteErrorTooLongIdField, WriteErrorUnsupportedType
+	Request               *AuditsAffectedRequest      `json:"request"`               //
+}
+
+// No Description.
+type AuditsSRIMessageSignatureIssueDetails struct {
+	Error               AuditsSRIMessageSignatureError `json:"error"`               //  enum values: MissingSignatureHeader, MissingSignatureInputHeader, InvalidSignatureHeader, InvalidSignatureInputHeader, SignatureHeaderValueIsNotByteSequence, SignatureHeaderValueIsParameterized, SignatureHeaderValueIsIncorrectLength, SignatureInputHeaderMissingLabel, SignatureInputHeaderValueNotInnerList, SignatureInputHeaderValueMissingComponents, SignatureInputHeaderInvalidComponentType, SignatureInputHeaderInvalidComponentName, SignatureInputHeaderInvalidHeaderComponentParameter, SignatureInputHeaderInvalidDerivedComponentParameter, SignatureInputHeaderKeyIdLength, SignatureInputHeaderInvalidParameter, SignatureInputHeaderMissingRequiredParameters, ValidationFailedSignatureExpired, ValidationFailedInvalidLength, ValidationFailedSignatureMismatch, ValidationFailedIntegrityMismatch
+	SignatureBase       string                         `json:"signatureBase"`       //
+	IntegrityAssertions []string                       `json:"integrityAssertions"` //
+	Request             *AuditsAffectedRequest         `json:"request"`             //
+}
+
+// No Description.
+type AuditsUnencodedDigestIssueDetails struct {
+	Error   AuditsUnencodedDigestError `json:"error"`   //  enum values: MalformedDictionary, UnknownAlgorithm, IncorrectDigestType, IncorrectDigestLength
+	Request *AuditsAffectedRequest     `json:"request"` //
+}
+
 // Depending on the concrete errorType, different properties are set.
 type AuditsGenericIssueDetails struct {
-	ErrorType              string `json:"errorType"`                        // Issues with the same errorType are aggregated in the frontend. enum values: CrossOriginPortalPostMessageError, FormLabelForNameError, FormDuplicateIdForInputError, FormInputWithNoLabelError, FormAutocompleteAttributeEmptyError, FormEmptyIdAndNameAttributesForInputError, FormAriaLabelledByToNonExistingId, FormInputAssignedAutocompleteValueToIdOrNameAttributeError, FormLabelHasNeitherForNorNestedInput, FormLabelForMatchesNonExistingIdError, FormInputHasWrongButWellIntendedAutocompleteValueError
-	FrameId                string `json:"frameId,omitempty"`                //
-	ViolatingNodeId        int    `json:"violatingNodeId,omitempty"`        //
-	ViolatingNodeAttribute string `json:"violatingNodeAttribute,omitempty"` //
+	ErrorType              AuditsGenericIssueErrorType `json:"errorType"`                        // Issues with the same errorType are aggregated in the frontend. enum values: FormLabelForNameError, FormDuplicateIdForInputError, FormInputWithNoLabelError, FormAutocompleteAttributeEmptyError, FormEmptyIdAndNameAttributesForInputError, FormAriaLabelledByToNonExistingId, FormInputAssignedAutocompleteValueToIdOrNameAttributeError, FormLabelHasNeitherForNorNestedInput, FormLabelForMatchesNonExistingIdError, FormInputHasWrongButWellIntendedAutocompleteValueError, ResponseWasBlockedByORB
+	FrameId                string                      `json:"frameId,omitempty"`                //
+	ViolatingNodeId        int                         `json:"violatingNodeId,omitempty"`        //
+	ViolatingNodeAttribute string                      `json:"violatingNodeAttribute,omitempty"` //
+	Request                *AuditsAffectedRequest      `json:"request,omitempty"`                //
 }
 
 // This issue tracks information needed to print a deprecation message. https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/frame/third_party/blink/renderer/core/frame/deprecation/README.md
@@ -164,15 +1084,68 @@ type AuditsBounceTrackingIssueDetails struct {
 	TrackingSites []string `json:"trackingSites"` //
 }
 
+// This issue warns about third-party sites that are accessing cookies on the current page, and have been permitted due to having a global metadata grant. Note that in this context 'site' means eTLD+1. For example, if the URL `https://example.test:80/web_page` was accessing cookies, the site reported would be `example.test`.
+type AuditsCookieDeprecationMetadataIssueDetails struct {
+	AllowedSites     []string              `json:"allowedSites"`     //
+	OptOutPercentage float64               `json:"optOutPercentage"` //
+	IsOptOutTopLevel bool                  `json:"isOptOutTopLevel"` //
+	Operation        AuditsCookieOperation `json:"operation"`        //  enum values: SetCookie, ReadCookie
+}
+
 // No Description.
 type AuditsFederatedAuthRequestIssueDetails struct {
-	FederatedAuthRequestIssueReason string `json:"federatedAuthRequestIssueReason"` //  enum values: ShouldEmbargo, TooManyRequests, WellKnownHttpNotFound, WellKnownNoResponse, WellKnownInvalidResponse, WellKnownListEmpty, WellKnownInvalidContentType, ConfigNotInWellKnown, WellKnownTooBig, ConfigHttpNotFound, ConfigNoResponse, ConfigInvalidResponse, ConfigInvalidContentType, ClientMetadataHttpNotFound, ClientMetadataNoResponse, ClientMetadataInvalidResponse, ClientMetadataInvalidContentType, DisabledInSettings, ErrorFetchingSignin, InvalidSigninResponse, AccountsHttpNotFound, AccountsNoResponse, AccountsInvalidResponse, AccountsListEmpty, AccountsInvalidContentType, IdTokenHttpNotFound, IdTokenNoResponse, IdTokenInvalidResponse, IdTokenInvalidRequest, IdTokenInvalidContentType, ErrorIdToken, Canceled, RpPageNotVisible
+	FederatedAuthRequestIssueReason AuditsFederatedAuthRequestIssueReason `json:"federatedAuthRequestIssueReason"` //  enum values: ShouldEmbargo, TooManyRequests, WellKnownHttpNotFound, WellKnownNoResponse, WellKnownInvalidResponse, WellKnownListEmpty, WellKnownInvalidContentType, ConfigNotInWellKnown, WellKnownTooBig, ConfigHttpNotFound, ConfigNoResponse, ConfigInvalidResponse, ConfigInvalidContentType, ClientMetadataHttpNotFound, ClientMetadataNoResponse, ClientMetadataInvalidResponse, ClientMetadataInvalidContentType, IdpNotPotentiallyTrustworthy, DisabledInSettings, DisabledInFlags, ErrorFetchingSignin, InvalidSigninResponse, AccountsHttpNotFound, AccountsNoResponse, AccountsInvalidResponse, AccountsListEmpty, AccountsInvalidContentType, IdTokenHttpNotFound, IdTokenNoResponse, IdTokenInvalidResponse, IdTokenIdpErrorResponse, IdTokenCrossSiteIdpErrorResponse, IdTokenInvalidRequest, IdTokenInvalidContentType, ErrorIdToken, Canceled, RpPageNotVisible, SilentMediationFailure, ThirdPartyCookiesBlocked, NotSignedInWithIdp, MissingTransientUserActivation, ReplacedByActiveMode, InvalidFieldsSpecified, RelyingPartyOriginIsOpaque, TypeNotMatching, UiDismissedNoEmbargo, CorsError, SuppressedBySegmentationPlatform
+}
+
+// No Description.
+type AuditsFederatedAuthUserInfoRequestIssueDetails struct {
+	FederatedAuthUserInfoRequestIssueReason AuditsFederatedAuthUserInfoRequestIssueReason `json:"federatedAuthUserInfoRequestIssueReason"` //  enum values: NotSameOrigin, NotIframe, NotPotentiallyTrustworthy, NoApiPermission, NotSignedInWithIdp, NoAccountSharingPermission, InvalidConfigOrWellKnown, InvalidAccountsResponse, NoReturningUserFromFetchedAccounts
 }
 
 // This issue tracks client hints related issues. It's used to deprecate old features, encourage the use of new ones, and provide general guidance.
 type AuditsClientHintIssueDetails struct {
-	SourceCodeLocation    *AuditsSourceCodeLocation `json:"sourceCodeLocation"`    //
-	ClientHintIssueReason string                    `json:"clientHintIssueReason"` //  enum values: MetaTagAllowListInvalidOrigin, MetaTagModifiedHTML
+	SourceCodeLocation    *AuditsSourceCodeLocation   `json:"sourceCodeLocation"`    //
+	ClientHintIssueReason AuditsClientHintIssueReason `json:"clientHintIssueReason"` //  enum values: MetaTagAllowListInvalidOrigin, MetaTagModifiedHTML
+}
+
+// No Description.
+type AuditsFailedRequestInfo struct {
+	Url            string `json:"url"`                 // The URL that failed to load.
+	FailureMessage string `json:"failureMessage"`      // The failure message for the failed request.
+	RequestId      string `json:"requestId,omitempty"` //
+}
+
+// No Description.
+type AuditsPartitioningBlobURLIssueDetails struct {
+	Url                     string                        `json:"url"`                     // The BlobURL that failed to load.
+	PartitioningBlobURLInfo AuditsPartitioningBlobURLInfo `json:"partitioningBlobURLInfo"` // Additional information about the Partitioning Blob URL issue. enum values: BlockedCrossPartitionFetching, EnforceNoopenerForNavigation
+}
+
+// This issue warns about errors in the select or summary element content model.
+type AuditsElementAccessibilityIssueDetails struct {
+	NodeId                          int                                   `json:"nodeId"`                          //
+	ElementAccessibilityIssueReason AuditsElementAccessibilityIssueReason `json:"elementAccessibilityIssueReason"` //  enum values: DisallowedSelectChild, DisallowedOptGroupChild, NonPhrasingContentOptionChild, InteractiveContentOptionChild, InteractiveContentLegendChild, InteractiveContentSummaryDescendant
+	HasDisallowedAttributes         bool                                  `json:"hasDisallowedAttributes"`         //
+}
+
+// This issue warns when a referenced stylesheet couldn't be loaded.
+type AuditsStylesheetLoadingIssueDetails struct {
+	SourceCodeLocation           *AuditsSourceCodeLocation          `json:"sourceCodeLocation"`           // Source code position that referenced the failing stylesheet.
+	StyleSheetLoadingIssueReason AuditsStyleSheetLoadingIssueReason `json:"styleSheetLoadingIssueReason"` // Reason why the stylesheet couldn't be loaded. enum values: LateImportRule, RequestFailed
+	FailedRequestInfo            *AuditsFailedRequestInfo           `json:"failedRequestInfo,omitempty"`  // Contains additional info when the failure was due to a request.
+}
+
+// This issue warns about errors in property rules that lead to property registrations being ignored.
+type AuditsPropertyRuleIssueDetails struct {
+	SourceCodeLocation      *AuditsSourceCodeLocation     `json:"sourceCodeLocation"`      // Source code position of the property rule.
+	PropertyRuleIssueReason AuditsPropertyRuleIssueReason `json:"propertyRuleIssueReason"` // Reason why the property rule was discarded. enum values: InvalidSyntax, InvalidInitialValue, InvalidInherits, InvalidName
+	PropertyValue           string                        `json:"propertyValue,omitempty"` // The value of the property rule property that failed to parse
+}
+
+// This issue warns about uses of APIs that may be considered misuse to re-identify users.
+type AuditsUserReidentificationIssueDetails struct {
+	Type    AuditsUserReidentificationIssueType `json:"type"`              //  enum values: BlockedFrameNavigation, BlockedSubresource
+	Request *AuditsAffectedRequest              `json:"request,omitempty"` // Applies to BlockedFrameNavigation and BlockedSubresource issue types.
 }
 
 // This struct holds a list of optional fields with additional information specific to the kind of issue. When adding a new issue code, please also add a new optional field to this type.
@@ -183,26 +1156,46 @@ type AuditsInspectorIssueDetails struct {
 	HeavyAdIssueDetails               *AuditsHeavyAdIssueDetails               `json:"heavyAdIssueDetails,omitempty"`               //
 	ContentSecurityPolicyIssueDetails *AuditsContentSecurityPolicyIssueDetails `json:"contentSecurityPolicyIssueDetails,omitempty"` //
 	SharedArrayBufferIssueDetails     *AuditsSharedArrayBufferIssueDetails     `json:"sharedArrayBufferIssueDetails,omitempty"`     //
-	TwaQualityEnforcementDetails      *AuditsTrustedWebActivityIssueDetails    `json:"twaQualityEnforcementDetails,omitempty"`      //
 	LowTextContrastIssueDetails       *AuditsLowTextContrastIssueDetails       `json:"lowTextContrastIssueDetails,omitempty"`       //
 	CorsIssueDetails                  *AuditsCorsIssueDetails                  `json:"corsIssueDetails,omitempty"`                  //
 	AttributionReportingIssueDetails  *AuditsAttributionReportingIssueDetails  `json:"attributionReportingIssueDetails,omitempty"`  //
 	QuirksModeIssueDetails            *AuditsQuirksModeIssueDetails            `json:"quirksModeIssueDetails,omitempty"`            //
-	NavigatorUserAgentIssueDetails    *AuditsNavigatorUserAgentIssueDetails    `json:"navigatorUserAgentIssueDetails,omitempty"`    //
-	GenericIssueDetails               *AuditsGenericIssueDetails               `json:"genericIssueDetails,omitempty"`               //
-	DeprecationIssueDetails           *AuditsDeprecationIssueDetails           `json:"deprecationIssueDetails,omitempty"`           //
-	ClientHintIssueDetails            *AuditsClientHintIssueDetails            `json:"clientHintIssueDetails,omitempty"`            //
-	FederatedAuthRequestIssueDetails  *AuditsFederatedAuthRequestIssueDetails  `json:"federatedAuthRequestIssueDetails,omitempty"`  //
-	BounceTrackingIssueDetails        *AuditsBounceTrackingIssueDetails        `json:"bounceTrackingIssueDetails,omitempty"`        //
+	PartitioningBlobURLIssueDetails   *AuditsPartitioningBlobURLIssueDetails   `json:"partitioningBlobURLIssueDetails,omitempty"`   //
+	// Deprecated: this field is deprecated in the protocol.
+	NavigatorUserAgentIssueDetails           *AuditsNavigatorUserAgentIssueDetails           `json:"navigatorUserAgentIssueDetails,omitempty"`           //
+	GenericIssueDetails                      *AuditsGenericIssueDetails                      `json:"genericIssueDetails,omitempty"`                      //
+	DeprecationIssueDetails                  *AuditsDeprecationIssueDetails                  `json:"deprecationIssueDetails,omitempty"`                  //
+	ClientHintIssueDetails                   *AuditsClientHintIssueDetails                   `json:"clientHintIssueDetails,omitempty"`                   //
+	FederatedAuthRequestIssueDetails         *AuditsFederatedAuthRequestIssueDetails         `json:"federatedAuthRequestIssueDetails,omitempty"`         //
+	BounceTrackingIssueDetails               *AuditsBounceTrackingIssueDetails               `json:"bounceTrackingIssueDetails,omitempty"`               //
+	CookieDeprecationMetadataIssueDetails    *AuditsCookieDeprecationMetadataIssueDetails    `json:"cookieDeprecationMetadataIssueDetails,omitempty"`    //
+	StylesheetLoadingIssueDetails            *AuditsStylesheetLoadingIssueDetails            `json:"stylesheetLoadingIssueDetails,omitempty"`            //
+	PropertyRuleIssueDetails                 *AuditsPropertyRuleIssueDetails                 `json:"propertyRuleIssueDetails,omitempty"`                 //
+	FederatedAuthUserInfoRequestIssueDetails *AuditsFederatedAuthUserInfoRequestIssueDetails `json:"federatedAuthUserInfoRequestIssueDetails,omitempty"` //
+	SharedDictionaryIssueDetails             *AuditsSharedDictionaryIssueDetails             `json:"sharedDictionaryIssueDetails,omitempty"`             //
+	ElementAccessibilityIssueDetails         *AuditsElementAccessibilityIssueDetails         `json:"elementAccessibilityIssueDetails,omitempty"`         //
+	SriMessageSignatureIssueDetails          *AuditsSRIMessageSignatureIssueDetails          `json:"sriMessageSignatureIssueDetails,omitempty"`          //
+	UnencodedDigestIssueDetails              *AuditsUnencodedDigestIssueDetails              `json:"unencodedDigestIssueDetails,omitempty"`              //
+	UserReidentificationIssueDetails         *AuditsUserReidentificationIssueDetails         `json:"userReidentificationIssueDetails,omitempty"`         //
 }
 
 // An inspector issue reported from the back-end.
 type AuditsInspectorIssue struct {
-	Code    string                       `json:"code"`              //  enum values: CookieIssue, MixedContentIssue, BlockedByResponseIssue, HeavyAdIssue, ContentSecurityPolicyIssue, SharedArrayBufferIssue, TrustedWebActivityIssue, LowTextContrastIssue, CorsIssue, AttributionReportingIssue, QuirksModeIssue, NavigatorUserAgentIssue, GenericIssue, DeprecationIssue, ClientHintIssue, FederatedAuthRequestIssue, BounceTrackingIssue
+	Code    AuditsInspectorIssueCode     `json:"code"`              //  enum values: CookieIssue, MixedContentIssue, BlockedByResponseIssue, HeavyAdIssue, ContentSecurityPolicyIssue, SharedArrayBufferIssue, LowTextContrastIssue, CorsIssue, AttributionReportingIssue, QuirksModeIssue, PartitioningBlobURLIssue, NavigatorUserAgentIssue, GenericIssue, DeprecationIssue, ClientHintIssue, FederatedAuthRequestIssue, BounceTrackingIssue, CookieDeprecationMetadataIssue, StylesheetLoadingIssue, FederatedAuthUserInfoRequestIssue, PropertyRuleIssue, SharedDictionaryIssue, ElementAccessibilityIssue, SRIMessageSignatureIssue, UnencodedDigestIssue, UserReidentificationIssue
 	Details *AuditsInspectorIssueDetails `json:"details"`           //
 	IssueId string                       `json:"issueId,omitempty"` // A unique id for this issue. May be omitted if no other entity (e.g. exception, CDP message, etc.) is referencing this issue.
 }
 
+// Event method names for the Audits domain
+const (
+	EventAuditsIssueAdded = "Audits.issueAdded"
+)
+
+// AuditsEvents lists all event method names for the Audits domain
+var AuditsEvents = []string{
+	EventAuditsIssueAdded,
+}
+
 type AuditsIssueAddedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -210,6 +1203,9 @@ type AuditsIssueAddedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Audits domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type Audits struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -218,89 +1214,3 @@ func NewAudits(target gcdmessage.ChromeTargeter) *Audits {
 	c := &Audits{target: target}
 	return c
 }
-
-type AuditsGetEncodedResponseParams struct {
-	// Identifier of the network request to get content for.
-	RequestId string `json:"requestId"`
-	// The encoding to use.
-	Encoding string `json:"encoding"`
-	// The quality of the encoding (0-1). (defaults to 1)
-	Quality float64 `json:"quality,omitempty"`
-	// Whether to only return the size information (defaults to false).
-	SizeOnly bool `json:"sizeOnly,omitempty"`
-}
-
-// GetEncodedResponseWithParams - Returns the response body and size if it were re-encoded with the specified settings. Only applies to images.
-// Returns -  body - The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON) originalSize - Size before re-encoding. encodedSize - Size after re-encoding.
-func (c *Audits) GetEncodedResponseWithParams(ctx context.Context, v *AuditsGetEncodedResponseParams) (string, int, int, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.getEncodedResponse", Params: v})
-	if err != nil {
-		return "", 0, 0, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Body         string
-			OriginalSize int
-			EncodedSize  int
-		}
-	}
-
-	if resp == nil {
-		return "", 0, 0, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return "", 0, 0, err
-	}
-
-	if chromeData.Error != nil {
-		return "", 0, 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Body, chromeData.Result.OriginalSize, chromeData.Result.EncodedSize, nil
-}
-
-// GetEncodedResponse - Returns the response body and size if it were re-encoded with the specified settings. Only applies to images.
-// requestId - Identifier of the network request to get content for.
-// encoding - The encoding to use.
-// quality - The quality of the encoding (0-1). (defaults to 1)
-// sizeOnly - Whether to only return the size information (defaults to false).
-// Returns -  body - The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON) originalSize - Size before re-encoding. encodedSize - Size after re-encoding.
-func (c *Audits) GetEncodedResponse(ctx context.Context, requestId string, encoding string, quality float64, sizeOnly bool) (string, int, int, error) {
-	var v AuditsGetEncodedResponseParams
-	v.RequestId = requestId
-	v.Encoding = encoding
-	v.Quality = quality
-	v.SizeOnly = sizeOnly
-	return c.GetEncodedResponseWithParams(ctx, &v)
-}
-
-// Disables issues domain, prevents further issues from being reported to the client.
-func (c *Audits) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.disable"})
-}
-
-// Enables issues domain, sends the issues collected so far to the client by means of the `issueAdded` event.
-func (c *Audits) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.enable"})
-}
-
-type AuditsCheckContrastParams struct {
-	// Whether to report WCAG AAA level issues. Default is false.
-	ReportAAA bool `json:"reportAAA,omitempty"`
-}
-
-// CheckContrastWithParams - Runs the contrast check for the target page. Found issues are reported using Audits.issueAdded event.
-func (c *Audits) CheckContrastWithParams(ctx context.Context, v *AuditsCheckContrastParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.checkContrast", Params: v})
-}
-
-// CheckContrast - Runs the contrast check for the target page. Found issues are reported using Audits.issueAdded event.
-// reportAAA - Whether to report WCAG AAA level issues. Default is false.
-func (c *Audits) CheckContrast(ctx context.Context, reportAAA bool) (*gcdmessage.ChromeResponse, error) {
-	var v AuditsCheckContrastParams
-	v.ReportAAA = reportAAA
-	return c.CheckContrastWithParams(ctx, &v)
-}
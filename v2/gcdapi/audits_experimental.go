@@ -0,0 +1,141 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Audits functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnIssueAdded subscribes to Audits.issueAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Audits) OnIssueAdded(callback func(*AuditsIssueAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventAuditsIssueAdded, func(payload []byte) error {
+		event := &AuditsIssueAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type AuditsGetEncodedResponseParams struct {
+	// Identifier of the network request to get content for.
+	RequestId string `json:"requestId"`
+	// The encoding to use.
+	Encoding AuditsGetEncodedResponseEncoding `json:"encoding"`
+	// The quality of the encoding (0-1). (defaults to 1)
+	Quality float64 `json:"quality,omitempty"`
+	// Whether to only return the size information (defaults to false).
+	SizeOnly bool `json:"sizeOnly,omitempty"`
+}
+
+// GetEncodedResponseWithParams - Returns the response body and size if it were re-encoded with the specified settings. Only applies to images.
+// Returns -  body - The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON) originalSize - Size before re-encoding. encodedSize - Size after re-encoding.
+func (c *Audits) GetEncodedResponseWithParams(ctx context.Context, v *AuditsGetEncodedResponseParams) (string, int, int, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.getEncodedResponse", Params: v})
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Body         string
+			OriginalSize int
+			EncodedSize  int
+		}
+	}
+
+	if resp == nil {
+		return "", 0, 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", 0, 0, err
+	}
+
+	if chromeData.Error != nil {
+		return "", 0, 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Body, chromeData.Result.OriginalSize, chromeData.Result.EncodedSize, nil
+}
+
+// GetEncodedResponse - Returns the response body and size if it were re-encoded with the specified settings. Only applies to images.
+// requestId - Identifier of the network request to get content for.
+// encoding - The encoding to use.
+// quality - The quality of the encoding (0-1). (defaults to 1)
+// sizeOnly - Whether to only return the size information (defaults to false).
+// Returns -  body - The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON) originalSize - Size before re-encoding. encodedSize - Size after re-encoding.
+func (c *Audits) GetEncodedResponse(ctx context.Context, requestId string, encoding AuditsGetEncodedResponseEncoding, quality float64, sizeOnly bool) (string, int, int, error) {
+	var v AuditsGetEncodedResponseParams
+	v.RequestId = requestId
+	v.Encoding = encoding
+	v.Quality = quality
+	v.SizeOnly = sizeOnly
+	return c.GetEncodedResponseWithParams(ctx, &v)
+}
+
+// Disables issues domain, prevents further issues from being reported to the client.
+func (c *Audits) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.disable"})
+}
+
+// Enables issues domain, sends the issues collected so far to the client by means of the `issueAdded` event.
+func (c *Audits) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.enable"})
+}
+
+type AuditsCheckContrastParams struct {
+	// Whether to report WCAG AAA level issues. Default is false.
+	ReportAAA bool `json:"reportAAA,omitempty"`
+}
+
+// CheckContrastWithParams - Runs the contrast check for the target page. Found issues are reported using Audits.issueAdded event.
+func (c *Audits) CheckContrastWithParams(ctx context.Context, v *AuditsCheckContrastParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.checkContrast", Params: v})
+}
+
+// CheckContrast - Runs the contrast check for the target page. Found issues are reported using Audits.issueAdded event.
+// reportAAA - Whether to report WCAG AAA level issues. Default is false.
+func (c *Audits) CheckContrast(ctx context.Context, reportAAA bool) (*gcdmessage.ChromeResponse, error) {
+	var v AuditsCheckContrastParams
+	v.ReportAAA = reportAAA
+	return c.CheckContrastWithParams(ctx, &v)
+}
+
+// CheckFormsIssues - Runs the form issues check for the target page. Found issues are reported using Audits.issueAdded event.
+// Returns -  formIssues -
+func (c *Audits) CheckFormsIssues(ctx context.Context) ([]*AuditsGenericIssueDetails, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Audits.checkFormsIssues"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			FormIssues []*AuditsGenericIssueDetails
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.FormIssues, nil
+}
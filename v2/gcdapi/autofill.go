@@ -0,0 +1,100 @@
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Autofill functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// Specified whether a filled field was done so by using the html autocomplete attribute or autofill heuristics.
+type AutofillFillingStrategy string
+
+const (
+	AutofillFillingStrategyAutocompleteAttribute AutofillFillingStrategy = "autocompleteAttribute"
+	AutofillFillingStrategyAutofillInferred      AutofillFillingStrategy = "autofillInferred"
+)
+
+// Valid returns true if the value is one of the AutofillFillingStrategy enum values.
+func (e AutofillFillingStrategy) Valid() bool {
+	switch e {
+	case AutofillFillingStrategyAutocompleteAttribute,
+		AutofillFillingStrategyAutofillInferred:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type AutofillCreditCard struct {
+	Number      string `json:"number"`      // 16-digit credit card number.
+	Name        string `json:"name"`        // Name of the credit card owner.
+	ExpiryMonth string `json:"expiryMonth"` // 2-digit expiry month.
+	ExpiryYear  string `json:"expiryYear"`  // 4-digit expiry year.
+	Cvc         string `json:"cvc"`         // 3-digit card verification code.
+}
+
+// No Description.
+type AutofillAddressField struct {
+	Name  string `json:"name"`  // address field name, for example GIVEN_NAME.
+	Value string `json:"value"` // address field value, for example Jon Doe.
+}
+
+// A list of address fields.
+type AutofillAddressFields struct {
+	Fields []*AutofillAddressField `json:"fields"` //
+}
+
+// No Description.
+type AutofillAddress struct {
+	Fields []*AutofillAddressField `json:"fields"` // fields and values defining an address.
+}
+
+// Defines how an address can be displayed like in chrome://settings/addresses. Address UI is a two dimensional array, each inner array is an "address information line", and when rendered in a UI surface should be displayed as such. The following address UI for instance: [[{name: "GIVE_NAME", value: "Jon"}, {name: "FAMILY_NAME", value: "Doe"}], [{name: "CITY", value: "Munich"}, {name: "ZIP", value: "81456"}]] should allow the receiver to render: Jon Doe Munich 81456
+type AutofillAddressUI struct {
+	AddressFields []*AutofillAddressFields `json:"addressFields"` // A two dimension array containing the representation of values from an address profile.
+}
+
+// No Description.
+type AutofillFilledField struct {
+	HtmlType        string                  `json:"htmlType"`        // The type of the field, e.g text, password etc.
+	Id              string                  `json:"id"`              // the html id
+	Name            string                  `json:"name"`            // the html name
+	Value           string                  `json:"value"`           // the field value
+	AutofillType    string                  `json:"autofillType"`    // The actual field type, e.g FAMILY_NAME
+	FillingStrategy AutofillFillingStrategy `json:"fillingStrategy"` // The filling strategy enum values: autocompleteAttribute, autofillInferred
+	FrameId         string                  `json:"frameId"`         // The frame the field belongs to
+	FieldId         int                     `json:"fieldId"`         // The form field's DOM node
+}
+
+// Event method names for the Autofill domain
+const (
+	EventAutofillAddressFormFilled = "Autofill.addressFormFilled"
+)
+
+// AutofillEvents lists all event method names for the Autofill domain
+var AutofillEvents = []string{
+	EventAutofillAddressFormFilled,
+}
+
+// Emitted when an address form is filled.
+type AutofillAddressFormFilledEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		FilledFields []*AutofillFilledField `json:"filledFields"` // Information about the fields that were filled
+		AddressUi    *AutofillAddressUI     `json:"addressUi"`    // An UI representation of the address used to fill the form. Consists of a 2D array where each child represents an address/profile line.
+	} `json:"Params,omitempty"`
+}
+
+// Autofill domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type Autofill struct {
+	target gcdmessage.ChromeTargeter
+}
+
+func NewAutofill(target gcdmessage.ChromeTargeter) *Autofill {
+	c := &Autofill{target: target}
+	return c
+}
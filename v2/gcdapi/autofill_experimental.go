@@ -0,0 +1,79 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Autofill functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnAddressFormFilled subscribes to Autofill.addressFormFilled events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Autofill) OnAddressFormFilled(callback func(*AutofillAddressFormFilledEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventAutofillAddressFormFilled, func(payload []byte) error {
+		event := &AutofillAddressFormFilledEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type AutofillTriggerParams struct {
+	// Identifies a field that serves as an anchor for autofill.
+	FieldId int `json:"fieldId"`
+	// Identifies the frame that field belongs to.
+	FrameId string `json:"frameId,omitempty"`
+	// Credit card information to fill out the form. Credit card data is not saved.
+	Card *AutofillCreditCard `json:"card"`
+}
+
+// TriggerWithParams - Trigger autofill on a form identified by the fieldId. If the field and related form cannot be autofilled, returns an error.
+func (c *Autofill) TriggerWithParams(ctx context.Context, v *AutofillTriggerParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Autofill.trigger", Params: v})
+}
+
+// Trigger - Trigger autofill on a form identified by the fieldId. If the field and related form cannot be autofilled, returns an error.
+// fieldId - Identifies a field that serves as an anchor for autofill.
+// frameId - Identifies the frame that field belongs to.
+// card - Credit card information to fill out the form. Credit card data is not saved.
+func (c *Autofill) Trigger(ctx context.Context, fieldId int, frameId string, card *AutofillCreditCard) (*gcdmessage.ChromeResponse, error) {
+	var v AutofillTriggerParams
+	v.FieldId = fieldId
+	v.FrameId = frameId
+	v.Card = card
+	return c.TriggerWithParams(ctx, &v)
+}
+
+type AutofillSetAddressesParams struct {
+	//
+	Addresses []*AutofillAddress `json:"addresses"`
+}
+
+// SetAddressesWithParams - Set addresses so that developers can verify their forms implementation.
+func (c *Autofill) SetAddressesWithParams(ctx context.Context, v *AutofillSetAddressesParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Autofill.setAddresses", Params: v})
+}
+
+// SetAddresses - Set addresses so that developers can verify their forms implementation.
+// addresses -
+func (c *Autofill) SetAddresses(ctx context.Context, addresses []*AutofillAddress) (*gcdmessage.ChromeResponse, error) {
+	var v AutofillSetAddressesParams
+	v.Addresses = addresses
+	return c.SetAddressesWithParams(ctx, &v)
+}
+
+// Disables autofill domain notifications.
+func (c *Autofill) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Autofill.disable"})
+}
+
+// Enables autofill domain notifications.
+func (c *Autofill) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Autofill.enable"})
+}
@@ -5,10 +5,35 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// The Background Service that will be associated with the commands/events. Every Background Service operates independently, but they share the same API.
+type BackgroundServiceServiceName string
+
+const (
+	BackgroundServiceServiceNameBackgroundFetch        BackgroundServiceServiceName = "backgroundFetch"
+	BackgroundServiceServiceNameBackgroundSync         BackgroundServiceServiceName = "backgroundSync"
+	BackgroundServiceServiceNamePushMessaging          BackgroundServiceServiceName = "pushMessaging"
+	BackgroundServiceServiceNameNotifications          BackgroundServiceServiceName = "notifications"
+	BackgroundServiceServiceNamePaymentHandler         BackgroundServiceServiceName = "paymentHandler"
+	BackgroundServiceServiceNamePeriodicBackgroundSync BackgroundServiceServiceName = "periodicBackgroundSync"
+)
+
+// Valid returns true if the value is one of the BackgroundServiceServiceName enum values.
+func (e BackgroundServiceServiceName) Valid() bool {
+	switch e {
+	case BackgroundServiceServiceNameBackgroundFetch,
+		BackgroundServiceServiceNameBackgroundSync,
+		BackgroundServiceServiceNamePushMessaging,
+		BackgroundServiceServiceNameNotifications,
+		BackgroundServiceServiceNamePaymentHandler,
+		BackgroundServiceServiceNamePeriodicBackgroundSync:
+		return true
+	}
+	return false
+}
+
 // A key-value pair for additional event information to pass along.
 type BackgroundServiceEventMetadata struct {
 	Key   string `json:"key"`   //
@@ -20,19 +45,31 @@ type BackgroundServiceBackgroundServiceEvent struct {
 	Timestamp                   float64                           `json:"timestamp"`                   // Timestamp of the event (in seconds).
 	Origin                      string                            `json:"origin"`                      // The origin this event belongs to.
 	ServiceWorkerRegistrationId string                            `json:"serviceWorkerRegistrationId"` // The Service Worker ID that initiated the event.
-	Service                     string                            `json:"service"`                     // The Background Service this event belongs to. enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+	Service                     BackgroundServiceServiceName      `json:"service"`                     // The Background Service this event belongs to. enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
 	EventName                   string                            `json:"eventName"`                   // A description of the event.
 	InstanceId                  string                            `json:"instanceId"`                  // An identifier that groups related events together.
 	EventMetadata               []*BackgroundServiceEventMetadata `json:"eventMetadata"`               // A list of event-specific information.
 	StorageKey                  string                            `json:"storageKey"`                  // Storage key this event belongs to.
 }
 
+// Event method names for the BackgroundService domain
+const (
+	EventBackgroundServiceRecordingStateChanged          = "BackgroundService.recordingStateChanged"
+	EventBackgroundServiceBackgroundServiceEventReceived = "BackgroundService.backgroundServiceEventReceived"
+)
+
+// BackgroundServiceEvents lists all event method names for the BackgroundService domain
+var BackgroundServiceEvents = []string{
+	EventBackgroundServiceRecordingStateChanged,
+	EventBackgroundServiceBackgroundServiceEventReceived,
+}
+
 // Called when the recording state for the service has been updated.
 type BackgroundServiceRecordingStateChangedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		IsRecording bool   `json:"isRecording"` //
-		Service     string `json:"service"`     //  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+		IsRecording bool                         `json:"isRecording"` //
+		Service     BackgroundServiceServiceName `json:"service"`     //  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
 	} `json:"Params,omitempty"`
 }
 
@@ -44,6 +81,9 @@ type BackgroundServiceBackgroundServiceEventReceivedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// BackgroundService domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type BackgroundService struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -52,79 +92,3 @@ func NewBackgroundService(target gcdmessage.ChromeTargeter) *BackgroundService {
 	c := &BackgroundService{target: target}
 	return c
 }
-
-type BackgroundServiceStartObservingParams struct {
-	//  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
-	Service string `json:"service"`
-}
-
-// StartObservingWithParams - Enables event updates for the service.
-func (c *BackgroundService) StartObservingWithParams(ctx context.Context, v *BackgroundServiceStartObservingParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BackgroundService.startObserving", Params: v})
-}
-
-// StartObserving - Enables event updates for the service.
-// service -  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
-func (c *BackgroundService) StartObserving(ctx context.Context, service string) (*gcdmessage.ChromeResponse, error) {
-	var v BackgroundServiceStartObservingParams
-	v.Service = service
-	return c.StartObservingWithParams(ctx, &v)
-}
-
-type BackgroundServiceStopObservingParams struct {
-	//  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
-	Service string `json:"service"`
-}
-
-// StopObservingWithParams - Disables event updates for the service.
-func (c *BackgroundService) StopObservingWithParams(ctx context.Context, v *BackgroundServiceStopObservingParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BackgroundService.stopObserving", Params: v})
-}
-
-// StopObserving - Disables event updates for the service.
-// service -  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
-func (c *BackgroundService) StopObserving(ctx context.Context, service string) (*gcdmessage.ChromeResponse, error) {
-	var v BackgroundServiceStopObservingParams
-	v.Service = service
-	return c.StopObservingWithParams(ctx, &v)
-}
-
-type BackgroundServiceSetRecordingParams struct {
-	//
-	ShouldRecord bool `json:"shouldRecord"`
-	//  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
-	Service string `json:"service"`
-}
-
-// SetRecordingWithParams - Set the recording state for the service.
-func (c *BackgroundService) SetRecordingWithParams(ctx context.Context, v *BackgroundServiceSetRecordingParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BackgroundService.setRecording", Params: v})
-}
-
-// SetRecording - Set the recording state for the service.
-// shouldRecord -
-// service -  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
-func (c *BackgroundService) SetRecording(ctx context.Context, shouldRecord bool, service string) (*gcdmessage.ChromeResponse, error) {
-	var v BackgroundServiceSetRecordingParams
-	v.ShouldRecord = shouldRecord
-	v.Service = service
-	return c.SetRecordingWithParams(ctx, &v)
-}
-
-type BackgroundServiceClearEventsParams struct {
-	//  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
-	Service string `json:"service"`
-}
-
-// ClearEventsWithParams - Clears all stored data for the service.
-func (c *BackgroundService) ClearEventsWithParams(ctx context.Context, v *BackgroundServiceClearEventsParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BackgroundService.clearEvents", Params: v})
-}
-
-// ClearEvents - Clears all stored data for the service.
-// service -  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
-func (c *BackgroundService) ClearEvents(ctx context.Context, service string) (*gcdmessage.ChromeResponse, error) {
-	var v BackgroundServiceClearEventsParams
-	v.Service = service
-	return c.ClearEventsWithParams(ctx, &v)
-}
@@ -0,0 +1,114 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains BackgroundService functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnRecordingStateChanged subscribes to BackgroundService.recordingStateChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *BackgroundService) OnRecordingStateChanged(callback func(*BackgroundServiceRecordingStateChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventBackgroundServiceRecordingStateChanged, func(payload []byte) error {
+		event := &BackgroundServiceRecordingStateChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnBackgroundServiceEventReceived subscribes to BackgroundService.backgroundServiceEventReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *BackgroundService) OnBackgroundServiceEventReceived(callback func(*BackgroundServiceBackgroundServiceEventReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventBackgroundServiceBackgroundServiceEventReceived, func(payload []byte) error {
+		event := &BackgroundServiceBackgroundServiceEventReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type BackgroundServiceStartObservingParams struct {
+	//  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+	Service BackgroundServiceServiceName `json:"service"`
+}
+
+// StartObservingWithParams - Enables event updates for the service.
+func (c *BackgroundService) StartObservingWithParams(ctx context.Context, v *BackgroundServiceStartObservingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BackgroundService.startObserving", Params: v})
+}
+
+// StartObserving - Enables event updates for the service.
+// service -  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+func (c *BackgroundService) StartObserving(ctx context.Context, service BackgroundServiceServiceName) (*gcdmessage.ChromeResponse, error) {
+	var v BackgroundServiceStartObservingParams
+	v.Service = service
+	return c.StartObservingWithParams(ctx, &v)
+}
+
+type BackgroundServiceStopObservingParams struct {
+	//  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+	Service BackgroundServiceServiceName `json:"service"`
+}
+
+// StopObservingWithParams - Disables event updates for the service.
+func (c *BackgroundService) StopObservingWithParams(ctx context.Context, v *BackgroundServiceStopObservingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BackgroundService.stopObserving", Params: v})
+}
+
+// StopObserving - Disables event updates for the service.
+// service -  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+func (c *BackgroundService) StopObserving(ctx context.Context, service BackgroundServiceServiceName) (*gcdmessage.ChromeResponse, error) {
+	var v BackgroundServiceStopObservingParams
+	v.Service = service
+	return c.StopObservingWithParams(ctx, &v)
+}
+
+type BackgroundServiceSetRecordingParams struct {
+	//
+	ShouldRecord bool `json:"shouldRecord"`
+	//  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+	Service BackgroundServiceServiceName `json:"service"`
+}
+
+// SetRecordingWithParams - Set the recording state for the service.
+func (c *BackgroundService) SetRecordingWithParams(ctx context.Context, v *BackgroundServiceSetRecordingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BackgroundService.setRecording", Params: v})
+}
+
+// SetRecording - Set the recording state for the service.
+// shouldRecord -
+// service -  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+func (c *BackgroundService) SetRecording(ctx context.Context, shouldRecord bool, service BackgroundServiceServiceName) (*gcdmessage.ChromeResponse, error) {
+	var v BackgroundServiceSetRecordingParams
+	v.ShouldRecord = shouldRecord
+	v.Service = service
+	return c.SetRecordingWithParams(ctx, &v)
+}
+
+type BackgroundServiceClearEventsParams struct {
+	//  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+	Service BackgroundServiceServiceName `json:"service"`
+}
+
+// ClearEventsWithParams - Clears all stored data for the service.
+func (c *BackgroundService) ClearEventsWithParams(ctx context.Context, v *BackgroundServiceClearEventsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BackgroundService.clearEvents", Params: v})
+}
+
+// ClearEvents - Clears all stored data for the service.
+// service -  enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync
+func (c *BackgroundService) ClearEvents(ctx context.Context, service BackgroundServiceServiceName) (*gcdmessage.ChromeResponse, error) {
+	var v BackgroundServiceClearEventsParams
+	v.Service = service
+	return c.ClearEventsWithParams(ctx, &v)
+}
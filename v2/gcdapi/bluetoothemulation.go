@@ -0,0 +1,197 @@
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains BluetoothEmulation functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// Indicates the various states of Central.
+type BluetoothEmulationCentralState string
+
+const (
+	BluetoothEmulationCentralStateAbsent     BluetoothEmulationCentralState = "absent"
+	BluetoothEmulationCentralStatePoweredOff BluetoothEmulationCentralState = "powered-off"
+	BluetoothEmulationCentralStatePoweredOn  BluetoothEmulationCentralState = "powered-on"
+)
+
+// Valid returns true if the value is one of the BluetoothEmulationCentralState enum values.
+func (e BluetoothEmulationCentralState) Valid() bool {
+	switch e {
+	case BluetoothEmulationCentralStateAbsent,
+		BluetoothEmulationCentralStatePoweredOff,
+		BluetoothEmulationCentralStatePoweredOn:
+		return true
+	}
+	return false
+}
+
+// Indicates the various types of GATT event.
+type BluetoothEmulationGATTOperationType string
+
+const (
+	BluetoothEmulationGATTOperationTypeConnection BluetoothEmulationGATTOperationType = "connection"
+	BluetoothEmulationGATTOperationTypeDiscovery  BluetoothEmulationGATTOperationType = "discovery"
+)
+
+// Valid returns true if the value is one of the BluetoothEmulationGATTOperationType enum values.
+func (e BluetoothEmulationGATTOperationType) Valid() bool {
+	switch e {
+	case BluetoothEmulationGATTOperationTypeConnection,
+		BluetoothEmulationGATTOperationTypeDiscovery:
+		return true
+	}
+	return false
+}
+
+// Indicates the various types of characteristic write.
+type BluetoothEmulationCharacteristicWriteType string
+
+const (
+	BluetoothEmulationCharacteristicWriteTypeWriteDefaultDeprecated BluetoothEmulationCharacteristicWriteType = "write-default-deprecated"
+	BluetoothEmulationCharacteristicWriteTypeWriteWithResponse      BluetoothEmulationCharacteristicWriteType = "write-with-response"
+	BluetoothEmulationCharacteristicWriteTypeWriteWithoutResponse   BluetoothEmulationCharacteristicWriteType = "write-without-response"
+)
+
+// Valid returns true if the value is one of the BluetoothEmulationCharacteristicWriteType enum values.
+func (e BluetoothEmulationCharacteristicWriteType) Valid() bool {
+	switch e {
+	case BluetoothEmulationCharacteristicWriteTypeWriteDefaultDeprecated,
+		BluetoothEmulationCharacteristicWriteTypeWriteWithResponse,
+		BluetoothEmulationCharacteristicWriteTypeWriteWithoutResponse:
+		return true
+	}
+	return false
+}
+
+// Indicates the various types of characteristic operation.
+type BluetoothEmulationCharacteristicOperationType string
+
+const (
+	BluetoothEmulationCharacteristicOperationTypeRead                         BluetoothEmulationCharacteristicOperationType = "read"
+	BluetoothEmulationCharacteristicOperationTypeWrite                        BluetoothEmulationCharacteristicOperationType = "write"
+	BluetoothEmulationCharacteristicOperationTypeSubscribeToNotifications     BluetoothEmulationCharacteristicOperationType = "subscribe-to-notifications"
+	BluetoothEmulationCharacteristicOperationTypeUnsubscribeFromNotifications BluetoothEmulationCharacteristicOperationType = "unsubscribe-from-notifications"
+)
+
+// Valid returns true if the value is one of the BluetoothEmulationCharacteristicOperationType enum values.
+func (e BluetoothEmulationCharacteristicOperationType) Valid() bool {
+	switch e {
+	case BluetoothEmulationCharacteristicOperationTypeRead,
+		BluetoothEmulationCharacteristicOperationTypeWrite,
+		BluetoothEmulationCharacteristicOperationTypeSubscribeToNotifications,
+		BluetoothEmulationCharacteristicOperationTypeUnsubscribeFromNotifications:
+		return true
+	}
+	return false
+}
+
+// Indicates the various types of descriptor operation.
+type BluetoothEmulationDescriptorOperationType string
+
+const (
+	BluetoothEmulationDescriptorOperationTypeRead  BluetoothEmulationDescriptorOperationType = "read"
+	BluetoothEmulationDescriptorOperationTypeWrite BluetoothEmulationDescriptorOperationType = "write"
+)
+
+// Valid returns true if the value is one of the BluetoothEmulationDescriptorOperationType enum values.
+func (e BluetoothEmulationDescriptorOperationType) Valid() bool {
+	switch e {
+	case BluetoothEmulationDescriptorOperationTypeRead,
+		BluetoothEmulationDescriptorOperationTypeWrite:
+		return true
+	}
+	return false
+}
+
+// Stores the manufacturer data
+type BluetoothEmulationManufacturerData struct {
+	Key  int    `json:"key"`  // Company identifier https://bitbucket.org/bluetooth-SIG/public/src/main/assigned_numbers/company_identifiers/company_identifiers.yaml https://usb.org/developers
+	Data string `json:"data"` // Manufacturer-specific data (Encoded as a base64 string when passed over JSON)
+}
+
+// Stores the byte data of the advertisement packet sent by a Bluetooth device.
+type BluetoothEmulationScanRecord struct {
+	Name             string                                `json:"name,omitempty"`             //
+	Uuids            []string                              `json:"uuids,omitempty"`            //
+	Appearance       int                                   `json:"appearance,omitempty"`       // Stores the external appearance description of the device.
+	TxPower          int                                   `json:"txPower,omitempty"`          // Stores the transmission power of a broadcasting device.
+	ManufacturerData []*BluetoothEmulationManufacturerData `json:"manufacturerData,omitempty"` // Key is the company identifier and the value is an array of bytes of manufacturer specific data.
+}
+
+// Stores the advertisement packet information that is sent by a Bluetooth device.
+type BluetoothEmulationScanEntry struct {
+	DeviceAddress string                        `json:"deviceAddress"` //
+	Rssi          int                           `json:"rssi"`          //
+	ScanRecord    *BluetoothEmulationScanRecord `json:"scanRecord"`    //
+}
+
+// Describes the properties of a characteristic. This follows Bluetooth Core Specification BT 4.2 Vol 3 Part G 3.3.1. Characteristic Properties.
+type BluetoothEmulationCharacteristicProperties struct {
+	Broadcast                 bool `json:"broadcast,omitempty"`                 //
+	Read                      bool `json:"read,omitempty"`                      //
+	WriteWithoutResponse      bool `json:"writeWithoutResponse,omitempty"`      //
+	Write                     bool `json:"write,omitempty"`                     //
+	Notify                    bool `json:"notify,omitempty"`                    //
+	Indicate                  bool `json:"indicate,omitempty"`                  //
+	AuthenticatedSignedWrites bool `json:"authenticatedSignedWrites,omitempty"` //
+	ExtendedProperties        bool `json:"extendedProperties,omitempty"`        //
+}
+
+// Event method names for the BluetoothEmulation domain
+const (
+	EventBluetoothEmulationGattOperationReceived           = "BluetoothEmulation.gattOperationReceived"
+	EventBluetoothEmulationCharacteristicOperationReceived = "BluetoothEmulation.characteristicOperationReceived"
+	EventBluetoothEmulationDescriptorOperationReceived     = "BluetoothEmulation.descriptorOperationReceived"
+)
+
+// BluetoothEmulationEvents lists all event method names for the BluetoothEmulation domain
+var BluetoothEmulationEvents = []string{
+	EventBluetoothEmulationGattOperationReceived,
+	EventBluetoothEmulationCharacteristicOperationReceived,
+	EventBluetoothEmulationDescriptorOperationReceived,
+}
+
+// Event for when a GATT operation of |type| to the peripheral with |address| happened.
+type BluetoothEmulationGattOperationReceivedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Address string                              `json:"address"` //
+		Type    BluetoothEmulationGATTOperationType `json:"type"`    //  enum values: connection, discovery
+	} `json:"Params,omitempty"`
+}
+
+// Event for when a characteristic operation of |type| to the characteristic respresented by |characteristicId| happened. |data| and |writeType| is expected to exist when |type| is write.
+type BluetoothEmulationCharacteristicOperationReceivedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		CharacteristicId string                                        `json:"characteristicId"`    //
+		Type             BluetoothEmulationCharacteristicOperationType `json:"type"`                //  enum values: read, write, subscribe-to-notifications, unsubscribe-from-notifications
+		Data             string                                        `json:"data,omitempty"`      //
+		WriteType        BluetoothEmulationCharacteristicWriteType     `json:"writeType,omitempty"` //  enum values: write-default-deprecated, write-with-response, write-without-response
+	} `json:"Params,omitempty"`
+}
+
+// Event for when a descriptor operation of |type| to the descriptor respresented by |descriptorId| happened. |data| is expected to exist when |type| is write.
+type BluetoothEmulationDescriptorOperationReceivedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		DescriptorId string                                    `json:"descriptorId"`   //
+		Type         BluetoothEmulationDescriptorOperationType `json:"type"`           //  enum values: read, write
+		Data         string                                    `json:"data,omitempty"` //
+	} `json:"Params,omitempty"`
+}
+
+// BluetoothEmulation domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type BluetoothEmulation struct {
+	target gcdmessage.ChromeTargeter
+}
+
+func NewBluetoothEmulation(target gcdmessage.ChromeTargeter) *BluetoothEmulation {
+	c := &BluetoothEmulation{target: target}
+	return c
+}
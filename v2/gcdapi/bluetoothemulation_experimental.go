@@ -0,0 +1,450 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains BluetoothEmulation functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnGattOperationReceived subscribes to BluetoothEmulation.gattOperationReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *BluetoothEmulation) OnGattOperationReceived(callback func(*BluetoothEmulationGattOperationReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventBluetoothEmulationGattOperationReceived, func(payload []byte) error {
+		event := &BluetoothEmulationGattOperationReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnCharacteristicOperationReceived subscribes to BluetoothEmulation.characteristicOperationReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *BluetoothEmulation) OnCharacteristicOperationReceived(callback func(*BluetoothEmulationCharacteristicOperationReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventBluetoothEmulationCharacteristicOperationReceived, func(payload []byte) error {
+		event := &BluetoothEmulationCharacteristicOperationReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDescriptorOperationReceived subscribes to BluetoothEmulation.descriptorOperationReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *BluetoothEmulation) OnDescriptorOperationReceived(callback func(*BluetoothEmulationDescriptorOperationReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventBluetoothEmulationDescriptorOperationReceived, func(payload []byte) error {
+		event := &BluetoothEmulationDescriptorOperationReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type BluetoothEmulationEnableParams struct {
+	// State of the simulated central. enum values: absent, powered-off, powered-on
+	State BluetoothEmulationCentralState `json:"state"`
+	// If the simulated central supports low-energy.
+	LeSupported bool `json:"leSupported"`
+}
+
+// EnableWithParams - Enable the BluetoothEmulation domain.
+func (c *BluetoothEmulation) EnableWithParams(ctx context.Context, v *BluetoothEmulationEnableParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.enable", Params: v})
+}
+
+// Enable - Enable the BluetoothEmulation domain.
+// state - State of the simulated central. enum values: absent, powered-off, powered-on
+// leSupported - If the simulated central supports low-energy.
+func (c *BluetoothEmulation) Enable(ctx context.Context, state BluetoothEmulationCentralState, leSupported bool) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationEnableParams
+	v.State = state
+	v.LeSupported = leSupported
+	return c.EnableWithParams(ctx, &v)
+}
+
+type BluetoothEmulationSetSimulatedCentralStateParams struct {
+	// State of the simulated central. enum values: absent, powered-off, powered-on
+	State BluetoothEmulationCentralState `json:"state"`
+}
+
+// SetSimulatedCentralStateWithParams - Set the state of the simulated central.
+func (c *BluetoothEmulation) SetSimulatedCentralStateWithParams(ctx context.Context, v *BluetoothEmulationSetSimulatedCentralStateParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.setSimulatedCentralState", Params: v})
+}
+
+// SetSimulatedCentralState - Set the state of the simulated central.
+// state - State of the simulated central. enum values: absent, powered-off, powered-on
+func (c *BluetoothEmulation) SetSimulatedCentralState(ctx context.Context, state BluetoothEmulationCentralState) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationSetSimulatedCentralStateParams
+	v.State = state
+	return c.SetSimulatedCentralStateWithParams(ctx, &v)
+}
+
+// Disable the BluetoothEmulation domain.
+func (c *BluetoothEmulation) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.disable"})
+}
+
+type BluetoothEmulationSimulatePreconnectedPeripheralParams struct {
+	//
+	Address string `json:"address"`
+	//
+	Name string `json:"name"`
+	//
+	ManufacturerData []*BluetoothEmulationManufacturerData `json:"manufacturerData"`
+	//
+	KnownServiceUuids []string `json:"knownServiceUuids"`
+}
+
+// SimulatePreconnectedPeripheralWithParams - Simulates a peripheral with |address|, |name| and |knownServiceUuids| that has already been connected to the system.
+func (c *BluetoothEmulation) SimulatePreconnectedPeripheralWithParams(ctx context.Context, v *BluetoothEmulationSimulatePreconnectedPeripheralParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.simulatePreconnectedPeripheral", Params: v})
+}
+
+// SimulatePreconnectedPeripheral - Simulates a peripheral with |address|, |name| and |knownServiceUuids| that has already been connected to the system.
+// address -
+// name -
+// manufacturerData -
+// knownServiceUuids -
+func (c *BluetoothEmulation) SimulatePreconnectedPeripheral(ctx context.Context, address string, name string, manufacturerData []*BluetoothEmulationManufacturerData, knownServiceUuids []string) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationSimulatePreconnectedPeripheralParams
+	v.Address = address
+	v.Name = name
+	v.ManufacturerData = manufacturerData
+	v.KnownServiceUuids = knownServiceUuids
+	return c.SimulatePreconnectedPeripheralWithParams(ctx, &v)
+}
+
+type BluetoothEmulationSimulateAdvertisementParams struct {
+	//
+	Entry *BluetoothEmulationScanEntry `json:"entry"`
+}
+
+// SimulateAdvertisementWithParams - Simulates an advertisement packet described in |entry| being received by the central.
+func (c *BluetoothEmulation) SimulateAdvertisementWithParams(ctx context.Context, v *BluetoothEmulationSimulateAdvertisementParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.simulateAdvertisement", Params: v})
+}
+
+// SimulateAdvertisement - Simulates an advertisement packet described in |entry| being received by the central.
+// entry -
+func (c *BluetoothEmulation) SimulateAdvertisement(ctx context.Context, entry *BluetoothEmulationScanEntry) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationSimulateAdvertisementParams
+	v.Entry = entry
+	return c.SimulateAdvertisementWithParams(ctx, &v)
+}
+
+type BluetoothEmulationSimulateGATTOperationResponseParams struct {
+	//
+	Address string `json:"address"`
+	//  enum values: connection, discovery
+	TheType BluetoothEmulationGATTOperationType `json:"type"`
+	//
+	Code int `json:"code"`
+}
+
+// SimulateGATTOperationResponseWithParams - Simulates the response code from the peripheral with |address| for a GATT operation of |type|. The |code| value follows the HCI Error Codes from Bluetooth Core Specification Vol 2 Part D 1.3 List Of Error Codes.
+func (c *BluetoothEmulation) SimulateGATTOperationResponseWithParams(ctx context.Context, v *BluetoothEmulationSimulateGATTOperationResponseParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.simulateGATTOperationResponse", Params: v})
+}
+
+// SimulateGATTOperationResponse - Simulates the response code from the peripheral with |address| for a GATT operation of |type|. The |code| value follows the HCI Error Codes from Bluetooth Core Specification Vol 2 Part D 1.3 List Of Error Codes.
+// address -
+// type -  enum values: connection, discovery
+// code -
+func (c *BluetoothEmulation) SimulateGATTOperationResponse(ctx context.Context, address string, theType BluetoothEmulationGATTOperationType, code int) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationSimulateGATTOperationResponseParams
+	v.Address = address
+	v.TheType = theType
+	v.Code = code
+	return c.SimulateGATTOperationResponseWithParams(ctx, &v)
+}
+
+type BluetoothEmulationSimulateCharacteristicOperationResponseParams struct {
+	//
+	CharacteristicId string `json:"characteristicId"`
+	//  enum values: read, write, subscribe-to-notifications, unsubscribe-from-notifications
+	TheType BluetoothEmulationCharacteristicOperationType `json:"type"`
+	//
+	Code int `json:"code"`
+	//
+	Data string `json:"data,omitempty"`
+}
+
+// SimulateCharacteristicOperationResponseWithParams - Simulates the response from the characteristic with |characteristicId| for a characteristic operation of |type|. The |code| value follows the Error Codes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The |data| is expected to exist when simulating a successful read operation response.
+func (c *BluetoothEmulation) SimulateCharacteristicOperationResponseWithParams(ctx context.Context, v *BluetoothEmulationSimulateCharacteristicOperationResponseParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.simulateCharacteristicOperationResponse", Params: v})
+}
+
+// SimulateCharacteristicOperationResponse - Simulates the response from the characteristic with |characteristicId| for a characteristic operation of |type|. The |code| value follows the Error Codes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The |data| is expected to exist when simulating a successful read operation response.
+// characteristicId -
+// type -  enum values: read, write, subscribe-to-notifications, unsubscribe-from-notifications
+// code -
+// data -
+func (c *BluetoothEmulation) SimulateCharacteristicOperationResponse(ctx context.Context, characteristicId string, theType BluetoothEmulationCharacteristicOperationType, code int, data string) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationSimulateCharacteristicOperationResponseParams
+	v.CharacteristicId = characteristicId
+	v.TheType = theType
+	v.Code = code
+	v.Data = data
+	return c.SimulateCharacteristicOperationResponseWithParams(ctx, &v)
+}
+
+type BluetoothEmulationSimulateDescriptorOperationResponseParams struct {
+	//
+	DescriptorId string `json:"descriptorId"`
+	//  enum values: read, write
+	TheType BluetoothEmulationDescriptorOperationType `json:"type"`
+	//
+	Code int `json:"code"`
+	//
+	Data string `json:"data,omitempty"`
+}
+
+// SimulateDescriptorOperationResponseWithParams - Simulates the response from the descriptor with |descriptorId| for a descriptor operation of |type|. The |code| value follows the Error Codes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The |data| is expected to exist when simulating a successful read operation response.
+func (c *BluetoothEmulation) SimulateDescriptorOperationResponseWithParams(ctx context.Context, v *BluetoothEmulationSimulateDescriptorOperationResponseParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.simulateDescriptorOperationResponse", Params: v})
+}
+
+// SimulateDescriptorOperationResponse - Simulates the response from the descriptor with |descriptorId| for a descriptor operation of |type|. The |code| value follows the Error Codes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The |data| is expected to exist when simulating a successful read operation response.
+// descriptorId -
+// type -  enum values: read, write
+// code -
+// data -
+func (c *BluetoothEmulation) SimulateDescriptorOperationResponse(ctx context.Context, descriptorId string, theType BluetoothEmulationDescriptorOperationType, code int, data string) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationSimulateDescriptorOperationResponseParams
+	v.DescriptorId = descriptorId
+	v.TheType = theType
+	v.Code = code
+	v.Data = data
+	return c.SimulateDescriptorOperationResponseWithParams(ctx, &v)
+}
+
+type BluetoothEmulationAddServiceParams struct {
+	//
+	Address string `json:"address"`
+	//
+	ServiceUuid string `json:"serviceUuid"`
+}
+
+// AddServiceWithParams - Adds a service with |serviceUuid| to the peripheral with |address|.
+// Returns -  serviceId - An identifier that uniquely represents this service.
+func (c *BluetoothEmulation) AddServiceWithParams(ctx context.Context, v *BluetoothEmulationAddServiceParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.addService", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			ServiceId string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.ServiceId, nil
+}
+
+// AddService - Adds a service with |serviceUuid| to the peripheral with |address|.
+// address -
+// serviceUuid -
+// Returns -  serviceId - An identifier that uniquely represents this service.
+func (c *BluetoothEmulation) AddService(ctx context.Context, address string, serviceUuid string) (string, error) {
+	var v BluetoothEmulationAddServiceParams
+	v.Address = address
+	v.ServiceUuid = serviceUuid
+	return c.AddServiceWithParams(ctx, &v)
+}
+
+type BluetoothEmulationRemoveServiceParams struct {
+	//
+	ServiceId string `json:"serviceId"`
+}
+
+// RemoveServiceWithParams - Removes the service respresented by |serviceId| from the simulated central.
+func (c *BluetoothEmulation) RemoveServiceWithParams(ctx context.Context, v *BluetoothEmulationRemoveServiceParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.removeService", Params: v})
+}
+
+// RemoveService - Removes the service respresented by |serviceId| from the simulated central.
+// serviceId -
+func (c *BluetoothEmulation) RemoveService(ctx context.Context, serviceId string) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationRemoveServiceParams
+	v.ServiceId = serviceId
+	return c.RemoveServiceWithParams(ctx, &v)
+}
+
+type BluetoothEmulationAddCharacteristicParams struct {
+	//
+	ServiceId string `json:"serviceId"`
+	//
+	CharacteristicUuid string `json:"characteristicUuid"`
+	//
+	Properties *BluetoothEmulationCharacteristicProperties `json:"properties"`
+}
+
+// AddCharacteristicWithParams - Adds a characteristic with |characteristicUuid| and |properties| to the service represented by |serviceId|.
+// Returns -  characteristicId - An identifier that uniquely represents this characteristic.
+func (c *BluetoothEmulation) AddCharacteristicWithParams(ctx context.Context, v *BluetoothEmulationAddCharacteristicParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.addCharacteristic", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			CharacteristicId string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.CharacteristicId, nil
+}
+
+// AddCharacteristic - Adds a characteristic with |characteristicUuid| and |properties| to the service represented by |serviceId|.
+// serviceId -
+// characteristicUuid -
+// properties -
+// Returns -  characteristicId - An identifier that uniquely represents this characteristic.
+func (c *BluetoothEmulation) AddCharacteristic(ctx context.Context, serviceId string, characteristicUuid string, properties *BluetoothEmulationCharacteristicProperties) (string, error) {
+	var v BluetoothEmulationAddCharacteristicParams
+	v.ServiceId = serviceId
+	v.CharacteristicUuid = characteristicUuid
+	v.Properties = properties
+	return c.AddCharacteristicWithParams(ctx, &v)
+}
+
+type BluetoothEmulationRemoveCharacteristicParams struct {
+	//
+	CharacteristicId string `json:"characteristicId"`
+}
+
+// RemoveCharacteristicWithParams - Removes the characteristic respresented by |characteristicId| from the simulated central.
+func (c *BluetoothEmulation) RemoveCharacteristicWithParams(ctx context.Context, v *BluetoothEmulationRemoveCharacteristicParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.removeCharacteristic", Params: v})
+}
+
+// RemoveCharacteristic - Removes the characteristic respresented by |characteristicId| from the simulated central.
+// characteristicId -
+func (c *BluetoothEmulation) RemoveCharacteristic(ctx context.Context, characteristicId string) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationRemoveCharacteristicParams
+	v.CharacteristicId = characteristicId
+	return c.RemoveCharacteristicWithParams(ctx, &v)
+}
+
+type BluetoothEmulationAddDescriptorParams struct {
+	//
+	CharacteristicId string `json:"characteristicId"`
+	//
+	DescriptorUuid string `json:"descriptorUuid"`
+}
+
+// AddDescriptorWithParams - Adds a descriptor with |descriptorUuid| to the characteristic respresented by |characteristicId|.
+// Returns -  descriptorId - An identifier that uniquely represents this descriptor.
+func (c *BluetoothEmulation) AddDescriptorWithParams(ctx context.Context, v *BluetoothEmulationAddDescriptorParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.addDescriptor", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			DescriptorId string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.DescriptorId, nil
+}
+
+// AddDescriptor - Adds a descriptor with |descriptorUuid| to the characteristic respresented by |characteristicId|.
+// characteristicId -
+// descriptorUuid -
+// Returns -  descriptorId - An identifier that uniquely represents this descriptor.
+func (c *BluetoothEmulation) AddDescriptor(ctx context.Context, characteristicId string, descriptorUuid string) (string, error) {
+	var v BluetoothEmulationAddDescriptorParams
+	v.CharacteristicId = characteristicId
+	v.DescriptorUuid = descriptorUuid
+	return c.AddDescriptorWithParams(ctx, &v)
+}
+
+type BluetoothEmulationRemoveDescriptorParams struct {
+	//
+	DescriptorId string `json:"descriptorId"`
+}
+
+// RemoveDescriptorWithParams - Removes the descriptor with |descriptorId| from the simulated central.
+func (c *BluetoothEmulation) RemoveDescriptorWithParams(ctx context.Context, v *BluetoothEmulationRemoveDescriptorParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.removeDescriptor", Params: v})
+}
+
+// RemoveDescriptor - Removes the descriptor with |descriptorId| from the simulated central.
+// descriptorId -
+func (c *BluetoothEmulation) RemoveDescriptor(ctx context.Context, descriptorId string) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationRemoveDescriptorParams
+	v.DescriptorId = descriptorId
+	return c.RemoveDescriptorWithParams(ctx, &v)
+}
+
+type BluetoothEmulationSimulateGATTDisconnectionParams struct {
+	//
+	Address string `json:"address"`
+}
+
+// SimulateGATTDisconnectionWithParams - Simulates a GATT disconnection from the peripheral with |address|.
+func (c *BluetoothEmulation) SimulateGATTDisconnectionWithParams(ctx context.Context, v *BluetoothEmulationSimulateGATTDisconnectionParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "BluetoothEmulation.simulateGATTDisconnection", Params: v})
+}
+
+// SimulateGATTDisconnection - Simulates a GATT disconnection from the peripheral with |address|.
+// address -
+func (c *BluetoothEmulation) SimulateGATTDisconnection(ctx context.Context, address string) (*gcdmessage.ChromeResponse, error) {
+	var v BluetoothEmulationSimulateGATTDisconnectionParams
+	v.Address = address
+	return c.SimulateGATTDisconnectionWithParams(ctx, &v)
+}
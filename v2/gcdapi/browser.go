@@ -9,25 +9,252 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// The state of the browser window.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type BrowserWindowState string
+
+const (
+	BrowserWindowStateNormal     BrowserWindowState = "normal"
+	BrowserWindowStateMinimized  BrowserWindowState = "minimized"
+	BrowserWindowStateMaximized  BrowserWindowState = "maximized"
+	BrowserWindowStateFullscreen BrowserWindowState = "fullscreen"
+)
+
+// Valid returns true if the value is one of the BrowserWindowState enum values.
+func (e BrowserWindowState) Valid() bool {
+	switch e {
+	case BrowserWindowStateNormal,
+		BrowserWindowStateMinimized,
+		BrowserWindowStateMaximized,
+		BrowserWindowStateFullscreen:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type BrowserPermissionType string
+
+const (
+	BrowserPermissionTypeAr                       BrowserPermissionType = "ar"
+	BrowserPermissionTypeAudioCapture             BrowserPermissionType = "audioCapture"
+	BrowserPermissionTypeAutomaticFullscreen      BrowserPermissionType = "automaticFullscreen"
+	BrowserPermissionTypeBackgroundFetch          BrowserPermissionType = "backgroundFetch"
+	BrowserPermissionTypeBackgroundSync           BrowserPermissionType = "backgroundSync"
+	BrowserPermissionTypeCameraPanTiltZoom        BrowserPermissionType = "cameraPanTiltZoom"
+	BrowserPermissionTypeCapturedSurfaceControl   BrowserPermissionType = "capturedSurfaceControl"
+	BrowserPermissionTypeClipboardReadWrite       BrowserPermissionType = "clipboardReadWrite"
+	BrowserPermissionTypeClipboardSanitizedWrite  BrowserPermissionType = "clipboardSanitizedWrite"
+	BrowserPermissionTypeDisplayCapture           BrowserPermissionType = "displayCapture"
+	BrowserPermissionTypeDurableStorage           BrowserPermissionType = "durableStorage"
+	BrowserPermissionTypeGeolocation              BrowserPermissionType = "geolocation"
+	BrowserPermissionTypeHandTracking             BrowserPermissionType = "handTracking"
+	BrowserPermissionTypeIdleDetection            BrowserPermissionType = "idleDetection"
+	BrowserPermissionTypeKeyboardLock             BrowserPermissionType = "keyboardLock"
+	BrowserPermissionTypeLocalFonts               BrowserPermissionType = "localFonts"
+	BrowserPermissionTypeLocalNetworkAccess       BrowserPermissionType = "localNetworkAccess"
+	BrowserPermissionTypeMidi                     BrowserPermissionType = "midi"
+	BrowserPermissionTypeMidiSysex                BrowserPermissionType = "midiSysex"
+	BrowserPermissionTypeNfc                      BrowserPermissionType = "nfc"
+	BrowserPermissionTypeNotifications            BrowserPermissionType = "notifications"
+	BrowserPermissionTypePaymentHandler           BrowserPermissionType = "paymentHandler"
+	BrowserPermissionTypePeriodicBackgroundSync   BrowserPermissionType = "periodicBackgroundSync"
+	BrowserPermissionTypePointerLock              BrowserPermissionType = "pointerLock"
+	BrowserPermissionTypeProtectedMediaIdentifier BrowserPermissionType = "protectedMediaIdentifier"
+	BrowserPermissionTypeSensors                  BrowserPermissionType = "sensors"
+	BrowserPermissionTypeSmartCard                BrowserPermissionType = "smartCard"
+	BrowserPermissionTypeSpeakerSelection         BrowserPermissionType = "speakerSelection"
+	BrowserPermissionTypeStorageAccess            BrowserPermissionType = "storageAccess"
+	BrowserPermissionTypeTopLevelStorageAccess    BrowserPermissionType = "topLevelStorageAccess"
+	BrowserPermissionTypeVideoCapture             BrowserPermissionType = "videoCapture"
+	BrowserPermissionTypeVr                       BrowserPermissionType = "vr"
+	BrowserPermissionTypeWakeLockScreen           BrowserPermissionType = "wakeLockScreen"
+	BrowserPermissionTypeWakeLockSystem           BrowserPermissionType = "wakeLockSystem"
+	BrowserPermissionTypeWebAppInstallation       BrowserPermissionType = "webAppInstallation"
+	BrowserPermissionTypeWebPrinting              BrowserPermissionType = "webPrinting"
+	BrowserPermissionTypeWindowManagement         BrowserPermissionType = "windowManagement"
+)
+
+// Valid returns true if the value is one of the BrowserPermissionType enum values.
+func (e BrowserPermissionType) Valid() bool {
+	switch e {
+	case BrowserPermissionTypeAr,
+		BrowserPermissionTypeAudioCapture,
+		BrowserPermissionTypeAutomaticFullscreen,
+		BrowserPermissionTypeBackgroundFetch,
+		BrowserPermissionTypeBackgroundSync,
+		BrowserPermissionTypeCameraPanTiltZoom,
+		BrowserPermissionTypeCapturedSurfaceControl,
+		BrowserPermissionTypeClipboardReadWrite,
+		BrowserPermissionTypeClipboardSanitizedWrite,
+		BrowserPermissionTypeDisplayCapture,
+		BrowserPermissionTypeDurableStorage,
+		BrowserPermissionTypeGeolocation,
+		BrowserPermissionTypeHandTracking,
+		BrowserPermissionTypeIdleDetection,
+		BrowserPermissionTypeKeyboardLock,
+		BrowserPermissionTypeLocalFonts,
+		BrowserPermissionTypeLocalNetworkAccess,
+		BrowserPermissionTypeMidi,
+		BrowserPermissionTypeMidiSysex,
+		BrowserPermissionTypeNfc,
+		BrowserPermissionTypeNotifications,
+		BrowserPermissionTypePaymentHandler,
+		BrowserPermissionTypePeriodicBackgroundSync,
+		BrowserPermissionTypePointerLock,
+		BrowserPermissionTypeProtectedMediaIdentifier,
+		BrowserPermissionTypeSensors,
+		BrowserPermissionTypeSmartCard,
+		BrowserPermissionTypeSpeakerSelection,
+		BrowserPermissionTypeStorageAccess,
+		BrowserPermissionTypeTopLevelStorageAccess,
+		BrowserPermissionTypeVideoCapture,
+		BrowserPermissionTypeVr,
+		BrowserPermissionTypeWakeLockScreen,
+		BrowserPermissionTypeWakeLockSystem,
+		BrowserPermissionTypeWebAppInstallation,
+		BrowserPermissionTypeWebPrinting,
+		BrowserPermissionTypeWindowManagement:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type BrowserPermissionSetting string
+
+const (
+	BrowserPermissionSettingGranted BrowserPermissionSetting = "granted"
+	BrowserPermissionSettingDenied  BrowserPermissionSetting = "denied"
+	BrowserPermissionSettingPrompt  BrowserPermissionSetting = "prompt"
+)
+
+// Valid returns true if the value is one of the BrowserPermissionSetting enum values.
+func (e BrowserPermissionSetting) Valid() bool {
+	switch e {
+	case BrowserPermissionSettingGranted,
+		BrowserPermissionSettingDenied,
+		BrowserPermissionSettingPrompt:
+		return true
+	}
+	return false
+}
+
+// Browser command ids used by executeBrowserCommand.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type BrowserBrowserCommandId string
+
+const (
+	BrowserBrowserCommandIdOpenTabSearch  BrowserBrowserCommandId = "openTabSearch"
+	BrowserBrowserCommandIdCloseTabSearch BrowserBrowserCommandId = "closeTabSearch"
+	BrowserBrowserCommandIdOpenGlic       BrowserBrowserCommandId = "openGlic"
+)
+
+// Valid returns true if the value is one of the BrowserBrowserCommandId enum values.
+func (e BrowserBrowserCommandId) Valid() bool {
+	switch e {
+	case BrowserBrowserCommandIdOpenTabSearch,
+		BrowserBrowserCommandIdCloseTabSearch,
+		BrowserBrowserCommandIdOpenGlic:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type BrowserPrivacySandboxAPI string
+
+const (
+	BrowserPrivacySandboxAPIBiddingAndAuctionServices BrowserPrivacySandboxAPI = "BiddingAndAuctionServices"
+	BrowserPrivacySandboxAPITrustedKeyValue           BrowserPrivacySandboxAPI = "TrustedKeyValue"
+)
+
+// Valid returns true if the value is one of the BrowserPrivacySandboxAPI enum values.
+func (e BrowserPrivacySandboxAPI) Valid() bool {
+	switch e {
+	case BrowserPrivacySandboxAPIBiddingAndAuctionServices,
+		BrowserPrivacySandboxAPITrustedKeyValue:
+		return true
+	}
+	return false
+}
+
+// Download status.
+type BrowserDownloadProgressState string
+
+const (
+	BrowserDownloadProgressStateInProgress BrowserDownloadProgressState = "inProgress"
+	BrowserDownloadProgressStateCompleted  BrowserDownloadProgressState = "completed"
+	BrowserDownloadProgressStateCanceled   BrowserDownloadProgressState = "canceled"
+)
+
+// Valid returns true if the value is one of the BrowserDownloadProgressState enum values.
+func (e BrowserDownloadProgressState) Valid() bool {
+	switch e {
+	case BrowserDownloadProgressStateInProgress,
+		BrowserDownloadProgressStateCompleted,
+		BrowserDownloadProgressStateCanceled:
+		return true
+	}
+	return false
+}
+
+// Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny). |allowAndName| allows download and names files according to their download guids.
+type BrowserSetDownloadBehaviorBehavior string
+
+const (
+	BrowserSetDownloadBehaviorBehaviorDeny         BrowserSetDownloadBehaviorBehavior = "deny"
+	BrowserSetDownloadBehaviorBehaviorAllow        BrowserSetDownloadBehaviorBehavior = "allow"
+	BrowserSetDownloadBehaviorBehaviorAllowAndName BrowserSetDownloadBehaviorBehavior = "allowAndName"
+	BrowserSetDownloadBehaviorBehaviorDefault      BrowserSetDownloadBehaviorBehavior = "default"
+)
+
+// Valid returns true if the value is one of the BrowserSetDownloadBehaviorBehavior enum values.
+func (e BrowserSetDownloadBehaviorBehavior) Valid() bool {
+	switch e {
+	case BrowserSetDownloadBehaviorBehaviorDeny,
+		BrowserSetDownloadBehaviorBehaviorAllow,
+		BrowserSetDownloadBehaviorBehaviorAllowAndName,
+		BrowserSetDownloadBehaviorBehaviorDefault:
+		return true
+	}
+	return false
+}
+
 // Browser window bounds information
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type BrowserBounds struct {
-	Left        int    `json:"left,omitempty"`        // The offset from the left edge of the screen to the window in pixels.
-	Top         int    `json:"top,omitempty"`         // The offset from the top edge of the screen to the window in pixels.
-	Width       int    `json:"width,omitempty"`       // The window width in pixels.
-	Height      int    `json:"height,omitempty"`      // The window height in pixels.
-	WindowState string `json:"windowState,omitempty"` // The window state. Default to normal. enum values: normal, minimized, maximized, fullscreen
+	Left        int                `json:"left,omitempty"`        // The offset from the left edge of the screen to the window in pixels.
+	Top         int                `json:"top,omitempty"`         // The offset from the top edge of the screen to the window in pixels.
+	Width       int                `json:"width,omitempty"`       // The window width in pixels.
+	Height      int                `json:"height,omitempty"`      // The window height in pixels.
+	WindowState BrowserWindowState `json:"windowState,omitempty"` // The window state. Default to normal. enum values: normal, minimized, maximized, fullscreen
 }
 
-// Definition of PermissionDescriptor defined in the Permissions API: https://w3c.github.io/permissions/#dictdef-permissiondescriptor.
+// Definition of PermissionDescriptor defined in the Permissions API: https://w3c.github.io/permissions/#dom-permissiondescriptor.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type BrowserPermissionDescriptor struct {
 	Name                     string `json:"name"`                               // Name of permission. See https://cs.chromium.org/chromium/src/third_party/blink/renderer/modules/permissions/permission_descriptor.idl for valid permission names.
 	Sysex                    bool   `json:"sysex,omitempty"`                    // For "midi" permission, may also specify sysex control.
 	UserVisibleOnly          bool   `json:"userVisibleOnly,omitempty"`          // For "push" permission, may specify userVisibleOnly. Note that userVisibleOnly = true is the only currently supported type.
 	AllowWithoutSanitization bool   `json:"allowWithoutSanitization,omitempty"` // For "clipboard" permission, may specify allowWithoutSanitization.
+	AllowWithoutGesture      bool   `json:"allowWithoutGesture,omitempty"`      // For "fullscreen" permission, must specify allowWithoutGesture:true.
 	PanTiltZoom              bool   `json:"panTiltZoom,omitempty"`              // For "camera" permission, may specify panTiltZoom.
 }
 
 // Chrome histogram bucket.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type BrowserBucket struct {
 	Low   int `json:"low"`   // Minimum value (inclusive).
 	High  int `json:"high"`  // Maximum value (exclusive).
@@ -35,6 +262,8 @@ type BrowserBucket struct {
 }
 
 // Chrome histogram.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type BrowserHistogram struct {
 	Name    string           `json:"name"`    // Name.
 	Sum     int              `json:"sum"`     // Sum of sample values.
@@ -42,7 +271,21 @@ type BrowserHistogram struct {
 	Buckets []*BrowserBucket `json:"buckets"` // Buckets.
 }
 
+// Event method names for the Browser domain
+const (
+	EventBrowserDownloadWillBegin = "Browser.downloadWillBegin"
+	EventBrowserDownloadProgress  = "Browser.downloadProgress"
+)
+
+// BrowserEvents lists all event method names for the Browser domain
+var BrowserEvents = []string{
+	EventBrowserDownloadWillBegin,
+	EventBrowserDownloadProgress,
+}
+
 // Fired when page is about to start a download.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type BrowserDownloadWillBeginEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -54,13 +297,16 @@ type BrowserDownloadWillBeginEvent struct {
 }
 
 // Fired when download makes progress. Last call has |done| == true.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type BrowserDownloadProgressEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		Guid          string  `json:"guid"`          // Global unique identifier of the download.
-		TotalBytes    float64 `json:"totalBytes"`    // Total expected bytes to download.
-		ReceivedBytes float64 `json:"receivedBytes"` // Total bytes received.
-		State         string  `json:"state"`         // Download status.
+		Guid          string                       `json:"guid"`               // Global unique identifier of the download.
+		TotalBytes    float64                      `json:"totalBytes"`         // Total expected bytes to download.
+		ReceivedBytes float64                      `json:"receivedBytes"`      // Total bytes received.
+		State         BrowserDownloadProgressState `json:"state"`              // Download status.
+		FilePath      string                       `json:"filePath,omitempty"` // If download is "completed", provides the path of the downloaded file. Depending on the platform, it is not guaranteed to be set, nor the file is guaranteed to exist.
 	} `json:"Params,omitempty"`
 }
 
@@ -73,11 +319,41 @@ func NewBrowser(target gcdmessage.ChromeTargeter) *Browser {
 	return c
 }
 
+// OnDownloadWillBegin subscribes to Browser.downloadWillBegin events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Browser) OnDownloadWillBegin(callback func(*BrowserDownloadWillBeginEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventBrowserDownloadWillBegin, func(payload []byte) error {
+		event := &BrowserDownloadWillBeginEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDownloadProgress subscribes to Browser.downloadProgress events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Browser) OnDownloadProgress(callback func(*BrowserDownloadProgressEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventBrowserDownloadProgress, func(payload []byte) error {
+		event := &BrowserDownloadProgressEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 type BrowserSetPermissionParams struct {
 	// Descriptor of permission to override.
 	Permission *BrowserPermissionDescriptor `json:"permission"`
 	// Setting of the permission. enum values: granted, denied, prompt
-	Setting string `json:"setting"`
+	Setting BrowserPermissionSetting `json:"setting"`
 	// Origin the permission applies to, all origins if not specified.
 	Origin string `json:"origin,omitempty"`
 	// Context to override. When omitted, default browser context is used.
@@ -85,6 +361,8 @@ type BrowserSetPermissionParams struct {
 }
 
 // SetPermissionWithParams - Set permission settings for given origin.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) SetPermissionWithParams(ctx context.Context, v *BrowserSetPermissionParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.setPermission", Params: v})
 }
@@ -94,7 +372,9 @@ func (c *Browser) SetPermissionWithParams(ctx context.Context, v *BrowserSetPerm
 // setting - Setting of the permission. enum values: granted, denied, prompt
 // origin - Origin the permission applies to, all origins if not specified.
 // browserContextId - Context to override. When omitted, default browser context is used.
-func (c *Browser) SetPermission(ctx context.Context, permission *BrowserPermissionDescriptor, setting string, origin string, browserContextId string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Browser) SetPermission(ctx context.Context, permission *BrowserPermissionDescriptor, setting BrowserPermissionSetting, origin string, browserContextId string) (*gcdmessage.ChromeResponse, error) {
 	var v BrowserSetPermissionParams
 	v.Permission = permission
 	v.Setting = setting
@@ -104,8 +384,8 @@ func (c *Browser) SetPermission(ctx context.Context, permission *BrowserPermissi
 }
 
 type BrowserGrantPermissionsParams struct {
-	//  enum values: accessibilityEvents, audioCapture, backgroundSync, backgroundFetch, clipboardReadWrite, clipboardSanitizedWrite, displayCapture, durableStorage, flash, geolocation, idleDetection, localFonts, midi, midiSysex, nfc, notifications, paymentHandler, periodicBackgroundSync, protectedMediaIdentifier, sensors, storageAccess, topLevelStorageAccess, videoCapture, videoCapturePanTiltZoom, wakeLockScreen, wakeLockSystem, windowManagement
-	Permissions []string `json:"permissions"`
+	//  enum values: ar, audioCapture, automaticFullscreen, backgroundFetch, backgroundSync, cameraPanTiltZoom, capturedSurfaceControl, clipboardReadWrite, clipboardSanitizedWrite, displayCapture, durableStorage, geolocation, handTracking, idleDetection, keyboardLock, localFonts, localNetworkAccess, midi, midiSysex, nfc, notifications, paymentHandler, periodicBackgroundSync, pointerLock, protectedMediaIdentifier, sensors, smartCard, speakerSelection, storageAccess, topLevelStorageAccess, videoCapture, vr, wakeLockScreen, wakeLockSystem, webAppInstallation, webPrinting, windowManagement
+	Permissions []BrowserPermissionType `json:"permissions"`
 	// Origin the permission applies to, all origins if not specified.
 	Origin string `json:"origin,omitempty"`
 	// BrowserContext to override permissions. When omitted, default browser context is used.
@@ -113,15 +393,19 @@ type BrowserGrantPermissionsParams struct {
 }
 
 // GrantPermissionsWithParams - Grant specific permissions to the given origin and reject all others.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GrantPermissionsWithParams(ctx context.Context, v *BrowserGrantPermissionsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.grantPermissions", Params: v})
 }
 
 // GrantPermissions - Grant specific permissions to the given origin and reject all others.
-// permissions -  enum values: accessibilityEvents, audioCapture, backgroundSync, backgroundFetch, clipboardReadWrite, clipboardSanitizedWrite, displayCapture, durableStorage, flash, geolocation, idleDetection, localFonts, midi, midiSysex, nfc, notifications, paymentHandler, periodicBackgroundSync, protectedMediaIdentifier, sensors, storageAccess, topLevelStorageAccess, videoCapture, videoCapturePanTiltZoom, wakeLockScreen, wakeLockSystem, windowManagement
+// permissions -  enum values: ar, audioCapture, automaticFullscreen, backgroundFetch, backgroundSync, cameraPanTiltZoom, capturedSurfaceControl, clipboardReadWrite, clipboardSanitizedWrite, displayCapture, durableStorage, geolocation, handTracking, idleDetection, keyboardLock, localFonts, localNetworkAccess, midi, midiSysex, nfc, notifications, paymentHandler, periodicBackgroundSync, pointerLock, protectedMediaIdentifier, sensors, smartCard, speakerSelection, storageAccess, topLevelStorageAccess, videoCapture, vr, wakeLockScreen, wakeLockSystem, webAppInstallation, webPrinting, windowManagement
 // origin - Origin the permission applies to, all origins if not specified.
 // browserContextId - BrowserContext to override permissions. When omitted, default browser context is used.
-func (c *Browser) GrantPermissions(ctx context.Context, permissions []string, origin string, browserContextId string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Browser) GrantPermissions(ctx context.Context, permissions []BrowserPermissionType, origin string, browserContextId string) (*gcdmessage.ChromeResponse, error) {
 	var v BrowserGrantPermissionsParams
 	v.Permissions = permissions
 	v.Origin = origin
@@ -148,8 +432,8 @@ func (c *Browser) ResetPermissions(ctx context.Context, browserContextId string)
 }
 
 type BrowserSetDownloadBehaviorParams struct {
-	// Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny). |allowAndName| allows download and names files according to their dowmload guids.
-	Behavior string `json:"behavior"`
+	// Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny). |allowAndName| allows download and names files according to their download guids.
+	Behavior BrowserSetDownloadBehaviorBehavior `json:"behavior"`
 	// BrowserContext to set download behavior. When omitted, default browser context is used.
 	BrowserContextId string `json:"browserContextId,omitempty"`
 	// The default path to save downloaded files to. This is required if behavior is set to 'allow' or 'allowAndName'.
@@ -159,16 +443,20 @@ type BrowserSetDownloadBehaviorParams struct {
 }
 
 // SetDownloadBehaviorWithParams - Set the behavior when downloading a file.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) SetDownloadBehaviorWithParams(ctx context.Context, v *BrowserSetDownloadBehaviorParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.setDownloadBehavior", Params: v})
 }
 
 // SetDownloadBehavior - Set the behavior when downloading a file.
-// behavior - Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny). |allowAndName| allows download and names files according to their dowmload guids.
+// behavior - Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny). |allowAndName| allows download and names files according to their download guids.
 // browserContextId - BrowserContext to set download behavior. When omitted, default browser context is used.
 // downloadPath - The default path to save downloaded files to. This is required if behavior is set to 'allow' or 'allowAndName'.
 // eventsEnabled - Whether to emit download events (defaults to false).
-func (c *Browser) SetDownloadBehavior(ctx context.Context, behavior string, browserContextId string, downloadPath string, eventsEnabled bool) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Browser) SetDownloadBehavior(ctx context.Context, behavior BrowserSetDownloadBehaviorBehavior, browserContextId string, downloadPath string, eventsEnabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v BrowserSetDownloadBehaviorParams
 	v.Behavior = behavior
 	v.BrowserContextId = browserContextId
@@ -185,6 +473,8 @@ type BrowserCancelDownloadParams struct {
 }
 
 // CancelDownloadWithParams - Cancel a download if in progress
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) CancelDownloadWithParams(ctx context.Context, v *BrowserCancelDownloadParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.cancelDownload", Params: v})
 }
@@ -192,6 +482,8 @@ func (c *Browser) CancelDownloadWithParams(ctx context.Context, v *BrowserCancel
 // CancelDownload - Cancel a download if in progress
 // guid - Global unique identifier of the download.
 // browserContextId - BrowserContext to perform the action in. When omitted, default browser context is used.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) CancelDownload(ctx context.Context, guid string, browserContextId string) (*gcdmessage.ChromeResponse, error) {
 	var v BrowserCancelDownloadParams
 	v.Guid = guid
@@ -205,11 +497,15 @@ func (c *Browser) Close(ctx context.Context) (*gcdmessage.ChromeResponse, error)
 }
 
 // Crashes browser on the main thread.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) Crash(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.crash"})
 }
 
 // Crashes GPU process.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) CrashGpuProcess(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.crashGpuProcess"})
 }
@@ -250,6 +546,8 @@ func (c *Browser) GetVersion(ctx context.Context) (string, string, string, strin
 
 // GetBrowserCommandLine - Returns the command line switches for the browser process if, and only if --enable-automation is on the commandline.
 // Returns -  arguments - Commandline parameters
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetBrowserCommandLine(ctx context.Context) ([]string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.getBrowserCommandLine"})
 	if err != nil {
@@ -287,6 +585,8 @@ type BrowserGetHistogramsParams struct {
 
 // GetHistogramsWithParams - Get Chrome histograms.
 // Returns -  histograms - Histograms.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetHistogramsWithParams(ctx context.Context, v *BrowserGetHistogramsParams) ([]*BrowserHistogram, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.getHistograms", Params: v})
 	if err != nil {
@@ -319,6 +619,8 @@ func (c *Browser) GetHistogramsWithParams(ctx context.Context, v *BrowserGetHist
 // query - Requested substring in name. Only histograms which have query as a substring in their name are extracted. An empty or absent query returns all histograms.
 // delta - If true, retrieve delta since last delta call.
 // Returns -  histograms - Histograms.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetHistograms(ctx context.Context, query string, delta bool) ([]*BrowserHistogram, error) {
 	var v BrowserGetHistogramsParams
 	v.Query = query
@@ -335,6 +637,8 @@ type BrowserGetHistogramParams struct {
 
 // GetHistogramWithParams - Get a Chrome histogram by name.
 // Returns -  histogram - Histogram.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetHistogramWithParams(ctx context.Context, v *BrowserGetHistogramParams) (*BrowserHistogram, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.getHistogram", Params: v})
 	if err != nil {
@@ -367,6 +671,8 @@ func (c *Browser) GetHistogramWithParams(ctx context.Context, v *BrowserGetHisto
 // name - Requested histogram name.
 // delta - If true, retrieve delta since last delta call.
 // Returns -  histogram - Histogram.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetHistogram(ctx context.Context, name string, delta bool) (*BrowserHistogram, error) {
 	var v BrowserGetHistogramParams
 	v.Name = name
@@ -381,6 +687,8 @@ type BrowserGetWindowBoundsParams struct {
 
 // GetWindowBoundsWithParams - Get position and size of the browser window.
 // Returns -  bounds - Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetWindowBoundsWithParams(ctx context.Context, v *BrowserGetWindowBoundsParams) (*BrowserBounds, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.getWindowBounds", Params: v})
 	if err != nil {
@@ -412,6 +720,8 @@ func (c *Browser) GetWindowBoundsWithParams(ctx context.Context, v *BrowserGetWi
 // GetWindowBounds - Get position and size of the browser window.
 // windowId - Browser window id.
 // Returns -  bounds - Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetWindowBounds(ctx context.Context, windowId int) (*BrowserBounds, error) {
 	var v BrowserGetWindowBoundsParams
 	v.WindowId = windowId
@@ -425,6 +735,8 @@ type BrowserGetWindowForTargetParams struct {
 
 // GetWindowForTargetWithParams - Get the browser window that contains the devtools target.
 // Returns -  windowId - Browser window id. bounds - Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetWindowForTargetWithParams(ctx context.Context, v *BrowserGetWindowForTargetParams) (int, *BrowserBounds, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.getWindowForTarget", Params: v})
 	if err != nil {
@@ -457,6 +769,8 @@ func (c *Browser) GetWindowForTargetWithParams(ctx context.Context, v *BrowserGe
 // GetWindowForTarget - Get the browser window that contains the devtools target.
 // targetId - Devtools agent host id. If called as a part of the session, associated targetId is used.
 // Returns -  windowId - Browser window id. bounds - Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) GetWindowForTarget(ctx context.Context, targetId string) (int, *BrowserBounds, error) {
 	var v BrowserGetWindowForTargetParams
 	v.TargetId = targetId
@@ -471,6 +785,8 @@ type BrowserSetWindowBoundsParams struct {
 }
 
 // SetWindowBoundsWithParams - Set position and/or size of the browser window.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) SetWindowBoundsWithParams(ctx context.Context, v *BrowserSetWindowBoundsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.setWindowBounds", Params: v})
 }
@@ -478,6 +794,8 @@ func (c *Browser) SetWindowBoundsWithParams(ctx context.Context, v *BrowserSetWi
 // SetWindowBounds - Set position and/or size of the browser window.
 // windowId - Browser window id.
 // bounds - New window bounds. The 'minimized', 'maximized' and 'fullscreen' states cannot be combined with 'left', 'top', 'width' or 'height'. Leaves unspecified fields unchanged.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) SetWindowBounds(ctx context.Context, windowId int, bounds *BrowserBounds) (*gcdmessage.ChromeResponse, error) {
 	var v BrowserSetWindowBoundsParams
 	v.WindowId = windowId
@@ -485,6 +803,36 @@ func (c *Browser) SetWindowBounds(ctx context.Context, windowId int, bounds *Bro
 	return c.SetWindowBoundsWithParams(ctx, &v)
 }
 
+type BrowserSetContentsSizeParams struct {
+	// Browser window id.
+	WindowId int `json:"windowId"`
+	// The window contents width in DIP. Assumes current width if omitted. Must be specified if 'height' is omitted.
+	Width int `json:"width,omitempty"`
+	// The window contents height in DIP. Assumes current height if omitted. Must be specified if 'width' is omitted.
+	Height int `json:"height,omitempty"`
+}
+
+// SetContentsSizeWithParams - Set size of the browser contents resizing browser window as necessary.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Browser) SetContentsSizeWithParams(ctx context.Context, v *BrowserSetContentsSizeParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.setContentsSize", Params: v})
+}
+
+// SetContentsSize - Set size of the browser contents resizing browser window as necessary.
+// windowId - Browser window id.
+// width - The window contents width in DIP. Assumes current width if omitted. Must be specified if 'height' is omitted.
+// height - The window contents height in DIP. Assumes current height if omitted. Must be specified if 'width' is omitted.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Browser) SetContentsSize(ctx context.Context, windowId int, width int, height int) (*gcdmessage.ChromeResponse, error) {
+	var v BrowserSetContentsSizeParams
+	v.WindowId = windowId
+	v.Width = width
+	v.Height = height
+	return c.SetContentsSizeWithParams(ctx, &v)
+}
+
 type BrowserSetDockTileParams struct {
 	//
 	BadgeLabel string `json:"badgeLabel,omitempty"`
@@ -493,6 +841,8 @@ type BrowserSetDockTileParams struct {
 }
 
 // SetDockTileWithParams - Set dock tile details, platform-specific.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) SetDockTileWithParams(ctx context.Context, v *BrowserSetDockTileParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.setDockTile", Params: v})
 }
@@ -500,6 +850,8 @@ func (c *Browser) SetDockTileWithParams(ctx context.Context, v *BrowserSetDockTi
 // SetDockTile - Set dock tile details, platform-specific.
 // badgeLabel -
 // image - Png encoded image. (Encoded as a base64 string when passed over JSON)
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) SetDockTile(ctx context.Context, badgeLabel string, image string) (*gcdmessage.ChromeResponse, error) {
 	var v BrowserSetDockTileParams
 	v.BadgeLabel = badgeLabel
@@ -508,19 +860,71 @@ func (c *Browser) SetDockTile(ctx context.Context, badgeLabel string, image stri
 }
 
 type BrowserExecuteBrowserCommandParams struct {
-	//  enum values: openTabSearch, closeTabSearch
-	CommandId string `json:"commandId"`
+	//  enum values: openTabSearch, closeTabSearch, openGlic
+	CommandId BrowserBrowserCommandId `json:"commandId"`
 }
 
 // ExecuteBrowserCommandWithParams - Invoke custom browser commands used by telemetry.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Browser) ExecuteBrowserCommandWithParams(ctx context.Context, v *BrowserExecuteBrowserCommandParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.executeBrowserCommand", Params: v})
 }
 
 // ExecuteBrowserCommand - Invoke custom browser commands used by telemetry.
-// commandId -  enum values: openTabSearch, closeTabSearch
-func (c *Browser) ExecuteBrowserCommand(ctx context.Context, commandId string) (*gcdmessage.ChromeResponse, error) {
+// commandId -  enum values: openTabSearch, closeTabSearch, openGlic
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Browser) ExecuteBrowserCommand(ctx context.Context, commandId BrowserBrowserCommandId) (*gcdmessage.ChromeResponse, error) {
 	var v BrowserExecuteBrowserCommandParams
 	v.CommandId = commandId
 	return c.ExecuteBrowserCommandWithParams(ctx, &v)
 }
+
+type BrowserAddPrivacySandboxEnrollmentOverrideParams struct {
+	//
+	Url string `json:"url"`
+}
+
+// AddPrivacySandboxEnrollmentOverrideWithParams - Allows a site to use privacy sandbox features that require enrollment without the site actually being enrolled. Only supported on page targets.
+func (c *Browser) AddPrivacySandboxEnrollmentOverrideWithParams(ctx context.Context, v *BrowserAddPrivacySandboxEnrollmentOverrideParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.addPrivacySandboxEnrollmentOverride", Params: v})
+}
+
+// AddPrivacySandboxEnrollmentOverride - Allows a site to use privacy sandbox features that require enrollment without the site actually being enrolled. Only supported on page targets.
+// url -
+func (c *Browser) AddPrivacySandboxEnrollmentOverride(ctx context.Context, url string) (*gcdmessage.ChromeResponse, error) {
+	var v BrowserAddPrivacySandboxEnrollmentOverrideParams
+	v.Url = url
+	return c.AddPrivacySandboxEnrollmentOverrideWithParams(ctx, &v)
+}
+
+type BrowserAddPrivacySandboxCoordinatorKeyConfigParams struct {
+	//  enum values: BiddingAndAuctionServices, TrustedKeyValue
+	Api BrowserPrivacySandboxAPI `json:"api"`
+	//
+	CoordinatorOrigin string `json:"coordinatorOrigin"`
+	//
+	KeyConfig string `json:"keyConfig"`
+	// BrowserContext to perform the action in. When omitted, default browser context is used.
+	BrowserContextId string `json:"browserContextId,omitempty"`
+}
+
+// AddPrivacySandboxCoordinatorKeyConfigWithParams - Configures encryption keys used with a given privacy sandbox API to talk to a trusted coordinator.  Since this is intended for test automation only, coordinatorOrigin must be a .test domain. No existing coordinator configuration for the origin may exist.
+func (c *Browser) AddPrivacySandboxCoordinatorKeyConfigWithParams(ctx context.Context, v *BrowserAddPrivacySandboxCoordinatorKeyConfigParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Browser.addPrivacySandboxCoordinatorKeyConfig", Params: v})
+}
+
+// AddPrivacySandboxCoordinatorKeyConfig - Configures encryption keys used with a given privacy sandbox API to talk to a trusted coordinator.  Since this is intended for test automation only, coordinatorOrigin must be a .test domain. No existing coordinator configuration for the origin may exist.
+// api -  enum values: BiddingAndAuctionServices, TrustedKeyValue
+// coordinatorOrigin -
+// keyConfig -
+// browserContextId - BrowserContext to perform the action in. When omitted, default browser context is used.
+func (c *Browser) AddPrivacySandboxCoordinatorKeyConfig(ctx context.Context, api BrowserPrivacySandboxAPI, coordinatorOrigin string, keyConfig string, browserContextId string) (*gcdmessage.ChromeResponse, error) {
+	var v BrowserAddPrivacySandboxCoordinatorKeyConfigParams
+	v.Api = api
+	v.CoordinatorOrigin = coordinatorOrigin
+	v.KeyConfig = keyConfig
+	v.BrowserContextId = browserContextId
+	return c.AddPrivacySandboxCoordinatorKeyConfigWithParams(ctx, &v)
+}
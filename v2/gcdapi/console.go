@@ -9,14 +9,84 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Message source.
+type ConsoleConsoleMessageSource string
+
+const (
+	ConsoleConsoleMessageSourceXml         ConsoleConsoleMessageSource = "xml"
+	ConsoleConsoleMessageSourceJavascript  ConsoleConsoleMessageSource = "javascript"
+	ConsoleConsoleMessageSourceNetwork     ConsoleConsoleMessageSource = "network"
+	ConsoleConsoleMessageSourceConsoleApi  ConsoleConsoleMessageSource = "console-api"
+	ConsoleConsoleMessageSourceStorage     ConsoleConsoleMessageSource = "storage"
+	ConsoleConsoleMessageSourceAppcache    ConsoleConsoleMessageSource = "appcache"
+	ConsoleConsoleMessageSourceRendering   ConsoleConsoleMessageSource = "rendering"
+	ConsoleConsoleMessageSourceSecurity    ConsoleConsoleMessageSource = "security"
+	ConsoleConsoleMessageSourceOther       ConsoleConsoleMessageSource = "other"
+	ConsoleConsoleMessageSourceDeprecation ConsoleConsoleMessageSource = "deprecation"
+	ConsoleConsoleMessageSourceWorker      ConsoleConsoleMessageSource = "worker"
+)
+
+// Valid returns true if the value is one of the ConsoleConsoleMessageSource enum values.
+func (e ConsoleConsoleMessageSource) Valid() bool {
+	switch e {
+	case ConsoleConsoleMessageSourceXml,
+		ConsoleConsoleMessageSourceJavascript,
+		ConsoleConsoleMessageSourceNetwork,
+		ConsoleConsoleMessageSourceConsoleApi,
+		ConsoleConsoleMessageSourceStorage,
+		ConsoleConsoleMessageSourceAppcache,
+		ConsoleConsoleMessageSourceRendering,
+		ConsoleConsoleMessageSourceSecurity,
+		ConsoleConsoleMessageSourceOther,
+		ConsoleConsoleMessageSourceDeprecation,
+		ConsoleConsoleMessageSourceWorker:
+		return true
+	}
+	return false
+}
+
+// Message severity.
+type ConsoleConsoleMessageLevel string
+
+const (
+	ConsoleConsoleMessageLevelLog     ConsoleConsoleMessageLevel = "log"
+	ConsoleConsoleMessageLevelWarning ConsoleConsoleMessageLevel = "warning"
+	ConsoleConsoleMessageLevelError   ConsoleConsoleMessageLevel = "error"
+	ConsoleConsoleMessageLevelDebug   ConsoleConsoleMessageLevel = "debug"
+	ConsoleConsoleMessageLevelInfo    ConsoleConsoleMessageLevel = "info"
+)
+
+// Valid returns true if the value is one of the ConsoleConsoleMessageLevel enum values.
+func (e ConsoleConsoleMessageLevel) Valid() bool {
+	switch e {
+	case ConsoleConsoleMessageLevelLog,
+		ConsoleConsoleMessageLevelWarning,
+		ConsoleConsoleMessageLevelError,
+		ConsoleConsoleMessageLevelDebug,
+		ConsoleConsoleMessageLevelInfo:
+		return true
+	}
+	return false
+}
+
 // Console message.
 type ConsoleConsoleMessage struct {
-	Source string `json:"source"`           // Message source.
-	Level  string `json:"level"`            // Message severity.
-	Text   string `json:"text"`             // Message text.
-	Url    string `json:"url,omitempty"`    // URL of the message origin.
-	Line   int    `json:"line,omitempty"`   // Line number in the resource that generated this message (1-based).
-	Column int    `json:"column,omitempty"` // Column number in the resource that generated this message (1-based).
+	Source ConsoleConsoleMessageSource `json:"source"`           // Message source.
+	Level  ConsoleConsoleMessageLevel  `json:"level"`            // Message severity.
+	Text   string                      `json:"text"`             // Message text.
+	Url    string                      `json:"url,omitempty"`    // URL of the message origin.
+	Line   int                         `json:"line,omitempty"`   // Line number in the resource that generated this message (1-based).
+	Column int                         `json:"column,omitempty"` // Column number in the resource that generated this message (1-based).
+}
+
+// Event method names for the Console domain
+const (
+	EventConsoleMessageAdded = "Console.messageAdded"
+)
+
+// ConsoleEvents lists all event method names for the Console domain
+var ConsoleEvents = []string{
+	EventConsoleMessageAdded,
 }
 
 // Issued when new console message is added.
@@ -27,6 +97,9 @@ type ConsoleMessageAddedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Console domain commands and events.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type Console struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -36,6 +109,19 @@ func NewConsole(target gcdmessage.ChromeTargeter) *Console {
 	return c
 }
 
+// OnMessageAdded subscribes to Console.messageAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Console) OnMessageAdded(callback func(*ConsoleMessageAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventConsoleMessageAdded, func(payload []byte) error {
+		event := &ConsoleMessageAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 // Does nothing.
 func (c *Console) ClearMessages(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Console.clearMessages"})
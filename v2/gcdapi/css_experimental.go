@@ -0,0 +1,1568 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains CSS functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnFontsUpdated subscribes to CSS.fontsUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *CSS) OnFontsUpdated(callback func(*CSSFontsUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventCSSFontsUpdated, func(payload []byte) error {
+		event := &CSSFontsUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnMediaQueryResultChanged subscribes to CSS.mediaQueryResultChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *CSS) OnMediaQueryResultChanged(callback func(*CSSMediaQueryResultChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventCSSMediaQueryResultChanged, func(payload []byte) error {
+		event := &CSSMediaQueryResultChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnStyleSheetAdded subscribes to CSS.styleSheetAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *CSS) OnStyleSheetAdded(callback func(*CSSStyleSheetAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventCSSStyleSheetAdded, func(payload []byte) error {
+		event := &CSSStyleSheetAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnStyleSheetChanged subscribes to CSS.styleSheetChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *CSS) OnStyleSheetChanged(callback func(*CSSStyleSheetChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventCSSStyleSheetChanged, func(payload []byte) error {
+		event := &CSSStyleSheetChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnStyleSheetRemoved subscribes to CSS.styleSheetRemoved events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *CSS) OnStyleSheetRemoved(callback func(*CSSStyleSheetRemovedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventCSSStyleSheetRemoved, func(payload []byte) error {
+		event := &CSSStyleSheetRemovedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnComputedStyleUpdated subscribes to CSS.computedStyleUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) OnComputedStyleUpdated(callback func(*CSSComputedStyleUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventCSSComputedStyleUpdated, func(payload []byte) error {
+		event := &CSSComputedStyleUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type CSSAddRuleParams struct {
+	// The css style sheet identifier where a new rule should be inserted.
+	StyleSheetId string `json:"styleSheetId"`
+	// The text of a new rule.
+	RuleText string `json:"ruleText"`
+	// Text position of a new rule in the target style sheet.
+	Location *CSSSourceRange `json:"location"`
+	// NodeId for the DOM node in whose context custom property declarations for registered properties should be validated. If omitted, declarations in the new rule text can only be validated statically, which may produce incorrect results if the declaration contains a var() for example.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	NodeForPropertySyntaxValidation int `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// AddRuleWithParams - Inserts a new rule with the given `ruleText` in a stylesheet with given `styleSheetId`, at the position specified by `location`.
+// Returns -  rule - The newly created rule.
+func (c *CSS) AddRuleWithParams(ctx context.Context, v *CSSAddRuleParams) (*CSSCSSRule, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.addRule", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Rule *CSSCSSRule
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Rule, nil
+}
+
+// AddRule - Inserts a new rule with the given `ruleText` in a stylesheet with given `styleSheetId`, at the position specified by `location`.
+// styleSheetId - The css style sheet identifier where a new rule should be inserted.
+// ruleText - The text of a new rule.
+// location - Text position of a new rule in the target style sheet.
+// nodeForPropertySyntaxValidation - NodeId for the DOM node in whose context custom property declarations for registered properties should be validated. If omitted, declarations in the new rule text can only be validated statically, which may produce incorrect results if the declaration contains a var() for example.
+// Returns -  rule - The newly created rule.
+func (c *CSS) AddRule(ctx context.Context, styleSheetId string, ruleText string, location *CSSSourceRange, nodeForPropertySyntaxValidation int) (*CSSCSSRule, error) {
+	var v CSSAddRuleParams
+	v.StyleSheetId = styleSheetId
+	v.RuleText = ruleText
+	v.Location = location
+	v.NodeForPropertySyntaxValidation = nodeForPropertySyntaxValidation
+	return c.AddRuleWithParams(ctx, &v)
+}
+
+type CSSCollectClassNamesParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+}
+
+// CollectClassNamesWithParams - Returns all class names from specified stylesheet.
+// Returns -  classNames - Class name list.
+func (c *CSS) CollectClassNamesWithParams(ctx context.Context, v *CSSCollectClassNamesParams) ([]string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.collectClassNames", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			ClassNames []string
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.ClassNames, nil
+}
+
+// CollectClassNames - Returns all class names from specified stylesheet.
+// styleSheetId -
+// Returns -  classNames - Class name list.
+func (c *CSS) CollectClassNames(ctx context.Context, styleSheetId string) ([]string, error) {
+	var v CSSCollectClassNamesParams
+	v.StyleSheetId = styleSheetId
+	return c.CollectClassNamesWithParams(ctx, &v)
+}
+
+type CSSCreateStyleSheetParams struct {
+	// Identifier of the frame where "via-inspector" stylesheet should be created.
+	FrameId string `json:"frameId"`
+	// If true, creates a new stylesheet for every call. If false, returns a stylesheet previously created by a call with force=false for the frame's document if it exists or creates a new stylesheet (default: false).
+	Force bool `json:"force,omitempty"`
+}
+
+// CreateStyleSheetWithParams - Creates a new special "via-inspector" stylesheet in the frame with given `frameId`.
+// Returns -  styleSheetId - Identifier of the created "via-inspector" stylesheet.
+func (c *CSS) CreateStyleSheetWithParams(ctx context.Context, v *CSSCreateStyleSheetParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.createStyleSheet", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			StyleSheetId string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.StyleSheetId, nil
+}
+
+// CreateStyleSheet - Creates a new special "via-inspector" stylesheet in the frame with given `frameId`.
+// frameId - Identifier of the frame where "via-inspector" stylesheet should be created.
+// force - If true, creates a new stylesheet for every call. If false, returns a stylesheet previously created by a call with force=false for the frame's document if it exists or creates a new stylesheet (default: false).
+// Returns -  styleSheetId - Identifier of the created "via-inspector" stylesheet.
+func (c *CSS) CreateStyleSheet(ctx context.Context, frameId string, force bool) (string, error) {
+	var v CSSCreateStyleSheetParams
+	v.FrameId = frameId
+	v.Force = force
+	return c.CreateStyleSheetWithParams(ctx, &v)
+}
+
+// Disables the CSS agent for the given page.
+func (c *CSS) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.disable"})
+}
+
+// Enables the CSS agent for the given page. Clients should not assume that the CSS agent has been enabled until the result of this command is received.
+func (c *CSS) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.enable"})
+}
+
+type CSSForcePseudoStateParams struct {
+	// The element id for which to force the pseudo state.
+	NodeId int `json:"nodeId"`
+	// Element pseudo classes to force when computing the element's style.
+	ForcedPseudoClasses []string `json:"forcedPseudoClasses"`
+}
+
+// ForcePseudoStateWithParams - Ensures that the given node will have specified pseudo-classes whenever its style is computed by the browser.
+func (c *CSS) ForcePseudoStateWithParams(ctx context.Context, v *CSSForcePseudoStateParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.forcePseudoState", Params: v})
+}
+
+// ForcePseudoState - Ensures that the given node will have specified pseudo-classes whenever its style is computed by the browser.
+// nodeId - The element id for which to force the pseudo state.
+// forcedPseudoClasses - Element pseudo classes to force when computing the element's style.
+func (c *CSS) ForcePseudoState(ctx context.Context, nodeId int, forcedPseudoClasses []string) (*gcdmessage.ChromeResponse, error) {
+	var v CSSForcePseudoStateParams
+	v.NodeId = nodeId
+	v.ForcedPseudoClasses = forcedPseudoClasses
+	return c.ForcePseudoStateWithParams(ctx, &v)
+}
+
+type CSSForceStartingStyleParams struct {
+	// The element id for which to force the starting-style state.
+	NodeId int `json:"nodeId"`
+	// Boolean indicating if this is on or off.
+	Forced bool `json:"forced"`
+}
+
+// ForceStartingStyleWithParams - Ensures that the given node is in its starting-style state.
+func (c *CSS) ForceStartingStyleWithParams(ctx context.Context, v *CSSForceStartingStyleParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.forceStartingStyle", Params: v})
+}
+
+// ForceStartingStyle - Ensures that the given node is in its starting-style state.
+// nodeId - The element id for which to force the starting-style state.
+// forced - Boolean indicating if this is on or off.
+func (c *CSS) ForceStartingStyle(ctx context.Context, nodeId int, forced bool) (*gcdmessage.ChromeResponse, error) {
+	var v CSSForceStartingStyleParams
+	v.NodeId = nodeId
+	v.Forced = forced
+	return c.ForceStartingStyleWithParams(ctx, &v)
+}
+
+type CSSGetBackgroundColorsParams struct {
+	// Id of the node to get background colors for.
+	NodeId int `json:"nodeId"`
+}
+
+// GetBackgroundColorsWithParams -
+// Returns -  backgroundColors - The range of background colors behind this element, if it contains any visible text. If no visible text is present, this will be undefined. In the case of a flat background color, this will consist of simply that color. In the case of a gradient, this will consist of each of the color stops. For anything more complicated, this will be an empty array. Images will be ignored (as if the image had failed to load). computedFontSize - The computed font size for this node, as a CSS computed value string (e.g. '12px'). computedFontWeight - The computed font weight for this node, as a CSS computed value string (e.g. 'normal' or '100').
+func (c *CSS) GetBackgroundColorsWithParams(ctx context.Context, v *CSSGetBackgroundColorsParams) ([]string, string, string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getBackgroundColors", Params: v})
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			BackgroundColors   []string
+			ComputedFontSize   string
+			ComputedFontWeight string
+		}
+	}
+
+	if resp == nil {
+		return nil, "", "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, "", "", err
+	}
+
+	if chromeData.Error != nil {
+		return nil, "", "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.BackgroundColors, chromeData.Result.ComputedFontSize, chromeData.Result.ComputedFontWeight, nil
+}
+
+// GetBackgroundColors -
+// nodeId - Id of the node to get background colors for.
+// Returns -  backgroundColors - The range of background colors behind this element, if it contains any visible text. If no visible text is present, this will be undefined. In the case of a flat background color, this will consist of simply that color. In the case of a gradient, this will consist of each of the color stops. For anything more complicated, this will be an empty array. Images will be ignored (as if the image had failed to load). computedFontSize - The computed font size for this node, as a CSS computed value string (e.g. '12px'). computedFontWeight - The computed font weight for this node, as a CSS computed value string (e.g. 'normal' or '100').
+func (c *CSS) GetBackgroundColors(ctx context.Context, nodeId int) ([]string, string, string, error) {
+	var v CSSGetBackgroundColorsParams
+	v.NodeId = nodeId
+	return c.GetBackgroundColorsWithParams(ctx, &v)
+}
+
+type CSSGetComputedStyleForNodeParams struct {
+	//
+	NodeId int `json:"nodeId"`
+}
+
+// GetComputedStyleForNodeWithParams - Returns the computed style for a DOM node identified by `nodeId`.
+// Returns -  computedStyle - Computed style for the specified DOM node.
+func (c *CSS) GetComputedStyleForNodeWithParams(ctx context.Context, v *CSSGetComputedStyleForNodeParams) ([]*CSSCSSComputedStyleProperty, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getComputedStyleForNode", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			ComputedStyle []*CSSCSSComputedStyleProperty
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.ComputedStyle, nil
+}
+
+// GetComputedStyleForNode - Returns the computed style for a DOM node identified by `nodeId`.
+// nodeId -
+// Returns -  computedStyle - Computed style for the specified DOM node.
+func (c *CSS) GetComputedStyleForNode(ctx context.Context, nodeId int) ([]*CSSCSSComputedStyleProperty, error) {
+	var v CSSGetComputedStyleForNodeParams
+	v.NodeId = nodeId
+	return c.GetComputedStyleForNodeWithParams(ctx, &v)
+}
+
+type CSSResolveValuesParams struct {
+	// Substitution functions (var()/env()/attr()) and cascade-dependent keywords (revert/revert-layer) do not work.
+	Values []string `json:"values"`
+	// Id of the node in whose context the expression is evaluated
+	NodeId int `json:"nodeId"`
+	// Only longhands and custom property names are accepted.
+	PropertyName string `json:"propertyName,omitempty"`
+	// Pseudo element type, only works for pseudo elements that generate elements in the tree, such as ::before and ::after. enum values: first-line, first-letter, checkmark, before, after, picker-icon, marker, backdrop, column, selection, search-text, target-text, spelling-error, grammar-error, highlight, first-line-inherited, scroll-marker, scroll-marker-group, scroll-button, scrollbar, scrollbar-thumb, scrollbar-button, scrollbar-track, scrollbar-track-piece, scrollbar-corner, resizer, input-list-button, view-transition, view-transition-group, view-transition-image-pair, view-transition-group-children, view-transition-old, view-transition-new, placeholder, file-selector-button, details-content, picker, permission-icon
+	PseudoType DOMPseudoType `json:"pseudoType,omitempty"`
+	// Pseudo element custom ident.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+}
+
+// ResolveValuesWithParams - Resolve the specified values in the context of the provided element. For example, a value of '1em' is evaluated according to the computed 'font-size' of the element and a value 'calc(1px + 2px)' will be resolved to '3px'. If the `propertyName` was specified the `values` are resolved as if they were property's declaration. If a value cannot be parsed according to the provided property syntax, the value is parsed using combined syntax as if null `propertyName` was provided. If the value cannot be resolved even then, return the provided value without any changes.
+// Returns -  results -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) ResolveValuesWithParams(ctx context.Context, v *CSSResolveValuesParams) ([]string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.resolveValues", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Results []string
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Results, nil
+}
+
+// ResolveValues - Resolve the specified values in the context of the provided element. For example, a value of '1em' is evaluated according to the computed 'font-size' of the element and a value 'calc(1px + 2px)' will be resolved to '3px'. If the `propertyName` was specified the `values` are resolved as if they were property's declaration. If a value cannot be parsed according to the provided property syntax, the value is parsed using combined syntax as if null `propertyName` was provided. If the value cannot be resolved even then, return the provided value without any changes.
+// values - Substitution functions (var()/env()/attr()) and cascade-dependent keywords (revert/revert-layer) do not work.
+// nodeId - Id of the node in whose context the expression is evaluated
+// propertyName - Only longhands and custom property names are accepted.
+// pseudoType - Pseudo element type, only works for pseudo elements that generate elements in the tree, such as ::before and ::after. enum values: first-line, first-letter, checkmark, before, after, picker-icon, marker, backdrop, column, selection, search-text, target-text, spelling-error, grammar-error, highlight, first-line-inherited, scroll-marker, scroll-marker-group, scroll-button, scrollbar, scrollbar-thumb, scrollbar-button, scrollbar-track, scrollbar-track-piece, scrollbar-corner, resizer, input-list-button, view-transition, view-transition-group, view-transition-image-pair, view-transition-group-children, view-transition-old, view-transition-new, placeholder, file-selector-button, details-content, picker, permission-icon
+// pseudoIdentifier - Pseudo element custom ident.
+// Returns -  results -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) ResolveValues(ctx context.Context, values []string, nodeId int, propertyName string, pseudoType DOMPseudoType, pseudoIdentifier string) ([]string, error) {
+	var v CSSResolveValuesParams
+	v.Values = values
+	v.NodeId = nodeId
+	v.PropertyName = propertyName
+	v.PseudoType = pseudoType
+	v.PseudoIdentifier = pseudoIdentifier
+	return c.ResolveValuesWithParams(ctx, &v)
+}
+
+type CSSGetLonghandPropertiesParams struct {
+	//
+	ShorthandName string `json:"shorthandName"`
+	//
+	Value string `json:"value"`
+}
+
+// GetLonghandPropertiesWithParams -
+// Returns -  longhandProperties -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetLonghandPropertiesWithParams(ctx context.Context, v *CSSGetLonghandPropertiesParams) ([]*CSSCSSProperty, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getLonghandProperties", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			LonghandProperties []*CSSCSSProperty
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.LonghandProperties, nil
+}
+
+// GetLonghandProperties -
+// shorthandName -
+// value -
+// Returns -  longhandProperties -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetLonghandProperties(ctx context.Context, shorthandName string, value string) ([]*CSSCSSProperty, error) {
+	var v CSSGetLonghandPropertiesParams
+	v.ShorthandName = shorthandName
+	v.Value = value
+	return c.GetLonghandPropertiesWithParams(ctx, &v)
+}
+
+type CSSGetInlineStylesForNodeParams struct {
+	//
+	NodeId int `json:"nodeId"`
+}
+
+// GetInlineStylesForNodeWithParams - Returns the styles defined inline (explicitly in the "style" attribute and implicitly, using DOM attributes) for a DOM node identified by `nodeId`.
+// Returns -  inlineStyle - Inline style for the specified DOM node. attributesStyle - Attribute-defined element style (e.g. resulting from "width=20 height=100%").
+func (c *CSS) GetInlineStylesForNodeWithParams(ctx context.Context, v *CSSGetInlineStylesForNodeParams) (*CSSCSSStyle, *CSSCSSStyle, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getInlineStylesForNode", Params: v})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			InlineStyle     *CSSCSSStyle
+			AttributesStyle *CSSCSSStyle
+		}
+	}
+
+	if resp == nil {
+		return nil, nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.InlineStyle, chromeData.Result.AttributesStyle, nil
+}
+
+// GetInlineStylesForNode - Returns the styles defined inline (explicitly in the "style" attribute and implicitly, using DOM attributes) for a DOM node identified by `nodeId`.
+// nodeId -
+// Returns -  inlineStyle - Inline style for the specified DOM node. attributesStyle - Attribute-defined element style (e.g. resulting from "width=20 height=100%").
+func (c *CSS) GetInlineStylesForNode(ctx context.Context, nodeId int) (*CSSCSSStyle, *CSSCSSStyle, error) {
+	var v CSSGetInlineStylesForNodeParams
+	v.NodeId = nodeId
+	return c.GetInlineStylesForNodeWithParams(ctx, &v)
+}
+
+type CSSGetAnimatedStylesForNodeParams struct {
+	//
+	NodeId int `json:"nodeId"`
+}
+
+// GetAnimatedStylesForNodeWithParams - Returns the styles coming from animations & transitions including the animation & transition styles coming from inheritance chain.
+// Returns -  animationStyles - Styles coming from animations. transitionsStyle - Style coming from transitions. inherited - Inherited style entries for animationsStyle and transitionsStyle from the inheritance chain of the element.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetAnimatedStylesForNodeWithParams(ctx context.Context, v *CSSGetAnimatedStylesForNodeParams) ([]*CSSCSSAnimationStyle, *CSSCSSStyle, []*CSSInheritedAnimatedStyleEntry, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getAnimatedStylesForNode", Params: v})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			AnimationStyles  []*CSSCSSAnimationStyle
+			TransitionsStyle *CSSCSSStyle
+			Inherited        []*CSSInheritedAnimatedStyleEntry
+		}
+	}
+
+	if resp == nil {
+		return nil, nil, nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, nil, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.AnimationStyles, chromeData.Result.TransitionsStyle, chromeData.Result.Inherited, nil
+}
+
+// GetAnimatedStylesForNode - Returns the styles coming from animations & transitions including the animation & transition styles coming from inheritance chain.
+// nodeId -
+// Returns -  animationStyles - Styles coming from animations. transitionsStyle - Style coming from transitions. inherited - Inherited style entries for animationsStyle and transitionsStyle from the inheritance chain of the element.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetAnimatedStylesForNode(ctx context.Context, nodeId int) ([]*CSSCSSAnimationStyle, *CSSCSSStyle, []*CSSInheritedAnimatedStyleEntry, error) {
+	var v CSSGetAnimatedStylesForNodeParams
+	v.NodeId = nodeId
+	return c.GetAnimatedStylesForNodeWithParams(ctx, &v)
+}
+
+type CSSGetMatchedStylesForNodeParams struct {
+	//
+	NodeId int `json:"nodeId"`
+}
+
+// GetMatchedStylesForNodeWithParams - Returns requested styles for a DOM node identified by `nodeId`.
+// Returns -  inlineStyle - Inline style for the specified DOM node. attributesStyle - Attribute-defined element style (e.g. resulting from "width=20 height=100%"). matchedCSSRules - CSS rules matching this node, from all applicable stylesheets. pseudoElements - Pseudo style matches for this node. inherited - A chain of inherited styles (from the immediate node parent up to the DOM tree root). inheritedPseudoElements - A chain of inherited pseudo element styles (from the immediate node parent up to the DOM tree root). cssKeyframesRules - A list of CSS keyframed animations matching this node. cssPositionTryRules - A list of CSS @position-try rules matching this node, based on the position-try-fallbacks property. activePositionFallbackIndex - Index of the active fallback in the applied position-try-fallback property, will not be set if there is no active position-try fallback. cssPropertyRules - A list of CSS at-property rules matching this node. cssPropertyRegistrations - A list of CSS property registrations matching this node. cssFontPaletteValuesRule - A font-palette-values rule matching this node. parentLayoutNodeId - Id of the first parent element that does not have display: contents. cssFunctionRules - A list of CSS at-function rules referenced by styles of this node.
+func (c *CSS) GetMatchedStylesForNodeWithParams(ctx context.Context, v *CSSGetMatchedStylesForNodeParams) (*CSSCSSStyle, *CSSCSSStyle, []*CSSRuleMatch, []*CSSPseudoElementMatches, []*CSSInheritedStyleEntry, []*CSSInheritedPseudoElementMatches, []*CSSCSSKeyframesRule, []*CSSCSSPositionTryRule, int, []*CSSCSSPropertyRule, []*CSSCSSPropertyRegistration, *CSSCSSFontPaletteValuesRule, int, []*CSSCSSFunctionRule, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getMatchedStylesForNode", Params: v})
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, 0, nil, nil, nil, 0, nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			InlineStyle                 *CSSCSSStyle
+			AttributesStyle             *CSSCSSStyle
+			MatchedCSSRules             []*CSSRuleMatch
+			PseudoElements              []*CSSPseudoElementMatches
+			Inherited                   []*CSSInheritedStyleEntry
+			InheritedPseudoElements     []*CSSInheritedPseudoElementMatches
+			CssKeyframesRules           []*CSSCSSKeyframesRule
+			CssPositionTryRules         []*CSSCSSPositionTryRule
+			ActivePositionFallbackIndex int
+			CssPropertyRules            []*CSSCSSPropertyRule
+			CssPropertyRegistrations    []*CSSCSSPropertyRegistration
+			CssFontPaletteValuesRule    *CSSCSSFontPaletteValuesRule
+			ParentLayoutNodeId          int
+			CssFunctionRules            []*CSSCSSFunctionRule
+		}
+	}
+
+	if resp == nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, 0, nil, nil, nil, 0, nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, 0, nil, nil, nil, 0, nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, 0, nil, nil, nil, 0, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.InlineStyle, chromeData.Result.AttributesStyle, chromeData.Result.MatchedCSSRules, chromeData.Result.PseudoElements, chromeData.Result.Inherited, chromeData.Result.InheritedPseudoElements, chromeData.Result.CssKeyframesRules, chromeData.Result.CssPositionTryRules, chromeData.Result.ActivePositionFallbackIndex, chromeData.Result.CssPropertyRules, chromeData.Result.CssPropertyRegistrations, chromeData.Result.CssFontPaletteValuesRule, chromeData.Result.ParentLayoutNodeId, chromeData.Result.CssFunctionRules, nil
+}
+
+// GetMatchedStylesForNode - Returns requested styles for a DOM node identified by `nodeId`.
+// nodeId -
+// Returns -  inlineStyle - Inline style for the specified DOM node. attributesStyle - Attribute-defined element style (e.g. resulting from "width=20 height=100%"). matchedCSSRules - CSS rules matching this node, from all applicable stylesheets. pseudoElements - Pseudo style matches for this node. inherited - A chain of inherited styles (from the immediate node parent up to the DOM tree root). inheritedPseudoElements - A chain of inherited pseudo element styles (from the immediate node parent up to the DOM tree root). cssKeyframesRules - A list of CSS keyframed animations matching this node. cssPositionTryRules - A list of CSS @position-try rules matching this node, based on the position-try-fallbacks property. activePositionFallbackIndex - Index of the active fallback in the applied position-try-fallback property, will not be set if there is no active position-try fallback. cssPropertyRules - A list of CSS at-property rules matching this node. cssPropertyRegistrations - A list of CSS property registrations matching this node. cssFontPaletteValuesRule - A font-palette-values rule matching this node. parentLayoutNodeId - Id of the first parent element that does not have display: contents. cssFunctionRules - A list of CSS at-function rules referenced by styles of this node.
+func (c *CSS) GetMatchedStylesForNode(ctx context.Context, nodeId int) (*CSSCSSStyle, *CSSCSSStyle, []*CSSRuleMatch, []*CSSPseudoElementMatches, []*CSSInheritedStyleEntry, []*CSSInheritedPseudoElementMatches, []*CSSCSSKeyframesRule, []*CSSCSSPositionTryRule, int, []*CSSCSSPropertyRule, []*CSSCSSPropertyRegistration, *CSSCSSFontPaletteValuesRule, int, []*CSSCSSFunctionRule, error) {
+	var v CSSGetMatchedStylesForNodeParams
+	v.NodeId = nodeId
+	return c.GetMatchedStylesForNodeWithParams(ctx, &v)
+}
+
+// GetEnvironmentVariables - Returns the values of the default UA-defined environment variables used in env()
+// Returns -  environmentVariables -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetEnvironmentVariables(ctx context.Context) (map[string]interface{}, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getEnvironmentVariables"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			EnvironmentVariables map[string]interface{}
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.EnvironmentVariables, nil
+}
+
+// GetMediaQueries - Returns all media queries parsed by the rendering engine.
+// Returns -  medias -
+func (c *CSS) GetMediaQueries(ctx context.Context) ([]*CSSCSSMedia, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getMediaQueries"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Medias []*CSSCSSMedia
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Medias, nil
+}
+
+type CSSGetPlatformFontsForNodeParams struct {
+	//
+	NodeId int `json:"nodeId"`
+}
+
+// GetPlatformFontsForNodeWithParams - Requests information about platform fonts which we used to render child TextNodes in the given node.
+// Returns -  fonts - Usage statistics for every employed platform font.
+func (c *CSS) GetPlatformFontsForNodeWithParams(ctx context.Context, v *CSSGetPlatformFontsForNodeParams) ([]*CSSPlatformFontUsage, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getPlatformFontsForNode", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Fonts []*CSSPlatformFontUsage
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Fonts, nil
+}
+
+// GetPlatformFontsForNode - Requests information about platform fonts which we used to render child TextNodes in the given node.
+// nodeId -
+// Returns -  fonts - Usage statistics for every employed platform font.
+func (c *CSS) GetPlatformFontsForNode(ctx context.Context, nodeId int) ([]*CSSPlatformFontUsage, error) {
+	var v CSSGetPlatformFontsForNodeParams
+	v.NodeId = nodeId
+	return c.GetPlatformFontsForNodeWithParams(ctx, &v)
+}
+
+type CSSGetStyleSheetTextParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+}
+
+// GetStyleSheetTextWithParams - Returns the current textual content for a stylesheet.
+// Returns -  text - The stylesheet text.
+func (c *CSS) GetStyleSheetTextWithParams(ctx context.Context, v *CSSGetStyleSheetTextParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getStyleSheetText", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Text string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Text, nil
+}
+
+// GetStyleSheetText - Returns the current textual content for a stylesheet.
+// styleSheetId -
+// Returns -  text - The stylesheet text.
+func (c *CSS) GetStyleSheetText(ctx context.Context, styleSheetId string) (string, error) {
+	var v CSSGetStyleSheetTextParams
+	v.StyleSheetId = styleSheetId
+	return c.GetStyleSheetTextWithParams(ctx, &v)
+}
+
+type CSSGetLayersForNodeParams struct {
+	//
+	NodeId int `json:"nodeId"`
+}
+
+// GetLayersForNodeWithParams - Returns all layers parsed by the rendering engine for the tree scope of a node. Given a DOM element identified by nodeId, getLayersForNode returns the root layer for the nearest ancestor document or shadow root. The layer root contains the full layer tree for the tree scope and their ordering.
+// Returns -  rootLayer -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetLayersForNodeWithParams(ctx context.Context, v *CSSGetLayersForNodeParams) (*CSSCSSLayerData, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getLayersForNode", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			RootLayer *CSSCSSLayerData
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.RootLayer, nil
+}
+
+// GetLayersForNode - Returns all layers parsed by the rendering engine for the tree scope of a node. Given a DOM element identified by nodeId, getLayersForNode returns the root layer for the nearest ancestor document or shadow root. The layer root contains the full layer tree for the tree scope and their ordering.
+// nodeId -
+// Returns -  rootLayer -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetLayersForNode(ctx context.Context, nodeId int) (*CSSCSSLayerData, error) {
+	var v CSSGetLayersForNodeParams
+	v.NodeId = nodeId
+	return c.GetLayersForNodeWithParams(ctx, &v)
+}
+
+type CSSGetLocationForSelectorParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	SelectorText string `json:"selectorText"`
+}
+
+// GetLocationForSelectorWithParams - Given a CSS selector text and a style sheet ID, getLocationForSelector returns an array of locations of the CSS selector in the style sheet.
+// Returns -  ranges -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetLocationForSelectorWithParams(ctx context.Context, v *CSSGetLocationForSelectorParams) ([]*CSSSourceRange, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.getLocationForSelector", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Ranges []*CSSSourceRange
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Ranges, nil
+}
+
+// GetLocationForSelector - Given a CSS selector text and a style sheet ID, getLocationForSelector returns an array of locations of the CSS selector in the style sheet.
+// styleSheetId -
+// selectorText -
+// Returns -  ranges -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) GetLocationForSelector(ctx context.Context, styleSheetId string, selectorText string) ([]*CSSSourceRange, error) {
+	var v CSSGetLocationForSelectorParams
+	v.StyleSheetId = styleSheetId
+	v.SelectorText = selectorText
+	return c.GetLocationForSelectorWithParams(ctx, &v)
+}
+
+type CSSTrackComputedStyleUpdatesForNodeParams struct {
+	//
+	NodeId int `json:"nodeId,omitempty"`
+}
+
+// TrackComputedStyleUpdatesForNodeWithParams - Starts tracking the given node for the computed style updates and whenever the computed style is updated for node, it queues a `computedStyleUpdated` event with throttling. There can only be 1 node tracked for computed style updates so passing a new node id removes tracking from the previous node. Pass `undefined` to disable tracking.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) TrackComputedStyleUpdatesForNodeWithParams(ctx context.Context, v *CSSTrackComputedStyleUpdatesForNodeParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.trackComputedStyleUpdatesForNode", Params: v})
+}
+
+// TrackComputedStyleUpdatesForNode - Starts tracking the given node for the computed style updates and whenever the computed style is updated for node, it queues a `computedStyleUpdated` event with throttling. There can only be 1 node tracked for computed style updates so passing a new node id removes tracking from the previous node. Pass `undefined` to disable tracking.
+// nodeId -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) TrackComputedStyleUpdatesForNode(ctx context.Context, nodeId int) (*gcdmessage.ChromeResponse, error) {
+	var v CSSTrackComputedStyleUpdatesForNodeParams
+	v.NodeId = nodeId
+	return c.TrackComputedStyleUpdatesForNodeWithParams(ctx, &v)
+}
+
+type CSSTrackComputedStyleUpdatesParams struct {
+	//
+	PropertiesToTrack []*CSSCSSComputedStyleProperty `json:"propertiesToTrack"`
+}
+
+// TrackComputedStyleUpdatesWithParams - Starts tracking the given computed styles for updates. The specified array of properties replaces the one previously specified. Pass empty array to disable tracking. Use takeComputedStyleUpdates to retrieve the list of nodes that had properties modified. The changes to computed style properties are only tracked for nodes pushed to the front-end by the DOM agent. If no changes to the tracked properties occur after the node has been pushed to the front-end, no updates will be issued for the node.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) TrackComputedStyleUpdatesWithParams(ctx context.Context, v *CSSTrackComputedStyleUpdatesParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.trackComputedStyleUpdates", Params: v})
+}
+
+// TrackComputedStyleUpdates - Starts tracking the given computed styles for updates. The specified array of properties replaces the one previously specified. Pass empty array to disable tracking. Use takeComputedStyleUpdates to retrieve the list of nodes that had properties modified. The changes to computed style properties are only tracked for nodes pushed to the front-end by the DOM agent. If no changes to the tracked properties occur after the node has been pushed to the front-end, no updates will be issued for the node.
+// propertiesToTrack -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) TrackComputedStyleUpdates(ctx context.Context, propertiesToTrack []*CSSCSSComputedStyleProperty) (*gcdmessage.ChromeResponse, error) {
+	var v CSSTrackComputedStyleUpdatesParams
+	v.PropertiesToTrack = propertiesToTrack
+	return c.TrackComputedStyleUpdatesWithParams(ctx, &v)
+}
+
+// TakeComputedStyleUpdates - Polls the next batch of computed style updates.
+// Returns -  nodeIds - The list of node Ids that have their tracked computed styles updated.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) TakeComputedStyleUpdates(ctx context.Context) ([]int, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.takeComputedStyleUpdates"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			NodeIds []int
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.NodeIds, nil
+}
+
+type CSSSetEffectivePropertyValueForNodeParams struct {
+	// The element id for which to set property.
+	NodeId int `json:"nodeId"`
+	//
+	PropertyName string `json:"propertyName"`
+	//
+	Value string `json:"value"`
+}
+
+// SetEffectivePropertyValueForNodeWithParams - Find a rule with the given active property for the given node and set the new value for this property
+func (c *CSS) SetEffectivePropertyValueForNodeWithParams(ctx context.Context, v *CSSSetEffectivePropertyValueForNodeParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setEffectivePropertyValueForNode", Params: v})
+}
+
+// SetEffectivePropertyValueForNode - Find a rule with the given active property for the given node and set the new value for this property
+// nodeId - The element id for which to set property.
+// propertyName -
+// value -
+func (c *CSS) SetEffectivePropertyValueForNode(ctx context.Context, nodeId int, propertyName string, value string) (*gcdmessage.ChromeResponse, error) {
+	var v CSSSetEffectivePropertyValueForNodeParams
+	v.NodeId = nodeId
+	v.PropertyName = propertyName
+	v.Value = value
+	return c.SetEffectivePropertyValueForNodeWithParams(ctx, &v)
+}
+
+type CSSSetPropertyRulePropertyNameParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	TheRange *CSSSourceRange `json:"range"`
+	//
+	PropertyName string `json:"propertyName"`
+}
+
+// SetPropertyRulePropertyNameWithParams - Modifies the property rule property name.
+// Returns -  propertyName - The resulting key text after modification.
+func (c *CSS) SetPropertyRulePropertyNameWithParams(ctx context.Context, v *CSSSetPropertyRulePropertyNameParams) (*CSSValue, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setPropertyRulePropertyName", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			PropertyName *CSSValue
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.PropertyName, nil
+}
+
+// SetPropertyRulePropertyName - Modifies the property rule property name.
+// styleSheetId -
+// range -
+// propertyName -
+// Returns -  propertyName - The resulting key text after modification.
+func (c *CSS) SetPropertyRulePropertyName(ctx context.Context, styleSheetId string, theRange *CSSSourceRange, propertyName string) (*CSSValue, error) {
+	var v CSSSetPropertyRulePropertyNameParams
+	v.StyleSheetId = styleSheetId
+	v.TheRange = theRange
+	v.PropertyName = propertyName
+	return c.SetPropertyRulePropertyNameWithParams(ctx, &v)
+}
+
+type CSSSetKeyframeKeyParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	TheRange *CSSSourceRange `json:"range"`
+	//
+	KeyText string `json:"keyText"`
+}
+
+// SetKeyframeKeyWithParams - Modifies the keyframe rule key text.
+// Returns -  keyText - The resulting key text after modification.
+func (c *CSS) SetKeyframeKeyWithParams(ctx context.Context, v *CSSSetKeyframeKeyParams) (*CSSValue, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setKeyframeKey", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			KeyText *CSSValue
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.KeyText, nil
+}
+
+// SetKeyframeKey - Modifies the keyframe rule key text.
+// styleSheetId -
+// range -
+// keyText -
+// Returns -  keyText - The resulting key text after modification.
+func (c *CSS) SetKeyframeKey(ctx context.Context, styleSheetId string, theRange *CSSSourceRange, keyText string) (*CSSValue, error) {
+	var v CSSSetKeyframeKeyParams
+	v.StyleSheetId = styleSheetId
+	v.TheRange = theRange
+	v.KeyText = keyText
+	return c.SetKeyframeKeyWithParams(ctx, &v)
+}
+
+type CSSSetMediaTextParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	TheRange *CSSSourceRange `json:"range"`
+	//
+	Text string `json:"text"`
+}
+
+// SetMediaTextWithParams - Modifies the rule selector.
+// Returns -  media - The resulting CSS media rule after modification.
+func (c *CSS) SetMediaTextWithParams(ctx context.Context, v *CSSSetMediaTextParams) (*CSSCSSMedia, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setMediaText", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Media *CSSCSSMedia
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Media, nil
+}
+
+// SetMediaText - Modifies the rule selector.
+// styleSheetId -
+// range -
+// text -
+// Returns -  media - The resulting CSS media rule after modification.
+func (c *CSS) SetMediaText(ctx context.Context, styleSheetId string, theRange *CSSSourceRange, text string) (*CSSCSSMedia, error) {
+	var v CSSSetMediaTextParams
+	v.StyleSheetId = styleSheetId
+	v.TheRange = theRange
+	v.Text = text
+	return c.SetMediaTextWithParams(ctx, &v)
+}
+
+type CSSSetContainerQueryTextParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	TheRange *CSSSourceRange `json:"range"`
+	//
+	Text string `json:"text"`
+}
+
+// SetContainerQueryTextWithParams - Modifies the expression of a container query.
+// Returns -  containerQuery - The resulting CSS container query rule after modification.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) SetContainerQueryTextWithParams(ctx context.Context, v *CSSSetContainerQueryTextParams) (*CSSCSSContainerQuery, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setContainerQueryText", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			ContainerQuery *CSSCSSContainerQuery
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.ContainerQuery, nil
+}
+
+// SetContainerQueryText - Modifies the expression of a container query.
+// styleSheetId -
+// range -
+// text -
+// Returns -  containerQuery - The resulting CSS container query rule after modification.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) SetContainerQueryText(ctx context.Context, styleSheetId string, theRange *CSSSourceRange, text string) (*CSSCSSContainerQuery, error) {
+	var v CSSSetContainerQueryTextParams
+	v.StyleSheetId = styleSheetId
+	v.TheRange = theRange
+	v.Text = text
+	return c.SetContainerQueryTextWithParams(ctx, &v)
+}
+
+type CSSSetSupportsTextParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	TheRange *CSSSourceRange `json:"range"`
+	//
+	Text string `json:"text"`
+}
+
+// SetSupportsTextWithParams - Modifies the expression of a supports at-rule.
+// Returns -  supports - The resulting CSS Supports rule after modification.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) SetSupportsTextWithParams(ctx context.Context, v *CSSSetSupportsTextParams) (*CSSCSSSupports, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setSupportsText", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Supports *CSSCSSSupports
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Supports, nil
+}
+
+// SetSupportsText - Modifies the expression of a supports at-rule.
+// styleSheetId -
+// range -
+// text -
+// Returns -  supports - The resulting CSS Supports rule after modification.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) SetSupportsText(ctx context.Context, styleSheetId string, theRange *CSSSourceRange, text string) (*CSSCSSSupports, error) {
+	var v CSSSetSupportsTextParams
+	v.StyleSheetId = styleSheetId
+	v.TheRange = theRange
+	v.Text = text
+	return c.SetSupportsTextWithParams(ctx, &v)
+}
+
+type CSSSetScopeTextParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	TheRange *CSSSourceRange `json:"range"`
+	//
+	Text string `json:"text"`
+}
+
+// SetScopeTextWithParams - Modifies the expression of a scope at-rule.
+// Returns -  scope - The resulting CSS Scope rule after modification.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) SetScopeTextWithParams(ctx context.Context, v *CSSSetScopeTextParams) (*CSSCSSScope, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setScopeText", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Scope *CSSCSSScope
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Scope, nil
+}
+
+// SetScopeText - Modifies the expression of a scope at-rule.
+// styleSheetId -
+// range -
+// text -
+// Returns -  scope - The resulting CSS Scope rule after modification.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) SetScopeText(ctx context.Context, styleSheetId string, theRange *CSSSourceRange, text string) (*CSSCSSScope, error) {
+	var v CSSSetScopeTextParams
+	v.StyleSheetId = styleSheetId
+	v.TheRange = theRange
+	v.Text = text
+	return c.SetScopeTextWithParams(ctx, &v)
+}
+
+type CSSSetRuleSelectorParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	TheRange *CSSSourceRange `json:"range"`
+	//
+	Selector string `json:"selector"`
+}
+
+// SetRuleSelectorWithParams - Modifies the rule selector.
+// Returns -  selectorList - The resulting selector list after modification.
+func (c *CSS) SetRuleSelectorWithParams(ctx context.Context, v *CSSSetRuleSelectorParams) (*CSSSelectorList, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setRuleSelector", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			SelectorList *CSSSelectorList
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.SelectorList, nil
+}
+
+// SetRuleSelector - Modifies the rule selector.
+// styleSheetId -
+// range -
+// selector -
+// Returns -  selectorList - The resulting selector list after modification.
+func (c *CSS) SetRuleSelector(ctx context.Context, styleSheetId string, theRange *CSSSourceRange, selector string) (*CSSSelectorList, error) {
+	var v CSSSetRuleSelectorParams
+	v.StyleSheetId = styleSheetId
+	v.TheRange = theRange
+	v.Selector = selector
+	return c.SetRuleSelectorWithParams(ctx, &v)
+}
+
+type CSSSetStyleSheetTextParams struct {
+	//
+	StyleSheetId string `json:"styleSheetId"`
+	//
+	Text string `json:"text"`
+}
+
+// SetStyleSheetTextWithParams - Sets the new stylesheet text.
+// Returns -  sourceMapURL - URL of source map associated with script (if any).
+func (c *CSS) SetStyleSheetTextWithParams(ctx context.Context, v *CSSSetStyleSheetTextParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setStyleSheetText", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			SourceMapURL string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.SourceMapURL, nil
+}
+
+// SetStyleSheetText - Sets the new stylesheet text.
+// styleSheetId -
+// text -
+// Returns -  sourceMapURL - URL of source map associated with script (if any).
+func (c *CSS) SetStyleSheetText(ctx context.Context, styleSheetId string, text string) (string, error) {
+	var v CSSSetStyleSheetTextParams
+	v.StyleSheetId = styleSheetId
+	v.Text = text
+	return c.SetStyleSheetTextWithParams(ctx, &v)
+}
+
+type CSSSetStyleTextsParams struct {
+	//
+	Edits []*CSSStyleDeclarationEdit `json:"edits"`
+	// NodeId for the DOM node in whose context custom property declarations for registered properties should be validated. If omitted, declarations in the new rule text can only be validated statically, which may produce incorrect results if the declaration contains a var() for example.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	NodeForPropertySyntaxValidation int `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// SetStyleTextsWithParams - Applies specified style edits one after another in the given order.
+// Returns -  styles - The resulting styles after modification.
+func (c *CSS) SetStyleTextsWithParams(ctx context.Context, v *CSSSetStyleTextsParams) ([]*CSSCSSStyle, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setStyleTexts", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Styles []*CSSCSSStyle
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Styles, nil
+}
+
+// SetStyleTexts - Applies specified style edits one after another in the given order.
+// edits -
+// nodeForPropertySyntaxValidation - NodeId for the DOM node in whose context custom property declarations for registered properties should be validated. If omitted, declarations in the new rule text can only be validated statically, which may produce incorrect results if the declaration contains a var() for example.
+// Returns -  styles - The resulting styles after modification.
+func (c *CSS) SetStyleTexts(ctx context.Context, edits []*CSSStyleDeclarationEdit, nodeForPropertySyntaxValidation int) ([]*CSSCSSStyle, error) {
+	var v CSSSetStyleTextsParams
+	v.Edits = edits
+	v.NodeForPropertySyntaxValidation = nodeForPropertySyntaxValidation
+	return c.SetStyleTextsWithParams(ctx, &v)
+}
+
+// Enables the selector recording.
+func (c *CSS) StartRuleUsageTracking(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.startRuleUsageTracking"})
+}
+
+// StopRuleUsageTracking - Stop tracking rule usage and return the list of rules that were used since last call to `takeCoverageDelta` (or since start of coverage instrumentation).
+// Returns -  ruleUsage -
+func (c *CSS) StopRuleUsageTracking(ctx context.Context) ([]*CSSRuleUsage, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.stopRuleUsageTracking"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			RuleUsage []*CSSRuleUsage
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.RuleUsage, nil
+}
+
+// TakeCoverageDelta - Obtain list of rules that became used since last call to this method (or since start of coverage instrumentation).
+// Returns -  coverage -  timestamp - Monotonically increasing time, in seconds.
+func (c *CSS) TakeCoverageDelta(ctx context.Context) ([]*CSSRuleUsage, float64, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.takeCoverageDelta"})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Coverage  []*CSSRuleUsage
+			Timestamp float64
+		}
+	}
+
+	if resp == nil {
+		return nil, 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, 0, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Coverage, chromeData.Result.Timestamp, nil
+}
+
+type CSSSetLocalFontsEnabledParams struct {
+	// Whether rendering of local fonts is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// SetLocalFontsEnabledWithParams - Enables/disables rendering of local CSS fonts (enabled by default).
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) SetLocalFontsEnabledWithParams(ctx context.Context, v *CSSSetLocalFontsEnabledParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "CSS.setLocalFontsEnabled", Params: v})
+}
+
+// SetLocalFontsEnabled - Enables/disables rendering of local CSS fonts (enabled by default).
+// enabled - Whether rendering of local fonts is enabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *CSS) SetLocalFontsEnabled(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
+	var v CSSSetLocalFontsEnabledParams
+	v.Enabled = enabled
+	return c.SetLocalFontsEnabledWithParams(ctx, &v)
+}
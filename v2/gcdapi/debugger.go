@@ -9,6 +9,214 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Scope type.
+type DebuggerScopeType string
+
+const (
+	DebuggerScopeTypeGlobal              DebuggerScopeType = "global"
+	DebuggerScopeTypeLocal               DebuggerScopeType = "local"
+	DebuggerScopeTypeWith                DebuggerScopeType = "with"
+	DebuggerScopeTypeClosure             DebuggerScopeType = "closure"
+	DebuggerScopeTypeCatch               DebuggerScopeType = "catch"
+	DebuggerScopeTypeBlock               DebuggerScopeType = "block"
+	DebuggerScopeTypeScript              DebuggerScopeType = "script"
+	DebuggerScopeTypeEval                DebuggerScopeType = "eval"
+	DebuggerScopeTypeModule              DebuggerScopeType = "module"
+	DebuggerScopeTypeWasmExpressionStack DebuggerScopeType = "wasm-expression-stack"
+)
+
+// Valid returns true if the value is one of the DebuggerScopeType enum values.
+func (e DebuggerScopeType) Valid() bool {
+	switch e {
+	case DebuggerScopeTypeGlobal,
+		DebuggerScopeTypeLocal,
+		DebuggerScopeTypeWith,
+		DebuggerScopeTypeClosure,
+		DebuggerScopeTypeCatch,
+		DebuggerScopeTypeBlock,
+		DebuggerScopeTypeScript,
+		DebuggerScopeTypeEval,
+		DebuggerScopeTypeModule,
+		DebuggerScopeTypeWasmExpressionStack:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type DebuggerBreakLocationType string
+
+const (
+	DebuggerBreakLocationTypeDebuggerStatement DebuggerBreakLocationType = "debuggerStatement"
+	DebuggerBreakLocationTypeCall              DebuggerBreakLocationType = "call"
+	DebuggerBreakLocationTypeReturn            DebuggerBreakLocationType = "return"
+)
+
+// Valid returns true if the value is one of the DebuggerBreakLocationType enum values.
+func (e DebuggerBreakLocationType) Valid() bool {
+	switch e {
+	case DebuggerBreakLocationTypeDebuggerStatement,
+		DebuggerBreakLocationTypeCall,
+		DebuggerBreakLocationTypeReturn:
+		return true
+	}
+	return false
+}
+
+// Enum of possible script languages.
+type DebuggerScriptLanguage string
+
+const (
+	DebuggerScriptLanguageJavaScript  DebuggerScriptLanguage = "JavaScript"
+	DebuggerScriptLanguageWebAssembly DebuggerScriptLanguage = "WebAssembly"
+)
+
+// Valid returns true if the value is one of the DebuggerScriptLanguage enum values.
+func (e DebuggerScriptLanguage) Valid() bool {
+	switch e {
+	case DebuggerScriptLanguageJavaScript,
+		DebuggerScriptLanguageWebAssembly:
+		return true
+	}
+	return false
+}
+
+// Type of the debug symbols.
+type DebuggerDebugSymbolsType string
+
+const (
+	DebuggerDebugSymbolsTypeSourceMap     DebuggerDebugSymbolsType = "SourceMap"
+	DebuggerDebugSymbolsTypeEmbeddedDWARF DebuggerDebugSymbolsType = "EmbeddedDWARF"
+	DebuggerDebugSymbolsTypeExternalDWARF DebuggerDebugSymbolsType = "ExternalDWARF"
+)
+
+// Valid returns true if the value is one of the DebuggerDebugSymbolsType enum values.
+func (e DebuggerDebugSymbolsType) Valid() bool {
+	switch e {
+	case DebuggerDebugSymbolsTypeSourceMap,
+		DebuggerDebugSymbolsTypeEmbeddedDWARF,
+		DebuggerDebugSymbolsTypeExternalDWARF:
+		return true
+	}
+	return false
+}
+
+// Pause reason.
+type DebuggerPausedReason string
+
+const (
+	DebuggerPausedReasonAmbiguous        DebuggerPausedReason = "ambiguous"
+	DebuggerPausedReasonAssert           DebuggerPausedReason = "assert"
+	DebuggerPausedReasonCSPViolation     DebuggerPausedReason = "CSPViolation"
+	DebuggerPausedReasonDebugCommand     DebuggerPausedReason = "debugCommand"
+	DebuggerPausedReasonDOM              DebuggerPausedReason = "DOM"
+	DebuggerPausedReasonEventListener    DebuggerPausedReason = "EventListener"
+	DebuggerPausedReasonException        DebuggerPausedReason = "exception"
+	DebuggerPausedReasonInstrumentation  DebuggerPausedReason = "instrumentation"
+	DebuggerPausedReasonOOM              DebuggerPausedReason = "OOM"
+	DebuggerPausedReasonOther            DebuggerPausedReason = "other"
+	DebuggerPausedReasonPromiseRejection DebuggerPausedReason = "promiseRejection"
+	DebuggerPausedReasonXHR              DebuggerPausedReason = "XHR"
+	DebuggerPausedReasonStep             DebuggerPausedReason = "step"
+)
+
+// Valid returns true if the value is one of the DebuggerPausedReason enum values.
+func (e DebuggerPausedReason) Valid() bool {
+	switch e {
+	case DebuggerPausedReasonAmbiguous,
+		DebuggerPausedReasonAssert,
+		DebuggerPausedReasonCSPViolation,
+		DebuggerPausedReasonDebugCommand,
+		DebuggerPausedReasonDOM,
+		DebuggerPausedReasonEventListener,
+		DebuggerPausedReasonException,
+		DebuggerPausedReasonInstrumentation,
+		DebuggerPausedReasonOOM,
+		DebuggerPausedReasonOther,
+		DebuggerPausedReasonPromiseRejection,
+		DebuggerPausedReasonXHR,
+		DebuggerPausedReasonStep:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type DebuggerContinueToLocationTargetCallFrames string
+
+const (
+	DebuggerContinueToLocationTargetCallFramesAny     DebuggerContinueToLocationTargetCallFrames = "any"
+	DebuggerContinueToLocationTargetCallFramesCurrent DebuggerContinueToLocationTargetCallFrames = "current"
+)
+
+// Valid returns true if the value is one of the DebuggerContinueToLocationTargetCallFrames enum values.
+func (e DebuggerContinueToLocationTargetCallFrames) Valid() bool {
+	switch e {
+	case DebuggerContinueToLocationTargetCallFramesAny,
+		DebuggerContinueToLocationTargetCallFramesCurrent:
+		return true
+	}
+	return false
+}
+
+// The `mode` parameter must be present and set to 'StepInto', otherwise `restartFrame` will error out.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type DebuggerRestartFrameMode string
+
+const (
+	DebuggerRestartFrameModeStepInto DebuggerRestartFrameMode = "StepInto"
+)
+
+// Valid returns true if the value is one of the DebuggerRestartFrameMode enum values.
+func (e DebuggerRestartFrameMode) Valid() bool {
+	switch e {
+	case DebuggerRestartFrameModeStepInto:
+		return true
+	}
+	return false
+}
+
+// Instrumentation name.
+type DebuggerSetInstrumentationBreakpointInstrumentation string
+
+const (
+	DebuggerSetInstrumentationBreakpointInstrumentationBeforeScriptExecution              DebuggerSetInstrumentationBreakpointInstrumentation = "beforeScriptExecution"
+	DebuggerSetInstrumentationBreakpointInstrumentationBeforeScriptWithSourceMapExecution DebuggerSetInstrumentationBreakpointInstrumentation = "beforeScriptWithSourceMapExecution"
+)
+
+// Valid returns true if the value is one of the DebuggerSetInstrumentationBreakpointInstrumentation enum values.
+func (e DebuggerSetInstrumentationBreakpointInstrumentation) Valid() bool {
+	switch e {
+	case DebuggerSetInstrumentationBreakpointInstrumentationBeforeScriptExecution,
+		DebuggerSetInstrumentationBreakpointInstrumentationBeforeScriptWithSourceMapExecution:
+		return true
+	}
+	return false
+}
+
+// Pause on exceptions mode.
+type DebuggerSetPauseOnExceptionsState string
+
+const (
+	DebuggerSetPauseOnExceptionsStateNone     DebuggerSetPauseOnExceptionsState = "none"
+	DebuggerSetPauseOnExceptionsStateCaught   DebuggerSetPauseOnExceptionsState = "caught"
+	DebuggerSetPauseOnExceptionsStateUncaught DebuggerSetPauseOnExceptionsState = "uncaught"
+	DebuggerSetPauseOnExceptionsStateAll      DebuggerSetPauseOnExceptionsState = "all"
+)
+
+// Valid returns true if the value is one of the DebuggerSetPauseOnExceptionsState enum values.
+func (e DebuggerSetPauseOnExceptionsState) Valid() bool {
+	switch e {
+	case DebuggerSetPauseOnExceptionsStateNone,
+		DebuggerSetPauseOnExceptionsStateCaught,
+		DebuggerSetPauseOnExceptionsStateUncaught,
+		DebuggerSetPauseOnExceptionsStateAll:
+		return true
+	}
+	return false
+}
+
 // Location in the source code.
 type DebuggerLocation struct {
 	ScriptId     string `json:"scriptId"`               // Script identifier as reported in the `Debugger.scriptParsed`.
@@ -17,12 +225,16 @@ type DebuggerLocation struct {
 }
 
 // Location in the source code.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DebuggerScriptPosition struct {
 	LineNumber   int `json:"lineNumber"`   //
 	ColumnNumber int `json:"columnNumber"` //
 }
 
 // Location range within one script.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DebuggerLocationRange struct {
 	ScriptId string                  `json:"scriptId"` //
 	Start    *DebuggerScriptPosition `json:"start"`    //
@@ -31,20 +243,21 @@ type DebuggerLocationRange struct {
 
 // JavaScript call frame. Array of call frames form the call stack.
 type DebuggerCallFrame struct {
-	CallFrameId      string               `json:"callFrameId"`                // Call frame identifier. This identifier is only valid while the virtual machine is paused.
-	FunctionName     string               `json:"functionName"`               // Name of the JavaScript function called on this call frame.
-	FunctionLocation *DebuggerLocation    `json:"functionLocation,omitempty"` // Location in the source code.
-	Location         *DebuggerLocation    `json:"location"`                   // Location in the source code.
-	Url              string               `json:"url"`                        // JavaScript script name or url. Deprecated in favor of using the `location.scriptId` to resolve the URL via a previously sent `Debugger.scriptParsed` event.
-	ScopeChain       []*DebuggerScope     `json:"scopeChain"`                 // Scope chain for this call frame.
-	This             *RuntimeRemoteObject `json:"this"`                       // `this` object for this call frame.
-	ReturnValue      *RuntimeRemoteObject `json:"returnValue,omitempty"`      // The value being returned, if the function is at return point.
-	CanBeRestarted   bool                 `json:"canBeRestarted,omitempty"`   // Valid only while the VM is paused and indicates whether this frame can be restarted or not. Note that a `true` value here does not guarantee that Debugger#restartFrame with this CallFrameId will be successful, but it is very likely.
+	CallFrameId      string            `json:"callFrameId"`                // Call frame identifier. This identifier is only valid while the virtual machine is paused.
+	FunctionName     string            `json:"functionName"`               // Name of the JavaScript function called on this call frame.
+	FunctionLocation *DebuggerLocation `json:"functionLocation,omitempty"` // Location in the source code.
+	Location         *DebuggerLocation `json:"location"`                   // Location in the source code.
+	// Deprecated: this field is deprecated in the protocol.
+	Url            string               `json:"url"`                      // JavaScript script name or url. Deprecated in favor of using the `location.scriptId` to resolve the URL via a previously sent `Debugger.scriptParsed` event.
+	ScopeChain     []*DebuggerScope     `json:"scopeChain"`               // Scope chain for this call frame.
+	This           *RuntimeRemoteObject `json:"this"`                     // `this` object for this call frame.
+	ReturnValue    *RuntimeRemoteObject `json:"returnValue,omitempty"`    // The value being returned, if the function is at return point.
+	CanBeRestarted bool                 `json:"canBeRestarted,omitempty"` // Valid only while the VM is paused and indicates whether this frame can be restarted or not. Note that a `true` value here does not guarantee that Debugger#restartFrame with this CallFrameId will be successful, but it is very likely.
 }
 
 // Scope description.
 type DebuggerScope struct {
-	Type          string               `json:"type"`                    // Scope type.
+	Type          DebuggerScopeType    `json:"type"`                    // Scope type.
 	Object        *RuntimeRemoteObject `json:"object"`                  // Object representing the scope. For `global` and `with` scopes it represents the actual object; for the rest of the scopes, it is artificial transient object enumerating scope variables as its properties.
 	Name          string               `json:"name,omitempty"`          //
 	StartLocation *DebuggerLocation    `json:"startLocation,omitempty"` // Location in the source code where scope starts
@@ -59,13 +272,15 @@ type DebuggerSearchMatch struct {
 
 // No Description.
 type DebuggerBreakLocation struct {
-	ScriptId     string `json:"scriptId"`               // Script identifier as reported in the `Debugger.scriptParsed`.
-	LineNumber   int    `json:"lineNumber"`             // Line number in the script (0-based).
-	ColumnNumber int    `json:"columnNumber,omitempty"` // Column number in the script (0-based).
-	Type         string `json:"type,omitempty"`         //
+	ScriptId     string                    `json:"scriptId"`               // Script identifier as reported in the `Debugger.scriptParsed`.
+	LineNumber   int                       `json:"lineNumber"`             // Line number in the script (0-based).
+	ColumnNumber int                       `json:"columnNumber,omitempty"` // Column number in the script (0-based).
+	Type         DebuggerBreakLocationType `json:"type,omitempty"`         //
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DebuggerWasmDisassemblyChunk struct {
 	Lines           []string `json:"lines"`           // The next chunk of disassembled lines.
 	BytecodeOffsets []int    `json:"bytecodeOffsets"` // The bytecode offsets describing the start of each line.
@@ -73,11 +288,37 @@ type DebuggerWasmDisassemblyChunk struct {
 
 // Debug symbols available for a wasm script.
 type DebuggerDebugSymbols struct {
-	Type        string `json:"type"`                  // Type of the debug symbols.
-	ExternalURL string `json:"externalURL,omitempty"` // URL of the external symbol source.
+	Type        DebuggerDebugSymbolsType `json:"type"`                  // Type of the debug symbols.
+	ExternalURL string                   `json:"externalURL,omitempty"` // URL of the external symbol source.
 }
 
-// Fired when breakpoint is resolved to an actual script and location.
+// No Description.
+type DebuggerResolvedBreakpoint struct {
+	BreakpointId string            `json:"breakpointId"` // Breakpoint unique identifier.
+	Location     *DebuggerLocation `json:"location"`     // Actual breakpoint location.
+}
+
+// Event method names for the Debugger domain
+const (
+	EventDebuggerBreakpointResolved  = "Debugger.breakpointResolved"
+	EventDebuggerPaused              = "Debugger.paused"
+	EventDebuggerResumed             = "Debugger.resumed"
+	EventDebuggerScriptFailedToParse = "Debugger.scriptFailedToParse"
+	EventDebuggerScriptParsed        = "Debugger.scriptParsed"
+)
+
+// DebuggerEvents lists all event method names for the Debugger domain
+var DebuggerEvents = []string{
+	EventDebuggerBreakpointResolved,
+	EventDebuggerPaused,
+	EventDebuggerResumed,
+	EventDebuggerScriptFailedToParse,
+	EventDebuggerScriptParsed,
+}
+
+// Fired when breakpoint is resolved to an actual script and location. Deprecated in favor of `resolvedBreakpoints` in the `scriptParsed` event.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type DebuggerBreakpointResolvedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -90,16 +331,22 @@ type DebuggerBreakpointResolvedEvent struct {
 type DebuggerPausedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		CallFrames            []*DebuggerCallFrame   `json:"callFrames"`                      // Call stack the virtual machine stopped on.
-		Reason                string                 `json:"reason"`                          // Pause reason.
-		Data                  map[string]interface{} `json:"data,omitempty"`                  // Object containing break-specific auxiliary properties.
-		HitBreakpoints        []string               `json:"hitBreakpoints,omitempty"`        // Hit breakpoints IDs
-		AsyncStackTrace       *RuntimeStackTrace     `json:"asyncStackTrace,omitempty"`       // Async stack trace, if any.
-		AsyncStackTraceId     *RuntimeStackTraceId   `json:"asyncStackTraceId,omitempty"`     // Async stack trace, if any.
-		AsyncCallStackTraceId *RuntimeStackTraceId   `json:"asyncCallStackTraceId,omitempty"` // Never present, will be removed.
+		CallFrames        []*DebuggerCallFrame   `json:"callFrames"`                  // Call stack the virtual machine stopped on.
+		Reason            DebuggerPausedReason   `json:"reason"`                      // Pause reason.
+		Data              map[string]interface{} `json:"data,omitempty"`              // Object containing break-specific auxiliary properties.
+		HitBreakpoints    []string               `json:"hitBreakpoints,omitempty"`    // Hit breakpoints IDs
+		AsyncStackTrace   *RuntimeStackTrace     `json:"asyncStackTrace,omitempty"`   // Async stack trace, if any.
+		AsyncStackTraceId *RuntimeStackTraceId   `json:"asyncStackTraceId,omitempty"` // Async stack trace, if any.
+		// Deprecated: this field is deprecated in the protocol.
+		AsyncCallStackTraceId *RuntimeStackTraceId `json:"asyncCallStackTraceId,omitempty"` // Never present, will be removed.
 	} `json:"Params,omitempty"`
 }
 
+// Fired when the virtual machine resumed execution.
+type DebuggerResumedEvent struct {
+	Method string `json:"method"`
+}
+
 // Fired when virtual machine fails to parse the script.
 type DebuggerScriptFailedToParseEvent struct {
 	Method string `json:"method"`
@@ -112,6 +359,7 @@ type DebuggerScriptFailedToParseEvent struct {
 		EndColumn               int                    `json:"endColumn"`                         // Length of the last line of the script.
 		ExecutionContextId      int                    `json:"executionContextId"`                // Specifies script creation context.
 		Hash                    string                 `json:"hash"`                              // Content hash of the script, SHA-256.
+		BuildId                 string                 `json:"buildId"`                           // For Wasm modules, the content of the `build_id` custom section. For JavaScript the `debugId` magic comment.
 		ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"` // Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
 		SourceMapURL            string                 `json:"sourceMapURL,omitempty"`            // URL of source map associated with script (if any).
 		HasSourceURL            bool                   `json:"hasSourceURL,omitempty"`            // True, if this script has sourceURL.
@@ -119,7 +367,7 @@ type DebuggerScriptFailedToParseEvent struct {
 		Length                  int                    `json:"length,omitempty"`                  // This script length.
 		StackTrace              *RuntimeStackTrace     `json:"stackTrace,omitempty"`              // JavaScript top stack frame of where the script parsed event was triggered if available.
 		CodeOffset              int                    `json:"codeOffset,omitempty"`              // If the scriptLanguage is WebAssembly, the code section offset in the module.
-		ScriptLanguage          string                 `json:"scriptLanguage,omitempty"`          // The language of the script. enum values: JavaScript, WebAssembly
+		ScriptLanguage          DebuggerScriptLanguage `json:"scriptLanguage,omitempty"`          // The language of the script. enum values: JavaScript, WebAssembly
 		EmbedderName            string                 `json:"embedderName,omitempty"`            // The name the embedder supplied for this script.
 	} `json:"Params,omitempty"`
 }
@@ -128,25 +376,27 @@ type DebuggerScriptFailedToParseEvent struct {
 type DebuggerScriptParsedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		ScriptId                string                 `json:"scriptId"`                          // Identifier of the script parsed.
-		Url                     string                 `json:"url"`                               // URL or name of the script parsed (if any).
-		StartLine               int                    `json:"startLine"`                         // Line offset of the script within the resource with given URL (for script tags).
-		StartColumn             int                    `json:"startColumn"`                       // Column offset of the script within the resource with given URL.
-		EndLine                 int                    `json:"endLine"`                           // Last line of the script.
-		EndColumn               int                    `json:"endColumn"`                         // Length of the last line of the script.
-		ExecutionContextId      int                    `json:"executionContextId"`                // Specifies script creation context.
-		Hash                    string                 `json:"hash"`                              // Content hash of the script, SHA-256.
-		ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"` // Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
-		IsLiveEdit              bool                   `json:"isLiveEdit,omitempty"`              // True, if this script is generated as a result of the live edit operation.
-		SourceMapURL            string                 `json:"sourceMapURL,omitempty"`            // URL of source map associated with script (if any).
-		HasSourceURL            bool                   `json:"hasSourceURL,omitempty"`            // True, if this script has sourceURL.
-		IsModule                bool                   `json:"isModule,omitempty"`                // True, if this script is ES6 module.
-		Length                  int                    `json:"length,omitempty"`                  // This script length.
-		StackTrace              *RuntimeStackTrace     `json:"stackTrace,omitempty"`              // JavaScript top stack frame of where the script parsed event was triggered if available.
-		CodeOffset              int                    `json:"codeOffset,omitempty"`              // If the scriptLanguage is WebAssembly, the code section offset in the module.
-		ScriptLanguage          string                 `json:"scriptLanguage,omitempty"`          // The language of the script. enum values: JavaScript, WebAssembly
-		DebugSymbols            *DebuggerDebugSymbols  `json:"debugSymbols,omitempty"`            // If the scriptLanguage is WebASsembly, the source of debug symbols for the module.
-		EmbedderName            string                 `json:"embedderName,omitempty"`            // The name the embedder supplied for this script.
+		ScriptId                string                        `json:"scriptId"`                          // Identifier of the script parsed.
+		Url                     string                        `json:"url"`                               // URL or name of the script parsed (if any).
+		StartLine               int                           `json:"startLine"`                         // Line offset of the script within the resource with given URL (for script tags).
+		StartColumn             int                           `json:"startColumn"`                       // Column offset of the script within the resource with given URL.
+		EndLine                 int                           `json:"endLine"`                           // Last line of the script.
+		EndColumn               int                           `json:"endColumn"`                         // Length of the last line of the script.
+		ExecutionContextId      int                           `json:"executionContextId"`                // Specifies script creation context.
+		Hash                    string                        `json:"hash"`                              // Content hash of the script, SHA-256.
+		BuildId                 string                        `json:"buildId"`                           // For Wasm modules, the content of the `build_id` custom section. For JavaScript the `debugId` magic comment.
+		ExecutionContextAuxData map[string]interface{}        `json:"executionContextAuxData,omitempty"` // Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}
+		IsLiveEdit              bool                          `json:"isLiveEdit,omitempty"`              // True, if this script is generated as a result of the live edit operation.
+		SourceMapURL            string                        `json:"sourceMapURL,omitempty"`            // URL of source map associated with script (if any).
+		HasSourceURL            bool                          `json:"hasSourceURL,omitempty"`            // True, if this script has sourceURL.
+		IsModule                bool                          `json:"isModule,omitempty"`                // True, if this script is ES6 module.
+		Length                  int                           `json:"length,omitempty"`                  // This script length.
+		StackTrace              *RuntimeStackTrace            `json:"stackTrace,omitempty"`              // JavaScript top stack frame of where the script parsed event was triggered if available.
+		CodeOffset              int                           `json:"codeOffset,omitempty"`              // If the scriptLanguage is WebAssembly, the code section offset in the module.
+		ScriptLanguage          DebuggerScriptLanguage        `json:"scriptLanguage,omitempty"`          // The language of the script. enum values: JavaScript, WebAssembly
+		DebugSymbols            []*DebuggerDebugSymbols       `json:"debugSymbols,omitempty"`            // If the scriptLanguage is WebAssembly, the source of debug symbols for the module.
+		EmbedderName            string                        `json:"embedderName,omitempty"`            // The name the embedder supplied for this script.
+		ResolvedBreakpoints     []*DebuggerResolvedBreakpoint `json:"resolvedBreakpoints,omitempty"`     // The list of set breakpoints in this script if calls to `setBreakpointByUrl` matches this script's URL or hash. Clients that use this list can ignore the `breakpointResolved` event. They are equivalent.
 	} `json:"Params,omitempty"`
 }
 
@@ -159,11 +409,78 @@ func NewDebugger(target gcdmessage.ChromeTargeter) *Debugger {
 	return c
 }
 
+// OnBreakpointResolved subscribes to Debugger.breakpointResolved events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Debugger) OnBreakpointResolved(callback func(*DebuggerBreakpointResolvedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDebuggerBreakpointResolved, func(payload []byte) error {
+		event := &DebuggerBreakpointResolvedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPaused subscribes to Debugger.paused events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Debugger) OnPaused(callback func(*DebuggerPausedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDebuggerPaused, func(payload []byte) error {
+		event := &DebuggerPausedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnResumed subscribes to Debugger.resumed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Debugger) OnResumed(callback func(*DebuggerResumedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDebuggerResumed, func(payload []byte) error {
+		event := &DebuggerResumedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnScriptFailedToParse subscribes to Debugger.scriptFailedToParse events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Debugger) OnScriptFailedToParse(callback func(*DebuggerScriptFailedToParseEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDebuggerScriptFailedToParse, func(payload []byte) error {
+		event := &DebuggerScriptFailedToParseEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnScriptParsed subscribes to Debugger.scriptParsed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Debugger) OnScriptParsed(callback func(*DebuggerScriptParsedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDebuggerScriptParsed, func(payload []byte) error {
+		event := &DebuggerScriptParsedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 type DebuggerContinueToLocationParams struct {
 	// Location to continue to.
 	Location *DebuggerLocation `json:"location"`
 	//
-	TargetCallFrames string `json:"targetCallFrames,omitempty"`
+	TargetCallFrames DebuggerContinueToLocationTargetCallFrames `json:"targetCallFrames,omitempty"`
 }
 
 // ContinueToLocationWithParams - Continues execution until specific location is reached.
@@ -174,7 +491,7 @@ func (c *Debugger) ContinueToLocationWithParams(ctx context.Context, v *Debugger
 // ContinueToLocation - Continues execution until specific location is reached.
 // location - Location to continue to.
 // targetCallFrames -
-func (c *Debugger) ContinueToLocation(ctx context.Context, location *DebuggerLocation, targetCallFrames string) (*gcdmessage.ChromeResponse, error) {
+func (c *Debugger) ContinueToLocation(ctx context.Context, location *DebuggerLocation, targetCallFrames DebuggerContinueToLocationTargetCallFrames) (*gcdmessage.ChromeResponse, error) {
 	var v DebuggerContinueToLocationParams
 	v.Location = location
 	v.TargetCallFrames = targetCallFrames
@@ -188,6 +505,8 @@ func (c *Debugger) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, err
 
 type DebuggerEnableParams struct {
 	// The maximum size in bytes of collected scripts (not referenced by other heap objects) the debugger can hold. Puts no limit if parameter is omitted.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	MaxScriptsCacheSize float64 `json:"maxScriptsCacheSize,omitempty"`
 }
 
@@ -244,10 +563,14 @@ type DebuggerEvaluateOnCallFrameParams struct {
 	// Whether the result is expected to be a JSON object that should be sent by value.
 	ReturnByValue bool `json:"returnByValue,omitempty"`
 	// Whether preview should be generated for the result.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	GeneratePreview bool `json:"generatePreview,omitempty"`
 	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
 	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
 	// Terminate execution after timing out (number of milliseconds).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Timeout float64 `json:"timeout,omitempty"`
 }
 
@@ -411,6 +734,8 @@ type DebuggerDisassembleWasmModuleParams struct {
 
 // DisassembleWasmModuleWithParams -
 // Returns -  streamId - For large modules, return a stream from which additional chunks of disassembly can be read successively. totalNumberOfLines - The total number of lines in the disassembly text. functionBodyOffsets - The offsets of all function bodies, in the format [start1, end1, start2, end2, ...] where all ends are exclusive. chunk - The first chunk of disassembly.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) DisassembleWasmModuleWithParams(ctx context.Context, v *DebuggerDisassembleWasmModuleParams) (string, int, []int, *DebuggerWasmDisassemblyChunk, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.disassembleWasmModule", Params: v})
 	if err != nil {
@@ -445,6 +770,8 @@ func (c *Debugger) DisassembleWasmModuleWithParams(ctx context.Context, v *Debug
 // DisassembleWasmModule -
 // scriptId - Id of the script to disassemble
 // Returns -  streamId - For large modules, return a stream from which additional chunks of disassembly can be read successively. totalNumberOfLines - The total number of lines in the disassembly text. functionBodyOffsets - The offsets of all function bodies, in the format [start1, end1, start2, end2, ...] where all ends are exclusive. chunk - The first chunk of disassembly.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) DisassembleWasmModule(ctx context.Context, scriptId string) (string, int, []int, *DebuggerWasmDisassemblyChunk, error) {
 	var v DebuggerDisassembleWasmModuleParams
 	v.ScriptId = scriptId
@@ -458,6 +785,8 @@ type DebuggerNextWasmDisassemblyChunkParams struct {
 
 // NextWasmDisassemblyChunkWithParams - Disassemble the next chunk of lines for the module corresponding to the stream. If disassembly is complete, this API will invalidate the streamId and return an empty chunk. Any subsequent calls for the now invalid stream will return errors.
 // Returns -  chunk - The next chunk of disassembly.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) NextWasmDisassemblyChunkWithParams(ctx context.Context, v *DebuggerNextWasmDisassemblyChunkParams) (*DebuggerWasmDisassemblyChunk, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.nextWasmDisassemblyChunk", Params: v})
 	if err != nil {
@@ -489,12 +818,15 @@ func (c *Debugger) NextWasmDisassemblyChunkWithParams(ctx context.Context, v *De
 // NextWasmDisassemblyChunk - Disassemble the next chunk of lines for the module corresponding to the stream. If disassembly is complete, this API will invalidate the streamId and return an empty chunk. Any subsequent calls for the now invalid stream will return errors.
 // streamId -
 // Returns -  chunk - The next chunk of disassembly.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) NextWasmDisassemblyChunk(ctx context.Context, streamId string) (*DebuggerWasmDisassemblyChunk, error) {
 	var v DebuggerNextWasmDisassemblyChunkParams
 	v.StreamId = streamId
 	return c.NextWasmDisassemblyChunkWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type DebuggerGetWasmBytecodeParams struct {
 	// Id of the Wasm script to get source for.
 	ScriptId string `json:"scriptId"`
@@ -502,6 +834,8 @@ type DebuggerGetWasmBytecodeParams struct {
 
 // GetWasmBytecodeWithParams - This command is deprecated. Use getScriptSource instead.
 // Returns -  bytecode - Script source. (Encoded as a base64 string when passed over JSON)
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Debugger) GetWasmBytecodeWithParams(ctx context.Context, v *DebuggerGetWasmBytecodeParams) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.getWasmBytecode", Params: v})
 	if err != nil {
@@ -533,6 +867,8 @@ func (c *Debugger) GetWasmBytecodeWithParams(ctx context.Context, v *DebuggerGet
 // GetWasmBytecode - This command is deprecated. Use getScriptSource instead.
 // scriptId - Id of the Wasm script to get source for.
 // Returns -  bytecode - Script source. (Encoded as a base64 string when passed over JSON)
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Debugger) GetWasmBytecode(ctx context.Context, scriptId string) (string, error) {
 	var v DebuggerGetWasmBytecodeParams
 	v.ScriptId = scriptId
@@ -546,6 +882,8 @@ type DebuggerGetStackTraceParams struct {
 
 // GetStackTraceWithParams - Returns stack trace with given `stackTraceId`.
 // Returns -  stackTrace -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) GetStackTraceWithParams(ctx context.Context, v *DebuggerGetStackTraceParams) (*RuntimeStackTrace, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.getStackTrace", Params: v})
 	if err != nil {
@@ -577,6 +915,8 @@ func (c *Debugger) GetStackTraceWithParams(ctx context.Context, v *DebuggerGetSt
 // GetStackTrace - Returns stack trace with given `stackTraceId`.
 // stackTraceId -
 // Returns -  stackTrace -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) GetStackTrace(ctx context.Context, stackTraceId *RuntimeStackTraceId) (*RuntimeStackTrace, error) {
 	var v DebuggerGetStackTraceParams
 	v.StackTraceId = stackTraceId
@@ -588,18 +928,27 @@ func (c *Debugger) Pause(ctx context.Context) (*gcdmessage.ChromeResponse, error
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.pause"})
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type DebuggerPauseOnAsyncCallParams struct {
 	// Debugger will pause when async call with given stack trace is started.
 	ParentStackTraceId *RuntimeStackTraceId `json:"parentStackTraceId"`
 }
 
 // PauseOnAsyncCallWithParams -
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) PauseOnAsyncCallWithParams(ctx context.Context, v *DebuggerPauseOnAsyncCallParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.pauseOnAsyncCall", Params: v})
 }
 
 // PauseOnAsyncCall -
 // parentStackTraceId - Debugger will pause when async call with given stack trace is started.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) PauseOnAsyncCall(ctx context.Context, parentStackTraceId *RuntimeStackTraceId) (*gcdmessage.ChromeResponse, error) {
 	var v DebuggerPauseOnAsyncCallParams
 	v.ParentStackTraceId = parentStackTraceId
@@ -628,7 +977,9 @@ type DebuggerRestartFrameParams struct {
 	// Call frame identifier to evaluate on.
 	CallFrameId string `json:"callFrameId"`
 	// The `mode` parameter must be present and set to 'StepInto', otherwise `restartFrame` will error out.
-	Mode string `json:"mode,omitempty"`
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	Mode DebuggerRestartFrameMode `json:"mode,omitempty"`
 }
 
 // RestartFrameWithParams - Restarts particular call frame from the beginning. The old, deprecated behavior of `restartFrame` is to stay paused and allow further CDP commands after a restart was scheduled. This can cause problems with restarting, so we now continue execution immediatly after it has been scheduled until we reach the beginning of the restarted frame.  To stay back-wards compatible, `restartFrame` now expects a `mode` parameter to be present. If the `mode` parameter is missing, `restartFrame` errors out.  The various return values are deprecated and `callFrames` is always empty. Use the call frames from the `Debugger#paused` events instead, that fires once V8 pauses at the beginning of the restarted function.
@@ -667,7 +1018,7 @@ func (c *Debugger) RestartFrameWithParams(ctx context.Context, v *DebuggerRestar
 // callFrameId - Call frame identifier to evaluate on.
 // mode - The `mode` parameter must be present and set to 'StepInto', otherwise `restartFrame` will error out.
 // Returns -  callFrames - New stack trace. asyncStackTrace - Async stack trace, if any. asyncStackTraceId - Async stack trace, if any.
-func (c *Debugger) RestartFrame(ctx context.Context, callFrameId string, mode string) ([]*DebuggerCallFrame, *RuntimeStackTrace, *RuntimeStackTraceId, error) {
+func (c *Debugger) RestartFrame(ctx context.Context, callFrameId string, mode DebuggerRestartFrameMode) ([]*DebuggerCallFrame, *RuntimeStackTrace, *RuntimeStackTraceId, error) {
 	var v DebuggerRestartFrameParams
 	v.CallFrameId = callFrameId
 	v.Mode = mode
@@ -766,21 +1117,51 @@ func (c *Debugger) SetAsyncCallStackDepth(ctx context.Context, maxDepth int) (*g
 	return c.SetAsyncCallStackDepthWithParams(ctx, &v)
 }
 
+type DebuggerSetBlackboxExecutionContextsParams struct {
+	// Array of execution context unique ids for the debugger to ignore.
+	UniqueIds []string `json:"uniqueIds"`
+}
+
+// SetBlackboxExecutionContextsWithParams - Replace previous blackbox execution contexts with passed ones. Forces backend to skip stepping/pausing in scripts in these execution contexts. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Debugger) SetBlackboxExecutionContextsWithParams(ctx context.Context, v *DebuggerSetBlackboxExecutionContextsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.setBlackboxExecutionContexts", Params: v})
+}
+
+// SetBlackboxExecutionContexts - Replace previous blackbox execution contexts with passed ones. Forces backend to skip stepping/pausing in scripts in these execution contexts. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+// uniqueIds - Array of execution context unique ids for the debugger to ignore.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Debugger) SetBlackboxExecutionContexts(ctx context.Context, uniqueIds []string) (*gcdmessage.ChromeResponse, error) {
+	var v DebuggerSetBlackboxExecutionContextsParams
+	v.UniqueIds = uniqueIds
+	return c.SetBlackboxExecutionContextsWithParams(ctx, &v)
+}
+
 type DebuggerSetBlackboxPatternsParams struct {
 	// Array of regexps that will be used to check script url for blackbox state.
 	Patterns []string `json:"patterns"`
+	// If true, also ignore scripts with no source url.
+	SkipAnonymous bool `json:"skipAnonymous,omitempty"`
 }
 
 // SetBlackboxPatternsWithParams - Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in scripts with url matching one of the patterns. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) SetBlackboxPatternsWithParams(ctx context.Context, v *DebuggerSetBlackboxPatternsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.setBlackboxPatterns", Params: v})
 }
 
 // SetBlackboxPatterns - Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in scripts with url matching one of the patterns. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
 // patterns - Array of regexps that will be used to check script url for blackbox state.
-func (c *Debugger) SetBlackboxPatterns(ctx context.Context, patterns []string) (*gcdmessage.ChromeResponse, error) {
+// skipAnonymous - If true, also ignore scripts with no source url.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Debugger) SetBlackboxPatterns(ctx context.Context, patterns []string, skipAnonymous bool) (*gcdmessage.ChromeResponse, error) {
 	var v DebuggerSetBlackboxPatternsParams
 	v.Patterns = patterns
+	v.SkipAnonymous = skipAnonymous
 	return c.SetBlackboxPatternsWithParams(ctx, &v)
 }
 
@@ -792,6 +1173,8 @@ type DebuggerSetBlackboxedRangesParams struct {
 }
 
 // SetBlackboxedRangesWithParams - Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted scripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful. Positions array contains positions where blackbox state is changed. First interval isn't blackboxed. Array should be sorted.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) SetBlackboxedRangesWithParams(ctx context.Context, v *DebuggerSetBlackboxedRangesParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.setBlackboxedRanges", Params: v})
 }
@@ -799,6 +1182,8 @@ func (c *Debugger) SetBlackboxedRangesWithParams(ctx context.Context, v *Debugge
 // SetBlackboxedRanges - Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted scripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful. Positions array contains positions where blackbox state is changed. First interval isn't blackboxed. Array should be sorted.
 // scriptId - Id of the script.
 // positions -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) SetBlackboxedRanges(ctx context.Context, scriptId string, positions []*DebuggerScriptPosition) (*gcdmessage.ChromeResponse, error) {
 	var v DebuggerSetBlackboxedRangesParams
 	v.ScriptId = scriptId
@@ -857,7 +1242,7 @@ func (c *Debugger) SetBreakpoint(ctx context.Context, location *DebuggerLocation
 
 type DebuggerSetInstrumentationBreakpointParams struct {
 	// Instrumentation name.
-	Instrumentation string `json:"instrumentation"`
+	Instrumentation DebuggerSetInstrumentationBreakpointInstrumentation `json:"instrumentation"`
 }
 
 // SetInstrumentationBreakpointWithParams - Sets instrumentation breakpoint.
@@ -893,7 +1278,7 @@ func (c *Debugger) SetInstrumentationBreakpointWithParams(ctx context.Context, v
 // SetInstrumentationBreakpoint - Sets instrumentation breakpoint.
 // instrumentation - Instrumentation name.
 // Returns -  breakpointId - Id of the created breakpoint for further reference.
-func (c *Debugger) SetInstrumentationBreakpoint(ctx context.Context, instrumentation string) (string, error) {
+func (c *Debugger) SetInstrumentationBreakpoint(ctx context.Context, instrumentation DebuggerSetInstrumentationBreakpointInstrumentation) (string, error) {
 	var v DebuggerSetInstrumentationBreakpointParams
 	v.Instrumentation = instrumentation
 	return c.SetInstrumentationBreakpointWithParams(ctx, &v)
@@ -973,6 +1358,8 @@ type DebuggerSetBreakpointOnFunctionCallParams struct {
 
 // SetBreakpointOnFunctionCallWithParams - Sets JavaScript breakpoint before each call to the given function. If another function was created from the same source as a given one, calling it will also trigger the breakpoint.
 // Returns -  breakpointId - Id of the created breakpoint for further reference.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) SetBreakpointOnFunctionCallWithParams(ctx context.Context, v *DebuggerSetBreakpointOnFunctionCallParams) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.setBreakpointOnFunctionCall", Params: v})
 	if err != nil {
@@ -1005,6 +1392,8 @@ func (c *Debugger) SetBreakpointOnFunctionCallWithParams(ctx context.Context, v
 // objectId - Function object id.
 // condition - Expression to use as a breakpoint condition. When specified, debugger will stop on the breakpoint if this expression evaluates to true.
 // Returns -  breakpointId - Id of the created breakpoint for further reference.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) SetBreakpointOnFunctionCall(ctx context.Context, objectId string, condition string) (string, error) {
 	var v DebuggerSetBreakpointOnFunctionCallParams
 	v.ObjectId = objectId
@@ -1032,7 +1421,7 @@ func (c *Debugger) SetBreakpointsActive(ctx context.Context, active bool) (*gcdm
 
 type DebuggerSetPauseOnExceptionsParams struct {
 	// Pause on exceptions mode.
-	State string `json:"state"`
+	State DebuggerSetPauseOnExceptionsState `json:"state"`
 }
 
 // SetPauseOnExceptionsWithParams - Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions, or caught exceptions, no exceptions. Initial pause on exceptions state is `none`.
@@ -1042,7 +1431,7 @@ func (c *Debugger) SetPauseOnExceptionsWithParams(ctx context.Context, v *Debugg
 
 // SetPauseOnExceptions - Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions, or caught exceptions, no exceptions. Initial pause on exceptions state is `none`.
 // state - Pause on exceptions mode.
-func (c *Debugger) SetPauseOnExceptions(ctx context.Context, state string) (*gcdmessage.ChromeResponse, error) {
+func (c *Debugger) SetPauseOnExceptions(ctx context.Context, state DebuggerSetPauseOnExceptionsState) (*gcdmessage.ChromeResponse, error) {
 	var v DebuggerSetPauseOnExceptionsParams
 	v.State = state
 	return c.SetPauseOnExceptionsWithParams(ctx, &v)
@@ -1054,12 +1443,16 @@ type DebuggerSetReturnValueParams struct {
 }
 
 // SetReturnValueWithParams - Changes return value in top frame. Available only at return break position.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) SetReturnValueWithParams(ctx context.Context, v *DebuggerSetReturnValueParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Debugger.setReturnValue", Params: v})
 }
 
 // SetReturnValue - Changes return value in top frame. Available only at return break position.
 // newValue - New return value.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Debugger) SetReturnValue(ctx context.Context, newValue *RuntimeCallArgument) (*gcdmessage.ChromeResponse, error) {
 	var v DebuggerSetReturnValueParams
 	v.NewValue = newValue
@@ -1074,6 +1467,8 @@ type DebuggerSetScriptSourceParams struct {
 	// If true the change will not actually be applied. Dry run may be used to get result description without actually modifying the code.
 	DryRun bool `json:"dryRun,omitempty"`
 	// If true, then `scriptSource` is allowed to change the function on top of the stack as long as the top-most stack frame is the only activation of that function.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	AllowTopFrameEditing bool `json:"allowTopFrameEditing,omitempty"`
 }
 
@@ -1177,8 +1572,12 @@ func (c *Debugger) SetVariableValue(ctx context.Context, scopeNumber int, variab
 
 type DebuggerStepIntoParams struct {
 	// Debugger will pause on the execution of the first async task which was scheduled before next pause.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	BreakOnAsyncCall bool `json:"breakOnAsyncCall,omitempty"`
 	// The skipList specifies location ranges that should be skipped on step into.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	SkipList []*DebuggerLocationRange `json:"skipList,omitempty"`
 }
 
@@ -1204,6 +1603,8 @@ func (c *Debugger) StepOut(ctx context.Context) (*gcdmessage.ChromeResponse, err
 
 type DebuggerStepOverParams struct {
 	// The skipList specifies location ranges that should be skipped on step over.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	SkipList []*DebuggerLocationRange `json:"skipList,omitempty"`
 }
 
@@ -0,0 +1,75 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains DeviceAccess functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnDeviceRequestPrompted subscribes to DeviceAccess.deviceRequestPrompted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DeviceAccess) OnDeviceRequestPrompted(callback func(*DeviceAccessDeviceRequestPromptedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDeviceAccessDeviceRequestPrompted, func(payload []byte) error {
+		event := &DeviceAccessDeviceRequestPromptedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// Enable events in this domain.
+func (c *DeviceAccess) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DeviceAccess.enable"})
+}
+
+// Disable events in this domain.
+func (c *DeviceAccess) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DeviceAccess.disable"})
+}
+
+type DeviceAccessSelectPromptParams struct {
+	//
+	Id string `json:"id"`
+	//
+	DeviceId string `json:"deviceId"`
+}
+
+// SelectPromptWithParams - Select a device in response to a DeviceAccess.deviceRequestPrompted event.
+func (c *DeviceAccess) SelectPromptWithParams(ctx context.Context, v *DeviceAccessSelectPromptParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DeviceAccess.selectPrompt", Params: v})
+}
+
+// SelectPrompt - Select a device in response to a DeviceAccess.deviceRequestPrompted event.
+// id -
+// deviceId -
+func (c *DeviceAccess) SelectPrompt(ctx context.Context, id string, deviceId string) (*gcdmessage.ChromeResponse, error) {
+	var v DeviceAccessSelectPromptParams
+	v.Id = id
+	v.DeviceId = deviceId
+	return c.SelectPromptWithParams(ctx, &v)
+}
+
+type DeviceAccessCancelPromptParams struct {
+	//
+	Id string `json:"id"`
+}
+
+// CancelPromptWithParams - Cancel a prompt in response to a DeviceAccess.deviceRequestPrompted event.
+func (c *DeviceAccess) CancelPromptWithParams(ctx context.Context, v *DeviceAccessCancelPromptParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DeviceAccess.cancelPrompt", Params: v})
+}
+
+// CancelPrompt - Cancel a prompt in response to a DeviceAccess.deviceRequestPrompted event.
+// id -
+func (c *DeviceAccess) CancelPrompt(ctx context.Context, id string) (*gcdmessage.ChromeResponse, error) {
+	var v DeviceAccessCancelPromptParams
+	v.Id = id
+	return c.CancelPromptWithParams(ctx, &v)
+}
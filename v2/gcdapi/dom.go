@@ -9,6 +9,234 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Pseudo element type.
+type DOMPseudoType string
+
+const (
+	DOMPseudoTypeFirstLine                   DOMPseudoType = "first-line"
+	DOMPseudoTypeFirstLetter                 DOMPseudoType = "first-letter"
+	DOMPseudoTypeCheckmark                   DOMPseudoType = "checkmark"
+	DOMPseudoTypeBefore                      DOMPseudoType = "before"
+	DOMPseudoTypeAfter                       DOMPseudoType = "after"
+	DOMPseudoTypePickerIcon                  DOMPseudoType = "picker-icon"
+	DOMPseudoTypeMarker                      DOMPseudoType = "marker"
+	DOMPseudoTypeBackdrop                    DOMPseudoType = "backdrop"
+	DOMPseudoTypeColumn                      DOMPseudoType = "column"
+	DOMPseudoTypeSelection                   DOMPseudoType = "selection"
+	DOMPseudoTypeSearchText                  DOMPseudoType = "search-text"
+	DOMPseudoTypeTargetText                  DOMPseudoType = "target-text"
+	DOMPseudoTypeSpellingError               DOMPseudoType = "spelling-error"
+	DOMPseudoTypeGrammarError                DOMPseudoType = "grammar-error"
+	DOMPseudoTypeHighlight                   DOMPseudoType = "highlight"
+	DOMPseudoTypeFirstLineInherited          DOMPseudoType = "first-line-inherited"
+	DOMPseudoTypeScrollMarker                DOMPseudoType = "scroll-marker"
+	DOMPseudoTypeScrollMarkerGroup           DOMPseudoType = "scroll-marker-group"
+	DOMPseudoTypeScrollButton                DOMPseudoType = "scroll-button"
+	DOMPseudoTypeScrollbar                   DOMPseudoType = "scrollbar"
+	DOMPseudoTypeScrollbarThumb              DOMPseudoType = "scrollbar-thumb"
+	DOMPseudoTypeScrollbarButton             DOMPseudoType = "scrollbar-button"
+	DOMPseudoTypeScrollbarTrack              DOMPseudoType = "scrollbar-track"
+	DOMPseudoTypeScrollbarTrackPiece         DOMPseudoType = "scrollbar-track-piece"
+	DOMPseudoTypeScrollbarCorner             DOMPseudoType = "scrollbar-corner"
+	DOMPseudoTypeResizer                     DOMPseudoType = "resizer"
+	DOMPseudoTypeInputListButton             DOMPseudoType = "input-list-button"
+	DOMPseudoTypeViewTransition              DOMPseudoType = "view-transition"
+	DOMPseudoTypeViewTransitionGroup         DOMPseudoType = "view-transition-group"
+	DOMPseudoTypeViewTransitionImagePair     DOMPseudoType = "view-transition-image-pair"
+	DOMPseudoTypeViewTransitionGroupChildren DOMPseudoType = "view-transition-group-children"
+	DOMPseudoTypeViewTransitionOld           DOMPseudoType = "view-transition-old"
+	DOMPseudoTypeViewTransitionNew           DOMPseudoType = "view-transition-new"
+	DOMPseudoTypePlaceholder                 DOMPseudoType = "placeholder"
+	DOMPseudoTypeFileSelectorButton          DOMPseudoType = "file-selector-button"
+	DOMPseudoTypeDetailsContent              DOMPseudoType = "details-content"
+	DOMPseudoTypePicker                      DOMPseudoType = "picker"
+	DOMPseudoTypePermissionIcon              DOMPseudoType = "permission-icon"
+)
+
+// Valid returns true if the value is one of the DOMPseudoType enum values.
+func (e DOMPseudoType) Valid() bool {
+	switch e {
+	case DOMPseudoTypeFirstLine,
+		DOMPseudoTypeFirstLetter,
+		DOMPseudoTypeCheckmark,
+		DOMPseudoTypeBefore,
+		DOMPseudoTypeAfter,
+		DOMPseudoTypePickerIcon,
+		DOMPseudoTypeMarker,
+		DOMPseudoTypeBackdrop,
+		DOMPseudoTypeColumn,
+		DOMPseudoTypeSelection,
+		DOMPseudoTypeSearchText,
+		DOMPseudoTypeTargetText,
+		DOMPseudoTypeSpellingError,
+		DOMPseudoTypeGrammarError,
+		DOMPseudoTypeHighlight,
+		DOMPseudoTypeFirstLineInherited,
+		DOMPseudoTypeScrollMarker,
+		DOMPseudoTypeScrollMarkerGroup,
+		DOMPseudoTypeScrollButton,
+		DOMPseudoTypeScrollbar,
+		DOMPseudoTypeScrollbarThumb,
+		DOMPseudoTypeScrollbarButton,
+		DOMPseudoTypeScrollbarTrack,
+		DOMPseudoTypeScrollbarTrackPiece,
+		DOMPseudoTypeScrollbarCorner,
+		DOMPseudoTypeResizer,
+		DOMPseudoTypeInputListButton,
+		DOMPseudoTypeViewTransition,
+		DOMPseudoTypeViewTransitionGroup,
+		DOMPseudoTypeViewTransitionImagePair,
+		DOMPseudoTypeViewTransitionGroupChildren,
+		DOMPseudoTypeViewTransitionOld,
+		DOMPseudoTypeViewTransitionNew,
+		DOMPseudoTypePlaceholder,
+		DOMPseudoTypeFileSelectorButton,
+		DOMPseudoTypeDetailsContent,
+		DOMPseudoTypePicker,
+		DOMPseudoTypePermissionIcon:
+		return true
+	}
+	return false
+}
+
+// Shadow root type.
+type DOMShadowRootType string
+
+const (
+	DOMShadowRootTypeUserAgent DOMShadowRootType = "user-agent"
+	DOMShadowRootTypeOpen      DOMShadowRootType = "open"
+	DOMShadowRootTypeClosed    DOMShadowRootType = "closed"
+)
+
+// Valid returns true if the value is one of the DOMShadowRootType enum values.
+func (e DOMShadowRootType) Valid() bool {
+	switch e {
+	case DOMShadowRootTypeUserAgent,
+		DOMShadowRootTypeOpen,
+		DOMShadowRootTypeClosed:
+		return true
+	}
+	return false
+}
+
+// Document compatibility mode.
+type DOMCompatibilityMode string
+
+const (
+	DOMCompatibilityModeQuirksMode        DOMCompatibilityMode = "QuirksMode"
+	DOMCompatibilityModeLimitedQuirksMode DOMCompatibilityMode = "LimitedQuirksMode"
+	DOMCompatibilityModeNoQuirksMode      DOMCompatibilityMode = "NoQuirksMode"
+)
+
+// Valid returns true if the value is one of the DOMCompatibilityMode enum values.
+func (e DOMCompatibilityMode) Valid() bool {
+	switch e {
+	case DOMCompatibilityModeQuirksMode,
+		DOMCompatibilityModeLimitedQuirksMode,
+		DOMCompatibilityModeNoQuirksMode:
+		return true
+	}
+	return false
+}
+
+// ContainerSelector physical axes
+type DOMPhysicalAxes string
+
+const (
+	DOMPhysicalAxesHorizontal DOMPhysicalAxes = "Horizontal"
+	DOMPhysicalAxesVertical   DOMPhysicalAxes = "Vertical"
+	DOMPhysicalAxesBoth       DOMPhysicalAxes = "Both"
+)
+
+// Valid returns true if the value is one of the DOMPhysicalAxes enum values.
+func (e DOMPhysicalAxes) Valid() bool {
+	switch e {
+	case DOMPhysicalAxesHorizontal,
+		DOMPhysicalAxesVertical,
+		DOMPhysicalAxesBoth:
+		return true
+	}
+	return false
+}
+
+// ContainerSelector logical axes
+type DOMLogicalAxes string
+
+const (
+	DOMLogicalAxesInline DOMLogicalAxes = "Inline"
+	DOMLogicalAxesBlock  DOMLogicalAxes = "Block"
+	DOMLogicalAxesBoth   DOMLogicalAxes = "Both"
+)
+
+// Valid returns true if the value is one of the DOMLogicalAxes enum values.
+func (e DOMLogicalAxes) Valid() bool {
+	switch e {
+	case DOMLogicalAxesInline,
+		DOMLogicalAxesBlock,
+		DOMLogicalAxesBoth:
+		return true
+	}
+	return false
+}
+
+// Physical scroll orientation
+type DOMScrollOrientation string
+
+const (
+	DOMScrollOrientationHorizontal DOMScrollOrientation = "horizontal"
+	DOMScrollOrientationVertical   DOMScrollOrientation = "vertical"
+)
+
+// Valid returns true if the value is one of the DOMScrollOrientation enum values.
+func (e DOMScrollOrientation) Valid() bool {
+	switch e {
+	case DOMScrollOrientationHorizontal,
+		DOMScrollOrientationVertical:
+		return true
+	}
+	return false
+}
+
+// Whether to include whitespaces in the children array of returned Nodes.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type DOMEnableIncludeWhitespace string
+
+const (
+	DOMEnableIncludeWhitespaceNone DOMEnableIncludeWhitespace = "none"
+	DOMEnableIncludeWhitespaceAll  DOMEnableIncludeWhitespace = "all"
+)
+
+// Valid returns true if the value is one of the DOMEnableIncludeWhitespace enum values.
+func (e DOMEnableIncludeWhitespace) Valid() bool {
+	switch e {
+	case DOMEnableIncludeWhitespaceNone,
+		DOMEnableIncludeWhitespaceAll:
+		return true
+	}
+	return false
+}
+
+// Type of relation to get.
+type DOMGetElementByRelationRelation string
+
+const (
+	DOMGetElementByRelationRelationPopoverTarget  DOMGetElementByRelationRelation = "PopoverTarget"
+	DOMGetElementByRelationRelationInterestTarget DOMGetElementByRelationRelation = "InterestTarget"
+	DOMGetElementByRelationRelationCommandFor     DOMGetElementByRelationRelation = "CommandFor"
+)
+
+// Valid returns true if the value is one of the DOMGetElementByRelationRelation enum values.
+func (e DOMGetElementByRelationRelation) Valid() bool {
+	switch e {
+	case DOMGetElementByRelationRelationPopoverTarget,
+		DOMGetElementByRelationRelationInterestTarget,
+		DOMGetElementByRelationRelationCommandFor:
+		return true
+	}
+	return false
+}
+
 // Backend node with a friendly name.
 type DOMBackendNode struct {
 	NodeType      int    `json:"nodeType"`      // `Node`'s nodeType.
@@ -18,37 +246,45 @@ type DOMBackendNode struct {
 
 // DOM interaction is implemented in terms of mirror objects that represent the actual DOM nodes. DOMNode is a base node mirror type.
 type DOMNode struct {
-	NodeId            int               `json:"nodeId"`                      // Node identifier that is passed into the rest of the DOM messages as the `nodeId`. Backend will only push node with given `id` once. It is aware of all requested nodes and will only fire DOM events for nodes known to the client.
-	ParentId          int               `json:"parentId,omitempty"`          // The id of the parent node if any.
-	BackendNodeId     int               `json:"backendNodeId"`               // The BackendNodeId for this node.
-	NodeType          int               `json:"nodeType"`                    // `Node`'s nodeType.
-	NodeName          string            `json:"nodeName"`                    // `Node`'s nodeName.
-	LocalName         string            `json:"localName"`                   // `Node`'s localName.
-	NodeValue         string            `json:"nodeValue"`                   // `Node`'s nodeValue.
-	ChildNodeCount    int               `json:"childNodeCount,omitempty"`    // Child count for `Container` nodes.
-	Children          []*DOMNode        `json:"children,omitempty"`          // Child nodes of this node when requested with children.
-	Attributes        []string          `json:"attributes,omitempty"`        // Attributes of the `Element` node in the form of flat array `[name1, value1, name2, value2]`.
-	DocumentURL       string            `json:"documentURL,omitempty"`       // Document URL that `Document` or `FrameOwner` node points to.
-	BaseURL           string            `json:"baseURL,omitempty"`           // Base URL that `Document` or `FrameOwner` node uses for URL completion.
-	PublicId          string            `json:"publicId,omitempty"`          // `DocumentType`'s publicId.
-	SystemId          string            `json:"systemId,omitempty"`          // `DocumentType`'s systemId.
-	InternalSubset    string            `json:"internalSubset,omitempty"`    // `DocumentType`'s internalSubset.
-	XmlVersion        string            `json:"xmlVersion,omitempty"`        // `Document`'s XML version in case of XML documents.
-	Name              string            `json:"name,omitempty"`              // `Attr`'s name.
-	Value             string            `json:"value,omitempty"`             // `Attr`'s value.
-	PseudoType        string            `json:"pseudoType,omitempty"`        // Pseudo element type for this node. enum values: first-line, first-letter, before, after, marker, backdrop, selection, target-text, spelling-error, grammar-error, highlight, first-line-inherited, scrollbar, scrollbar-thumb, scrollbar-button, scrollbar-track, scrollbar-track-piece, scrollbar-corner, resizer, input-list-button, view-transition, view-transition-group, view-transition-image-pair, view-transition-old, view-transition-new
-	PseudoIdentifier  string            `json:"pseudoIdentifier,omitempty"`  // Pseudo element identifier for this node. Only present if there is a valid pseudoType.
-	ShadowRootType    string            `json:"shadowRootType,omitempty"`    // Shadow root type. enum values: user-agent, open, closed
-	FrameId           string            `json:"frameId,omitempty"`           // Frame ID for frame owner elements.
-	ContentDocument   *DOMNode          `json:"contentDocument,omitempty"`   // Content document for frame owner elements.
-	ShadowRoots       []*DOMNode        `json:"shadowRoots,omitempty"`       // Shadow root list for given element host.
-	TemplateContent   *DOMNode          `json:"templateContent,omitempty"`   // Content document fragment for template elements.
-	PseudoElements    []*DOMNode        `json:"pseudoElements,omitempty"`    // Pseudo elements associated with this node.
-	ImportedDocument  *DOMNode          `json:"importedDocument,omitempty"`  // Deprecated, as the HTML Imports API has been removed (crbug.com/937746). This property used to return the imported document for the HTMLImport links. The property is always undefined now.
-	DistributedNodes  []*DOMBackendNode `json:"distributedNodes,omitempty"`  // Distributed nodes for given insertion point.
-	IsSVG             bool              `json:"isSVG,omitempty"`             // Whether the node is SVG.
-	CompatibilityMode string            `json:"compatibilityMode,omitempty"` //  enum values: QuirksMode, LimitedQuirksMode, NoQuirksMode
-	AssignedSlot      *DOMBackendNode   `json:"assignedSlot,omitempty"`      //
+	NodeId           int               `json:"nodeId"`                     // Node identifier that is passed into the rest of the DOM messages as the `nodeId`. Backend will only push node with given `id` once. It is aware of all requested nodes and will only fire DOM events for nodes known to the client.
+	ParentId         int               `json:"parentId,omitempty"`         // The id of the parent node if any.
+	BackendNodeId    int               `json:"backendNodeId"`              // The BackendNodeId for this node.
+	NodeType         int               `json:"nodeType"`                   // `Node`'s nodeType.
+	NodeName         string            `json:"nodeName"`                   // `Node`'s nodeName.
+	LocalName        string            `json:"localName"`                  // `Node`'s localName.
+	NodeValue        string            `json:"nodeValue"`                  // `Node`'s nodeValue.
+	ChildNodeCount   int               `json:"childNodeCount,omitempty"`   // Child count for `Container` nodes.
+	Children         []*DOMNode        `json:"children,omitempty"`         // Child nodes of this node when requested with children.
+	Attributes       []string          `json:"attributes,omitempty"`       // Attributes of the `Element` node in the form of flat array `[name1, value1, name2, value2]`.
+	DocumentURL      string            `json:"documentURL,omitempty"`      // Document URL that `Document` or `FrameOwner` node points to.
+	BaseURL          string            `json:"baseURL,omitempty"`          // Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	PublicId         string            `json:"publicId,omitempty"`         // `DocumentType`'s publicId.
+	SystemId         string            `json:"systemId,omitempty"`         // `DocumentType`'s systemId.
+	InternalSubset   string            `json:"internalSubset,omitempty"`   // `DocumentType`'s internalSubset.
+	XmlVersion       string            `json:"xmlVersion,omitempty"`       // `Document`'s XML version in case of XML documents.
+	Name             string            `json:"name,omitempty"`             // `Attr`'s name.
+	Value            string            `json:"value,omitempty"`            // `Attr`'s value.
+	PseudoType       DOMPseudoType     `json:"pseudoType,omitempty"`       // Pseudo element type for this node. enum values: first-line, first-letter, checkmark, before, after, picker-icon, marker, backdrop, column, selection, search-text, target-text, spelling-error, grammar-error, highlight, first-line-inherited, scroll-marker, scroll-marker-group, scroll-button, scrollbar, scrollbar-thumb, scrollbar-button, scrollbar-track, scrollbar-track-piece, scrollbar-corner, resizer, input-list-button, view-transition, view-transition-group, view-transition-image-pair, view-transition-group-children, view-transition-old, view-transition-new, placeholder, file-selector-button, details-content, picker, permission-icon
+	PseudoIdentifier string            `json:"pseudoIdentifier,omitempty"` // Pseudo element identifier for this node. Only present if there is a valid pseudoType.
+	ShadowRootType   DOMShadowRootType `json:"shadowRootType,omitempty"`   // Shadow root type. enum values: user-agent, open, closed
+	FrameId          string            `json:"frameId,omitempty"`          // Frame ID for frame owner elements.
+	ContentDocument  *DOMNode          `json:"contentDocument,omitempty"`  // Content document for frame owner elements.
+	ShadowRoots      []*DOMNode        `json:"shadowRoots,omitempty"`      // Shadow root list for given element host.
+	TemplateContent  *DOMNode          `json:"templateContent,omitempty"`  // Content document fragment for template elements.
+	PseudoElements   []*DOMNode        `json:"pseudoElements,omitempty"`   // Pseudo elements associated with this node.
+	// Deprecated: this field is deprecated in the protocol.
+	ImportedDocument  *DOMNode             `json:"importedDocument,omitempty"`  // Deprecated, as the HTML Imports API has been removed (crbug.com/937746). This property used to return the imported document for the HTMLImport links. The property is always undefined now.
+	DistributedNodes  []*DOMBackendNode    `json:"distributedNodes,omitempty"`  // Distributed nodes for given insertion point.
+	IsSVG             bool                 `json:"isSVG,omitempty"`             // Whether the node is SVG.
+	CompatibilityMode DOMCompatibilityMode `json:"compatibilityMode,omitempty"` //  enum values: QuirksMode, LimitedQuirksMode, NoQuirksMode
+	AssignedSlot      *DOMBackendNode      `json:"assignedSlot,omitempty"`      //
+	IsScrollable      bool                 `json:"isScrollable,omitempty"`      //
+}
+
+// A structure to hold the top-level node of a detached tree and an array of its retained descendants.
+type DOMDetachedElementInfo struct {
+	TreeNode        *DOMNode `json:"treeNode"`        //
+	RetainedNodeIds []int    `json:"retainedNodeIds"` //
 }
 
 // A structure holding an RGBA color.
@@ -91,6 +327,46 @@ type DOMCSSComputedStyleProperty struct {
 	Value string `json:"value"` // Computed style property value.
 }
 
+// Event method names for the DOM domain
+const (
+	EventDOMAttributeModified       = "DOM.attributeModified"
+	EventDOMAttributeRemoved        = "DOM.attributeRemoved"
+	EventDOMCharacterDataModified   = "DOM.characterDataModified"
+	EventDOMChildNodeCountUpdated   = "DOM.childNodeCountUpdated"
+	EventDOMChildNodeInserted       = "DOM.childNodeInserted"
+	EventDOMChildNodeRemoved        = "DOM.childNodeRemoved"
+	EventDOMDistributedNodesUpdated = "DOM.distributedNodesUpdated"
+	EventDOMDocumentUpdated         = "DOM.documentUpdated"
+	EventDOMInlineStyleInvalidated  = "DOM.inlineStyleInvalidated"
+	EventDOMPseudoElementAdded      = "DOM.pseudoElementAdded"
+	EventDOMTopLayerElementsUpdated = "DOM.topLayerElementsUpdated"
+	EventDOMScrollableFlagUpdated   = "DOM.scrollableFlagUpdated"
+	EventDOMPseudoElementRemoved    = "DOM.pseudoElementRemoved"
+	EventDOMSetChildNodes           = "DOM.setChildNodes"
+	EventDOMShadowRootPopped        = "DOM.shadowRootPopped"
+	EventDOMShadowRootPushed        = "DOM.shadowRootPushed"
+)
+
+// DOMEvents lists all event method names for the DOM domain
+var DOMEvents = []string{
+	EventDOMAttributeModified,
+	EventDOMAttributeRemoved,
+	EventDOMCharacterDataModified,
+	EventDOMChildNodeCountUpdated,
+	EventDOMChildNodeInserted,
+	EventDOMChildNodeRemoved,
+	EventDOMDistributedNodesUpdated,
+	EventDOMDocumentUpdated,
+	EventDOMInlineStyleInvalidated,
+	EventDOMPseudoElementAdded,
+	EventDOMTopLayerElementsUpdated,
+	EventDOMScrollableFlagUpdated,
+	EventDOMPseudoElementRemoved,
+	EventDOMSetChildNodes,
+	EventDOMShadowRootPopped,
+	EventDOMShadowRootPushed,
+}
+
 // Fired when `Element`'s attribute is modified.
 type DOMAttributeModifiedEvent struct {
 	Method string `json:"method"`
@@ -148,6 +424,8 @@ type DOMChildNodeRemovedEvent struct {
 }
 
 // Called when distribution is changed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DOMDistributedNodesUpdatedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -156,7 +434,14 @@ type DOMDistributedNodesUpdatedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Fired when `Document` has been totally updated. Node ids are no longer valid.
+type DOMDocumentUpdatedEvent struct {
+	Method string `json:"method"`
+}
+
 // Fired when `Element`'s inline style is modified via a CSS property modification.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DOMInlineStyleInvalidatedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -165,6 +450,8 @@ type DOMInlineStyleInvalidatedEvent struct {
 }
 
 // Called when a pseudo element is added to an element.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DOMPseudoElementAddedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -173,7 +460,27 @@ type DOMPseudoElementAddedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Called when top layer elements are changed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type DOMTopLayerElementsUpdatedEvent struct {
+	Method string `json:"method"`
+}
+
+// Fired when a node's scrollability state changes.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type DOMScrollableFlagUpdatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		NodeId       int  `json:"nodeId"`       // The id of the node.
+		IsScrollable bool `json:"isScrollable"` // If the node is scrollable.
+	} `json:"Params,omitempty"`
+}
+
 // Called when a pseudo element is removed from an element.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DOMPseudoElementRemovedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -192,6 +499,8 @@ type DOMSetChildNodesEvent struct {
 }
 
 // Called when shadow root is popped from the element.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DOMShadowRootPoppedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -201,6 +510,8 @@ type DOMShadowRootPoppedEvent struct {
 }
 
 // Called when shadow root is pushed into the element.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DOMShadowRootPushedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -218,6 +529,230 @@ func NewDOM(target gcdmessage.ChromeTargeter) *DOM {
 	return c
 }
 
+// OnAttributeModified subscribes to DOM.attributeModified events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DOM) OnAttributeModified(callback func(*DOMAttributeModifiedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMAttributeModified, func(payload []byte) error {
+		event := &DOMAttributeModifiedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAttributeRemoved subscribes to DOM.attributeRemoved events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DOM) OnAttributeRemoved(callback func(*DOMAttributeRemovedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMAttributeRemoved, func(payload []byte) error {
+		event := &DOMAttributeRemovedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnCharacterDataModified subscribes to DOM.characterDataModified events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DOM) OnCharacterDataModified(callback func(*DOMCharacterDataModifiedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMCharacterDataModified, func(payload []byte) error {
+		event := &DOMCharacterDataModifiedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnChildNodeCountUpdated subscribes to DOM.childNodeCountUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DOM) OnChildNodeCountUpdated(callback func(*DOMChildNodeCountUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMChildNodeCountUpdated, func(payload []byte) error {
+		event := &DOMChildNodeCountUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnChildNodeInserted subscribes to DOM.childNodeInserted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DOM) OnChildNodeInserted(callback func(*DOMChildNodeInsertedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMChildNodeInserted, func(payload []byte) error {
+		event := &DOMChildNodeInsertedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnChildNodeRemoved subscribes to DOM.childNodeRemoved events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DOM) OnChildNodeRemoved(callback func(*DOMChildNodeRemovedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMChildNodeRemoved, func(payload []byte) error {
+		event := &DOMChildNodeRemovedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDistributedNodesUpdated subscribes to DOM.distributedNodesUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) OnDistributedNodesUpdated(callback func(*DOMDistributedNodesUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMDistributedNodesUpdated, func(payload []byte) error {
+		event := &DOMDistributedNodesUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDocumentUpdated subscribes to DOM.documentUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DOM) OnDocumentUpdated(callback func(*DOMDocumentUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMDocumentUpdated, func(payload []byte) error {
+		event := &DOMDocumentUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnInlineStyleInvalidated subscribes to DOM.inlineStyleInvalidated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) OnInlineStyleInvalidated(callback func(*DOMInlineStyleInvalidatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMInlineStyleInvalidated, func(payload []byte) error {
+		event := &DOMInlineStyleInvalidatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPseudoElementAdded subscribes to DOM.pseudoElementAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) OnPseudoElementAdded(callback func(*DOMPseudoElementAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMPseudoElementAdded, func(payload []byte) error {
+		event := &DOMPseudoElementAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTopLayerElementsUpdated subscribes to DOM.topLayerElementsUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) OnTopLayerElementsUpdated(callback func(*DOMTopLayerElementsUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMTopLayerElementsUpdated, func(payload []byte) error {
+		event := &DOMTopLayerElementsUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnScrollableFlagUpdated subscribes to DOM.scrollableFlagUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) OnScrollableFlagUpdated(callback func(*DOMScrollableFlagUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMScrollableFlagUpdated, func(payload []byte) error {
+		event := &DOMScrollableFlagUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPseudoElementRemoved subscribes to DOM.pseudoElementRemoved events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) OnPseudoElementRemoved(callback func(*DOMPseudoElementRemovedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMPseudoElementRemoved, func(payload []byte) error {
+		event := &DOMPseudoElementRemovedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSetChildNodes subscribes to DOM.setChildNodes events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *DOM) OnSetChildNodes(callback func(*DOMSetChildNodesEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMSetChildNodes, func(payload []byte) error {
+		event := &DOMSetChildNodesEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnShadowRootPopped subscribes to DOM.shadowRootPopped events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) OnShadowRootPopped(callback func(*DOMShadowRootPoppedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMShadowRootPopped, func(payload []byte) error {
+		event := &DOMShadowRootPoppedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnShadowRootPushed subscribes to DOM.shadowRootPushed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) OnShadowRootPushed(callback func(*DOMShadowRootPushedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventDOMShadowRootPushed, func(payload []byte) error {
+		event := &DOMShadowRootPushedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 type DOMCollectClassNamesFromSubtreeParams struct {
 	// Id of the node to collect class names.
 	NodeId int `json:"nodeId"`
@@ -225,6 +760,8 @@ type DOMCollectClassNamesFromSubtreeParams struct {
 
 // CollectClassNamesFromSubtreeWithParams - Collects class names for the node with given id and all of it's child nodes.
 // Returns -  classNames - Class name list.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) CollectClassNamesFromSubtreeWithParams(ctx context.Context, v *DOMCollectClassNamesFromSubtreeParams) ([]string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.collectClassNamesFromSubtree", Params: v})
 	if err != nil {
@@ -256,6 +793,8 @@ func (c *DOM) CollectClassNamesFromSubtreeWithParams(ctx context.Context, v *DOM
 // CollectClassNamesFromSubtree - Collects class names for the node with given id and all of it's child nodes.
 // nodeId - Id of the node to collect class names.
 // Returns -  classNames - Class name list.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) CollectClassNamesFromSubtree(ctx context.Context, nodeId int) ([]string, error) {
 	var v DOMCollectClassNamesFromSubtreeParams
 	v.NodeId = nodeId
@@ -273,6 +812,8 @@ type DOMCopyToParams struct {
 
 // CopyToWithParams - Creates a deep copy of the specified node and places it into the target container before the given anchor.
 // Returns -  nodeId - Id of the node clone.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) CopyToWithParams(ctx context.Context, v *DOMCopyToParams) (int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.copyTo", Params: v})
 	if err != nil {
@@ -306,6 +847,8 @@ func (c *DOM) CopyToWithParams(ctx context.Context, v *DOMCopyToParams) (int, er
 // targetNodeId - Id of the element to drop the copy into.
 // insertBeforeNodeId - Drop the copy before this node (if absent, the copy becomes the last child of `targetNodeId`).
 // Returns -  nodeId - Id of the node clone.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) CopyTo(ctx context.Context, nodeId int, targetNodeId int, insertBeforeNodeId int) (int, error) {
 	var v DOMCopyToParams
 	v.NodeId = nodeId
@@ -415,12 +958,16 @@ type DOMDiscardSearchResultsParams struct {
 }
 
 // DiscardSearchResultsWithParams - Discards search results from the session with the given id. `getSearchResults` should no longer be called for that search.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) DiscardSearchResultsWithParams(ctx context.Context, v *DOMDiscardSearchResultsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.discardSearchResults", Params: v})
 }
 
 // DiscardSearchResults - Discards search results from the session with the given id. `getSearchResults` should no longer be called for that search.
 // searchId - Unique search session identifier.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) DiscardSearchResults(ctx context.Context, searchId string) (*gcdmessage.ChromeResponse, error) {
 	var v DOMDiscardSearchResultsParams
 	v.SearchId = searchId
@@ -429,7 +976,9 @@ func (c *DOM) DiscardSearchResults(ctx context.Context, searchId string) (*gcdme
 
 type DOMEnableParams struct {
 	// Whether to include whitespaces in the children array of returned Nodes.
-	IncludeWhitespace string `json:"includeWhitespace,omitempty"`
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	IncludeWhitespace DOMEnableIncludeWhitespace `json:"includeWhitespace,omitempty"`
 }
 
 // EnableWithParams - Enables DOM agent for the given page.
@@ -439,7 +988,7 @@ func (c *DOM) EnableWithParams(ctx context.Context, v *DOMEnableParams) (*gcdmes
 
 // Enable - Enables DOM agent for the given page.
 // includeWhitespace - Whether to include whitespaces in the children array of returned Nodes.
-func (c *DOM) Enable(ctx context.Context, includeWhitespace string) (*gcdmessage.ChromeResponse, error) {
+func (c *DOM) Enable(ctx context.Context, includeWhitespace DOMEnableIncludeWhitespace) (*gcdmessage.ChromeResponse, error) {
 	var v DOMEnableParams
 	v.IncludeWhitespace = includeWhitespace
 	return c.EnableWithParams(ctx, &v)
@@ -472,7 +1021,7 @@ func (c *DOM) Focus(ctx context.Context, nodeId int, backendNodeId int, objectId
 }
 
 type DOMGetAttributesParams struct {
-	// Id of the node to retrieve attibutes for.
+	// Id of the node to retrieve attributes for.
 	NodeId int `json:"nodeId"`
 }
 
@@ -507,7 +1056,7 @@ func (c *DOM) GetAttributesWithParams(ctx context.Context, v *DOMGetAttributesPa
 }
 
 // GetAttributes - Returns attributes for the specified node.
-// nodeId - Id of the node to retrieve attibutes for.
+// nodeId - Id of the node to retrieve attributes for.
 // Returns -  attributes - An interleaved array of node attribute names and values.
 func (c *DOM) GetAttributes(ctx context.Context, nodeId int) ([]string, error) {
 	var v DOMGetAttributesParams
@@ -578,6 +1127,8 @@ type DOMGetContentQuadsParams struct {
 
 // GetContentQuadsWithParams - Returns quads that describe node position on the page. This method might return multiple quads for inline nodes.
 // Returns -  quads - Quads that describe node layout relative to viewport.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetContentQuadsWithParams(ctx context.Context, v *DOMGetContentQuadsParams) ([]float64, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getContentQuads", Params: v})
 	if err != nil {
@@ -611,6 +1162,8 @@ func (c *DOM) GetContentQuadsWithParams(ctx context.Context, v *DOMGetContentQua
 // backendNodeId - Identifier of the backend node.
 // objectId - JavaScript object id of the node wrapper.
 // Returns -  quads - Quads that describe node layout relative to viewport.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetContentQuads(ctx context.Context, nodeId int, backendNodeId int, objectId string) ([]float64, error) {
 	var v DOMGetContentQuadsParams
 	v.NodeId = nodeId
@@ -667,6 +1220,7 @@ func (c *DOM) GetDocument(ctx context.Context, depth int, pierce bool) (*DOMNode
 	return c.GetDocumentWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type DOMGetFlattenedDocumentParams struct {
 	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.
 	Depth int `json:"depth,omitempty"`
@@ -676,6 +1230,8 @@ type DOMGetFlattenedDocumentParams struct {
 
 // GetFlattenedDocumentWithParams - Returns the root DOM node (and optionally the subtree) to the caller. Deprecated, as it is not designed to work well with the rest of the DOM agent. Use DOMSnapshot.captureSnapshot instead.
 // Returns -  nodes - Resulting node.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *DOM) GetFlattenedDocumentWithParams(ctx context.Context, v *DOMGetFlattenedDocumentParams) ([]*DOMNode, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getFlattenedDocument", Params: v})
 	if err != nil {
@@ -708,6 +1264,8 @@ func (c *DOM) GetFlattenedDocumentWithParams(ctx context.Context, v *DOMGetFlatt
 // depth - The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.
 // pierce - Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false).
 // Returns -  nodes - Resulting node.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *DOM) GetFlattenedDocument(ctx context.Context, depth int, pierce bool) ([]*DOMNode, error) {
 	var v DOMGetFlattenedDocumentParams
 	v.Depth = depth
@@ -726,6 +1284,8 @@ type DOMGetNodesForSubtreeByStyleParams struct {
 
 // GetNodesForSubtreeByStyleWithParams - Finds nodes with a given computed style in a subtree.
 // Returns -  nodeIds - Resulting nodes.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetNodesForSubtreeByStyleWithParams(ctx context.Context, v *DOMGetNodesForSubtreeByStyleParams) ([]int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getNodesForSubtreeByStyle", Params: v})
 	if err != nil {
@@ -759,6 +1319,8 @@ func (c *DOM) GetNodesForSubtreeByStyleWithParams(ctx context.Context, v *DOMGet
 // computedStyles - The style to filter nodes by (includes nodes if any of properties matches).
 // pierce - Whether or not iframes and shadow roots in the same target should be traversed when returning the results (default is false).
 // Returns -  nodeIds - Resulting nodes.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetNodesForSubtreeByStyle(ctx context.Context, nodeId int, computedStyles []*DOMCSSComputedStyleProperty, pierce bool) ([]int, error) {
 	var v DOMGetNodesForSubtreeByStyleParams
 	v.NodeId = nodeId
@@ -832,6 +1394,10 @@ type DOMGetOuterHTMLParams struct {
 	BackendNodeId int `json:"backendNodeId,omitempty"`
 	// JavaScript object id of the node wrapper.
 	ObjectId string `json:"objectId,omitempty"`
+	// Include all shadow roots. Equals to false if not specified.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	IncludeShadowDOM bool `json:"includeShadowDOM,omitempty"`
 }
 
 // GetOuterHTMLWithParams - Returns node's HTML markup.
@@ -868,12 +1434,14 @@ func (c *DOM) GetOuterHTMLWithParams(ctx context.Context, v *DOMGetOuterHTMLPara
 // nodeId - Identifier of the node.
 // backendNodeId - Identifier of the backend node.
 // objectId - JavaScript object id of the node wrapper.
+// includeShadowDOM - Include all shadow roots. Equals to false if not specified.
 // Returns -  outerHTML - Outer HTML markup.
-func (c *DOM) GetOuterHTML(ctx context.Context, nodeId int, backendNodeId int, objectId string) (string, error) {
+func (c *DOM) GetOuterHTML(ctx context.Context, nodeId int, backendNodeId int, objectId string, includeShadowDOM bool) (string, error) {
 	var v DOMGetOuterHTMLParams
 	v.NodeId = nodeId
 	v.BackendNodeId = backendNodeId
 	v.ObjectId = objectId
+	v.IncludeShadowDOM = includeShadowDOM
 	return c.GetOuterHTMLWithParams(ctx, &v)
 }
 
@@ -884,6 +1452,8 @@ type DOMGetRelayoutBoundaryParams struct {
 
 // GetRelayoutBoundaryWithParams - Returns the id of the nearest ancestor that is a relayout boundary.
 // Returns -  nodeId - Relayout boundary node id for the given node.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetRelayoutBoundaryWithParams(ctx context.Context, v *DOMGetRelayoutBoundaryParams) (int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getRelayoutBoundary", Params: v})
 	if err != nil {
@@ -915,6 +1485,8 @@ func (c *DOM) GetRelayoutBoundaryWithParams(ctx context.Context, v *DOMGetRelayo
 // GetRelayoutBoundary - Returns the id of the nearest ancestor that is a relayout boundary.
 // nodeId - Id of the node.
 // Returns -  nodeId - Relayout boundary node id for the given node.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetRelayoutBoundary(ctx context.Context, nodeId int) (int, error) {
 	var v DOMGetRelayoutBoundaryParams
 	v.NodeId = nodeId
@@ -932,6 +1504,8 @@ type DOMGetSearchResultsParams struct {
 
 // GetSearchResultsWithParams - Returns search results from given `fromIndex` to given `toIndex` from the search with the given identifier.
 // Returns -  nodeIds - Ids of the search result nodes.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetSearchResultsWithParams(ctx context.Context, v *DOMGetSearchResultsParams) ([]int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getSearchResults", Params: v})
 	if err != nil {
@@ -965,6 +1539,8 @@ func (c *DOM) GetSearchResultsWithParams(ctx context.Context, v *DOMGetSearchRes
 // fromIndex - Start index of the search result to be returned.
 // toIndex - End index of the search result to be returned.
 // Returns -  nodeIds - Ids of the search result nodes.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetSearchResults(ctx context.Context, searchId string, fromIndex int, toIndex int) ([]int, error) {
 	var v DOMGetSearchResultsParams
 	v.SearchId = searchId
@@ -989,6 +1565,8 @@ func (c *DOM) HighlightRect(ctx context.Context) (*gcdmessage.ChromeResponse, er
 }
 
 // Marks last undoable state.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) MarkUndoableState(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.markUndoableState"})
 }
@@ -1054,6 +1632,8 @@ type DOMPerformSearchParams struct {
 
 // PerformSearchWithParams - Searches for a given string in the DOM tree. Use `getSearchResults` to access search results or `cancelSearch` to end this search session.
 // Returns -  searchId - Unique search session identifier. resultCount - Number of search results.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) PerformSearchWithParams(ctx context.Context, v *DOMPerformSearchParams) (string, int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.performSearch", Params: v})
 	if err != nil {
@@ -1087,6 +1667,8 @@ func (c *DOM) PerformSearchWithParams(ctx context.Context, v *DOMPerformSearchPa
 // query - Plain text or query selector or XPath search query.
 // includeUserAgentShadowDOM - True to search in user agent shadow DOM.
 // Returns -  searchId - Unique search session identifier. resultCount - Number of search results.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) PerformSearch(ctx context.Context, query string, includeUserAgentShadowDOM bool) (string, int, error) {
 	var v DOMPerformSearchParams
 	v.Query = query
@@ -1101,6 +1683,8 @@ type DOMPushNodeByPathToFrontendParams struct {
 
 // PushNodeByPathToFrontendWithParams - Requests that the node is sent to the caller given its path. // FIXME, use XPath
 // Returns -  nodeId - Id of the node for given path.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) PushNodeByPathToFrontendWithParams(ctx context.Context, v *DOMPushNodeByPathToFrontendParams) (int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.pushNodeByPathToFrontend", Params: v})
 	if err != nil {
@@ -1132,6 +1716,8 @@ func (c *DOM) PushNodeByPathToFrontendWithParams(ctx context.Context, v *DOMPush
 // PushNodeByPathToFrontend - Requests that the node is sent to the caller given its path. // FIXME, use XPath
 // path - Path to node in the proprietary format.
 // Returns -  nodeId - Id of the node for given path.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) PushNodeByPathToFrontend(ctx context.Context, path string) (int, error) {
 	var v DOMPushNodeByPathToFrontendParams
 	v.Path = path
@@ -1145,6 +1731,8 @@ type DOMPushNodesByBackendIdsToFrontendParams struct {
 
 // PushNodesByBackendIdsToFrontendWithParams - Requests that a batch of nodes is sent to the caller given their backend node ids.
 // Returns -  nodeIds - The array of ids of pushed nodes that correspond to the backend ids specified in backendNodeIds.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) PushNodesByBackendIdsToFrontendWithParams(ctx context.Context, v *DOMPushNodesByBackendIdsToFrontendParams) ([]int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.pushNodesByBackendIdsToFrontend", Params: v})
 	if err != nil {
@@ -1176,6 +1764,8 @@ func (c *DOM) PushNodesByBackendIdsToFrontendWithParams(ctx context.Context, v *
 // PushNodesByBackendIdsToFrontend - Requests that a batch of nodes is sent to the caller given their backend node ids.
 // backendNodeIds - The array of backend node ids.
 // Returns -  nodeIds - The array of ids of pushed nodes that correspond to the backend ids specified in backendNodeIds.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) PushNodesByBackendIdsToFrontend(ctx context.Context, backendNodeIds []int) ([]int, error) {
 	var v DOMPushNodesByBackendIdsToFrontendParams
 	v.BackendNodeIds = backendNodeIds
@@ -1280,6 +1870,8 @@ func (c *DOM) QuerySelectorAll(ctx context.Context, nodeId int, selector string)
 
 // GetTopLayerElements - Returns NodeIds of current top layer elements. Top layer is rendered closest to the user within a viewport, therefore its elements always appear on top of all other content.
 // Returns -  nodeIds - NodeIds of top layer elements
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetTopLayerElements(ctx context.Context) ([]int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getTopLayerElements"})
 	if err != nil {
@@ -1308,7 +1900,61 @@ func (c *DOM) GetTopLayerElements(ctx context.Context) ([]int, error) {
 	return chromeData.Result.NodeIds, nil
 }
 
+type DOMGetElementByRelationParams struct {
+	// Id of the node from which to query the relation.
+	NodeId int `json:"nodeId"`
+	// Type of relation to get.
+	Relation DOMGetElementByRelationRelation `json:"relation"`
+}
+
+// GetElementByRelationWithParams - Returns the NodeId of the matched element according to certain relations.
+// Returns -  nodeId - NodeId of the element matching the queried relation.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) GetElementByRelationWithParams(ctx context.Context, v *DOMGetElementByRelationParams) (int, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getElementByRelation", Params: v})
+	if err != nil {
+		return 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			NodeId int
+		}
+	}
+
+	if resp == nil {
+		return 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return 0, err
+	}
+
+	if chromeData.Error != nil {
+		return 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.NodeId, nil
+}
+
+// GetElementByRelation - Returns the NodeId of the matched element according to certain relations.
+// nodeId - Id of the node from which to query the relation.
+// relation - Type of relation to get.
+// Returns -  nodeId - NodeId of the element matching the queried relation.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) GetElementByRelation(ctx context.Context, nodeId int, relation DOMGetElementByRelationRelation) (int, error) {
+	var v DOMGetElementByRelationParams
+	v.NodeId = nodeId
+	v.Relation = relation
+	return c.GetElementByRelationWithParams(ctx, &v)
+}
+
 // Re-does the last undone action.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) Redo(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.redo"})
 }
@@ -1567,12 +2213,16 @@ type DOMSetNodeStackTracesEnabledParams struct {
 }
 
 // SetNodeStackTracesEnabledWithParams - Sets if stack traces should be captured for Nodes. See `Node.getNodeStackTraces`. Default is disabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) SetNodeStackTracesEnabledWithParams(ctx context.Context, v *DOMSetNodeStackTracesEnabledParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.setNodeStackTracesEnabled", Params: v})
 }
 
 // SetNodeStackTracesEnabled - Sets if stack traces should be captured for Nodes. See `Node.getNodeStackTraces`. Default is disabled.
 // enable - Enable or disable.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) SetNodeStackTracesEnabled(ctx context.Context, enable bool) (*gcdmessage.ChromeResponse, error) {
 	var v DOMSetNodeStackTracesEnabledParams
 	v.Enable = enable
@@ -1586,6 +2236,8 @@ type DOMGetNodeStackTracesParams struct {
 
 // GetNodeStackTracesWithParams - Gets stack traces associated with a Node. As of now, only provides stack trace for Node creation.
 // Returns -  creation - Creation stack trace, if available.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetNodeStackTracesWithParams(ctx context.Context, v *DOMGetNodeStackTracesParams) (*RuntimeStackTrace, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getNodeStackTraces", Params: v})
 	if err != nil {
@@ -1617,6 +2269,8 @@ func (c *DOM) GetNodeStackTracesWithParams(ctx context.Context, v *DOMGetNodeSta
 // GetNodeStackTraces - Gets stack traces associated with a Node. As of now, only provides stack trace for Node creation.
 // nodeId - Id of the node to get stack traces for.
 // Returns -  creation - Creation stack trace, if available.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetNodeStackTraces(ctx context.Context, nodeId int) (*RuntimeStackTrace, error) {
 	var v DOMGetNodeStackTracesParams
 	v.NodeId = nodeId
@@ -1630,6 +2284,8 @@ type DOMGetFileInfoParams struct {
 
 // GetFileInfoWithParams - Returns file information for the given File wrapper.
 // Returns -  path -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetFileInfoWithParams(ctx context.Context, v *DOMGetFileInfoParams) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getFileInfo", Params: v})
 	if err != nil {
@@ -1661,24 +2317,62 @@ func (c *DOM) GetFileInfoWithParams(ctx context.Context, v *DOMGetFileInfoParams
 // GetFileInfo - Returns file information for the given File wrapper.
 // objectId - JavaScript object id of the node wrapper.
 // Returns -  path -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetFileInfo(ctx context.Context, objectId string) (string, error) {
 	var v DOMGetFileInfoParams
 	v.ObjectId = objectId
 	return c.GetFileInfoWithParams(ctx, &v)
 }
 
+// GetDetachedDomNodes - Returns list of detached nodes
+// Returns -  detachedNodes - The list of detached nodes
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) GetDetachedDomNodes(ctx context.Context) ([]*DOMDetachedElementInfo, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getDetachedDomNodes"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			DetachedNodes []*DOMDetachedElementInfo
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.DetachedNodes, nil
+}
+
 type DOMSetInspectedNodeParams struct {
 	// DOM node id to be accessible by means of $x command line API.
 	NodeId int `json:"nodeId"`
 }
 
 // SetInspectedNodeWithParams - Enables console to refer to the node with given id via $x (see Command Line API for more details $x functions).
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) SetInspectedNodeWithParams(ctx context.Context, v *DOMSetInspectedNodeParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.setInspectedNode", Params: v})
 }
 
 // SetInspectedNode - Enables console to refer to the node with given id via $x (see Command Line API for more details $x functions).
 // nodeId - DOM node id to be accessible by means of $x command line API.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) SetInspectedNode(ctx context.Context, nodeId int) (*gcdmessage.ChromeResponse, error) {
 	var v DOMSetInspectedNodeParams
 	v.NodeId = nodeId
@@ -1778,6 +2472,8 @@ func (c *DOM) SetOuterHTML(ctx context.Context, nodeId int, outerHTML string) (*
 }
 
 // Undoes the last performed action.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) Undo(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.undo"})
 }
@@ -1789,6 +2485,8 @@ type DOMGetFrameOwnerParams struct {
 
 // GetFrameOwnerWithParams - Returns iframe node that owns iframe with the given domain.
 // Returns -  backendNodeId - Resulting node. nodeId - Id of the node at given coordinates, only when enabled and requested document.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetFrameOwnerWithParams(ctx context.Context, v *DOMGetFrameOwnerParams) (int, int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getFrameOwner", Params: v})
 	if err != nil {
@@ -1821,6 +2519,8 @@ func (c *DOM) GetFrameOwnerWithParams(ctx context.Context, v *DOMGetFrameOwnerPa
 // GetFrameOwner - Returns iframe node that owns iframe with the given domain.
 // frameId -
 // Returns -  backendNodeId - Resulting node. nodeId - Id of the node at given coordinates, only when enabled and requested document.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetFrameOwner(ctx context.Context, frameId string) (int, int, error) {
 	var v DOMGetFrameOwnerParams
 	v.FrameId = frameId
@@ -1833,13 +2533,19 @@ type DOMGetContainerForNodeParams struct {
 	//
 	ContainerName string `json:"containerName,omitempty"`
 	//  enum values: Horizontal, Vertical, Both
-	PhysicalAxes string `json:"physicalAxes,omitempty"`
+	PhysicalAxes DOMPhysicalAxes `json:"physicalAxes,omitempty"`
 	//  enum values: Inline, Block, Both
-	LogicalAxes string `json:"logicalAxes,omitempty"`
+	LogicalAxes DOMLogicalAxes `json:"logicalAxes,omitempty"`
+	//
+	QueriesScrollState bool `json:"queriesScrollState,omitempty"`
+	//
+	QueriesAnchored bool `json:"queriesAnchored,omitempty"`
 }
 
-// GetContainerForNodeWithParams - Returns the query container of the given node based on container query conditions: containerName, physical, and logical axes. If no axes are provided, the style container is returned, which is the direct parent or the closest element with a matching container-name.
+// GetContainerForNodeWithParams - Returns the query container of the given node based on container query conditions: containerName, physical and logical axes, and whether it queries scroll-state or anchored elements. If no axes are provided and queriesScrollState is false, the style container is returned, which is the direct parent or the closest element with a matching container-name.
 // Returns -  nodeId - The container node for the given node, or null if not found.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetContainerForNodeWithParams(ctx context.Context, v *DOMGetContainerForNodeParams) (int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getContainerForNode", Params: v})
 	if err != nil {
@@ -1868,18 +2574,24 @@ func (c *DOM) GetContainerForNodeWithParams(ctx context.Context, v *DOMGetContai
 	return chromeData.Result.NodeId, nil
 }
 
-// GetContainerForNode - Returns the query container of the given node based on container query conditions: containerName, physical, and logical axes. If no axes are provided, the style container is returned, which is the direct parent or the closest element with a matching container-name.
+// GetContainerForNode - Returns the query container of the given node based on container query conditions: containerName, physical and logical axes, and whether it queries scroll-state or anchored elements. If no axes are provided and queriesScrollState is false, the style container is returned, which is the direct parent or the closest element with a matching container-name.
 // nodeId -
 // containerName -
 // physicalAxes -  enum values: Horizontal, Vertical, Both
 // logicalAxes -  enum values: Inline, Block, Both
+// queriesScrollState -
+// queriesAnchored -
 // Returns -  nodeId - The container node for the given node, or null if not found.
-func (c *DOM) GetContainerForNode(ctx context.Context, nodeId int, containerName string, physicalAxes string, logicalAxes string) (int, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) GetContainerForNode(ctx context.Context, nodeId int, containerName string, physicalAxes DOMPhysicalAxes, logicalAxes DOMLogicalAxes, queriesScrollState bool, queriesAnchored bool) (int, error) {
 	var v DOMGetContainerForNodeParams
 	v.NodeId = nodeId
 	v.ContainerName = containerName
 	v.PhysicalAxes = physicalAxes
 	v.LogicalAxes = logicalAxes
+	v.QueriesScrollState = queriesScrollState
+	v.QueriesAnchored = queriesAnchored
 	return c.GetContainerForNodeWithParams(ctx, &v)
 }
 
@@ -1890,6 +2602,8 @@ type DOMGetQueryingDescendantsForContainerParams struct {
 
 // GetQueryingDescendantsForContainerWithParams - Returns the descendants of a container query container that have container queries against this container.
 // Returns -  nodeIds - Descendant nodes with container queries against the given container.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetQueryingDescendantsForContainerWithParams(ctx context.Context, v *DOMGetQueryingDescendantsForContainerParams) ([]int, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getQueryingDescendantsForContainer", Params: v})
 	if err != nil {
@@ -1921,8 +2635,114 @@ func (c *DOM) GetQueryingDescendantsForContainerWithParams(ctx context.Context,
 // GetQueryingDescendantsForContainer - Returns the descendants of a container query container that have container queries against this container.
 // nodeId - Id of the container node to find querying descendants from.
 // Returns -  nodeIds - Descendant nodes with container queries against the given container.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOM) GetQueryingDescendantsForContainer(ctx context.Context, nodeId int) ([]int, error) {
 	var v DOMGetQueryingDescendantsForContainerParams
 	v.NodeId = nodeId
 	return c.GetQueryingDescendantsForContainerWithParams(ctx, &v)
 }
+
+type DOMGetAnchorElementParams struct {
+	// Id of the positioned element from which to find the anchor.
+	NodeId int `json:"nodeId"`
+	// An optional anchor specifier, as defined in https://www.w3.org/TR/css-anchor-position-1/#anchor-specifier. If not provided, it will return the implicit anchor element for the given positioned element.
+	AnchorSpecifier string `json:"anchorSpecifier,omitempty"`
+}
+
+// GetAnchorElementWithParams - Returns the target anchor element of the given anchor query according to https://www.w3.org/TR/css-anchor-position-1/#target.
+// Returns -  nodeId - The anchor element of the given anchor query.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) GetAnchorElementWithParams(ctx context.Context, v *DOMGetAnchorElementParams) (int, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.getAnchorElement", Params: v})
+	if err != nil {
+		return 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			NodeId int
+		}
+	}
+
+	if resp == nil {
+		return 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return 0, err
+	}
+
+	if chromeData.Error != nil {
+		return 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.NodeId, nil
+}
+
+// GetAnchorElement - Returns the target anchor element of the given anchor query according to https://www.w3.org/TR/css-anchor-position-1/#target.
+// nodeId - Id of the positioned element from which to find the anchor.
+// anchorSpecifier - An optional anchor specifier, as defined in https://www.w3.org/TR/css-anchor-position-1/#anchor-specifier. If not provided, it will return the implicit anchor element for the given positioned element.
+// Returns -  nodeId - The anchor element of the given anchor query.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) GetAnchorElement(ctx context.Context, nodeId int, anchorSpecifier string) (int, error) {
+	var v DOMGetAnchorElementParams
+	v.NodeId = nodeId
+	v.AnchorSpecifier = anchorSpecifier
+	return c.GetAnchorElementWithParams(ctx, &v)
+}
+
+type DOMForceShowPopoverParams struct {
+	// Id of the popover HTMLElement
+	NodeId int `json:"nodeId"`
+	// If true, opens the popover and keeps it open. If false, closes the popover if it was previously force-opened.
+	Enable bool `json:"enable"`
+}
+
+// ForceShowPopoverWithParams - When enabling, this API force-opens the popover identified by nodeId and keeps it open until disabled.
+// Returns -  nodeIds - List of popovers that were closed in order to respect popover stacking order.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) ForceShowPopoverWithParams(ctx context.Context, v *DOMForceShowPopoverParams) ([]int, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOM.forceShowPopover", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			NodeIds []int
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.NodeIds, nil
+}
+
+// ForceShowPopover - When enabling, this API force-opens the popover identified by nodeId and keeps it open until disabled.
+// nodeId - Id of the popover HTMLElement
+// enable - If true, opens the popover and keeps it open. If false, closes the popover if it was previously force-opened.
+// Returns -  nodeIds - List of popovers that were closed in order to respect popover stacking order.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOM) ForceShowPopover(ctx context.Context, nodeId int, enable bool) ([]int, error) {
+	var v DOMForceShowPopoverParams
+	v.NodeId = nodeId
+	v.Enable = enable
+	return c.ForceShowPopoverWithParams(ctx, &v)
+}
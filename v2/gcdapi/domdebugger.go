@@ -9,6 +9,46 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// DOM breakpoint type.
+type DOMDebuggerDOMBreakpointType string
+
+const (
+	DOMDebuggerDOMBreakpointTypeSubtreeModified   DOMDebuggerDOMBreakpointType = "subtree-modified"
+	DOMDebuggerDOMBreakpointTypeAttributeModified DOMDebuggerDOMBreakpointType = "attribute-modified"
+	DOMDebuggerDOMBreakpointTypeNodeRemoved       DOMDebuggerDOMBreakpointType = "node-removed"
+)
+
+// Valid returns true if the value is one of the DOMDebuggerDOMBreakpointType enum values.
+func (e DOMDebuggerDOMBreakpointType) Valid() bool {
+	switch e {
+	case DOMDebuggerDOMBreakpointTypeSubtreeModified,
+		DOMDebuggerDOMBreakpointTypeAttributeModified,
+		DOMDebuggerDOMBreakpointTypeNodeRemoved:
+		return true
+	}
+	return false
+}
+
+// CSP Violation type.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type DOMDebuggerCSPViolationType string
+
+const (
+	DOMDebuggerCSPViolationTypeTrustedtypeSinkViolation   DOMDebuggerCSPViolationType = "trustedtype-sink-violation"
+	DOMDebuggerCSPViolationTypeTrustedtypePolicyViolation DOMDebuggerCSPViolationType = "trustedtype-policy-violation"
+)
+
+// Valid returns true if the value is one of the DOMDebuggerCSPViolationType enum values.
+func (e DOMDebuggerCSPViolationType) Valid() bool {
+	switch e {
+	case DOMDebuggerCSPViolationTypeTrustedtypeSinkViolation,
+		DOMDebuggerCSPViolationTypeTrustedtypePolicyViolation:
+		return true
+	}
+	return false
+}
+
 // Object event listener.
 type DOMDebuggerEventListener struct {
 	Type            string               `json:"type"`                      // `EventListener`'s type.
@@ -88,7 +128,7 @@ type DOMDebuggerRemoveDOMBreakpointParams struct {
 	// Identifier of the node to remove breakpoint from.
 	NodeId int `json:"nodeId"`
 	// Type of the breakpoint to remove. enum values: subtree-modified, attribute-modified, node-removed
-	TheType string `json:"type"`
+	TheType DOMDebuggerDOMBreakpointType `json:"type"`
 }
 
 // RemoveDOMBreakpointWithParams - Removes DOM breakpoint that was set using `setDOMBreakpoint`.
@@ -99,7 +139,7 @@ func (c *DOMDebugger) RemoveDOMBreakpointWithParams(ctx context.Context, v *DOMD
 // RemoveDOMBreakpoint - Removes DOM breakpoint that was set using `setDOMBreakpoint`.
 // nodeId - Identifier of the node to remove breakpoint from.
 // type - Type of the breakpoint to remove. enum values: subtree-modified, attribute-modified, node-removed
-func (c *DOMDebugger) RemoveDOMBreakpoint(ctx context.Context, nodeId int, theType string) (*gcdmessage.ChromeResponse, error) {
+func (c *DOMDebugger) RemoveDOMBreakpoint(ctx context.Context, nodeId int, theType DOMDebuggerDOMBreakpointType) (*gcdmessage.ChromeResponse, error) {
 	var v DOMDebuggerRemoveDOMBreakpointParams
 	v.NodeId = nodeId
 	v.TheType = theType
@@ -110,6 +150,8 @@ type DOMDebuggerRemoveEventListenerBreakpointParams struct {
 	// Event name.
 	EventName string `json:"eventName"`
 	// EventTarget interface name.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	TargetName string `json:"targetName,omitempty"`
 }
 
@@ -128,18 +170,27 @@ func (c *DOMDebugger) RemoveEventListenerBreakpoint(ctx context.Context, eventNa
 	return c.RemoveEventListenerBreakpointWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type DOMDebuggerRemoveInstrumentationBreakpointParams struct {
 	// Instrumentation name to stop on.
 	EventName string `json:"eventName"`
 }
 
 // RemoveInstrumentationBreakpointWithParams - Removes breakpoint on particular native event.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOMDebugger) RemoveInstrumentationBreakpointWithParams(ctx context.Context, v *DOMDebuggerRemoveInstrumentationBreakpointParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMDebugger.removeInstrumentationBreakpoint", Params: v})
 }
 
 // RemoveInstrumentationBreakpoint - Removes breakpoint on particular native event.
 // eventName - Instrumentation name to stop on.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOMDebugger) RemoveInstrumentationBreakpoint(ctx context.Context, eventName string) (*gcdmessage.ChromeResponse, error) {
 	var v DOMDebuggerRemoveInstrumentationBreakpointParams
 	v.EventName = eventName
@@ -166,17 +217,21 @@ func (c *DOMDebugger) RemoveXHRBreakpoint(ctx context.Context, url string) (*gcd
 
 type DOMDebuggerSetBreakOnCSPViolationParams struct {
 	// CSP Violations to stop upon. enum values: trustedtype-sink-violation, trustedtype-policy-violation
-	ViolationTypes []string `json:"violationTypes"`
+	ViolationTypes []DOMDebuggerCSPViolationType `json:"violationTypes"`
 }
 
 // SetBreakOnCSPViolationWithParams - Sets breakpoint on particular CSP violations.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOMDebugger) SetBreakOnCSPViolationWithParams(ctx context.Context, v *DOMDebuggerSetBreakOnCSPViolationParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMDebugger.setBreakOnCSPViolation", Params: v})
 }
 
 // SetBreakOnCSPViolation - Sets breakpoint on particular CSP violations.
 // violationTypes - CSP Violations to stop upon. enum values: trustedtype-sink-violation, trustedtype-policy-violation
-func (c *DOMDebugger) SetBreakOnCSPViolation(ctx context.Context, violationTypes []string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *DOMDebugger) SetBreakOnCSPViolation(ctx context.Context, violationTypes []DOMDebuggerCSPViolationType) (*gcdmessage.ChromeResponse, error) {
 	var v DOMDebuggerSetBreakOnCSPViolationParams
 	v.ViolationTypes = violationTypes
 	return c.SetBreakOnCSPViolationWithParams(ctx, &v)
@@ -186,7 +241,7 @@ type DOMDebuggerSetDOMBreakpointParams struct {
 	// Identifier of the node to set breakpoint on.
 	NodeId int `json:"nodeId"`
 	// Type of the operation to stop upon. enum values: subtree-modified, attribute-modified, node-removed
-	TheType string `json:"type"`
+	TheType DOMDebuggerDOMBreakpointType `json:"type"`
 }
 
 // SetDOMBreakpointWithParams - Sets breakpoint on particular operation with DOM.
@@ -197,7 +252,7 @@ func (c *DOMDebugger) SetDOMBreakpointWithParams(ctx context.Context, v *DOMDebu
 // SetDOMBreakpoint - Sets breakpoint on particular operation with DOM.
 // nodeId - Identifier of the node to set breakpoint on.
 // type - Type of the operation to stop upon. enum values: subtree-modified, attribute-modified, node-removed
-func (c *DOMDebugger) SetDOMBreakpoint(ctx context.Context, nodeId int, theType string) (*gcdmessage.ChromeResponse, error) {
+func (c *DOMDebugger) SetDOMBreakpoint(ctx context.Context, nodeId int, theType DOMDebuggerDOMBreakpointType) (*gcdmessage.ChromeResponse, error) {
 	var v DOMDebuggerSetDOMBreakpointParams
 	v.NodeId = nodeId
 	v.TheType = theType
@@ -208,6 +263,8 @@ type DOMDebuggerSetEventListenerBreakpointParams struct {
 	// DOM Event name to stop on (any DOM event will do).
 	EventName string `json:"eventName"`
 	// EventTarget interface name to stop on. If equal to `"*"` or not provided, will stop on any EventTarget.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	TargetName string `json:"targetName,omitempty"`
 }
 
@@ -226,18 +283,27 @@ func (c *DOMDebugger) SetEventListenerBreakpoint(ctx context.Context, eventName
 	return c.SetEventListenerBreakpointWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type DOMDebuggerSetInstrumentationBreakpointParams struct {
 	// Instrumentation name to stop on.
 	EventName string `json:"eventName"`
 }
 
 // SetInstrumentationBreakpointWithParams - Sets breakpoint on particular native event.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOMDebugger) SetInstrumentationBreakpointWithParams(ctx context.Context, v *DOMDebuggerSetInstrumentationBreakpointParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMDebugger.setInstrumentationBreakpoint", Params: v})
 }
 
 // SetInstrumentationBreakpoint - Sets breakpoint on particular native event.
 // eventName - Instrumentation name to stop on.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *DOMDebugger) SetInstrumentationBreakpoint(ctx context.Context, eventName string) (*gcdmessage.ChromeResponse, error) {
 	var v DOMDebuggerSetInstrumentationBreakpointParams
 	v.EventName = eventName
@@ -5,7 +5,6 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
@@ -31,8 +30,8 @@ type DOMSnapshotDOMNode struct {
 	SystemId             string                      `json:"systemId,omitempty"`             // `DocumentType` node's systemId.
 	FrameId              string                      `json:"frameId,omitempty"`              // Frame ID for frame owner elements and also for the document node.
 	ContentDocumentIndex int                         `json:"contentDocumentIndex,omitempty"` // The index of a frame owner element's content document in the `domNodes` array returned by `getSnapshot`, if any.
-	PseudoType           string                      `json:"pseudoType,omitempty"`           // Type of a pseudo element node. enum values: first-line, first-letter, before, after, marker, backdrop, selection, target-text, spelling-error, grammar-error, highlight, first-line-inherited, scrollbar, scrollbar-thumb, scrollbar-button, scrollbar-track, scrollbar-track-piece, scrollbar-corner, resizer, input-list-button, view-transition, view-transition-group, view-transition-image-pair, view-transition-old, view-transition-new
-	ShadowRootType       string                      `json:"shadowRootType,omitempty"`       // Shadow root type. enum values: user-agent, open, closed
+	PseudoType           DOMPseudoType               `json:"pseudoType,omitempty"`           // Type of a pseudo element node. enum values: first-line, first-letter, checkmark, before, after, picker-icon, marker, backdrop, column, selection, search-text, target-text, spelling-error, grammar-error, highlight, first-line-inherited, scroll-marker, scroll-marker-group, scroll-button, scrollbar, scrollbar-thumb, scrollbar-button, scrollbar-track, scrollbar-track-piece, scrollbar-corner, resizer, input-list-button, view-transition, view-transition-group, view-transition-image-pair, view-transition-group-children, view-transition-old, view-transition-new, placeholder, file-selector-button, details-content, picker, permission-icon
+	ShadowRootType       DOMShadowRootType           `json:"shadowRootType,omitempty"`       // Shadow root type. enum values: user-agent, open, closed
 	IsClickable          bool                        `json:"isClickable,omitempty"`          // Whether this DOM node responds to mouse clicks. This includes nodes that have had click event listeners attached via JavaScript as well as anchor tags that naturally navigate when clicked.
 	EventListeners       []*DOMDebuggerEventListener `json:"eventListeners,omitempty"`       // Details of the node's event listeners, if any.
 	CurrentSourceURL     string                      `json:"currentSourceURL,omitempty"`     // The selected url for nodes with a srcset attribute.
@@ -150,6 +149,9 @@ type DOMSnapshotTextBoxSnapshot struct {
 	Length      []int     `json:"length"`      // The number of characters in this post layout textbox substring. Characters that would be represented as a surrogate pair in UTF-16 have length 2.
 }
 
+// DOMSnapshot domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type DOMSnapshot struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -158,132 +160,3 @@ func NewDOMSnapshot(target gcdmessage.ChromeTargeter) *DOMSnapshot {
 	c := &DOMSnapshot{target: target}
 	return c
 }
-
-// Disables DOM snapshot agent for the given page.
-func (c *DOMSnapshot) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMSnapshot.disable"})
-}
-
-// Enables DOM snapshot agent for the given page.
-func (c *DOMSnapshot) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMSnapshot.enable"})
-}
-
-type DOMSnapshotGetSnapshotParams struct {
-	// Whitelist of computed styles to return.
-	ComputedStyleWhitelist []string `json:"computedStyleWhitelist"`
-	// Whether or not to retrieve details of DOM listeners (default false).
-	IncludeEventListeners bool `json:"includeEventListeners,omitempty"`
-	// Whether to determine and include the paint order index of LayoutTreeNodes (default false).
-	IncludePaintOrder bool `json:"includePaintOrder,omitempty"`
-	// Whether to include UA shadow tree in the snapshot (default false).
-	IncludeUserAgentShadowTree bool `json:"includeUserAgentShadowTree,omitempty"`
-}
-
-// GetSnapshotWithParams - Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
-// Returns -  domNodes - The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. layoutTreeNodes - The nodes in the layout tree. computedStyles - Whitelisted ComputedStyle properties for each node in the layout tree.
-func (c *DOMSnapshot) GetSnapshotWithParams(ctx context.Context, v *DOMSnapshotGetSnapshotParams) ([]*DOMSnapshotDOMNode, []*DOMSnapshotLayoutTreeNode, []*DOMSnapshotComputedStyle, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMSnapshot.getSnapshot", Params: v})
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			DomNodes        []*DOMSnapshotDOMNode
-			LayoutTreeNodes []*DOMSnapshotLayoutTreeNode
-			ComputedStyles  []*DOMSnapshotComputedStyle
-		}
-	}
-
-	if resp == nil {
-		return nil, nil, nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, nil, nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, nil, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.DomNodes, chromeData.Result.LayoutTreeNodes, chromeData.Result.ComputedStyles, nil
-}
-
-// GetSnapshot - Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
-// computedStyleWhitelist - Whitelist of computed styles to return.
-// includeEventListeners - Whether or not to retrieve details of DOM listeners (default false).
-// includePaintOrder - Whether to determine and include the paint order index of LayoutTreeNodes (default false).
-// includeUserAgentShadowTree - Whether to include UA shadow tree in the snapshot (default false).
-// Returns -  domNodes - The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. layoutTreeNodes - The nodes in the layout tree. computedStyles - Whitelisted ComputedStyle properties for each node in the layout tree.
-func (c *DOMSnapshot) GetSnapshot(ctx context.Context, computedStyleWhitelist []string, includeEventListeners bool, includePaintOrder bool, includeUserAgentShadowTree bool) ([]*DOMSnapshotDOMNode, []*DOMSnapshotLayoutTreeNode, []*DOMSnapshotComputedStyle, error) {
-	var v DOMSnapshotGetSnapshotParams
-	v.ComputedStyleWhitelist = computedStyleWhitelist
-	v.IncludeEventListeners = includeEventListeners
-	v.IncludePaintOrder = includePaintOrder
-	v.IncludeUserAgentShadowTree = includeUserAgentShadowTree
-	return c.GetSnapshotWithParams(ctx, &v)
-}
-
-type DOMSnapshotCaptureSnapshotParams struct {
-	// Whitelist of computed styles to return.
-	ComputedStyles []string `json:"computedStyles"`
-	// Whether to include layout object paint orders into the snapshot.
-	IncludePaintOrder bool `json:"includePaintOrder,omitempty"`
-	// Whether to include DOM rectangles (offsetRects, clientRects, scrollRects) into the snapshot
-	IncludeDOMRects bool `json:"includeDOMRects,omitempty"`
-	// Whether to include blended background colors in the snapshot (default: false). Blended background color is achieved by blending background colors of all elements that overlap with the current element.
-	IncludeBlendedBackgroundColors bool `json:"includeBlendedBackgroundColors,omitempty"`
-	// Whether to include text color opacity in the snapshot (default: false). An element might have the opacity property set that affects the text color of the element. The final text color opacity is computed based on the opacity of all overlapping elements.
-	IncludeTextColorOpacities bool `json:"includeTextColorOpacities,omitempty"`
-}
-
-// CaptureSnapshotWithParams - Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
-// Returns -  documents - The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. strings - Shared string table that all string properties refer to with indexes.
-func (c *DOMSnapshot) CaptureSnapshotWithParams(ctx context.Context, v *DOMSnapshotCaptureSnapshotParams) ([]*DOMSnapshotDocumentSnapshot, []string, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMSnapshot.captureSnapshot", Params: v})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Documents []*DOMSnapshotDocumentSnapshot
-			Strings   []string
-		}
-	}
-
-	if resp == nil {
-		return nil, nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Documents, chromeData.Result.Strings, nil
-}
-
-// CaptureSnapshot - Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
-// computedStyles - Whitelist of computed styles to return.
-// includePaintOrder - Whether to include layout object paint orders into the snapshot.
-// includeDOMRects - Whether to include DOM rectangles (offsetRects, clientRects, scrollRects) into the snapshot
-// includeBlendedBackgroundColors - Whether to include blended background colors in the snapshot (default: false). Blended background color is achieved by blending background colors of all elements that overlap with the current element.
-// includeTextColorOpacities - Whether to include text color opacity in the snapshot (default: false). An element might have the opacity property set that affects the text color of the element. The final text color opacity is computed based on the opacity of all overlapping elements.
-// Returns -  documents - The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. strings - Shared string table that all string properties refer to with indexes.
-func (c *DOMSnapshot) CaptureSnapshot(ctx context.Context, computedStyles []string, includePaintOrder bool, includeDOMRects bool, includeBlendedBackgroundColors bool, includeTextColorOpacities bool) ([]*DOMSnapshotDocumentSnapshot, []string, error) {
-	var v DOMSnapshotCaptureSnapshotParams
-	v.ComputedStyles = computedStyles
-	v.IncludePaintOrder = includePaintOrder
-	v.IncludeDOMRects = includeDOMRects
-	v.IncludeBlendedBackgroundColors = includeBlendedBackgroundColors
-	v.IncludeTextColorOpacities = includeTextColorOpacities
-	return c.CaptureSnapshotWithParams(ctx, &v)
-}
@@ -0,0 +1,150 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains DOMSnapshot functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// Disables DOM snapshot agent for the given page.
+func (c *DOMSnapshot) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMSnapshot.disable"})
+}
+
+// Enables DOM snapshot agent for the given page.
+func (c *DOMSnapshot) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMSnapshot.enable"})
+}
+
+// Deprecated: this command is deprecated in the protocol.
+type DOMSnapshotGetSnapshotParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyleWhitelist []string `json:"computedStyleWhitelist"`
+	// Whether or not to retrieve details of DOM listeners (default false).
+	IncludeEventListeners bool `json:"includeEventListeners,omitempty"`
+	// Whether to determine and include the paint order index of LayoutTreeNodes (default false).
+	IncludePaintOrder bool `json:"includePaintOrder,omitempty"`
+	// Whether to include UA shadow tree in the snapshot (default false).
+	IncludeUserAgentShadowTree bool `json:"includeUserAgentShadowTree,omitempty"`
+}
+
+// GetSnapshotWithParams - Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
+// Returns -  domNodes - The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. layoutTreeNodes - The nodes in the layout tree. computedStyles - Whitelisted ComputedStyle properties for each node in the layout tree.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *DOMSnapshot) GetSnapshotWithParams(ctx context.Context, v *DOMSnapshotGetSnapshotParams) ([]*DOMSnapshotDOMNode, []*DOMSnapshotLayoutTreeNode, []*DOMSnapshotComputedStyle, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMSnapshot.getSnapshot", Params: v})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			DomNodes        []*DOMSnapshotDOMNode
+			LayoutTreeNodes []*DOMSnapshotLayoutTreeNode
+			ComputedStyles  []*DOMSnapshotComputedStyle
+		}
+	}
+
+	if resp == nil {
+		return nil, nil, nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, nil, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.DomNodes, chromeData.Result.LayoutTreeNodes, chromeData.Result.ComputedStyles, nil
+}
+
+// GetSnapshot - Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
+// computedStyleWhitelist - Whitelist of computed styles to return.
+// includeEventListeners - Whether or not to retrieve details of DOM listeners (default false).
+// includePaintOrder - Whether to determine and include the paint order index of LayoutTreeNodes (default false).
+// includeUserAgentShadowTree - Whether to include UA shadow tree in the snapshot (default false).
+// Returns -  domNodes - The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. layoutTreeNodes - The nodes in the layout tree. computedStyles - Whitelisted ComputedStyle properties for each node in the layout tree.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *DOMSnapshot) GetSnapshot(ctx context.Context, computedStyleWhitelist []string, includeEventListeners bool, includePaintOrder bool, includeUserAgentShadowTree bool) ([]*DOMSnapshotDOMNode, []*DOMSnapshotLayoutTreeNode, []*DOMSnapshotComputedStyle, error) {
+	var v DOMSnapshotGetSnapshotParams
+	v.ComputedStyleWhitelist = computedStyleWhitelist
+	v.IncludeEventListeners = includeEventListeners
+	v.IncludePaintOrder = includePaintOrder
+	v.IncludeUserAgentShadowTree = includeUserAgentShadowTree
+	return c.GetSnapshotWithParams(ctx, &v)
+}
+
+type DOMSnapshotCaptureSnapshotParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyles []string `json:"computedStyles"`
+	// Whether to include layout object paint orders into the snapshot.
+	IncludePaintOrder bool `json:"includePaintOrder,omitempty"`
+	// Whether to include DOM rectangles (offsetRects, clientRects, scrollRects) into the snapshot
+	IncludeDOMRects bool `json:"includeDOMRects,omitempty"`
+	// Whether to include blended background colors in the snapshot (default: false). Blended background color is achieved by blending background colors of all elements that overlap with the current element.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	IncludeBlendedBackgroundColors bool `json:"includeBlendedBackgroundColors,omitempty"`
+	// Whether to include text color opacity in the snapshot (default: false). An element might have the opacity property set that affects the text color of the element. The final text color opacity is computed based on the opacity of all overlapping elements.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	IncludeTextColorOpacities bool `json:"includeTextColorOpacities,omitempty"`
+}
+
+// CaptureSnapshotWithParams - Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
+// Returns -  documents - The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. strings - Shared string table that all string properties refer to with indexes.
+func (c *DOMSnapshot) CaptureSnapshotWithParams(ctx context.Context, v *DOMSnapshotCaptureSnapshotParams) ([]*DOMSnapshotDocumentSnapshot, []string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "DOMSnapshot.captureSnapshot", Params: v})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Documents []*DOMSnapshotDocumentSnapshot
+			Strings   []string
+		}
+	}
+
+	if resp == nil {
+		return nil, nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Documents, chromeData.Result.Strings, nil
+}
+
+// CaptureSnapshot - Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
+// computedStyles - Whitelist of computed styles to return.
+// includePaintOrder - Whether to include layout object paint orders into the snapshot.
+// includeDOMRects - Whether to include DOM rectangles (offsetRects, clientRects, scrollRects) into the snapshot
+// includeBlendedBackgroundColors - Whether to include blended background colors in the snapshot (default: false). Blended background color is achieved by blending background colors of all elements that overlap with the current element.
+// includeTextColorOpacities - Whether to include text color opacity in the snapshot (default: false). An element might have the opacity property set that affects the text color of the element. The final text color opacity is computed based on the opacity of all overlapping elements.
+// Returns -  documents - The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. strings - Shared string table that all string properties refer to with indexes.
+func (c *DOMSnapshot) CaptureSnapshot(ctx context.Context, computedStyles []string, includePaintOrder bool, includeDOMRects bool, includeBlendedBackgroundColors bool, includeTextColorOpacities bool) ([]*DOMSnapshotDocumentSnapshot, []string, error) {
+	var v DOMSnapshotCaptureSnapshotParams
+	v.ComputedStyles = computedStyles
+	v.IncludePaintOrder = includePaintOrder
+	v.IncludeDOMRects = includeDOMRects
+	v.IncludeBlendedBackgroundColors = includeBlendedBackgroundColors
+	v.IncludeTextColorOpacities = includeTextColorOpacities
+	return c.CaptureSnapshotWithParams(ctx, &v)
+}
@@ -9,17 +9,256 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Orientation type.
+type EmulationScreenOrientationType string
+
+const (
+	EmulationScreenOrientationTypePortraitPrimary    EmulationScreenOrientationType = "portraitPrimary"
+	EmulationScreenOrientationTypePortraitSecondary  EmulationScreenOrientationType = "portraitSecondary"
+	EmulationScreenOrientationTypeLandscapePrimary   EmulationScreenOrientationType = "landscapePrimary"
+	EmulationScreenOrientationTypeLandscapeSecondary EmulationScreenOrientationType = "landscapeSecondary"
+)
+
+// Valid returns true if the value is one of the EmulationScreenOrientationType enum values.
+func (e EmulationScreenOrientationType) Valid() bool {
+	switch e {
+	case EmulationScreenOrientationTypePortraitPrimary,
+		EmulationScreenOrientationTypePortraitSecondary,
+		EmulationScreenOrientationTypeLandscapePrimary,
+		EmulationScreenOrientationTypeLandscapeSecondary:
+		return true
+	}
+	return false
+}
+
+// Orientation of a display feature in relation to screen
+type EmulationDisplayFeatureOrientation string
+
+const (
+	EmulationDisplayFeatureOrientationVertical   EmulationDisplayFeatureOrientation = "vertical"
+	EmulationDisplayFeatureOrientationHorizontal EmulationDisplayFeatureOrientation = "horizontal"
+)
+
+// Valid returns true if the value is one of the EmulationDisplayFeatureOrientation enum values.
+func (e EmulationDisplayFeatureOrientation) Valid() bool {
+	switch e {
+	case EmulationDisplayFeatureOrientationVertical,
+		EmulationDisplayFeatureOrientationHorizontal:
+		return true
+	}
+	return false
+}
+
+// Current posture of the device
+type EmulationDevicePostureType string
+
+const (
+	EmulationDevicePostureTypeContinuous EmulationDevicePostureType = "continuous"
+	EmulationDevicePostureTypeFolded     EmulationDevicePostureType = "folded"
+)
+
+// Valid returns true if the value is one of the EmulationDevicePostureType enum values.
+func (e EmulationDevicePostureType) Valid() bool {
+	switch e {
+	case EmulationDevicePostureTypeContinuous,
+		EmulationDevicePostureTypeFolded:
+		return true
+	}
+	return false
+}
+
+// advance: If the scheduler runs out of immediate work, the virtual time base may fast forward to allow the next delayed task (if any) to run; pause: The virtual time base may not advance; pauseIfNetworkFetchesPending: The virtual time base may not advance if there are any pending resource fetches.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationVirtualTimePolicy string
+
+const (
+	EmulationVirtualTimePolicyAdvance                      EmulationVirtualTimePolicy = "advance"
+	EmulationVirtualTimePolicyPause                        EmulationVirtualTimePolicy = "pause"
+	EmulationVirtualTimePolicyPauseIfNetworkFetchesPending EmulationVirtualTimePolicy = "pauseIfNetworkFetchesPending"
+)
+
+// Valid returns true if the value is one of the EmulationVirtualTimePolicy enum values.
+func (e EmulationVirtualTimePolicy) Valid() bool {
+	switch e {
+	case EmulationVirtualTimePolicyAdvance,
+		EmulationVirtualTimePolicyPause,
+		EmulationVirtualTimePolicyPauseIfNetworkFetchesPending:
+		return true
+	}
+	return false
+}
+
+// Used to specify sensor types to emulate. See https://w3c.github.io/sensors/#automation for more information.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationSensorType string
+
+const (
+	EmulationSensorTypeAbsoluteOrientation EmulationSensorType = "absolute-orientation"
+	EmulationSensorTypeAccelerometer       EmulationSensorType = "accelerometer"
+	EmulationSensorTypeAmbientLight        EmulationSensorType = "ambient-light"
+	EmulationSensorTypeGravity             EmulationSensorType = "gravity"
+	EmulationSensorTypeGyroscope           EmulationSensorType = "gyroscope"
+	EmulationSensorTypeLinearAcceleration  EmulationSensorType = "linear-acceleration"
+	EmulationSensorTypeMagnetometer        EmulationSensorType = "magnetometer"
+	EmulationSensorTypeRelativeOrientation EmulationSensorType = "relative-orientation"
+)
+
+// Valid returns true if the value is one of the EmulationSensorType enum values.
+func (e EmulationSensorType) Valid() bool {
+	switch e {
+	case EmulationSensorTypeAbsoluteOrientation,
+		EmulationSensorTypeAccelerometer,
+		EmulationSensorTypeAmbientLight,
+		EmulationSensorTypeGravity,
+		EmulationSensorTypeGyroscope,
+		EmulationSensorTypeLinearAcceleration,
+		EmulationSensorTypeMagnetometer,
+		EmulationSensorTypeRelativeOrientation:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationPressureSource string
+
+const (
+	EmulationPressureSourceCpu EmulationPressureSource = "cpu"
+)
+
+// Valid returns true if the value is one of the EmulationPressureSource enum values.
+func (e EmulationPressureSource) Valid() bool {
+	switch e {
+	case EmulationPressureSourceCpu:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationPressureState string
+
+const (
+	EmulationPressureStateNominal  EmulationPressureState = "nominal"
+	EmulationPressureStateFair     EmulationPressureState = "fair"
+	EmulationPressureStateSerious  EmulationPressureState = "serious"
+	EmulationPressureStateCritical EmulationPressureState = "critical"
+)
+
+// Valid returns true if the value is one of the EmulationPressureState enum values.
+func (e EmulationPressureState) Valid() bool {
+	switch e {
+	case EmulationPressureStateNominal,
+		EmulationPressureStateFair,
+		EmulationPressureStateSerious,
+		EmulationPressureStateCritical:
+		return true
+	}
+	return false
+}
+
+// Enum of image types that can be disabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationDisabledImageType string
+
+const (
+	EmulationDisabledImageTypeAvif EmulationDisabledImageType = "avif"
+	EmulationDisabledImageTypeWebp EmulationDisabledImageType = "webp"
+)
+
+// Valid returns true if the value is one of the EmulationDisabledImageType enum values.
+func (e EmulationDisabledImageType) Valid() bool {
+	switch e {
+	case EmulationDisabledImageTypeAvif,
+		EmulationDisabledImageTypeWebp:
+		return true
+	}
+	return false
+}
+
+// Touch/gesture events configuration. Default: current platform.
+type EmulationSetEmitTouchEventsForMouseConfiguration string
+
+const (
+	EmulationSetEmitTouchEventsForMouseConfigurationMobile  EmulationSetEmitTouchEventsForMouseConfiguration = "mobile"
+	EmulationSetEmitTouchEventsForMouseConfigurationDesktop EmulationSetEmitTouchEventsForMouseConfiguration = "desktop"
+)
+
+// Valid returns true if the value is one of the EmulationSetEmitTouchEventsForMouseConfiguration enum values.
+func (e EmulationSetEmitTouchEventsForMouseConfiguration) Valid() bool {
+	switch e {
+	case EmulationSetEmitTouchEventsForMouseConfigurationMobile,
+		EmulationSetEmitTouchEventsForMouseConfigurationDesktop:
+		return true
+	}
+	return false
+}
+
+// Vision deficiency to emulate. Order: best-effort emulations come first, followed by any physiologically accurate emulations for medically recognized color vision deficiencies.
+type EmulationSetEmulatedVisionDeficiencyType string
+
+const (
+	EmulationSetEmulatedVisionDeficiencyTypeNone            EmulationSetEmulatedVisionDeficiencyType = "none"
+	EmulationSetEmulatedVisionDeficiencyTypeBlurredVision   EmulationSetEmulatedVisionDeficiencyType = "blurredVision"
+	EmulationSetEmulatedVisionDeficiencyTypeReducedContrast EmulationSetEmulatedVisionDeficiencyType = "reducedContrast"
+	EmulationSetEmulatedVisionDeficiencyTypeAchromatopsia   EmulationSetEmulatedVisionDeficiencyType = "achromatopsia"
+	EmulationSetEmulatedVisionDeficiencyTypeDeuteranopia    EmulationSetEmulatedVisionDeficiencyType = "deuteranopia"
+	EmulationSetEmulatedVisionDeficiencyTypeProtanopia      EmulationSetEmulatedVisionDeficiencyType = "protanopia"
+	EmulationSetEmulatedVisionDeficiencyTypeTritanopia      EmulationSetEmulatedVisionDeficiencyType = "tritanopia"
+)
+
+// Valid returns true if the value is one of the EmulationSetEmulatedVisionDeficiencyType enum values.
+func (e EmulationSetEmulatedVisionDeficiencyType) Valid() bool {
+	switch e {
+	case EmulationSetEmulatedVisionDeficiencyTypeNone,
+		EmulationSetEmulatedVisionDeficiencyTypeBlurredVision,
+		EmulationSetEmulatedVisionDeficiencyTypeReducedContrast,
+		EmulationSetEmulatedVisionDeficiencyTypeAchromatopsia,
+		EmulationSetEmulatedVisionDeficiencyTypeDeuteranopia,
+		EmulationSetEmulatedVisionDeficiencyTypeProtanopia,
+		EmulationSetEmulatedVisionDeficiencyTypeTritanopia:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationSafeAreaInsets struct {
+	Top       int `json:"top,omitempty"`       // Overrides safe-area-inset-top.
+	TopMax    int `json:"topMax,omitempty"`    // Overrides safe-area-max-inset-top.
+	Left      int `json:"left,omitempty"`      // Overrides safe-area-inset-left.
+	LeftMax   int `json:"leftMax,omitempty"`   // Overrides safe-area-max-inset-left.
+	Bottom    int `json:"bottom,omitempty"`    // Overrides safe-area-inset-bottom.
+	BottomMax int `json:"bottomMax,omitempty"` // Overrides safe-area-max-inset-bottom.
+	Right     int `json:"right,omitempty"`     // Overrides safe-area-inset-right.
+	RightMax  int `json:"rightMax,omitempty"`  // Overrides safe-area-max-inset-right.
+}
+
 // Screen orientation.
 type EmulationScreenOrientation struct {
-	Type  string `json:"type"`  // Orientation type.
-	Angle int    `json:"angle"` // Orientation angle.
+	Type  EmulationScreenOrientationType `json:"type"`  // Orientation type.
+	Angle int                            `json:"angle"` // Orientation angle.
 }
 
 // No Description.
 type EmulationDisplayFeature struct {
-	Orientation string `json:"orientation"` // Orientation of a display feature in relation to screen
-	Offset      int    `json:"offset"`      // The offset from the screen origin in either the x (for vertical orientation) or y (for horizontal orientation) direction.
-	MaskLength  int    `json:"maskLength"`  // A display feature may mask content such that it is not physically displayed - this length along with the offset describes this area. A display feature that only splits content will have a 0 mask_length.
+	Orientation EmulationDisplayFeatureOrientation `json:"orientation"` // Orientation of a display feature in relation to screen
+	Offset      int                                `json:"offset"`      // The offset from the screen origin in either the x (for vertical orientation) or y (for horizontal orientation) direction.
+	MaskLength  int                                `json:"maskLength"`  // A display feature may mask content such that it is not physically displayed - this length along with the offset describes this area. A display feature that only splits content will have a 0 mask_length.
+}
+
+// No Description.
+type EmulationDevicePosture struct {
+	Type EmulationDevicePostureType `json:"type"` // Current posture of the device
 }
 
 // No Description.
@@ -28,24 +267,98 @@ type EmulationMediaFeature struct {
 	Value string `json:"value"` //
 }
 
-// Used to specify User Agent Cient Hints to emulate. See https://wicg.github.io/ua-client-hints
+// Used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type EmulationUserAgentBrandVersion struct {
 	Brand   string `json:"brand"`   //
 	Version string `json:"version"` //
 }
 
-// Used to specify User Agent Cient Hints to emulate. See https://wicg.github.io/ua-client-hints Missing optional values will be filled in by the target with what it would normally use.
+// Used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints Missing optional values will be filled in by the target with what it would normally use.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type EmulationUserAgentMetadata struct {
 	Brands          []*EmulationUserAgentBrandVersion `json:"brands,omitempty"`          // Brands appearing in Sec-CH-UA.
 	FullVersionList []*EmulationUserAgentBrandVersion `json:"fullVersionList,omitempty"` // Brands appearing in Sec-CH-UA-Full-Version-List.
-	FullVersion     string                            `json:"fullVersion,omitempty"`     //
-	Platform        string                            `json:"platform"`                  //
-	PlatformVersion string                            `json:"platformVersion"`           //
-	Architecture    string                            `json:"architecture"`              //
-	Model           string                            `json:"model"`                     //
-	Mobile          bool                              `json:"mobile"`                    //
-	Bitness         string                            `json:"bitness,omitempty"`         //
-	Wow64           bool                              `json:"wow64,omitempty"`           //
+	// Deprecated: this field is deprecated in the protocol.
+	FullVersion     string   `json:"fullVersion,omitempty"` //
+	Platform        string   `json:"platform"`              //
+	PlatformVersion string   `json:"platformVersion"`       //
+	Architecture    string   `json:"architecture"`          //
+	Model           string   `json:"model"`                 //
+	Mobile          bool     `json:"mobile"`                //
+	Bitness         string   `json:"bitness,omitempty"`     //
+	Wow64           bool     `json:"wow64,omitempty"`       //
+	FormFactors     []string `json:"formFactors,omitempty"` // Used to specify User Agent form-factor values. See https://wicg.github.io/ua-client-hints/#sec-ch-ua-form-factors
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationSensorMetadata struct {
+	Available        bool    `json:"available,omitempty"`        //
+	MinimumFrequency float64 `json:"minimumFrequency,omitempty"` //
+	MaximumFrequency float64 `json:"maximumFrequency,omitempty"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationSensorReadingSingle struct {
+	Value float64 `json:"value"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationSensorReadingXYZ struct {
+	X float64 `json:"x"` //
+	Y float64 `json:"y"` //
+	Z float64 `json:"z"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationSensorReadingQuaternion struct {
+	X float64 `json:"x"` //
+	Y float64 `json:"y"` //
+	Z float64 `json:"z"` //
+	W float64 `json:"w"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationSensorReading struct {
+	Single     *EmulationSensorReadingSingle     `json:"single,omitempty"`     //
+	Xyz        *EmulationSensorReadingXYZ        `json:"xyz,omitempty"`        //
+	Quaternion *EmulationSensorReadingQuaternion `json:"quaternion,omitempty"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationPressureMetadata struct {
+	Available bool `json:"available,omitempty"` //
+}
+
+// Event method names for the Emulation domain
+const (
+	EventEmulationVirtualTimeBudgetExpired = "Emulation.virtualTimeBudgetExpired"
+)
+
+// EmulationEvents lists all event method names for the Emulation domain
+var EmulationEvents = []string{
+	EventEmulationVirtualTimeBudgetExpired,
+}
+
+// Notification sent after the virtual time budget for the current VirtualTimePolicy has run out.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type EmulationVirtualTimeBudgetExpiredEvent struct {
+	Method string `json:"method"`
 }
 
 type Emulation struct {
@@ -57,8 +370,25 @@ func NewEmulation(target gcdmessage.ChromeTargeter) *Emulation {
 	return c
 }
 
+// OnVirtualTimeBudgetExpired subscribes to Emulation.virtualTimeBudgetExpired events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) OnVirtualTimeBudgetExpired(callback func(*EmulationVirtualTimeBudgetExpiredEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventEmulationVirtualTimeBudgetExpired, func(payload []byte) error {
+		event := &EmulationVirtualTimeBudgetExpiredEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 // CanEmulate - Tells whether emulation is supported.
 // Returns -  result - True if emulation is supported.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Emulation) CanEmulate(ctx context.Context) (bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.canEmulate"})
 	if err != nil {
@@ -98,6 +428,8 @@ func (c *Emulation) ClearGeolocationOverride(ctx context.Context) (*gcdmessage.C
 }
 
 // Requests that page scale factor is reset to initial values.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) ResetPageScaleFactor(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.resetPageScaleFactor"})
 }
@@ -108,12 +440,16 @@ type EmulationSetFocusEmulationEnabledParams struct {
 }
 
 // SetFocusEmulationEnabledWithParams - Enables or disables simulating a focused and active page.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetFocusEmulationEnabledWithParams(ctx context.Context, v *EmulationSetFocusEmulationEnabledParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setFocusEmulationEnabled", Params: v})
 }
 
 // SetFocusEmulationEnabled - Enables or disables simulating a focused and active page.
 // enabled - Whether to enable to disable focus emulation.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetFocusEmulationEnabled(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetFocusEmulationEnabledParams
 	v.Enabled = enabled
@@ -126,12 +462,16 @@ type EmulationSetAutoDarkModeOverrideParams struct {
 }
 
 // SetAutoDarkModeOverrideWithParams - Automatically render all web contents using a dark theme.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetAutoDarkModeOverrideWithParams(ctx context.Context, v *EmulationSetAutoDarkModeOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setAutoDarkModeOverride", Params: v})
 }
 
 // SetAutoDarkModeOverride - Automatically render all web contents using a dark theme.
 // enabled - Whether to enable or disable automatic dark mode. If not specified, any existing override will be cleared.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetAutoDarkModeOverride(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetAutoDarkModeOverrideParams
 	v.Enabled = enabled
@@ -174,6 +514,28 @@ func (c *Emulation) SetDefaultBackgroundColorOverride(ctx context.Context, color
 	return c.SetDefaultBackgroundColorOverrideWithParams(ctx, &v)
 }
 
+type EmulationSetSafeAreaInsetsOverrideParams struct {
+	//
+	Insets *EmulationSafeAreaInsets `json:"insets"`
+}
+
+// SetSafeAreaInsetsOverrideWithParams - Overrides the values for env(safe-area-inset-*) and env(safe-area-max-inset-*). Unset values will cause the respective variables to be undefined, even if previously overridden.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetSafeAreaInsetsOverrideWithParams(ctx context.Context, v *EmulationSetSafeAreaInsetsOverrideParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setSafeAreaInsetsOverride", Params: v})
+}
+
+// SetSafeAreaInsetsOverride - Overrides the values for env(safe-area-inset-*) and env(safe-area-max-inset-*). Unset values will cause the respective variables to be undefined, even if previously overridden.
+// insets -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetSafeAreaInsetsOverride(ctx context.Context, insets *EmulationSafeAreaInsets) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetSafeAreaInsetsOverrideParams
+	v.Insets = insets
+	return c.SetSafeAreaInsetsOverrideWithParams(ctx, &v)
+}
+
 type EmulationSetDeviceMetricsOverrideParams struct {
 	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
 	Width int `json:"width"`
@@ -184,23 +546,47 @@ type EmulationSetDeviceMetricsOverrideParams struct {
 	// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.
 	Mobile bool `json:"mobile"`
 	// Scale to apply to resulting view image.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Scale float64 `json:"scale,omitempty"`
 	// Overriding screen width value in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ScreenWidth int `json:"screenWidth,omitempty"`
 	// Overriding screen height value in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ScreenHeight int `json:"screenHeight,omitempty"`
 	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	PositionX int `json:"positionX,omitempty"`
 	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	PositionY int `json:"positionY,omitempty"`
 	// Do not set visible view size, rely upon explicit setVisibleSize call.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	DontSetVisibleSize bool `json:"dontSetVisibleSize,omitempty"`
 	// Screen orientation override.
 	ScreenOrientation *EmulationScreenOrientation `json:"screenOrientation,omitempty"`
 	// If set, the visible area of the page will be overridden to this viewport. This viewport change is not observed by the page, e.g. viewport-relative elements do not change positions.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Viewport *PageViewport `json:"viewport,omitempty"`
-	// If set, the display feature of a multi-segment screen. If not set, multi-segment support is turned-off.
+	// If set, the display feature of a multi-segment screen. If not set, multi-segment support is turned-off. Deprecated, use Emulation.setDisplayFeaturesOverride.
+	//
+	// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	DisplayFeature *EmulationDisplayFeature `json:"displayFeature,omitempty"`
+	// If set, the posture of a foldable device. If not set the posture is set to continuous. Deprecated, use Emulation.setDevicePostureOverride.
+	//
+	// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	DevicePosture *EmulationDevicePosture `json:"devicePosture,omitempty"`
 }
 
 // SetDeviceMetricsOverrideWithParams - Overrides the values of device screen dimensions (window.screen.width, window.screen.height, window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media query results).
@@ -221,8 +607,9 @@ func (c *Emulation) SetDeviceMetricsOverrideWithParams(ctx context.Context, v *E
 // dontSetVisibleSize - Do not set visible view size, rely upon explicit setVisibleSize call.
 // screenOrientation - Screen orientation override.
 // viewport - If set, the visible area of the page will be overridden to this viewport. This viewport change is not observed by the page, e.g. viewport-relative elements do not change positions.
-// displayFeature - If set, the display feature of a multi-segment screen. If not set, multi-segment support is turned-off.
-func (c *Emulation) SetDeviceMetricsOverride(ctx context.Context, width int, height int, deviceScaleFactor float64, mobile bool, scale float64, screenWidth int, screenHeight int, positionX int, positionY int, dontSetVisibleSize bool, screenOrientation *EmulationScreenOrientation, viewport *PageViewport, displayFeature *EmulationDisplayFeature) (*gcdmessage.ChromeResponse, error) {
+// displayFeature - If set, the display feature of a multi-segment screen. If not set, multi-segment support is turned-off. Deprecated, use Emulation.setDisplayFeaturesOverride.
+// devicePosture - If set, the posture of a foldable device. If not set the posture is set to continuous. Deprecated, use Emulation.setDevicePostureOverride.
+func (c *Emulation) SetDeviceMetricsOverride(ctx context.Context, width int, height int, deviceScaleFactor float64, mobile bool, scale float64, screenWidth int, screenHeight int, positionX int, positionY int, dontSetVisibleSize bool, screenOrientation *EmulationScreenOrientation, viewport *PageViewport, displayFeature *EmulationDisplayFeature, devicePosture *EmulationDevicePosture) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetDeviceMetricsOverrideParams
 	v.Width = width
 	v.Height = height
@@ -237,21 +624,84 @@ func (c *Emulation) SetDeviceMetricsOverride(ctx context.Context, width int, hei
 	v.ScreenOrientation = screenOrientation
 	v.Viewport = viewport
 	v.DisplayFeature = displayFeature
+	v.DevicePosture = devicePosture
 	return c.SetDeviceMetricsOverrideWithParams(ctx, &v)
 }
 
+type EmulationSetDevicePostureOverrideParams struct {
+	//
+	Posture *EmulationDevicePosture `json:"posture"`
+}
+
+// SetDevicePostureOverrideWithParams - Start reporting the given posture value to the Device Posture API. This override can also be set in setDeviceMetricsOverride().
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetDevicePostureOverrideWithParams(ctx context.Context, v *EmulationSetDevicePostureOverrideParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setDevicePostureOverride", Params: v})
+}
+
+// SetDevicePostureOverride - Start reporting the given posture value to the Device Posture API. This override can also be set in setDeviceMetricsOverride().
+// posture -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetDevicePostureOverride(ctx context.Context, posture *EmulationDevicePosture) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetDevicePostureOverrideParams
+	v.Posture = posture
+	return c.SetDevicePostureOverrideWithParams(ctx, &v)
+}
+
+// Clears a device posture override set with either setDeviceMetricsOverride() or setDevicePostureOverride() and starts using posture information from the platform again. Does nothing if no override is set.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) ClearDevicePostureOverride(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.clearDevicePostureOverride"})
+}
+
+type EmulationSetDisplayFeaturesOverrideParams struct {
+	//
+	Features []*EmulationDisplayFeature `json:"features"`
+}
+
+// SetDisplayFeaturesOverrideWithParams - Start using the given display features to pupulate the Viewport Segments API. This override can also be set in setDeviceMetricsOverride().
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetDisplayFeaturesOverrideWithParams(ctx context.Context, v *EmulationSetDisplayFeaturesOverrideParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setDisplayFeaturesOverride", Params: v})
+}
+
+// SetDisplayFeaturesOverride - Start using the given display features to pupulate the Viewport Segments API. This override can also be set in setDeviceMetricsOverride().
+// features -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetDisplayFeaturesOverride(ctx context.Context, features []*EmulationDisplayFeature) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetDisplayFeaturesOverrideParams
+	v.Features = features
+	return c.SetDisplayFeaturesOverrideWithParams(ctx, &v)
+}
+
+// Clears the display features override set with either setDeviceMetricsOverride() or setDisplayFeaturesOverride() and starts using display features from the platform again. Does nothing if no override is set.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) ClearDisplayFeaturesOverride(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.clearDisplayFeaturesOverride"})
+}
+
 type EmulationSetScrollbarsHiddenParams struct {
 	// Whether scrollbars should be always hidden.
 	Hidden bool `json:"hidden"`
 }
 
 // SetScrollbarsHiddenWithParams -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetScrollbarsHiddenWithParams(ctx context.Context, v *EmulationSetScrollbarsHiddenParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setScrollbarsHidden", Params: v})
 }
 
 // SetScrollbarsHidden -
 // hidden - Whether scrollbars should be always hidden.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetScrollbarsHidden(ctx context.Context, hidden bool) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetScrollbarsHiddenParams
 	v.Hidden = hidden
@@ -264,12 +714,16 @@ type EmulationSetDocumentCookieDisabledParams struct {
 }
 
 // SetDocumentCookieDisabledWithParams -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetDocumentCookieDisabledWithParams(ctx context.Context, v *EmulationSetDocumentCookieDisabledParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setDocumentCookieDisabled", Params: v})
 }
 
 // SetDocumentCookieDisabled -
 // disabled - Whether document.coookie API should be disabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetDocumentCookieDisabled(ctx context.Context, disabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetDocumentCookieDisabledParams
 	v.Disabled = disabled
@@ -280,10 +734,12 @@ type EmulationSetEmitTouchEventsForMouseParams struct {
 	// Whether touch emulation based on mouse input should be enabled.
 	Enabled bool `json:"enabled"`
 	// Touch/gesture events configuration. Default: current platform.
-	Configuration string `json:"configuration,omitempty"`
+	Configuration EmulationSetEmitTouchEventsForMouseConfiguration `json:"configuration,omitempty"`
 }
 
 // SetEmitTouchEventsForMouseWithParams -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetEmitTouchEventsForMouseWithParams(ctx context.Context, v *EmulationSetEmitTouchEventsForMouseParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setEmitTouchEventsForMouse", Params: v})
 }
@@ -291,7 +747,9 @@ func (c *Emulation) SetEmitTouchEventsForMouseWithParams(ctx context.Context, v
 // SetEmitTouchEventsForMouse -
 // enabled - Whether touch emulation based on mouse input should be enabled.
 // configuration - Touch/gesture events configuration. Default: current platform.
-func (c *Emulation) SetEmitTouchEventsForMouse(ctx context.Context, enabled bool, configuration string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetEmitTouchEventsForMouse(ctx context.Context, enabled bool, configuration EmulationSetEmitTouchEventsForMouseConfiguration) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetEmitTouchEventsForMouseParams
 	v.Enabled = enabled
 	v.Configuration = configuration
@@ -322,7 +780,7 @@ func (c *Emulation) SetEmulatedMedia(ctx context.Context, media string, features
 
 type EmulationSetEmulatedVisionDeficiencyParams struct {
 	// Vision deficiency to emulate. Order: best-effort emulations come first, followed by any physiologically accurate emulations for medically recognized color vision deficiencies.
-	TheType string `json:"type"`
+	TheType EmulationSetEmulatedVisionDeficiencyType `json:"type"`
 }
 
 // SetEmulatedVisionDeficiencyWithParams - Emulates the given vision deficiency.
@@ -332,12 +790,30 @@ func (c *Emulation) SetEmulatedVisionDeficiencyWithParams(ctx context.Context, v
 
 // SetEmulatedVisionDeficiency - Emulates the given vision deficiency.
 // type - Vision deficiency to emulate. Order: best-effort emulations come first, followed by any physiologically accurate emulations for medically recognized color vision deficiencies.
-func (c *Emulation) SetEmulatedVisionDeficiency(ctx context.Context, theType string) (*gcdmessage.ChromeResponse, error) {
+func (c *Emulation) SetEmulatedVisionDeficiency(ctx context.Context, theType EmulationSetEmulatedVisionDeficiencyType) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetEmulatedVisionDeficiencyParams
 	v.TheType = theType
 	return c.SetEmulatedVisionDeficiencyWithParams(ctx, &v)
 }
 
+type EmulationSetEmulatedOSTextScaleParams struct {
+	//
+	Scale float64 `json:"scale,omitempty"`
+}
+
+// SetEmulatedOSTextScaleWithParams - Emulates the given OS text scale.
+func (c *Emulation) SetEmulatedOSTextScaleWithParams(ctx context.Context, v *EmulationSetEmulatedOSTextScaleParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setEmulatedOSTextScale", Params: v})
+}
+
+// SetEmulatedOSTextScale - Emulates the given OS text scale.
+// scale -
+func (c *Emulation) SetEmulatedOSTextScale(ctx context.Context, scale float64) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetEmulatedOSTextScaleParams
+	v.Scale = scale
+	return c.SetEmulatedOSTextScaleWithParams(ctx, &v)
+}
+
 type EmulationSetGeolocationOverrideParams struct {
 	// Mock latitude
 	Latitude float64 `json:"latitude,omitempty"`
@@ -345,25 +821,231 @@ type EmulationSetGeolocationOverrideParams struct {
 	Longitude float64 `json:"longitude,omitempty"`
 	// Mock accuracy
 	Accuracy float64 `json:"accuracy,omitempty"`
+	// Mock altitude
+	Altitude float64 `json:"altitude,omitempty"`
+	// Mock altitudeAccuracy
+	AltitudeAccuracy float64 `json:"altitudeAccuracy,omitempty"`
+	// Mock heading
+	Heading float64 `json:"heading,omitempty"`
+	// Mock speed
+	Speed float64 `json:"speed,omitempty"`
 }
 
-// SetGeolocationOverrideWithParams - Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position unavailable.
+// SetGeolocationOverrideWithParams - Overrides the Geolocation Position or Error. Omitting latitude, longitude or accuracy emulates position unavailable.
 func (c *Emulation) SetGeolocationOverrideWithParams(ctx context.Context, v *EmulationSetGeolocationOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setGeolocationOverride", Params: v})
 }
 
-// SetGeolocationOverride - Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position unavailable.
+// SetGeolocationOverride - Overrides the Geolocation Position or Error. Omitting latitude, longitude or accuracy emulates position unavailable.
 // latitude - Mock latitude
 // longitude - Mock longitude
 // accuracy - Mock accuracy
-func (c *Emulation) SetGeolocationOverride(ctx context.Context, latitude float64, longitude float64, accuracy float64) (*gcdmessage.ChromeResponse, error) {
+// altitude - Mock altitude
+// altitudeAccuracy - Mock altitudeAccuracy
+// heading - Mock heading
+// speed - Mock speed
+func (c *Emulation) SetGeolocationOverride(ctx context.Context, latitude float64, longitude float64, accuracy float64, altitude float64, altitudeAccuracy float64, heading float64, speed float64) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetGeolocationOverrideParams
 	v.Latitude = latitude
 	v.Longitude = longitude
 	v.Accuracy = accuracy
+	v.Altitude = altitude
+	v.AltitudeAccuracy = altitudeAccuracy
+	v.Heading = heading
+	v.Speed = speed
 	return c.SetGeolocationOverrideWithParams(ctx, &v)
 }
 
+type EmulationGetOverriddenSensorInformationParams struct {
+	//  enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation
+	TheType EmulationSensorType `json:"type"`
+}
+
+// GetOverriddenSensorInformationWithParams -
+// Returns -  requestedSamplingFrequency -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) GetOverriddenSensorInformationWithParams(ctx context.Context, v *EmulationGetOverriddenSensorInformationParams) (float64, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.getOverriddenSensorInformation", Params: v})
+	if err != nil {
+		return 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			RequestedSamplingFrequency float64
+		}
+	}
+
+	if resp == nil {
+		return 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return 0, err
+	}
+
+	if chromeData.Error != nil {
+		return 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.RequestedSamplingFrequency, nil
+}
+
+// GetOverriddenSensorInformation -
+// type -  enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation
+// Returns -  requestedSamplingFrequency -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) GetOverriddenSensorInformation(ctx context.Context, theType EmulationSensorType) (float64, error) {
+	var v EmulationGetOverriddenSensorInformationParams
+	v.TheType = theType
+	return c.GetOverriddenSensorInformationWithParams(ctx, &v)
+}
+
+type EmulationSetSensorOverrideEnabledParams struct {
+	//
+	Enabled bool `json:"enabled"`
+	//  enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation
+	TheType EmulationSensorType `json:"type"`
+	//
+	Metadata *EmulationSensorMetadata `json:"metadata,omitempty"`
+}
+
+// SetSensorOverrideEnabledWithParams - Overrides a platform sensor of a given type. If |enabled| is true, calls to Sensor.start() will use a virtual sensor as backend rather than fetching data from a real hardware sensor. Otherwise, existing virtual sensor-backend Sensor objects will fire an error event and new calls to Sensor.start() will attempt to use a real sensor instead.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetSensorOverrideEnabledWithParams(ctx context.Context, v *EmulationSetSensorOverrideEnabledParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setSensorOverrideEnabled", Params: v})
+}
+
+// SetSensorOverrideEnabled - Overrides a platform sensor of a given type. If |enabled| is true, calls to Sensor.start() will use a virtual sensor as backend rather than fetching data from a real hardware sensor. Otherwise, existing virtual sensor-backend Sensor objects will fire an error event and new calls to Sensor.start() will attempt to use a real sensor instead.
+// enabled -
+// type -  enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation
+// metadata -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetSensorOverrideEnabled(ctx context.Context, enabled bool, theType EmulationSensorType, metadata *EmulationSensorMetadata) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetSensorOverrideEnabledParams
+	v.Enabled = enabled
+	v.TheType = theType
+	v.Metadata = metadata
+	return c.SetSensorOverrideEnabledWithParams(ctx, &v)
+}
+
+type EmulationSetSensorOverrideReadingsParams struct {
+	//  enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation
+	TheType EmulationSensorType `json:"type"`
+	//
+	Reading *EmulationSensorReading `json:"reading"`
+}
+
+// SetSensorOverrideReadingsWithParams - Updates the sensor readings reported by a sensor type previously overridden by setSensorOverrideEnabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetSensorOverrideReadingsWithParams(ctx context.Context, v *EmulationSetSensorOverrideReadingsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setSensorOverrideReadings", Params: v})
+}
+
+// SetSensorOverrideReadings - Updates the sensor readings reported by a sensor type previously overridden by setSensorOverrideEnabled.
+// type -  enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation
+// reading -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetSensorOverrideReadings(ctx context.Context, theType EmulationSensorType, reading *EmulationSensorReading) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetSensorOverrideReadingsParams
+	v.TheType = theType
+	v.Reading = reading
+	return c.SetSensorOverrideReadingsWithParams(ctx, &v)
+}
+
+type EmulationSetPressureSourceOverrideEnabledParams struct {
+	//
+	Enabled bool `json:"enabled"`
+	//  enum values: cpu
+	Source EmulationPressureSource `json:"source"`
+	//
+	Metadata *EmulationPressureMetadata `json:"metadata,omitempty"`
+}
+
+// SetPressureSourceOverrideEnabledWithParams - Overrides a pressure source of a given type, as used by the Compute Pressure API, so that updates to PressureObserver.observe() are provided via setPressureStateOverride instead of being retrieved from platform-provided telemetry data.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetPressureSourceOverrideEnabledWithParams(ctx context.Context, v *EmulationSetPressureSourceOverrideEnabledParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setPressureSourceOverrideEnabled", Params: v})
+}
+
+// SetPressureSourceOverrideEnabled - Overrides a pressure source of a given type, as used by the Compute Pressure API, so that updates to PressureObserver.observe() are provided via setPressureStateOverride instead of being retrieved from platform-provided telemetry data.
+// enabled -
+// source -  enum values: cpu
+// metadata -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetPressureSourceOverrideEnabled(ctx context.Context, enabled bool, source EmulationPressureSource, metadata *EmulationPressureMetadata) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetPressureSourceOverrideEnabledParams
+	v.Enabled = enabled
+	v.Source = source
+	v.Metadata = metadata
+	return c.SetPressureSourceOverrideEnabledWithParams(ctx, &v)
+}
+
+type EmulationSetPressureStateOverrideParams struct {
+	//  enum values: cpu
+	Source EmulationPressureSource `json:"source"`
+	//  enum values: nominal, fair, serious, critical
+	State EmulationPressureState `json:"state"`
+}
+
+// SetPressureStateOverrideWithParams - TODO: OBSOLETE: To remove when setPressureDataOverride is merged. Provides a given pressure state that will be processed and eventually be delivered to PressureObserver users. |source| must have been previously overridden by setPressureSourceOverrideEnabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetPressureStateOverrideWithParams(ctx context.Context, v *EmulationSetPressureStateOverrideParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setPressureStateOverride", Params: v})
+}
+
+// SetPressureStateOverride - TODO: OBSOLETE: To remove when setPressureDataOverride is merged. Provides a given pressure state that will be processed and eventually be delivered to PressureObserver users. |source| must have been previously overridden by setPressureSourceOverrideEnabled.
+// source -  enum values: cpu
+// state -  enum values: nominal, fair, serious, critical
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetPressureStateOverride(ctx context.Context, source EmulationPressureSource, state EmulationPressureState) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetPressureStateOverrideParams
+	v.Source = source
+	v.State = state
+	return c.SetPressureStateOverrideWithParams(ctx, &v)
+}
+
+type EmulationSetPressureDataOverrideParams struct {
+	//  enum values: cpu
+	Source EmulationPressureSource `json:"source"`
+	//  enum values: nominal, fair, serious, critical
+	State EmulationPressureState `json:"state"`
+	//
+	OwnContributionEstimate float64 `json:"ownContributionEstimate,omitempty"`
+}
+
+// SetPressureDataOverrideWithParams - Provides a given pressure data set that will be processed and eventually be delivered to PressureObserver users. |source| must have been previously overridden by setPressureSourceOverrideEnabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetPressureDataOverrideWithParams(ctx context.Context, v *EmulationSetPressureDataOverrideParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setPressureDataOverride", Params: v})
+}
+
+// SetPressureDataOverride - Provides a given pressure data set that will be processed and eventually be delivered to PressureObserver users. |source| must have been previously overridden by setPressureSourceOverrideEnabled.
+// source -  enum values: cpu
+// state -  enum values: nominal, fair, serious, critical
+// ownContributionEstimate -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetPressureDataOverride(ctx context.Context, source EmulationPressureSource, state EmulationPressureState, ownContributionEstimate float64) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetPressureDataOverrideParams
+	v.Source = source
+	v.State = state
+	v.OwnContributionEstimate = ownContributionEstimate
+	return c.SetPressureDataOverrideWithParams(ctx, &v)
+}
+
 type EmulationSetIdleOverrideParams struct {
 	// Mock isUserActive
 	IsUserActive bool `json:"isUserActive"`
@@ -391,18 +1073,27 @@ func (c *Emulation) ClearIdleOverride(ctx context.Context) (*gcdmessage.ChromeRe
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.clearIdleOverride"})
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type EmulationSetNavigatorOverridesParams struct {
 	// The platform navigator.platform should return.
 	Platform string `json:"platform"`
 }
 
 // SetNavigatorOverridesWithParams - Overrides value returned by the javascript navigator object.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetNavigatorOverridesWithParams(ctx context.Context, v *EmulationSetNavigatorOverridesParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setNavigatorOverrides", Params: v})
 }
 
 // SetNavigatorOverrides - Overrides value returned by the javascript navigator object.
 // platform - The platform navigator.platform should return.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetNavigatorOverrides(ctx context.Context, platform string) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetNavigatorOverridesParams
 	v.Platform = platform
@@ -415,12 +1106,16 @@ type EmulationSetPageScaleFactorParams struct {
 }
 
 // SetPageScaleFactorWithParams - Sets a specified page scale factor.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetPageScaleFactorWithParams(ctx context.Context, v *EmulationSetPageScaleFactorParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setPageScaleFactor", Params: v})
 }
 
 // SetPageScaleFactor - Sets a specified page scale factor.
 // pageScaleFactor - Page scale factor.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetPageScaleFactor(ctx context.Context, pageScaleFactor float64) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetPageScaleFactorParams
 	v.PageScaleFactor = pageScaleFactor
@@ -469,7 +1164,7 @@ func (c *Emulation) SetTouchEmulationEnabled(ctx context.Context, enabled bool,
 
 type EmulationSetVirtualTimePolicyParams struct {
 	//  enum values: advance, pause, pauseIfNetworkFetchesPending
-	Policy string `json:"policy"`
+	Policy EmulationVirtualTimePolicy `json:"policy"`
 	// If set, after this many virtual milliseconds have elapsed virtual time will be paused and a virtualTimeBudgetExpired event is sent.
 	Budget float64 `json:"budget,omitempty"`
 	// If set this specifies the maximum number of tasks that can be run before virtual is forced forwards to prevent deadlock.
@@ -480,6 +1175,8 @@ type EmulationSetVirtualTimePolicyParams struct {
 
 // SetVirtualTimePolicyWithParams - Turns on virtual time for all frames (replacing real-time with a synthetic time source) and sets the current virtual time policy.  Note this supersedes any previous time budget.
 // Returns -  virtualTimeTicksBase - Absolute timestamp at which virtual time was first enabled (up time in milliseconds).
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetVirtualTimePolicyWithParams(ctx context.Context, v *EmulationSetVirtualTimePolicyParams) (float64, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setVirtualTimePolicy", Params: v})
 	if err != nil {
@@ -514,7 +1211,9 @@ func (c *Emulation) SetVirtualTimePolicyWithParams(ctx context.Context, v *Emula
 // maxVirtualTimeTaskStarvationCount - If set this specifies the maximum number of tasks that can be run before virtual is forced forwards to prevent deadlock.
 // initialVirtualTime - If set, base::Time::Now will be overridden to initially return this value.
 // Returns -  virtualTimeTicksBase - Absolute timestamp at which virtual time was first enabled (up time in milliseconds).
-func (c *Emulation) SetVirtualTimePolicy(ctx context.Context, policy string, budget float64, maxVirtualTimeTaskStarvationCount int, initialVirtualTime float64) (float64, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetVirtualTimePolicy(ctx context.Context, policy EmulationVirtualTimePolicy, budget float64, maxVirtualTimeTaskStarvationCount int, initialVirtualTime float64) (float64, error) {
 	var v EmulationSetVirtualTimePolicyParams
 	v.Policy = policy
 	v.Budget = budget
@@ -529,12 +1228,16 @@ type EmulationSetLocaleOverrideParams struct {
 }
 
 // SetLocaleOverrideWithParams - Overrides default host system locale with the specified one.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetLocaleOverrideWithParams(ctx context.Context, v *EmulationSetLocaleOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setLocaleOverride", Params: v})
 }
 
 // SetLocaleOverride - Overrides default host system locale with the specified one.
 // locale - ICU style C locale (e.g. "en_US"). If not specified or empty, disables the override and restores default host system locale.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetLocaleOverride(ctx context.Context, locale string) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetLocaleOverrideParams
 	v.Locale = locale
@@ -542,7 +1245,7 @@ func (c *Emulation) SetLocaleOverride(ctx context.Context, locale string) (*gcdm
 }
 
 type EmulationSetTimezoneOverrideParams struct {
-	// The timezone identifier. If empty, disables the override and restores default host system timezone.
+	// The timezone identifier. List of supported timezones: https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt If empty, disables the override and restores default host system timezone.
 	TimezoneId string `json:"timezoneId"`
 }
 
@@ -552,13 +1255,14 @@ func (c *Emulation) SetTimezoneOverrideWithParams(ctx context.Context, v *Emulat
 }
 
 // SetTimezoneOverride - Overrides default host system timezone with the specified one.
-// timezoneId - The timezone identifier. If empty, disables the override and restores default host system timezone.
+// timezoneId - The timezone identifier. List of supported timezones: https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt If empty, disables the override and restores default host system timezone.
 func (c *Emulation) SetTimezoneOverride(ctx context.Context, timezoneId string) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetTimezoneOverrideParams
 	v.TimezoneId = timezoneId
 	return c.SetTimezoneOverrideWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type EmulationSetVisibleSizeParams struct {
 	// Frame width (DIP).
 	Width int `json:"width"`
@@ -567,6 +1271,10 @@ type EmulationSetVisibleSizeParams struct {
 }
 
 // SetVisibleSizeWithParams - Resizes the frame/viewport of the page. Note that this does not affect the frame's container (e.g. browser window). Can be used to produce screenshots of the specified size. Not supported on Android.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetVisibleSizeWithParams(ctx context.Context, v *EmulationSetVisibleSizeParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setVisibleSize", Params: v})
 }
@@ -574,6 +1282,10 @@ func (c *Emulation) SetVisibleSizeWithParams(ctx context.Context, v *EmulationSe
 // SetVisibleSize - Resizes the frame/viewport of the page. Note that this does not affect the frame's container (e.g. browser window). Can be used to produce screenshots of the specified size. Not supported on Android.
 // width - Frame width (DIP).
 // height - Frame height (DIP).
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetVisibleSize(ctx context.Context, width int, height int) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetVisibleSizeParams
 	v.Width = width
@@ -583,34 +1295,64 @@ func (c *Emulation) SetVisibleSize(ctx context.Context, width int, height int) (
 
 type EmulationSetDisabledImageTypesParams struct {
 	// Image types to disable. enum values: avif, webp
-	ImageTypes []string `json:"imageTypes"`
+	ImageTypes []EmulationDisabledImageType `json:"imageTypes"`
 }
 
 // SetDisabledImageTypesWithParams -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetDisabledImageTypesWithParams(ctx context.Context, v *EmulationSetDisabledImageTypesParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setDisabledImageTypes", Params: v})
 }
 
 // SetDisabledImageTypes -
 // imageTypes - Image types to disable. enum values: avif, webp
-func (c *Emulation) SetDisabledImageTypes(ctx context.Context, imageTypes []string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetDisabledImageTypes(ctx context.Context, imageTypes []EmulationDisabledImageType) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetDisabledImageTypesParams
 	v.ImageTypes = imageTypes
 	return c.SetDisabledImageTypesWithParams(ctx, &v)
 }
 
+type EmulationSetDataSaverOverrideParams struct {
+	// Override value. Omitting the parameter disables the override.
+	DataSaverEnabled bool `json:"dataSaverEnabled,omitempty"`
+}
+
+// SetDataSaverOverrideWithParams - Override the value of navigator.connection.saveData
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetDataSaverOverrideWithParams(ctx context.Context, v *EmulationSetDataSaverOverrideParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setDataSaverOverride", Params: v})
+}
+
+// SetDataSaverOverride - Override the value of navigator.connection.saveData
+// dataSaverEnabled - Override value. Omitting the parameter disables the override.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetDataSaverOverride(ctx context.Context, dataSaverEnabled bool) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetDataSaverOverrideParams
+	v.DataSaverEnabled = dataSaverEnabled
+	return c.SetDataSaverOverrideWithParams(ctx, &v)
+}
+
 type EmulationSetHardwareConcurrencyOverrideParams struct {
 	// Hardware concurrency to report
 	HardwareConcurrency int `json:"hardwareConcurrency"`
 }
 
 // SetHardwareConcurrencyOverrideWithParams -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetHardwareConcurrencyOverrideWithParams(ctx context.Context, v *EmulationSetHardwareConcurrencyOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setHardwareConcurrencyOverride", Params: v})
 }
 
 // SetHardwareConcurrencyOverride -
 // hardwareConcurrency - Hardware concurrency to report
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetHardwareConcurrencyOverride(ctx context.Context, hardwareConcurrency int) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetHardwareConcurrencyOverrideParams
 	v.HardwareConcurrency = hardwareConcurrency
@@ -620,22 +1362,24 @@ func (c *Emulation) SetHardwareConcurrencyOverride(ctx context.Context, hardware
 type EmulationSetUserAgentOverrideParams struct {
 	// User agent to use.
 	UserAgent string `json:"userAgent"`
-	// Browser langugage to emulate.
+	// Browser language to emulate.
 	AcceptLanguage string `json:"acceptLanguage,omitempty"`
 	// The platform navigator.platform should return.
 	Platform string `json:"platform,omitempty"`
 	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	UserAgentMetadata *EmulationUserAgentMetadata `json:"userAgentMetadata,omitempty"`
 }
 
-// SetUserAgentOverrideWithParams - Allows overriding user agent with the given string.
+// SetUserAgentOverrideWithParams - Allows overriding user agent with the given string. `userAgentMetadata` must be set for Client Hint headers to be sent.
 func (c *Emulation) SetUserAgentOverrideWithParams(ctx context.Context, v *EmulationSetUserAgentOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setUserAgentOverride", Params: v})
 }
 
-// SetUserAgentOverride - Allows overriding user agent with the given string.
+// SetUserAgentOverride - Allows overriding user agent with the given string. `userAgentMetadata` must be set for Client Hint headers to be sent.
 // userAgent - User agent to use.
-// acceptLanguage - Browser langugage to emulate.
+// acceptLanguage - Browser language to emulate.
 // platform - The platform navigator.platform should return.
 // userAgentMetadata - To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
 func (c *Emulation) SetUserAgentOverride(ctx context.Context, userAgent string, acceptLanguage string, platform string, userAgentMetadata *EmulationUserAgentMetadata) (*gcdmessage.ChromeResponse, error) {
@@ -653,14 +1397,40 @@ type EmulationSetAutomationOverrideParams struct {
 }
 
 // SetAutomationOverrideWithParams - Allows overriding the automation flag.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetAutomationOverrideWithParams(ctx context.Context, v *EmulationSetAutomationOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setAutomationOverride", Params: v})
 }
 
 // SetAutomationOverride - Allows overriding the automation flag.
 // enabled - Whether the override should be enabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Emulation) SetAutomationOverride(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v EmulationSetAutomationOverrideParams
 	v.Enabled = enabled
 	return c.SetAutomationOverrideWithParams(ctx, &v)
 }
+
+type EmulationSetSmallViewportHeightDifferenceOverrideParams struct {
+	// This will cause an element of size 100svh to be `difference` pixels smaller than an element of size 100lvh.
+	Difference int `json:"difference"`
+}
+
+// SetSmallViewportHeightDifferenceOverrideWithParams - Allows overriding the difference between the small and large viewport sizes, which determine the value of the `svh` and `lvh` unit, respectively. Only supported for top-level frames.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetSmallViewportHeightDifferenceOverrideWithParams(ctx context.Context, v *EmulationSetSmallViewportHeightDifferenceOverrideParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Emulation.setSmallViewportHeightDifferenceOverride", Params: v})
+}
+
+// SetSmallViewportHeightDifferenceOverride - Allows overriding the difference between the small and large viewport sizes, which determine the value of the `svh` and `lvh` unit, respectively. Only supported for top-level frames.
+// difference - This will cause an element of size 100svh to be `difference` pixels smaller than an element of size 100lvh.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Emulation) SetSmallViewportHeightDifferenceOverride(ctx context.Context, difference int) (*gcdmessage.ChromeResponse, error) {
+	var v EmulationSetSmallViewportHeightDifferenceOverrideParams
+	v.Difference = difference
+	return c.SetSmallViewportHeightDifferenceOverrideWithParams(ctx, &v)
+}
@@ -0,0 +1,43 @@
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Extensions functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// Storage areas.
+type ExtensionsStorageArea string
+
+const (
+	ExtensionsStorageAreaSession ExtensionsStorageArea = "session"
+	ExtensionsStorageAreaLocal   ExtensionsStorageArea = "local"
+	ExtensionsStorageAreaSync    ExtensionsStorageArea = "sync"
+	ExtensionsStorageAreaManaged ExtensionsStorageArea = "managed"
+)
+
+// Valid returns true if the value is one of the ExtensionsStorageArea enum values.
+func (e ExtensionsStorageArea) Valid() bool {
+	switch e {
+	case ExtensionsStorageAreaSession,
+		ExtensionsStorageAreaLocal,
+		ExtensionsStorageAreaSync,
+		ExtensionsStorageAreaManaged:
+		return true
+	}
+	return false
+}
+
+// Extensions domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type Extensions struct {
+	target gcdmessage.ChromeTargeter
+}
+
+func NewExtensions(target gcdmessage.ChromeTargeter) *Extensions {
+	c := &Extensions{target: target}
+	return c
+}
@@ -5,110 +5,140 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
-// Corresponds to IdentityRequestAccount
-type FedCmAccount struct {
-	AccountId         string `json:"accountId"`                   //
-	Email             string `json:"email"`                       //
-	Name              string `json:"name"`                        //
-	GivenName         string `json:"givenName"`                   //
-	PictureUrl        string `json:"pictureUrl"`                  //
-	IdpConfigUrl      string `json:"idpConfigUrl"`                //
-	IdpSigninUrl      string `json:"idpSigninUrl"`                //
-	LoginState        string `json:"loginState"`                  //  enum values: SignIn, SignUp
-	TermsOfServiceUrl string `json:"termsOfServiceUrl,omitempty"` // These two are only set if the loginState is signUp
-	PrivacyPolicyUrl  string `json:"privacyPolicyUrl,omitempty"`  //
-}
+// Whether this is a sign-up or sign-in action for this account, i.e. whether this account has ever been used to sign in to this RP before.
+type FedCmLoginState string
 
-type FedCmDialogShownEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		DialogId string          `json:"dialogId"`           //
-		Accounts []*FedCmAccount `json:"accounts"`           //
-		Title    string          `json:"title"`              // These exist primarily so that the caller can verify the RP context was used appropriately.
-		Subtitle string          `json:"subtitle,omitempty"` //
-	} `json:"Params,omitempty"`
-}
+const (
+	FedCmLoginStateSignIn FedCmLoginState = "SignIn"
+	FedCmLoginStateSignUp FedCmLoginState = "SignUp"
+)
 
-type FedCm struct {
-	target gcdmessage.ChromeTargeter
+// Valid returns true if the value is one of the FedCmLoginState enum values.
+func (e FedCmLoginState) Valid() bool {
+	switch e {
+	case FedCmLoginStateSignIn,
+		FedCmLoginStateSignUp:
+		return true
+	}
+	return false
 }
 
-func NewFedCm(target gcdmessage.ChromeTargeter) *FedCm {
-	c := &FedCm{target: target}
-	return c
-}
+// The types of FedCM dialogs.
+type FedCmDialogType string
 
-type FedCmEnableParams struct {
-	// Allows callers to disable the promise rejection delay that would normally happen, if this is unimportant to what's being tested. (step 4 of https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)
-	DisableRejectionDelay bool `json:"disableRejectionDelay,omitempty"`
-}
+const (
+	FedCmDialogTypeAccountChooser  FedCmDialogType = "AccountChooser"
+	FedCmDialogTypeAutoReauthn     FedCmDialogType = "AutoReauthn"
+	FedCmDialogTypeConfirmIdpLogin FedCmDialogType = "ConfirmIdpLogin"
+	FedCmDialogTypeError           FedCmDialogType = "Error"
+)
 
-// EnableWithParams -
-func (c *FedCm) EnableWithParams(ctx context.Context, v *FedCmEnableParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.enable", Params: v})
+// Valid returns true if the value is one of the FedCmDialogType enum values.
+func (e FedCmDialogType) Valid() bool {
+	switch e {
+	case FedCmDialogTypeAccountChooser,
+		FedCmDialogTypeAutoReauthn,
+		FedCmDialogTypeConfirmIdpLogin,
+		FedCmDialogTypeError:
+		return true
+	}
+	return false
 }
 
-// Enable -
-// disableRejectionDelay - Allows callers to disable the promise rejection delay that would normally happen, if this is unimportant to what's being tested. (step 4 of https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)
-func (c *FedCm) Enable(ctx context.Context, disableRejectionDelay bool) (*gcdmessage.ChromeResponse, error) {
-	var v FedCmEnableParams
-	v.DisableRejectionDelay = disableRejectionDelay
-	return c.EnableWithParams(ctx, &v)
-}
+// The buttons on the FedCM dialog.
+type FedCmDialogButton string
+
+const (
+	FedCmDialogButtonConfirmIdpLoginContinue FedCmDialogButton = "ConfirmIdpLoginContinue"
+	FedCmDialogButtonErrorGotIt              FedCmDialogButton = "ErrorGotIt"
+	FedCmDialogButtonErrorMoreDetails        FedCmDialogButton = "ErrorMoreDetails"
+)
 
-func (c *FedCm) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.disable"})
+// Valid returns true if the value is one of the FedCmDialogButton enum values.
+func (e FedCmDialogButton) Valid() bool {
+	switch e {
+	case FedCmDialogButtonConfirmIdpLoginContinue,
+		FedCmDialogButtonErrorGotIt,
+		FedCmDialogButtonErrorMoreDetails:
+		return true
+	}
+	return false
 }
 
-type FedCmSelectAccountParams struct {
-	//
-	DialogId string `json:"dialogId"`
-	//
-	AccountIndex int `json:"accountIndex"`
+// The URLs that each account has
+type FedCmAccountUrlType string
+
+const (
+	FedCmAccountUrlTypeTermsOfService FedCmAccountUrlType = "TermsOfService"
+	FedCmAccountUrlTypePrivacyPolicy  FedCmAccountUrlType = "PrivacyPolicy"
+)
+
+// Valid returns true if the value is one of the FedCmAccountUrlType enum values.
+func (e FedCmAccountUrlType) Valid() bool {
+	switch e {
+	case FedCmAccountUrlTypeTermsOfService,
+		FedCmAccountUrlTypePrivacyPolicy:
+		return true
+	}
+	return false
 }
 
-// SelectAccountWithParams -
-func (c *FedCm) SelectAccountWithParams(ctx context.Context, v *FedCmSelectAccountParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.selectAccount", Params: v})
+// Corresponds to IdentityRequestAccount
+type FedCmAccount struct {
+	AccountId         string          `json:"accountId"`                   //
+	Email             string          `json:"email"`                       //
+	Name              string          `json:"name"`                        //
+	GivenName         string          `json:"givenName"`                   //
+	PictureUrl        string          `json:"pictureUrl"`                  //
+	IdpConfigUrl      string          `json:"idpConfigUrl"`                //
+	IdpLoginUrl       string          `json:"idpLoginUrl"`                 //
+	LoginState        FedCmLoginState `json:"loginState"`                  //  enum values: SignIn, SignUp
+	TermsOfServiceUrl string          `json:"termsOfServiceUrl,omitempty"` // These two are only set if the loginState is signUp
+	PrivacyPolicyUrl  string          `json:"privacyPolicyUrl,omitempty"`  //
 }
 
-// SelectAccount -
-// dialogId -
-// accountIndex -
-func (c *FedCm) SelectAccount(ctx context.Context, dialogId string, accountIndex int) (*gcdmessage.ChromeResponse, error) {
-	var v FedCmSelectAccountParams
-	v.DialogId = dialogId
-	v.AccountIndex = accountIndex
-	return c.SelectAccountWithParams(ctx, &v)
+// Event method names for the FedCm domain
+const (
+	EventFedCmDialogShown  = "FedCm.dialogShown"
+	EventFedCmDialogClosed = "FedCm.dialogClosed"
+)
+
+// FedCmEvents lists all event method names for the FedCm domain
+var FedCmEvents = []string{
+	EventFedCmDialogShown,
+	EventFedCmDialogClosed,
 }
 
-type FedCmDismissDialogParams struct {
-	//
-	DialogId string `json:"dialogId"`
-	//
-	TriggerCooldown bool `json:"triggerCooldown,omitempty"`
+type FedCmDialogShownEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		DialogId   string          `json:"dialogId"`           //
+		DialogType FedCmDialogType `json:"dialogType"`         //  enum values: AccountChooser, AutoReauthn, ConfirmIdpLogin, Error
+		Accounts   []*FedCmAccount `json:"accounts"`           //
+		Title      string          `json:"title"`              // These exist primarily so that the caller can verify the RP context was used appropriately.
+		Subtitle   string          `json:"subtitle,omitempty"` //
+	} `json:"Params,omitempty"`
 }
 
-// DismissDialogWithParams -
-func (c *FedCm) DismissDialogWithParams(ctx context.Context, v *FedCmDismissDialogParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.dismissDialog", Params: v})
+// Triggered when a dialog is closed, either by user action, JS abort, or a command below.
+type FedCmDialogClosedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		DialogId string `json:"dialogId"` //
+	} `json:"Params,omitempty"`
 }
 
-// DismissDialog -
-// dialogId -
-// triggerCooldown -
-func (c *FedCm) DismissDialog(ctx context.Context, dialogId string, triggerCooldown bool) (*gcdmessage.ChromeResponse, error) {
-	var v FedCmDismissDialogParams
-	v.DialogId = dialogId
-	v.TriggerCooldown = triggerCooldown
-	return c.DismissDialogWithParams(ctx, &v)
+// FedCm domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type FedCm struct {
+	target gcdmessage.ChromeTargeter
 }
 
-// Resets the cooldown time, if any, to allow the next FedCM call to show a dialog even if one was recently dismissed by the user.
-func (c *FedCm) ResetCooldown(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.resetCooldown"})
+func NewFedCm(target gcdmessage.ChromeTargeter) *FedCm {
+	c := &FedCm{target: target}
+	return c
 }
@@ -0,0 +1,157 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains FedCm functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnDialogShown subscribes to FedCm.dialogShown events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *FedCm) OnDialogShown(callback func(*FedCmDialogShownEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventFedCmDialogShown, func(payload []byte) error {
+		event := &FedCmDialogShownEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDialogClosed subscribes to FedCm.dialogClosed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *FedCm) OnDialogClosed(callback func(*FedCmDialogClosedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventFedCmDialogClosed, func(payload []byte) error {
+		event := &FedCmDialogClosedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type FedCmEnableParams struct {
+	// Allows callers to disable the promise rejection delay that would normally happen, if this is unimportant to what's being tested. (step 4 of https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)
+	DisableRejectionDelay bool `json:"disableRejectionDelay,omitempty"`
+}
+
+// EnableWithParams -
+func (c *FedCm) EnableWithParams(ctx context.Context, v *FedCmEnableParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.enable", Params: v})
+}
+
+// Enable -
+// disableRejectionDelay - Allows callers to disable the promise rejection delay that would normally happen, if this is unimportant to what's being tested. (step 4 of https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)
+func (c *FedCm) Enable(ctx context.Context, disableRejectionDelay bool) (*gcdmessage.ChromeResponse, error) {
+	var v FedCmEnableParams
+	v.DisableRejectionDelay = disableRejectionDelay
+	return c.EnableWithParams(ctx, &v)
+}
+
+func (c *FedCm) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.disable"})
+}
+
+type FedCmSelectAccountParams struct {
+	//
+	DialogId string `json:"dialogId"`
+	//
+	AccountIndex int `json:"accountIndex"`
+}
+
+// SelectAccountWithParams -
+func (c *FedCm) SelectAccountWithParams(ctx context.Context, v *FedCmSelectAccountParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.selectAccount", Params: v})
+}
+
+// SelectAccount -
+// dialogId -
+// accountIndex -
+func (c *FedCm) SelectAccount(ctx context.Context, dialogId string, accountIndex int) (*gcdmessage.ChromeResponse, error) {
+	var v FedCmSelectAccountParams
+	v.DialogId = dialogId
+	v.AccountIndex = accountIndex
+	return c.SelectAccountWithParams(ctx, &v)
+}
+
+type FedCmClickDialogButtonParams struct {
+	//
+	DialogId string `json:"dialogId"`
+	//  enum values: ConfirmIdpLoginContinue, ErrorGotIt, ErrorMoreDetails
+	DialogButton FedCmDialogButton `json:"dialogButton"`
+}
+
+// ClickDialogButtonWithParams -
+func (c *FedCm) ClickDialogButtonWithParams(ctx context.Context, v *FedCmClickDialogButtonParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.clickDialogButton", Params: v})
+}
+
+// ClickDialogButton -
+// dialogId -
+// dialogButton -  enum values: ConfirmIdpLoginContinue, ErrorGotIt, ErrorMoreDetails
+func (c *FedCm) ClickDialogButton(ctx context.Context, dialogId string, dialogButton FedCmDialogButton) (*gcdmessage.ChromeResponse, error) {
+	var v FedCmClickDialogButtonParams
+	v.DialogId = dialogId
+	v.DialogButton = dialogButton
+	return c.ClickDialogButtonWithParams(ctx, &v)
+}
+
+type FedCmOpenUrlParams struct {
+	//
+	DialogId string `json:"dialogId"`
+	//
+	AccountIndex int `json:"accountIndex"`
+	//  enum values: TermsOfService, PrivacyPolicy
+	AccountUrlType FedCmAccountUrlType `json:"accountUrlType"`
+}
+
+// OpenUrlWithParams -
+func (c *FedCm) OpenUrlWithParams(ctx context.Context, v *FedCmOpenUrlParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.openUrl", Params: v})
+}
+
+// OpenUrl -
+// dialogId -
+// accountIndex -
+// accountUrlType -  enum values: TermsOfService, PrivacyPolicy
+func (c *FedCm) OpenUrl(ctx context.Context, dialogId string, accountIndex int, accountUrlType FedCmAccountUrlType) (*gcdmessage.ChromeResponse, error) {
+	var v FedCmOpenUrlParams
+	v.DialogId = dialogId
+	v.AccountIndex = accountIndex
+	v.AccountUrlType = accountUrlType
+	return c.OpenUrlWithParams(ctx, &v)
+}
+
+type FedCmDismissDialogParams struct {
+	//
+	DialogId string `json:"dialogId"`
+	//
+	TriggerCooldown bool `json:"triggerCooldown,omitempty"`
+}
+
+// DismissDialogWithParams -
+func (c *FedCm) DismissDialogWithParams(ctx context.Context, v *FedCmDismissDialogParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.dismissDialog", Params: v})
+}
+
+// DismissDialog -
+// dialogId -
+// triggerCooldown -
+func (c *FedCm) DismissDialog(ctx context.Context, dialogId string, triggerCooldown bool) (*gcdmessage.ChromeResponse, error) {
+	var v FedCmDismissDialogParams
+	v.DialogId = dialogId
+	v.TriggerCooldown = triggerCooldown
+	return c.DismissDialogWithParams(ctx, &v)
+}
+
+// Resets the cooldown time, if any, to allow the next FedCM call to show a dialog even if one was recently dismissed by the user.
+func (c *FedCm) ResetCooldown(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FedCm.resetCooldown"})
+}
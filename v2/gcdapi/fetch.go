@@ -9,11 +9,67 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Stages of the request to handle. Request will intercept before the request is sent. Response will intercept after the response is received (but before response body is received).
+type FetchRequestStage string
+
+const (
+	FetchRequestStageRequest  FetchRequestStage = "Request"
+	FetchRequestStageResponse FetchRequestStage = "Response"
+)
+
+// Valid returns true if the value is one of the FetchRequestStage enum values.
+func (e FetchRequestStage) Valid() bool {
+	switch e {
+	case FetchRequestStageRequest,
+		FetchRequestStageResponse:
+		return true
+	}
+	return false
+}
+
+// Source of the authentication challenge.
+type FetchAuthChallengeSource string
+
+const (
+	FetchAuthChallengeSourceServer FetchAuthChallengeSource = "Server"
+	FetchAuthChallengeSourceProxy  FetchAuthChallengeSource = "Proxy"
+)
+
+// Valid returns true if the value is one of the FetchAuthChallengeSource enum values.
+func (e FetchAuthChallengeSource) Valid() bool {
+	switch e {
+	case FetchAuthChallengeSourceServer,
+		FetchAuthChallengeSourceProxy:
+		return true
+	}
+	return false
+}
+
+// The decision on what to do in response to the authorization challenge.  Default means deferring to the default behavior of the net stack, which will likely either the Cancel authentication or display a popup dialog box.
+type FetchAuthChallengeResponseResponse string
+
+const (
+	FetchAuthChallengeResponseResponseDefault            FetchAuthChallengeResponseResponse = "Default"
+	FetchAuthChallengeResponseResponseCancelAuth         FetchAuthChallengeResponseResponse = "CancelAuth"
+	FetchAuthChallengeResponseResponseProvideCredentials FetchAuthChallengeResponseResponse = "ProvideCredentials"
+)
+
+// Valid returns true if the value is one of the FetchAuthChallengeResponseResponse enum values.
+func (e FetchAuthChallengeResponseResponse) Valid() bool {
+	switch e {
+	case FetchAuthChallengeResponseResponseDefault,
+		FetchAuthChallengeResponseResponseCancelAuth,
+		FetchAuthChallengeResponseResponseProvideCredentials:
+		return true
+	}
+	return false
+}
+
 // No Description.
 type FetchRequestPattern struct {
-	UrlPattern   string `json:"urlPattern,omitempty"`   // Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is backslash. Omitting is equivalent to `"*"`.
-	ResourceType string `json:"resourceType,omitempty"` // If set, only requests for matching resource types will be intercepted. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
-	RequestStage string `json:"requestStage,omitempty"` // Stage at which to begin intercepting requests. Default is Request. enum values: Request, Response
+	UrlPattern   string              `json:"urlPattern,omitempty"`   // Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is backslash. Omitting is equivalent to `"*"`.
+	ResourceType NetworkResourceType `json:"resourceType,omitempty"` // If set, only requests for matching resource types will be intercepted. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
+	RequestStage FetchRequestStage   `json:"requestStage,omitempty"` // Stage at which to begin intercepting requests. Default is Request. enum values: Request, Response
 }
 
 // Response HTTP header entry
@@ -24,28 +80,40 @@ type FetchHeaderEntry struct {
 
 // Authorization challenge for HTTP status code 401 or 407.
 type FetchAuthChallenge struct {
-	Source string `json:"source,omitempty"` // Source of the authentication challenge.
-	Origin string `json:"origin"`           // Origin of the challenger.
-	Scheme string `json:"scheme"`           // The authentication scheme used, such as basic or digest
-	Realm  string `json:"realm"`            // The realm of the challenge. May be empty.
+	Source FetchAuthChallengeSource `json:"source,omitempty"` // Source of the authentication challenge.
+	Origin string                   `json:"origin"`           // Origin of the challenger.
+	Scheme string                   `json:"scheme"`           // The authentication scheme used, such as basic or digest
+	Realm  string                   `json:"realm"`            // The realm of the challenge. May be empty.
 }
 
 // Response to an AuthChallenge.
 type FetchAuthChallengeResponse struct {
-	Response string `json:"response"`           // The decision on what to do in response to the authorization challenge.  Default means deferring to the default behavior of the net stack, which will likely either the Cancel authentication or display a popup dialog box.
-	Username string `json:"username,omitempty"` // The username to provide, possibly empty. Should only be set if response is ProvideCredentials.
-	Password string `json:"password,omitempty"` // The password to provide, possibly empty. Should only be set if response is ProvideCredentials.
+	Response FetchAuthChallengeResponseResponse `json:"response"`           // The decision on what to do in response to the authorization challenge.  Default means deferring to the default behavior of the net stack, which will likely either the Cancel authentication or display a popup dialog box.
+	Username string                             `json:"username,omitempty"` // The username to provide, possibly empty. Should only be set if response is ProvideCredentials.
+	Password string                             `json:"password,omitempty"` // The password to provide, possibly empty. Should only be set if response is ProvideCredentials.
 }
 
-// Issued when the domain is enabled and the request URL matches the specified filter. The request is paused until the client responds with one of continueRequest, failRequest or fulfillRequest. The stage of the request can be determined by presence of responseErrorReason and responseStatusCode -- the request is at the response stage if either of these fields is present and in the request stage otherwise.
+// Event method names for the Fetch domain
+const (
+	EventFetchRequestPaused = "Fetch.requestPaused"
+	EventFetchAuthRequired  = "Fetch.authRequired"
+)
+
+// FetchEvents lists all event method names for the Fetch domain
+var FetchEvents = []string{
+	EventFetchRequestPaused,
+	EventFetchAuthRequired,
+}
+
+// Issued when the domain is enabled and the request URL matches the specified filter. The request is paused until the client responds with one of continueRequest, failRequest or fulfillRequest. The stage of the request can be determined by presence of responseErrorReason and responseStatusCode -- the request is at the response stage if either of these fields is present and in the request stage otherwise. Redirect responses and subsequent requests are reported similarly to regular responses and requests. Redirect responses may be distinguished by the value of `responseStatusCode` (which is one of 301, 302, 303, 307, 308) along with presence of the `location` header. Requests resulting from a redirect will have `redirectedRequestId` field set.
 type FetchRequestPausedEvent struct {
 	Method string `json:"method"`
 	Params struct {
 		RequestId           string              `json:"requestId"`                     // Each request the page makes will have a unique id.
 		Request             *NetworkRequest     `json:"request"`                       // The details of the request.
 		FrameId             string              `json:"frameId"`                       // The id of the frame that initiated the request.
-		ResourceType        string              `json:"resourceType"`                  // How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
-		ResponseErrorReason string              `json:"responseErrorReason,omitempty"` // Response error if intercepted at response stage. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse
+		ResourceType        NetworkResourceType `json:"resourceType"`                  // How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
+		ResponseErrorReason NetworkErrorReason  `json:"responseErrorReason,omitempty"` // Response error if intercepted at response stage. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse
 		ResponseStatusCode  int                 `json:"responseStatusCode,omitempty"`  // Response code if intercepted at response stage.
 		ResponseStatusText  string              `json:"responseStatusText,omitempty"`  // Response status text if intercepted at response stage.
 		ResponseHeaders     []*FetchHeaderEntry `json:"responseHeaders,omitempty"`     // Response headers if intercepted at the response stage.
@@ -61,7 +129,7 @@ type FetchAuthRequiredEvent struct {
 		RequestId     string              `json:"requestId"`     // Each request the page makes will have a unique id.
 		Request       *NetworkRequest     `json:"request"`       // The details of the request.
 		FrameId       string              `json:"frameId"`       // The id of the frame that initiated the request.
-		ResourceType  string              `json:"resourceType"`  // How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
+		ResourceType  NetworkResourceType `json:"resourceType"`  // How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
 		AuthChallenge *FetchAuthChallenge `json:"authChallenge"` // Details of the Authorization Challenge encountered. If this is set, client should respond with continueRequest that contains AuthChallengeResponse.
 	} `json:"Params,omitempty"`
 }
@@ -75,6 +143,32 @@ func NewFetch(target gcdmessage.ChromeTargeter) *Fetch {
 	return c
 }
 
+// OnRequestPaused subscribes to Fetch.requestPaused events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Fetch) OnRequestPaused(callback func(*FetchRequestPausedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventFetchRequestPaused, func(payload []byte) error {
+		event := &FetchRequestPausedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAuthRequired subscribes to Fetch.authRequired events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Fetch) OnAuthRequired(callback func(*FetchAuthRequiredEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventFetchAuthRequired, func(payload []byte) error {
+		event := &FetchAuthRequiredEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 // Disables the fetch domain.
 func (c *Fetch) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Fetch.disable"})
@@ -106,7 +200,7 @@ type FetchFailRequestParams struct {
 	// An id the client received in requestPaused event.
 	RequestId string `json:"requestId"`
 	// Causes the request to fail with the given reason. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse
-	ErrorReason string `json:"errorReason"`
+	ErrorReason NetworkErrorReason `json:"errorReason"`
 }
 
 // FailRequestWithParams - Causes the request to fail with specified reason.
@@ -117,7 +211,7 @@ func (c *Fetch) FailRequestWithParams(ctx context.Context, v *FetchFailRequestPa
 // FailRequest - Causes the request to fail with specified reason.
 // requestId - An id the client received in requestPaused event.
 // errorReason - Causes the request to fail with the given reason. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse
-func (c *Fetch) FailRequest(ctx context.Context, requestId string, errorReason string) (*gcdmessage.ChromeResponse, error) {
+func (c *Fetch) FailRequest(ctx context.Context, requestId string, errorReason NetworkErrorReason) (*gcdmessage.ChromeResponse, error) {
 	var v FetchFailRequestParams
 	v.RequestId = requestId
 	v.ErrorReason = errorReason
@@ -174,6 +268,8 @@ type FetchContinueRequestParams struct {
 	// If set, overrides the request headers. Note that the overrides do not extend to subsequent redirect hops, if a redirect happens. Another override may be applied to a different request produced by a redirect.
 	Headers []*FetchHeaderEntry `json:"headers,omitempty"`
 	// If set, overrides response interception behavior for this request.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	InterceptResponse bool `json:"interceptResponse,omitempty"`
 }
 
@@ -236,6 +332,8 @@ type FetchContinueResponseParams struct {
 }
 
 // ContinueResponseWithParams - Continues loading of the paused response, optionally modifying the response headers. If either responseCode or headers are modified, all of them must be present.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Fetch) ContinueResponseWithParams(ctx context.Context, v *FetchContinueResponseParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Fetch.continueResponse", Params: v})
 }
@@ -246,6 +344,8 @@ func (c *Fetch) ContinueResponseWithParams(ctx context.Context, v *FetchContinue
 // responsePhrase - A textual representation of responseCode. If absent, a standard phrase matching responseCode is used.
 // responseHeaders - Response headers. If absent, original response headers will be used.
 // binaryResponseHeaders - Alternative way of specifying response headers as a \0-separated series of name: value pairs. Prefer the above method unless you need to represent some non-UTF8 values that can't be transmitted over the protocol as text. (Encoded as a base64 string when passed over JSON)
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Fetch) ContinueResponse(ctx context.Context, requestId string, responseCode int, responsePhrase string, responseHeaders []*FetchHeaderEntry, binaryResponseHeaders string) (*gcdmessage.ChromeResponse, error) {
 	var v FetchContinueResponseParams
 	v.RequestId = requestId
@@ -261,7 +361,7 @@ type FetchGetResponseBodyParams struct {
 	RequestId string `json:"requestId"`
 }
 
-// GetResponseBodyWithParams - Causes the body of the response to be received from the server and returned as a single string. May only be issued for a request that is paused in the Response stage and is mutually exclusive with takeResponseBodyForInterceptionAsStream. Calling other methods that affect the request or disabling fetch domain before body is received results in an undefined behavior.
+// GetResponseBodyWithParams - Causes the body of the response to be received from the server and returned as a single string. May only be issued for a request that is paused in the Response stage and is mutually exclusive with takeResponseBodyForInterceptionAsStream. Calling other methods that affect the request or disabling fetch domain before body is received results in an undefined behavior. Note that the response body is not available for redirects. Requests paused in the _redirect received_ state may be differentiated by `responseCode` and presence of `location` response header, see comments to `requestPaused` for details.
 // Returns -  body - Response body. base64Encoded - True, if content was sent as base64.
 func (c *Fetch) GetResponseBodyWithParams(ctx context.Context, v *FetchGetResponseBodyParams) (string, bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Fetch.getResponseBody", Params: v})
@@ -292,7 +392,7 @@ func (c *Fetch) GetResponseBodyWithParams(ctx context.Context, v *FetchGetRespon
 	return chromeData.Result.Body, chromeData.Result.Base64Encoded, nil
 }
 
-// GetResponseBody - Causes the body of the response to be received from the server and returned as a single string. May only be issued for a request that is paused in the Response stage and is mutually exclusive with takeResponseBodyForInterceptionAsStream. Calling other methods that affect the request or disabling fetch domain before body is received results in an undefined behavior.
+// GetResponseBody - Causes the body of the response to be received from the server and returned as a single string. May only be issued for a request that is paused in the Response stage and is mutually exclusive with takeResponseBodyForInterceptionAsStream. Calling other methods that affect the request or disabling fetch domain before body is received results in an undefined behavior. Note that the response body is not available for redirects. Requests paused in the _redirect received_ state may be differentiated by `responseCode` and presence of `location` response header, see comments to `requestPaused` for details.
 // requestId - Identifier for the intercepted request to get body for.
 // Returns -  body - Response body. base64Encoded - True, if content was sent as base64.
 func (c *Fetch) GetResponseBody(ctx context.Context, requestId string) (string, bool, error) {
@@ -0,0 +1,43 @@
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains FileSystem functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// No Description.
+type FileSystemFile struct {
+	Name         string  `json:"name"`         //
+	LastModified float64 `json:"lastModified"` // Timestamp
+	Size         float64 `json:"size"`         // Size in bytes
+	Type         string  `json:"type"`         //
+}
+
+// No Description.
+type FileSystemDirectory struct {
+	Name              string            `json:"name"`              //
+	NestedDirectories []string          `json:"nestedDirectories"` //
+	NestedFiles       []*FileSystemFile `json:"nestedFiles"`       // Files that are directly nested under this directory.
+}
+
+// No Description.
+type FileSystemBucketFileSystemLocator struct {
+	StorageKey     string   `json:"storageKey"`           // Storage key
+	BucketName     string   `json:"bucketName,omitempty"` // Bucket name. Not passing a `bucketName` will retrieve the default Bucket. (https://developer.mozilla.org/en-US/docs/Web/API/Storage_API#storage_buckets)
+	PathComponents []string `json:"pathComponents"`       // Path to the directory using each path component as an array item.
+}
+
+// FileSystem domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type FileSystem struct {
+	target gcdmessage.ChromeTargeter
+}
+
+func NewFileSystem(target gcdmessage.ChromeTargeter) *FileSystem {
+	c := &FileSystem{target: target}
+	return c
+}
@@ -0,0 +1,56 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains FileSystem functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+type FileSystemGetDirectoryParams struct {
+	//
+	BucketFileSystemLocator *FileSystemBucketFileSystemLocator `json:"bucketFileSystemLocator"`
+}
+
+// GetDirectoryWithParams -
+// Returns -  directory - Returns the directory object at the path.
+func (c *FileSystem) GetDirectoryWithParams(ctx context.Context, v *FileSystemGetDirectoryParams) (*FileSystemDirectory, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "FileSystem.getDirectory", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Directory *FileSystemDirectory
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Directory, nil
+}
+
+// GetDirectory -
+// bucketFileSystemLocator -
+// Returns -  directory - Returns the directory object at the path.
+func (c *FileSystem) GetDirectory(ctx context.Context, bucketFileSystemLocator *FileSystemBucketFileSystemLocator) (*FileSystemDirectory, error) {
+	var v FileSystemGetDirectoryParams
+	v.BucketFileSystemLocator = bucketFileSystemLocator
+	return c.GetDirectoryWithParams(ctx, &v)
+}
@@ -9,6 +9,182 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type InputGestureSourceType string
+
+const (
+	InputGestureSourceTypeDefault InputGestureSourceType = "default"
+	InputGestureSourceTypeTouch   InputGestureSourceType = "touch"
+	InputGestureSourceTypeMouse   InputGestureSourceType = "mouse"
+)
+
+// Valid returns true if the value is one of the InputGestureSourceType enum values.
+func (e InputGestureSourceType) Valid() bool {
+	switch e {
+	case InputGestureSourceTypeDefault,
+		InputGestureSourceTypeTouch,
+		InputGestureSourceTypeMouse:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type InputMouseButton string
+
+const (
+	InputMouseButtonNone    InputMouseButton = "none"
+	InputMouseButtonLeft    InputMouseButton = "left"
+	InputMouseButtonMiddle  InputMouseButton = "middle"
+	InputMouseButtonRight   InputMouseButton = "right"
+	InputMouseButtonBack    InputMouseButton = "back"
+	InputMouseButtonForward InputMouseButton = "forward"
+)
+
+// Valid returns true if the value is one of the InputMouseButton enum values.
+func (e InputMouseButton) Valid() bool {
+	switch e {
+	case InputMouseButtonNone,
+		InputMouseButtonLeft,
+		InputMouseButtonMiddle,
+		InputMouseButtonRight,
+		InputMouseButtonBack,
+		InputMouseButtonForward:
+		return true
+	}
+	return false
+}
+
+// Type of the drag event.
+type InputDispatchDragEventType string
+
+const (
+	InputDispatchDragEventTypeDragEnter  InputDispatchDragEventType = "dragEnter"
+	InputDispatchDragEventTypeDragOver   InputDispatchDragEventType = "dragOver"
+	InputDispatchDragEventTypeDrop       InputDispatchDragEventType = "drop"
+	InputDispatchDragEventTypeDragCancel InputDispatchDragEventType = "dragCancel"
+)
+
+// Valid returns true if the value is one of the InputDispatchDragEventType enum values.
+func (e InputDispatchDragEventType) Valid() bool {
+	switch e {
+	case InputDispatchDragEventTypeDragEnter,
+		InputDispatchDragEventTypeDragOver,
+		InputDispatchDragEventTypeDrop,
+		InputDispatchDragEventTypeDragCancel:
+		return true
+	}
+	return false
+}
+
+// Type of the key event.
+type InputDispatchKeyEventType string
+
+const (
+	InputDispatchKeyEventTypeKeyDown    InputDispatchKeyEventType = "keyDown"
+	InputDispatchKeyEventTypeKeyUp      InputDispatchKeyEventType = "keyUp"
+	InputDispatchKeyEventTypeRawKeyDown InputDispatchKeyEventType = "rawKeyDown"
+	InputDispatchKeyEventTypeChar       InputDispatchKeyEventType = "char"
+)
+
+// Valid returns true if the value is one of the InputDispatchKeyEventType enum values.
+func (e InputDispatchKeyEventType) Valid() bool {
+	switch e {
+	case InputDispatchKeyEventTypeKeyDown,
+		InputDispatchKeyEventTypeKeyUp,
+		InputDispatchKeyEventTypeRawKeyDown,
+		InputDispatchKeyEventTypeChar:
+		return true
+	}
+	return false
+}
+
+// Type of the mouse event.
+type InputDispatchMouseEventType string
+
+const (
+	InputDispatchMouseEventTypeMousePressed  InputDispatchMouseEventType = "mousePressed"
+	InputDispatchMouseEventTypeMouseReleased InputDispatchMouseEventType = "mouseReleased"
+	InputDispatchMouseEventTypeMouseMoved    InputDispatchMouseEventType = "mouseMoved"
+	InputDispatchMouseEventTypeMouseWheel    InputDispatchMouseEventType = "mouseWheel"
+)
+
+// Valid returns true if the value is one of the InputDispatchMouseEventType enum values.
+func (e InputDispatchMouseEventType) Valid() bool {
+	switch e {
+	case InputDispatchMouseEventTypeMousePressed,
+		InputDispatchMouseEventTypeMouseReleased,
+		InputDispatchMouseEventTypeMouseMoved,
+		InputDispatchMouseEventTypeMouseWheel:
+		return true
+	}
+	return false
+}
+
+// Pointer type (default: "mouse").
+type InputDispatchMouseEventPointerType string
+
+const (
+	InputDispatchMouseEventPointerTypeMouse InputDispatchMouseEventPointerType = "mouse"
+	InputDispatchMouseEventPointerTypePen   InputDispatchMouseEventPointerType = "pen"
+)
+
+// Valid returns true if the value is one of the InputDispatchMouseEventPointerType enum values.
+func (e InputDispatchMouseEventPointerType) Valid() bool {
+	switch e {
+	case InputDispatchMouseEventPointerTypeMouse,
+		InputDispatchMouseEventPointerTypePen:
+		return true
+	}
+	return false
+}
+
+// Type of the touch event. TouchEnd and TouchCancel must not contain any touch points, while TouchStart and TouchMove must contains at least one.
+type InputDispatchTouchEventType string
+
+const (
+	InputDispatchTouchEventTypeTouchStart  InputDispatchTouchEventType = "touchStart"
+	InputDispatchTouchEventTypeTouchEnd    InputDispatchTouchEventType = "touchEnd"
+	InputDispatchTouchEventTypeTouchMove   InputDispatchTouchEventType = "touchMove"
+	InputDispatchTouchEventTypeTouchCancel InputDispatchTouchEventType = "touchCancel"
+)
+
+// Valid returns true if the value is one of the InputDispatchTouchEventType enum values.
+func (e InputDispatchTouchEventType) Valid() bool {
+	switch e {
+	case InputDispatchTouchEventTypeTouchStart,
+		InputDispatchTouchEventTypeTouchEnd,
+		InputDispatchTouchEventTypeTouchMove,
+		InputDispatchTouchEventTypeTouchCancel:
+		return true
+	}
+	return false
+}
+
+// Type of the mouse event.
+type InputEmulateTouchFromMouseEventType string
+
+const (
+	InputEmulateTouchFromMouseEventTypeMousePressed  InputEmulateTouchFromMouseEventType = "mousePressed"
+	InputEmulateTouchFromMouseEventTypeMouseReleased InputEmulateTouchFromMouseEventType = "mouseReleased"
+	InputEmulateTouchFromMouseEventTypeMouseMoved    InputEmulateTouchFromMouseEventType = "mouseMoved"
+	InputEmulateTouchFromMouseEventTypeMouseWheel    InputEmulateTouchFromMouseEventType = "mouseWheel"
+)
+
+// Valid returns true if the value is one of the InputEmulateTouchFromMouseEventType enum values.
+func (e InputEmulateTouchFromMouseEventType) Valid() bool {
+	switch e {
+	case InputEmulateTouchFromMouseEventTypeMousePressed,
+		InputEmulateTouchFromMouseEventTypeMouseReleased,
+		InputEmulateTouchFromMouseEventTypeMouseMoved,
+		InputEmulateTouchFromMouseEventTypeMouseWheel:
+		return true
+	}
+	return false
+}
+
 // No Description.
 type InputTouchPoint struct {
 	X                  float64 `json:"x"`                            // X coordinate of the event relative to the main frame's viewport in CSS pixels.
@@ -18,13 +194,15 @@ type InputTouchPoint struct {
 	RotationAngle      float64 `json:"rotationAngle,omitempty"`      // Rotation angle (default: 0.0).
 	Force              float64 `json:"force,omitempty"`              // Force (default: 1.0).
 	TangentialPressure float64 `json:"tangentialPressure,omitempty"` // The normalized tangential pressure, which has a range of [-1,1] (default: 0).
-	TiltX              int     `json:"tiltX,omitempty"`              // The plane angle between the Y-Z plane and the plane containing both the stylus axis and the Y axis, in degrees of the range [-90,90], a positive tiltX is to the right (default: 0)
-	TiltY              int     `json:"tiltY,omitempty"`              // The plane angle between the X-Z plane and the plane containing both the stylus axis and the X axis, in degrees of the range [-90,90], a positive tiltY is towards the user (default: 0).
+	TiltX              float64 `json:"tiltX,omitempty"`              // The plane angle between the Y-Z plane and the plane containing both the stylus axis and the Y axis, in degrees of the range [-90,90], a positive tiltX is to the right (default: 0)
+	TiltY              float64 `json:"tiltY,omitempty"`              // The plane angle between the X-Z plane and the plane containing both the stylus axis and the X axis, in degrees of the range [-90,90], a positive tiltY is towards the user (default: 0).
 	Twist              int     `json:"twist,omitempty"`              // The clockwise rotation of a pen stylus around its own major axis, in degrees in the range [0,359] (default: 0).
 	Id                 float64 `json:"id,omitempty"`                 // Identifier used to track touch sources between events, must be unique within an event.
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type InputDragDataItem struct {
 	MimeType string `json:"mimeType"`          // Mime type of the dragged data.
 	Data     string `json:"data"`              // Depending of the value of `mimeType`, it contains the dragged link, text, HTML markup or any other data.
@@ -33,13 +211,27 @@ type InputDragDataItem struct {
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type InputDragData struct {
 	Items              []*InputDragDataItem `json:"items"`              //
 	Files              []string             `json:"files,omitempty"`    // List of filenames that should be included when dropping
 	DragOperationsMask int                  `json:"dragOperationsMask"` // Bit field representing allowed drag operations. Copy = 1, Link = 2, Move = 16
 }
 
+// Event method names for the Input domain
+const (
+	EventInputDragIntercepted = "Input.dragIntercepted"
+)
+
+// InputEvents lists all event method names for the Input domain
+var InputEvents = []string{
+	EventInputDragIntercepted,
+}
+
 // Emitted only when `Input.setInterceptDrags` is enabled. Use this data with `Input.dispatchDragEvent` to restore normal drag and drop behavior.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type InputDragInterceptedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -56,9 +248,24 @@ func NewInput(target gcdmessage.ChromeTargeter) *Input {
 	return c
 }
 
+// OnDragIntercepted subscribes to Input.dragIntercepted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Input) OnDragIntercepted(callback func(*InputDragInterceptedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventInputDragIntercepted, func(payload []byte) error {
+		event := &InputDragInterceptedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 type InputDispatchDragEventParams struct {
 	// Type of the drag event.
-	TheType string `json:"type"`
+	TheType InputDispatchDragEventType `json:"type"`
 	// X coordinate of the event relative to the main frame's viewport in CSS pixels.
 	X float64 `json:"x"`
 	// Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.
@@ -70,6 +277,8 @@ type InputDispatchDragEventParams struct {
 }
 
 // DispatchDragEventWithParams - Dispatches a drag event into the page.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) DispatchDragEventWithParams(ctx context.Context, v *InputDispatchDragEventParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.dispatchDragEvent", Params: v})
 }
@@ -80,7 +289,9 @@ func (c *Input) DispatchDragEventWithParams(ctx context.Context, v *InputDispatc
 // y - Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.
 // data -
 // modifiers - Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).
-func (c *Input) DispatchDragEvent(ctx context.Context, theType string, x float64, y float64, data *InputDragData, modifiers int) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Input) DispatchDragEvent(ctx context.Context, theType InputDispatchDragEventType, x float64, y float64, data *InputDragData, modifiers int) (*gcdmessage.ChromeResponse, error) {
 	var v InputDispatchDragEventParams
 	v.TheType = theType
 	v.X = x
@@ -92,7 +303,7 @@ func (c *Input) DispatchDragEvent(ctx context.Context, theType string, x float64
 
 type InputDispatchKeyEventParams struct {
 	// Type of the key event.
-	TheType string `json:"type"`
+	TheType InputDispatchKeyEventType `json:"type"`
 	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).
 	Modifiers int `json:"modifiers,omitempty"`
 	// Time at which the event occurred.
@@ -120,6 +331,8 @@ type InputDispatchKeyEventParams struct {
 	// Whether the event was from the left or right side of the keyboard. 1=Left, 2=Right (default: 0).
 	Location int `json:"location,omitempty"`
 	// Editing commands to send with the key event (e.g., 'selectAll') (default: []). These are related to but not equal the command names used in `document.execCommand` and NSStandardKeyBindingResponding. See https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/editing/commands/editor_command_names.h for valid command names.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Commands []string `json:"commands,omitempty"`
 }
 
@@ -144,7 +357,7 @@ func (c *Input) DispatchKeyEventWithParams(ctx context.Context, v *InputDispatch
 // isSystemKey - Whether the event was a system key event (default: false).
 // location - Whether the event was from the left or right side of the keyboard. 1=Left, 2=Right (default: 0).
 // commands - Editing commands to send with the key event (e.g., 'selectAll') (default: []). These are related to but not equal the command names used in `document.execCommand` and NSStandardKeyBindingResponding. See https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/editing/commands/editor_command_names.h for valid command names.
-func (c *Input) DispatchKeyEvent(ctx context.Context, theType string, modifiers int, timestamp float64, text string, unmodifiedText string, keyIdentifier string, code string, key string, windowsVirtualKeyCode int, nativeVirtualKeyCode int, autoRepeat bool, isKeypad bool, isSystemKey bool, location int, commands []string) (*gcdmessage.ChromeResponse, error) {
+func (c *Input) DispatchKeyEvent(ctx context.Context, theType InputDispatchKeyEventType, modifiers int, timestamp float64, text string, unmodifiedText string, keyIdentifier string, code string, key string, windowsVirtualKeyCode int, nativeVirtualKeyCode int, autoRepeat bool, isKeypad bool, isSystemKey bool, location int, commands []string) (*gcdmessage.ChromeResponse, error) {
 	var v InputDispatchKeyEventParams
 	v.TheType = theType
 	v.Modifiers = modifiers
@@ -170,12 +383,16 @@ type InputInsertTextParams struct {
 }
 
 // InsertTextWithParams - This method emulates inserting text that doesn't come from a key press, for example an emoji keyboard or an IME.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) InsertTextWithParams(ctx context.Context, v *InputInsertTextParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.insertText", Params: v})
 }
 
 // InsertText - This method emulates inserting text that doesn't come from a key press, for example an emoji keyboard or an IME.
 // text - The text to insert.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) InsertText(ctx context.Context, text string) (*gcdmessage.ChromeResponse, error) {
 	var v InputInsertTextParams
 	v.Text = text
@@ -195,17 +412,21 @@ type InputImeSetCompositionParams struct {
 	ReplacementEnd int `json:"replacementEnd,omitempty"`
 }
 
-// ImeSetCompositionWithParams - This method sets the current candidate text for ime. Use imeCommitComposition to commit the final text. Use imeSetComposition with empty string as text to cancel composition.
+// ImeSetCompositionWithParams - This method sets the current candidate text for IME. Use imeCommitComposition to commit the final text. Use imeSetComposition with empty string as text to cancel composition.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) ImeSetCompositionWithParams(ctx context.Context, v *InputImeSetCompositionParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.imeSetComposition", Params: v})
 }
 
-// ImeSetComposition - This method sets the current candidate text for ime. Use imeCommitComposition to commit the final text. Use imeSetComposition with empty string as text to cancel composition.
+// ImeSetComposition - This method sets the current candidate text for IME. Use imeCommitComposition to commit the final text. Use imeSetComposition with empty string as text to cancel composition.
 // text - The text to insert
 // selectionStart - selection start
 // selectionEnd - selection end
 // replacementStart - replacement start
 // replacementEnd - replacement end
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) ImeSetComposition(ctx context.Context, text string, selectionStart int, selectionEnd int, replacementStart int, replacementEnd int) (*gcdmessage.ChromeResponse, error) {
 	var v InputImeSetCompositionParams
 	v.Text = text
@@ -218,7 +439,7 @@ func (c *Input) ImeSetComposition(ctx context.Context, text string, selectionSta
 
 type InputDispatchMouseEventParams struct {
 	// Type of the mouse event.
-	TheType string `json:"type"`
+	TheType InputDispatchMouseEventType `json:"type"`
 	// X coordinate of the event relative to the main frame's viewport in CSS pixels.
 	X float64 `json:"x"`
 	// Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.
@@ -228,27 +449,33 @@ type InputDispatchMouseEventParams struct {
 	// Time at which the event occurred.
 	Timestamp float64 `json:"timestamp,omitempty"`
 	// Mouse button (default: "none"). enum values: none, left, middle, right, back, forward
-	Button string `json:"button,omitempty"`
+	Button InputMouseButton `json:"button,omitempty"`
 	// A number indicating which buttons are pressed on the mouse when a mouse event is triggered. Left=1, Right=2, Middle=4, Back=8, Forward=16, None=0.
 	Buttons int `json:"buttons,omitempty"`
 	// Number of times the mouse button was clicked (default: 0).
 	ClickCount int `json:"clickCount,omitempty"`
 	// The normalized pressure, which has a range of [0,1] (default: 0).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Force float64 `json:"force,omitempty"`
 	// The normalized tangential pressure, which has a range of [-1,1] (default: 0).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	TangentialPressure float64 `json:"tangentialPressure,omitempty"`
 	// The plane angle between the Y-Z plane and the plane containing both the stylus axis and the Y axis, in degrees of the range [-90,90], a positive tiltX is to the right (default: 0).
-	TiltX int `json:"tiltX,omitempty"`
+	TiltX float64 `json:"tiltX,omitempty"`
 	// The plane angle between the X-Z plane and the plane containing both the stylus axis and the X axis, in degrees of the range [-90,90], a positive tiltY is towards the user (default: 0).
-	TiltY int `json:"tiltY,omitempty"`
+	TiltY float64 `json:"tiltY,omitempty"`
 	// The clockwise rotation of a pen stylus around its own major axis, in degrees in the range [0,359] (default: 0).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Twist int `json:"twist,omitempty"`
 	// X delta in CSS pixels for mouse wheel event (default: 0).
 	DeltaX float64 `json:"deltaX,omitempty"`
 	// Y delta in CSS pixels for mouse wheel event (default: 0).
 	DeltaY float64 `json:"deltaY,omitempty"`
 	// Pointer type (default: "mouse").
-	PointerType string `json:"pointerType,omitempty"`
+	PointerType InputDispatchMouseEventPointerType `json:"pointerType,omitempty"`
 }
 
 // DispatchMouseEventWithParams - Dispatches a mouse event to the page.
@@ -273,7 +500,7 @@ func (c *Input) DispatchMouseEventWithParams(ctx context.Context, v *InputDispat
 // deltaX - X delta in CSS pixels for mouse wheel event (default: 0).
 // deltaY - Y delta in CSS pixels for mouse wheel event (default: 0).
 // pointerType - Pointer type (default: "mouse").
-func (c *Input) DispatchMouseEvent(ctx context.Context, theType string, x float64, y float64, modifiers int, timestamp float64, button string, buttons int, clickCount int, force float64, tangentialPressure float64, tiltX int, tiltY int, twist int, deltaX float64, deltaY float64, pointerType string) (*gcdmessage.ChromeResponse, error) {
+func (c *Input) DispatchMouseEvent(ctx context.Context, theType InputDispatchMouseEventType, x float64, y float64, modifiers int, timestamp float64, button InputMouseButton, buttons int, clickCount int, force float64, tangentialPressure float64, tiltX float64, tiltY float64, twist int, deltaX float64, deltaY float64, pointerType InputDispatchMouseEventPointerType) (*gcdmessage.ChromeResponse, error) {
 	var v InputDispatchMouseEventParams
 	v.TheType = theType
 	v.X = x
@@ -296,7 +523,7 @@ func (c *Input) DispatchMouseEvent(ctx context.Context, theType string, x float6
 
 type InputDispatchTouchEventParams struct {
 	// Type of the touch event. TouchEnd and TouchCancel must not contain any touch points, while TouchStart and TouchMove must contains at least one.
-	TheType string `json:"type"`
+	TheType InputDispatchTouchEventType `json:"type"`
 	// Active touch points on the touch device. One event per any changed point (compared to previous touch event in a sequence) is generated, emulating pressing/moving/releasing points one by one.
 	TouchPoints []*InputTouchPoint `json:"touchPoints"`
 	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).
@@ -315,7 +542,7 @@ func (c *Input) DispatchTouchEventWithParams(ctx context.Context, v *InputDispat
 // touchPoints - Active touch points on the touch device. One event per any changed point (compared to previous touch event in a sequence) is generated, emulating pressing/moving/releasing points one by one.
 // modifiers - Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).
 // timestamp - Time at which the event occurred.
-func (c *Input) DispatchTouchEvent(ctx context.Context, theType string, touchPoints []*InputTouchPoint, modifiers int, timestamp float64) (*gcdmessage.ChromeResponse, error) {
+func (c *Input) DispatchTouchEvent(ctx context.Context, theType InputDispatchTouchEventType, touchPoints []*InputTouchPoint, modifiers int, timestamp float64) (*gcdmessage.ChromeResponse, error) {
 	var v InputDispatchTouchEventParams
 	v.TheType = theType
 	v.TouchPoints = touchPoints
@@ -324,15 +551,20 @@ func (c *Input) DispatchTouchEvent(ctx context.Context, theType string, touchPoi
 	return c.DispatchTouchEventWithParams(ctx, &v)
 }
 
+// Cancels any active dragging in the page.
+func (c *Input) CancelDragging(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.cancelDragging"})
+}
+
 type InputEmulateTouchFromMouseEventParams struct {
 	// Type of the mouse event.
-	TheType string `json:"type"`
+	TheType InputEmulateTouchFromMouseEventType `json:"type"`
 	// X coordinate of the mouse pointer in DIP.
 	X int `json:"x"`
 	// Y coordinate of the mouse pointer in DIP.
 	Y int `json:"y"`
 	// Mouse button. Only "none", "left", "right" are supported. enum values: none, left, middle, right, back, forward
-	Button string `json:"button"`
+	Button InputMouseButton `json:"button"`
 	// Time at which the event occurred (default: current time).
 	Timestamp float64 `json:"timestamp,omitempty"`
 	// X delta in DIP for mouse wheel event (default: 0).
@@ -346,6 +578,8 @@ type InputEmulateTouchFromMouseEventParams struct {
 }
 
 // EmulateTouchFromMouseEventWithParams - Emulates touch event from the mouse event parameters.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) EmulateTouchFromMouseEventWithParams(ctx context.Context, v *InputEmulateTouchFromMouseEventParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.emulateTouchFromMouseEvent", Params: v})
 }
@@ -360,7 +594,9 @@ func (c *Input) EmulateTouchFromMouseEventWithParams(ctx context.Context, v *Inp
 // deltaY - Y delta in DIP for mouse wheel event (default: 0).
 // modifiers - Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).
 // clickCount - Number of times the mouse button was clicked (default: 0).
-func (c *Input) EmulateTouchFromMouseEvent(ctx context.Context, theType string, x int, y int, button string, timestamp float64, deltaX float64, deltaY float64, modifiers int, clickCount int) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Input) EmulateTouchFromMouseEvent(ctx context.Context, theType InputEmulateTouchFromMouseEventType, x int, y int, button InputMouseButton, timestamp float64, deltaX float64, deltaY float64, modifiers int, clickCount int) (*gcdmessage.ChromeResponse, error) {
 	var v InputEmulateTouchFromMouseEventParams
 	v.TheType = theType
 	v.X = x
@@ -398,12 +634,16 @@ type InputSetInterceptDragsParams struct {
 }
 
 // SetInterceptDragsWithParams - Prevents default drag and drop behavior and instead emits `Input.dragIntercepted` events. Drag and drop behavior can be directly controlled via `Input.dispatchDragEvent`.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) SetInterceptDragsWithParams(ctx context.Context, v *InputSetInterceptDragsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.setInterceptDrags", Params: v})
 }
 
 // SetInterceptDrags - Prevents default drag and drop behavior and instead emits `Input.dragIntercepted` events. Drag and drop behavior can be directly controlled via `Input.dispatchDragEvent`.
 // enabled -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) SetInterceptDrags(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v InputSetInterceptDragsParams
 	v.Enabled = enabled
@@ -420,10 +660,12 @@ type InputSynthesizePinchGestureParams struct {
 	// Relative pointer speed in pixels per second (default: 800).
 	RelativeSpeed int `json:"relativeSpeed,omitempty"`
 	// Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). enum values: default, touch, mouse
-	GestureSourceType string `json:"gestureSourceType,omitempty"`
+	GestureSourceType InputGestureSourceType `json:"gestureSourceType,omitempty"`
 }
 
 // SynthesizePinchGestureWithParams - Synthesizes a pinch gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) SynthesizePinchGestureWithParams(ctx context.Context, v *InputSynthesizePinchGestureParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.synthesizePinchGesture", Params: v})
 }
@@ -434,7 +676,9 @@ func (c *Input) SynthesizePinchGestureWithParams(ctx context.Context, v *InputSy
 // scaleFactor - Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out).
 // relativeSpeed - Relative pointer speed in pixels per second (default: 800).
 // gestureSourceType - Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). enum values: default, touch, mouse
-func (c *Input) SynthesizePinchGesture(ctx context.Context, x float64, y float64, scaleFactor float64, relativeSpeed int, gestureSourceType string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Input) SynthesizePinchGesture(ctx context.Context, x float64, y float64, scaleFactor float64, relativeSpeed int, gestureSourceType InputGestureSourceType) (*gcdmessage.ChromeResponse, error) {
 	var v InputSynthesizePinchGestureParams
 	v.X = x
 	v.Y = y
@@ -462,7 +706,7 @@ type InputSynthesizeScrollGestureParams struct {
 	// Swipe speed in pixels per second (default: 800).
 	Speed int `json:"speed,omitempty"`
 	// Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). enum values: default, touch, mouse
-	GestureSourceType string `json:"gestureSourceType,omitempty"`
+	GestureSourceType InputGestureSourceType `json:"gestureSourceType,omitempty"`
 	// The number of times to repeat the gesture (default: 0).
 	RepeatCount int `json:"repeatCount,omitempty"`
 	// The number of milliseconds delay between each repeat. (default: 250).
@@ -472,6 +716,8 @@ type InputSynthesizeScrollGestureParams struct {
 }
 
 // SynthesizeScrollGestureWithParams - Synthesizes a scroll gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) SynthesizeScrollGestureWithParams(ctx context.Context, v *InputSynthesizeScrollGestureParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.synthesizeScrollGesture", Params: v})
 }
@@ -489,7 +735,9 @@ func (c *Input) SynthesizeScrollGestureWithParams(ctx context.Context, v *InputS
 // repeatCount - The number of times to repeat the gesture (default: 0).
 // repeatDelayMs - The number of milliseconds delay between each repeat. (default: 250).
 // interactionMarkerName - The name of the interaction markers to generate, if not empty (default: "").
-func (c *Input) SynthesizeScrollGesture(ctx context.Context, x float64, y float64, xDistance float64, yDistance float64, xOverscroll float64, yOverscroll float64, preventFling bool, speed int, gestureSourceType string, repeatCount int, repeatDelayMs int, interactionMarkerName string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Input) SynthesizeScrollGesture(ctx context.Context, x float64, y float64, xDistance float64, yDistance float64, xOverscroll float64, yOverscroll float64, preventFling bool, speed int, gestureSourceType InputGestureSourceType, repeatCount int, repeatDelayMs int, interactionMarkerName string) (*gcdmessage.ChromeResponse, error) {
 	var v InputSynthesizeScrollGestureParams
 	v.X = x
 	v.Y = y
@@ -516,10 +764,12 @@ type InputSynthesizeTapGestureParams struct {
 	// Number of times to perform the tap (e.g. 2 for double tap, default: 1).
 	TapCount int `json:"tapCount,omitempty"`
 	// Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). enum values: default, touch, mouse
-	GestureSourceType string `json:"gestureSourceType,omitempty"`
+	GestureSourceType InputGestureSourceType `json:"gestureSourceType,omitempty"`
 }
 
 // SynthesizeTapGestureWithParams - Synthesizes a tap gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Input) SynthesizeTapGestureWithParams(ctx context.Context, v *InputSynthesizeTapGestureParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Input.synthesizeTapGesture", Params: v})
 }
@@ -530,7 +780,9 @@ func (c *Input) SynthesizeTapGestureWithParams(ctx context.Context, v *InputSynt
 // duration - Duration between touchdown and touchup events in ms (default: 50).
 // tapCount - Number of times to perform the tap (e.g. 2 for double tap, default: 1).
 // gestureSourceType - Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). enum values: default, touch, mouse
-func (c *Input) SynthesizeTapGesture(ctx context.Context, x float64, y float64, duration int, tapCount int, gestureSourceType string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Input) SynthesizeTapGesture(ctx context.Context, x float64, y float64, duration int, tapCount int, gestureSourceType InputGestureSourceType) (*gcdmessage.ChromeResponse, error) {
 	var v InputSynthesizeTapGestureParams
 	v.X = x
 	v.Y = y
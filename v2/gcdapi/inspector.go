@@ -5,10 +5,23 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Event method names for the Inspector domain
+const (
+	EventInspectorDetached                 = "Inspector.detached"
+	EventInspectorTargetCrashed            = "Inspector.targetCrashed"
+	EventInspectorTargetReloadedAfterCrash = "Inspector.targetReloadedAfterCrash"
+)
+
+// InspectorEvents lists all event method names for the Inspector domain
+var InspectorEvents = []string{
+	EventInspectorDetached,
+	EventInspectorTargetCrashed,
+	EventInspectorTargetReloadedAfterCrash,
+}
+
 // Fired when remote debugging connection is about to be terminated. Contains detach reason.
 type InspectorDetachedEvent struct {
 	Method string `json:"method"`
@@ -17,6 +30,19 @@ type InspectorDetachedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Fired when debugging target has crashed
+type InspectorTargetCrashedEvent struct {
+	Method string `json:"method"`
+}
+
+// Fired when debugging target has reloaded after crash
+type InspectorTargetReloadedAfterCrashEvent struct {
+	Method string `json:"method"`
+}
+
+// Inspector domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type Inspector struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -25,13 +51,3 @@ func NewInspector(target gcdmessage.ChromeTargeter) *Inspector {
 	c := &Inspector{target: target}
 	return c
 }
-
-// Disables inspector domain notifications.
-func (c *Inspector) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Inspector.disable"})
-}
-
-// Enables inspector domain notifications.
-func (c *Inspector) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Inspector.enable"})
-}
@@ -0,0 +1,61 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Inspector functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnDetached subscribes to Inspector.detached events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Inspector) OnDetached(callback func(*InspectorDetachedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventInspectorDetached, func(payload []byte) error {
+		event := &InspectorDetachedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTargetCrashed subscribes to Inspector.targetCrashed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Inspector) OnTargetCrashed(callback func(*InspectorTargetCrashedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventInspectorTargetCrashed, func(payload []byte) error {
+		event := &InspectorTargetCrashedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTargetReloadedAfterCrash subscribes to Inspector.targetReloadedAfterCrash events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Inspector) OnTargetReloadedAfterCrash(callback func(*InspectorTargetReloadedAfterCrashEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventInspectorTargetReloadedAfterCrash, func(payload []byte) error {
+		event := &InspectorTargetReloadedAfterCrashEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// Disables inspector domain notifications.
+func (c *Inspector) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Inspector.disable"})
+}
+
+// Enables inspector domain notifications.
+func (c *Inspector) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Inspector.enable"})
+}
@@ -5,14 +5,33 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Reason for rectangle to force scrolling on the main thread
+type LayerTreeScrollRectType string
+
+const (
+	LayerTreeScrollRectTypeRepaintsOnScroll  LayerTreeScrollRectType = "RepaintsOnScroll"
+	LayerTreeScrollRectTypeTouchEventHandler LayerTreeScrollRectType = "TouchEventHandler"
+	LayerTreeScrollRectTypeWheelEventHandler LayerTreeScrollRectType = "WheelEventHandler"
+)
+
+// Valid returns true if the value is one of the LayerTreeScrollRectType enum values.
+func (e LayerTreeScrollRectType) Valid() bool {
+	switch e {
+	case LayerTreeScrollRectTypeRepaintsOnScroll,
+		LayerTreeScrollRectTypeTouchEventHandler,
+		LayerTreeScrollRectTypeWheelEventHandler:
+		return true
+	}
+	return false
+}
+
 // Rectangle where scrolling happens on the main thread.
 type LayerTreeScrollRect struct {
-	Rect *DOMRect `json:"rect"` // Rectangle itself.
-	Type string   `json:"type"` // Reason for rectangle to force scrolling on the main thread
+	Rect *DOMRect                `json:"rect"` // Rectangle itself.
+	Type LayerTreeScrollRectType `json:"type"` // Reason for rectangle to force scrolling on the main thread
 }
 
 // Sticky position constraints.
@@ -50,6 +69,18 @@ type LayerTreeLayer struct {
 	StickyPositionConstraint *LayerTreeStickyPositionConstraint `json:"stickyPositionConstraint,omitempty"` // Sticky position constraint information
 }
 
+// Event method names for the LayerTree domain
+const (
+	EventLayerTreeLayerPainted       = "LayerTree.layerPainted"
+	EventLayerTreeLayerTreeDidChange = "LayerTree.layerTreeDidChange"
+)
+
+// LayerTreeEvents lists all event method names for the LayerTree domain
+var LayerTreeEvents = []string{
+	EventLayerTreeLayerPainted,
+	EventLayerTreeLayerTreeDidChange,
+}
+
 type LayerTreeLayerPaintedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -61,10 +92,13 @@ type LayerTreeLayerPaintedEvent struct {
 type LayerTreeLayerTreeDidChangeEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		Layers []*LayerTreeLayer `json:"layers,omitempty"` // Layer tree, absent if not in the comspositing mode.
+		Layers []*LayerTreeLayer `json:"layers,omitempty"` // Layer tree, absent if not in the compositing mode.
 	} `json:"Params,omitempty"`
 }
 
+// LayerTree domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type LayerTree struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -73,319 +107,3 @@ func NewLayerTree(target gcdmessage.ChromeTargeter) *LayerTree {
 	c := &LayerTree{target: target}
 	return c
 }
-
-type LayerTreeCompositingReasonsParams struct {
-	// The id of the layer for which we want to get the reasons it was composited.
-	LayerId string `json:"layerId"`
-}
-
-// CompositingReasonsWithParams - Provides the reasons why the given layer was composited.
-// Returns -  compositingReasons - A list of strings specifying reasons for the given layer to become composited. compositingReasonIds - A list of strings specifying reason IDs for the given layer to become composited.
-func (c *LayerTree) CompositingReasonsWithParams(ctx context.Context, v *LayerTreeCompositingReasonsParams) ([]string, []string, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.compositingReasons", Params: v})
-	if err != nil {
-		return nil, nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			CompositingReasons   []string
-			CompositingReasonIds []string
-		}
-	}
-
-	if resp == nil {
-		return nil, nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.CompositingReasons, chromeData.Result.CompositingReasonIds, nil
-}
-
-// CompositingReasons - Provides the reasons why the given layer was composited.
-// layerId - The id of the layer for which we want to get the reasons it was composited.
-// Returns -  compositingReasons - A list of strings specifying reasons for the given layer to become composited. compositingReasonIds - A list of strings specifying reason IDs for the given layer to become composited.
-func (c *LayerTree) CompositingReasons(ctx context.Context, layerId string) ([]string, []string, error) {
-	var v LayerTreeCompositingReasonsParams
-	v.LayerId = layerId
-	return c.CompositingReasonsWithParams(ctx, &v)
-}
-
-// Disables compositing tree inspection.
-func (c *LayerTree) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.disable"})
-}
-
-// Enables compositing tree inspection.
-func (c *LayerTree) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.enable"})
-}
-
-type LayerTreeLoadSnapshotParams struct {
-	// An array of tiles composing the snapshot.
-	Tiles []*LayerTreePictureTile `json:"tiles"`
-}
-
-// LoadSnapshotWithParams - Returns the snapshot identifier.
-// Returns -  snapshotId - The id of the snapshot.
-func (c *LayerTree) LoadSnapshotWithParams(ctx context.Context, v *LayerTreeLoadSnapshotParams) (string, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.loadSnapshot", Params: v})
-	if err != nil {
-		return "", err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			SnapshotId string
-		}
-	}
-
-	if resp == nil {
-		return "", &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return "", err
-	}
-
-	if chromeData.Error != nil {
-		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.SnapshotId, nil
-}
-
-// LoadSnapshot - Returns the snapshot identifier.
-// tiles - An array of tiles composing the snapshot.
-// Returns -  snapshotId - The id of the snapshot.
-func (c *LayerTree) LoadSnapshot(ctx context.Context, tiles []*LayerTreePictureTile) (string, error) {
-	var v LayerTreeLoadSnapshotParams
-	v.Tiles = tiles
-	return c.LoadSnapshotWithParams(ctx, &v)
-}
-
-type LayerTreeMakeSnapshotParams struct {
-	// The id of the layer.
-	LayerId string `json:"layerId"`
-}
-
-// MakeSnapshotWithParams - Returns the layer snapshot identifier.
-// Returns -  snapshotId - The id of the layer snapshot.
-func (c *LayerTree) MakeSnapshotWithParams(ctx context.Context, v *LayerTreeMakeSnapshotParams) (string, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.makeSnapshot", Params: v})
-	if err != nil {
-		return "", err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			SnapshotId string
-		}
-	}
-
-	if resp == nil {
-		return "", &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return "", err
-	}
-
-	if chromeData.Error != nil {
-		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.SnapshotId, nil
-}
-
-// MakeSnapshot - Returns the layer snapshot identifier.
-// layerId - The id of the layer.
-// Returns -  snapshotId - The id of the layer snapshot.
-func (c *LayerTree) MakeSnapshot(ctx context.Context, layerId string) (string, error) {
-	var v LayerTreeMakeSnapshotParams
-	v.LayerId = layerId
-	return c.MakeSnapshotWithParams(ctx, &v)
-}
-
-type LayerTreeProfileSnapshotParams struct {
-	// The id of the layer snapshot.
-	SnapshotId string `json:"snapshotId"`
-	// The maximum number of times to replay the snapshot (1, if not specified).
-	MinRepeatCount int `json:"minRepeatCount,omitempty"`
-	// The minimum duration (in seconds) to replay the snapshot.
-	MinDuration float64 `json:"minDuration,omitempty"`
-	// The clip rectangle to apply when replaying the snapshot.
-	ClipRect *DOMRect `json:"clipRect,omitempty"`
-}
-
-// ProfileSnapshotWithParams -
-// Returns -  timings - The array of paint profiles, one per run.
-func (c *LayerTree) ProfileSnapshotWithParams(ctx context.Context, v *LayerTreeProfileSnapshotParams) ([]float64, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.profileSnapshot", Params: v})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Timings []float64
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Timings, nil
-}
-
-// ProfileSnapshot -
-// snapshotId - The id of the layer snapshot.
-// minRepeatCount - The maximum number of times to replay the snapshot (1, if not specified).
-// minDuration - The minimum duration (in seconds) to replay the snapshot.
-// clipRect - The clip rectangle to apply when replaying the snapshot.
-// Returns -  timings - The array of paint profiles, one per run.
-func (c *LayerTree) ProfileSnapshot(ctx context.Context, snapshotId string, minRepeatCount int, minDuration float64, clipRect *DOMRect) ([]float64, error) {
-	var v LayerTreeProfileSnapshotParams
-	v.SnapshotId = snapshotId
-	v.MinRepeatCount = minRepeatCount
-	v.MinDuration = minDuration
-	v.ClipRect = clipRect
-	return c.ProfileSnapshotWithParams(ctx, &v)
-}
-
-type LayerTreeReleaseSnapshotParams struct {
-	// The id of the layer snapshot.
-	SnapshotId string `json:"snapshotId"`
-}
-
-// ReleaseSnapshotWithParams - Releases layer snapshot captured by the back-end.
-func (c *LayerTree) ReleaseSnapshotWithParams(ctx context.Context, v *LayerTreeReleaseSnapshotParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.releaseSnapshot", Params: v})
-}
-
-// ReleaseSnapshot - Releases layer snapshot captured by the back-end.
-// snapshotId - The id of the layer snapshot.
-func (c *LayerTree) ReleaseSnapshot(ctx context.Context, snapshotId string) (*gcdmessage.ChromeResponse, error) {
-	var v LayerTreeReleaseSnapshotParams
-	v.SnapshotId = snapshotId
-	return c.ReleaseSnapshotWithParams(ctx, &v)
-}
-
-type LayerTreeReplaySnapshotParams struct {
-	// The id of the layer snapshot.
-	SnapshotId string `json:"snapshotId"`
-	// The first step to replay from (replay from the very start if not specified).
-	FromStep int `json:"fromStep,omitempty"`
-	// The last step to replay to (replay till the end if not specified).
-	ToStep int `json:"toStep,omitempty"`
-	// The scale to apply while replaying (defaults to 1).
-	Scale float64 `json:"scale,omitempty"`
-}
-
-// ReplaySnapshotWithParams - Replays the layer snapshot and returns the resulting bitmap.
-// Returns -  dataURL - A data: URL for resulting image.
-func (c *LayerTree) ReplaySnapshotWithParams(ctx context.Context, v *LayerTreeReplaySnapshotParams) (string, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.replaySnapshot", Params: v})
-	if err != nil {
-		return "", err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			DataURL string
-		}
-	}
-
-	if resp == nil {
-		return "", &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return "", err
-	}
-
-	if chromeData.Error != nil {
-		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.DataURL, nil
-}
-
-// ReplaySnapshot - Replays the layer snapshot and returns the resulting bitmap.
-// snapshotId - The id of the layer snapshot.
-// fromStep - The first step to replay from (replay from the very start if not specified).
-// toStep - The last step to replay to (replay till the end if not specified).
-// scale - The scale to apply while replaying (defaults to 1).
-// Returns -  dataURL - A data: URL for resulting image.
-func (c *LayerTree) ReplaySnapshot(ctx context.Context, snapshotId string, fromStep int, toStep int, scale float64) (string, error) {
-	var v LayerTreeReplaySnapshotParams
-	v.SnapshotId = snapshotId
-	v.FromStep = fromStep
-	v.ToStep = toStep
-	v.Scale = scale
-	return c.ReplaySnapshotWithParams(ctx, &v)
-}
-
-type LayerTreeSnapshotCommandLogParams struct {
-	// The id of the layer snapshot.
-	SnapshotId string `json:"snapshotId"`
-}
-
-// SnapshotCommandLogWithParams - Replays the layer snapshot and returns canvas log.
-// Returns -
-func (c *LayerTree) SnapshotCommandLogWithParams(ctx context.Context, v *LayerTreeSnapshotCommandLogParams) error {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "LayerTree.snapshotCommandLog", Params: v})
-	if err != nil {
-		return err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-		}
-	}
-
-	if resp == nil {
-		return &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return err
-	}
-
-	if chromeData.Error != nil {
-		return &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return nil
-}
-
-// SnapshotCommandLog - Replays the layer snapshot and returns canvas log.
-// snapshotId - The id of the layer snapshot.
-// Returns -
-func (c *LayerTree) SnapshotCommandLog(ctx context.Context, snapshotId string) error {
-	var v LayerTreeSnapshotCommandLogParams
-	v.SnapshotId = snapshotId
-	return c.SnapshotCommandLogWithParams(ctx, &v)
-}
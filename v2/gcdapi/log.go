@@ -9,12 +9,118 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Log entry source.
+type LogLogEntrySource string
+
+const (
+	LogLogEntrySourceXml            LogLogEntrySource = "xml"
+	LogLogEntrySourceJavascript     LogLogEntrySource = "javascript"
+	LogLogEntrySourceNetwork        LogLogEntrySource = "network"
+	LogLogEntrySourceStorage        LogLogEntrySource = "storage"
+	LogLogEntrySourceAppcache       LogLogEntrySource = "appcache"
+	LogLogEntrySourceRendering      LogLogEntrySource = "rendering"
+	LogLogEntrySourceSecurity       LogLogEntrySource = "security"
+	LogLogEntrySourceDeprecation    LogLogEntrySource = "deprecation"
+	LogLogEntrySourceWorker         LogLogEntrySource = "worker"
+	LogLogEntrySourceViolation      LogLogEntrySource = "violation"
+	LogLogEntrySourceIntervention   LogLogEntrySource = "intervention"
+	LogLogEntrySourceRecommendation LogLogEntrySource = "recommendation"
+	LogLogEntrySourceOther          LogLogEntrySource = "other"
+)
+
+// Valid returns true if the value is one of the LogLogEntrySource enum values.
+func (e LogLogEntrySource) Valid() bool {
+	switch e {
+	case LogLogEntrySourceXml,
+		LogLogEntrySourceJavascript,
+		LogLogEntrySourceNetwork,
+		LogLogEntrySourceStorage,
+		LogLogEntrySourceAppcache,
+		LogLogEntrySourceRendering,
+		LogLogEntrySourceSecurity,
+		LogLogEntrySourceDeprecation,
+		LogLogEntrySourceWorker,
+		LogLogEntrySourceViolation,
+		LogLogEntrySourceIntervention,
+		LogLogEntrySourceRecommendation,
+		LogLogEntrySourceOther:
+		return true
+	}
+	return false
+}
+
+// Log entry severity.
+type LogLogEntryLevel string
+
+const (
+	LogLogEntryLevelVerbose LogLogEntryLevel = "verbose"
+	LogLogEntryLevelInfo    LogLogEntryLevel = "info"
+	LogLogEntryLevelWarning LogLogEntryLevel = "warning"
+	LogLogEntryLevelError   LogLogEntryLevel = "error"
+)
+
+// Valid returns true if the value is one of the LogLogEntryLevel enum values.
+func (e LogLogEntryLevel) Valid() bool {
+	switch e {
+	case LogLogEntryLevelVerbose,
+		LogLogEntryLevelInfo,
+		LogLogEntryLevelWarning,
+		LogLogEntryLevelError:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type LogLogEntryCategory string
+
+const (
+	LogLogEntryCategoryCors LogLogEntryCategory = "cors"
+)
+
+// Valid returns true if the value is one of the LogLogEntryCategory enum values.
+func (e LogLogEntryCategory) Valid() bool {
+	switch e {
+	case LogLogEntryCategoryCors:
+		return true
+	}
+	return false
+}
+
+// Violation type.
+type LogViolationSettingName string
+
+const (
+	LogViolationSettingNameLongTask          LogViolationSettingName = "longTask"
+	LogViolationSettingNameLongLayout        LogViolationSettingName = "longLayout"
+	LogViolationSettingNameBlockedEvent      LogViolationSettingName = "blockedEvent"
+	LogViolationSettingNameBlockedParser     LogViolationSettingName = "blockedParser"
+	LogViolationSettingNameDiscouragedAPIUse LogViolationSettingName = "discouragedAPIUse"
+	LogViolationSettingNameHandler           LogViolationSettingName = "handler"
+	LogViolationSettingNameRecurringHandler  LogViolationSettingName = "recurringHandler"
+)
+
+// Valid returns true if the value is one of the LogViolationSettingName enum values.
+func (e LogViolationSettingName) Valid() bool {
+	switch e {
+	case LogViolationSettingNameLongTask,
+		LogViolationSettingNameLongLayout,
+		LogViolationSettingNameBlockedEvent,
+		LogViolationSettingNameBlockedParser,
+		LogViolationSettingNameDiscouragedAPIUse,
+		LogViolationSettingNameHandler,
+		LogViolationSettingNameRecurringHandler:
+		return true
+	}
+	return false
+}
+
 // Log entry.
 type LogLogEntry struct {
-	Source           string                 `json:"source"`                     // Log entry source.
-	Level            string                 `json:"level"`                      // Log entry severity.
+	Source           LogLogEntrySource      `json:"source"`                     // Log entry source.
+	Level            LogLogEntryLevel       `json:"level"`                      // Log entry severity.
 	Text             string                 `json:"text"`                       // Logged text.
-	Category         string                 `json:"category,omitempty"`         //
+	Category         LogLogEntryCategory    `json:"category,omitempty"`         //
 	Timestamp        float64                `json:"timestamp"`                  // Timestamp when this entry was added.
 	Url              string                 `json:"url,omitempty"`              // URL of the resource if known.
 	LineNumber       int                    `json:"lineNumber,omitempty"`       // Line number in the resource.
@@ -26,8 +132,18 @@ type LogLogEntry struct {
 
 // Violation configuration setting.
 type LogViolationSetting struct {
-	Name      string  `json:"name"`      // Violation type.
-	Threshold float64 `json:"threshold"` // Time threshold to trigger upon.
+	Name      LogViolationSettingName `json:"name"`      // Violation type.
+	Threshold float64                 `json:"threshold"` // Time threshold to trigger upon.
+}
+
+// Event method names for the Log domain
+const (
+	EventLogEntryAdded = "Log.entryAdded"
+)
+
+// LogEvents lists all event method names for the Log domain
+var LogEvents = []string{
+	EventLogEntryAdded,
 }
 
 // Issued when new message was logged.
@@ -47,6 +163,19 @@ func NewLog(target gcdmessage.ChromeTargeter) *Log {
 	return c
 }
 
+// OnEntryAdded subscribes to Log.entryAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Log) OnEntryAdded(callback func(*LogEntryAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventLogEntryAdded, func(payload []byte) error {
+		event := &LogEntryAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 // Clears the log.
 func (c *Log) Clear(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Log.clear"})
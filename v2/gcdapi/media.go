@@ -5,14 +5,35 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Keep in sync with MediaLogMessageLevel We are currently keeping the message level 'error' separate from the PlayerError type because right now they represent different things, this one being a DVLOG(ERROR) style log message that gets printed based on what log level is selected in the UI, and the other is a representation of a media::PipelineStatus object. Soon however we're going to be moving away from using PipelineStatus for errors and introducing a new error type which should hopefully let us integrate the error log level into the PlayerError type.
+type MediaPlayerMessageLevel string
+
+const (
+	MediaPlayerMessageLevelError   MediaPlayerMessageLevel = "error"
+	MediaPlayerMessageLevelWarning MediaPlayerMessageLevel = "warning"
+	MediaPlayerMessageLevelInfo    MediaPlayerMessageLevel = "info"
+	MediaPlayerMessageLevelDebug   MediaPlayerMessageLevel = "debug"
+)
+
+// Valid returns true if the value is one of the MediaPlayerMessageLevel enum values.
+func (e MediaPlayerMessageLevel) Valid() bool {
+	switch e {
+	case MediaPlayerMessageLevelError,
+		MediaPlayerMessageLevelWarning,
+		MediaPlayerMessageLevelInfo,
+		MediaPlayerMessageLevelDebug:
+		return true
+	}
+	return false
+}
+
 // Have one type per entry in MediaLogRecord::Type Corresponds to kMessage
 type MediaPlayerMessage struct {
-	Level   string `json:"level"`   // Keep in sync with MediaLogMessageLevel We are currently keeping the message level 'error' separate from the PlayerError type because right now they represent different things, this one being a DVLOG(ERROR) style log message that gets printed based on what log level is selected in the UI, and the other is a representation of a media::PipelineStatus object. Soon however we're going to be moving away from using PipelineStatus for errors and introducing a new error type which should hopefully let us integrate the error log level into the PlayerError type.
-	Message string `json:"message"` //
+	Level   MediaPlayerMessageLevel `json:"level"`   // Keep in sync with MediaLogMessageLevel We are currently keeping the message level 'error' separate from the PlayerError type because right now they represent different things, this one being a DVLOG(ERROR) style log message that gets printed based on what log level is selected in the UI, and the other is a representation of a media::PipelineStatus object. Soon however we're going to be moving away from using PipelineStatus for errors and introducing a new error type which should hopefully let us integrate the error log level into the PlayerError type.
+	Message string                  `json:"message"` //
 }
 
 // Corresponds to kMediaPropertyChange
@@ -42,6 +63,24 @@ type MediaPlayerError struct {
 	Data      map[string]interface{}            `json:"data"`      // Extra data attached to an error, such as an HRESULT, Video Codec, etc.
 }
 
+// Event method names for the Media domain
+const (
+	EventMediaPlayerPropertiesChanged = "Media.playerPropertiesChanged"
+	EventMediaPlayerEventsAdded       = "Media.playerEventsAdded"
+	EventMediaPlayerMessagesLogged    = "Media.playerMessagesLogged"
+	EventMediaPlayerErrorsRaised      = "Media.playerErrorsRaised"
+	EventMediaPlayersCreated          = "Media.playersCreated"
+)
+
+// MediaEvents lists all event method names for the Media domain
+var MediaEvents = []string{
+	EventMediaPlayerPropertiesChanged,
+	EventMediaPlayerEventsAdded,
+	EventMediaPlayerMessagesLogged,
+	EventMediaPlayerErrorsRaised,
+	EventMediaPlayersCreated,
+}
+
 // This can be called multiple times, and can be used to set / override / remove player properties. A null propValue indicates removal.
 type MediaPlayerPropertiesChangedEvent struct {
 	Method string `json:"method"`
@@ -86,6 +125,9 @@ type MediaPlayersCreatedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Media domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type Media struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -94,13 +136,3 @@ func NewMedia(target gcdmessage.ChromeTargeter) *Media {
 	c := &Media{target: target}
 	return c
 }
-
-// Enables the Media domain
-func (c *Media) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Media.enable"})
-}
-
-// Disables the Media domain.
-func (c *Media) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Media.disable"})
-}
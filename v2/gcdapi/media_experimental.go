@@ -0,0 +1,87 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Media functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnPlayerPropertiesChanged subscribes to Media.playerPropertiesChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Media) OnPlayerPropertiesChanged(callback func(*MediaPlayerPropertiesChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventMediaPlayerPropertiesChanged, func(payload []byte) error {
+		event := &MediaPlayerPropertiesChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPlayerEventsAdded subscribes to Media.playerEventsAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Media) OnPlayerEventsAdded(callback func(*MediaPlayerEventsAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventMediaPlayerEventsAdded, func(payload []byte) error {
+		event := &MediaPlayerEventsAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPlayerMessagesLogged subscribes to Media.playerMessagesLogged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Media) OnPlayerMessagesLogged(callback func(*MediaPlayerMessagesLoggedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventMediaPlayerMessagesLogged, func(payload []byte) error {
+		event := &MediaPlayerMessagesLoggedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPlayerErrorsRaised subscribes to Media.playerErrorsRaised events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Media) OnPlayerErrorsRaised(callback func(*MediaPlayerErrorsRaisedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventMediaPlayerErrorsRaised, func(payload []byte) error {
+		event := &MediaPlayerErrorsRaisedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPlayersCreated subscribes to Media.playersCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Media) OnPlayersCreated(callback func(*MediaPlayersCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventMediaPlayersCreated, func(payload []byte) error {
+		event := &MediaPlayersCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// Enables the Media domain
+func (c *Media) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Media.enable"})
+}
+
+// Disables the Media domain.
+func (c *Media) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Media.disable"})
+}
@@ -5,10 +5,27 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Memory pressure level.
+type MemoryPressureLevel string
+
+const (
+	MemoryPressureLevelModerate MemoryPressureLevel = "moderate"
+	MemoryPressureLevelCritical MemoryPressureLevel = "critical"
+)
+
+// Valid returns true if the value is one of the MemoryPressureLevel enum values.
+func (e MemoryPressureLevel) Valid() bool {
+	switch e {
+	case MemoryPressureLevelModerate,
+		MemoryPressureLevelCritical:
+		return true
+	}
+	return false
+}
+
 // Heap profile sample.
 type MemorySamplingProfileNode struct {
 	Size  float64  `json:"size"`  // Size of the sampled allocation.
@@ -30,6 +47,15 @@ type MemoryModule struct {
 	Size        float64 `json:"size"`        // Size of the module in bytes.
 }
 
+// DOM object counter data.
+type MemoryDOMCounter struct {
+	Name  string `json:"name"`  // Object name. Note: object names should be presumed volatile and clients should not expect the returned names to be consistent across runs.
+	Count int    `json:"count"` // Object count.
+}
+
+// Memory domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type Memory struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -38,197 +64,3 @@ func NewMemory(target gcdmessage.ChromeTargeter) *Memory {
 	c := &Memory{target: target}
 	return c
 }
-
-// GetDOMCounters -
-// Returns -  documents -  nodes -  jsEventListeners -
-func (c *Memory) GetDOMCounters(ctx context.Context) (int, int, int, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getDOMCounters"})
-	if err != nil {
-		return 0, 0, 0, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Documents        int
-			Nodes            int
-			JsEventListeners int
-		}
-	}
-
-	if resp == nil {
-		return 0, 0, 0, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return 0, 0, 0, err
-	}
-
-	if chromeData.Error != nil {
-		return 0, 0, 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Documents, chromeData.Result.Nodes, chromeData.Result.JsEventListeners, nil
-}
-
-func (c *Memory) PrepareForLeakDetection(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.prepareForLeakDetection"})
-}
-
-// Simulate OomIntervention by purging V8 memory.
-func (c *Memory) ForciblyPurgeJavaScriptMemory(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.forciblyPurgeJavaScriptMemory"})
-}
-
-type MemorySetPressureNotificationsSuppressedParams struct {
-	// If true, memory pressure notifications will be suppressed.
-	Suppressed bool `json:"suppressed"`
-}
-
-// SetPressureNotificationsSuppressedWithParams - Enable/disable suppressing memory pressure notifications in all processes.
-func (c *Memory) SetPressureNotificationsSuppressedWithParams(ctx context.Context, v *MemorySetPressureNotificationsSuppressedParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.setPressureNotificationsSuppressed", Params: v})
-}
-
-// SetPressureNotificationsSuppressed - Enable/disable suppressing memory pressure notifications in all processes.
-// suppressed - If true, memory pressure notifications will be suppressed.
-func (c *Memory) SetPressureNotificationsSuppressed(ctx context.Context, suppressed bool) (*gcdmessage.ChromeResponse, error) {
-	var v MemorySetPressureNotificationsSuppressedParams
-	v.Suppressed = suppressed
-	return c.SetPressureNotificationsSuppressedWithParams(ctx, &v)
-}
-
-type MemorySimulatePressureNotificationParams struct {
-	// Memory pressure level of the notification. enum values: moderate, critical
-	Level string `json:"level"`
-}
-
-// SimulatePressureNotificationWithParams - Simulate a memory pressure notification in all processes.
-func (c *Memory) SimulatePressureNotificationWithParams(ctx context.Context, v *MemorySimulatePressureNotificationParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.simulatePressureNotification", Params: v})
-}
-
-// SimulatePressureNotification - Simulate a memory pressure notification in all processes.
-// level - Memory pressure level of the notification. enum values: moderate, critical
-func (c *Memory) SimulatePressureNotification(ctx context.Context, level string) (*gcdmessage.ChromeResponse, error) {
-	var v MemorySimulatePressureNotificationParams
-	v.Level = level
-	return c.SimulatePressureNotificationWithParams(ctx, &v)
-}
-
-type MemoryStartSamplingParams struct {
-	// Average number of bytes between samples.
-	SamplingInterval int `json:"samplingInterval,omitempty"`
-	// Do not randomize intervals between samples.
-	SuppressRandomness bool `json:"suppressRandomness,omitempty"`
-}
-
-// StartSamplingWithParams - Start collecting native memory profile.
-func (c *Memory) StartSamplingWithParams(ctx context.Context, v *MemoryStartSamplingParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.startSampling", Params: v})
-}
-
-// StartSampling - Start collecting native memory profile.
-// samplingInterval - Average number of bytes between samples.
-// suppressRandomness - Do not randomize intervals between samples.
-func (c *Memory) StartSampling(ctx context.Context, samplingInterval int, suppressRandomness bool) (*gcdmessage.ChromeResponse, error) {
-	var v MemoryStartSamplingParams
-	v.SamplingInterval = samplingInterval
-	v.SuppressRandomness = suppressRandomness
-	return c.StartSamplingWithParams(ctx, &v)
-}
-
-// Stop collecting native memory profile.
-func (c *Memory) StopSampling(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.stopSampling"})
-}
-
-// GetAllTimeSamplingProfile - Retrieve native memory allocations profile collected since renderer process startup.
-// Returns -  profile -
-func (c *Memory) GetAllTimeSamplingProfile(ctx context.Context) (*MemorySamplingProfile, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getAllTimeSamplingProfile"})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Profile *MemorySamplingProfile
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Profile, nil
-}
-
-// GetBrowserSamplingProfile - Retrieve native memory allocations profile collected since browser process startup.
-// Returns -  profile -
-func (c *Memory) GetBrowserSamplingProfile(ctx context.Context) (*MemorySamplingProfile, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getBrowserSamplingProfile"})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Profile *MemorySamplingProfile
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Profile, nil
-}
-
-// GetSamplingProfile - Retrieve native memory allocations profile collected since last `startSampling` call.
-// Returns -  profile -
-func (c *Memory) GetSamplingProfile(ctx context.Context) (*MemorySamplingProfile, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getSamplingProfile"})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Profile *MemorySamplingProfile
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Profile, nil
-}
@@ -0,0 +1,237 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Memory functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// GetDOMCounters - Retruns current DOM object counters.
+// Returns -  documents -  nodes -  jsEventListeners -
+func (c *Memory) GetDOMCounters(ctx context.Context) (int, int, int, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getDOMCounters"})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Documents        int
+			Nodes            int
+			JsEventListeners int
+		}
+	}
+
+	if resp == nil {
+		return 0, 0, 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return 0, 0, 0, err
+	}
+
+	if chromeData.Error != nil {
+		return 0, 0, 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Documents, chromeData.Result.Nodes, chromeData.Result.JsEventListeners, nil
+}
+
+// GetDOMCountersForLeakDetection - Retruns DOM object counters after preparing renderer for leak detection.
+// Returns -  counters - DOM object counters.
+func (c *Memory) GetDOMCountersForLeakDetection(ctx context.Context) ([]*MemoryDOMCounter, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getDOMCountersForLeakDetection"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Counters []*MemoryDOMCounter
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Counters, nil
+}
+
+// Prepares for leak detection by terminating workers, stopping spellcheckers, dropping non-essential internal caches, running garbage collections, etc.
+func (c *Memory) PrepareForLeakDetection(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.prepareForLeakDetection"})
+}
+
+// Simulate OomIntervention by purging V8 memory.
+func (c *Memory) ForciblyPurgeJavaScriptMemory(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.forciblyPurgeJavaScriptMemory"})
+}
+
+type MemorySetPressureNotificationsSuppressedParams struct {
+	// If true, memory pressure notifications will be suppressed.
+	Suppressed bool `json:"suppressed"`
+}
+
+// SetPressureNotificationsSuppressedWithParams - Enable/disable suppressing memory pressure notifications in all processes.
+func (c *Memory) SetPressureNotificationsSuppressedWithParams(ctx context.Context, v *MemorySetPressureNotificationsSuppressedParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.setPressureNotificationsSuppressed", Params: v})
+}
+
+// SetPressureNotificationsSuppressed - Enable/disable suppressing memory pressure notifications in all processes.
+// suppressed - If true, memory pressure notifications will be suppressed.
+func (c *Memory) SetPressureNotificationsSuppressed(ctx context.Context, suppressed bool) (*gcdmessage.ChromeResponse, error) {
+	var v MemorySetPressureNotificationsSuppressedParams
+	v.Suppressed = suppressed
+	return c.SetPressureNotificationsSuppressedWithParams(ctx, &v)
+}
+
+type MemorySimulatePressureNotificationParams struct {
+	// Memory pressure level of the notification. enum values: moderate, critical
+	Level MemoryPressureLevel `json:"level"`
+}
+
+// SimulatePressureNotificationWithParams - Simulate a memory pressure notification in all processes.
+func (c *Memory) SimulatePressureNotificationWithParams(ctx context.Context, v *MemorySimulatePressureNotificationParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.simulatePressureNotification", Params: v})
+}
+
+// SimulatePressureNotification - Simulate a memory pressure notification in all processes.
+// level - Memory pressure level of the notification. enum values: moderate, critical
+func (c *Memory) SimulatePressureNotification(ctx context.Context, level MemoryPressureLevel) (*gcdmessage.ChromeResponse, error) {
+	var v MemorySimulatePressureNotificationParams
+	v.Level = level
+	return c.SimulatePressureNotificationWithParams(ctx, &v)
+}
+
+type MemoryStartSamplingParams struct {
+	// Average number of bytes between samples.
+	SamplingInterval int `json:"samplingInterval,omitempty"`
+	// Do not randomize intervals between samples.
+	SuppressRandomness bool `json:"suppressRandomness,omitempty"`
+}
+
+// StartSamplingWithParams - Start collecting native memory profile.
+func (c *Memory) StartSamplingWithParams(ctx context.Context, v *MemoryStartSamplingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.startSampling", Params: v})
+}
+
+// StartSampling - Start collecting native memory profile.
+// samplingInterval - Average number of bytes between samples.
+// suppressRandomness - Do not randomize intervals between samples.
+func (c *Memory) StartSampling(ctx context.Context, samplingInterval int, suppressRandomness bool) (*gcdmessage.ChromeResponse, error) {
+	var v MemoryStartSamplingParams
+	v.SamplingInterval = samplingInterval
+	v.SuppressRandomness = suppressRandomness
+	return c.StartSamplingWithParams(ctx, &v)
+}
+
+// Stop collecting native memory profile.
+func (c *Memory) StopSampling(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.stopSampling"})
+}
+
+// GetAllTimeSamplingProfile - Retrieve native memory allocations profile collected since renderer process startup.
+// Returns -  profile -
+func (c *Memory) GetAllTimeSamplingProfile(ctx context.Context) (*MemorySamplingProfile, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getAllTimeSamplingProfile"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Profile *MemorySamplingProfile
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Profile, nil
+}
+
+// GetBrowserSamplingProfile - Retrieve native memory allocations profile collected since browser process startup.
+// Returns -  profile -
+func (c *Memory) GetBrowserSamplingProfile(ctx context.Context) (*MemorySamplingProfile, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getBrowserSamplingProfile"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Profile *MemorySamplingProfile
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Profile, nil
+}
+
+// GetSamplingProfile - Retrieve native memory allocations profile collected since last `startSampling` call.
+// Returns -  profile -
+func (c *Memory) GetSamplingProfile(ctx context.Context) (*MemorySamplingProfile, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Memory.getSamplingProfile"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Profile *MemorySamplingProfile
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Profile, nil
+}
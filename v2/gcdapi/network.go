@@ -9,26 +9,1039 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Resource type as it was perceived by the rendering engine.
+type NetworkResourceType string
+
+const (
+	NetworkResourceTypeDocument           NetworkResourceType = "Document"
+	NetworkResourceTypeStylesheet         NetworkResourceType = "Stylesheet"
+	NetworkResourceTypeImage              NetworkResourceType = "Image"
+	NetworkResourceTypeMedia              NetworkResourceType = "Media"
+	NetworkResourceTypeFont               NetworkResourceType = "Font"
+	NetworkResourceTypeScript             NetworkResourceType = "Script"
+	NetworkResourceTypeTextTrack          NetworkResourceType = "TextTrack"
+	NetworkResourceTypeXHR                NetworkResourceType = "XHR"
+	NetworkResourceTypeFetch              NetworkResourceType = "Fetch"
+	NetworkResourceTypePrefetch           NetworkResourceType = "Prefetch"
+	NetworkResourceTypeEventSource        NetworkResourceType = "EventSource"
+	NetworkResourceTypeWebSocket          NetworkResourceType = "WebSocket"
+	NetworkResourceTypeManifest           NetworkResourceType = "Manifest"
+	NetworkResourceTypeSignedExchange     NetworkResourceType = "SignedExchange"
+	NetworkResourceTypePing               NetworkResourceType = "Ping"
+	NetworkResourceTypeCSPViolationReport NetworkResourceType = "CSPViolationReport"
+	NetworkResourceTypePreflight          NetworkResourceType = "Preflight"
+	NetworkResourceTypeFedCM              NetworkResourceType = "FedCM"
+	NetworkResourceTypeOther              NetworkResourceType = "Other"
+)
+
+// Valid returns true if the value is one of the NetworkResourceType enum values.
+func (e NetworkResourceType) Valid() bool {
+	switch e {
+	case NetworkResourceTypeDocument,
+		NetworkResourceTypeStylesheet,
+		NetworkResourceTypeImage,
+		NetworkResourceTypeMedia,
+		NetworkResourceTypeFont,
+		NetworkResourceTypeScript,
+		NetworkResourceTypeTextTrack,
+		NetworkResourceTypeXHR,
+		NetworkResourceTypeFetch,
+		NetworkResourceTypePrefetch,
+		NetworkResourceTypeEventSource,
+		NetworkResourceTypeWebSocket,
+		NetworkResourceTypeManifest,
+		NetworkResourceTypeSignedExchange,
+		NetworkResourceTypePing,
+		NetworkResourceTypeCSPViolationReport,
+		NetworkResourceTypePreflight,
+		NetworkResourceTypeFedCM,
+		NetworkResourceTypeOther:
+		return true
+	}
+	return false
+}
+
+// Network level fetch failure reason.
+type NetworkErrorReason string
+
+const (
+	NetworkErrorReasonFailed               NetworkErrorReason = "Failed"
+	NetworkErrorReasonAborted              NetworkErrorReason = "Aborted"
+	NetworkErrorReasonTimedOut             NetworkErrorReason = "TimedOut"
+	NetworkErrorReasonAccessDenied         NetworkErrorReason = "AccessDenied"
+	NetworkErrorReasonConnectionClosed     NetworkErrorReason = "ConnectionClosed"
+	NetworkErrorReasonConnectionReset      NetworkErrorReason = "ConnectionReset"
+	NetworkErrorReasonConnectionRefused    NetworkErrorReason = "ConnectionRefused"
+	NetworkErrorReasonConnectionAborted    NetworkErrorReason = "ConnectionAborted"
+	NetworkErrorReasonConnectionFailed     NetworkErrorReason = "ConnectionFailed"
+	NetworkErrorReasonNameNotResolved      NetworkErrorReason = "NameNotResolved"
+	NetworkErrorReasonInternetDisconnected NetworkErrorReason = "InternetDisconnected"
+	NetworkErrorReasonAddressUnreachable   NetworkErrorReason = "AddressUnreachable"
+	NetworkErrorReasonBlockedByClient      NetworkErrorReason = "BlockedByClient"
+	NetworkErrorReasonBlockedByResponse    NetworkErrorReason = "BlockedByResponse"
+)
+
+// Valid returns true if the value is one of the NetworkErrorReason enum values.
+func (e NetworkErrorReason) Valid() bool {
+	switch e {
+	case NetworkErrorReasonFailed,
+		NetworkErrorReasonAborted,
+		NetworkErrorReasonTimedOut,
+		NetworkErrorReasonAccessDenied,
+		NetworkErrorReasonConnectionClosed,
+		NetworkErrorReasonConnectionReset,
+		NetworkErrorReasonConnectionRefused,
+		NetworkErrorReasonConnectionAborted,
+		NetworkErrorReasonConnectionFailed,
+		NetworkErrorReasonNameNotResolved,
+		NetworkErrorReasonInternetDisconnected,
+		NetworkErrorReasonAddressUnreachable,
+		NetworkErrorReasonBlockedByClient,
+		NetworkErrorReasonBlockedByResponse:
+		return true
+	}
+	return false
+}
+
+// The underlying connection technology that the browser is supposedly using.
+type NetworkConnectionType string
+
+const (
+	NetworkConnectionTypeNone       NetworkConnectionType = "none"
+	NetworkConnectionTypeCellular2g NetworkConnectionType = "cellular2g"
+	NetworkConnectionTypeCellular3g NetworkConnectionType = "cellular3g"
+	NetworkConnectionTypeCellular4g NetworkConnectionType = "cellular4g"
+	NetworkConnectionTypeBluetooth  NetworkConnectionType = "bluetooth"
+	NetworkConnectionTypeEthernet   NetworkConnectionType = "ethernet"
+	NetworkConnectionTypeWifi       NetworkConnectionType = "wifi"
+	NetworkConnectionTypeWimax      NetworkConnectionType = "wimax"
+	NetworkConnectionTypeOther      NetworkConnectionType = "other"
+)
+
+// Valid returns true if the value is one of the NetworkConnectionType enum values.
+func (e NetworkConnectionType) Valid() bool {
+	switch e {
+	case NetworkConnectionTypeNone,
+		NetworkConnectionTypeCellular2g,
+		NetworkConnectionTypeCellular3g,
+		NetworkConnectionTypeCellular4g,
+		NetworkConnectionTypeBluetooth,
+		NetworkConnectionTypeEthernet,
+		NetworkConnectionTypeWifi,
+		NetworkConnectionTypeWimax,
+		NetworkConnectionTypeOther:
+		return true
+	}
+	return false
+}
+
+// Represents the cookie's 'SameSite' status: https://tools.ietf.org/html/draft-west-first-party-cookies
+type NetworkCookieSameSite string
+
+const (
+	NetworkCookieSameSiteStrict NetworkCookieSameSite = "Strict"
+	NetworkCookieSameSiteLax    NetworkCookieSameSite = "Lax"
+	NetworkCookieSameSiteNone   NetworkCookieSameSite = "None"
+)
+
+// Valid returns true if the value is one of the NetworkCookieSameSite enum values.
+func (e NetworkCookieSameSite) Valid() bool {
+	switch e {
+	case NetworkCookieSameSiteStrict,
+		NetworkCookieSameSiteLax,
+		NetworkCookieSameSiteNone:
+		return true
+	}
+	return false
+}
+
+// Represents the cookie's 'Priority' status: https://tools.ietf.org/html/draft-west-cookie-priority-00
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkCookiePriority string
+
+const (
+	NetworkCookiePriorityLow    NetworkCookiePriority = "Low"
+	NetworkCookiePriorityMedium NetworkCookiePriority = "Medium"
+	NetworkCookiePriorityHigh   NetworkCookiePriority = "High"
+)
+
+// Valid returns true if the value is one of the NetworkCookiePriority enum values.
+func (e NetworkCookiePriority) Valid() bool {
+	switch e {
+	case NetworkCookiePriorityLow,
+		NetworkCookiePriorityMedium,
+		NetworkCookiePriorityHigh:
+		return true
+	}
+	return false
+}
+
+// Represents the source scheme of the origin that originally set the cookie. A value of "Unset" allows protocol clients to emulate legacy cookie scope for the scheme. This is a temporary ability and it will be removed in the future.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkCookieSourceScheme string
+
+const (
+	NetworkCookieSourceSchemeUnset     NetworkCookieSourceScheme = "Unset"
+	NetworkCookieSourceSchemeNonSecure NetworkCookieSourceScheme = "NonSecure"
+	NetworkCookieSourceSchemeSecure    NetworkCookieSourceScheme = "Secure"
+)
+
+// Valid returns true if the value is one of the NetworkCookieSourceScheme enum values.
+func (e NetworkCookieSourceScheme) Valid() bool {
+	switch e {
+	case NetworkCookieSourceSchemeUnset,
+		NetworkCookieSourceSchemeNonSecure,
+		NetworkCookieSourceSchemeSecure:
+		return true
+	}
+	return false
+}
+
+// Loading priority of a resource request.
+type NetworkResourcePriority string
+
+const (
+	NetworkResourcePriorityVeryLow  NetworkResourcePriority = "VeryLow"
+	NetworkResourcePriorityLow      NetworkResourcePriority = "Low"
+	NetworkResourcePriorityMedium   NetworkResourcePriority = "Medium"
+	NetworkResourcePriorityHigh     NetworkResourcePriority = "High"
+	NetworkResourcePriorityVeryHigh NetworkResourcePriority = "VeryHigh"
+)
+
+// Valid returns true if the value is one of the NetworkResourcePriority enum values.
+func (e NetworkResourcePriority) Valid() bool {
+	switch e {
+	case NetworkResourcePriorityVeryLow,
+		NetworkResourcePriorityLow,
+		NetworkResourcePriorityMedium,
+		NetworkResourcePriorityHigh,
+		NetworkResourcePriorityVeryHigh:
+		return true
+	}
+	return false
+}
+
+// The referrer policy of the request, as defined in https://www.w3.org/TR/referrer-policy/
+type NetworkRequestReferrerPolicy string
+
+const (
+	NetworkRequestReferrerPolicyUnsafeUrl                   NetworkRequestReferrerPolicy = "unsafe-url"
+	NetworkRequestReferrerPolicyNoReferrerWhenDowngrade     NetworkRequestReferrerPolicy = "no-referrer-when-downgrade"
+	NetworkRequestReferrerPolicyNoReferrer                  NetworkRequestReferrerPolicy = "no-referrer"
+	NetworkRequestReferrerPolicyOrigin                      NetworkRequestReferrerPolicy = "origin"
+	NetworkRequestReferrerPolicyOriginWhenCrossOrigin       NetworkRequestReferrerPolicy = "origin-when-cross-origin"
+	NetworkRequestReferrerPolicySameOrigin                  NetworkRequestReferrerPolicy = "same-origin"
+	NetworkRequestReferrerPolicyStrictOrigin                NetworkRequestReferrerPolicy = "strict-origin"
+	NetworkRequestReferrerPolicyStrictOriginWhenCrossOrigin NetworkRequestReferrerPolicy = "strict-origin-when-cross-origin"
+)
+
+// Valid returns true if the value is one of the NetworkRequestReferrerPolicy enum values.
+func (e NetworkRequestReferrerPolicy) Valid() bool {
+	switch e {
+	case NetworkRequestReferrerPolicyUnsafeUrl,
+		NetworkRequestReferrerPolicyNoReferrerWhenDowngrade,
+		NetworkRequestReferrerPolicyNoReferrer,
+		NetworkRequestReferrerPolicyOrigin,
+		NetworkRequestReferrerPolicyOriginWhenCrossOrigin,
+		NetworkRequestReferrerPolicySameOrigin,
+		NetworkRequestReferrerPolicyStrictOrigin,
+		NetworkRequestReferrerPolicyStrictOriginWhenCrossOrigin:
+		return true
+	}
+	return false
+}
+
+// Whether the request complied with Certificate Transparency policy.
+type NetworkCertificateTransparencyCompliance string
+
+const (
+	NetworkCertificateTransparencyComplianceUnknown      NetworkCertificateTransparencyCompliance = "unknown"
+	NetworkCertificateTransparencyComplianceNotCompliant NetworkCertificateTransparencyCompliance = "not-compliant"
+	NetworkCertificateTransparencyComplianceCompliant    NetworkCertificateTransparencyCompliance = "compliant"
+)
+
+// Valid returns true if the value is one of the NetworkCertificateTransparencyCompliance enum values.
+func (e NetworkCertificateTransparencyCompliance) Valid() bool {
+	switch e {
+	case NetworkCertificateTransparencyComplianceUnknown,
+		NetworkCertificateTransparencyComplianceNotCompliant,
+		NetworkCertificateTransparencyComplianceCompliant:
+		return true
+	}
+	return false
+}
+
+// The reason why request was blocked.
+type NetworkBlockedReason string
+
+const (
+	NetworkBlockedReasonOther                                                   NetworkBlockedReason = "other"
+	NetworkBlockedReasonCsp                                                     NetworkBlockedReason = "csp"
+	NetworkBlockedReasonMixedContent                                            NetworkBlockedReason = "mixed-content"
+	NetworkBlockedReasonOrigin                                                  NetworkBlockedReason = "origin"
+	NetworkBlockedReasonInspector                                               NetworkBlockedReason = "inspector"
+	NetworkBlockedReasonIntegrity                                               NetworkBlockedReason = "integrity"
+	NetworkBlockedReasonSubresourceFilter                                       NetworkBlockedReason = "subresource-filter"
+	NetworkBlockedReasonContentType                                             NetworkBlockedReason = "content-type"
+	NetworkBlockedReasonCoepFrameResourceNeedsCoepHeader                        NetworkBlockedReason = "coep-frame-resource-needs-coep-header"
+	NetworkBlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage             NetworkBlockedReason = "coop-sandboxed-iframe-cannot-navigate-to-coop-page"
+	NetworkBlockedReasonCorpNotSameOrigin                                       NetworkBlockedReason = "corp-not-same-origin"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-dip"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"
+	NetworkBlockedReasonCorpNotSameSite                                         NetworkBlockedReason = "corp-not-same-site"
+	NetworkBlockedReasonSriMessageSignatureMismatch                             NetworkBlockedReason = "sri-message-signature-mismatch"
+)
+
+// Valid returns true if the value is one of the NetworkBlockedReason enum values.
+func (e NetworkBlockedReason) Valid() bool {
+	switch e {
+	case NetworkBlockedReasonOther,
+		NetworkBlockedReasonCsp,
+		NetworkBlockedReasonMixedContent,
+		NetworkBlockedReasonOrigin,
+		NetworkBlockedReasonInspector,
+		NetworkBlockedReasonIntegrity,
+		NetworkBlockedReasonSubresourceFilter,
+		NetworkBlockedReasonContentType,
+		NetworkBlockedReasonCoepFrameResourceNeedsCoepHeader,
+		NetworkBlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage,
+		NetworkBlockedReasonCorpNotSameOrigin,
+		NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep,
+		NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip,
+		NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip,
+		NetworkBlockedReasonCorpNotSameSite,
+		NetworkBlockedReasonSriMessageSignatureMismatch:
+		return true
+	}
+	return false
+}
+
+// The reason why request was blocked.
+type NetworkCorsError string
+
+const (
+	NetworkCorsErrorDisallowedByMode                          NetworkCorsError = "DisallowedByMode"
+	NetworkCorsErrorInvalidResponse                           NetworkCorsError = "InvalidResponse"
+	NetworkCorsErrorWildcardOriginNotAllowed                  NetworkCorsError = "WildcardOriginNotAllowed"
+	NetworkCorsErrorMissingAllowOriginHeader                  NetworkCorsError = "MissingAllowOriginHeader"
+	NetworkCorsErrorMultipleAllowOriginValues                 NetworkCorsError = "MultipleAllowOriginValues"
+	NetworkCorsErrorInvalidAllowOriginValue                   NetworkCorsError = "InvalidAllowOriginValue"
+	NetworkCorsErrorAllowOriginMismatch                       NetworkCorsError = "AllowOriginMismatch"
+	NetworkCorsErrorInvalidAllowCredentials                   NetworkCorsError = "InvalidAllowCredentials"
+	NetworkCorsErrorCorsDisabledScheme                        NetworkCorsError = "CorsDisabledScheme"
+	NetworkCorsErrorPreflightInvalidStatus                    NetworkCorsError = "PreflightInvalidStatus"
+	NetworkCorsErrorPreflightDisallowedRedirect               NetworkCorsError = "PreflightDisallowedRedirect"
+	NetworkCorsErrorPreflightWildcardOriginNotAllowed         NetworkCorsError = "PreflightWildcardOriginNotAllowed"
+	NetworkCorsErrorPreflightMissingAllowOriginHeader         NetworkCorsError = "PreflightMissingAllowOriginHeader"
+	NetworkCorsErrorPreflightMultipleAllowOriginValues        NetworkCorsError = "PreflightMultipleAllowOriginValues"
+	NetworkCorsErrorPreflightInvalidAllowOriginValue          NetworkCorsError = "PreflightInvalidAllowOriginValue"
+	NetworkCorsErrorPreflightAllowOriginMismatch              NetworkCorsError = "PreflightAllowOriginMismatch"
+	NetworkCorsErrorPreflightInvalidAllowCredentials          NetworkCorsError = "PreflightInvalidAllowCredentials"
+	NetworkCorsErrorPreflightMissingAllowExternal             NetworkCorsError = "PreflightMissingAllowExternal"
+	NetworkCorsErrorPreflightInvalidAllowExternal             NetworkCorsError = "PreflightInvalidAllowExternal"
+	NetworkCorsErrorPreflightMissingAllowPrivateNetwork       NetworkCorsError = "PreflightMissingAllowPrivateNetwork"
+	NetworkCorsErrorPreflightInvalidAllowPrivateNetwork       NetworkCorsError = "PreflightInvalidAllowPrivateNetwork"
+	NetworkCorsErrorInvalidAllowMethodsPreflightResponse      NetworkCorsError = "InvalidAllowMethodsPreflightResponse"
+	NetworkCorsErrorInvalidAllowHeadersPreflightResponse      NetworkCorsError = "InvalidAllowHeadersPreflightResponse"
+	NetworkCorsErrorMethodDisallowedByPreflightResponse       NetworkCorsError = "MethodDisallowedByPreflightResponse"
+	NetworkCorsErrorHeaderDisallowedByPreflightResponse       NetworkCorsError = "HeaderDisallowedByPreflightResponse"
+	NetworkCorsErrorRedirectContainsCredentials               NetworkCorsError = "RedirectContainsCredentials"
+	NetworkCorsErrorInsecurePrivateNetwork                    NetworkCorsError = "InsecurePrivateNetwork"
+	NetworkCorsErrorInvalidPrivateNetworkAccess               NetworkCorsError = "InvalidPrivateNetworkAccess"
+	NetworkCorsErrorUnexpectedPrivateNetworkAccess            NetworkCorsError = "UnexpectedPrivateNetworkAccess"
+	NetworkCorsErrorNoCorsRedirectModeNotFollow               NetworkCorsError = "NoCorsRedirectModeNotFollow"
+	NetworkCorsErrorPreflightMissingPrivateNetworkAccessId    NetworkCorsError = "PreflightMissingPrivateNetworkAccessId"
+	NetworkCorsErrorPreflightMissingPrivateNetworkAccessName  NetworkCorsError = "PreflightMissingPrivateNetworkAccessName"
+	NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable NetworkCorsError = "PrivateNetworkAccessPermissionUnavailable"
+	NetworkCorsErrorPrivateNetworkAccessPermissionDenied      NetworkCorsError = "PrivateNetworkAccessPermissionDenied"
+	NetworkCorsErrorLocalNetworkAccessPermissionDenied        NetworkCorsError = "LocalNetworkAccessPermissionDenied"
+)
+
+// Valid returns true if the value is one of the NetworkCorsError enum values.
+func (e NetworkCorsError) Valid() bool {
+	switch e {
+	case NetworkCorsErrorDisallowedByMode,
+		NetworkCorsErrorInvalidResponse,
+		NetworkCorsErrorWildcardOriginNotAllowed,
+		NetworkCorsErrorMissingAllowOriginHeader,
+		NetworkCorsErrorMultipleAllowOriginValues,
+		NetworkCorsErrorInvalidAllowOriginValue,
+		NetworkCorsErrorAllowOriginMismatch,
+		NetworkCorsErrorInvalidAllowCredentials,
+		NetworkCorsErrorCorsDisabledScheme,
+		NetworkCorsErrorPreflightInvalidStatus,
+		NetworkCorsErrorPreflightDisallowedRedirect,
+		NetworkCorsErrorPreflightWildcardOriginNotAllowed,
+		NetworkCorsErrorPreflightMissingAllowOriginHeader,
+		NetworkCorsErrorPreflightMultipleAllowOriginValues,
+		NetworkCorsErrorPreflightInvalidAllowOriginValue,
+		NetworkCorsErrorPreflightAllowOriginMismatch,
+		NetworkCorsErrorPreflightInvalidAllowCredentials,
+		NetworkCorsErrorPreflightMissingAllowExternal,
+		NetworkCorsErrorPreflightInvalidAllowExternal,
+		NetworkCorsErrorPreflightMissingAllowPrivateNetwork,
+		NetworkCorsErrorPreflightInvalidAllowPrivateNetwork,
+		NetworkCorsErrorInvalidAllowMethodsPreflightResponse,
+		NetworkCorsErrorInvalidAllowHeadersPreflightResponse,
+		NetworkCorsErrorMethodDisallowedByPreflightResponse,
+		NetworkCorsErrorHeaderDisallowedByPreflightResponse,
+		NetworkCorsErrorRedirectContainsCredentials,
+		NetworkCorsErrorInsecurePrivateNetwork,
+		NetworkCorsErrorInvalidPrivateNetworkAccess,
+		NetworkCorsErrorUnexpectedPrivateNetworkAccess,
+		NetworkCorsErrorNoCorsRedirectModeNotFollow,
+		NetworkCorsErrorPreflightMissingPrivateNetworkAccessId,
+		NetworkCorsErrorPreflightMissingPrivateNetworkAccessName,
+		NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable,
+		NetworkCorsErrorPrivateNetworkAccessPermissionDenied,
+		NetworkCorsErrorLocalNetworkAccessPermissionDenied:
+		return true
+	}
+	return false
+}
+
+// Source of serviceworker response.
+type NetworkServiceWorkerResponseSource string
+
+const (
+	NetworkServiceWorkerResponseSourceCacheStorage NetworkServiceWorkerResponseSource = "cache-storage"
+	NetworkServiceWorkerResponseSourceHttpCache    NetworkServiceWorkerResponseSource = "http-cache"
+	NetworkServiceWorkerResponseSourceFallbackCode NetworkServiceWorkerResponseSource = "fallback-code"
+	NetworkServiceWorkerResponseSourceNetwork      NetworkServiceWorkerResponseSource = "network"
+)
+
+// Valid returns true if the value is one of the NetworkServiceWorkerResponseSource enum values.
+func (e NetworkServiceWorkerResponseSource) Valid() bool {
+	switch e {
+	case NetworkServiceWorkerResponseSourceCacheStorage,
+		NetworkServiceWorkerResponseSourceHttpCache,
+		NetworkServiceWorkerResponseSourceFallbackCode,
+		NetworkServiceWorkerResponseSourceNetwork:
+		return true
+	}
+	return false
+}
+
+// Only set for "token-redemption" operation and determine whether to request a fresh SRR or use a still valid cached SRR.
+type NetworkTrustTokenParamsRefreshPolicy string
+
+const (
+	NetworkTrustTokenParamsRefreshPolicyUseCached NetworkTrustTokenParamsRefreshPolicy = "UseCached"
+	NetworkTrustTokenParamsRefreshPolicyRefresh   NetworkTrustTokenParamsRefreshPolicy = "Refresh"
+)
+
+// Valid returns true if the value is one of the NetworkTrustTokenParamsRefreshPolicy enum values.
+func (e NetworkTrustTokenParamsRefreshPolicy) Valid() bool {
+	switch e {
+	case NetworkTrustTokenParamsRefreshPolicyUseCached,
+		NetworkTrustTokenParamsRefreshPolicyRefresh:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkTrustTokenOperationType string
+
+const (
+	NetworkTrustTokenOperationTypeIssuance   NetworkTrustTokenOperationType = "Issuance"
+	NetworkTrustTokenOperationTypeRedemption NetworkTrustTokenOperationType = "Redemption"
+	NetworkTrustTokenOperationTypeSigning    NetworkTrustTokenOperationType = "Signing"
+)
+
+// Valid returns true if the value is one of the NetworkTrustTokenOperationType enum values.
+func (e NetworkTrustTokenOperationType) Valid() bool {
+	switch e {
+	case NetworkTrustTokenOperationTypeIssuance,
+		NetworkTrustTokenOperationTypeRedemption,
+		NetworkTrustTokenOperationTypeSigning:
+		return true
+	}
+	return false
+}
+
+// The reason why Chrome uses a specific transport protocol for HTTP semantics.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkAlternateProtocolUsage string
+
+const (
+	NetworkAlternateProtocolUsageAlternativeJobWonWithoutRace NetworkAlternateProtocolUsage = "alternativeJobWonWithoutRace"
+	NetworkAlternateProtocolUsageAlternativeJobWonRace        NetworkAlternateProtocolUsage = "alternativeJobWonRace"
+	NetworkAlternateProtocolUsageMainJobWonRace               NetworkAlternateProtocolUsage = "mainJobWonRace"
+	NetworkAlternateProtocolUsageMappingMissing               NetworkAlternateProtocolUsage = "mappingMissing"
+	NetworkAlternateProtocolUsageBroken                       NetworkAlternateProtocolUsage = "broken"
+	NetworkAlternateProtocolUsageDnsAlpnH3JobWonWithoutRace   NetworkAlternateProtocolUsage = "dnsAlpnH3JobWonWithoutRace"
+	NetworkAlternateProtocolUsageDnsAlpnH3JobWonRace          NetworkAlternateProtocolUsage = "dnsAlpnH3JobWonRace"
+	NetworkAlternateProtocolUsageUnspecifiedReason            NetworkAlternateProtocolUsage = "unspecifiedReason"
+)
+
+// Valid returns true if the value is one of the NetworkAlternateProtocolUsage enum values.
+func (e NetworkAlternateProtocolUsage) Valid() bool {
+	switch e {
+	case NetworkAlternateProtocolUsageAlternativeJobWonWithoutRace,
+		NetworkAlternateProtocolUsageAlternativeJobWonRace,
+		NetworkAlternateProtocolUsageMainJobWonRace,
+		NetworkAlternateProtocolUsageMappingMissing,
+		NetworkAlternateProtocolUsageBroken,
+		NetworkAlternateProtocolUsageDnsAlpnH3JobWonWithoutRace,
+		NetworkAlternateProtocolUsageDnsAlpnH3JobWonRace,
+		NetworkAlternateProtocolUsageUnspecifiedReason:
+		return true
+	}
+	return false
+}
+
+// Source of service worker router.
+type NetworkServiceWorkerRouterSource string
+
+const (
+	NetworkServiceWorkerRouterSourceNetwork                    NetworkServiceWorkerRouterSource = "network"
+	NetworkServiceWorkerRouterSourceCache                      NetworkServiceWorkerRouterSource = "cache"
+	NetworkServiceWorkerRouterSourceFetchEvent                 NetworkServiceWorkerRouterSource = "fetch-event"
+	NetworkServiceWorkerRouterSourceRaceNetworkAndFetchHandler NetworkServiceWorkerRouterSource = "race-network-and-fetch-handler"
+	NetworkServiceWorkerRouterSourceRaceNetworkAndCache        NetworkServiceWorkerRouterSource = "race-network-and-cache"
+)
+
+// Valid returns true if the value is one of the NetworkServiceWorkerRouterSource enum values.
+func (e NetworkServiceWorkerRouterSource) Valid() bool {
+	switch e {
+	case NetworkServiceWorkerRouterSourceNetwork,
+		NetworkServiceWorkerRouterSourceCache,
+		NetworkServiceWorkerRouterSourceFetchEvent,
+		NetworkServiceWorkerRouterSourceRaceNetworkAndFetchHandler,
+		NetworkServiceWorkerRouterSourceRaceNetworkAndCache:
+		return true
+	}
+	return false
+}
+
+// Type of this initiator.
+type NetworkInitiatorType string
+
+const (
+	NetworkInitiatorTypeParser         NetworkInitiatorType = "parser"
+	NetworkInitiatorTypeScript         NetworkInitiatorType = "script"
+	NetworkInitiatorTypePreload        NetworkInitiatorType = "preload"
+	NetworkInitiatorTypeSignedExchange NetworkInitiatorType = "SignedExchange"
+	NetworkInitiatorTypePreflight      NetworkInitiatorType = "preflight"
+	NetworkInitiatorTypeOther          NetworkInitiatorType = "other"
+)
+
+// Valid returns true if the value is one of the NetworkInitiatorType enum values.
+func (e NetworkInitiatorType) Valid() bool {
+	switch e {
+	case NetworkInitiatorTypeParser,
+		NetworkInitiatorTypeScript,
+		NetworkInitiatorTypePreload,
+		NetworkInitiatorTypeSignedExchange,
+		NetworkInitiatorTypePreflight,
+		NetworkInitiatorTypeOther:
+		return true
+	}
+	return false
+}
+
+// Types of reasons why a cookie may not be stored from a response.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkSetCookieBlockedReason string
+
+const (
+	NetworkSetCookieBlockedReasonSecureOnly                               NetworkSetCookieBlockedReason = "SecureOnly"
+	NetworkSetCookieBlockedReasonSameSiteStrict                           NetworkSetCookieBlockedReason = "SameSiteStrict"
+	NetworkSetCookieBlockedReasonSameSiteLax                              NetworkSetCookieBlockedReason = "SameSiteLax"
+	NetworkSetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          NetworkSetCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	NetworkSetCookieBlockedReasonSameSiteNoneInsecure                     NetworkSetCookieBlockedReason = "SameSiteNoneInsecure"
+	NetworkSetCookieBlockedReasonUserPreferences                          NetworkSetCookieBlockedReason = "UserPreferences"
+	NetworkSetCookieBlockedReasonThirdPartyPhaseout                       NetworkSetCookieBlockedReason = "ThirdPartyPhaseout"
+	NetworkSetCookieBlockedReasonThirdPartyBlockedInFirstPartySet         NetworkSetCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	NetworkSetCookieBlockedReasonSyntaxError                              NetworkSetCookieBlockedReason = "SyntaxError"
+	NetworkSetCookieBlockedReasonSchemeNotSupported                       NetworkSetCookieBlockedReason = "SchemeNotSupported"
+	NetworkSetCookieBlockedReasonOverwriteSecure                          NetworkSetCookieBlockedReason = "OverwriteSecure"
+	NetworkSetCookieBlockedReasonInvalidDomain                            NetworkSetCookieBlockedReason = "InvalidDomain"
+	NetworkSetCookieBlockedReasonInvalidPrefix                            NetworkSetCookieBlockedReason = "InvalidPrefix"
+	NetworkSetCookieBlockedReasonUnknownError                             NetworkSetCookieBlockedReason = "UnknownError"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteStrict                  NetworkSetCookieBlockedReason = "SchemefulSameSiteStrict"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteLax                     NetworkSetCookieBlockedReason = "SchemefulSameSiteLax"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax NetworkSetCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	NetworkSetCookieBlockedReasonSamePartyFromCrossPartyContext           NetworkSetCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	NetworkSetCookieBlockedReasonSamePartyConflictsWithOtherAttributes    NetworkSetCookieBlockedReason = "SamePartyConflictsWithOtherAttributes"
+	NetworkSetCookieBlockedReasonNameValuePairExceedsMaxSize              NetworkSetCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	NetworkSetCookieBlockedReasonDisallowedCharacter                      NetworkSetCookieBlockedReason = "DisallowedCharacter"
+	NetworkSetCookieBlockedReasonNoCookieContent                          NetworkSetCookieBlockedReason = "NoCookieContent"
+)
+
+// Valid returns true if the value is one of the NetworkSetCookieBlockedReason enum values.
+func (e NetworkSetCookieBlockedReason) Valid() bool {
+	switch e {
+	case NetworkSetCookieBlockedReasonSecureOnly,
+		NetworkSetCookieBlockedReasonSameSiteStrict,
+		NetworkSetCookieBlockedReasonSameSiteLax,
+		NetworkSetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax,
+		NetworkSetCookieBlockedReasonSameSiteNoneInsecure,
+		NetworkSetCookieBlockedReasonUserPreferences,
+		NetworkSetCookieBlockedReasonThirdPartyPhaseout,
+		NetworkSetCookieBlockedReasonThirdPartyBlockedInFirstPartySet,
+		NetworkSetCookieBlockedReasonSyntaxError,
+		NetworkSetCookieBlockedReasonSchemeNotSupported,
+		NetworkSetCookieBlockedReasonOverwriteSecure,
+		NetworkSetCookieBlockedReasonInvalidDomain,
+		NetworkSetCookieBlockedReasonInvalidPrefix,
+		NetworkSetCookieBlockedReasonUnknownError,
+		NetworkSetCookieBlockedReasonSchemefulSameSiteStrict,
+		NetworkSetCookieBlockedReasonSchemefulSameSiteLax,
+		NetworkSetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax,
+		NetworkSetCookieBlockedReasonSamePartyFromCrossPartyContext,
+		NetworkSetCookieBlockedReasonSamePartyConflictsWithOtherAttributes,
+		NetworkSetCookieBlockedReasonNameValuePairExceedsMaxSize,
+		NetworkSetCookieBlockedReasonDisallowedCharacter,
+		NetworkSetCookieBlockedReasonNoCookieContent:
+		return true
+	}
+	return false
+}
+
+// Types of reasons why a cookie may not be sent with a request.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkCookieBlockedReason string
+
+const (
+	NetworkCookieBlockedReasonSecureOnly                               NetworkCookieBlockedReason = "SecureOnly"
+	NetworkCookieBlockedReasonNotOnPath                                NetworkCookieBlockedReason = "NotOnPath"
+	NetworkCookieBlockedReasonDomainMismatch                           NetworkCookieBlockedReason = "DomainMismatch"
+	NetworkCookieBlockedReasonSameSiteStrict                           NetworkCookieBlockedReason = "SameSiteStrict"
+	NetworkCookieBlockedReasonSameSiteLax                              NetworkCookieBlockedReason = "SameSiteLax"
+	NetworkCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          NetworkCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	NetworkCookieBlockedReasonSameSiteNoneInsecure                     NetworkCookieBlockedReason = "SameSiteNoneInsecure"
+	NetworkCookieBlockedReasonUserPreferences                          NetworkCookieBlockedReason = "UserPreferences"
+	NetworkCookieBlockedReasonThirdPartyPhaseout                       NetworkCookieBlockedReason = "ThirdPartyPhaseout"
+	NetworkCookieBlockedReasonThirdPartyBlockedInFirstPartySet         NetworkCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	NetworkCookieBlockedReasonUnknownError                             NetworkCookieBlockedReason = "UnknownError"
+	NetworkCookieBlockedReasonSchemefulSameSiteStrict                  NetworkCookieBlockedReason = "SchemefulSameSiteStrict"
+	NetworkCookieBlockedReasonSchemefulSameSiteLax                     NetworkCookieBlockedReason = "SchemefulSameSiteLax"
+	NetworkCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax NetworkCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	NetworkCookieBlockedReasonSamePartyFromCrossPartyContext           NetworkCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	NetworkCookieBlockedReasonNameValuePairExceedsMaxSize              NetworkCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	NetworkCookieBlockedReasonPortMismatch                             NetworkCookieBlockedReason = "PortMismatch"
+	NetworkCookieBlockedReasonSchemeMismatch                           NetworkCookieBlockedReason = "SchemeMismatch"
+	NetworkCookieBlockedReasonAnonymousContext                         NetworkCookieBlockedReason = "AnonymousContext"
+)
+
+// Valid returns true if the value is one of the NetworkCookieBlockedReason enum values.
+func (e NetworkCookieBlockedReason) Valid() bool {
+	switch e {
+	case NetworkCookieBlockedReasonSecureOnly,
+		NetworkCookieBlockedReasonNotOnPath,
+		NetworkCookieBlockedReasonDomainMismatch,
+		NetworkCookieBlockedReasonSameSiteStrict,
+		NetworkCookieBlockedReasonSameSiteLax,
+		NetworkCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax,
+		NetworkCookieBlockedReasonSameSiteNoneInsecure,
+		NetworkCookieBlockedReasonUserPreferences,
+		NetworkCookieBlockedReasonThirdPartyPhaseout,
+		NetworkCookieBlockedReasonThirdPartyBlockedInFirstPartySet,
+		NetworkCookieBlockedReasonUnknownError,
+		NetworkCookieBlockedReasonSchemefulSameSiteStrict,
+		NetworkCookieBlockedReasonSchemefulSameSiteLax,
+		NetworkCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax,
+		NetworkCookieBlockedReasonSamePartyFromCrossPartyContext,
+		NetworkCookieBlockedReasonNameValuePairExceedsMaxSize,
+		NetworkCookieBlockedReasonPortMismatch,
+		NetworkCookieBlockedReasonSchemeMismatch,
+		NetworkCookieBlockedReasonAnonymousContext:
+		return true
+	}
+	return false
+}
+
+// Types of reasons why a cookie should have been blocked by 3PCD but is exempted for the request.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkCookieExemptionReason string
+
+const (
+	NetworkCookieExemptionReasonNone                         NetworkCookieExemptionReason = "None"
+	NetworkCookieExemptionReasonUserSetting                  NetworkCookieExemptionReason = "UserSetting"
+	NetworkCookieExemptionReasonTPCDMetadata                 NetworkCookieExemptionReason = "TPCDMetadata"
+	NetworkCookieExemptionReasonTPCDDeprecationTrial         NetworkCookieExemptionReason = "TPCDDeprecationTrial"
+	NetworkCookieExemptionReasonTopLevelTPCDDeprecationTrial NetworkCookieExemptionReason = "TopLevelTPCDDeprecationTrial"
+	NetworkCookieExemptionReasonTPCDHeuristics               NetworkCookieExemptionReason = "TPCDHeuristics"
+	NetworkCookieExemptionReasonEnterprisePolicy             NetworkCookieExemptionReason = "EnterprisePolicy"
+	NetworkCookieExemptionReasonStorageAccess                NetworkCookieExemptionReason = "StorageAccess"
+	NetworkCookieExemptionReasonTopLevelStorageAccess        NetworkCookieExemptionReason = "TopLevelStorageAccess"
+	NetworkCookieExemptionReasonScheme                       NetworkCookieExemptionReason = "Scheme"
+	NetworkCookieExemptionReasonSameSiteNoneCookiesInSandbox NetworkCookieExemptionReason = "SameSiteNoneCookiesInSandbox"
+)
+
+// Valid returns true if the value is one of the NetworkCookieExemptionReason enum values.
+func (e NetworkCookieExemptionReason) Valid() bool {
+	switch e {
+	case NetworkCookieExemptionReasonNone,
+		NetworkCookieExemptionReasonUserSetting,
+		NetworkCookieExemptionReasonTPCDMetadata,
+		NetworkCookieExemptionReasonTPCDDeprecationTrial,
+		NetworkCookieExemptionReasonTopLevelTPCDDeprecationTrial,
+		NetworkCookieExemptionReasonTPCDHeuristics,
+		NetworkCookieExemptionReasonEnterprisePolicy,
+		NetworkCookieExemptionReasonStorageAccess,
+		NetworkCookieExemptionReasonTopLevelStorageAccess,
+		NetworkCookieExemptionReasonScheme,
+		NetworkCookieExemptionReasonSameSiteNoneCookiesInSandbox:
+		return true
+	}
+	return false
+}
+
+// Source of the authentication challenge.
+type NetworkAuthChallengeSource string
+
+const (
+	NetworkAuthChallengeSourceServer NetworkAuthChallengeSource = "Server"
+	NetworkAuthChallengeSourceProxy  NetworkAuthChallengeSource = "Proxy"
+)
+
+// Valid returns true if the value is one of the NetworkAuthChallengeSource enum values.
+func (e NetworkAuthChallengeSource) Valid() bool {
+	switch e {
+	case NetworkAuthChallengeSourceServer,
+		NetworkAuthChallengeSourceProxy:
+		return true
+	}
+	return false
+}
+
+// The decision on what to do in response to the authorization challenge.  Default means deferring to the default behavior of the net stack, which will likely either the Cancel authentication or display a popup dialog box.
+type NetworkAuthChallengeResponseResponse string
+
+const (
+	NetworkAuthChallengeResponseResponseDefault            NetworkAuthChallengeResponseResponse = "Default"
+	NetworkAuthChallengeResponseResponseCancelAuth         NetworkAuthChallengeResponseResponse = "CancelAuth"
+	NetworkAuthChallengeResponseResponseProvideCredentials NetworkAuthChallengeResponseResponse = "ProvideCredentials"
+)
+
+// Valid returns true if the value is one of the NetworkAuthChallengeResponseResponse enum values.
+func (e NetworkAuthChallengeResponseResponse) Valid() bool {
+	switch e {
+	case NetworkAuthChallengeResponseResponseDefault,
+		NetworkAuthChallengeResponseResponseCancelAuth,
+		NetworkAuthChallengeResponseResponseProvideCredentials:
+		return true
+	}
+	return false
+}
+
+// Stages of the interception to begin intercepting. Request will intercept before the request is sent. Response will intercept after the response is received.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkInterceptionStage string
+
+const (
+	NetworkInterceptionStageRequest         NetworkInterceptionStage = "Request"
+	NetworkInterceptionStageHeadersReceived NetworkInterceptionStage = "HeadersReceived"
+)
+
+// Valid returns true if the value is one of the NetworkInterceptionStage enum values.
+func (e NetworkInterceptionStage) Valid() bool {
+	switch e {
+	case NetworkInterceptionStageRequest,
+		NetworkInterceptionStageHeadersReceived:
+		return true
+	}
+	return false
+}
+
+// Field type for a signed exchange related error.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkSignedExchangeErrorField string
+
+const (
+	NetworkSignedExchangeErrorFieldSignatureSig         NetworkSignedExchangeErrorField = "signatureSig"
+	NetworkSignedExchangeErrorFieldSignatureIntegrity   NetworkSignedExchangeErrorField = "signatureIntegrity"
+	NetworkSignedExchangeErrorFieldSignatureCertUrl     NetworkSignedExchangeErrorField = "signatureCertUrl"
+	NetworkSignedExchangeErrorFieldSignatureCertSha256  NetworkSignedExchangeErrorField = "signatureCertSha256"
+	NetworkSignedExchangeErrorFieldSignatureValidityUrl NetworkSignedExchangeErrorField = "signatureValidityUrl"
+	NetworkSignedExchangeErrorFieldSignatureTimestamps  NetworkSignedExchangeErrorField = "signatureTimestamps"
+)
+
+// Valid returns true if the value is one of the NetworkSignedExchangeErrorField enum values.
+func (e NetworkSignedExchangeErrorField) Valid() bool {
+	switch e {
+	case NetworkSignedExchangeErrorFieldSignatureSig,
+		NetworkSignedExchangeErrorFieldSignatureIntegrity,
+		NetworkSignedExchangeErrorFieldSignatureCertUrl,
+		NetworkSignedExchangeErrorFieldSignatureCertSha256,
+		NetworkSignedExchangeErrorFieldSignatureValidityUrl,
+		NetworkSignedExchangeErrorFieldSignatureTimestamps:
+		return true
+	}
+	return false
+}
+
+// List of content encodings supported by the backend.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkContentEncoding string
+
+const (
+	NetworkContentEncodingDeflate NetworkContentEncoding = "deflate"
+	NetworkContentEncodingGzip    NetworkContentEncoding = "gzip"
+	NetworkContentEncodingBr      NetworkContentEncoding = "br"
+	NetworkContentEncodingZstd    NetworkContentEncoding = "zstd"
+)
+
+// Valid returns true if the value is one of the NetworkContentEncoding enum values.
+func (e NetworkContentEncoding) Valid() bool {
+	switch e {
+	case NetworkContentEncodingDeflate,
+		NetworkContentEncodingGzip,
+		NetworkContentEncodingBr,
+		NetworkContentEncodingZstd:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectSocketDnsQueryType string
+
+const (
+	NetworkDirectSocketDnsQueryTypeIpv4 NetworkDirectSocketDnsQueryType = "ipv4"
+	NetworkDirectSocketDnsQueryTypeIpv6 NetworkDirectSocketDnsQueryType = "ipv6"
+)
+
+// Valid returns true if the value is one of the NetworkDirectSocketDnsQueryType enum values.
+func (e NetworkDirectSocketDnsQueryType) Valid() bool {
+	switch e {
+	case NetworkDirectSocketDnsQueryTypeIpv4,
+		NetworkDirectSocketDnsQueryTypeIpv6:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkPrivateNetworkRequestPolicy string
+
+const (
+	NetworkPrivateNetworkRequestPolicyAllow                          NetworkPrivateNetworkRequestPolicy = "Allow"
+	NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate NetworkPrivateNetworkRequestPolicy = "BlockFromInsecureToMorePrivate"
+	NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate  NetworkPrivateNetworkRequestPolicy = "WarnFromInsecureToMorePrivate"
+	NetworkPrivateNetworkRequestPolicyPreflightBlock                 NetworkPrivateNetworkRequestPolicy = "PreflightBlock"
+	NetworkPrivateNetworkRequestPolicyPreflightWarn                  NetworkPrivateNetworkRequestPolicy = "PreflightWarn"
+	NetworkPrivateNetworkRequestPolicyPermissionBlock                NetworkPrivateNetworkRequestPolicy = "PermissionBlock"
+	NetworkPrivateNetworkRequestPolicyPermissionWarn                 NetworkPrivateNetworkRequestPolicy = "PermissionWarn"
+)
+
+// Valid returns true if the value is one of the NetworkPrivateNetworkRequestPolicy enum values.
+func (e NetworkPrivateNetworkRequestPolicy) Valid() bool {
+	switch e {
+	case NetworkPrivateNetworkRequestPolicyAllow,
+		NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate,
+		NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate,
+		NetworkPrivateNetworkRequestPolicyPreflightBlock,
+		NetworkPrivateNetworkRequestPolicyPreflightWarn,
+		NetworkPrivateNetworkRequestPolicyPermissionBlock,
+		NetworkPrivateNetworkRequestPolicyPermissionWarn:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkIPAddressSpace string
+
+const (
+	NetworkIPAddressSpaceLoopback NetworkIPAddressSpace = "Loopback"
+	NetworkIPAddressSpaceLocal    NetworkIPAddressSpace = "Local"
+	NetworkIPAddressSpacePublic   NetworkIPAddressSpace = "Public"
+	NetworkIPAddressSpaceUnknown  NetworkIPAddressSpace = "Unknown"
+)
+
+// Valid returns true if the value is one of the NetworkIPAddressSpace enum values.
+func (e NetworkIPAddressSpace) Valid() bool {
+	switch e {
+	case NetworkIPAddressSpaceLoopback,
+		NetworkIPAddressSpaceLocal,
+		NetworkIPAddressSpacePublic,
+		NetworkIPAddressSpaceUnknown:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkCrossOriginOpenerPolicyValue string
+
+const (
+	NetworkCrossOriginOpenerPolicyValueSameOrigin                 NetworkCrossOriginOpenerPolicyValue = "SameOrigin"
+	NetworkCrossOriginOpenerPolicyValueSameOriginAllowPopups      NetworkCrossOriginOpenerPolicyValue = "SameOriginAllowPopups"
+	NetworkCrossOriginOpenerPolicyValueRestrictProperties         NetworkCrossOriginOpenerPolicyValue = "RestrictProperties"
+	NetworkCrossOriginOpenerPolicyValueUnsafeNone                 NetworkCrossOriginOpenerPolicyValue = "UnsafeNone"
+	NetworkCrossOriginOpenerPolicyValueSameOriginPlusCoep         NetworkCrossOriginOpenerPolicyValue = "SameOriginPlusCoep"
+	NetworkCrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep NetworkCrossOriginOpenerPolicyValue = "RestrictPropertiesPlusCoep"
+	NetworkCrossOriginOpenerPolicyValueNoopenerAllowPopups        NetworkCrossOriginOpenerPolicyValue = "NoopenerAllowPopups"
+)
+
+// Valid returns true if the value is one of the NetworkCrossOriginOpenerPolicyValue enum values.
+func (e NetworkCrossOriginOpenerPolicyValue) Valid() bool {
+	switch e {
+	case NetworkCrossOriginOpenerPolicyValueSameOrigin,
+		NetworkCrossOriginOpenerPolicyValueSameOriginAllowPopups,
+		NetworkCrossOriginOpenerPolicyValueRestrictProperties,
+		NetworkCrossOriginOpenerPolicyValueUnsafeNone,
+		NetworkCrossOriginOpenerPolicyValueSameOriginPlusCoep,
+		NetworkCrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep,
+		NetworkCrossOriginOpenerPolicyValueNoopenerAllowPopups:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkCrossOriginEmbedderPolicyValue string
+
+const (
+	NetworkCrossOriginEmbedderPolicyValueNone           NetworkCrossOriginEmbedderPolicyValue = "None"
+	NetworkCrossOriginEmbedderPolicyValueCredentialless NetworkCrossOriginEmbedderPolicyValue = "Credentialless"
+	NetworkCrossOriginEmbedderPolicyValueRequireCorp    NetworkCrossOriginEmbedderPolicyValue = "RequireCorp"
+)
+
+// Valid returns true if the value is one of the NetworkCrossOriginEmbedderPolicyValue enum values.
+func (e NetworkCrossOriginEmbedderPolicyValue) Valid() bool {
+	switch e {
+	case NetworkCrossOriginEmbedderPolicyValueNone,
+		NetworkCrossOriginEmbedderPolicyValueCredentialless,
+		NetworkCrossOriginEmbedderPolicyValueRequireCorp:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkContentSecurityPolicySource string
+
+const (
+	NetworkContentSecurityPolicySourceHTTP NetworkContentSecurityPolicySource = "HTTP"
+	NetworkContentSecurityPolicySourceMeta NetworkContentSecurityPolicySource = "Meta"
+)
+
+// Valid returns true if the value is one of the NetworkContentSecurityPolicySource enum values.
+func (e NetworkContentSecurityPolicySource) Valid() bool {
+	switch e {
+	case NetworkContentSecurityPolicySourceHTTP,
+		NetworkContentSecurityPolicySourceMeta:
+		return true
+	}
+	return false
+}
+
+// The status of a Reporting API report.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkReportStatus string
+
+const (
+	NetworkReportStatusQueued           NetworkReportStatus = "Queued"
+	NetworkReportStatusPending          NetworkReportStatus = "Pending"
+	NetworkReportStatusMarkedForRemoval NetworkReportStatus = "MarkedForRemoval"
+	NetworkReportStatusSuccess          NetworkReportStatus = "Success"
+)
+
+// Valid returns true if the value is one of the NetworkReportStatus enum values.
+func (e NetworkReportStatus) Valid() bool {
+	switch e {
+	case NetworkReportStatusQueued,
+		NetworkReportStatusPending,
+		NetworkReportStatusMarkedForRemoval,
+		NetworkReportStatusSuccess:
+		return true
+	}
+	return false
+}
+
+// Detailed success or error status of the operation. 'AlreadyExists' also signifies a successful operation, as the result of the operation already exists und thus, the operation was abort preemptively (e.g. a cache hit).
+type NetworkTrustTokenOperationDoneStatus string
+
+const (
+	NetworkTrustTokenOperationDoneStatusOk                 NetworkTrustTokenOperationDoneStatus = "Ok"
+	NetworkTrustTokenOperationDoneStatusInvalidArgument    NetworkTrustTokenOperationDoneStatus = "InvalidArgument"
+	NetworkTrustTokenOperationDoneStatusMissingIssuerKeys  NetworkTrustTokenOperationDoneStatus = "MissingIssuerKeys"
+	NetworkTrustTokenOperationDoneStatusFailedPrecondition NetworkTrustTokenOperationDoneStatus = "FailedPrecondition"
+	NetworkTrustTokenOperationDoneStatusResourceExhausted  NetworkTrustTokenOperationDoneStatus = "ResourceExhausted"
+	NetworkTrustTokenOperationDoneStatusAlreadyExists      NetworkTrustTokenOperationDoneStatus = "AlreadyExists"
+	NetworkTrustTokenOperationDoneStatusResourceLimited    NetworkTrustTokenOperationDoneStatus = "ResourceLimited"
+	NetworkTrustTokenOperationDoneStatusUnauthorized       NetworkTrustTokenOperationDoneStatus = "Unauthorized"
+	NetworkTrustTokenOperationDoneStatusBadResponse        NetworkTrustTokenOperationDoneStatus = "BadResponse"
+	NetworkTrustTokenOperationDoneStatusInternalError      NetworkTrustTokenOperationDoneStatus = "InternalError"
+	NetworkTrustTokenOperationDoneStatusUnknownError       NetworkTrustTokenOperationDoneStatus = "UnknownError"
+	NetworkTrustTokenOperationDoneStatusFulfilledLocally   NetworkTrustTokenOperationDoneStatus = "FulfilledLocally"
+	NetworkTrustTokenOperationDoneStatusSiteIssuerLimit    NetworkTrustTokenOperationDoneStatus = "SiteIssuerLimit"
+)
+
+// Valid returns true if the value is one of the NetworkTrustTokenOperationDoneStatus enum values.
+func (e NetworkTrustTokenOperationDoneStatus) Valid() bool {
+	switch e {
+	case NetworkTrustTokenOperationDoneStatusOk,
+		NetworkTrustTokenOperationDoneStatusInvalidArgument,
+		NetworkTrustTokenOperationDoneStatusMissingIssuerKeys,
+		NetworkTrustTokenOperationDoneStatusFailedPrecondition,
+		NetworkTrustTokenOperationDoneStatusResourceExhausted,
+		NetworkTrustTokenOperationDoneStatusAlreadyExists,
+		NetworkTrustTokenOperationDoneStatusResourceLimited,
+		NetworkTrustTokenOperationDoneStatusUnauthorized,
+		NetworkTrustTokenOperationDoneStatusBadResponse,
+		NetworkTrustTokenOperationDoneStatusInternalError,
+		NetworkTrustTokenOperationDoneStatusUnknownError,
+		NetworkTrustTokenOperationDoneStatusFulfilledLocally,
+		NetworkTrustTokenOperationDoneStatusSiteIssuerLimit:
+		return true
+	}
+	return false
+}
+
 // Timing information for the request.
 type NetworkResourceTiming struct {
-	RequestTime              float64 `json:"requestTime"`              // Timing's requestTime is a baseline in seconds, while the other numbers are ticks in milliseconds relatively to this requestTime.
-	ProxyStart               float64 `json:"proxyStart"`               // Started resolving proxy.
-	ProxyEnd                 float64 `json:"proxyEnd"`                 // Finished resolving proxy.
-	DnsStart                 float64 `json:"dnsStart"`                 // Started DNS address resolve.
-	DnsEnd                   float64 `json:"dnsEnd"`                   // Finished DNS address resolve.
-	ConnectStart             float64 `json:"connectStart"`             // Started connecting to the remote host.
-	ConnectEnd               float64 `json:"connectEnd"`               // Connected to the remote host.
-	SslStart                 float64 `json:"sslStart"`                 // Started SSL handshake.
-	SslEnd                   float64 `json:"sslEnd"`                   // Finished SSL handshake.
-	WorkerStart              float64 `json:"workerStart"`              // Started running ServiceWorker.
-	WorkerReady              float64 `json:"workerReady"`              // Finished Starting ServiceWorker.
-	WorkerFetchStart         float64 `json:"workerFetchStart"`         // Started fetch event.
-	WorkerRespondWithSettled float64 `json:"workerRespondWithSettled"` // Settled fetch event respondWith promise.
-	SendStart                float64 `json:"sendStart"`                // Started sending request.
-	SendEnd                  float64 `json:"sendEnd"`                  // Finished sending request.
-	PushStart                float64 `json:"pushStart"`                // Time the server started pushing request.
-	PushEnd                  float64 `json:"pushEnd"`                  // Time the server finished pushing request.
-	ReceiveHeadersEnd        float64 `json:"receiveHeadersEnd"`        // Finished receiving response headers.
+	RequestTime                 float64 `json:"requestTime"`                           // Timing's requestTime is a baseline in seconds, while the other numbers are ticks in milliseconds relatively to this requestTime.
+	ProxyStart                  float64 `json:"proxyStart"`                            // Started resolving proxy.
+	ProxyEnd                    float64 `json:"proxyEnd"`                              // Finished resolving proxy.
+	DnsStart                    float64 `json:"dnsStart"`                              // Started DNS address resolve.
+	DnsEnd                      float64 `json:"dnsEnd"`                                // Finished DNS address resolve.
+	ConnectStart                float64 `json:"connectStart"`                          // Started connecting to the remote host.
+	ConnectEnd                  float64 `json:"connectEnd"`                            // Connected to the remote host.
+	SslStart                    float64 `json:"sslStart"`                              // Started SSL handshake.
+	SslEnd                      float64 `json:"sslEnd"`                                // Finished SSL handshake.
+	WorkerStart                 float64 `json:"workerStart"`                           // Started running ServiceWorker.
+	WorkerReady                 float64 `json:"workerReady"`                           // Finished Starting ServiceWorker.
+	WorkerFetchStart            float64 `json:"workerFetchStart"`                      // Started fetch event.
+	WorkerRespondWithSettled    float64 `json:"workerRespondWithSettled"`              // Settled fetch event respondWith promise.
+	WorkerRouterEvaluationStart float64 `json:"workerRouterEvaluationStart,omitempty"` // Started ServiceWorker static routing source evaluation.
+	WorkerCacheLookupStart      float64 `json:"workerCacheLookupStart,omitempty"`      // Started cache lookup when the source was evaluated to `cache`.
+	SendStart                   float64 `json:"sendStart"`                             // Started sending request.
+	SendEnd                     float64 `json:"sendEnd"`                               // Finished sending request.
+	PushStart                   float64 `json:"pushStart"`                             // Time the server started pushing request.
+	PushEnd                     float64 `json:"pushEnd"`                               // Time the server finished pushing request.
+	ReceiveHeadersStart         float64 `json:"receiveHeadersStart"`                   // Started receiving response headers.
+	ReceiveHeadersEnd           float64 `json:"receiveHeadersEnd"`                     // Finished receiving response headers.
 }
 
 // Post data entry for HTTP request
@@ -38,19 +1051,20 @@ type NetworkPostDataEntry struct {
 
 // HTTP request data.
 type NetworkRequest struct {
-	Url              string                   `json:"url"`                        // Request URL (without fragment).
-	UrlFragment      string                   `json:"urlFragment,omitempty"`      // Fragment of the requested URL starting with hash, if present.
-	Method           string                   `json:"method"`                     // HTTP request method.
-	Headers          map[string]interface{}   `json:"headers"`                    // HTTP request headers.
-	PostData         string                   `json:"postData,omitempty"`         // HTTP POST request data.
-	HasPostData      bool                     `json:"hasPostData,omitempty"`      // True when the request has POST data. Note that postData might still be omitted when this flag is true when the data is too long.
-	PostDataEntries  []*NetworkPostDataEntry  `json:"postDataEntries,omitempty"`  // Request body elements. This will be converted from base64 to binary
-	MixedContentType string                   `json:"mixedContentType,omitempty"` // The mixed content type of the request. enum values: blockable, optionally-blockable, none
-	InitialPriority  string                   `json:"initialPriority"`            // Priority of the resource request at the time request is sent. enum values: VeryLow, Low, Medium, High, VeryHigh
-	ReferrerPolicy   string                   `json:"referrerPolicy"`             // The referrer policy of the request, as defined in https://www.w3.org/TR/referrer-policy/
-	IsLinkPreload    bool                     `json:"isLinkPreload,omitempty"`    // Whether is loaded via link preload.
-	TrustTokenParams *NetworkTrustTokenParams `json:"trustTokenParams,omitempty"` // Set for requests when the TrustToken API is used. Contains the parameters passed by the developer (e.g. via "fetch") as understood by the backend.
-	IsSameSite       bool                     `json:"isSameSite,omitempty"`       // True if this resource request is considered to be the 'same site' as the request correspondinfg to the main frame.
+	Url         string                 `json:"url"`                   // Request URL (without fragment).
+	UrlFragment string                 `json:"urlFragment,omitempty"` // Fragment of the requested URL starting with hash, if present.
+	Method      string                 `json:"method"`                // HTTP request method.
+	Headers     map[string]interface{} `json:"headers"`               // HTTP request headers.
+	// Deprecated: this field is deprecated in the protocol.
+	PostData         string                       `json:"postData,omitempty"`         // HTTP POST request data. Use postDataEntries instead.
+	HasPostData      bool                         `json:"hasPostData,omitempty"`      // True when the request has POST data. Note that postData might still be omitted when this flag is true when the data is too long.
+	PostDataEntries  []*NetworkPostDataEntry      `json:"postDataEntries,omitempty"`  // Request body elements (post data broken into individual entries).
+	MixedContentType SecurityMixedContentType     `json:"mixedContentType,omitempty"` // The mixed content type of the request. enum values: blockable, optionally-blockable, none
+	InitialPriority  NetworkResourcePriority      `json:"initialPriority"`            // Priority of the resource request at the time request is sent. enum values: VeryLow, Low, Medium, High, VeryHigh
+	ReferrerPolicy   NetworkRequestReferrerPolicy `json:"referrerPolicy"`             // The referrer policy of the request, as defined in https://www.w3.org/TR/referrer-policy/
+	IsLinkPreload    bool                         `json:"isLinkPreload,omitempty"`    // Whether is loaded via link preload.
+	TrustTokenParams *NetworkTrustTokenParams     `json:"trustTokenParams,omitempty"` // Set for requests when the TrustToken API is used. Contains the parameters passed by the developer (e.g. via "fetch") as understood by the backend.
+	IsSameSite       bool                         `json:"isSameSite,omitempty"`       // True if this resource request is considered to be the 'same site' as the request corresponding to the main frame.
 }
 
 // Details of a signed certificate timestamp (SCT).
@@ -67,62 +1081,79 @@ type NetworkSignedCertificateTimestamp struct {
 
 // Security details about a request.
 type NetworkSecurityDetails struct {
-	Protocol                          string                               `json:"protocol"`                           // Protocol name (e.g. "TLS 1.2" or "QUIC").
-	KeyExchange                       string                               `json:"keyExchange"`                        // Key Exchange used by the connection, or the empty string if not applicable.
-	KeyExchangeGroup                  string                               `json:"keyExchangeGroup,omitempty"`         // (EC)DH group used by the connection, if applicable.
-	Cipher                            string                               `json:"cipher"`                             // Cipher name.
-	Mac                               string                               `json:"mac,omitempty"`                      // TLS MAC. Note that AEAD ciphers do not have separate MACs.
-	CertificateId                     int                                  `json:"certificateId"`                      // Certificate ID value.
-	SubjectName                       string                               `json:"subjectName"`                        // Certificate subject name.
-	SanList                           []string                             `json:"sanList"`                            // Subject Alternative Name (SAN) DNS names and IP addresses.
-	Issuer                            string                               `json:"issuer"`                             // Name of the issuing CA.
-	ValidFrom                         float64                              `json:"validFrom"`                          // Certificate valid from date.
-	ValidTo                           float64                              `json:"validTo"`                            // Certificate valid to (expiration) date
-	SignedCertificateTimestampList    []*NetworkSignedCertificateTimestamp `json:"signedCertificateTimestampList"`     // List of signed certificate timestamps (SCTs).
-	CertificateTransparencyCompliance string                               `json:"certificateTransparencyCompliance"`  // Whether the request complied with Certificate Transparency policy enum values: unknown, not-compliant, compliant
-	ServerSignatureAlgorithm          int                                  `json:"serverSignatureAlgorithm,omitempty"` // The signature algorithm used by the server in the TLS server signature, represented as a TLS SignatureScheme code point. Omitted if not applicable or not known.
-	EncryptedClientHello              bool                                 `json:"encryptedClientHello"`               // Whether the connection used Encrypted ClientHello
+	Protocol                          string                                   `json:"protocol"`                           // Protocol name (e.g. "TLS 1.2" or "QUIC").
+	KeyExchange                       string                                   `json:"keyExchange"`                        // Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchangeGroup                  string                                   `json:"keyExchangeGroup,omitempty"`         // (EC)DH group used by the connection, if applicable.
+	Cipher                            string                                   `json:"cipher"`                             // Cipher name.
+	Mac                               string                                   `json:"mac,omitempty"`                      // TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	CertificateId                     int                                      `json:"certificateId"`                      // Certificate ID value.
+	SubjectName                       string                                   `json:"subjectName"`                        // Certificate subject name.
+	SanList                           []string                                 `json:"sanList"`                            // Subject Alternative Name (SAN) DNS names and IP addresses.
+	Issuer                            string                                   `json:"issuer"`                             // Name of the issuing CA.
+	ValidFrom                         float64                                  `json:"validFrom"`                          // Certificate valid from date.
+	ValidTo                           float64                                  `json:"validTo"`                            // Certificate valid to (expiration) date
+	SignedCertificateTimestampList    []*NetworkSignedCertificateTimestamp     `json:"signedCertificateTimestampList"`     // List of signed certificate timestamps (SCTs).
+	CertificateTransparencyCompliance NetworkCertificateTransparencyCompliance `json:"certificateTransparencyCompliance"`  // Whether the request complied with Certificate Transparency policy enum values: unknown, not-compliant, compliant
+	ServerSignatureAlgorithm          int                                      `json:"serverSignatureAlgorithm,omitempty"` // The signature algorithm used by the server in the TLS server signature, represented as a TLS SignatureScheme code point. Omitted if not applicable or not known.
+	EncryptedClientHello              bool                                     `json:"encryptedClientHello"`               // Whether the connection used Encrypted ClientHello
 }
 
 // No Description.
 type NetworkCorsErrorStatus struct {
-	CorsError       string `json:"corsError"`       //  enum values: DisallowedByMode, InvalidResponse, WildcardOriginNotAllowed, MissingAllowOriginHeader, MultipleAllowOriginValues, InvalidAllowOriginValue, AllowOriginMismatch, InvalidAllowCredentials, CorsDisabledScheme, PreflightInvalidStatus, PreflightDisallowedRedirect, PreflightWildcardOriginNotAllowed, PreflightMissingAllowOriginHeader, PreflightMultipleAllowOriginValues, PreflightInvalidAllowOriginValue, PreflightAllowOriginMismatch, PreflightInvalidAllowCredentials, PreflightMissingAllowExternal, PreflightInvalidAllowExternal, PreflightMissingAllowPrivateNetwork, PreflightInvalidAllowPrivateNetwork, InvalidAllowMethodsPreflightResponse, InvalidAllowHeadersPreflightResponse, MethodDisallowedByPreflightResponse, HeaderDisallowedByPreflightResponse, RedirectContainsCredentials, InsecurePrivateNetwork, InvalidPrivateNetworkAccess, UnexpectedPrivateNetworkAccess, NoCorsRedirectModeNotFollow
-	FailedParameter string `json:"failedParameter"` //
+	CorsError       NetworkCorsError `json:"corsError"`       //  enum values: DisallowedByMode, InvalidResponse, WildcardOriginNotAllowed, MissingAllowOriginHeader, MultipleAllowOriginValues, InvalidAllowOriginValue, AllowOriginMismatch, InvalidAllowCredentials, CorsDisabledScheme, PreflightInvalidStatus, PreflightDisallowedRedirect, PreflightWildcardOriginNotAllowed, PreflightMissingAllowOriginHeader, PreflightMultipleAllowOriginValues, PreflightInvalidAllowOriginValue, PreflightAllowOriginMismatch, PreflightInvalidAllowCredentials, PreflightMissingAllowExternal, PreflightInvalidAllowExternal, PreflightMissingAllowPrivateNetwork, PreflightInvalidAllowPrivateNetwork, InvalidAllowMethodsPreflightResponse, InvalidAllowHeadersPreflightResponse, MethodDisallowedByPreflightResponse, HeaderDisallowedByPreflightResponse, RedirectContainsCredentials, InsecurePrivateNetwork, InvalidPrivateNetworkAccess, UnexpectedPrivateNetworkAccess, NoCorsRedirectModeNotFollow, PreflightMissingPrivateNetworkAccessId, PreflightMissingPrivateNetworkAccessName, PrivateNetworkAccessPermissionUnavailable, PrivateNetworkAccessPermissionDenied, LocalNetworkAccessPermissionDenied
+	FailedParameter string           `json:"failedParameter"` //
 }
 
 // Determines what type of Trust Token operation is executed and depending on the type, some additional parameters. The values are specified in third_party/blink/renderer/core/fetch/trust_token.idl.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkTrustTokenParams struct {
-	Operation     string   `json:"operation"`         //  enum values: Issuance, Redemption, Signing
-	RefreshPolicy string   `json:"refreshPolicy"`     // Only set for "token-redemption" operation and determine whether to request a fresh SRR or use a still valid cached SRR.
-	Issuers       []string `json:"issuers,omitempty"` // Origins of issuers from whom to request tokens or redemption records.
+	Operation     NetworkTrustTokenOperationType       `json:"operation"`         //  enum values: Issuance, Redemption, Signing
+	RefreshPolicy NetworkTrustTokenParamsRefreshPolicy `json:"refreshPolicy"`     // Only set for "token-redemption" operation and determine whether to request a fresh SRR or use a still valid cached SRR.
+	Issuers       []string                             `json:"issuers,omitempty"` // Origins of issuers from whom to request tokens or redemption records.
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkServiceWorkerRouterInfo struct {
+	RuleIdMatched     int                              `json:"ruleIdMatched,omitempty"`     // ID of the rule matched. If there is a matched rule, this field will be set, otherwiser no value will be set.
+	MatchedSourceType NetworkServiceWorkerRouterSource `json:"matchedSourceType,omitempty"` // The router source of the matched rule. If there is a matched rule, this field will be set, otherwise no value will be set. enum values: network, cache, fetch-event, race-network-and-fetch-handler, race-network-and-cache
+	ActualSourceType  NetworkServiceWorkerRouterSource `json:"actualSourceType,omitempty"`  // The actual router source used. enum values: network, cache, fetch-event, race-network-and-fetch-handler, race-network-and-cache
 }
 
 // HTTP response data.
 type NetworkResponse struct {
-	Url                         string                  `json:"url"`                                   // Response URL. This URL can be different from CachedResource.url in case of redirect.
-	Status                      int                     `json:"status"`                                // HTTP response status code.
-	StatusText                  string                  `json:"statusText"`                            // HTTP response status text.
-	Headers                     map[string]interface{}  `json:"headers"`                               // HTTP response headers.
-	HeadersText                 string                  `json:"headersText,omitempty"`                 // HTTP response headers text. This has been replaced by the headers in Network.responseReceivedExtraInfo.
-	MimeType                    string                  `json:"mimeType"`                              // Resource mimeType as determined by the browser.
-	RequestHeaders              map[string]interface{}  `json:"requestHeaders,omitempty"`              // Refined HTTP request headers that were actually transmitted over the network.
-	RequestHeadersText          string                  `json:"requestHeadersText,omitempty"`          // HTTP request headers text. This has been replaced by the headers in Network.requestWillBeSentExtraInfo.
-	ConnectionReused            bool                    `json:"connectionReused"`                      // Specifies whether physical connection was actually reused for this request.
-	ConnectionId                float64                 `json:"connectionId"`                          // Physical connection id that was actually used for this request.
-	RemoteIPAddress             string                  `json:"remoteIPAddress,omitempty"`             // Remote IP address.
-	RemotePort                  int                     `json:"remotePort,omitempty"`                  // Remote port.
-	FromDiskCache               bool                    `json:"fromDiskCache,omitempty"`               // Specifies that the request was served from the disk cache.
-	FromServiceWorker           bool                    `json:"fromServiceWorker,omitempty"`           // Specifies that the request was served from the ServiceWorker.
-	FromPrefetchCache           bool                    `json:"fromPrefetchCache,omitempty"`           // Specifies that the request was served from the prefetch cache.
-	EncodedDataLength           float64                 `json:"encodedDataLength"`                     // Total number of bytes received for this request so far.
-	Timing                      *NetworkResourceTiming  `json:"timing,omitempty"`                      // Timing information for the given request.
-	ServiceWorkerResponseSource string                  `json:"serviceWorkerResponseSource,omitempty"` // Response source of response from ServiceWorker. enum values: cache-storage, http-cache, fallback-code, network
-	ResponseTime                float64                 `json:"responseTime,omitempty"`                // The time at which the returned response was generated.
-	CacheStorageCacheName       string                  `json:"cacheStorageCacheName,omitempty"`       // Cache Storage Cache Name.
-	Protocol                    string                  `json:"protocol,omitempty"`                    // Protocol used to fetch this request.
-	AlternateProtocolUsage      string                  `json:"alternateProtocolUsage,omitempty"`      // The reason why Chrome uses a specific transport protocol for HTTP semantics. enum values: alternativeJobWonWithoutRace, alternativeJobWonRace, mainJobWonRace, mappingMissing, broken, dnsAlpnH3JobWonWithoutRace, dnsAlpnH3JobWonRace, unspecifiedReason
-	SecurityState               string                  `json:"securityState"`                         // Security state of the request resource. enum values: unknown, neutral, insecure, secure, info, insecure-broken
-	SecurityDetails             *NetworkSecurityDetails `json:"securityDetails,omitempty"`             // Security details for the request.
+	Url        string                 `json:"url"`        // Response URL. This URL can be different from CachedResource.url in case of redirect.
+	Status     int                    `json:"status"`     // HTTP response status code.
+	StatusText string                 `json:"statusText"` // HTTP response status text.
+	Headers    map[string]interface{} `json:"headers"`    // HTTP response headers.
+	// Deprecated: this field is deprecated in the protocol.
+	HeadersText    string                 `json:"headersText,omitempty"`    // HTTP response headers text. This has been replaced by the headers in Network.responseReceivedExtraInfo.
+	MimeType       string                 `json:"mimeType"`                 // Resource mimeType as determined by the browser.
+	Charset        string                 `json:"charset"`                  // Resource charset as determined by the browser (if applicable).
+	RequestHeaders map[string]interface{} `json:"requestHeaders,omitempty"` // Refined HTTP request headers that were actually transmitted over the network.
+	// Deprecated: this field is deprecated in the protocol.
+	RequestHeadersText          string                             `json:"requestHeadersText,omitempty"`          // HTTP request headers text. This has been replaced by the headers in Network.requestWillBeSentExtraInfo.
+	ConnectionReused            bool                               `json:"connectionReused"`                      // Specifies whether physical connection was actually reused for this request.
+	ConnectionId                float64                            `json:"connectionId"`                          // Physical connection id that was actually used for this request.
+	RemoteIPAddress             string                             `json:"remoteIPAddress,omitempty"`             // Remote IP address.
+	RemotePort                  int                                `json:"remotePort,omitempty"`                  // Remote port.
+	FromDiskCache               bool                               `json:"fromDiskCache,omitempty"`               // Specifies that the request was served from the disk cache.
+	FromServiceWorker           bool                               `json:"fromServiceWorker,omitempty"`           // Specifies that the request was served from the ServiceWorker.
+	FromPrefetchCache           bool                               `json:"fromPrefetchCache,omitempty"`           // Specifies that the request was served from the prefetch cache.
+	FromEarlyHints              bool                               `json:"fromEarlyHints,omitempty"`              // Specifies that the request was served from the prefetch cache.
+	ServiceWorkerRouterInfo     *NetworkServiceWorkerRouterInfo    `json:"serviceWorkerRouterInfo,omitempty"`     // Information about how ServiceWorker Static Router API was used. If this field is set with `matchedSourceType` field, a matching rule is found. If this field is set without `matchedSource`, no matching rule is found. Otherwise, the API is not used.
+	EncodedDataLength           float64                            `json:"encodedDataLength"`                     // Total number of bytes received for this request so far.
+	Timing                      *NetworkResourceTiming             `json:"timing,omitempty"`                      // Timing information for the given request.
+	ServiceWorkerResponseSource NetworkServiceWorkerResponseSource `json:"serviceWorkerResponseSource,omitempty"` // Response source of response from ServiceWorker. enum values: cache-storage, http-cache, fallback-code, network
+	ResponseTime                float64                            `json:"responseTime,omitempty"`                // The time at which the returned response was generated.
+	CacheStorageCacheName       string                             `json:"cacheStorageCacheName,omitempty"`       // Cache Storage Cache Name.
+	Protocol                    string                             `json:"protocol,omitempty"`                    // Protocol used to fetch this request.
+	AlternateProtocolUsage      NetworkAlternateProtocolUsage      `json:"alternateProtocolUsage,omitempty"`      // The reason why Chrome uses a specific transport protocol for HTTP semantics. enum values: alternativeJobWonWithoutRace, alternativeJobWonRace, mainJobWonRace, mappingMissing, broken, dnsAlpnH3JobWonWithoutRace, dnsAlpnH3JobWonRace, unspecifiedReason
+	SecurityState               SecuritySecurityState              `json:"securityState"`                         // Security state of the request resource. enum values: unknown, neutral, insecure, secure, info, insecure-broken
+	SecurityDetails             *NetworkSecurityDetails            `json:"securityDetails,omitempty"`             // Security details for the request.
+	IsIpProtectionUsed          bool                               `json:"isIpProtectionUsed,omitempty"`          // Indicates whether the request was sent through IP Protection proxies. If set to true, the request used the IP Protection privacy feature.
 }
 
 // WebSocket request data.
@@ -149,96 +1180,127 @@ type NetworkWebSocketFrame struct {
 
 // Information about the cached resource.
 type NetworkCachedResource struct {
-	Url      string           `json:"url"`                // Resource URL. This is the url of the original network request.
-	Type     string           `json:"type"`               // Type of this resource. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
-	Response *NetworkResponse `json:"response,omitempty"` // Cached response data.
-	BodySize float64          `json:"bodySize"`           // Cached response body size.
+	Url      string              `json:"url"`                // Resource URL. This is the url of the original network request.
+	Type     NetworkResourceType `json:"type"`               // Type of this resource. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
+	Response *NetworkResponse    `json:"response,omitempty"` // Cached response data.
+	BodySize float64             `json:"bodySize"`           // Cached response body size.
 }
 
 // Information about the request initiator.
 type NetworkInitiator struct {
-	Type         string             `json:"type"`                   // Type of this initiator.
-	Stack        *RuntimeStackTrace `json:"stack,omitempty"`        // Initiator JavaScript stack trace, set for Script only.
-	Url          string             `json:"url,omitempty"`          // Initiator URL, set for Parser type or for Script type (when script is importing module) or for SignedExchange type.
-	LineNumber   float64            `json:"lineNumber,omitempty"`   // Initiator line number, set for Parser type or for Script type (when script is importing module) (0-based).
-	ColumnNumber float64            `json:"columnNumber,omitempty"` // Initiator column number, set for Parser type or for Script type (when script is importing module) (0-based).
-	RequestId    string             `json:"requestId,omitempty"`    // Set if another request triggered this request (e.g. preflight).
+	Type         NetworkInitiatorType `json:"type"`                   // Type of this initiator.
+	Stack        *RuntimeStackTrace   `json:"stack,omitempty"`        // Initiator JavaScript stack trace, set for Script only. Requires the Debugger domain to be enabled.
+	Url          string               `json:"url,omitempty"`          // Initiator URL, set for Parser type or for Script type (when script is importing module) or for SignedExchange type.
+	LineNumber   float64              `json:"lineNumber,omitempty"`   // Initiator line number, set for Parser type or for Script type (when script is importing module) (0-based).
+	ColumnNumber float64              `json:"columnNumber,omitempty"` // Initiator column number, set for Parser type or for Script type (when script is importing module) (0-based).
+	RequestId    string               `json:"requestId,omitempty"`    // Set if another request triggered this request (e.g. preflight).
+}
+
+// cookiePartitionKey object The representation of the components of the key that are created by the cookiePartitionKey class contained in net/cookies/cookie_partition_key.h.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkCookiePartitionKey struct {
+	TopLevelSite         string `json:"topLevelSite"`         // The site of the top-level URL the browser was visiting at the start of the request to the endpoint that set the cookie.
+	HasCrossSiteAncestor bool   `json:"hasCrossSiteAncestor"` // Indicates if the cookie has any ancestors that are cross-site to the topLevelSite.
 }
 
 // Cookie object
 type NetworkCookie struct {
-	Name               string  `json:"name"`                         // Cookie name.
-	Value              string  `json:"value"`                        // Cookie value.
-	Domain             string  `json:"domain"`                       // Cookie domain.
-	Path               string  `json:"path"`                         // Cookie path.
-	Expires            float64 `json:"expires"`                      // Cookie expiration date as the number of seconds since the UNIX epoch.
-	Size               int     `json:"size"`                         // Cookie size.
-	HttpOnly           bool    `json:"httpOnly"`                     // True if cookie is http-only.
-	Secure             bool    `json:"secure"`                       // True if cookie is secure.
-	Session            bool    `json:"session"`                      // True in case of session cookie.
-	SameSite           string  `json:"sameSite,omitempty"`           // Cookie SameSite type. enum values: Strict, Lax, None
-	Priority           string  `json:"priority"`                     // Cookie Priority enum values: Low, Medium, High
-	SameParty          bool    `json:"sameParty"`                    // True if cookie is SameParty.
-	SourceScheme       string  `json:"sourceScheme"`                 // Cookie source scheme type. enum values: Unset, NonSecure, Secure
-	SourcePort         int     `json:"sourcePort"`                   // Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
-	PartitionKey       string  `json:"partitionKey,omitempty"`       // Cookie partition key. The site of the top-level URL the browser was visiting at the start of the request to the endpoint that set the cookie.
-	PartitionKeyOpaque bool    `json:"partitionKeyOpaque,omitempty"` // True if cookie partition key is opaque.
+	Name     string                `json:"name"`               // Cookie name.
+	Value    string                `json:"value"`              // Cookie value.
+	Domain   string                `json:"domain"`             // Cookie domain.
+	Path     string                `json:"path"`               // Cookie path.
+	Expires  float64               `json:"expires"`            // Cookie expiration date as the number of seconds since the UNIX epoch.
+	Size     int                   `json:"size"`               // Cookie size.
+	HttpOnly bool                  `json:"httpOnly"`           // True if cookie is http-only.
+	Secure   bool                  `json:"secure"`             // True if cookie is secure.
+	Session  bool                  `json:"session"`            // True in case of session cookie.
+	SameSite NetworkCookieSameSite `json:"sameSite,omitempty"` // Cookie SameSite type. enum values: Strict, Lax, None
+	Priority NetworkCookiePriority `json:"priority"`           // Cookie Priority enum values: Low, Medium, High
+	// Deprecated: this field is deprecated in the protocol.
+	SameParty          bool                       `json:"sameParty"`                    // True if cookie is SameParty.
+	SourceScheme       NetworkCookieSourceScheme  `json:"sourceScheme"`                 // Cookie source scheme type. enum values: Unset, NonSecure, Secure
+	SourcePort         int                        `json:"sourcePort"`                   // Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
+	PartitionKey       *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`       // Cookie partition key.
+	PartitionKeyOpaque bool                       `json:"partitionKeyOpaque,omitempty"` // True if cookie partition key is opaque.
 }
 
 // A cookie which was not stored from a response with the corresponding reason.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkBlockedSetCookieWithReason struct {
-	BlockedReasons []string       `json:"blockedReasons"`   // The reason(s) this cookie was blocked. enum values: SecureOnly, SameSiteStrict, SameSiteLax, SameSiteUnspecifiedTreatedAsLax, SameSiteNoneInsecure, UserPreferences, ThirdPartyBlockedInFirstPartySet, SyntaxError, SchemeNotSupported, OverwriteSecure, InvalidDomain, InvalidPrefix, UnknownError, SchemefulSameSiteStrict, SchemefulSameSiteLax, SchemefulSameSiteUnspecifiedTreatedAsLax, SamePartyFromCrossPartyContext, SamePartyConflictsWithOtherAttributes, NameValuePairExceedsMaxSize
-	CookieLine     string         `json:"cookieLine"`       // The string representing this individual cookie as it would appear in the header. This is not the entire "cookie" or "set-cookie" header which could have multiple cookies.
-	Cookie         *NetworkCookie `json:"cookie,omitempty"` // The cookie object which represents the cookie which was not stored. It is optional because sometimes complete cookie information is not available, such as in the case of parsing errors.
+	BlockedReasons []NetworkSetCookieBlockedReason `json:"blockedReasons"`   // The reason(s) this cookie was blocked. enum values: SecureOnly, SameSiteStrict, SameSiteLax, SameSiteUnspecifiedTreatedAsLax, SameSiteNoneInsecure, UserPreferences, ThirdPartyPhaseout, ThirdPartyBlockedInFirstPartySet, SyntaxError, SchemeNotSupported, OverwriteSecure, InvalidDomain, InvalidPrefix, UnknownError, SchemefulSameSiteStrict, SchemefulSameSiteLax, SchemefulSameSiteUnspecifiedTreatedAsLax, SamePartyFromCrossPartyContext, SamePartyConflictsWithOtherAttributes, NameValuePairExceedsMaxSize, DisallowedCharacter, NoCookieContent
+	CookieLine     string                          `json:"cookieLine"`       // The string representing this individual cookie as it would appear in the header. This is not the entire "cookie" or "set-cookie" header which could have multiple cookies.
+	Cookie         *NetworkCookie                  `json:"cookie,omitempty"` // The cookie object which represents the cookie which was not stored. It is optional because sometimes complete cookie information is not available, such as in the case of parsing errors.
+}
+
+// A cookie should have been blocked by 3PCD but is exempted and stored from a response with the corresponding reason. A cookie could only have at most one exemption reason.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkExemptedSetCookieWithReason struct {
+	ExemptionReason NetworkCookieExemptionReason `json:"exemptionReason"` // The reason the cookie was exempted. enum values: None, UserSetting, TPCDMetadata, TPCDDeprecationTrial, TopLevelTPCDDeprecationTrial, TPCDHeuristics, EnterprisePolicy, StorageAccess, TopLevelStorageAccess, Scheme, SameSiteNoneCookiesInSandbox
+	CookieLine      string                       `json:"cookieLine"`      // The string representing this individual cookie as it would appear in the header.
+	Cookie          *NetworkCookie               `json:"cookie"`          // The cookie object representing the cookie.
 }
 
-// A cookie with was not sent with a request with the corresponding reason.
-type NetworkBlockedCookieWithReason struct {
-	BlockedReasons []string       `json:"blockedReasons"` // The reason(s) the cookie was blocked. enum values: SecureOnly, NotOnPath, DomainMismatch, SameSiteStrict, SameSiteLax, SameSiteUnspecifiedTreatedAsLax, SameSiteNoneInsecure, UserPreferences, ThirdPartyBlockedInFirstPartySet, UnknownError, SchemefulSameSiteStrict, SchemefulSameSiteLax, SchemefulSameSiteUnspecifiedTreatedAsLax, SamePartyFromCrossPartyContext, NameValuePairExceedsMaxSize
-	Cookie         *NetworkCookie `json:"cookie"`         // The cookie object representing the cookie which was not sent.
+// A cookie associated with the request which may or may not be sent with it. Includes the cookies itself and reasons for blocking or exemption.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkAssociatedCookie struct {
+	Cookie          *NetworkCookie               `json:"cookie"`                    // The cookie object representing the cookie which was not sent.
+	BlockedReasons  []NetworkCookieBlockedReason `json:"blockedReasons"`            // The reason(s) the cookie was blocked. If empty means the cookie is included. enum values: SecureOnly, NotOnPath, DomainMismatch, SameSiteStrict, SameSiteLax, SameSiteUnspecifiedTreatedAsLax, SameSiteNoneInsecure, UserPreferences, ThirdPartyPhaseout, ThirdPartyBlockedInFirstPartySet, UnknownError, SchemefulSameSiteStrict, SchemefulSameSiteLax, SchemefulSameSiteUnspecifiedTreatedAsLax, SamePartyFromCrossPartyContext, NameValuePairExceedsMaxSize, PortMismatch, SchemeMismatch, AnonymousContext
+	ExemptionReason NetworkCookieExemptionReason `json:"exemptionReason,omitempty"` // The reason the cookie should have been blocked by 3PCD but is exempted. A cookie could only have at most one exemption reason. enum values: None, UserSetting, TPCDMetadata, TPCDDeprecationTrial, TopLevelTPCDDeprecationTrial, TPCDHeuristics, EnterprisePolicy, StorageAccess, TopLevelStorageAccess, Scheme, SameSiteNoneCookiesInSandbox
 }
 
 // Cookie parameter object
 type NetworkCookieParam struct {
-	Name         string  `json:"name"`                   // Cookie name.
-	Value        string  `json:"value"`                  // Cookie value.
-	Url          string  `json:"url,omitempty"`          // The request-URI to associate with the setting of the cookie. This value can affect the default domain, path, source port, and source scheme values of the created cookie.
-	Domain       string  `json:"domain,omitempty"`       // Cookie domain.
-	Path         string  `json:"path,omitempty"`         // Cookie path.
-	Secure       bool    `json:"secure,omitempty"`       // True if cookie is secure.
-	HttpOnly     bool    `json:"httpOnly,omitempty"`     // True if cookie is http-only.
-	SameSite     string  `json:"sameSite,omitempty"`     // Cookie SameSite type. enum values: Strict, Lax, None
-	Expires      float64 `json:"expires,omitempty"`      // Cookie expiration date, session cookie if not set
-	Priority     string  `json:"priority,omitempty"`     // Cookie Priority. enum values: Low, Medium, High
-	SameParty    bool    `json:"sameParty,omitempty"`    // True if cookie is SameParty.
-	SourceScheme string  `json:"sourceScheme,omitempty"` // Cookie source scheme type. enum values: Unset, NonSecure, Secure
-	SourcePort   int     `json:"sourcePort,omitempty"`   // Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
-	PartitionKey string  `json:"partitionKey,omitempty"` // Cookie partition key. The site of the top-level URL the browser was visiting at the start of the request to the endpoint that set the cookie. If not set, the cookie will be set as not partitioned.
+	Name         string                     `json:"name"`                   // Cookie name.
+	Value        string                     `json:"value"`                  // Cookie value.
+	Url          string                     `json:"url,omitempty"`          // The request-URI to associate with the setting of the cookie. This value can affect the default domain, path, source port, and source scheme values of the created cookie.
+	Domain       string                     `json:"domain,omitempty"`       // Cookie domain.
+	Path         string                     `json:"path,omitempty"`         // Cookie path.
+	Secure       bool                       `json:"secure,omitempty"`       // True if cookie is secure.
+	HttpOnly     bool                       `json:"httpOnly,omitempty"`     // True if cookie is http-only.
+	SameSite     NetworkCookieSameSite      `json:"sameSite,omitempty"`     // Cookie SameSite type. enum values: Strict, Lax, None
+	Expires      float64                    `json:"expires,omitempty"`      // Cookie expiration date, session cookie if not set
+	Priority     NetworkCookiePriority      `json:"priority,omitempty"`     // Cookie Priority. enum values: Low, Medium, High
+	SameParty    bool                       `json:"sameParty,omitempty"`    // True if cookie is SameParty.
+	SourceScheme NetworkCookieSourceScheme  `json:"sourceScheme,omitempty"` // Cookie source scheme type. enum values: Unset, NonSecure, Secure
+	SourcePort   int                        `json:"sourcePort,omitempty"`   // Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"` // Cookie partition key. If not set, the cookie will be set as not partitioned.
 }
 
 // Authorization challenge for HTTP status code 401 or 407.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkAuthChallenge struct {
-	Source string `json:"source,omitempty"` // Source of the authentication challenge.
-	Origin string `json:"origin"`           // Origin of the challenger.
-	Scheme string `json:"scheme"`           // The authentication scheme used, such as basic or digest
-	Realm  string `json:"realm"`            // The realm of the challenge. May be empty.
+	Source NetworkAuthChallengeSource `json:"source,omitempty"` // Source of the authentication challenge.
+	Origin string                     `json:"origin"`           // Origin of the challenger.
+	Scheme string                     `json:"scheme"`           // The authentication scheme used, such as basic or digest
+	Realm  string                     `json:"realm"`            // The realm of the challenge. May be empty.
 }
 
 // Response to an AuthChallenge.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkAuthChallengeResponse struct {
-	Response string `json:"response"`           // The decision on what to do in response to the authorization challenge.  Default means deferring to the default behavior of the net stack, which will likely either the Cancel authentication or display a popup dialog box.
-	Username string `json:"username,omitempty"` // The username to provide, possibly empty. Should only be set if response is ProvideCredentials.
-	Password string `json:"password,omitempty"` // The password to provide, possibly empty. Should only be set if response is ProvideCredentials.
+	Response NetworkAuthChallengeResponseResponse `json:"response"`           // The decision on what to do in response to the authorization challenge.  Default means deferring to the default behavior of the net stack, which will likely either the Cancel authentication or display a popup dialog box.
+	Username string                               `json:"username,omitempty"` // The username to provide, possibly empty. Should only be set if response is ProvideCredentials.
+	Password string                               `json:"password,omitempty"` // The password to provide, possibly empty. Should only be set if response is ProvideCredentials.
 }
 
 // Request pattern for interception.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkRequestPattern struct {
-	UrlPattern        string `json:"urlPattern,omitempty"`        // Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is backslash. Omitting is equivalent to `"*"`.
-	ResourceType      string `json:"resourceType,omitempty"`      // If set, only requests for matching resource types will be intercepted. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
-	InterceptionStage string `json:"interceptionStage,omitempty"` // Stage at which to begin intercepting requests. Default is Request. enum values: Request, HeadersReceived
+	UrlPattern        string                   `json:"urlPattern,omitempty"`        // Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is backslash. Omitting is equivalent to `"*"`.
+	ResourceType      NetworkResourceType      `json:"resourceType,omitempty"`      // If set, only requests for matching resource types will be intercepted. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
+	InterceptionStage NetworkInterceptionStage `json:"interceptionStage,omitempty"` // Stage at which to begin intercepting requests. Default is Request. enum values: Request, HeadersReceived
 }
 
 // Information about a signed exchange signature. https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSignedExchangeSignature struct {
 	Label        string   `json:"label"`                  // Signed exchange signature label.
 	Signature    string   `json:"signature"`              // The hex string of signed exchange signature.
@@ -252,64 +1314,126 @@ type NetworkSignedExchangeSignature struct {
 }
 
 // Information about a signed exchange header. https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSignedExchangeHeader struct {
 	RequestUrl      string                            `json:"requestUrl"`      // Signed exchange request URL.
 	ResponseCode    int                               `json:"responseCode"`    // Signed exchange response code.
 	ResponseHeaders map[string]interface{}            `json:"responseHeaders"` // Signed exchange response headers.
 	Signatures      []*NetworkSignedExchangeSignature `json:"signatures"`      // Signed exchange response signature.
-	HeaderIntegrity string                            `json:"headerIntegrity"` // Signed exchange header integrity hash in the form of "sha256-<base64-hash-value>".
+	HeaderIntegrity string                            `json:"headerIntegrity"` // Signed exchange header integrity hash in the form of `sha256-<base64-hash-value>`.
 }
 
 // Information about a signed exchange response.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSignedExchangeError struct {
-	Message        string `json:"message"`                  // Error message.
-	SignatureIndex int    `json:"signatureIndex,omitempty"` // The index of the signature which caused the error.
-	ErrorField     string `json:"errorField,omitempty"`     // The field which caused the error. enum values: signatureSig, signatureIntegrity, signatureCertUrl, signatureCertSha256, signatureValidityUrl, signatureTimestamps
+	Message        string                          `json:"message"`                  // Error message.
+	SignatureIndex int                             `json:"signatureIndex,omitempty"` // The index of the signature which caused the error.
+	ErrorField     NetworkSignedExchangeErrorField `json:"errorField,omitempty"`     // The field which caused the error. enum values: signatureSig, signatureIntegrity, signatureCertUrl, signatureCertSha256, signatureValidityUrl, signatureTimestamps
 }
 
 // Information about a signed exchange response.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSignedExchangeInfo struct {
 	OuterResponse   *NetworkResponse              `json:"outerResponse"`             // The outer response of signed HTTP exchange which was received from network.
+	HasExtraInfo    bool                          `json:"hasExtraInfo"`              // Whether network response for the signed exchange was accompanied by extra headers.
 	Header          *NetworkSignedExchangeHeader  `json:"header,omitempty"`          // Information about the signed exchange header.
 	SecurityDetails *NetworkSecurityDetails       `json:"securityDetails,omitempty"` // Security details for the signed exchange header.
-	Errors          []*NetworkSignedExchangeError `json:"errors,omitempty"`          // Errors occurred while handling the signed exchagne.
+	Errors          []*NetworkSignedExchangeError `json:"errors,omitempty"`          // Errors occurred while handling the signed exchange.
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectTCPSocketOptions struct {
+	NoDelay           bool                            `json:"noDelay"`                     // TCP_NODELAY option
+	KeepAliveDelay    float64                         `json:"keepAliveDelay,omitempty"`    // Expected to be unsigned integer.
+	SendBufferSize    float64                         `json:"sendBufferSize,omitempty"`    // Expected to be unsigned integer.
+	ReceiveBufferSize float64                         `json:"receiveBufferSize,omitempty"` // Expected to be unsigned integer.
+	DnsQueryType      NetworkDirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`      //  enum values: ipv4, ipv6
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectUDPSocketOptions struct {
+	RemoteAddr        string                          `json:"remoteAddr,omitempty"`        //
+	RemotePort        int                             `json:"remotePort,omitempty"`        // Unsigned int 16.
+	LocalAddr         string                          `json:"localAddr,omitempty"`         //
+	LocalPort         int                             `json:"localPort,omitempty"`         // Unsigned int 16.
+	DnsQueryType      NetworkDirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`      //  enum values: ipv4, ipv6
+	SendBufferSize    float64                         `json:"sendBufferSize,omitempty"`    // Expected to be unsigned integer.
+	ReceiveBufferSize float64                         `json:"receiveBufferSize,omitempty"` // Expected to be unsigned integer.
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectUDPMessage struct {
+	Data       string `json:"data"`                 //
+	RemoteAddr string `json:"remoteAddr,omitempty"` // Null for connected mode.
+	RemotePort int    `json:"remotePort,omitempty"` // Null for connected mode. Expected to be unsigned integer.
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkConnectTiming struct {
 	RequestTime float64 `json:"requestTime"` // Timing's requestTime is a baseline in seconds, while the other numbers are ticks in milliseconds relatively to this requestTime. Matches ResourceTiming's requestTime for the same request (but not for redirected requests).
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkClientSecurityState struct {
-	InitiatorIsSecureContext    bool   `json:"initiatorIsSecureContext"`    //
-	InitiatorIPAddressSpace     string `json:"initiatorIPAddressSpace"`     //  enum values: Local, Private, Public, Unknown
-	PrivateNetworkRequestPolicy string `json:"privateNetworkRequestPolicy"` //  enum values: Allow, BlockFromInsecureToMorePrivate, WarnFromInsecureToMorePrivate, PreflightBlock, PreflightWarn
+	InitiatorIsSecureContext    bool                               `json:"initiatorIsSecureContext"`    //
+	InitiatorIPAddressSpace     NetworkIPAddressSpace              `json:"initiatorIPAddressSpace"`     //  enum values: Loopback, Local, Public, Unknown
+	PrivateNetworkRequestPolicy NetworkPrivateNetworkRequestPolicy `json:"privateNetworkRequestPolicy"` //  enum values: Allow, BlockFromInsecureToMorePrivate, WarnFromInsecureToMorePrivate, PreflightBlock, PreflightWarn, PermissionBlock, PermissionWarn
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkCrossOriginOpenerPolicyStatus struct {
-	Value                       string `json:"value"`                                 //  enum values: SameOrigin, SameOriginAllowPopups, RestrictProperties, UnsafeNone, SameOriginPlusCoep, RestrictPropertiesPlusCoep
-	ReportOnlyValue             string `json:"reportOnlyValue"`                       //  enum values: SameOrigin, SameOriginAllowPopups, RestrictProperties, UnsafeNone, SameOriginPlusCoep, RestrictPropertiesPlusCoep
-	ReportingEndpoint           string `json:"reportingEndpoint,omitempty"`           //
-	ReportOnlyReportingEndpoint string `json:"reportOnlyReportingEndpoint,omitempty"` //
+	Value                       NetworkCrossOriginOpenerPolicyValue `json:"value"`                                 //  enum values: SameOrigin, SameOriginAllowPopups, RestrictProperties, UnsafeNone, SameOriginPlusCoep, RestrictPropertiesPlusCoep, NoopenerAllowPopups
+	ReportOnlyValue             NetworkCrossOriginOpenerPolicyValue `json:"reportOnlyValue"`                       //  enum values: SameOrigin, SameOriginAllowPopups, RestrictProperties, UnsafeNone, SameOriginPlusCoep, RestrictPropertiesPlusCoep, NoopenerAllowPopups
+	ReportingEndpoint           string                              `json:"reportingEndpoint,omitempty"`           //
+	ReportOnlyReportingEndpoint string                              `json:"reportOnlyReportingEndpoint,omitempty"` //
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkCrossOriginEmbedderPolicyStatus struct {
-	Value                       string `json:"value"`                                 //  enum values: None, Credentialless, RequireCorp
-	ReportOnlyValue             string `json:"reportOnlyValue"`                       //  enum values: None, Credentialless, RequireCorp
-	ReportingEndpoint           string `json:"reportingEndpoint,omitempty"`           //
-	ReportOnlyReportingEndpoint string `json:"reportOnlyReportingEndpoint,omitempty"` //
+	Value                       NetworkCrossOriginEmbedderPolicyValue `json:"value"`                                 //  enum values: None, Credentialless, RequireCorp
+	ReportOnlyValue             NetworkCrossOriginEmbedderPolicyValue `json:"reportOnlyValue"`                       //  enum values: None, Credentialless, RequireCorp
+	ReportingEndpoint           string                                `json:"reportingEndpoint,omitempty"`           //
+	ReportOnlyReportingEndpoint string                                `json:"reportOnlyReportingEndpoint,omitempty"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkContentSecurityPolicyStatus struct {
+	EffectiveDirectives string                             `json:"effectiveDirectives"` //
+	IsEnforced          bool                               `json:"isEnforced"`          //
+	Source              NetworkContentSecurityPolicySource `json:"source"`              //  enum values: HTTP, Meta
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSecurityIsolationStatus struct {
 	Coop *NetworkCrossOriginOpenerPolicyStatus   `json:"coop,omitempty"` //
 	Coep *NetworkCrossOriginEmbedderPolicyStatus `json:"coep,omitempty"` //
+	Csp  []*NetworkContentSecurityPolicyStatus   `json:"csp,omitempty"`  //
 }
 
 // An object representing a report generated by the Reporting API.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkReportingApiReport struct {
 	Id                string                 `json:"id"`                //
 	InitiatorUrl      string                 `json:"initiatorUrl"`      // The URL of the document that triggered the report.
@@ -319,16 +1443,20 @@ type NetworkReportingApiReport struct {
 	Depth             int                    `json:"depth"`             // How many uploads deep the related request was.
 	CompletedAttempts int                    `json:"completedAttempts"` // The number of delivery attempts made so far, not including an active attempt.
 	Body              map[string]interface{} `json:"body"`              //
-	Status            string                 `json:"status"`            //  enum values: Queued, Pending, MarkedForRemoval, Success
+	Status            NetworkReportStatus    `json:"status"`            //  enum values: Queued, Pending, MarkedForRemoval, Success
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkReportingApiEndpoint struct {
 	Url       string `json:"url"`       // The URL of the endpoint to which reports may be delivered.
 	GroupName string `json:"groupName"` // Name of the endpoint group.
 }
 
 // An object providing the result of a network resource load.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkLoadNetworkResourcePageResult struct {
 	Success        bool                   `json:"success"`                  //
 	NetError       float64                `json:"netError,omitempty"`       // Optional values used for error reporting.
@@ -339,11 +1467,109 @@ type NetworkLoadNetworkResourcePageResult struct {
 }
 
 // An options object that may be extended later to better support CORS, CORB and streaming.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkLoadNetworkResourceOptions struct {
 	DisableCache       bool `json:"disableCache"`       //
 	IncludeCredentials bool `json:"includeCredentials"` //
 }
 
+// Event method names for the Network domain
+const (
+	EventNetworkDataReceived                            = "Network.dataReceived"
+	EventNetworkEventSourceMessageReceived              = "Network.eventSourceMessageReceived"
+	EventNetworkLoadingFailed                           = "Network.loadingFailed"
+	EventNetworkLoadingFinished                         = "Network.loadingFinished"
+	EventNetworkRequestIntercepted                      = "Network.requestIntercepted"
+	EventNetworkRequestServedFromCache                  = "Network.requestServedFromCache"
+	EventNetworkRequestWillBeSent                       = "Network.requestWillBeSent"
+	EventNetworkResourceChangedPriority                 = "Network.resourceChangedPriority"
+	EventNetworkSignedExchangeReceived                  = "Network.signedExchangeReceived"
+	EventNetworkResponseReceived                        = "Network.responseReceived"
+	EventNetworkWebSocketClosed                         = "Network.webSocketClosed"
+	EventNetworkWebSocketCreated                        = "Network.webSocketCreated"
+	EventNetworkWebSocketFrameError                     = "Network.webSocketFrameError"
+	EventNetworkWebSocketFrameReceived                  = "Network.webSocketFrameReceived"
+	EventNetworkWebSocketFrameSent                      = "Network.webSocketFrameSent"
+	EventNetworkWebSocketHandshakeResponseReceived      = "Network.webSocketHandshakeResponseReceived"
+	EventNetworkWebSocketWillSendHandshakeRequest       = "Network.webSocketWillSendHandshakeRequest"
+	EventNetworkWebTransportCreated                     = "Network.webTransportCreated"
+	EventNetworkWebTransportConnectionEstablished       = "Network.webTransportConnectionEstablished"
+	EventNetworkWebTransportClosed                      = "Network.webTransportClosed"
+	EventNetworkDirectTCPSocketCreated                  = "Network.directTCPSocketCreated"
+	EventNetworkDirectTCPSocketOpened                   = "Network.directTCPSocketOpened"
+	EventNetworkDirectTCPSocketAborted                  = "Network.directTCPSocketAborted"
+	EventNetworkDirectTCPSocketClosed                   = "Network.directTCPSocketClosed"
+	EventNetworkDirectTCPSocketChunkSent                = "Network.directTCPSocketChunkSent"
+	EventNetworkDirectTCPSocketChunkReceived            = "Network.directTCPSocketChunkReceived"
+	EventNetworkDirectUDPSocketCreated                  = "Network.directUDPSocketCreated"
+	EventNetworkDirectUDPSocketOpened                   = "Network.directUDPSocketOpened"
+	EventNetworkDirectUDPSocketAborted                  = "Network.directUDPSocketAborted"
+	EventNetworkDirectUDPSocketClosed                   = "Network.directUDPSocketClosed"
+	EventNetworkDirectUDPSocketChunkSent                = "Network.directUDPSocketChunkSent"
+	EventNetworkDirectUDPSocketChunkReceived            = "Network.directUDPSocketChunkReceived"
+	EventNetworkRequestWillBeSentExtraInfo              = "Network.requestWillBeSentExtraInfo"
+	EventNetworkResponseReceivedExtraInfo               = "Network.responseReceivedExtraInfo"
+	EventNetworkResponseReceivedEarlyHints              = "Network.responseReceivedEarlyHints"
+	EventNetworkTrustTokenOperationDone                 = "Network.trustTokenOperationDone"
+	EventNetworkPolicyUpdated                           = "Network.policyUpdated"
+	EventNetworkSubresourceWebBundleMetadataReceived    = "Network.subresourceWebBundleMetadataReceived"
+	EventNetworkSubresourceWebBundleMetadataError       = "Network.subresourceWebBundleMetadataError"
+	EventNetworkSubresourceWebBundleInnerResponseParsed = "Network.subresourceWebBundleInnerResponseParsed"
+	EventNetworkSubresourceWebBundleInnerResponseError  = "Network.subresourceWebBundleInnerResponseError"
+	EventNetworkReportingApiReportAdded                 = "Network.reportingApiReportAdded"
+	EventNetworkReportingApiReportUpdated               = "Network.reportingApiReportUpdated"
+	EventNetworkReportingApiEndpointsChangedForOrigin   = "Network.reportingApiEndpointsChangedForOrigin"
+)
+
+// NetworkEvents lists all event method names for the Network domain
+var NetworkEvents = []string{
+	EventNetworkDataReceived,
+	EventNetworkEventSourceMessageReceived,
+	EventNetworkLoadingFailed,
+	EventNetworkLoadingFinished,
+	EventNetworkRequestIntercepted,
+	EventNetworkRequestServedFromCache,
+	EventNetworkRequestWillBeSent,
+	EventNetworkResourceChangedPriority,
+	EventNetworkSignedExchangeReceived,
+	EventNetworkResponseReceived,
+	EventNetworkWebSocketClosed,
+	EventNetworkWebSocketCreated,
+	EventNetworkWebSocketFrameError,
+	EventNetworkWebSocketFrameReceived,
+	EventNetworkWebSocketFrameSent,
+	EventNetworkWebSocketHandshakeResponseReceived,
+	EventNetworkWebSocketWillSendHandshakeRequest,
+	EventNetworkWebTransportCreated,
+	EventNetworkWebTransportConnectionEstablished,
+	EventNetworkWebTransportClosed,
+	EventNetworkDirectTCPSocketCreated,
+	EventNetworkDirectTCPSocketOpened,
+	EventNetworkDirectTCPSocketAborted,
+	EventNetworkDirectTCPSocketClosed,
+	EventNetworkDirectTCPSocketChunkSent,
+	EventNetworkDirectTCPSocketChunkReceived,
+	EventNetworkDirectUDPSocketCreated,
+	EventNetworkDirectUDPSocketOpened,
+	EventNetworkDirectUDPSocketAborted,
+	EventNetworkDirectUDPSocketClosed,
+	EventNetworkDirectUDPSocketChunkSent,
+	EventNetworkDirectUDPSocketChunkReceived,
+	EventNetworkRequestWillBeSentExtraInfo,
+	EventNetworkResponseReceivedExtraInfo,
+	EventNetworkResponseReceivedEarlyHints,
+	EventNetworkTrustTokenOperationDone,
+	EventNetworkPolicyUpdated,
+	EventNetworkSubresourceWebBundleMetadataReceived,
+	EventNetworkSubresourceWebBundleMetadataError,
+	EventNetworkSubresourceWebBundleInnerResponseParsed,
+	EventNetworkSubresourceWebBundleInnerResponseError,
+	EventNetworkReportingApiReportAdded,
+	EventNetworkReportingApiReportUpdated,
+	EventNetworkReportingApiEndpointsChangedForOrigin,
+}
+
 // Fired when data chunk was received over the network.
 type NetworkDataReceivedEvent struct {
 	Method string `json:"method"`
@@ -352,6 +1578,7 @@ type NetworkDataReceivedEvent struct {
 		Timestamp         float64 `json:"timestamp"`         // Timestamp.
 		DataLength        int     `json:"dataLength"`        // Data chunk length.
 		EncodedDataLength int     `json:"encodedDataLength"` // Actual bytes received (might be less than dataLength for compressed encodings).
+		Data              string  `json:"data,omitempty"`    // Data that was received. (Encoded as a base64 string when passed over JSON)
 	} `json:"Params,omitempty"`
 }
 
@@ -373,10 +1600,10 @@ type NetworkLoadingFailedEvent struct {
 	Params struct {
 		RequestId       string                  `json:"requestId"`                 // Request identifier.
 		Timestamp       float64                 `json:"timestamp"`                 // Timestamp.
-		Type            string                  `json:"type"`                      // Resource type. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
-		ErrorText       string                  `json:"errorText"`                 // User friendly error message.
+		Type            NetworkResourceType     `json:"type"`                      // Resource type. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
+		ErrorText       string                  `json:"errorText"`                 // Error message. List of network errors: https://cs.chromium.org/chromium/src/net/base/net_error_list.h
 		Canceled        bool                    `json:"canceled,omitempty"`        // True if loading was canceled.
-		BlockedReason   string                  `json:"blockedReason,omitempty"`   // The reason why loading was blocked, if any. enum values: other, csp, mixed-content, origin, inspector, subresource-filter, content-type, coep-frame-resource-needs-coep-header, coop-sandboxed-iframe-cannot-navigate-to-coop-page, corp-not-same-origin, corp-not-same-origin-after-defaulted-to-same-origin-by-coep, corp-not-same-site
+		BlockedReason   NetworkBlockedReason    `json:"blockedReason,omitempty"`   // The reason why loading was blocked, if any. enum values: other, csp, mixed-content, origin, inspector, integrity, subresource-filter, content-type, coep-frame-resource-needs-coep-header, coop-sandboxed-iframe-cannot-navigate-to-coop-page, corp-not-same-origin, corp-not-same-origin-after-defaulted-to-same-origin-by-coep, corp-not-same-origin-after-defaulted-to-same-origin-by-dip, corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip, corp-not-same-site, sri-message-signature-mismatch
 		CorsErrorStatus *NetworkCorsErrorStatus `json:"corsErrorStatus,omitempty"` // The reason why loading was blocked by CORS, if any.
 	} `json:"Params,omitempty"`
 }
@@ -385,26 +1612,29 @@ type NetworkLoadingFailedEvent struct {
 type NetworkLoadingFinishedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		RequestId                string  `json:"requestId"`                          // Request identifier.
-		Timestamp                float64 `json:"timestamp"`                          // Timestamp.
-		EncodedDataLength        float64 `json:"encodedDataLength"`                  // Total number of bytes received for this request.
-		ShouldReportCorbBlocking bool    `json:"shouldReportCorbBlocking,omitempty"` // Set when 1) response was blocked by Cross-Origin Read Blocking and also 2) this needs to be reported to the DevTools console.
+		RequestId         string  `json:"requestId"`         // Request identifier.
+		Timestamp         float64 `json:"timestamp"`         // Timestamp.
+		EncodedDataLength float64 `json:"encodedDataLength"` // Total number of bytes received for this request.
 	} `json:"Params,omitempty"`
 }
 
 // Details of an intercepted HTTP request, which must be either allowed, blocked, modified or mocked. Deprecated, use Fetch.requestPaused instead.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkRequestInterceptedEvent struct {
 	Method string `json:"method"`
 	Params struct {
 		InterceptionId      string                 `json:"interceptionId"`                // Each request the page makes will have a unique id, however if any redirects are encountered while processing that fetch, they will be reported with the same id as the original fetch. Likewise if HTTP authentication is needed then the same fetch id will be used.
 		Request             *NetworkRequest        `json:"request"`                       //
 		FrameId             string                 `json:"frameId"`                       // The id of the frame that initiated the request.
-		ResourceType        string                 `json:"resourceType"`                  // How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
+		ResourceType        NetworkResourceType    `json:"resourceType"`                  // How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
 		IsNavigationRequest bool                   `json:"isNavigationRequest"`           // Whether this is a navigation request, which can abort the navigation completely.
 		IsDownload          bool                   `json:"isDownload,omitempty"`          // Set if the request is a navigation that will result in a download. Only present after response is received from the server (i.e. HeadersReceived stage).
 		RedirectUrl         string                 `json:"redirectUrl,omitempty"`         // Redirect location, only sent if a redirect was intercepted.
 		AuthChallenge       *NetworkAuthChallenge  `json:"authChallenge,omitempty"`       // Details of the Authorization Challenge encountered. If this is set then continueInterceptedRequest must contain an authChallengeResponse.
-		ResponseErrorReason string                 `json:"responseErrorReason,omitempty"` // Response error if intercepted at response stage or if redirect occurred while intercepting request. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse
+		ResponseErrorReason NetworkErrorReason     `json:"responseErrorReason,omitempty"` // Response error if intercepted at response stage or if redirect occurred while intercepting request. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse
 		ResponseStatusCode  int                    `json:"responseStatusCode,omitempty"`  // Response code if intercepted at response stage or if redirect occurred while intercepting request or auth retry occurred.
 		ResponseHeaders     map[string]interface{} `json:"responseHeaders,omitempty"`     // Response headers if intercepted at the response stage or if redirect occurred while intercepting request or auth retry occurred.
 		RequestId           string                 `json:"requestId,omitempty"`           // If the intercepted request had a corresponding requestWillBeSent event fired for it, then this requestId will be the same as the requestId present in the requestWillBeSent event.
@@ -423,32 +1653,36 @@ type NetworkRequestServedFromCacheEvent struct {
 type NetworkRequestWillBeSentEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		RequestId            string            `json:"requestId"`                  // Request identifier.
-		LoaderId             string            `json:"loaderId"`                   // Loader identifier. Empty string if the request is fetched from worker.
-		DocumentURL          string            `json:"documentURL"`                // URL of the document this request is loaded for.
-		Request              *NetworkRequest   `json:"request"`                    // Request data.
-		Timestamp            float64           `json:"timestamp"`                  // Timestamp.
-		WallTime             float64           `json:"wallTime"`                   // Timestamp.
-		Initiator            *NetworkInitiator `json:"initiator"`                  // Request initiator.
-		RedirectHasExtraInfo bool              `json:"redirectHasExtraInfo"`       // In the case that redirectResponse is populated, this flag indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted for the request which was just redirected.
-		RedirectResponse     *NetworkResponse  `json:"redirectResponse,omitempty"` // Redirect response data.
-		Type                 string            `json:"type,omitempty"`             // Type of this resource. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
-		FrameId              string            `json:"frameId,omitempty"`          // Frame identifier.
-		HasUserGesture       bool              `json:"hasUserGesture,omitempty"`   // Whether the request is initiated by a user gesture. Defaults to false.
+		RequestId            string              `json:"requestId"`                  // Request identifier.
+		LoaderId             string              `json:"loaderId"`                   // Loader identifier. Empty string if the request is fetched from worker.
+		DocumentURL          string              `json:"documentURL"`                // URL of the document this request is loaded for.
+		Request              *NetworkRequest     `json:"request"`                    // Request data.
+		Timestamp            float64             `json:"timestamp"`                  // Timestamp.
+		WallTime             float64             `json:"wallTime"`                   // Timestamp.
+		Initiator            *NetworkInitiator   `json:"initiator"`                  // Request initiator.
+		RedirectHasExtraInfo bool                `json:"redirectHasExtraInfo"`       // In the case that redirectResponse is populated, this flag indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted for the request which was just redirected.
+		RedirectResponse     *NetworkResponse    `json:"redirectResponse,omitempty"` // Redirect response data.
+		Type                 NetworkResourceType `json:"type,omitempty"`             // Type of this resource. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
+		FrameId              string              `json:"frameId,omitempty"`          // Frame identifier.
+		HasUserGesture       bool                `json:"hasUserGesture,omitempty"`   // Whether the request is initiated by a user gesture. Defaults to false.
 	} `json:"Params,omitempty"`
 }
 
 // Fired when resource loading priority is changed
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkResourceChangedPriorityEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		RequestId   string  `json:"requestId"`   // Request identifier.
-		NewPriority string  `json:"newPriority"` // New priority enum values: VeryLow, Low, Medium, High, VeryHigh
-		Timestamp   float64 `json:"timestamp"`   // Timestamp.
+		RequestId   string                  `json:"requestId"`   // Request identifier.
+		NewPriority NetworkResourcePriority `json:"newPriority"` // New priority enum values: VeryLow, Low, Medium, High, VeryHigh
+		Timestamp   float64                 `json:"timestamp"`   // Timestamp.
 	} `json:"Params,omitempty"`
 }
 
 // Fired when a signed exchange was received over the network
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSignedExchangeReceivedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -461,13 +1695,13 @@ type NetworkSignedExchangeReceivedEvent struct {
 type NetworkResponseReceivedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		RequestId    string           `json:"requestId"`         // Request identifier.
-		LoaderId     string           `json:"loaderId"`          // Loader identifier. Empty string if the request is fetched from worker.
-		Timestamp    float64          `json:"timestamp"`         // Timestamp.
-		Type         string           `json:"type"`              // Resource type. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
-		Response     *NetworkResponse `json:"response"`          // Response data.
-		HasExtraInfo bool             `json:"hasExtraInfo"`      // Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted for this request.
-		FrameId      string           `json:"frameId,omitempty"` // Frame identifier.
+		RequestId    string              `json:"requestId"`         // Request identifier.
+		LoaderId     string              `json:"loaderId"`          // Loader identifier. Empty string if the request is fetched from worker.
+		Timestamp    float64             `json:"timestamp"`         // Timestamp.
+		Type         NetworkResourceType `json:"type"`              // Resource type. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
+		Response     *NetworkResponse    `json:"response"`          // Response data.
+		HasExtraInfo bool                `json:"hasExtraInfo"`      // Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted for this request.
+		FrameId      string              `json:"frameId,omitempty"` // Frame identifier.
 	} `json:"Params,omitempty"`
 }
 
@@ -570,48 +1804,227 @@ type NetworkWebTransportClosedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Fired upon direct_socket.TCPSocket creation.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectTCPSocketCreatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string                         `json:"identifier"`          //
+		RemoteAddr string                         `json:"remoteAddr"`          //
+		RemotePort int                            `json:"remotePort"`          // Unsigned int 16.
+		Options    *NetworkDirectTCPSocketOptions `json:"options"`             //
+		Timestamp  float64                        `json:"timestamp"`           //
+		Initiator  *NetworkInitiator              `json:"initiator,omitempty"` //
+	} `json:"Params,omitempty"`
+}
+
+// Fired when direct_socket.TCPSocket connection is opened.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectTCPSocketOpenedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string  `json:"identifier"`          //
+		RemoteAddr string  `json:"remoteAddr"`          //
+		RemotePort int     `json:"remotePort"`          // Expected to be unsigned integer.
+		Timestamp  float64 `json:"timestamp"`           //
+		LocalAddr  string  `json:"localAddr,omitempty"` //
+		LocalPort  int     `json:"localPort,omitempty"` // Expected to be unsigned integer.
+	} `json:"Params,omitempty"`
+}
+
+// Fired when direct_socket.TCPSocket is aborted.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectTCPSocketAbortedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier   string  `json:"identifier"`   //
+		ErrorMessage string  `json:"errorMessage"` //
+		Timestamp    float64 `json:"timestamp"`    //
+	} `json:"Params,omitempty"`
+}
+
+// Fired when direct_socket.TCPSocket is closed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectTCPSocketClosedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string  `json:"identifier"` //
+		Timestamp  float64 `json:"timestamp"`  //
+	} `json:"Params,omitempty"`
+}
+
+// Fired when data is sent to tcp direct socket stream.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectTCPSocketChunkSentEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string  `json:"identifier"` //
+		Data       string  `json:"data"`       //
+		Timestamp  float64 `json:"timestamp"`  //
+	} `json:"Params,omitempty"`
+}
+
+// Fired when data is received from tcp direct socket stream.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectTCPSocketChunkReceivedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string  `json:"identifier"` //
+		Data       string  `json:"data"`       //
+		Timestamp  float64 `json:"timestamp"`  //
+	} `json:"Params,omitempty"`
+}
+
+// Fired upon direct_socket.UDPSocket creation.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectUDPSocketCreatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string                         `json:"identifier"`          //
+		Options    *NetworkDirectUDPSocketOptions `json:"options"`             //
+		Timestamp  float64                        `json:"timestamp"`           //
+		Initiator  *NetworkInitiator              `json:"initiator,omitempty"` //
+	} `json:"Params,omitempty"`
+}
+
+// Fired when direct_socket.UDPSocket connection is opened.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectUDPSocketOpenedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string  `json:"identifier"`           //
+		LocalAddr  string  `json:"localAddr"`            //
+		LocalPort  int     `json:"localPort"`            // Expected to be unsigned integer.
+		Timestamp  float64 `json:"timestamp"`            //
+		RemoteAddr string  `json:"remoteAddr,omitempty"` //
+		RemotePort int     `json:"remotePort,omitempty"` // Expected to be unsigned integer.
+	} `json:"Params,omitempty"`
+}
+
+// Fired when direct_socket.UDPSocket is aborted.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectUDPSocketAbortedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier   string  `json:"identifier"`   //
+		ErrorMessage string  `json:"errorMessage"` //
+		Timestamp    float64 `json:"timestamp"`    //
+	} `json:"Params,omitempty"`
+}
+
+// Fired when direct_socket.UDPSocket is closed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectUDPSocketClosedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string  `json:"identifier"` //
+		Timestamp  float64 `json:"timestamp"`  //
+	} `json:"Params,omitempty"`
+}
+
+// Fired when message is sent to udp direct socket stream.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectUDPSocketChunkSentEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string                   `json:"identifier"` //
+		Message    *NetworkDirectUDPMessage `json:"message"`    //
+		Timestamp  float64                  `json:"timestamp"`  //
+	} `json:"Params,omitempty"`
+}
+
+// Fired when message is received from udp direct socket stream.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkDirectUDPSocketChunkReceivedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Identifier string                   `json:"identifier"` //
+		Message    *NetworkDirectUDPMessage `json:"message"`    //
+		Timestamp  float64                  `json:"timestamp"`  //
+	} `json:"Params,omitempty"`
+}
+
 // Fired when additional information about a requestWillBeSent event is available from the network stack. Not every requestWillBeSent event will have an additional requestWillBeSentExtraInfo fired for it, and there is no guarantee whether requestWillBeSent or requestWillBeSentExtraInfo will be fired first for the same request.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkRequestWillBeSentExtraInfoEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		RequestId                     string                            `json:"requestId"`                               // Request identifier. Used to match this information to an existing requestWillBeSent event.
-		AssociatedCookies             []*NetworkBlockedCookieWithReason `json:"associatedCookies"`                       // A list of cookies potentially associated to the requested URL. This includes both cookies sent with the request and the ones not sent; the latter are distinguished by having blockedReason field set.
-		Headers                       map[string]interface{}            `json:"headers"`                                 // Raw request headers as they will be sent over the wire.
-		ConnectTiming                 *NetworkConnectTiming             `json:"connectTiming"`                           // Connection timing information for the request.
-		ClientSecurityState           *NetworkClientSecurityState       `json:"clientSecurityState,omitempty"`           // The client security state set for the request.
-		SiteHasCookieInOtherPartition bool                              `json:"siteHasCookieInOtherPartition,omitempty"` // Whether the site has partitioned cookies stored in a partition different than the current one.
+		RequestId                     string                      `json:"requestId"`                               // Request identifier. Used to match this information to an existing requestWillBeSent event.
+		AssociatedCookies             []*NetworkAssociatedCookie  `json:"associatedCookies"`                       // A list of cookies potentially associated to the requested URL. This includes both cookies sent with the request and the ones not sent; the latter are distinguished by having blockedReasons field set.
+		Headers                       map[string]interface{}      `json:"headers"`                                 // Raw request headers as they will be sent over the wire.
+		ConnectTiming                 *NetworkConnectTiming       `json:"connectTiming"`                           // Connection timing information for the request.
+		ClientSecurityState           *NetworkClientSecurityState `json:"clientSecurityState,omitempty"`           // The client security state set for the request.
+		SiteHasCookieInOtherPartition bool                        `json:"siteHasCookieInOtherPartition,omitempty"` // Whether the site has partitioned cookies stored in a partition different than the current one.
 	} `json:"Params,omitempty"`
 }
 
 // Fired when additional information about a responseReceived event is available from the network stack. Not every responseReceived event will have an additional responseReceivedExtraInfo for it, and responseReceivedExtraInfo may be fired before or after responseReceived.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkResponseReceivedExtraInfoEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		RequestId                string                               `json:"requestId"`                          // Request identifier. Used to match this information to another responseReceived event.
-		BlockedCookies           []*NetworkBlockedSetCookieWithReason `json:"blockedCookies"`                     // A list of cookies which were not stored from the response along with the corresponding reasons for blocking. The cookies here may not be valid due to syntax errors, which are represented by the invalid cookie line string instead of a proper cookie.
-		Headers                  map[string]interface{}               `json:"headers"`                            // Raw response headers as they were received over the wire.
-		ResourceIPAddressSpace   string                               `json:"resourceIPAddressSpace"`             // The IP address space of the resource. The address space can only be determined once the transport established the connection, so we can't send it in `requestWillBeSentExtraInfo`. enum values: Local, Private, Public, Unknown
-		StatusCode               int                                  `json:"statusCode"`                         // The status code of the response. This is useful in cases the request failed and no responseReceived event is triggered, which is the case for, e.g., CORS errors. This is also the correct status code for cached requests, where the status in responseReceived is a 200 and this will be 304.
-		HeadersText              string                               `json:"headersText,omitempty"`              // Raw response header text as it was received over the wire. The raw text may not always be available, such as in the case of HTTP/2 or QUIC.
-		CookiePartitionKey       string                               `json:"cookiePartitionKey,omitempty"`       // The cookie partition key that will be used to store partitioned cookies set in this response. Only sent when partitioned cookies are enabled.
-		CookiePartitionKeyOpaque bool                                 `json:"cookiePartitionKeyOpaque,omitempty"` // True if partitioned cookies are enabled, but the partition key is not serializeable to string.
+		RequestId                string                                `json:"requestId"`                          // Request identifier. Used to match this information to another responseReceived event.
+		BlockedCookies           []*NetworkBlockedSetCookieWithReason  `json:"blockedCookies"`                     // A list of cookies which were not stored from the response along with the corresponding reasons for blocking. The cookies here may not be valid due to syntax errors, which are represented by the invalid cookie line string instead of a proper cookie.
+		Headers                  map[string]interface{}                `json:"headers"`                            // Raw response headers as they were received over the wire. Duplicate headers in the response are represented as a single key with their values concatentated using `\n` as the separator. See also `headersText` that contains verbatim text for HTTP/1.*.
+		ResourceIPAddressSpace   NetworkIPAddressSpace                 `json:"resourceIPAddressSpace"`             // The IP address space of the resource. The address space can only be determined once the transport established the connection, so we can't send it in `requestWillBeSentExtraInfo`. enum values: Loopback, Local, Public, Unknown
+		StatusCode               int                                   `json:"statusCode"`                         // The status code of the response. This is useful in cases the request failed and no responseReceived event is triggered, which is the case for, e.g., CORS errors. This is also the correct status code for cached requests, where the status in responseReceived is a 200 and this will be 304.
+		HeadersText              string                                `json:"headersText,omitempty"`              // Raw response header text as it was received over the wire. The raw text may not always be available, such as in the case of HTTP/2 or QUIC.
+		CookiePartitionKey       *NetworkCookiePartitionKey            `json:"cookiePartitionKey,omitempty"`       // The cookie partition key that will be used to store partitioned cookies set in this response. Only sent when partitioned cookies are enabled.
+		CookiePartitionKeyOpaque bool                                  `json:"cookiePartitionKeyOpaque,omitempty"` // True if partitioned cookies are enabled, but the partition key is not serializable to string.
+		ExemptedCookies          []*NetworkExemptedSetCookieWithReason `json:"exemptedCookies,omitempty"`          // A list of cookies which should have been blocked by 3PCD but are exempted and stored from the response with the corresponding reason.
+	} `json:"Params,omitempty"`
+}
+
+// Fired when 103 Early Hints headers is received in addition to the common response. Not every responseReceived event will have an responseReceivedEarlyHints fired. Only one responseReceivedEarlyHints may be fired for eached responseReceived event.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkResponseReceivedEarlyHintsEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		RequestId string                 `json:"requestId"` // Request identifier. Used to match this information to another responseReceived event.
+		Headers   map[string]interface{} `json:"headers"`   // Raw response headers as they were received over the wire. Duplicate headers in the response are represented as a single key with their values concatentated using `\n` as the separator. See also `headersText` that contains verbatim text for HTTP/1.*.
 	} `json:"Params,omitempty"`
 }
 
 // Fired exactly once for each Trust Token operation. Depending on the type of the operation and whether the operation succeeded or failed, the event is fired before the corresponding request was sent or after the response was received.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkTrustTokenOperationDoneEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		Status           string `json:"status"`                     // Detailed success or error status of the operation. 'AlreadyExists' also signifies a successful operation, as the result of the operation already exists und thus, the operation was abort preemptively (e.g. a cache hit).
-		Type             string `json:"type"`                       //  enum values: Issuance, Redemption, Signing
-		RequestId        string `json:"requestId"`                  //
-		TopLevelOrigin   string `json:"topLevelOrigin,omitempty"`   // Top level origin. The context in which the operation was attempted.
-		IssuerOrigin     string `json:"issuerOrigin,omitempty"`     // Origin of the issuer in case of a "Issuance" or "Redemption" operation.
-		IssuedTokenCount int    `json:"issuedTokenCount,omitempty"` // The number of obtained Trust Tokens on a successful "Issuance" operation.
+		Status           NetworkTrustTokenOperationDoneStatus `json:"status"`                     // Detailed success or error status of the operation. 'AlreadyExists' also signifies a successful operation, as the result of the operation already exists und thus, the operation was abort preemptively (e.g. a cache hit).
+		Type             NetworkTrustTokenOperationType       `json:"type"`                       //  enum values: Issuance, Redemption, Signing
+		RequestId        string                               `json:"requestId"`                  //
+		TopLevelOrigin   string                               `json:"topLevelOrigin,omitempty"`   // Top level origin. The context in which the operation was attempted.
+		IssuerOrigin     string                               `json:"issuerOrigin,omitempty"`     // Origin of the issuer in case of a "Issuance" or "Redemption" operation.
+		IssuedTokenCount int                                  `json:"issuedTokenCount,omitempty"` // The number of obtained Trust Tokens on a successful "Issuance" operation.
 	} `json:"Params,omitempty"`
 }
 
+// Fired once security policy has been updated.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type NetworkPolicyUpdatedEvent struct {
+	Method string `json:"method"`
+}
+
 // Fired once when parsing the .wbn file has succeeded. The event contains the information about the web bundle contents.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSubresourceWebBundleMetadataReceivedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -621,6 +2034,8 @@ type NetworkSubresourceWebBundleMetadataReceivedEvent struct {
 }
 
 // Fired once when parsing the .wbn file has failed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSubresourceWebBundleMetadataErrorEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -630,6 +2045,8 @@ type NetworkSubresourceWebBundleMetadataErrorEvent struct {
 }
 
 // Fired when handling requests for resources within a .wbn file. Note: this will only be fired for resources that are requested by the webpage.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSubresourceWebBundleInnerResponseParsedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -640,6 +2057,8 @@ type NetworkSubresourceWebBundleInnerResponseParsedEvent struct {
 }
 
 // Fired when request for resources within a .wbn file failed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkSubresourceWebBundleInnerResponseErrorEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -651,6 +2070,8 @@ type NetworkSubresourceWebBundleInnerResponseErrorEvent struct {
 }
 
 // Is sent whenever a new report is added. And after 'enableReportingApi' for all existing reports.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkReportingApiReportAddedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -658,6 +2079,7 @@ type NetworkReportingApiReportAddedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkReportingApiReportUpdatedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -665,6 +2087,7 @@ type NetworkReportingApiReportUpdatedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Experimental: this may change or be removed in a future Chrome release.
 type NetworkReportingApiEndpointsChangedForOriginEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -682,31 +2105,667 @@ func NewNetwork(target gcdmessage.ChromeTargeter) *Network {
 	return c
 }
 
+// OnDataReceived subscribes to Network.dataReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnDataReceived(callback func(*NetworkDataReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDataReceived, func(payload []byte) error {
+		event := &NetworkDataReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnEventSourceMessageReceived subscribes to Network.eventSourceMessageReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnEventSourceMessageReceived(callback func(*NetworkEventSourceMessageReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkEventSourceMessageReceived, func(payload []byte) error {
+		event := &NetworkEventSourceMessageReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnLoadingFailed subscribes to Network.loadingFailed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnLoadingFailed(callback func(*NetworkLoadingFailedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkLoadingFailed, func(payload []byte) error {
+		event := &NetworkLoadingFailedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnLoadingFinished subscribes to Network.loadingFinished events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnLoadingFinished(callback func(*NetworkLoadingFinishedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkLoadingFinished, func(payload []byte) error {
+		event := &NetworkLoadingFinishedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnRequestIntercepted subscribes to Network.requestIntercepted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnRequestIntercepted(callback func(*NetworkRequestInterceptedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkRequestIntercepted, func(payload []byte) error {
+		event := &NetworkRequestInterceptedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnRequestServedFromCache subscribes to Network.requestServedFromCache events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnRequestServedFromCache(callback func(*NetworkRequestServedFromCacheEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkRequestServedFromCache, func(payload []byte) error {
+		event := &NetworkRequestServedFromCacheEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnRequestWillBeSent subscribes to Network.requestWillBeSent events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnRequestWillBeSent(callback func(*NetworkRequestWillBeSentEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkRequestWillBeSent, func(payload []byte) error {
+		event := &NetworkRequestWillBeSentEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnResourceChangedPriority subscribes to Network.resourceChangedPriority events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnResourceChangedPriority(callback func(*NetworkResourceChangedPriorityEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkResourceChangedPriority, func(payload []byte) error {
+		event := &NetworkResourceChangedPriorityEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSignedExchangeReceived subscribes to Network.signedExchangeReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnSignedExchangeReceived(callback func(*NetworkSignedExchangeReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkSignedExchangeReceived, func(payload []byte) error {
+		event := &NetworkSignedExchangeReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnResponseReceived subscribes to Network.responseReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnResponseReceived(callback func(*NetworkResponseReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkResponseReceived, func(payload []byte) error {
+		event := &NetworkResponseReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebSocketClosed subscribes to Network.webSocketClosed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebSocketClosed(callback func(*NetworkWebSocketClosedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebSocketClosed, func(payload []byte) error {
+		event := &NetworkWebSocketClosedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebSocketCreated subscribes to Network.webSocketCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebSocketCreated(callback func(*NetworkWebSocketCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebSocketCreated, func(payload []byte) error {
+		event := &NetworkWebSocketCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebSocketFrameError subscribes to Network.webSocketFrameError events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebSocketFrameError(callback func(*NetworkWebSocketFrameErrorEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebSocketFrameError, func(payload []byte) error {
+		event := &NetworkWebSocketFrameErrorEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebSocketFrameReceived subscribes to Network.webSocketFrameReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebSocketFrameReceived(callback func(*NetworkWebSocketFrameReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebSocketFrameReceived, func(payload []byte) error {
+		event := &NetworkWebSocketFrameReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebSocketFrameSent subscribes to Network.webSocketFrameSent events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebSocketFrameSent(callback func(*NetworkWebSocketFrameSentEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebSocketFrameSent, func(payload []byte) error {
+		event := &NetworkWebSocketFrameSentEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebSocketHandshakeResponseReceived subscribes to Network.webSocketHandshakeResponseReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebSocketHandshakeResponseReceived(callback func(*NetworkWebSocketHandshakeResponseReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebSocketHandshakeResponseReceived, func(payload []byte) error {
+		event := &NetworkWebSocketHandshakeResponseReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebSocketWillSendHandshakeRequest subscribes to Network.webSocketWillSendHandshakeRequest events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebSocketWillSendHandshakeRequest(callback func(*NetworkWebSocketWillSendHandshakeRequestEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebSocketWillSendHandshakeRequest, func(payload []byte) error {
+		event := &NetworkWebSocketWillSendHandshakeRequestEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebTransportCreated subscribes to Network.webTransportCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebTransportCreated(callback func(*NetworkWebTransportCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebTransportCreated, func(payload []byte) error {
+		event := &NetworkWebTransportCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebTransportConnectionEstablished subscribes to Network.webTransportConnectionEstablished events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebTransportConnectionEstablished(callback func(*NetworkWebTransportConnectionEstablishedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebTransportConnectionEstablished, func(payload []byte) error {
+		event := &NetworkWebTransportConnectionEstablishedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWebTransportClosed subscribes to Network.webTransportClosed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Network) OnWebTransportClosed(callback func(*NetworkWebTransportClosedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkWebTransportClosed, func(payload []byte) error {
+		event := &NetworkWebTransportClosedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectTCPSocketCreated subscribes to Network.directTCPSocketCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectTCPSocketCreated(callback func(*NetworkDirectTCPSocketCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectTCPSocketCreated, func(payload []byte) error {
+		event := &NetworkDirectTCPSocketCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectTCPSocketOpened subscribes to Network.directTCPSocketOpened events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectTCPSocketOpened(callback func(*NetworkDirectTCPSocketOpenedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectTCPSocketOpened, func(payload []byte) error {
+		event := &NetworkDirectTCPSocketOpenedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectTCPSocketAborted subscribes to Network.directTCPSocketAborted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectTCPSocketAborted(callback func(*NetworkDirectTCPSocketAbortedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectTCPSocketAborted, func(payload []byte) error {
+		event := &NetworkDirectTCPSocketAbortedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectTCPSocketClosed subscribes to Network.directTCPSocketClosed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectTCPSocketClosed(callback func(*NetworkDirectTCPSocketClosedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectTCPSocketClosed, func(payload []byte) error {
+		event := &NetworkDirectTCPSocketClosedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectTCPSocketChunkSent subscribes to Network.directTCPSocketChunkSent events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectTCPSocketChunkSent(callback func(*NetworkDirectTCPSocketChunkSentEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectTCPSocketChunkSent, func(payload []byte) error {
+		event := &NetworkDirectTCPSocketChunkSentEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectTCPSocketChunkReceived subscribes to Network.directTCPSocketChunkReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectTCPSocketChunkReceived(callback func(*NetworkDirectTCPSocketChunkReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectTCPSocketChunkReceived, func(payload []byte) error {
+		event := &NetworkDirectTCPSocketChunkReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectUDPSocketCreated subscribes to Network.directUDPSocketCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectUDPSocketCreated(callback func(*NetworkDirectUDPSocketCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectUDPSocketCreated, func(payload []byte) error {
+		event := &NetworkDirectUDPSocketCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectUDPSocketOpened subscribes to Network.directUDPSocketOpened events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectUDPSocketOpened(callback func(*NetworkDirectUDPSocketOpenedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectUDPSocketOpened, func(payload []byte) error {
+		event := &NetworkDirectUDPSocketOpenedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectUDPSocketAborted subscribes to Network.directUDPSocketAborted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectUDPSocketAborted(callback func(*NetworkDirectUDPSocketAbortedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectUDPSocketAborted, func(payload []byte) error {
+		event := &NetworkDirectUDPSocketAbortedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectUDPSocketClosed subscribes to Network.directUDPSocketClosed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectUDPSocketClosed(callback func(*NetworkDirectUDPSocketClosedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectUDPSocketClosed, func(payload []byte) error {
+		event := &NetworkDirectUDPSocketClosedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectUDPSocketChunkSent subscribes to Network.directUDPSocketChunkSent events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectUDPSocketChunkSent(callback func(*NetworkDirectUDPSocketChunkSentEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectUDPSocketChunkSent, func(payload []byte) error {
+		event := &NetworkDirectUDPSocketChunkSentEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDirectUDPSocketChunkReceived subscribes to Network.directUDPSocketChunkReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnDirectUDPSocketChunkReceived(callback func(*NetworkDirectUDPSocketChunkReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkDirectUDPSocketChunkReceived, func(payload []byte) error {
+		event := &NetworkDirectUDPSocketChunkReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnRequestWillBeSentExtraInfo subscribes to Network.requestWillBeSentExtraInfo events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnRequestWillBeSentExtraInfo(callback func(*NetworkRequestWillBeSentExtraInfoEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkRequestWillBeSentExtraInfo, func(payload []byte) error {
+		event := &NetworkRequestWillBeSentExtraInfoEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnResponseReceivedExtraInfo subscribes to Network.responseReceivedExtraInfo events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnResponseReceivedExtraInfo(callback func(*NetworkResponseReceivedExtraInfoEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkResponseReceivedExtraInfo, func(payload []byte) error {
+		event := &NetworkResponseReceivedExtraInfoEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnResponseReceivedEarlyHints subscribes to Network.responseReceivedEarlyHints events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnResponseReceivedEarlyHints(callback func(*NetworkResponseReceivedEarlyHintsEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkResponseReceivedEarlyHints, func(payload []byte) error {
+		event := &NetworkResponseReceivedEarlyHintsEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTrustTokenOperationDone subscribes to Network.trustTokenOperationDone events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnTrustTokenOperationDone(callback func(*NetworkTrustTokenOperationDoneEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkTrustTokenOperationDone, func(payload []byte) error {
+		event := &NetworkTrustTokenOperationDoneEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPolicyUpdated subscribes to Network.policyUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnPolicyUpdated(callback func(*NetworkPolicyUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkPolicyUpdated, func(payload []byte) error {
+		event := &NetworkPolicyUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSubresourceWebBundleMetadataReceived subscribes to Network.subresourceWebBundleMetadataReceived events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnSubresourceWebBundleMetadataReceived(callback func(*NetworkSubresourceWebBundleMetadataReceivedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkSubresourceWebBundleMetadataReceived, func(payload []byte) error {
+		event := &NetworkSubresourceWebBundleMetadataReceivedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSubresourceWebBundleMetadataError subscribes to Network.subresourceWebBundleMetadataError events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnSubresourceWebBundleMetadataError(callback func(*NetworkSubresourceWebBundleMetadataErrorEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkSubresourceWebBundleMetadataError, func(payload []byte) error {
+		event := &NetworkSubresourceWebBundleMetadataErrorEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSubresourceWebBundleInnerResponseParsed subscribes to Network.subresourceWebBundleInnerResponseParsed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnSubresourceWebBundleInnerResponseParsed(callback func(*NetworkSubresourceWebBundleInnerResponseParsedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkSubresourceWebBundleInnerResponseParsed, func(payload []byte) error {
+		event := &NetworkSubresourceWebBundleInnerResponseParsedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSubresourceWebBundleInnerResponseError subscribes to Network.subresourceWebBundleInnerResponseError events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnSubresourceWebBundleInnerResponseError(callback func(*NetworkSubresourceWebBundleInnerResponseErrorEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkSubresourceWebBundleInnerResponseError, func(payload []byte) error {
+		event := &NetworkSubresourceWebBundleInnerResponseErrorEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnReportingApiReportAdded subscribes to Network.reportingApiReportAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnReportingApiReportAdded(callback func(*NetworkReportingApiReportAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkReportingApiReportAdded, func(payload []byte) error {
+		event := &NetworkReportingApiReportAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnReportingApiReportUpdated subscribes to Network.reportingApiReportUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnReportingApiReportUpdated(callback func(*NetworkReportingApiReportUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkReportingApiReportUpdated, func(payload []byte) error {
+		event := &NetworkReportingApiReportUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnReportingApiEndpointsChangedForOrigin subscribes to Network.reportingApiEndpointsChangedForOrigin events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) OnReportingApiEndpointsChangedForOrigin(callback func(*NetworkReportingApiEndpointsChangedForOriginEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventNetworkReportingApiEndpointsChangedForOrigin, func(payload []byte) error {
+		event := &NetworkReportingApiEndpointsChangedForOriginEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 type NetworkSetAcceptedEncodingsParams struct {
-	// List of accepted content encodings. enum values: deflate, gzip, br
-	Encodings []string `json:"encodings"`
+	// List of accepted content encodings. enum values: deflate, gzip, br, zstd
+	Encodings []NetworkContentEncoding `json:"encodings"`
 }
 
 // SetAcceptedEncodingsWithParams - Sets a list of content encodings that will be accepted. Empty list means no encoding is accepted.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SetAcceptedEncodingsWithParams(ctx context.Context, v *NetworkSetAcceptedEncodingsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.setAcceptedEncodings", Params: v})
 }
 
 // SetAcceptedEncodings - Sets a list of content encodings that will be accepted. Empty list means no encoding is accepted.
-// encodings - List of accepted content encodings. enum values: deflate, gzip, br
-func (c *Network) SetAcceptedEncodings(ctx context.Context, encodings []string) (*gcdmessage.ChromeResponse, error) {
+// encodings - List of accepted content encodings. enum values: deflate, gzip, br, zstd
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) SetAcceptedEncodings(ctx context.Context, encodings []NetworkContentEncoding) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkSetAcceptedEncodingsParams
 	v.Encodings = encodings
 	return c.SetAcceptedEncodingsWithParams(ctx, &v)
 }
 
 // Clears accepted encodings set by setAcceptedEncodings
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) ClearAcceptedEncodingsOverride(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.clearAcceptedEncodingsOverride"})
 }
 
 // CanClearBrowserCache - Tells whether clearing browser cache is supported.
 // Returns -  result - True if browser cache can be cleared.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Network) CanClearBrowserCache(ctx context.Context) (bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.canClearBrowserCache"})
 	if err != nil {
@@ -737,6 +2796,8 @@ func (c *Network) CanClearBrowserCache(ctx context.Context) (bool, error) {
 
 // CanClearBrowserCookies - Tells whether clearing browser cookies is supported.
 // Returns -  result - True if browser cookies can be cleared.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Network) CanClearBrowserCookies(ctx context.Context) (bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.canClearBrowserCookies"})
 	if err != nil {
@@ -767,6 +2828,8 @@ func (c *Network) CanClearBrowserCookies(ctx context.Context) (bool, error) {
 
 // CanEmulateNetworkConditions - Tells whether emulation of network conditions is supported.
 // Returns -  result - True if emulation of network conditions is supported.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Network) CanEmulateNetworkConditions(ctx context.Context) (bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.canEmulateNetworkConditions"})
 	if err != nil {
@@ -805,11 +2868,12 @@ func (c *Network) ClearBrowserCookies(ctx context.Context) (*gcdmessage.ChromeRe
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.clearBrowserCookies"})
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type NetworkContinueInterceptedRequestParams struct {
 	//
 	InterceptionId string `json:"interceptionId"`
 	// If set this causes the request to fail with the given reason. Passing `Aborted` for requests marked with `isNavigationRequest` also cancels the navigation. Must not be set in response to an authChallenge. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse
-	ErrorReason string `json:"errorReason,omitempty"`
+	ErrorReason NetworkErrorReason `json:"errorReason,omitempty"`
 	// If set the requests completes using with the provided base64 encoded raw response, including HTTP status line and headers etc... Must not be set in response to an authChallenge. (Encoded as a base64 string when passed over JSON)
 	RawResponse string `json:"rawResponse,omitempty"`
 	// If set the request url will be modified in a way that's not observable by page. Must not be set in response to an authChallenge.
@@ -825,6 +2889,10 @@ type NetworkContinueInterceptedRequestParams struct {
 }
 
 // ContinueInterceptedRequestWithParams - Response to Network.requestIntercepted which either modifies the request to continue with any modifications, or blocks it, or completes it with the provided response bytes. If a network fetch occurs as a result which encounters a redirect an additional Network.requestIntercepted event will be sent with the same InterceptionId. Deprecated, use Fetch.continueRequest, Fetch.fulfillRequest and Fetch.failRequest instead.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) ContinueInterceptedRequestWithParams(ctx context.Context, v *NetworkContinueInterceptedRequestParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.continueInterceptedRequest", Params: v})
 }
@@ -838,7 +2906,11 @@ func (c *Network) ContinueInterceptedRequestWithParams(ctx context.Context, v *N
 // postData - If set this allows postData to be set. Must not be set in response to an authChallenge.
 // headers - If set this allows the request headers to be changed. Must not be set in response to an authChallenge.
 // authChallengeResponse - Response to a requestIntercepted with an authChallenge. Must not be set otherwise.
-func (c *Network) ContinueInterceptedRequest(ctx context.Context, interceptionId string, errorReason string, rawResponse string, url string, method string, postData string, headers map[string]interface{}, authChallengeResponse *NetworkAuthChallengeResponse) (*gcdmessage.ChromeResponse, error) {
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) ContinueInterceptedRequest(ctx context.Context, interceptionId string, errorReason NetworkErrorReason, rawResponse string, url string, method string, postData string, headers map[string]interface{}, authChallengeResponse *NetworkAuthChallengeResponse) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkContinueInterceptedRequestParams
 	v.InterceptionId = interceptionId
 	v.ErrorReason = errorReason
@@ -860,24 +2932,30 @@ type NetworkDeleteCookiesParams struct {
 	Domain string `json:"domain,omitempty"`
 	// If specified, deletes only cookies with the exact path.
 	Path string `json:"path,omitempty"`
+	// If specified, deletes only cookies with the the given name and partitionKey where all partition key attributes match the cookie partition key attribute.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
 }
 
-// DeleteCookiesWithParams - Deletes browser cookies with matching name and url or domain/path pair.
+// DeleteCookiesWithParams - Deletes browser cookies with matching name and url or domain/path/partitionKey pair.
 func (c *Network) DeleteCookiesWithParams(ctx context.Context, v *NetworkDeleteCookiesParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.deleteCookies", Params: v})
 }
 
-// DeleteCookies - Deletes browser cookies with matching name and url or domain/path pair.
+// DeleteCookies - Deletes browser cookies with matching name and url or domain/path/partitionKey pair.
 // name - Name of the cookies to remove.
 // url - If specified, deletes all the cookies with the given name where domain and path match provided URL.
 // domain - If specified, deletes only cookies with the exact domain.
 // path - If specified, deletes only cookies with the exact path.
-func (c *Network) DeleteCookies(ctx context.Context, name string, url string, domain string, path string) (*gcdmessage.ChromeResponse, error) {
+// partitionKey - If specified, deletes only cookies with the the given name and partitionKey where all partition key attributes match the cookie partition key attribute.
+func (c *Network) DeleteCookies(ctx context.Context, name string, url string, domain string, path string, partitionKey *NetworkCookiePartitionKey) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkDeleteCookiesParams
 	v.Name = name
 	v.Url = url
 	v.Domain = domain
 	v.Path = path
+	v.PartitionKey = partitionKey
 	return c.DeleteCookiesWithParams(ctx, &v)
 }
 
@@ -896,7 +2974,19 @@ type NetworkEmulateNetworkConditionsParams struct {
 	// Maximal aggregated upload throughput (bytes/sec).  -1 disables upload throttling.
 	UploadThroughput float64 `json:"uploadThroughput"`
 	// Connection type if known. enum values: none, cellular2g, cellular3g, cellular4g, bluetooth, ethernet, wifi, wimax, other
-	ConnectionType string `json:"connectionType,omitempty"`
+	ConnectionType NetworkConnectionType `json:"connectionType,omitempty"`
+	// WebRTC packet loss (percent, 0-100). 0 disables packet loss emulation, 100 drops all the packets.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	PacketLoss float64 `json:"packetLoss,omitempty"`
+	// WebRTC packet queue length (packet). 0 removes any queue length limitations.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	PacketQueueLength int `json:"packetQueueLength,omitempty"`
+	// WebRTC packetReordering feature.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	PacketReordering bool `json:"packetReordering,omitempty"`
 }
 
 // EmulateNetworkConditionsWithParams - Activates emulation of network conditions.
@@ -910,23 +3000,37 @@ func (c *Network) EmulateNetworkConditionsWithParams(ctx context.Context, v *Net
 // downloadThroughput - Maximal aggregated download throughput (bytes/sec). -1 disables download throttling.
 // uploadThroughput - Maximal aggregated upload throughput (bytes/sec).  -1 disables upload throttling.
 // connectionType - Connection type if known. enum values: none, cellular2g, cellular3g, cellular4g, bluetooth, ethernet, wifi, wimax, other
-func (c *Network) EmulateNetworkConditions(ctx context.Context, offline bool, latency float64, downloadThroughput float64, uploadThroughput float64, connectionType string) (*gcdmessage.ChromeResponse, error) {
+// packetLoss - WebRTC packet loss (percent, 0-100). 0 disables packet loss emulation, 100 drops all the packets.
+// packetQueueLength - WebRTC packet queue length (packet). 0 removes any queue length limitations.
+// packetReordering - WebRTC packetReordering feature.
+func (c *Network) EmulateNetworkConditions(ctx context.Context, offline bool, latency float64, downloadThroughput float64, uploadThroughput float64, connectionType NetworkConnectionType, packetLoss float64, packetQueueLength int, packetReordering bool) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkEmulateNetworkConditionsParams
 	v.Offline = offline
 	v.Latency = latency
 	v.DownloadThroughput = downloadThroughput
 	v.UploadThroughput = uploadThroughput
 	v.ConnectionType = connectionType
+	v.PacketLoss = packetLoss
+	v.PacketQueueLength = packetQueueLength
+	v.PacketReordering = packetReordering
 	return c.EmulateNetworkConditionsWithParams(ctx, &v)
 }
 
 type NetworkEnableParams struct {
 	// Buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	MaxTotalBufferSize int `json:"maxTotalBufferSize,omitempty"`
 	// Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	MaxResourceBufferSize int `json:"maxResourceBufferSize,omitempty"`
 	// Longest post body size (in bytes) that would be included in requestWillBeSent notification
 	MaxPostDataSize int `json:"maxPostDataSize,omitempty"`
+	// Whether DirectSocket chunk send/receive events should be reported.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	ReportDirectSocketTraffic bool `json:"reportDirectSocketTraffic,omitempty"`
 }
 
 // EnableWithParams - Enables network tracking, network events will now be delivered to the client.
@@ -938,16 +3042,20 @@ func (c *Network) EnableWithParams(ctx context.Context, v *NetworkEnableParams)
 // maxTotalBufferSize - Buffer size in bytes to use when preserving network payloads (XHRs, etc).
 // maxResourceBufferSize - Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc).
 // maxPostDataSize - Longest post body size (in bytes) that would be included in requestWillBeSent notification
-func (c *Network) Enable(ctx context.Context, maxTotalBufferSize int, maxResourceBufferSize int, maxPostDataSize int) (*gcdmessage.ChromeResponse, error) {
+// reportDirectSocketTraffic - Whether DirectSocket chunk send/receive events should be reported.
+func (c *Network) Enable(ctx context.Context, maxTotalBufferSize int, maxResourceBufferSize int, maxPostDataSize int, reportDirectSocketTraffic bool) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkEnableParams
 	v.MaxTotalBufferSize = maxTotalBufferSize
 	v.MaxResourceBufferSize = maxResourceBufferSize
 	v.MaxPostDataSize = maxPostDataSize
+	v.ReportDirectSocketTraffic = reportDirectSocketTraffic
 	return c.EnableWithParams(ctx, &v)
 }
 
 // GetAllCookies - Returns all browser cookies. Depending on the backend support, will return detailed cookie information in the `cookies` field. Deprecated. Use Storage.getCookies instead.
 // Returns -  cookies - Array of cookie objects.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Network) GetAllCookies(ctx context.Context) ([]*NetworkCookie, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.getAllCookies"})
 	if err != nil {
@@ -983,6 +3091,8 @@ type NetworkGetCertificateParams struct {
 
 // GetCertificateWithParams - Returns the DER-encoded certificate.
 // Returns -  tableNames -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) GetCertificateWithParams(ctx context.Context, v *NetworkGetCertificateParams) ([]string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.getCertificate", Params: v})
 	if err != nil {
@@ -1014,6 +3124,8 @@ func (c *Network) GetCertificateWithParams(ctx context.Context, v *NetworkGetCer
 // GetCertificate - Returns the DER-encoded certificate.
 // origin - Origin to get certificate for.
 // Returns -  tableNames -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) GetCertificate(ctx context.Context, origin string) ([]string, error) {
 	var v NetworkGetCertificateParams
 	v.Origin = origin
@@ -1160,6 +3272,8 @@ type NetworkGetResponseBodyForInterceptionParams struct {
 
 // GetResponseBodyForInterceptionWithParams - Returns content served for the given currently intercepted request.
 // Returns -  body - Response body. base64Encoded - True, if content was sent as base64.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) GetResponseBodyForInterceptionWithParams(ctx context.Context, v *NetworkGetResponseBodyForInterceptionParams) (string, bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.getResponseBodyForInterception", Params: v})
 	if err != nil {
@@ -1192,6 +3306,8 @@ func (c *Network) GetResponseBodyForInterceptionWithParams(ctx context.Context,
 // GetResponseBodyForInterception - Returns content served for the given currently intercepted request.
 // interceptionId - Identifier for the intercepted request to get body for.
 // Returns -  body - Response body. base64Encoded - True, if content was sent as base64.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) GetResponseBodyForInterception(ctx context.Context, interceptionId string) (string, bool, error) {
 	var v NetworkGetResponseBodyForInterceptionParams
 	v.InterceptionId = interceptionId
@@ -1205,6 +3321,8 @@ type NetworkTakeResponseBodyForInterceptionAsStreamParams struct {
 
 // TakeResponseBodyForInterceptionAsStreamWithParams - Returns a handle to the stream representing the response body. Note that after this command, the intercepted request can't be continued as is -- you either need to cancel it or to provide the response body. The stream only supports sequential read, IO.read will fail if the position is specified.
 // Returns -  stream -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) TakeResponseBodyForInterceptionAsStreamWithParams(ctx context.Context, v *NetworkTakeResponseBodyForInterceptionAsStreamParams) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.takeResponseBodyForInterceptionAsStream", Params: v})
 	if err != nil {
@@ -1236,6 +3354,8 @@ func (c *Network) TakeResponseBodyForInterceptionAsStreamWithParams(ctx context.
 // TakeResponseBodyForInterceptionAsStream - Returns a handle to the stream representing the response body. Note that after this command, the intercepted request can't be continued as is -- you either need to cancel it or to provide the response body. The stream only supports sequential read, IO.read will fail if the position is specified.
 // interceptionId -
 // Returns -  stream -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) TakeResponseBodyForInterceptionAsStream(ctx context.Context, interceptionId string) (string, error) {
 	var v NetworkTakeResponseBodyForInterceptionAsStreamParams
 	v.InterceptionId = interceptionId
@@ -1248,12 +3368,16 @@ type NetworkReplayXHRParams struct {
 }
 
 // ReplayXHRWithParams - This method sends a new XMLHttpRequest which is identical to the original one. The following parameters should be identical: method, url, async, request body, extra headers, withCredentials attribute, user, password.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) ReplayXHRWithParams(ctx context.Context, v *NetworkReplayXHRParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.replayXHR", Params: v})
 }
 
 // ReplayXHR - This method sends a new XMLHttpRequest which is identical to the original one. The following parameters should be identical: method, url, async, request body, extra headers, withCredentials attribute, user, password.
 // requestId - Identifier of XHR to replay.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) ReplayXHR(ctx context.Context, requestId string) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkReplayXHRParams
 	v.RequestId = requestId
@@ -1273,6 +3397,8 @@ type NetworkSearchInResponseBodyParams struct {
 
 // SearchInResponseBodyWithParams - Searches for given string in response content.
 // Returns -  result - List of search matches.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SearchInResponseBodyWithParams(ctx context.Context, v *NetworkSearchInResponseBodyParams) ([]*DebuggerSearchMatch, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.searchInResponseBody", Params: v})
 	if err != nil {
@@ -1307,6 +3433,8 @@ func (c *Network) SearchInResponseBodyWithParams(ctx context.Context, v *Network
 // caseSensitive - If true, search is case sensitive.
 // isRegex - If true, treats string parameter as regex.
 // Returns -  result - List of search matches.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SearchInResponseBody(ctx context.Context, requestId string, query string, caseSensitive bool, isRegex bool) ([]*DebuggerSearchMatch, error) {
 	var v NetworkSearchInResponseBodyParams
 	v.RequestId = requestId
@@ -1322,12 +3450,16 @@ type NetworkSetBlockedURLsParams struct {
 }
 
 // SetBlockedURLsWithParams - Blocks URLs from loading.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SetBlockedURLsWithParams(ctx context.Context, v *NetworkSetBlockedURLsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.setBlockedURLs", Params: v})
 }
 
 // SetBlockedURLs - Blocks URLs from loading.
 // urls - URL patterns to block. Wildcards ('*') are allowed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SetBlockedURLs(ctx context.Context, urls []string) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkSetBlockedURLsParams
 	v.Urls = urls
@@ -1386,19 +3518,29 @@ type NetworkSetCookieParams struct {
 	// True if cookie is http-only.
 	HttpOnly bool `json:"httpOnly,omitempty"`
 	// Cookie SameSite type. enum values: Strict, Lax, None
-	SameSite string `json:"sameSite,omitempty"`
+	SameSite NetworkCookieSameSite `json:"sameSite,omitempty"`
 	// Cookie expiration date, session cookie if not set
 	Expires float64 `json:"expires,omitempty"`
 	// Cookie Priority type. enum values: Low, Medium, High
-	Priority string `json:"priority,omitempty"`
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	Priority NetworkCookiePriority `json:"priority,omitempty"`
 	// True if cookie is SameParty.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	SameParty bool `json:"sameParty,omitempty"`
 	// Cookie source scheme type. enum values: Unset, NonSecure, Secure
-	SourceScheme string `json:"sourceScheme,omitempty"`
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	SourceScheme NetworkCookieSourceScheme `json:"sourceScheme,omitempty"`
 	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	SourcePort int `json:"sourcePort,omitempty"`
-	// Cookie partition key. The site of the top-level URL the browser was visiting at the start of the request to the endpoint that set the cookie. If not set, the cookie will be set as not partitioned.
-	PartitionKey string `json:"partitionKey,omitempty"`
+	// Cookie partition key. If not set, the cookie will be set as not partitioned.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
 }
 
 // SetCookieWithParams - Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.
@@ -1445,9 +3587,9 @@ func (c *Network) SetCookieWithParams(ctx context.Context, v *NetworkSetCookiePa
 // sameParty - True if cookie is SameParty.
 // sourceScheme - Cookie source scheme type. enum values: Unset, NonSecure, Secure
 // sourcePort - Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.
-// partitionKey - Cookie partition key. The site of the top-level URL the browser was visiting at the start of the request to the endpoint that set the cookie. If not set, the cookie will be set as not partitioned.
+// partitionKey - Cookie partition key. If not set, the cookie will be set as not partitioned.
 // Returns -  success - Always set to true. If an error occurs, the response indicates protocol error.
-func (c *Network) SetCookie(ctx context.Context, name string, value string, url string, domain string, path string, secure bool, httpOnly bool, sameSite string, expires float64, priority string, sameParty bool, sourceScheme string, sourcePort int, partitionKey string) (bool, error) {
+func (c *Network) SetCookie(ctx context.Context, name string, value string, url string, domain string, path string, secure bool, httpOnly bool, sameSite NetworkCookieSameSite, expires float64, priority NetworkCookiePriority, sameParty bool, sourceScheme NetworkCookieSourceScheme, sourcePort int, partitionKey *NetworkCookiePartitionKey) (bool, error) {
 	var v NetworkSetCookieParams
 	v.Name = name
 	v.Value = value
@@ -1508,30 +3650,43 @@ type NetworkSetAttachDebugStackParams struct {
 }
 
 // SetAttachDebugStackWithParams - Specifies whether to attach a page script stack id in requests
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SetAttachDebugStackWithParams(ctx context.Context, v *NetworkSetAttachDebugStackParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.setAttachDebugStack", Params: v})
 }
 
 // SetAttachDebugStack - Specifies whether to attach a page script stack id in requests
 // enabled - Whether to attach a page script stack for debugging purpose.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SetAttachDebugStack(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkSetAttachDebugStackParams
 	v.Enabled = enabled
 	return c.SetAttachDebugStackWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type NetworkSetRequestInterceptionParams struct {
 	// Requests matching any of these patterns will be forwarded and wait for the corresponding continueInterceptedRequest call.
 	Patterns []*NetworkRequestPattern `json:"patterns"`
 }
 
 // SetRequestInterceptionWithParams - Sets the requests to intercept that match the provided patterns and optionally resource types. Deprecated, please use Fetch.enable instead.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SetRequestInterceptionWithParams(ctx context.Context, v *NetworkSetRequestInterceptionParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.setRequestInterception", Params: v})
 }
 
 // SetRequestInterception - Sets the requests to intercept that match the provided patterns and optionally resource types. Deprecated, please use Fetch.enable instead.
 // patterns - Requests matching any of these patterns will be forwarded and wait for the corresponding continueInterceptedRequest call.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) SetRequestInterception(ctx context.Context, patterns []*NetworkRequestPattern) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkSetRequestInterceptionParams
 	v.Patterns = patterns
@@ -1541,11 +3696,13 @@ func (c *Network) SetRequestInterception(ctx context.Context, patterns []*Networ
 type NetworkSetUserAgentOverrideParams struct {
 	// User agent to use.
 	UserAgent string `json:"userAgent"`
-	// Browser langugage to emulate.
+	// Browser language to emulate.
 	AcceptLanguage string `json:"acceptLanguage,omitempty"`
 	// The platform navigator.platform should return.
 	Platform string `json:"platform,omitempty"`
 	// To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	UserAgentMetadata *EmulationUserAgentMetadata `json:"userAgentMetadata,omitempty"`
 }
 
@@ -1556,7 +3713,7 @@ func (c *Network) SetUserAgentOverrideWithParams(ctx context.Context, v *Network
 
 // SetUserAgentOverride - Allows overriding user agent with the given string.
 // userAgent - User agent to use.
-// acceptLanguage - Browser langugage to emulate.
+// acceptLanguage - Browser language to emulate.
 // platform - The platform navigator.platform should return.
 // userAgentMetadata - To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
 func (c *Network) SetUserAgentOverride(ctx context.Context, userAgent string, acceptLanguage string, platform string, userAgentMetadata *EmulationUserAgentMetadata) (*gcdmessage.ChromeResponse, error) {
@@ -1568,6 +3725,54 @@ func (c *Network) SetUserAgentOverride(ctx context.Context, userAgent string, ac
 	return c.SetUserAgentOverrideWithParams(ctx, &v)
 }
 
+type NetworkStreamResourceContentParams struct {
+	// Identifier of the request to stream.
+	RequestId string `json:"requestId"`
+}
+
+// StreamResourceContentWithParams - Enables streaming of the response for the given requestId. If enabled, the dataReceived event contains the data that was received during streaming.
+// Returns -  bufferedData - Data that has been buffered until streaming is enabled. (Encoded as a base64 string when passed over JSON)
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) StreamResourceContentWithParams(ctx context.Context, v *NetworkStreamResourceContentParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.streamResourceContent", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			BufferedData string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.BufferedData, nil
+}
+
+// StreamResourceContent - Enables streaming of the response for the given requestId. If enabled, the dataReceived event contains the data that was received during streaming.
+// requestId - Identifier of the request to stream.
+// Returns -  bufferedData - Data that has been buffered until streaming is enabled. (Encoded as a base64 string when passed over JSON)
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) StreamResourceContent(ctx context.Context, requestId string) (string, error) {
+	var v NetworkStreamResourceContentParams
+	v.RequestId = requestId
+	return c.StreamResourceContentWithParams(ctx, &v)
+}
+
 type NetworkGetSecurityIsolationStatusParams struct {
 	// If no frameId is provided, the status of the target is provided.
 	FrameId string `json:"frameId,omitempty"`
@@ -1575,6 +3780,8 @@ type NetworkGetSecurityIsolationStatusParams struct {
 
 // GetSecurityIsolationStatusWithParams - Returns information about the COEP/COOP isolation status.
 // Returns -  status -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) GetSecurityIsolationStatusWithParams(ctx context.Context, v *NetworkGetSecurityIsolationStatusParams) (*NetworkSecurityIsolationStatus, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.getSecurityIsolationStatus", Params: v})
 	if err != nil {
@@ -1606,6 +3813,8 @@ func (c *Network) GetSecurityIsolationStatusWithParams(ctx context.Context, v *N
 // GetSecurityIsolationStatus - Returns information about the COEP/COOP isolation status.
 // frameId - If no frameId is provided, the status of the target is provided.
 // Returns -  status -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) GetSecurityIsolationStatus(ctx context.Context, frameId string) (*NetworkSecurityIsolationStatus, error) {
 	var v NetworkGetSecurityIsolationStatusParams
 	v.FrameId = frameId
@@ -1618,12 +3827,16 @@ type NetworkEnableReportingApiParams struct {
 }
 
 // EnableReportingApiWithParams - Enables tracking for the Reporting API, events generated by the Reporting API will now be delivered to the client. Enabling triggers 'reportingApiReportAdded' for all existing reports.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) EnableReportingApiWithParams(ctx context.Context, v *NetworkEnableReportingApiParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.enableReportingApi", Params: v})
 }
 
 // EnableReportingApi - Enables tracking for the Reporting API, events generated by the Reporting API will now be delivered to the client. Enabling triggers 'reportingApiReportAdded' for all existing reports.
 // enable - Whether to enable or disable events for the Reporting API
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) EnableReportingApi(ctx context.Context, enable bool) (*gcdmessage.ChromeResponse, error) {
 	var v NetworkEnableReportingApiParams
 	v.Enable = enable
@@ -1641,6 +3854,8 @@ type NetworkLoadNetworkResourceParams struct {
 
 // LoadNetworkResourceWithParams - Fetches the resource and returns the content.
 // Returns -  resource -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) LoadNetworkResourceWithParams(ctx context.Context, v *NetworkLoadNetworkResourceParams) (*NetworkLoadNetworkResourcePageResult, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.loadNetworkResource", Params: v})
 	if err != nil {
@@ -1674,6 +3889,8 @@ func (c *Network) LoadNetworkResourceWithParams(ctx context.Context, v *NetworkL
 // url - URL of the resource to get content for.
 // options - Options for the request.
 // Returns -  resource -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Network) LoadNetworkResource(ctx context.Context, frameId string, url string, options *NetworkLoadNetworkResourceOptions) (*NetworkLoadNetworkResourcePageResult, error) {
 	var v NetworkLoadNetworkResourceParams
 	v.FrameId = frameId
@@ -1681,3 +3898,33 @@ func (c *Network) LoadNetworkResource(ctx context.Context, frameId string, url s
 	v.Options = options
 	return c.LoadNetworkResourceWithParams(ctx, &v)
 }
+
+type NetworkSetCookieControlsParams struct {
+	// Whether 3pc restriction is enabled.
+	EnableThirdPartyCookieRestriction bool `json:"enableThirdPartyCookieRestriction"`
+	// Whether 3pc grace period exception should be enabled; false by default.
+	DisableThirdPartyCookieMetadata bool `json:"disableThirdPartyCookieMetadata"`
+	// Whether 3pc heuristics exceptions should be enabled; false by default.
+	DisableThirdPartyCookieHeuristics bool `json:"disableThirdPartyCookieHeuristics"`
+}
+
+// SetCookieControlsWithParams - Sets Controls for third-party cookie access Page reload is required before the new cookie behavior will be observed
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) SetCookieControlsWithParams(ctx context.Context, v *NetworkSetCookieControlsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Network.setCookieControls", Params: v})
+}
+
+// SetCookieControls - Sets Controls for third-party cookie access Page reload is required before the new cookie behavior will be observed
+// enableThirdPartyCookieRestriction - Whether 3pc restriction is enabled.
+// disableThirdPartyCookieMetadata - Whether 3pc grace period exception should be enabled; false by default.
+// disableThirdPartyCookieHeuristics - Whether 3pc heuristics exceptions should be enabled; false by default.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Network) SetCookieControls(ctx context.Context, enableThirdPartyCookieRestriction bool, disableThirdPartyCookieMetadata bool, disableThirdPartyCookieHeuristics bool) (*gcdmessage.ChromeResponse, error) {
+	var v NetworkSetCookieControlsParams
+	v.EnableThirdPartyCookieRestriction = enableThirdPartyCookieRestriction
+	v.DisableThirdPartyCookieMetadata = disableThirdPartyCookieMetadata
+	v.DisableThirdPartyCookieHeuristics = disableThirdPartyCookieHeuristics
+	return c.SetCookieControlsWithParams(ctx, &v)
+}
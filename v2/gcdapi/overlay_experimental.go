@@ -0,0 +1,724 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Overlay functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnInspectNodeRequested subscribes to Overlay.inspectNodeRequested events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Overlay) OnInspectNodeRequested(callback func(*OverlayInspectNodeRequestedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventOverlayInspectNodeRequested, func(payload []byte) error {
+		event := &OverlayInspectNodeRequestedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnNodeHighlightRequested subscribes to Overlay.nodeHighlightRequested events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Overlay) OnNodeHighlightRequested(callback func(*OverlayNodeHighlightRequestedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventOverlayNodeHighlightRequested, func(payload []byte) error {
+		event := &OverlayNodeHighlightRequestedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnScreenshotRequested subscribes to Overlay.screenshotRequested events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Overlay) OnScreenshotRequested(callback func(*OverlayScreenshotRequestedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventOverlayScreenshotRequested, func(payload []byte) error {
+		event := &OverlayScreenshotRequestedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnInspectModeCanceled subscribes to Overlay.inspectModeCanceled events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Overlay) OnInspectModeCanceled(callback func(*OverlayInspectModeCanceledEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventOverlayInspectModeCanceled, func(payload []byte) error {
+		event := &OverlayInspectModeCanceledEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// Disables domain notifications.
+func (c *Overlay) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.disable"})
+}
+
+// Enables domain notifications.
+func (c *Overlay) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.enable"})
+}
+
+type OverlayGetHighlightObjectForTestParams struct {
+	// Id of the node to get highlight object for.
+	NodeId int `json:"nodeId"`
+	// Whether to include distance info.
+	IncludeDistance bool `json:"includeDistance,omitempty"`
+	// Whether to include style info.
+	IncludeStyle bool `json:"includeStyle,omitempty"`
+	// The color format to get config with (default: hex). enum values: rgb, hsl, hwb, hex
+	ColorFormat OverlayColorFormat `json:"colorFormat,omitempty"`
+	// Whether to show accessibility info (default: true).
+	ShowAccessibilityInfo bool `json:"showAccessibilityInfo,omitempty"`
+}
+
+// GetHighlightObjectForTestWithParams - For testing.
+// Returns -  highlight - Highlight data for the node.
+func (c *Overlay) GetHighlightObjectForTestWithParams(ctx context.Context, v *OverlayGetHighlightObjectForTestParams) (map[string]interface{}, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.getHighlightObjectForTest", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Highlight map[string]interface{}
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Highlight, nil
+}
+
+// GetHighlightObjectForTest - For testing.
+// nodeId - Id of the node to get highlight object for.
+// includeDistance - Whether to include distance info.
+// includeStyle - Whether to include style info.
+// colorFormat - The color format to get config with (default: hex). enum values: rgb, hsl, hwb, hex
+// showAccessibilityInfo - Whether to show accessibility info (default: true).
+// Returns -  highlight - Highlight data for the node.
+func (c *Overlay) GetHighlightObjectForTest(ctx context.Context, nodeId int, includeDistance bool, includeStyle bool, colorFormat OverlayColorFormat, showAccessibilityInfo bool) (map[string]interface{}, error) {
+	var v OverlayGetHighlightObjectForTestParams
+	v.NodeId = nodeId
+	v.IncludeDistance = includeDistance
+	v.IncludeStyle = includeStyle
+	v.ColorFormat = colorFormat
+	v.ShowAccessibilityInfo = showAccessibilityInfo
+	return c.GetHighlightObjectForTestWithParams(ctx, &v)
+}
+
+type OverlayGetGridHighlightObjectsForTestParams struct {
+	// Ids of the node to get highlight object for.
+	NodeIds []int `json:"nodeIds"`
+}
+
+// GetGridHighlightObjectsForTestWithParams - For Persistent Grid testing.
+// Returns -  highlights - Grid Highlight data for the node ids provided.
+func (c *Overlay) GetGridHighlightObjectsForTestWithParams(ctx context.Context, v *OverlayGetGridHighlightObjectsForTestParams) (map[string]interface{}, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.getGridHighlightObjectsForTest", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Highlights map[string]interface{}
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Highlights, nil
+}
+
+// GetGridHighlightObjectsForTest - For Persistent Grid testing.
+// nodeIds - Ids of the node to get highlight object for.
+// Returns -  highlights - Grid Highlight data for the node ids provided.
+func (c *Overlay) GetGridHighlightObjectsForTest(ctx context.Context, nodeIds []int) (map[string]interface{}, error) {
+	var v OverlayGetGridHighlightObjectsForTestParams
+	v.NodeIds = nodeIds
+	return c.GetGridHighlightObjectsForTestWithParams(ctx, &v)
+}
+
+type OverlayGetSourceOrderHighlightObjectForTestParams struct {
+	// Id of the node to highlight.
+	NodeId int `json:"nodeId"`
+}
+
+// GetSourceOrderHighlightObjectForTestWithParams - For Source Order Viewer testing.
+// Returns -  highlight - Source order highlight data for the node id provided.
+func (c *Overlay) GetSourceOrderHighlightObjectForTestWithParams(ctx context.Context, v *OverlayGetSourceOrderHighlightObjectForTestParams) (map[string]interface{}, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.getSourceOrderHighlightObjectForTest", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Highlight map[string]interface{}
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Highlight, nil
+}
+
+// GetSourceOrderHighlightObjectForTest - For Source Order Viewer testing.
+// nodeId - Id of the node to highlight.
+// Returns -  highlight - Source order highlight data for the node id provided.
+func (c *Overlay) GetSourceOrderHighlightObjectForTest(ctx context.Context, nodeId int) (map[string]interface{}, error) {
+	var v OverlayGetSourceOrderHighlightObjectForTestParams
+	v.NodeId = nodeId
+	return c.GetSourceOrderHighlightObjectForTestWithParams(ctx, &v)
+}
+
+// Hides any highlight.
+func (c *Overlay) HideHighlight(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.hideHighlight"})
+}
+
+// Deprecated: this command is deprecated in the protocol.
+type OverlayHighlightFrameParams struct {
+	// Identifier of the frame to highlight.
+	FrameId string `json:"frameId"`
+	// The content box highlight fill color (default: transparent).
+	ContentColor *DOMRGBA `json:"contentColor,omitempty"`
+	// The content box highlight outline color (default: transparent).
+	ContentOutlineColor *DOMRGBA `json:"contentOutlineColor,omitempty"`
+}
+
+// HighlightFrameWithParams - Highlights owner element of the frame with given id. Deprecated: Doesn't work reliably and cannot be fixed due to process separation (the owner node might be in a different process). Determine the owner node in the client and use highlightNode.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Overlay) HighlightFrameWithParams(ctx context.Context, v *OverlayHighlightFrameParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.highlightFrame", Params: v})
+}
+
+// HighlightFrame - Highlights owner element of the frame with given id. Deprecated: Doesn't work reliably and cannot be fixed due to process separation (the owner node might be in a different process). Determine the owner node in the client and use highlightNode.
+// frameId - Identifier of the frame to highlight.
+// contentColor - The content box highlight fill color (default: transparent).
+// contentOutlineColor - The content box highlight outline color (default: transparent).
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Overlay) HighlightFrame(ctx context.Context, frameId string, contentColor *DOMRGBA, contentOutlineColor *DOMRGBA) (*gcdmessage.ChromeResponse, error) {
+	var v OverlayHighlightFrameParams
+	v.FrameId = frameId
+	v.ContentColor = contentColor
+	v.ContentOutlineColor = contentOutlineColor
+	return c.HighlightFrameWithParams(ctx, &v)
+}
+
+type OverlayHighlightNodeParams struct {
+	// A descriptor for the highlight appearance.
+	HighlightConfig *OverlayHighlightConfig `json:"highlightConfig"`
+	// Identifier of the node to highlight.
+	NodeId int `json:"nodeId,omitempty"`
+	// Identifier of the backend node to highlight.
+	BackendNodeId int `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node to be highlighted.
+	ObjectId string `json:"objectId,omitempty"`
+	// Selectors to highlight relevant nodes.
+	Selector string `json:"selector,omitempty"`
+}
+
+// HighlightNodeWithParams - Highlights DOM node with given id or with the given JavaScript object wrapper. Either nodeId or objectId must be specified.
+func (c *Overlay) HighlightNodeWithParams(ctx context.Context, v *OverlayHighlightNodeParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.highlightNode", Params: v})
+}
+
+// HighlightNode - Highlights DOM node with given id or with the given JavaScript object wrapper. Either nodeId or objectId must be specified.
+// highlightConfig - A descriptor for the highlight appearance.
+// nodeId - Identifier of the node to highlight.
+// backendNodeId - Identifier of the backend node to highlight.
+// objectId - JavaScript object id of the node to be highlighted.
+// selector - Selectors to highlight relevant nodes.
+func (c *Overlay) HighlightNode(ctx context.Context, highlightConfig *OverlayHighlightConfig, nodeId int, backendNodeId int, objectId string, selector string) (*gcdmessage.ChromeResponse, error) {
+	var v OverlayHighlightNodeParams
+	v.HighlightConfig = highlightConfig
+	v.NodeId = nodeId
+	v.BackendNodeId = backendNodeId
+	v.ObjectId = objectId
+	v.Selector = selector
+	return c.HighlightNodeWithParams(ctx, &v)
+}
+
+type OverlayHighlightQuadParams struct {
+	// Quad to highlight
+	Quad []float64 `json:"quad"`
+	// The highlight fill color (default: transparent).
+	Color *DOMRGBA `json:"color,omitempty"`
+	// The highlight outline color (default: transparent).
+	OutlineColor *DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// HighlightQuadWithParams - Highlights given quad. Coordinates are absolute with respect to the main frame viewport.
+func (c *Overlay) HighlightQuadWithParams(ctx context.Context, v *OverlayHighlightQuadParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.highlightQuad", Params: v})
+}
+
+// HighlightQuad - Highlights given quad. Coordinates are absolute with respect to the main frame viewport.
+// quad - Quad to highlight
+// color - The highlight fill color (default: transparent).
+// outlineColor - The highlight outline color (default: transparent).
+func (c *Overlay) HighlightQuad(ctx context.Context, quad []float64, color *DOMRGBA, outlineColor *DOMRGBA) (*gcdmessage.ChromeResponse, error) {
+	var v OverlayHighlightQuadParams
+	v.Quad = quad
+	v.Color = color
+	v.OutlineColor = outlineColor
+	return c.HighlightQuadWithParams(ctx, &v)
+}
+
+type OverlayHighlightRectParams struct {
+	// X coordinate
+	X int `json:"x"`
+	// Y coordinate
+	Y int `json:"y"`
+	// Rectangle width
+	Width int `json:"width"`
+	// Rectangle height
+	Height int `json:"height"`
+	// The highlight fill color (default: transparent).
+	Color *DOMRGBA `json:"color,omitempty"`
+	// The highlight outline color (default: transparent).
+	OutlineColor *DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// HighlightRectWithParams - Highlights given rectangle. Coordinates are absolute with respect to the main frame viewport.
+func (c *Overlay) HighlightRectWithParams(ctx context.Context, v *OverlayHighlightRectParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.highlightRect", Params: v})
+}
+
+// HighlightRect - Highlights given rectangle. Coordinates are absolute with respect to the main frame viewport.
+// x - X coordinate
+// y - Y coordinate
+// width - Rectangle width
+// height - Rectangle height
+// color - The highlight fill color (default: transparent).
+// outlineColor - The highlight outline color (default: transparent).
+func (c *Overlay) HighlightRect(ctx context.Context, x int, y int, width int, height int, color *DOMRGBA, outlineColor *DOMRGBA) (*gcdmessage.ChromeResponse, error) {
+	var v OverlayHighlightRectParams
+	v.X = x
+	v.Y = y
+	v.Width = width
+	v.Height = height
+	v.Color = color
+	v.OutlineColor = outlineColor
+	return c.HighlightRectWithParams(ctx, &v)
+}
+
+type OverlayHighlightSourceOrderParams struct {
+	// A descriptor for the appearance of the overlay drawing.
+	SourceOrderConfig *OverlaySourceOrderConfig `json:"sourceOrderConfig"`
+	// Identifier of the node to highlight.
+	NodeId int `json:"nodeId,omitempty"`
+	// Identifier of the backend node to highlight.
+	BackendNodeId int `json:"backendNodeId,omitempty"`
+	// JavaScript object id of the node to be highlighted.
+	ObjectId string `json:"objectId,omitempty"`
+}
+
+// HighlightSourceOrderWithParams - Highlights the source order of the children of the DOM node with given id or with the given JavaScript object wrapper. Either nodeId or objectId must be specified.
+func (c *Overlay) HighlightSourceOrderWithParams(ctx context.Context, v *OverlayHighlightSourceOrderParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.highlightSourceOrder", Params: v})
+}
+
+// HighlightSourceOrder - Highlights the source order of the children of the DOM node with given id or with the given JavaScript object wrapper. Either nodeId or objectId must be specified.
+// sourceOrderConfig - A descriptor for the appearance of the overlay drawing.
+// nodeId - Identifier of the node to highlight.
+// backendNodeId - Identifier of the backend node to highlight.
+// objectId - JavaScript object id of the node to be highlighted.
+func (c *Overlay) HighlightSourceOrder(ctx context.Context, sourceOrderConfig *OverlaySourceOrderConfig, nodeId int, backendNodeId int, objectId string) (*gcdmessage.ChromeResponse, error) {
+	var v OverlayHighlightSourceOrderParams
+	v.SourceOrderConfig = sourceOrderConfig
+	v.NodeId = nodeId
+	v.BackendNodeId = backendNodeId
+	v.ObjectId = objectId
+	return c.HighlightSourceOrderWithParams(ctx, &v)
+}
+
+type OverlaySetInspectModeParams struct {
+	// Set an inspection mode. enum values: searchForNode, searchForUAShadowDOM, captureAreaScreenshot, none
+	Mode OverlayInspectMode `json:"mode"`
+	// A descriptor for the highlight appearance of hovered-over nodes. May be omitted if `enabled == false`.
+	HighlightConfig *OverlayHighlightConfig `json:"highlightConfig,omitempty"`
+}
+
+// SetInspectModeWithParams - Enters the 'inspect' mode. In this mode, elements that user is hovering over are highlighted. Backend then generates 'inspectNodeRequested' event upon element selection.
+func (c *Overlay) SetInspectModeWithParams(ctx context.Context, v *OverlaySetInspectModeParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setInspectMode", Params: v})
+}
+
+// SetInspectMode - Enters the 'inspect' mode. In this mode, elements that user is hovering over are highlighted. Backend then generates 'inspectNodeRequested' event upon element selection.
+// mode - Set an inspection mode. enum values: searchForNode, searchForUAShadowDOM, captureAreaScreenshot, none
+// highlightConfig - A descriptor for the highlight appearance of hovered-over nodes. May be omitted if `enabled == false`.
+func (c *Overlay) SetInspectMode(ctx context.Context, mode OverlayInspectMode, highlightConfig *OverlayHighlightConfig) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetInspectModeParams
+	v.Mode = mode
+	v.HighlightConfig = highlightConfig
+	return c.SetInspectModeWithParams(ctx, &v)
+}
+
+type OverlaySetShowAdHighlightsParams struct {
+	// True for showing ad highlights
+	Show bool `json:"show"`
+}
+
+// SetShowAdHighlightsWithParams - Highlights owner element of all frames detected to be ads.
+func (c *Overlay) SetShowAdHighlightsWithParams(ctx context.Context, v *OverlaySetShowAdHighlightsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowAdHighlights", Params: v})
+}
+
+// SetShowAdHighlights - Highlights owner element of all frames detected to be ads.
+// show - True for showing ad highlights
+func (c *Overlay) SetShowAdHighlights(ctx context.Context, show bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowAdHighlightsParams
+	v.Show = show
+	return c.SetShowAdHighlightsWithParams(ctx, &v)
+}
+
+type OverlaySetPausedInDebuggerMessageParams struct {
+	// The message to display, also triggers resume and step over controls.
+	Message string `json:"message,omitempty"`
+}
+
+// SetPausedInDebuggerMessageWithParams -
+func (c *Overlay) SetPausedInDebuggerMessageWithParams(ctx context.Context, v *OverlaySetPausedInDebuggerMessageParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setPausedInDebuggerMessage", Params: v})
+}
+
+// SetPausedInDebuggerMessage -
+// message - The message to display, also triggers resume and step over controls.
+func (c *Overlay) SetPausedInDebuggerMessage(ctx context.Context, message string) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetPausedInDebuggerMessageParams
+	v.Message = message
+	return c.SetPausedInDebuggerMessageWithParams(ctx, &v)
+}
+
+type OverlaySetShowDebugBordersParams struct {
+	// True for showing debug borders
+	Show bool `json:"show"`
+}
+
+// SetShowDebugBordersWithParams - Requests that backend shows debug borders on layers
+func (c *Overlay) SetShowDebugBordersWithParams(ctx context.Context, v *OverlaySetShowDebugBordersParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowDebugBorders", Params: v})
+}
+
+// SetShowDebugBorders - Requests that backend shows debug borders on layers
+// show - True for showing debug borders
+func (c *Overlay) SetShowDebugBorders(ctx context.Context, show bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowDebugBordersParams
+	v.Show = show
+	return c.SetShowDebugBordersWithParams(ctx, &v)
+}
+
+type OverlaySetShowFPSCounterParams struct {
+	// True for showing the FPS counter
+	Show bool `json:"show"`
+}
+
+// SetShowFPSCounterWithParams - Requests that backend shows the FPS counter
+func (c *Overlay) SetShowFPSCounterWithParams(ctx context.Context, v *OverlaySetShowFPSCounterParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowFPSCounter", Params: v})
+}
+
+// SetShowFPSCounter - Requests that backend shows the FPS counter
+// show - True for showing the FPS counter
+func (c *Overlay) SetShowFPSCounter(ctx context.Context, show bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowFPSCounterParams
+	v.Show = show
+	return c.SetShowFPSCounterWithParams(ctx, &v)
+}
+
+type OverlaySetShowGridOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	GridNodeHighlightConfigs []*OverlayGridNodeHighlightConfig `json:"gridNodeHighlightConfigs"`
+}
+
+// SetShowGridOverlaysWithParams - Highlight multiple elements with the CSS Grid overlay.
+func (c *Overlay) SetShowGridOverlaysWithParams(ctx context.Context, v *OverlaySetShowGridOverlaysParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowGridOverlays", Params: v})
+}
+
+// SetShowGridOverlays - Highlight multiple elements with the CSS Grid overlay.
+// gridNodeHighlightConfigs - An array of node identifiers and descriptors for the highlight appearance.
+func (c *Overlay) SetShowGridOverlays(ctx context.Context, gridNodeHighlightConfigs []*OverlayGridNodeHighlightConfig) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowGridOverlaysParams
+	v.GridNodeHighlightConfigs = gridNodeHighlightConfigs
+	return c.SetShowGridOverlaysWithParams(ctx, &v)
+}
+
+type OverlaySetShowFlexOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	FlexNodeHighlightConfigs []*OverlayFlexNodeHighlightConfig `json:"flexNodeHighlightConfigs"`
+}
+
+// SetShowFlexOverlaysWithParams -
+func (c *Overlay) SetShowFlexOverlaysWithParams(ctx context.Context, v *OverlaySetShowFlexOverlaysParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowFlexOverlays", Params: v})
+}
+
+// SetShowFlexOverlays -
+// flexNodeHighlightConfigs - An array of node identifiers and descriptors for the highlight appearance.
+func (c *Overlay) SetShowFlexOverlays(ctx context.Context, flexNodeHighlightConfigs []*OverlayFlexNodeHighlightConfig) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowFlexOverlaysParams
+	v.FlexNodeHighlightConfigs = flexNodeHighlightConfigs
+	return c.SetShowFlexOverlaysWithParams(ctx, &v)
+}
+
+type OverlaySetShowScrollSnapOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	ScrollSnapHighlightConfigs []*OverlayScrollSnapHighlightConfig `json:"scrollSnapHighlightConfigs"`
+}
+
+// SetShowScrollSnapOverlaysWithParams -
+func (c *Overlay) SetShowScrollSnapOverlaysWithParams(ctx context.Context, v *OverlaySetShowScrollSnapOverlaysParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowScrollSnapOverlays", Params: v})
+}
+
+// SetShowScrollSnapOverlays -
+// scrollSnapHighlightConfigs - An array of node identifiers and descriptors for the highlight appearance.
+func (c *Overlay) SetShowScrollSnapOverlays(ctx context.Context, scrollSnapHighlightConfigs []*OverlayScrollSnapHighlightConfig) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowScrollSnapOverlaysParams
+	v.ScrollSnapHighlightConfigs = scrollSnapHighlightConfigs
+	return c.SetShowScrollSnapOverlaysWithParams(ctx, &v)
+}
+
+type OverlaySetShowContainerQueryOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	ContainerQueryHighlightConfigs []*OverlayContainerQueryHighlightConfig `json:"containerQueryHighlightConfigs"`
+}
+
+// SetShowContainerQueryOverlaysWithParams -
+func (c *Overlay) SetShowContainerQueryOverlaysWithParams(ctx context.Context, v *OverlaySetShowContainerQueryOverlaysParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowContainerQueryOverlays", Params: v})
+}
+
+// SetShowContainerQueryOverlays -
+// containerQueryHighlightConfigs - An array of node identifiers and descriptors for the highlight appearance.
+func (c *Overlay) SetShowContainerQueryOverlays(ctx context.Context, containerQueryHighlightConfigs []*OverlayContainerQueryHighlightConfig) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowContainerQueryOverlaysParams
+	v.ContainerQueryHighlightConfigs = containerQueryHighlightConfigs
+	return c.SetShowContainerQueryOverlaysWithParams(ctx, &v)
+}
+
+type OverlaySetShowPaintRectsParams struct {
+	// True for showing paint rectangles
+	Result bool `json:"result"`
+}
+
+// SetShowPaintRectsWithParams - Requests that backend shows paint rectangles
+func (c *Overlay) SetShowPaintRectsWithParams(ctx context.Context, v *OverlaySetShowPaintRectsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowPaintRects", Params: v})
+}
+
+// SetShowPaintRects - Requests that backend shows paint rectangles
+// result - True for showing paint rectangles
+func (c *Overlay) SetShowPaintRects(ctx context.Context, result bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowPaintRectsParams
+	v.Result = result
+	return c.SetShowPaintRectsWithParams(ctx, &v)
+}
+
+type OverlaySetShowLayoutShiftRegionsParams struct {
+	// True for showing layout shift regions
+	Result bool `json:"result"`
+}
+
+// SetShowLayoutShiftRegionsWithParams - Requests that backend shows layout shift regions
+func (c *Overlay) SetShowLayoutShiftRegionsWithParams(ctx context.Context, v *OverlaySetShowLayoutShiftRegionsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowLayoutShiftRegions", Params: v})
+}
+
+// SetShowLayoutShiftRegions - Requests that backend shows layout shift regions
+// result - True for showing layout shift regions
+func (c *Overlay) SetShowLayoutShiftRegions(ctx context.Context, result bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowLayoutShiftRegionsParams
+	v.Result = result
+	return c.SetShowLayoutShiftRegionsWithParams(ctx, &v)
+}
+
+type OverlaySetShowScrollBottleneckRectsParams struct {
+	// True for showing scroll bottleneck rects
+	Show bool `json:"show"`
+}
+
+// SetShowScrollBottleneckRectsWithParams - Requests that backend shows scroll bottleneck rects
+func (c *Overlay) SetShowScrollBottleneckRectsWithParams(ctx context.Context, v *OverlaySetShowScrollBottleneckRectsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowScrollBottleneckRects", Params: v})
+}
+
+// SetShowScrollBottleneckRects - Requests that backend shows scroll bottleneck rects
+// show - True for showing scroll bottleneck rects
+func (c *Overlay) SetShowScrollBottleneckRects(ctx context.Context, show bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowScrollBottleneckRectsParams
+	v.Show = show
+	return c.SetShowScrollBottleneckRectsWithParams(ctx, &v)
+}
+
+// Deprecated: this command is deprecated in the protocol.
+type OverlaySetShowHitTestBordersParams struct {
+	// True for showing hit-test borders
+	Show bool `json:"show"`
+}
+
+// SetShowHitTestBordersWithParams - Deprecated, no longer has any effect.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Overlay) SetShowHitTestBordersWithParams(ctx context.Context, v *OverlaySetShowHitTestBordersParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowHitTestBorders", Params: v})
+}
+
+// SetShowHitTestBorders - Deprecated, no longer has any effect.
+// show - True for showing hit-test borders
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Overlay) SetShowHitTestBorders(ctx context.Context, show bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowHitTestBordersParams
+	v.Show = show
+	return c.SetShowHitTestBordersWithParams(ctx, &v)
+}
+
+// Deprecated: this command is deprecated in the protocol.
+type OverlaySetShowWebVitalsParams struct {
+	//
+	Show bool `json:"show"`
+}
+
+// SetShowWebVitalsWithParams - Deprecated, no longer has any effect.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Overlay) SetShowWebVitalsWithParams(ctx context.Context, v *OverlaySetShowWebVitalsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowWebVitals", Params: v})
+}
+
+// SetShowWebVitals - Deprecated, no longer has any effect.
+// show -
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Overlay) SetShowWebVitals(ctx context.Context, show bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowWebVitalsParams
+	v.Show = show
+	return c.SetShowWebVitalsWithParams(ctx, &v)
+}
+
+type OverlaySetShowViewportSizeOnResizeParams struct {
+	// Whether to paint size or not.
+	Show bool `json:"show"`
+}
+
+// SetShowViewportSizeOnResizeWithParams - Paints viewport size upon main frame resize.
+func (c *Overlay) SetShowViewportSizeOnResizeWithParams(ctx context.Context, v *OverlaySetShowViewportSizeOnResizeParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowViewportSizeOnResize", Params: v})
+}
+
+// SetShowViewportSizeOnResize - Paints viewport size upon main frame resize.
+// show - Whether to paint size or not.
+func (c *Overlay) SetShowViewportSizeOnResize(ctx context.Context, show bool) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowViewportSizeOnResizeParams
+	v.Show = show
+	return c.SetShowViewportSizeOnResizeWithParams(ctx, &v)
+}
+
+type OverlaySetShowHingeParams struct {
+	// hinge data, null means hideHinge
+	HingeConfig *OverlayHingeConfig `json:"hingeConfig,omitempty"`
+}
+
+// SetShowHingeWithParams - Add a dual screen device hinge
+func (c *Overlay) SetShowHingeWithParams(ctx context.Context, v *OverlaySetShowHingeParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowHinge", Params: v})
+}
+
+// SetShowHinge - Add a dual screen device hinge
+// hingeConfig - hinge data, null means hideHinge
+func (c *Overlay) SetShowHinge(ctx context.Context, hingeConfig *OverlayHingeConfig) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowHingeParams
+	v.HingeConfig = hingeConfig
+	return c.SetShowHingeWithParams(ctx, &v)
+}
+
+type OverlaySetShowIsolatedElementsParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	IsolatedElementHighlightConfigs []*OverlayIsolatedElementHighlightConfig `json:"isolatedElementHighlightConfigs"`
+}
+
+// SetShowIsolatedElementsWithParams - Show elements in isolation mode with overlays.
+func (c *Overlay) SetShowIsolatedElementsWithParams(ctx context.Context, v *OverlaySetShowIsolatedElementsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowIsolatedElements", Params: v})
+}
+
+// SetShowIsolatedElements - Show elements in isolation mode with overlays.
+// isolatedElementHighlightConfigs - An array of node identifiers and descriptors for the highlight appearance.
+func (c *Overlay) SetShowIsolatedElements(ctx context.Context, isolatedElementHighlightConfigs []*OverlayIsolatedElementHighlightConfig) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowIsolatedElementsParams
+	v.IsolatedElementHighlightConfigs = isolatedElementHighlightConfigs
+	return c.SetShowIsolatedElementsWithParams(ctx, &v)
+}
+
+type OverlaySetShowWindowControlsOverlayParams struct {
+	// Window Controls Overlay data, null means hide Window Controls Overlay
+	WindowControlsOverlayConfig *OverlayWindowControlsOverlayConfig `json:"windowControlsOverlayConfig,omitempty"`
+}
+
+// SetShowWindowControlsOverlayWithParams - Show Window Controls Overlay for PWA
+func (c *Overlay) SetShowWindowControlsOverlayWithParams(ctx context.Context, v *OverlaySetShowWindowControlsOverlayParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Overlay.setShowWindowControlsOverlay", Params: v})
+}
+
+// SetShowWindowControlsOverlay - Show Window Controls Overlay for PWA
+// windowControlsOverlayConfig - Window Controls Overlay data, null means hide Window Controls Overlay
+func (c *Overlay) SetShowWindowControlsOverlay(ctx context.Context, windowControlsOverlayConfig *OverlayWindowControlsOverlayConfig) (*gcdmessage.ChromeResponse, error) {
+	var v OverlaySetShowWindowControlsOverlayParams
+	v.WindowControlsOverlayConfig = windowControlsOverlayConfig
+	return c.SetShowWindowControlsOverlayWithParams(ctx, &v)
+}
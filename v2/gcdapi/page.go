@@ -9,85 +9,1349 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Indicates whether a frame has been identified as an ad.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageAdFrameType string
+
+const (
+	PageAdFrameTypeNone  PageAdFrameType = "none"
+	PageAdFrameTypeChild PageAdFrameType = "child"
+	PageAdFrameTypeRoot  PageAdFrameType = "root"
+)
+
+// Valid returns true if the value is one of the PageAdFrameType enum values.
+func (e PageAdFrameType) Valid() bool {
+	switch e {
+	case PageAdFrameTypeNone,
+		PageAdFrameTypeChild,
+		PageAdFrameTypeRoot:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageAdFrameExplanation string
+
+const (
+	PageAdFrameExplanationParentIsAd          PageAdFrameExplanation = "ParentIsAd"
+	PageAdFrameExplanationCreatedByAdScript   PageAdFrameExplanation = "CreatedByAdScript"
+	PageAdFrameExplanationMatchedBlockingRule PageAdFrameExplanation = "MatchedBlockingRule"
+)
+
+// Valid returns true if the value is one of the PageAdFrameExplanation enum values.
+func (e PageAdFrameExplanation) Valid() bool {
+	switch e {
+	case PageAdFrameExplanationParentIsAd,
+		PageAdFrameExplanationCreatedByAdScript,
+		PageAdFrameExplanationMatchedBlockingRule:
+		return true
+	}
+	return false
+}
+
+// Indicates whether the frame is a secure context and why it is the case.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageSecureContextType string
+
+const (
+	PageSecureContextTypeSecure           PageSecureContextType = "Secure"
+	PageSecureContextTypeSecureLocalhost  PageSecureContextType = "SecureLocalhost"
+	PageSecureContextTypeInsecureScheme   PageSecureContextType = "InsecureScheme"
+	PageSecureContextTypeInsecureAncestor PageSecureContextType = "InsecureAncestor"
+)
+
+// Valid returns true if the value is one of the PageSecureContextType enum values.
+func (e PageSecureContextType) Valid() bool {
+	switch e {
+	case PageSecureContextTypeSecure,
+		PageSecureContextTypeSecureLocalhost,
+		PageSecureContextTypeInsecureScheme,
+		PageSecureContextTypeInsecureAncestor:
+		return true
+	}
+	return false
+}
+
+// Indicates whether the frame is cross-origin isolated and why it is the case.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageCrossOriginIsolatedContextType string
+
+const (
+	PageCrossOriginIsolatedContextTypeIsolated                   PageCrossOriginIsolatedContextType = "Isolated"
+	PageCrossOriginIsolatedContextTypeNotIsolated                PageCrossOriginIsolatedContextType = "NotIsolated"
+	PageCrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled PageCrossOriginIsolatedContextType = "NotIsolatedFeatureDisabled"
+)
+
+// Valid returns true if the value is one of the PageCrossOriginIsolatedContextType enum values.
+func (e PageCrossOriginIsolatedContextType) Valid() bool {
+	switch e {
+	case PageCrossOriginIsolatedContextTypeIsolated,
+		PageCrossOriginIsolatedContextTypeNotIsolated,
+		PageCrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageGatedAPIFeatures string
+
+const (
+	PageGatedAPIFeaturesSharedArrayBuffers                PageGatedAPIFeatures = "SharedArrayBuffers"
+	PageGatedAPIFeaturesSharedArrayBuffersTransferAllowed PageGatedAPIFeatures = "SharedArrayBuffersTransferAllowed"
+	PageGatedAPIFeaturesPerformanceMeasureMemory          PageGatedAPIFeatures = "PerformanceMeasureMemory"
+	PageGatedAPIFeaturesPerformanceProfile                PageGatedAPIFeatures = "PerformanceProfile"
+)
+
+// Valid returns true if the value is one of the PageGatedAPIFeatures enum values.
+func (e PageGatedAPIFeatures) Valid() bool {
+	switch e {
+	case PageGatedAPIFeaturesSharedArrayBuffers,
+		PageGatedAPIFeaturesSharedArrayBuffersTransferAllowed,
+		PageGatedAPIFeaturesPerformanceMeasureMemory,
+		PageGatedAPIFeaturesPerformanceProfile:
+		return true
+	}
+	return false
+}
+
+// All Permissions Policy features. This enum should match the one defined in services/network/public/cpp/permissions_policy/permissions_policy_features.json5. LINT.IfChange(PermissionsPolicyFeature)
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PagePermissionsPolicyFeature string
+
+const (
+	PagePermissionsPolicyFeatureAccelerometer                  PagePermissionsPolicyFeature = "accelerometer"
+	PagePermissionsPolicyFeatureAllScreensCapture              PagePermissionsPolicyFeature = "all-screens-capture"
+	PagePermissionsPolicyFeatureAmbientLightSensor             PagePermissionsPolicyFeature = "ambient-light-sensor"
+	PagePermissionsPolicyFeatureAriaNotify                     PagePermissionsPolicyFeature = "aria-notify"
+	PagePermissionsPolicyFeatureAttributionReporting           PagePermissionsPolicyFeature = "attribution-reporting"
+	PagePermissionsPolicyFeatureAutoplay                       PagePermissionsPolicyFeature = "autoplay"
+	PagePermissionsPolicyFeatureBluetooth                      PagePermissionsPolicyFeature = "bluetooth"
+	PagePermissionsPolicyFeatureBrowsingTopics                 PagePermissionsPolicyFeature = "browsing-topics"
+	PagePermissionsPolicyFeatureCamera                         PagePermissionsPolicyFeature = "camera"
+	PagePermissionsPolicyFeatureCapturedSurfaceControl         PagePermissionsPolicyFeature = "captured-surface-control"
+	PagePermissionsPolicyFeatureChDpr                          PagePermissionsPolicyFeature = "ch-dpr"
+	PagePermissionsPolicyFeatureChDeviceMemory                 PagePermissionsPolicyFeature = "ch-device-memory"
+	PagePermissionsPolicyFeatureChDownlink                     PagePermissionsPolicyFeature = "ch-downlink"
+	PagePermissionsPolicyFeatureChEct                          PagePermissionsPolicyFeature = "ch-ect"
+	PagePermissionsPolicyFeatureChPrefersColorScheme           PagePermissionsPolicyFeature = "ch-prefers-color-scheme"
+	PagePermissionsPolicyFeatureChPrefersReducedMotion         PagePermissionsPolicyFeature = "ch-prefers-reduced-motion"
+	PagePermissionsPolicyFeatureChPrefersReducedTransparency   PagePermissionsPolicyFeature = "ch-prefers-reduced-transparency"
+	PagePermissionsPolicyFeatureChRtt                          PagePermissionsPolicyFeature = "ch-rtt"
+	PagePermissionsPolicyFeatureChSaveData                     PagePermissionsPolicyFeature = "ch-save-data"
+	PagePermissionsPolicyFeatureChUa                           PagePermissionsPolicyFeature = "ch-ua"
+	PagePermissionsPolicyFeatureChUaArch                       PagePermissionsPolicyFeature = "ch-ua-arch"
+	PagePermissionsPolicyFeatureChUaBitness                    PagePermissionsPolicyFeature = "ch-ua-bitness"
+	PagePermissionsPolicyFeatureChUaHighEntropyValues          PagePermissionsPolicyFeature = "ch-ua-high-entropy-values"
+	PagePermissionsPolicyFeatureChUaPlatform                   PagePermissionsPolicyFeature = "ch-ua-platform"
+	PagePermissionsPolicyFeatureChUaModel                      PagePermissionsPolicyFeature = "ch-ua-model"
+	PagePermissionsPolicyFeatureChUaMobile                     PagePermissionsPolicyFeature = "ch-ua-mobile"
+	PagePermissionsPolicyFeatureChUaFormFactors                PagePermissionsPolicyFeature = "ch-ua-form-factors"
+	PagePermissionsPolicyFeatureChUaFullVersion                PagePermissionsPolicyFeature = "ch-ua-full-version"
+	PagePermissionsPolicyFeatureChUaFullVersionList            PagePermissionsPolicyFeature = "ch-ua-full-version-list"
+	PagePermissionsPolicyFeatureChUaPlatformVersion            PagePermissionsPolicyFeature = "ch-ua-platform-version"
+	PagePermissionsPolicyFeatureChUaWow64                      PagePermissionsPolicyFeature = "ch-ua-wow64"
+	PagePermissionsPolicyFeatureChViewportHeight               PagePermissionsPolicyFeature = "ch-viewport-height"
+	PagePermissionsPolicyFeatureChViewportWidth                PagePermissionsPolicyFeature = "ch-viewport-width"
+	PagePermissionsPolicyFeatureChWidth                        PagePermissionsPolicyFeature = "ch-width"
+	PagePermissionsPolicyFeatureClipboardRead                  PagePermissionsPolicyFeature = "clipboard-read"
+	PagePermissionsPolicyFeatureClipboardWrite                 PagePermissionsPolicyFeature = "clipboard-write"
+	PagePermissionsPolicyFeatureComputePressure                PagePermissionsPolicyFeature = "compute-pressure"
+	PagePermissionsPolicyFeatureControlledFrame                PagePermissionsPolicyFeature = "controlled-frame"
+	PagePermissionsPolicyFeatureCrossOriginIsolated            PagePermissionsPolicyFeature = "cross-origin-isolated"
+	PagePermissionsPolicyFeatureDeferredFetch                  PagePermissionsPolicyFeature = "deferred-fetch"
+	PagePermissionsPolicyFeatureDeferredFetchMinimal           PagePermissionsPolicyFeature = "deferred-fetch-minimal"
+	PagePermissionsPolicyFeatureDeviceAttributes               PagePermissionsPolicyFeature = "device-attributes"
+	PagePermissionsPolicyFeatureDigitalCredentialsGet          PagePermissionsPolicyFeature = "digital-credentials-get"
+	PagePermissionsPolicyFeatureDirectSockets                  PagePermissionsPolicyFeature = "direct-sockets"
+	PagePermissionsPolicyFeatureDirectSocketsPrivate           PagePermissionsPolicyFeature = "direct-sockets-private"
+	PagePermissionsPolicyFeatureDisplayCapture                 PagePermissionsPolicyFeature = "display-capture"
+	PagePermissionsPolicyFeatureDocumentDomain                 PagePermissionsPolicyFeature = "document-domain"
+	PagePermissionsPolicyFeatureEncryptedMedia                 PagePermissionsPolicyFeature = "encrypted-media"
+	PagePermissionsPolicyFeatureExecutionWhileOutOfViewport    PagePermissionsPolicyFeature = "execution-while-out-of-viewport"
+	PagePermissionsPolicyFeatureExecutionWhileNotRendered      PagePermissionsPolicyFeature = "execution-while-not-rendered"
+	PagePermissionsPolicyFeatureFencedUnpartitionedStorageRead PagePermissionsPolicyFeature = "fenced-unpartitioned-storage-read"
+	PagePermissionsPolicyFeatureFocusWithoutUserActivation     PagePermissionsPolicyFeature = "focus-without-user-activation"
+	PagePermissionsPolicyFeatureFullscreen                     PagePermissionsPolicyFeature = "fullscreen"
+	PagePermissionsPolicyFeatureFrobulate                      PagePermissionsPolicyFeature = "frobulate"
+	PagePermissionsPolicyFeatureGamepad                        PagePermissionsPolicyFeature = "gamepad"
+	PagePermissionsPolicyFeatureGeolocation                    PagePermissionsPolicyFeature = "geolocation"
+	PagePermissionsPolicyFeatureGyroscope                      PagePermissionsPolicyFeature = "gyroscope"
+	PagePermissionsPolicyFeatureHid                            PagePermissionsPolicyFeature = "hid"
+	PagePermissionsPolicyFeatureIdentityCredentialsGet         PagePermissionsPolicyFeature = "identity-credentials-get"
+	PagePermissionsPolicyFeatureIdleDetection                  PagePermissionsPolicyFeature = "idle-detection"
+	PagePermissionsPolicyFeatureInterestCohort                 PagePermissionsPolicyFeature = "interest-cohort"
+	PagePermissionsPolicyFeatureJoinAdInterestGroup            PagePermissionsPolicyFeature = "join-ad-interest-group"
+	PagePermissionsPolicyFeatureKeyboardMap                    PagePermissionsPolicyFeature = "keyboard-map"
+	PagePermissionsPolicyFeatureLanguageDetector               PagePermissionsPolicyFeature = "language-detector"
+	PagePermissionsPolicyFeatureLanguageModel                  PagePermissionsPolicyFeature = "language-model"
+	PagePermissionsPolicyFeatureLocalFonts                     PagePermissionsPolicyFeature = "local-fonts"
+	PagePermissionsPolicyFeatureLocalNetworkAccess             PagePermissionsPolicyFeature = "local-network-access"
+	PagePermissionsPolicyFeatureMagnetometer                   PagePermissionsPolicyFeature = "magnetometer"
+	PagePermissionsPolicyFeatureMediaPlaybackWhileNotVisible   PagePermissionsPolicyFeature = "media-playback-while-not-visible"
+	PagePermissionsPolicyFeatureMicrophone                     PagePermissionsPolicyFeature = "microphone"
+	PagePermissionsPolicyFeatureMidi                           PagePermissionsPolicyFeature = "midi"
+	PagePermissionsPolicyFeatureOnDeviceSpeechRecognition      PagePermissionsPolicyFeature = "on-device-speech-recognition"
+	PagePermissionsPolicyFeatureOtpCredentials                 PagePermissionsPolicyFeature = "otp-credentials"
+	PagePermissionsPolicyFeaturePayment                        PagePermissionsPolicyFeature = "payment"
+	PagePermissionsPolicyFeaturePictureInPicture               PagePermissionsPolicyFeature = "picture-in-picture"
+	PagePermissionsPolicyFeaturePopins                         PagePermissionsPolicyFeature = "popins"
+	PagePermissionsPolicyFeaturePrivateAggregation             PagePermissionsPolicyFeature = "private-aggregation"
+	PagePermissionsPolicyFeaturePrivateStateTokenIssuance      PagePermissionsPolicyFeature = "private-state-token-issuance"
+	PagePermissionsPolicyFeaturePrivateStateTokenRedemption    PagePermissionsPolicyFeature = "private-state-token-redemption"
+	PagePermissionsPolicyFeaturePublickeyCredentialsCreate     PagePermissionsPolicyFeature = "publickey-credentials-create"
+	PagePermissionsPolicyFeaturePublickeyCredentialsGet        PagePermissionsPolicyFeature = "publickey-credentials-get"
+	PagePermissionsPolicyFeatureRecordAdAuctionEvents          PagePermissionsPolicyFeature = "record-ad-auction-events"
+	PagePermissionsPolicyFeatureRewriter                       PagePermissionsPolicyFeature = "rewriter"
+	PagePermissionsPolicyFeatureRunAdAuction                   PagePermissionsPolicyFeature = "run-ad-auction"
+	PagePermissionsPolicyFeatureScreenWakeLock                 PagePermissionsPolicyFeature = "screen-wake-lock"
+	PagePermissionsPolicyFeatureSerial                         PagePermissionsPolicyFeature = "serial"
+	PagePermissionsPolicyFeatureSharedAutofill                 PagePermissionsPolicyFeature = "shared-autofill"
+	PagePermissionsPolicyFeatureSharedStorage                  PagePermissionsPolicyFeature = "shared-storage"
+	PagePermissionsPolicyFeatureSharedStorageSelectUrl         PagePermissionsPolicyFeature = "shared-storage-select-url"
+	PagePermissionsPolicyFeatureSmartCard                      PagePermissionsPolicyFeature = "smart-card"
+	PagePermissionsPolicyFeatureSpeakerSelection               PagePermissionsPolicyFeature = "speaker-selection"
+	PagePermissionsPolicyFeatureStorageAccess                  PagePermissionsPolicyFeature = "storage-access"
+	PagePermissionsPolicyFeatureSubApps                        PagePermissionsPolicyFeature = "sub-apps"
+	PagePermissionsPolicyFeatureSummarizer                     PagePermissionsPolicyFeature = "summarizer"
+	PagePermissionsPolicyFeatureSyncXhr                        PagePermissionsPolicyFeature = "sync-xhr"
+	PagePermissionsPolicyFeatureTranslator                     PagePermissionsPolicyFeature = "translator"
+	PagePermissionsPolicyFeatureUnload                         PagePermissionsPolicyFeature = "unload"
+	PagePermissionsPolicyFeatureUsb                            PagePermissionsPolicyFeature = "usb"
+	PagePermissionsPolicyFeatureUsbUnrestricted                PagePermissionsPolicyFeature = "usb-unrestricted"
+	PagePermissionsPolicyFeatureVerticalScroll                 PagePermissionsPolicyFeature = "vertical-scroll"
+	PagePermissionsPolicyFeatureWebAppInstallation             PagePermissionsPolicyFeature = "web-app-installation"
+	PagePermissionsPolicyFeatureWebPrinting                    PagePermissionsPolicyFeature = "web-printing"
+	PagePermissionsPolicyFeatureWebShare                       PagePermissionsPolicyFeature = "web-share"
+	PagePermissionsPolicyFeatureWindowManagement               PagePermissionsPolicyFeature = "window-management"
+	PagePermissionsPolicyFeatureWriter                         PagePermissionsPolicyFeature = "writer"
+	PagePermissionsPolicyFeatureXrSpatialTracking              PagePermissionsPolicyFeature = "xr-spatial-tracking"
+)
+
+// Valid returns true if the value is one of the PagePermissionsPolicyFeature enum values.
+func (e PagePermissionsPolicyFeature) Valid() bool {
+	switch e {
+	case PagePermissionsPolicyFeatureAccelerometer,
+		PagePermissionsPolicyFeatureAllScreensCapture,
+		PagePermissionsPolicyFeatureAmbientLightSensor,
+		PagePermissionsPolicyFeatureAriaNotify,
+		PagePermissionsPolicyFeatureAttributionReporting,
+		PagePermissionsPolicyFeatureAutoplay,
+		PagePermissionsPolicyFeatureBluetooth,
+		PagePermissionsPolicyFeatureBrowsingTopics,
+		PagePermissionsPolicyFeatureCamera,
+		PagePermissionsPolicyFeatureCapturedSurfaceControl,
+		PagePermissionsPolicyFeatureChDpr,
+		PagePermissionsPolicyFeatureChDeviceMemory,
+		PagePermissionsPolicyFeatureChDownlink,
+		PagePermissionsPolicyFeatureChEct,
+		PagePermissionsPolicyFeatureChPrefersColorScheme,
+		PagePermissionsPolicyFeatureChPrefersReducedMotion,
+		PagePermissionsPolicyFeatureChPrefersReducedTransparency,
+		PagePermissionsPolicyFeatureChRtt,
+		PagePermissionsPolicyFeatureChSaveData,
+		PagePermissionsPolicyFeatureChUa,
+		PagePermissionsPolicyFeatureChUaArch,
+		PagePermissionsPolicyFeatureChUaBitness,
+		PagePermissionsPolicyFeatureChUaHighEntropyValues,
+		PagePermissionsPolicyFeatureChUaPlatform,
+		PagePermissionsPolicyFeatureChUaModel,
+		PagePermissionsPolicyFeatureChUaMobile,
+		PagePermissionsPolicyFeatureChUaFormFactors,
+		PagePermissionsPolicyFeatureChUaFullVersion,
+		PagePermissionsPolicyFeatureChUaFullVersionList,
+		PagePermissionsPolicyFeatureChUaPlatformVersion,
+		PagePermissionsPolicyFeatureChUaWow64,
+		PagePermissionsPolicyFeatureChViewportHeight,
+		PagePermissionsPolicyFeatureChViewportWidth,
+		PagePermissionsPolicyFeatureChWidth,
+		PagePermissionsPolicyFeatureClipboardRead,
+		PagePermissionsPolicyFeatureClipboardWrite,
+		PagePermissionsPolicyFeatureComputePressure,
+		PagePermissionsPolicyFeatureControlledFrame,
+		PagePermissionsPolicyFeatureCrossOriginIsolated,
+		PagePermissionsPolicyFeatureDeferredFetch,
+		PagePermissionsPolicyFeatureDeferredFetchMinimal,
+		PagePermissionsPolicyFeatureDeviceAttributes,
+		PagePermissionsPolicyFeatureDigitalCredentialsGet,
+		PagePermissionsPolicyFeatureDirectSockets,
+		PagePermissionsPolicyFeatureDirectSocketsPrivate,
+		PagePermissionsPolicyFeatureDisplayCapture,
+		PagePermissionsPolicyFeatureDocumentDomain,
+		PagePermissionsPolicyFeatureEncryptedMedia,
+		PagePermissionsPolicyFeatureExecutionWhileOutOfViewport,
+		PagePermissionsPolicyFeatureExecutionWhileNotRendered,
+		PagePermissionsPolicyFeatureFencedUnpartitionedStorageRead,
+		PagePermissionsPolicyFeatureFocusWithoutUserActivation,
+		PagePermissionsPolicyFeatureFullscreen,
+		PagePermissionsPolicyFeatureFrobulate,
+		PagePermissionsPolicyFeatureGamepad,
+		PagePermissionsPolicyFeatureGeolocation,
+		PagePermissionsPolicyFeatureGyroscope,
+		PagePermissionsPolicyFeatureHid,
+		PagePermissionsPolicyFeatureIdentityCredentialsGet,
+		PagePermissionsPolicyFeatureIdleDetection,
+		PagePermissionsPolicyFeatureInterestCohort,
+		PagePermissionsPolicyFeatureJoinAdInterestGroup,
+		PagePermissionsPolicyFeatureKeyboardMap,
+		PagePermissionsPolicyFeatureLanguageDetector,
+		PagePermissionsPolicyFeatureLanguageModel,
+		PagePermissionsPolicyFeatureLocalFonts,
+		PagePermissionsPolicyFeatureLocalNetworkAccess,
+		PagePermissionsPolicyFeatureMagnetometer,
+		PagePermissionsPolicyFeatureMediaPlaybackWhileNotVisible,
+		PagePermissionsPolicyFeatureMicrophone,
+		PagePermissionsPolicyFeatureMidi,
+		PagePermissionsPolicyFeatureOnDeviceSpeechRecognition,
+		PagePermissionsPolicyFeatureOtpCredentials,
+		PagePermissionsPolicyFeaturePayment,
+		PagePermissionsPolicyFeaturePictureInPicture,
+		PagePermissionsPolicyFeaturePopins,
+		PagePermissionsPolicyFeaturePrivateAggregation,
+		PagePermissionsPolicyFeaturePrivateStateTokenIssuance,
+		PagePermissionsPolicyFeaturePrivateStateTokenRedemption,
+		PagePermissionsPolicyFeaturePublickeyCredentialsCreate,
+		PagePermissionsPolicyFeaturePublickeyCredentialsGet,
+		PagePermissionsPolicyFeatureRecordAdAuctionEvents,
+		PagePermissionsPolicyFeatureRewriter,
+		PagePermissionsPolicyFeatureRunAdAuction,
+		PagePermissionsPolicyFeatureScreenWakeLock,
+		PagePermissionsPolicyFeatureSerial,
+		PagePermissionsPolicyFeatureSharedAutofill,
+		PagePermissionsPolicyFeatureSharedStorage,
+		PagePermissionsPolicyFeatureSharedStorageSelectUrl,
+		PagePermissionsPolicyFeatureSmartCard,
+		PagePermissionsPolicyFeatureSpeakerSelection,
+		PagePermissionsPolicyFeatureStorageAccess,
+		PagePermissionsPolicyFeatureSubApps,
+		PagePermissionsPolicyFeatureSummarizer,
+		PagePermissionsPolicyFeatureSyncXhr,
+		PagePermissionsPolicyFeatureTranslator,
+		PagePermissionsPolicyFeatureUnload,
+		PagePermissionsPolicyFeatureUsb,
+		PagePermissionsPolicyFeatureUsbUnrestricted,
+		PagePermissionsPolicyFeatureVerticalScroll,
+		PagePermissionsPolicyFeatureWebAppInstallation,
+		PagePermissionsPolicyFeatureWebPrinting,
+		PagePermissionsPolicyFeatureWebShare,
+		PagePermissionsPolicyFeatureWindowManagement,
+		PagePermissionsPolicyFeatureWriter,
+		PagePermissionsPolicyFeatureXrSpatialTracking:
+		return true
+	}
+	return false
+}
+
+// Reason for a permissions policy feature to be disabled.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PagePermissionsPolicyBlockReason string
+
+const (
+	PagePermissionsPolicyBlockReasonHeader            PagePermissionsPolicyBlockReason = "Header"
+	PagePermissionsPolicyBlockReasonIframeAttribute   PagePermissionsPolicyBlockReason = "IframeAttribute"
+	PagePermissionsPolicyBlockReasonInFencedFrameTree PagePermissionsPolicyBlockReason = "InFencedFrameTree"
+	PagePermissionsPolicyBlockReasonInIsolatedApp     PagePermissionsPolicyBlockReason = "InIsolatedApp"
+)
+
+// Valid returns true if the value is one of the PagePermissionsPolicyBlockReason enum values.
+func (e PagePermissionsPolicyBlockReason) Valid() bool {
+	switch e {
+	case PagePermissionsPolicyBlockReasonHeader,
+		PagePermissionsPolicyBlockReasonIframeAttribute,
+		PagePermissionsPolicyBlockReasonInFencedFrameTree,
+		PagePermissionsPolicyBlockReasonInIsolatedApp:
+		return true
+	}
+	return false
+}
+
+// Origin Trial(https://www.chromium.org/blink/origin-trials) support. Status for an Origin Trial token.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageOriginTrialTokenStatus string
+
+const (
+	PageOriginTrialTokenStatusSuccess                PageOriginTrialTokenStatus = "Success"
+	PageOriginTrialTokenStatusNotSupported           PageOriginTrialTokenStatus = "NotSupported"
+	PageOriginTrialTokenStatusInsecure               PageOriginTrialTokenStatus = "Insecure"
+	PageOriginTrialTokenStatusExpired                PageOriginTrialTokenStatus = "Expired"
+	PageOriginTrialTokenStatusWrongOrigin            PageOriginTrialTokenStatus = "WrongOrigin"
+	PageOriginTrialTokenStatusInvalidSignature       PageOriginTrialTokenStatus = "InvalidSignature"
+	PageOriginTrialTokenStatusMalformed              PageOriginTrialTokenStatus = "Malformed"
+	PageOriginTrialTokenStatusWrongVersion           PageOriginTrialTokenStatus = "WrongVersion"
+	PageOriginTrialTokenStatusFeatureDisabled        PageOriginTrialTokenStatus = "FeatureDisabled"
+	PageOriginTrialTokenStatusTokenDisabled          PageOriginTrialTokenStatus = "TokenDisabled"
+	PageOriginTrialTokenStatusFeatureDisabledForUser PageOriginTrialTokenStatus = "FeatureDisabledForUser"
+	PageOriginTrialTokenStatusUnknownTrial           PageOriginTrialTokenStatus = "UnknownTrial"
+)
+
+// Valid returns true if the value is one of the PageOriginTrialTokenStatus enum values.
+func (e PageOriginTrialTokenStatus) Valid() bool {
+	switch e {
+	case PageOriginTrialTokenStatusSuccess,
+		PageOriginTrialTokenStatusNotSupported,
+		PageOriginTrialTokenStatusInsecure,
+		PageOriginTrialTokenStatusExpired,
+		PageOriginTrialTokenStatusWrongOrigin,
+		PageOriginTrialTokenStatusInvalidSignature,
+		PageOriginTrialTokenStatusMalformed,
+		PageOriginTrialTokenStatusWrongVersion,
+		PageOriginTrialTokenStatusFeatureDisabled,
+		PageOriginTrialTokenStatusTokenDisabled,
+		PageOriginTrialTokenStatusFeatureDisabledForUser,
+		PageOriginTrialTokenStatusUnknownTrial:
+		return true
+	}
+	return false
+}
+
+// Status for an Origin Trial.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageOriginTrialStatus string
+
+const (
+	PageOriginTrialStatusEnabled               PageOriginTrialStatus = "Enabled"
+	PageOriginTrialStatusValidTokenNotProvided PageOriginTrialStatus = "ValidTokenNotProvided"
+	PageOriginTrialStatusOSNotSupported        PageOriginTrialStatus = "OSNotSupported"
+	PageOriginTrialStatusTrialNotAllowed       PageOriginTrialStatus = "TrialNotAllowed"
+)
+
+// Valid returns true if the value is one of the PageOriginTrialStatus enum values.
+func (e PageOriginTrialStatus) Valid() bool {
+	switch e {
+	case PageOriginTrialStatusEnabled,
+		PageOriginTrialStatusValidTokenNotProvided,
+		PageOriginTrialStatusOSNotSupported,
+		PageOriginTrialStatusTrialNotAllowed:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageOriginTrialUsageRestriction string
+
+const (
+	PageOriginTrialUsageRestrictionNone   PageOriginTrialUsageRestriction = "None"
+	PageOriginTrialUsageRestrictionSubset PageOriginTrialUsageRestriction = "Subset"
+)
+
+// Valid returns true if the value is one of the PageOriginTrialUsageRestriction enum values.
+func (e PageOriginTrialUsageRestriction) Valid() bool {
+	switch e {
+	case PageOriginTrialUsageRestrictionNone,
+		PageOriginTrialUsageRestrictionSubset:
+		return true
+	}
+	return false
+}
+
+// Transition type.
+type PageTransitionType string
+
+const (
+	PageTransitionTypeLink             PageTransitionType = "link"
+	PageTransitionTypeTyped            PageTransitionType = "typed"
+	PageTransitionTypeAddressBar       PageTransitionType = "address_bar"
+	PageTransitionTypeAutoBookmark     PageTransitionType = "auto_bookmark"
+	PageTransitionTypeAutoSubframe     PageTransitionType = "auto_subframe"
+	PageTransitionTypeManualSubframe   PageTransitionType = "manual_subframe"
+	PageTransitionTypeGenerated        PageTransitionType = "generated"
+	PageTransitionTypeAutoToplevel     PageTransitionType = "auto_toplevel"
+	PageTransitionTypeFormSubmit       PageTransitionType = "form_submit"
+	PageTransitionTypeReload           PageTransitionType = "reload"
+	PageTransitionTypeKeyword          PageTransitionType = "keyword"
+	PageTransitionTypeKeywordGenerated PageTransitionType = "keyword_generated"
+	PageTransitionTypeOther            PageTransitionType = "other"
+)
+
+// Valid returns true if the value is one of the PageTransitionType enum values.
+func (e PageTransitionType) Valid() bool {
+	switch e {
+	case PageTransitionTypeLink,
+		PageTransitionTypeTyped,
+		PageTransitionTypeAddressBar,
+		PageTransitionTypeAutoBookmark,
+		PageTransitionTypeAutoSubframe,
+		PageTransitionTypeManualSubframe,
+		PageTransitionTypeGenerated,
+		PageTransitionTypeAutoToplevel,
+		PageTransitionTypeFormSubmit,
+		PageTransitionTypeReload,
+		PageTransitionTypeKeyword,
+		PageTransitionTypeKeywordGenerated,
+		PageTransitionTypeOther:
+		return true
+	}
+	return false
+}
+
+// Javascript dialog type.
+type PageDialogType string
+
+const (
+	PageDialogTypeAlert        PageDialogType = "alert"
+	PageDialogTypeConfirm      PageDialogType = "confirm"
+	PageDialogTypePrompt       PageDialogType = "prompt"
+	PageDialogTypeBeforeunload PageDialogType = "beforeunload"
+)
+
+// Valid returns true if the value is one of the PageDialogType enum values.
+func (e PageDialogType) Valid() bool {
+	switch e {
+	case PageDialogTypeAlert,
+		PageDialogTypeConfirm,
+		PageDialogTypePrompt,
+		PageDialogTypeBeforeunload:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageClientNavigationReason string
+
+const (
+	PageClientNavigationReasonAnchorClick            PageClientNavigationReason = "anchorClick"
+	PageClientNavigationReasonFormSubmissionGet      PageClientNavigationReason = "formSubmissionGet"
+	PageClientNavigationReasonFormSubmissionPost     PageClientNavigationReason = "formSubmissionPost"
+	PageClientNavigationReasonHttpHeaderRefresh      PageClientNavigationReason = "httpHeaderRefresh"
+	PageClientNavigationReasonInitialFrameNavigation PageClientNavigationReason = "initialFrameNavigation"
+	PageClientNavigationReasonMetaTagRefresh         PageClientNavigationReason = "metaTagRefresh"
+	PageClientNavigationReasonOther                  PageClientNavigationReason = "other"
+	PageClientNavigationReasonPageBlockInterstitial  PageClientNavigationReason = "pageBlockInterstitial"
+	PageClientNavigationReasonReload                 PageClientNavigationReason = "reload"
+	PageClientNavigationReasonScriptInitiated        PageClientNavigationReason = "scriptInitiated"
+)
+
+// Valid returns true if the value is one of the PageClientNavigationReason enum values.
+func (e PageClientNavigationReason) Valid() bool {
+	switch e {
+	case PageClientNavigationReasonAnchorClick,
+		PageClientNavigationReasonFormSubmissionGet,
+		PageClientNavigationReasonFormSubmissionPost,
+		PageClientNavigationReasonHttpHeaderRefresh,
+		PageClientNavigationReasonInitialFrameNavigation,
+		PageClientNavigationReasonMetaTagRefresh,
+		PageClientNavigationReasonOther,
+		PageClientNavigationReasonPageBlockInterstitial,
+		PageClientNavigationReasonReload,
+		PageClientNavigationReasonScriptInitiated:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageClientNavigationDisposition string
+
+const (
+	PageClientNavigationDispositionCurrentTab PageClientNavigationDisposition = "currentTab"
+	PageClientNavigationDispositionNewTab     PageClientNavigationDisposition = "newTab"
+	PageClientNavigationDispositionNewWindow  PageClientNavigationDisposition = "newWindow"
+	PageClientNavigationDispositionDownload   PageClientNavigationDisposition = "download"
+)
+
+// Valid returns true if the value is one of the PageClientNavigationDisposition enum values.
+func (e PageClientNavigationDisposition) Valid() bool {
+	switch e {
+	case PageClientNavigationDispositionCurrentTab,
+		PageClientNavigationDispositionNewTab,
+		PageClientNavigationDispositionNewWindow,
+		PageClientNavigationDispositionDownload:
+		return true
+	}
+	return false
+}
+
+// The referring-policy used for the navigation.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageReferrerPolicy string
+
+const (
+	PageReferrerPolicyNoReferrer                  PageReferrerPolicy = "noReferrer"
+	PageReferrerPolicyNoReferrerWhenDowngrade     PageReferrerPolicy = "noReferrerWhenDowngrade"
+	PageReferrerPolicyOrigin                      PageReferrerPolicy = "origin"
+	PageReferrerPolicyOriginWhenCrossOrigin       PageReferrerPolicy = "originWhenCrossOrigin"
+	PageReferrerPolicySameOrigin                  PageReferrerPolicy = "sameOrigin"
+	PageReferrerPolicyStrictOrigin                PageReferrerPolicy = "strictOrigin"
+	PageReferrerPolicyStrictOriginWhenCrossOrigin PageReferrerPolicy = "strictOriginWhenCrossOrigin"
+	PageReferrerPolicyUnsafeUrl                   PageReferrerPolicy = "unsafeUrl"
+)
+
+// Valid returns true if the value is one of the PageReferrerPolicy enum values.
+func (e PageReferrerPolicy) Valid() bool {
+	switch e {
+	case PageReferrerPolicyNoReferrer,
+		PageReferrerPolicyNoReferrerWhenDowngrade,
+		PageReferrerPolicyOrigin,
+		PageReferrerPolicyOriginWhenCrossOrigin,
+		PageReferrerPolicySameOrigin,
+		PageReferrerPolicyStrictOrigin,
+		PageReferrerPolicyStrictOriginWhenCrossOrigin,
+		PageReferrerPolicyUnsafeUrl:
+		return true
+	}
+	return false
+}
+
+// The type of a frameNavigated event.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageNavigationType string
+
+const (
+	PageNavigationTypeNavigation              PageNavigationType = "Navigation"
+	PageNavigationTypeBackForwardCacheRestore PageNavigationType = "BackForwardCacheRestore"
+)
+
+// Valid returns true if the value is one of the PageNavigationType enum values.
+func (e PageNavigationType) Valid() bool {
+	switch e {
+	case PageNavigationTypeNavigation,
+		PageNavigationTypeBackForwardCacheRestore:
+		return true
+	}
+	return false
+}
+
+// List of not restored reasons for back-forward cache.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageBackForwardCacheNotRestoredReason string
+
+const (
+	PageBackForwardCacheNotRestoredReasonNotPrimaryMainFrame                                      PageBackForwardCacheNotRestoredReason = "NotPrimaryMainFrame"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabled                                 PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabled"
+	PageBackForwardCacheNotRestoredReasonRelatedActiveContentsExist                               PageBackForwardCacheNotRestoredReason = "RelatedActiveContentsExist"
+	PageBackForwardCacheNotRestoredReasonHTTPStatusNotOK                                          PageBackForwardCacheNotRestoredReason = "HTTPStatusNotOK"
+	PageBackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS                                     PageBackForwardCacheNotRestoredReason = "SchemeNotHTTPOrHTTPS"
+	PageBackForwardCacheNotRestoredReasonLoading                                                  PageBackForwardCacheNotRestoredReason = "Loading"
+	PageBackForwardCacheNotRestoredReasonWasGrantedMediaAccess                                    PageBackForwardCacheNotRestoredReason = "WasGrantedMediaAccess"
+	PageBackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled                          PageBackForwardCacheNotRestoredReason = "DisableForRenderFrameHostCalled"
+	PageBackForwardCacheNotRestoredReasonDomainNotAllowed                                         PageBackForwardCacheNotRestoredReason = "DomainNotAllowed"
+	PageBackForwardCacheNotRestoredReasonHTTPMethodNotGET                                         PageBackForwardCacheNotRestoredReason = "HTTPMethodNotGET"
+	PageBackForwardCacheNotRestoredReasonSubframeIsNavigating                                     PageBackForwardCacheNotRestoredReason = "SubframeIsNavigating"
+	PageBackForwardCacheNotRestoredReasonTimeout                                                  PageBackForwardCacheNotRestoredReason = "Timeout"
+	PageBackForwardCacheNotRestoredReasonCacheLimit                                               PageBackForwardCacheNotRestoredReason = "CacheLimit"
+	PageBackForwardCacheNotRestoredReasonJavaScriptExecution                                      PageBackForwardCacheNotRestoredReason = "JavaScriptExecution"
+	PageBackForwardCacheNotRestoredReasonRendererProcessKilled                                    PageBackForwardCacheNotRestoredReason = "RendererProcessKilled"
+	PageBackForwardCacheNotRestoredReasonRendererProcessCrashed                                   PageBackForwardCacheNotRestoredReason = "RendererProcessCrashed"
+	PageBackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed                              PageBackForwardCacheNotRestoredReason = "SchedulerTrackedFeatureUsed"
+	PageBackForwardCacheNotRestoredReasonConflictingBrowsingInstance                              PageBackForwardCacheNotRestoredReason = "ConflictingBrowsingInstance"
+	PageBackForwardCacheNotRestoredReasonCacheFlushed                                             PageBackForwardCacheNotRestoredReason = "CacheFlushed"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerVersionActivation                           PageBackForwardCacheNotRestoredReason = "ServiceWorkerVersionActivation"
+	PageBackForwardCacheNotRestoredReasonSessionRestored                                          PageBackForwardCacheNotRestoredReason = "SessionRestored"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerPostMessage                                 PageBackForwardCacheNotRestoredReason = "ServiceWorkerPostMessage"
+	PageBackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded      PageBackForwardCacheNotRestoredReason = "EnteredBackForwardCacheBeforeServiceWorkerHostAdded"
+	PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite                            PageBackForwardCacheNotRestoredReason = "RenderFrameHostReused_SameSite"
+	PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite                           PageBackForwardCacheNotRestoredReason = "RenderFrameHostReused_CrossSite"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerClaim                                       PageBackForwardCacheNotRestoredReason = "ServiceWorkerClaim"
+	PageBackForwardCacheNotRestoredReasonIgnoreEventAndEvict                                      PageBackForwardCacheNotRestoredReason = "IgnoreEventAndEvict"
+	PageBackForwardCacheNotRestoredReasonHaveInnerContents                                        PageBackForwardCacheNotRestoredReason = "HaveInnerContents"
+	PageBackForwardCacheNotRestoredReasonTimeoutPuttingInCache                                    PageBackForwardCacheNotRestoredReason = "TimeoutPuttingInCache"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory                      PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByLowMemory"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine                    PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByCommandLine"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer             PageBackForwardCacheNotRestoredReason = "NetworkRequestDatapipeDrainedAsBytesConsumer"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestRedirected                                 PageBackForwardCacheNotRestoredReason = "NetworkRequestRedirected"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestTimeout                                    PageBackForwardCacheNotRestoredReason = "NetworkRequestTimeout"
+	PageBackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit                                PageBackForwardCacheNotRestoredReason = "NetworkExceedsBufferLimit"
+	PageBackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring                        PageBackForwardCacheNotRestoredReason = "NavigationCancelledWhileRestoring"
+	PageBackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry                             PageBackForwardCacheNotRestoredReason = "NotMostRecentNavigationEntry"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender                     PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForPrerender"
+	PageBackForwardCacheNotRestoredReasonUserAgentOverrideDiffers                                 PageBackForwardCacheNotRestoredReason = "UserAgentOverrideDiffers"
+	PageBackForwardCacheNotRestoredReasonForegroundCacheLimit                                     PageBackForwardCacheNotRestoredReason = "ForegroundCacheLimit"
+	PageBackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped                               PageBackForwardCacheNotRestoredReason = "BrowsingInstanceNotSwapped"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate                      PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForDelegate"
+	PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame                           PageBackForwardCacheNotRestoredReason = "UnloadHandlerExistsInMainFrame"
+	PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame                            PageBackForwardCacheNotRestoredReason = "UnloadHandlerExistsInSubFrame"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerUnregistration                              PageBackForwardCacheNotRestoredReason = "ServiceWorkerUnregistration"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStore                                      PageBackForwardCacheNotRestoredReason = "CacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified                        PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreCookieModified"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified                PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreHTTPOnlyCookieModified"
+	PageBackForwardCacheNotRestoredReasonNoResponseHead                                           PageBackForwardCacheNotRestoredReason = "NoResponseHead"
+	PageBackForwardCacheNotRestoredReasonUnknown                                                  PageBackForwardCacheNotRestoredReason = "Unknown"
+	PageBackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857             PageBackForwardCacheNotRestoredReason = "ActivationNavigationsDisallowedForBug1234857"
+	PageBackForwardCacheNotRestoredReasonErrorDocument                                            PageBackForwardCacheNotRestoredReason = "ErrorDocument"
+	PageBackForwardCacheNotRestoredReasonFencedFramesEmbedder                                     PageBackForwardCacheNotRestoredReason = "FencedFramesEmbedder"
+	PageBackForwardCacheNotRestoredReasonCookieDisabled                                           PageBackForwardCacheNotRestoredReason = "CookieDisabled"
+	PageBackForwardCacheNotRestoredReasonHTTPAuthRequired                                         PageBackForwardCacheNotRestoredReason = "HTTPAuthRequired"
+	PageBackForwardCacheNotRestoredReasonCookieFlushed                                            PageBackForwardCacheNotRestoredReason = "CookieFlushed"
+	PageBackForwardCacheNotRestoredReasonBroadcastChannelOnMessage                                PageBackForwardCacheNotRestoredReason = "BroadcastChannelOnMessage"
+	PageBackForwardCacheNotRestoredReasonWebViewSettingsChanged                                   PageBackForwardCacheNotRestoredReason = "WebViewSettingsChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged                           PageBackForwardCacheNotRestoredReason = "WebViewJavaScriptObjectChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewMessageListenerInjected                           PageBackForwardCacheNotRestoredReason = "WebViewMessageListenerInjected"
+	PageBackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged                      PageBackForwardCacheNotRestoredReason = "WebViewSafeBrowsingAllowlistChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged                    PageBackForwardCacheNotRestoredReason = "WebViewDocumentStartJavascriptChanged"
+	PageBackForwardCacheNotRestoredReasonWebSocket                                                PageBackForwardCacheNotRestoredReason = "WebSocket"
+	PageBackForwardCacheNotRestoredReasonWebTransport                                             PageBackForwardCacheNotRestoredReason = "WebTransport"
+	PageBackForwardCacheNotRestoredReasonWebRTC                                                   PageBackForwardCacheNotRestoredReason = "WebRTC"
+	PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore                       PageBackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache                       PageBackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoCache"
+	PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore                        PageBackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache                        PageBackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoCache"
+	PageBackForwardCacheNotRestoredReasonContainsPlugins                                          PageBackForwardCacheNotRestoredReason = "ContainsPlugins"
+	PageBackForwardCacheNotRestoredReasonDocumentLoaded                                           PageBackForwardCacheNotRestoredReason = "DocumentLoaded"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers                          PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestOthers"
+	PageBackForwardCacheNotRestoredReasonRequestedMIDIPermission                                  PageBackForwardCacheNotRestoredReason = "RequestedMIDIPermission"
+	PageBackForwardCacheNotRestoredReasonRequestedAudioCapturePermission                          PageBackForwardCacheNotRestoredReason = "RequestedAudioCapturePermission"
+	PageBackForwardCacheNotRestoredReasonRequestedVideoCapturePermission                          PageBackForwardCacheNotRestoredReason = "RequestedVideoCapturePermission"
+	PageBackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors                  PageBackForwardCacheNotRestoredReason = "RequestedBackForwardCacheBlockedSensors"
+	PageBackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission                        PageBackForwardCacheNotRestoredReason = "RequestedBackgroundWorkPermission"
+	PageBackForwardCacheNotRestoredReasonBroadcastChannel                                         PageBackForwardCacheNotRestoredReason = "BroadcastChannel"
+	PageBackForwardCacheNotRestoredReasonWebXR                                                    PageBackForwardCacheNotRestoredReason = "WebXR"
+	PageBackForwardCacheNotRestoredReasonSharedWorker                                             PageBackForwardCacheNotRestoredReason = "SharedWorker"
+	PageBackForwardCacheNotRestoredReasonSharedWorkerMessage                                      PageBackForwardCacheNotRestoredReason = "SharedWorkerMessage"
+	PageBackForwardCacheNotRestoredReasonWebLocks                                                 PageBackForwardCacheNotRestoredReason = "WebLocks"
+	PageBackForwardCacheNotRestoredReasonWebHID                                                   PageBackForwardCacheNotRestoredReason = "WebHID"
+	PageBackForwardCacheNotRestoredReasonWebShare                                                 PageBackForwardCacheNotRestoredReason = "WebShare"
+	PageBackForwardCacheNotRestoredReasonRequestedStorageAccessGrant                              PageBackForwardCacheNotRestoredReason = "RequestedStorageAccessGrant"
+	PageBackForwardCacheNotRestoredReasonWebNfc                                                   PageBackForwardCacheNotRestoredReason = "WebNfc"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch                           PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestFetch"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR                             PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestXHR"
+	PageBackForwardCacheNotRestoredReasonAppBanner                                                PageBackForwardCacheNotRestoredReason = "AppBanner"
+	PageBackForwardCacheNotRestoredReasonPrinting                                                 PageBackForwardCacheNotRestoredReason = "Printing"
+	PageBackForwardCacheNotRestoredReasonWebDatabase                                              PageBackForwardCacheNotRestoredReason = "WebDatabase"
+	PageBackForwardCacheNotRestoredReasonPictureInPicture                                         PageBackForwardCacheNotRestoredReason = "PictureInPicture"
+	PageBackForwardCacheNotRestoredReasonSpeechRecognizer                                         PageBackForwardCacheNotRestoredReason = "SpeechRecognizer"
+	PageBackForwardCacheNotRestoredReasonIdleManager                                              PageBackForwardCacheNotRestoredReason = "IdleManager"
+	PageBackForwardCacheNotRestoredReasonPaymentManager                                           PageBackForwardCacheNotRestoredReason = "PaymentManager"
+	PageBackForwardCacheNotRestoredReasonSpeechSynthesis                                          PageBackForwardCacheNotRestoredReason = "SpeechSynthesis"
+	PageBackForwardCacheNotRestoredReasonKeyboardLock                                             PageBackForwardCacheNotRestoredReason = "KeyboardLock"
+	PageBackForwardCacheNotRestoredReasonWebOTPService                                            PageBackForwardCacheNotRestoredReason = "WebOTPService"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket                    PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestDirectSocket"
+	PageBackForwardCacheNotRestoredReasonInjectedJavascript                                       PageBackForwardCacheNotRestoredReason = "InjectedJavascript"
+	PageBackForwardCacheNotRestoredReasonInjectedStyleSheet                                       PageBackForwardCacheNotRestoredReason = "InjectedStyleSheet"
+	PageBackForwardCacheNotRestoredReasonKeepaliveRequest                                         PageBackForwardCacheNotRestoredReason = "KeepaliveRequest"
+	PageBackForwardCacheNotRestoredReasonIndexedDBEvent                                           PageBackForwardCacheNotRestoredReason = "IndexedDBEvent"
+	PageBackForwardCacheNotRestoredReasonDummy                                                    PageBackForwardCacheNotRestoredReason = "Dummy"
+	PageBackForwardCacheNotRestoredReasonJsNetworkRequestReceivedCacheControlNoStoreResource      PageBackForwardCacheNotRestoredReason = "JsNetworkRequestReceivedCacheControlNoStoreResource"
+	PageBackForwardCacheNotRestoredReasonWebRTCSticky                                             PageBackForwardCacheNotRestoredReason = "WebRTCSticky"
+	PageBackForwardCacheNotRestoredReasonWebTransportSticky                                       PageBackForwardCacheNotRestoredReason = "WebTransportSticky"
+	PageBackForwardCacheNotRestoredReasonWebSocketSticky                                          PageBackForwardCacheNotRestoredReason = "WebSocketSticky"
+	PageBackForwardCacheNotRestoredReasonSmartCard                                                PageBackForwardCacheNotRestoredReason = "SmartCard"
+	PageBackForwardCacheNotRestoredReasonLiveMediaStreamTrack                                     PageBackForwardCacheNotRestoredReason = "LiveMediaStreamTrack"
+	PageBackForwardCacheNotRestoredReasonUnloadHandler                                            PageBackForwardCacheNotRestoredReason = "UnloadHandler"
+	PageBackForwardCacheNotRestoredReasonParserAborted                                            PageBackForwardCacheNotRestoredReason = "ParserAborted"
+	PageBackForwardCacheNotRestoredReasonContentSecurityHandler                                   PageBackForwardCacheNotRestoredReason = "ContentSecurityHandler"
+	PageBackForwardCacheNotRestoredReasonContentWebAuthenticationAPI                              PageBackForwardCacheNotRestoredReason = "ContentWebAuthenticationAPI"
+	PageBackForwardCacheNotRestoredReasonContentFileChooser                                       PageBackForwardCacheNotRestoredReason = "ContentFileChooser"
+	PageBackForwardCacheNotRestoredReasonContentSerial                                            PageBackForwardCacheNotRestoredReason = "ContentSerial"
+	PageBackForwardCacheNotRestoredReasonContentFileSystemAccess                                  PageBackForwardCacheNotRestoredReason = "ContentFileSystemAccess"
+	PageBackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost                        PageBackForwardCacheNotRestoredReason = "ContentMediaDevicesDispatcherHost"
+	PageBackForwardCacheNotRestoredReasonContentWebBluetooth                                      PageBackForwardCacheNotRestoredReason = "ContentWebBluetooth"
+	PageBackForwardCacheNotRestoredReasonContentWebUSB                                            PageBackForwardCacheNotRestoredReason = "ContentWebUSB"
+	PageBackForwardCacheNotRestoredReasonContentMediaSessionService                               PageBackForwardCacheNotRestoredReason = "ContentMediaSessionService"
+	PageBackForwardCacheNotRestoredReasonContentScreenReader                                      PageBackForwardCacheNotRestoredReason = "ContentScreenReader"
+	PageBackForwardCacheNotRestoredReasonContentDiscarded                                         PageBackForwardCacheNotRestoredReason = "ContentDiscarded"
+	PageBackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper                            PageBackForwardCacheNotRestoredReason = "EmbedderPopupBlockerTabHelper"
+	PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker                PageBackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingTriggeredPopupBlocker"
+	PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails                        PageBackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingThreatDetails"
+	PageBackForwardCacheNotRestoredReasonEmbedderAppBannerManager                                 PageBackForwardCacheNotRestoredReason = "EmbedderAppBannerManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderDomDistillerViewerSource                         PageBackForwardCacheNotRestoredReason = "EmbedderDomDistillerViewerSource"
+	PageBackForwardCacheNotRestoredReasonEmbedderDomDistillerSelfDeletingRequestDelegate          PageBackForwardCacheNotRestoredReason = "EmbedderDomDistillerSelfDeletingRequestDelegate"
+	PageBackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper                         PageBackForwardCacheNotRestoredReason = "EmbedderOomInterventionTabHelper"
+	PageBackForwardCacheNotRestoredReasonEmbedderOfflinePage                                      PageBackForwardCacheNotRestoredReason = "EmbedderOfflinePage"
+	PageBackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager PageBackForwardCacheNotRestoredReason = "EmbedderChromePasswordManagerClientBindCredentialManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager                         PageBackForwardCacheNotRestoredReason = "EmbedderPermissionRequestManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderModalDialog                                      PageBackForwardCacheNotRestoredReason = "EmbedderModalDialog"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensions                                       PageBackForwardCacheNotRestoredReason = "EmbedderExtensions"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessaging                               PageBackForwardCacheNotRestoredReason = "EmbedderExtensionMessaging"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort                    PageBackForwardCacheNotRestoredReason = "EmbedderExtensionMessagingForOpenPort"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame                PageBackForwardCacheNotRestoredReason = "EmbedderExtensionSentMessageToCachedFrame"
+	PageBackForwardCacheNotRestoredReasonRequestedByWebViewClient                                 PageBackForwardCacheNotRestoredReason = "RequestedByWebViewClient"
+	PageBackForwardCacheNotRestoredReasonPostMessageByWebViewClient                               PageBackForwardCacheNotRestoredReason = "PostMessageByWebViewClient"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated          PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreDeviceBoundSessionTerminated"
+	PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure                 PageBackForwardCacheNotRestoredReason = "CacheLimitPrunedOnModerateMemoryPressure"
+	PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure                 PageBackForwardCacheNotRestoredReason = "CacheLimitPrunedOnCriticalMemoryPressure"
+)
+
+// Valid returns true if the value is one of the PageBackForwardCacheNotRestoredReason enum values.
+func (e PageBackForwardCacheNotRestoredReason) Valid() bool {
+	switch e {
+	case PageBackForwardCacheNotRestoredReasonNotPrimaryMainFrame,
+		PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabled,
+		PageBackForwardCacheNotRestoredReasonRelatedActiveContentsExist,
+		PageBackForwardCacheNotRestoredReasonHTTPStatusNotOK,
+		PageBackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS,
+		PageBackForwardCacheNotRestoredReasonLoading,
+		PageBackForwardCacheNotRestoredReasonWasGrantedMediaAccess,
+		PageBackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled,
+		PageBackForwardCacheNotRestoredReasonDomainNotAllowed,
+		PageBackForwardCacheNotRestoredReasonHTTPMethodNotGET,
+		PageBackForwardCacheNotRestoredReasonSubframeIsNavigating,
+		PageBackForwardCacheNotRestoredReasonTimeout,
+		PageBackForwardCacheNotRestoredReasonCacheLimit,
+		PageBackForwardCacheNotRestoredReasonJavaScriptExecution,
+		PageBackForwardCacheNotRestoredReasonRendererProcessKilled,
+		PageBackForwardCacheNotRestoredReasonRendererProcessCrashed,
+		PageBackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed,
+		PageBackForwardCacheNotRestoredReasonConflictingBrowsingInstance,
+		PageBackForwardCacheNotRestoredReasonCacheFlushed,
+		PageBackForwardCacheNotRestoredReasonServiceWorkerVersionActivation,
+		PageBackForwardCacheNotRestoredReasonSessionRestored,
+		PageBackForwardCacheNotRestoredReasonServiceWorkerPostMessage,
+		PageBackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded,
+		PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite,
+		PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite,
+		PageBackForwardCacheNotRestoredReasonServiceWorkerClaim,
+		PageBackForwardCacheNotRestoredReasonIgnoreEventAndEvict,
+		PageBackForwardCacheNotRestoredReasonHaveInnerContents,
+		PageBackForwardCacheNotRestoredReasonTimeoutPuttingInCache,
+		PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory,
+		PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine,
+		PageBackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer,
+		PageBackForwardCacheNotRestoredReasonNetworkRequestRedirected,
+		PageBackForwardCacheNotRestoredReasonNetworkRequestTimeout,
+		PageBackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit,
+		PageBackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring,
+		PageBackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry,
+		PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender,
+		PageBackForwardCacheNotRestoredReasonUserAgentOverrideDiffers,
+		PageBackForwardCacheNotRestoredReasonForegroundCacheLimit,
+		PageBackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped,
+		PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate,
+		PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame,
+		PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame,
+		PageBackForwardCacheNotRestoredReasonServiceWorkerUnregistration,
+		PageBackForwardCacheNotRestoredReasonCacheControlNoStore,
+		PageBackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified,
+		PageBackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified,
+		PageBackForwardCacheNotRestoredReasonNoResponseHead,
+		PageBackForwardCacheNotRestoredReasonUnknown,
+		PageBackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857,
+		PageBackForwardCacheNotRestoredReasonErrorDocument,
+		PageBackForwardCacheNotRestoredReasonFencedFramesEmbedder,
+		PageBackForwardCacheNotRestoredReasonCookieDisabled,
+		PageBackForwardCacheNotRestoredReasonHTTPAuthRequired,
+		PageBackForwardCacheNotRestoredReasonCookieFlushed,
+		PageBackForwardCacheNotRestoredReasonBroadcastChannelOnMessage,
+		PageBackForwardCacheNotRestoredReasonWebViewSettingsChanged,
+		PageBackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged,
+		PageBackForwardCacheNotRestoredReasonWebViewMessageListenerInjected,
+		PageBackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged,
+		PageBackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged,
+		PageBackForwardCacheNotRestoredReasonWebSocket,
+		PageBackForwardCacheNotRestoredReasonWebTransport,
+		PageBackForwardCacheNotRestoredReasonWebRTC,
+		PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore,
+		PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache,
+		PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore,
+		PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache,
+		PageBackForwardCacheNotRestoredReasonContainsPlugins,
+		PageBackForwardCacheNotRestoredReasonDocumentLoaded,
+		PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers,
+		PageBackForwardCacheNotRestoredReasonRequestedMIDIPermission,
+		PageBackForwardCacheNotRestoredReasonRequestedAudioCapturePermission,
+		PageBackForwardCacheNotRestoredReasonRequestedVideoCapturePermission,
+		PageBackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors,
+		PageBackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission,
+		PageBackForwardCacheNotRestoredReasonBroadcastChannel,
+		PageBackForwardCacheNotRestoredReasonWebXR,
+		PageBackForwardCacheNotRestoredReasonSharedWorker,
+		PageBackForwardCacheNotRestoredReasonSharedWorkerMessage,
+		PageBackForwardCacheNotRestoredReasonWebLocks,
+		PageBackForwardCacheNotRestoredReasonWebHID,
+		PageBackForwardCacheNotRestoredReasonWebShare,
+		PageBackForwardCacheNotRestoredReasonRequestedStorageAccessGrant,
+		PageBackForwardCacheNotRestoredReasonWebNfc,
+		PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch,
+		PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR,
+		PageBackForwardCacheNotRestoredReasonAppBanner,
+		PageBackForwardCacheNotRestoredReasonPrinting,
+		PageBackForwardCacheNotRestoredReasonWebDatabase,
+		PageBackForwardCacheNotRestoredReasonPictureInPicture,
+		PageBackForwardCacheNotRestoredReasonSpeechRecognizer,
+		PageBackForwardCacheNotRestoredReasonIdleManager,
+		PageBackForwardCacheNotRestoredReasonPaymentManager,
+		PageBackForwardCacheNotRestoredReasonSpeechSynthesis,
+		PageBackForwardCacheNotRestoredReasonKeyboardLock,
+		PageBackForwardCacheNotRestoredReasonWebOTPService,
+		PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket,
+		PageBackForwardCacheNotRestoredReasonInjectedJavascript,
+		PageBackForwardCacheNotRestoredReasonInjectedStyleSheet,
+		PageBackForwardCacheNotRestoredReasonKeepaliveRequest,
+		PageBackForwardCacheNotRestoredReasonIndexedDBEvent,
+		PageBackForwardCacheNotRestoredReasonDummy,
+		PageBackForwardCacheNotRestoredReasonJsNetworkRequestReceivedCacheControlNoStoreResource,
+		PageBackForwardCacheNotRestoredReasonWebRTCSticky,
+		PageBackForwardCacheNotRestoredReasonWebTransportSticky,
+		PageBackForwardCacheNotRestoredReasonWebSocketSticky,
+		PageBackForwardCacheNotRestoredReasonSmartCard,
+		PageBackForwardCacheNotRestoredReasonLiveMediaStreamTrack,
+		PageBackForwardCacheNotRestoredReasonUnloadHandler,
+		PageBackForwardCacheNotRestoredReasonParserAborted,
+		PageBackForwardCacheNotRestoredReasonContentSecurityHandler,
+		PageBackForwardCacheNotRestoredReasonContentWebAuthenticationAPI,
+		PageBackForwardCacheNotRestoredReasonContentFileChooser,
+		PageBackForwardCacheNotRestoredReasonContentSerial,
+		PageBackForwardCacheNotRestoredReasonContentFileSystemAccess,
+		PageBackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost,
+		PageBackForwardCacheNotRestoredReasonContentWebBluetooth,
+		PageBackForwardCacheNotRestoredReasonContentWebUSB,
+		PageBackForwardCacheNotRestoredReasonContentMediaSessionService,
+		PageBackForwardCacheNotRestoredReasonContentScreenReader,
+		PageBackForwardCacheNotRestoredReasonContentDiscarded,
+		PageBackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper,
+		PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker,
+		PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails,
+		PageBackForwardCacheNotRestoredReasonEmbedderAppBannerManager,
+		PageBackForwardCacheNotRestoredReasonEmbedderDomDistillerViewerSource,
+		PageBackForwardCacheNotRestoredReasonEmbedderDomDistillerSelfDeletingRequestDelegate,
+		PageBackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper,
+		PageBackForwardCacheNotRestoredReasonEmbedderOfflinePage,
+		PageBackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager,
+		PageBackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager,
+		PageBackForwardCacheNotRestoredReasonEmbedderModalDialog,
+		PageBackForwardCacheNotRestoredReasonEmbedderExtensions,
+		PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessaging,
+		PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort,
+		PageBackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame,
+		PageBackForwardCacheNotRestoredReasonRequestedByWebViewClient,
+		PageBackForwardCacheNotRestoredReasonPostMessageByWebViewClient,
+		PageBackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated,
+		PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure,
+		PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure:
+		return true
+	}
+	return false
+}
+
+// Types of not restored reasons for back-forward cache.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageBackForwardCacheNotRestoredReasonType string
+
+const (
+	PageBackForwardCacheNotRestoredReasonTypeSupportPending    PageBackForwardCacheNotRestoredReasonType = "SupportPending"
+	PageBackForwardCacheNotRestoredReasonTypePageSupportNeeded PageBackForwardCacheNotRestoredReasonType = "PageSupportNeeded"
+	PageBackForwardCacheNotRestoredReasonTypeCircumstantial    PageBackForwardCacheNotRestoredReasonType = "Circumstantial"
+)
+
+// Valid returns true if the value is one of the PageBackForwardCacheNotRestoredReasonType enum values.
+func (e PageBackForwardCacheNotRestoredReasonType) Valid() bool {
+	switch e {
+	case PageBackForwardCacheNotRestoredReasonTypeSupportPending,
+		PageBackForwardCacheNotRestoredReasonTypePageSupportNeeded,
+		PageBackForwardCacheNotRestoredReasonTypeCircumstantial:
+		return true
+	}
+	return false
+}
+
+// Input mode.
+type PageFileChooserOpenedMode string
+
+const (
+	PageFileChooserOpenedModeSelectSingle   PageFileChooserOpenedMode = "selectSingle"
+	PageFileChooserOpenedModeSelectMultiple PageFileChooserOpenedMode = "selectMultiple"
+)
+
+// Valid returns true if the value is one of the PageFileChooserOpenedMode enum values.
+func (e PageFileChooserOpenedMode) Valid() bool {
+	switch e {
+	case PageFileChooserOpenedModeSelectSingle,
+		PageFileChooserOpenedModeSelectMultiple:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageFrameDetachedReason string
+
+const (
+	PageFrameDetachedReasonRemove PageFrameDetachedReason = "remove"
+	PageFrameDetachedReasonSwap   PageFrameDetachedReason = "swap"
+)
+
+// Valid returns true if the value is one of the PageFrameDetachedReason enum values.
+func (e PageFrameDetachedReason) Valid() bool {
+	switch e {
+	case PageFrameDetachedReasonRemove,
+		PageFrameDetachedReasonSwap:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type PageFrameStartedNavigatingNavigationType string
+
+const (
+	PageFrameStartedNavigatingNavigationTypeReload                   PageFrameStartedNavigatingNavigationType = "reload"
+	PageFrameStartedNavigatingNavigationTypeReloadBypassingCache     PageFrameStartedNavigatingNavigationType = "reloadBypassingCache"
+	PageFrameStartedNavigatingNavigationTypeRestore                  PageFrameStartedNavigatingNavigationType = "restore"
+	PageFrameStartedNavigatingNavigationTypeRestoreWithPost          PageFrameStartedNavigatingNavigationType = "restoreWithPost"
+	PageFrameStartedNavigatingNavigationTypeHistorySameDocument      PageFrameStartedNavigatingNavigationType = "historySameDocument"
+	PageFrameStartedNavigatingNavigationTypeHistoryDifferentDocument PageFrameStartedNavigatingNavigationType = "historyDifferentDocument"
+	PageFrameStartedNavigatingNavigationTypeSameDocument             PageFrameStartedNavigatingNavigationType = "sameDocument"
+	PageFrameStartedNavigatingNavigationTypeDifferentDocument        PageFrameStartedNavigatingNavigationType = "differentDocument"
+)
+
+// Valid returns true if the value is one of the PageFrameStartedNavigatingNavigationType enum values.
+func (e PageFrameStartedNavigatingNavigationType) Valid() bool {
+	switch e {
+	case PageFrameStartedNavigatingNavigationTypeReload,
+		PageFrameStartedNavigatingNavigationTypeReloadBypassingCache,
+		PageFrameStartedNavigatingNavigationTypeRestore,
+		PageFrameStartedNavigatingNavigationTypeRestoreWithPost,
+		PageFrameStartedNavigatingNavigationTypeHistorySameDocument,
+		PageFrameStartedNavigatingNavigationTypeHistoryDifferentDocument,
+		PageFrameStartedNavigatingNavigationTypeSameDocument,
+		PageFrameStartedNavigatingNavigationTypeDifferentDocument:
+		return true
+	}
+	return false
+}
+
+// Download status.
+type PageDownloadProgressState string
+
+const (
+	PageDownloadProgressStateInProgress PageDownloadProgressState = "inProgress"
+	PageDownloadProgressStateCompleted  PageDownloadProgressState = "completed"
+	PageDownloadProgressStateCanceled   PageDownloadProgressState = "canceled"
+)
+
+// Valid returns true if the value is one of the PageDownloadProgressState enum values.
+func (e PageDownloadProgressState) Valid() bool {
+	switch e {
+	case PageDownloadProgressStateInProgress,
+		PageDownloadProgressStateCompleted,
+		PageDownloadProgressStateCanceled:
+		return true
+	}
+	return false
+}
+
+// Navigation type
+type PageNavigatedWithinDocumentNavigationType string
+
+const (
+	PageNavigatedWithinDocumentNavigationTypeFragment   PageNavigatedWithinDocumentNavigationType = "fragment"
+	PageNavigatedWithinDocumentNavigationTypeHistoryApi PageNavigatedWithinDocumentNavigationType = "historyApi"
+	PageNavigatedWithinDocumentNavigationTypeOther      PageNavigatedWithinDocumentNavigationType = "other"
+)
+
+// Valid returns true if the value is one of the PageNavigatedWithinDocumentNavigationType enum values.
+func (e PageNavigatedWithinDocumentNavigationType) Valid() bool {
+	switch e {
+	case PageNavigatedWithinDocumentNavigationTypeFragment,
+		PageNavigatedWithinDocumentNavigationTypeHistoryApi,
+		PageNavigatedWithinDocumentNavigationTypeOther:
+		return true
+	}
+	return false
+}
+
+// Image compression format (defaults to png).
+type PageCaptureScreenshotFormat string
+
+const (
+	PageCaptureScreenshotFormatJpeg PageCaptureScreenshotFormat = "jpeg"
+	PageCaptureScreenshotFormatPng  PageCaptureScreenshotFormat = "png"
+	PageCaptureScreenshotFormatWebp PageCaptureScreenshotFormat = "webp"
+)
+
+// Valid returns true if the value is one of the PageCaptureScreenshotFormat enum values.
+func (e PageCaptureScreenshotFormat) Valid() bool {
+	switch e {
+	case PageCaptureScreenshotFormatJpeg,
+		PageCaptureScreenshotFormatPng,
+		PageCaptureScreenshotFormatWebp:
+		return true
+	}
+	return false
+}
+
+// Format (defaults to mhtml).
+type PageCaptureSnapshotFormat string
+
+const (
+	PageCaptureSnapshotFormatMhtml PageCaptureSnapshotFormat = "mhtml"
+)
+
+// Valid returns true if the value is one of the PageCaptureSnapshotFormat enum values.
+func (e PageCaptureSnapshotFormat) Valid() bool {
+	switch e {
+	case PageCaptureSnapshotFormatMhtml:
+		return true
+	}
+	return false
+}
+
+// return as stream
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PagePrintToPDFTransferMode string
+
+const (
+	PagePrintToPDFTransferModeReturnAsBase64 PagePrintToPDFTransferMode = "ReturnAsBase64"
+	PagePrintToPDFTransferModeReturnAsStream PagePrintToPDFTransferMode = "ReturnAsStream"
+)
+
+// Valid returns true if the value is one of the PagePrintToPDFTransferMode enum values.
+func (e PagePrintToPDFTransferMode) Valid() bool {
+	switch e {
+	case PagePrintToPDFTransferModeReturnAsBase64,
+		PagePrintToPDFTransferModeReturnAsStream:
+		return true
+	}
+	return false
+}
+
+// Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny).
+type PageSetDownloadBehaviorBehavior string
+
+const (
+	PageSetDownloadBehaviorBehaviorDeny    PageSetDownloadBehaviorBehavior = "deny"
+	PageSetDownloadBehaviorBehaviorAllow   PageSetDownloadBehaviorBehavior = "allow"
+	PageSetDownloadBehaviorBehaviorDefault PageSetDownloadBehaviorBehavior = "default"
+)
+
+// Valid returns true if the value is one of the PageSetDownloadBehaviorBehavior enum values.
+func (e PageSetDownloadBehaviorBehavior) Valid() bool {
+	switch e {
+	case PageSetDownloadBehaviorBehaviorDeny,
+		PageSetDownloadBehaviorBehaviorAllow,
+		PageSetDownloadBehaviorBehaviorDefault:
+		return true
+	}
+	return false
+}
+
+// Touch/gesture events configuration. Default: current platform.
+type PageSetTouchEmulationEnabledConfiguration string
+
+const (
+	PageSetTouchEmulationEnabledConfigurationMobile  PageSetTouchEmulationEnabledConfiguration = "mobile"
+	PageSetTouchEmulationEnabledConfigurationDesktop PageSetTouchEmulationEnabledConfiguration = "desktop"
+)
+
+// Valid returns true if the value is one of the PageSetTouchEmulationEnabledConfiguration enum values.
+func (e PageSetTouchEmulationEnabledConfiguration) Valid() bool {
+	switch e {
+	case PageSetTouchEmulationEnabledConfigurationMobile,
+		PageSetTouchEmulationEnabledConfigurationDesktop:
+		return true
+	}
+	return false
+}
+
+// Image compression format.
+type PageStartScreencastFormat string
+
+const (
+	PageStartScreencastFormatJpeg PageStartScreencastFormat = "jpeg"
+	PageStartScreencastFormatPng  PageStartScreencastFormat = "png"
+)
+
+// Valid returns true if the value is one of the PageStartScreencastFormat enum values.
+func (e PageStartScreencastFormat) Valid() bool {
+	switch e {
+	case PageStartScreencastFormatJpeg,
+		PageStartScreencastFormatPng:
+		return true
+	}
+	return false
+}
+
+// Target lifecycle state
+type PageSetWebLifecycleStateState string
+
+const (
+	PageSetWebLifecycleStateStateFrozen PageSetWebLifecycleStateState = "frozen"
+	PageSetWebLifecycleStateStateActive PageSetWebLifecycleStateState = "active"
+)
+
+// Valid returns true if the value is one of the PageSetWebLifecycleStateState enum values.
+func (e PageSetWebLifecycleStateState) Valid() bool {
+	switch e {
+	case PageSetWebLifecycleStateStateFrozen,
+		PageSetWebLifecycleStateStateActive:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type PageSetSPCTransactionModeMode string
+
+const (
+	PageSetSPCTransactionModeModeNone                       PageSetSPCTransactionModeMode = "none"
+	PageSetSPCTransactionModeModeAutoAccept                 PageSetSPCTransactionModeMode = "autoAccept"
+	PageSetSPCTransactionModeModeAutoChooseToAuthAnotherWay PageSetSPCTransactionModeMode = "autoChooseToAuthAnotherWay"
+	PageSetSPCTransactionModeModeAutoReject                 PageSetSPCTransactionModeMode = "autoReject"
+	PageSetSPCTransactionModeModeAutoOptOut                 PageSetSPCTransactionModeMode = "autoOptOut"
+)
+
+// Valid returns true if the value is one of the PageSetSPCTransactionModeMode enum values.
+func (e PageSetSPCTransactionModeMode) Valid() bool {
+	switch e {
+	case PageSetSPCTransactionModeModeNone,
+		PageSetSPCTransactionModeModeAutoAccept,
+		PageSetSPCTransactionModeModeAutoChooseToAuthAnotherWay,
+		PageSetSPCTransactionModeModeAutoReject,
+		PageSetSPCTransactionModeModeAutoOptOut:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type PageSetRPHRegistrationModeMode string
+
+const (
+	PageSetRPHRegistrationModeModeNone       PageSetRPHRegistrationModeMode = "none"
+	PageSetRPHRegistrationModeModeAutoAccept PageSetRPHRegistrationModeMode = "autoAccept"
+	PageSetRPHRegistrationModeModeAutoReject PageSetRPHRegistrationModeMode = "autoReject"
+)
+
+// Valid returns true if the value is one of the PageSetRPHRegistrationModeMode enum values.
+func (e PageSetRPHRegistrationModeMode) Valid() bool {
+	switch e {
+	case PageSetRPHRegistrationModeModeNone,
+		PageSetRPHRegistrationModeModeAutoAccept,
+		PageSetRPHRegistrationModeModeAutoReject:
+		return true
+	}
+	return false
+}
+
 // Indicates whether a frame has been identified as an ad and why.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageAdFrameStatus struct {
-	AdFrameType  string   `json:"adFrameType"`            //  enum values: none, child, root
-	Explanations []string `json:"explanations,omitempty"` //  enum values: ParentIsAd, CreatedByAdScript, MatchedBlockingRule
+	AdFrameType  PageAdFrameType          `json:"adFrameType"`            //  enum values: none, child, root
+	Explanations []PageAdFrameExplanation `json:"explanations,omitempty"` //  enum values: ParentIsAd, CreatedByAdScript, MatchedBlockingRule
 }
 
-// Identifies the bottom-most script which caused the frame to be labelled as an ad.
+// Identifies the script which caused a script or frame to be labelled as an ad.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageAdScriptId struct {
-	ScriptId   string `json:"scriptId"`   // Script Id of the bottom-most script which caused the frame to be labelled as an ad.
-	DebuggerId string `json:"debuggerId"` // Id of adScriptId's debugger.
+	ScriptId   string `json:"scriptId"`   // Script Id of the script which caused a script or frame to be labelled as an ad.
+	DebuggerId string `json:"debuggerId"` // Id of scriptId's debugger.
+}
+
+// Encapsulates the script ancestry and the root script filterlist rule that caused the frame to be labelled as an ad. Only created when `ancestryChain` is not empty.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageAdScriptAncestry struct {
+	AncestryChain            []*PageAdScriptId `json:"ancestryChain"`                      // A chain of `AdScriptId`s representing the ancestry of an ad script that led to the creation of a frame. The chain is ordered from the script itself (lower level) up to its root ancestor that was flagged by filterlist.
+	RootScriptFilterlistRule string            `json:"rootScriptFilterlistRule,omitempty"` // The filterlist rule that caused the root (last) script in `ancestryChain` to be ad-tagged. Only populated if the rule is available.
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PagePermissionsPolicyBlockLocator struct {
-	FrameId     string `json:"frameId"`     //
-	BlockReason string `json:"blockReason"` //  enum values: Header, IframeAttribute, InFencedFrameTree, InIsolatedApp
+	FrameId     string                           `json:"frameId"`     //
+	BlockReason PagePermissionsPolicyBlockReason `json:"blockReason"` //  enum values: Header, IframeAttribute, InFencedFrameTree, InIsolatedApp
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PagePermissionsPolicyFeatureState struct {
-	Feature string                             `json:"feature"`           //  enum values: accelerometer, ambient-light-sensor, attribution-reporting, autoplay, bluetooth, browsing-topics, camera, ch-dpr, ch-device-memory, ch-downlink, ch-ect, ch-prefers-color-scheme, ch-prefers-reduced-motion, ch-rtt, ch-save-data, ch-ua, ch-ua-arch, ch-ua-bitness, ch-ua-platform, ch-ua-model, ch-ua-mobile, ch-ua-full, ch-ua-full-version, ch-ua-full-version-list, ch-ua-platform-version, ch-ua-reduced, ch-ua-wow64, ch-viewport-height, ch-viewport-width, ch-width, clipboard-read, clipboard-write, compute-pressure, cross-origin-isolated, direct-sockets, display-capture, document-domain, encrypted-media, execution-while-out-of-viewport, execution-while-not-rendered, focus-without-user-activation, fullscreen, frobulate, gamepad, geolocation, gyroscope, hid, identity-credentials-get, idle-detection, interest-cohort, join-ad-interest-group, keyboard-map, local-fonts, magnetometer, microphone, midi, otp-credentials, payment, picture-in-picture, private-aggregation, private-state-token-issuance, private-state-token-redemption, publickey-credentials-get, run-ad-auction, screen-wake-lock, serial, shared-autofill, shared-storage, shared-storage-select-url, smart-card, storage-access, sync-xhr, unload, usb, vertical-scroll, web-share, window-management, window-placement, xr-spatial-tracking
+	Feature PagePermissionsPolicyFeature       `json:"feature"`           //  enum values: accelerometer, all-screens-capture, ambient-light-sensor, aria-notify, attribution-reporting, autoplay, bluetooth, browsing-topics, camera, captured-surface-control, ch-dpr, ch-device-memory, ch-downlink, ch-ect, ch-prefers-color-scheme, ch-prefers-reduced-motion, ch-prefers-reduced-transparency, ch-rtt, ch-save-data, ch-ua, ch-ua-arch, ch-ua-bitness, ch-ua-high-entropy-values, ch-ua-platform, ch-ua-model, ch-ua-mobile, ch-ua-form-factors, ch-ua-full-version, ch-ua-full-version-list, ch-ua-platform-version, ch-ua-wow64, ch-viewport-height, ch-viewport-width, ch-width, clipboard-read, clipboard-write, compute-pressure, controlled-frame, cross-origin-isolated, deferred-fetch, deferred-fetch-minimal, device-attributes, digital-credentials-get, direct-sockets, direct-sockets-private, display-capture, document-domain, encrypted-media, execution-while-out-of-viewport, execution-while-not-rendered, fenced-unpartitioned-storage-read, focus-without-user-activation, fullscreen, frobulate, gamepad, geolocation, gyroscope, hid, identity-credentials-get, idle-detection, interest-cohort, join-ad-interest-group, keyboard-map, language-detector, language-model, local-fonts, local-network-access, magnetometer, media-playback-while-not-visible, microphone, midi, on-device-speech-recognition, otp-credentials, payment, picture-in-picture, popins, private-aggregation, private-state-token-issuance, private-state-token-redemption, publickey-credentials-create, publickey-credentials-get, record-ad-auction-events, rewriter, run-ad-auction, screen-wake-lock, serial, shared-autofill, shared-storage, shared-storage-select-url, smart-card, speaker-selection, storage-access, sub-apps, summarizer, sync-xhr, translator, unload, usb, usb-unrestricted, vertical-scroll, web-app-installation, web-printing, web-share, window-management, writer, xr-spatial-tracking
 	Allowed bool                               `json:"allowed"`           //
 	Locator *PagePermissionsPolicyBlockLocator `json:"locator,omitempty"` //
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageOriginTrialToken struct {
-	Origin           string  `json:"origin"`           //
-	MatchSubDomains  bool    `json:"matchSubDomains"`  //
-	TrialName        string  `json:"trialName"`        //
-	ExpiryTime       float64 `json:"expiryTime"`       //
-	IsThirdParty     bool    `json:"isThirdParty"`     //
-	UsageRestriction string  `json:"usageRestriction"` //  enum values: None, Subset
+	Origin           string                          `json:"origin"`           //
+	MatchSubDomains  bool                            `json:"matchSubDomains"`  //
+	TrialName        string                          `json:"trialName"`        //
+	ExpiryTime       float64                         `json:"expiryTime"`       //
+	IsThirdParty     bool                            `json:"isThirdParty"`     //
+	UsageRestriction PageOriginTrialUsageRestriction `json:"usageRestriction"` //  enum values: None, Subset
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageOriginTrialTokenWithStatus struct {
-	RawTokenText string                `json:"rawTokenText"`          //
-	ParsedToken  *PageOriginTrialToken `json:"parsedToken,omitempty"` // `parsedToken` is present only when the token is extractable and parsable.
-	Status       string                `json:"status"`                //  enum values: Success, NotSupported, Insecure, Expired, WrongOrigin, InvalidSignature, Malformed, WrongVersion, FeatureDisabled, TokenDisabled, FeatureDisabledForUser, UnknownTrial
+	RawTokenText string                     `json:"rawTokenText"`          //
+	ParsedToken  *PageOriginTrialToken      `json:"parsedToken,omitempty"` // `parsedToken` is present only when the token is extractable and parsable.
+	Status       PageOriginTrialTokenStatus `json:"status"`                //  enum values: Success, NotSupported, Insecure, Expired, WrongOrigin, InvalidSignature, Malformed, WrongVersion, FeatureDisabled, TokenDisabled, FeatureDisabledForUser, UnknownTrial
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageOriginTrial struct {
 	TrialName        string                            `json:"trialName"`        //
-	Status           string                            `json:"status"`           //  enum values: Enabled, ValidTokenNotProvided, OSNotSupported, TrialNotAllowed
+	Status           PageOriginTrialStatus             `json:"status"`           //  enum values: Enabled, ValidTokenNotProvided, OSNotSupported, TrialNotAllowed
 	TokensWithStatus []*PageOriginTrialTokenWithStatus `json:"tokensWithStatus"` //
 }
 
+// Additional information about the frame document's security origin.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageSecurityOriginDetails struct {
+	IsLocalhost bool `json:"isLocalhost"` // Indicates whether the frame document's security origin is one of the local hostnames (e.g. "localhost") or IP addresses (IPv4 127.0.0.0/8 or IPv6 ::1).
+}
+
 // Information about the Frame on the page.
 type PageFrame struct {
-	Id                             string             `json:"id"`                             // Frame unique identifier.
-	ParentId                       string             `json:"parentId,omitempty"`             // Parent frame identifier.
-	LoaderId                       string             `json:"loaderId"`                       // Identifier of the loader associated with this frame.
-	Name                           string             `json:"name,omitempty"`                 // Frame's name as specified in the tag.
-	Url                            string             `json:"url"`                            // Frame document's URL without fragment.
-	UrlFragment                    string             `json:"urlFragment,omitempty"`          // Frame document's URL fragment including the '#'.
-	DomainAndRegistry              string             `json:"domainAndRegistry"`              // Frame document's registered domain, taking the public suffixes list into account. Extracted from the Frame's url. Example URLs: http://www.google.com/file.html -> "google.com"               http://a.b.co.uk/file.html      -> "b.co.uk"
-	SecurityOrigin                 string             `json:"securityOrigin"`                 // Frame document's security origin.
-	MimeType                       string             `json:"mimeType"`                       // Frame document's mimeType as determined by the browser.
-	UnreachableUrl                 string             `json:"unreachableUrl,omitempty"`       // If the frame failed to load, this contains the URL that could not be loaded. Note that unlike url above, this URL may contain a fragment.
-	AdFrameStatus                  *PageAdFrameStatus `json:"adFrameStatus,omitempty"`        // Indicates whether this frame was tagged as an ad and why.
-	SecureContextType              string             `json:"secureContextType"`              // Indicates whether the main document is a secure context and explains why that is the case. enum values: Secure, SecureLocalhost, InsecureScheme, InsecureAncestor
-	CrossOriginIsolatedContextType string             `json:"crossOriginIsolatedContextType"` // Indicates whether this is a cross origin isolated context. enum values: Isolated, NotIsolated, NotIsolatedFeatureDisabled
-	GatedAPIFeatures               []string           `json:"gatedAPIFeatures"`               // Indicated which gated APIs / features are available. enum values: SharedArrayBuffers, SharedArrayBuffersTransferAllowed, PerformanceMeasureMemory, PerformanceProfile
+	Id                             string                             `json:"id"`                              // Frame unique identifier.
+	ParentId                       string                             `json:"parentId,omitempty"`              // Parent frame identifier.
+	LoaderId                       string                             `json:"loaderId"`                        // Identifier of the loader associated with this frame.
+	Name                           string                             `json:"name,omitempty"`                  // Frame's name as specified in the tag.
+	Url                            string                             `json:"url"`                             // Frame document's URL without fragment.
+	UrlFragment                    string                             `json:"urlFragment,omitempty"`           // Frame document's URL fragment including the '#'.
+	DomainAndRegistry              string                             `json:"domainAndRegistry"`               // Frame document's registered domain, taking the public suffixes list into account. Extracted from the Frame's url. Example URLs: http://www.google.com/file.html -> "google.com"               http://a.b.co.uk/file.html      -> "b.co.uk"
+	SecurityOrigin                 string                             `json:"securityOrigin"`                  // Frame document's security origin.
+	SecurityOriginDetails          *PageSecurityOriginDetails         `json:"securityOriginDetails,omitempty"` // Additional details about the frame document's security origin.
+	MimeType                       string                             `json:"mimeType"`                        // Frame document's mimeType as determined by the browser.
+	UnreachableUrl                 string                             `json:"unreachableUrl,omitempty"`        // If the frame failed to load, this contains the URL that could not be loaded. Note that unlike url above, this URL may contain a fragment.
+	AdFrameStatus                  *PageAdFrameStatus                 `json:"adFrameStatus,omitempty"`         // Indicates whether this frame was tagged as an ad and why.
+	SecureContextType              PageSecureContextType              `json:"secureContextType"`               // Indicates whether the main document is a secure context and explains why that is the case. enum values: Secure, SecureLocalhost, InsecureScheme, InsecureAncestor
+	CrossOriginIsolatedContextType PageCrossOriginIsolatedContextType `json:"crossOriginIsolatedContextType"`  // Indicates whether this is a cross origin isolated context. enum values: Isolated, NotIsolated, NotIsolatedFeatureDisabled
+	GatedAPIFeatures               []PageGatedAPIFeatures             `json:"gatedAPIFeatures"`                // Indicated which gated APIs / features are available. enum values: SharedArrayBuffers, SharedArrayBuffersTransferAllowed, PerformanceMeasureMemory, PerformanceProfile
 }
 
 // Information about the Resource on the page.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageFrameResource struct {
-	Url          string  `json:"url"`                    // Resource URL.
-	Type         string  `json:"type"`                   // Type of this resource. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, Other
-	MimeType     string  `json:"mimeType"`               // Resource mimeType as determined by the browser.
-	LastModified float64 `json:"lastModified,omitempty"` // last-modified timestamp as reported by server.
-	ContentSize  float64 `json:"contentSize,omitempty"`  // Resource content size.
-	Failed       bool    `json:"failed,omitempty"`       // True if the resource failed to load.
-	Canceled     bool    `json:"canceled,omitempty"`     // True if the resource was canceled during loading.
+	Url          string              `json:"url"`                    // Resource URL.
+	Type         NetworkResourceType `json:"type"`                   // Type of this resource. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other
+	MimeType     string              `json:"mimeType"`               // Resource mimeType as determined by the browser.
+	LastModified float64             `json:"lastModified,omitempty"` // last-modified timestamp as reported by server.
+	ContentSize  float64             `json:"contentSize,omitempty"`  // Resource content size.
+	Failed       bool                `json:"failed,omitempty"`       // True if the resource failed to load.
+	Canceled     bool                `json:"canceled,omitempty"`     // True if the resource was canceled during loading.
 }
 
 // Information about the Frame hierarchy along with their cached resources.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageFrameResourceTree struct {
 	Frame       *PageFrame               `json:"frame"`                 // Frame information for this tree item.
 	ChildFrames []*PageFrameResourceTree `json:"childFrames,omitempty"` // Child frames.
@@ -102,14 +1366,16 @@ type PageFrameTree struct {
 
 // Navigation history entry.
 type PageNavigationEntry struct {
-	Id             int    `json:"id"`             // Unique id of the navigation history entry.
-	Url            string `json:"url"`            // URL of the navigation history entry.
-	UserTypedURL   string `json:"userTypedURL"`   // URL that the user typed in the url bar.
-	Title          string `json:"title"`          // Title of the navigation history entry.
-	TransitionType string `json:"transitionType"` // Transition type. enum values: link, typed, address_bar, auto_bookmark, auto_subframe, manual_subframe, generated, auto_toplevel, form_submit, reload, keyword, keyword_generated, other
+	Id             int                `json:"id"`             // Unique id of the navigation history entry.
+	Url            string             `json:"url"`            // URL of the navigation history entry.
+	UserTypedURL   string             `json:"userTypedURL"`   // URL that the user typed in the url bar.
+	Title          string             `json:"title"`          // Title of the navigation history entry.
+	TransitionType PageTransitionType `json:"transitionType"` // Transition type. enum values: link, typed, address_bar, auto_bookmark, auto_subframe, manual_subframe, generated, auto_toplevel, form_submit, reload, keyword, keyword_generated, other
 }
 
 // Screencast frame metadata.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageScreencastFrameMetadata struct {
 	OffsetTop       float64 `json:"offsetTop"`           // Top offset in DIP.
 	PageScaleFactor float64 `json:"pageScaleFactor"`     // Page scale factor.
@@ -123,12 +1389,14 @@ type PageScreencastFrameMetadata struct {
 // Error while paring app manifest.
 type PageAppManifestError struct {
 	Message  string `json:"message"`  // Error message.
-	Critical int    `json:"critical"` // If criticial, this is a non-recoverable parse error.
+	Critical int    `json:"critical"` // If critical, this is a non-recoverable parse error.
 	Line     int    `json:"line"`     // Error line.
 	Column   int    `json:"column"`   // Error column.
 }
 
 // Parsed app manifest properties.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageAppManifestParsedProperties struct {
 	Scope string `json:"scope"` // Computed scope value
 }
@@ -163,6 +1431,8 @@ type PageViewport struct {
 }
 
 // Generic font families collection.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageFontFamilies struct {
 	Standard  string `json:"standard,omitempty"`  // The standard font-family.
 	Fixed     string `json:"fixed,omitempty"`     // The fixed font-family.
@@ -174,49 +1444,256 @@ type PageFontFamilies struct {
 }
 
 // Font families collection for a script.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageScriptFontFamilies struct {
 	Script       string            `json:"script"`       // Name of the script which these font families are defined for.
 	FontFamilies *PageFontFamilies `json:"fontFamilies"` // Generic font families collection for the script.
 }
 
 // Default font sizes.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageFontSizes struct {
 	Standard int `json:"standard,omitempty"` // Default standard font size.
 	Fixed    int `json:"fixed,omitempty"`    // Default fixed font size.
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageInstallabilityErrorArgument struct {
 	Name  string `json:"name"`  // Argument name (e.g. name:'minimum-icon-size-in-pixels').
 	Value string `json:"value"` // Argument value (e.g. value:'64').
 }
 
 // The installability error
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageInstallabilityError struct {
 	ErrorId        string                             `json:"errorId"`        // The error id (e.g. 'manifest-missing-suitable-icon').
 	ErrorArguments []*PageInstallabilityErrorArgument `json:"errorArguments"` // The list of error arguments (e.g. {name:'minimum-icon-size-in-pixels', value:'64'}).
 }
 
 // Per-script compilation cache parameters for `Page.produceCompilationCache`
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageCompilationCacheParams struct {
 	Url   string `json:"url"`             // The URL of the script to produce a compilation cache entry for.
 	Eager bool   `json:"eager,omitempty"` // A hint to the backend whether eager compilation is recommended. (the actual compilation mode used is upon backend discretion).
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageFileFilter struct {
+	Name    string   `json:"name,omitempty"`    //
+	Accepts []string `json:"accepts,omitempty"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageFileHandler struct {
+	Action     string               `json:"action"`            //
+	Name       string               `json:"name"`              //
+	Icons      []*PageImageResource `json:"icons,omitempty"`   //
+	Accepts    []*PageFileFilter    `json:"accepts,omitempty"` // Mimic a map, name is the key, accepts is the value.
+	LaunchType string               `json:"launchType"`        // Won't repeat the enums, using string for easy comparison. Same as the other enums below.
+}
+
+// The image definition used in both icon and screenshot.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageImageResource struct {
+	Url   string `json:"url"`             // The src field in the definition, but changing to url in favor of consistency.
+	Sizes string `json:"sizes,omitempty"` //
+	Type  string `json:"type,omitempty"`  //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageLaunchHandler struct {
+	ClientMode string `json:"clientMode"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageProtocolHandler struct {
+	Protocol string `json:"protocol"` //
+	Url      string `json:"url"`      //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageRelatedApplication struct {
+	Id  string `json:"id,omitempty"` //
+	Url string `json:"url"`          //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageScopeExtension struct {
+	Origin            string `json:"origin"`            // Instead of using tuple, this field always returns the serialized string for easy understanding and comparison.
+	HasOriginWildcard bool   `json:"hasOriginWildcard"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageScreenshot struct {
+	Image      *PageImageResource `json:"image"`           //
+	FormFactor string             `json:"formFactor"`      //
+	Label      string             `json:"label,omitempty"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageShareTarget struct {
+	Action  string            `json:"action"`          //
+	Method  string            `json:"method"`          //
+	Enctype string            `json:"enctype"`         //
+	Title   string            `json:"title,omitempty"` // Embed the ShareTargetParams
+	Text    string            `json:"text,omitempty"`  //
+	Url     string            `json:"url,omitempty"`   //
+	Files   []*PageFileFilter `json:"files,omitempty"` //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageShortcut struct {
+	Name string `json:"name"` //
+	Url  string `json:"url"`  //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageWebAppManifest struct {
+	BackgroundColor           string                    `json:"backgroundColor,omitempty"`           //
+	Description               string                    `json:"description,omitempty"`               // The extra description provided by the manifest.
+	Dir                       string                    `json:"dir,omitempty"`                       //
+	Display                   string                    `json:"display,omitempty"`                   //
+	DisplayOverrides          []string                  `json:"displayOverrides,omitempty"`          // The overrided display mode controlled by the user.
+	FileHandlers              []*PageFileHandler        `json:"fileHandlers,omitempty"`              // The handlers to open files.
+	Icons                     []*PageImageResource      `json:"icons,omitempty"`                     //
+	Id                        string                    `json:"id,omitempty"`                        //
+	Lang                      string                    `json:"lang,omitempty"`                      //
+	LaunchHandler             *PageLaunchHandler        `json:"launchHandler,omitempty"`             // TODO(crbug.com/1231886): This field is non-standard and part of a Chrome experiment. See: https://github.com/WICG/web-app-launch/blob/main/launch_handler.md
+	Name                      string                    `json:"name,omitempty"`                      //
+	Orientation               string                    `json:"orientation,omitempty"`               //
+	PreferRelatedApplications bool                      `json:"preferRelatedApplications,omitempty"` //
+	ProtocolHandlers          []*PageProtocolHandler    `json:"protocolHandlers,omitempty"`          // The handlers to open protocols.
+	RelatedApplications       []*PageRelatedApplication `json:"relatedApplications,omitempty"`       //
+	Scope                     string                    `json:"scope,omitempty"`                     //
+	ScopeExtensions           []*PageScopeExtension     `json:"scopeExtensions,omitempty"`           // Non-standard, see https://github.com/WICG/manifest-incubations/blob/gh-pages/scope_extensions-explainer.md
+	Screenshots               []*PageScreenshot         `json:"screenshots,omitempty"`               // The screenshots used by chromium.
+	ShareTarget               *PageShareTarget          `json:"shareTarget,omitempty"`               //
+	ShortName                 string                    `json:"shortName,omitempty"`                 //
+	Shortcuts                 []*PageShortcut           `json:"shortcuts,omitempty"`                 //
+	StartUrl                  string                    `json:"startUrl,omitempty"`                  //
+	ThemeColor                string                    `json:"themeColor,omitempty"`                //
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageBackForwardCacheBlockingDetails struct {
+	Url          string `json:"url,omitempty"`      // Url of the file where blockage happened. Optional because of tests.
+	Function     string `json:"function,omitempty"` // Function name where blockage happened. Optional because of anonymous functions and tests.
+	LineNumber   int    `json:"lineNumber"`         // Line number in the script (0-based).
+	ColumnNumber int    `json:"columnNumber"`       // Column number in the script (0-based).
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageBackForwardCacheNotRestoredExplanation struct {
-	Type    string `json:"type"`              // Type of the reason enum values: SupportPending, PageSupportNeeded, Circumstantial
-	Reason  string `json:"reason"`            // Not restored reason enum values: NotPrimaryMainFrame, BackForwardCacheDisabled, RelatedActiveContentsExist, HTTPStatusNotOK, SchemeNotHTTPOrHTTPS, Loading, WasGrantedMediaAccess, DisableForRenderFrameHostCalled, DomainNotAllowed, HTTPMethodNotGET, SubframeIsNavigating, Timeout, CacheLimit, JavaScriptExecution, RendererProcessKilled, RendererProcessCrashed, SchedulerTrackedFeatureUsed, ConflictingBrowsingInstance, CacheFlushed, ServiceWorkerVersionActivation, SessionRestored, ServiceWorkerPostMessage, EnteredBackForwardCacheBeforeServiceWorkerHostAdded, RenderFrameHostReused_SameSite, RenderFrameHostReused_CrossSite, ServiceWorkerClaim, IgnoreEventAndEvict, HaveInnerContents, TimeoutPuttingInCache, BackForwardCacheDisabledByLowMemory, BackForwardCacheDisabledByCommandLine, NetworkRequestDatapipeDrainedAsBytesConsumer, NetworkRequestRedirected, NetworkRequestTimeout, NetworkExceedsBufferLimit, NavigationCancelledWhileRestoring, NotMostRecentNavigationEntry, BackForwardCacheDisabledForPrerender, UserAgentOverrideDiffers, ForegroundCacheLimit, BrowsingInstanceNotSwapped, BackForwardCacheDisabledForDelegate, UnloadHandlerExistsInMainFrame, UnloadHandlerExistsInSubFrame, ServiceWorkerUnregistration, CacheControlNoStore, CacheControlNoStoreCookieModified, CacheControlNoStoreHTTPOnlyCookieModified, NoResponseHead, Unknown, ActivationNavigationsDisallowedForBug1234857, ErrorDocument, FencedFramesEmbedder, WebSocket, WebTransport, WebRTC, MainResourceHasCacheControlNoStore, MainResourceHasCacheControlNoCache, SubresourceHasCacheControlNoStore, SubresourceHasCacheControlNoCache, ContainsPlugins, DocumentLoaded, DedicatedWorkerOrWorklet, OutstandingNetworkRequestOthers, OutstandingIndexedDBTransaction, RequestedMIDIPermission, RequestedAudioCapturePermission, RequestedVideoCapturePermission, RequestedBackForwardCacheBlockedSensors, RequestedBackgroundWorkPermission, BroadcastChannel, IndexedDBConnection, WebXR, SharedWorker, WebLocks, WebHID, WebShare, RequestedStorageAccessGrant, WebNfc, OutstandingNetworkRequestFetch, OutstandingNetworkRequestXHR, AppBanner, Printing, WebDatabase, PictureInPicture, Portal, SpeechRecognizer, IdleManager, PaymentManager, SpeechSynthesis, KeyboardLock, WebOTPService, OutstandingNetworkRequestDirectSocket, InjectedJavascript, InjectedStyleSheet, KeepaliveRequest, IndexedDBEvent, Dummy, AuthorizationHeader, ContentSecurityHandler, ContentWebAuthenticationAPI, ContentFileChooser, ContentSerial, ContentFileSystemAccess, ContentMediaDevicesDispatcherHost, ContentWebBluetooth, ContentWebUSB, ContentMediaSessionService, ContentScreenReader, EmbedderPopupBlockerTabHelper, EmbedderSafeBrowsingTriggeredPopupBlocker, EmbedderSafeBrowsingThreatDetails, EmbedderAppBannerManager, EmbedderDomDistillerViewerSource, EmbedderDomDistillerSelfDeletingRequestDelegate, EmbedderOomInterventionTabHelper, EmbedderOfflinePage, EmbedderChromePasswordManagerClientBindCredentialManager, EmbedderPermissionRequestManager, EmbedderModalDialog, EmbedderExtensions, EmbedderExtensionMessaging, EmbedderExtensionMessagingForOpenPort, EmbedderExtensionSentMessageToCachedFrame
-	Context string `json:"context,omitempty"` // Context associated with the reason. The meaning of this context is dependent on the reason: - EmbedderExtensionSentMessageToCachedFrame: the extension ID.
+	Type    PageBackForwardCacheNotRestoredReasonType `json:"type"`              // Type of the reason enum values: SupportPending, PageSupportNeeded, Circumstantial
+	Reason  PageBackForwardCacheNotRestoredReason     `json:"reason"`            // Not restored reason enum values: NotPrimaryMainFrame, BackForwardCacheDisabled, RelatedActiveContentsExist, HTTPStatusNotOK, SchemeNotHTTPOrHTTPS, Loading, WasGrantedMediaAccess, DisableForRenderFrameHostCalled, DomainNotAllowed, HTTPMethodNotGET, SubframeIsNavigating, Timeout, CacheLimit, JavaScriptExecution, RendererProcessKilled, RendererProcessCrashed, SchedulerTrackedFeatureUsed, ConflictingBrowsingInstance, CacheFlushed, ServiceWorkerVersionActivation, SessionRestored, ServiceWorkerPostMessage, EnteredBackForwardCacheBeforeServiceWorkerHostAdded, RenderFrameHostReused_SameSite, RenderFrameHostReused_CrossSite, ServiceWorkerClaim, IgnoreEventAndEvict, HaveInnerContents, TimeoutPuttingInCache, BackForwardCacheDisabledByLowMemory, BackForwardCacheDisabledByCommandLine, NetworkRequestDatapipeDrainedAsBytesConsumer, NetworkRequestRedirected, NetworkRequestTimeout, NetworkExceedsBufferLimit, NavigationCancelledWhileRestoring, NotMostRecentNavigationEntry, BackForwardCacheDisabledForPrerender, UserAgentOverrideDiffers, ForegroundCacheLimit, BrowsingInstanceNotSwapped, BackForwardCacheDisabledForDelegate, UnloadHandlerExistsInMainFrame, UnloadHandlerExistsInSubFrame, ServiceWorkerUnregistration, CacheControlNoStore, CacheControlNoStoreCookieModified, CacheControlNoStoreHTTPOnlyCookieModified, NoResponseHead, Unknown, ActivationNavigationsDisallowedForBug1234857, ErrorDocument, FencedFramesEmbedder, CookieDisabled, HTTPAuthRequired, CookieFlushed, BroadcastChannelOnMessage, WebViewSettingsChanged, WebViewJavaScriptObjectChanged, WebViewMessageListenerInjected, WebViewSafeBrowsingAllowlistChanged, WebViewDocumentStartJavascriptChanged, WebSocket, WebTransport, WebRTC, MainResourceHasCacheControlNoStore, MainResourceHasCacheControlNoCache, SubresourceHasCacheControlNoStore, SubresourceHasCacheControlNoCache, ContainsPlugins, DocumentLoaded, OutstandingNetworkRequestOthers, RequestedMIDIPermission, RequestedAudioCapturePermission, RequestedVideoCapturePermission, RequestedBackForwardCacheBlockedSensors, RequestedBackgroundWorkPermission, BroadcastChannel, WebXR, SharedWorker, SharedWorkerMessage, WebLocks, WebHID, WebShare, RequestedStorageAccessGrant, WebNfc, OutstandingNetworkRequestFetch, OutstandingNetworkRequestXHR, AppBanner, Printing, WebDatabase, PictureInPicture, SpeechRecognizer, IdleManager, PaymentManager, SpeechSynthesis, KeyboardLock, WebOTPService, OutstandingNetworkRequestDirectSocket, InjectedJavascript, InjectedStyleSheet, KeepaliveRequest, IndexedDBEvent, Dummy, JsNetworkRequestReceivedCacheControlNoStoreResource, WebRTCSticky, WebTransportSticky, WebSocketSticky, SmartCard, LiveMediaStreamTrack, UnloadHandler, ParserAborted, ContentSecurityHandler, ContentWebAuthenticationAPI, ContentFileChooser, ContentSerial, ContentFileSystemAccess, ContentMediaDevicesDispatcherHost, ContentWebBluetooth, ContentWebUSB, ContentMediaSessionService, ContentScreenReader, ContentDiscarded, EmbedderPopupBlockerTabHelper, EmbedderSafeBrowsingTriggeredPopupBlocker, EmbedderSafeBrowsingThreatDetails, EmbedderAppBannerManager, EmbedderDomDistillerViewerSource, EmbedderDomDistillerSelfDeletingRequestDelegate, EmbedderOomInterventionTabHelper, EmbedderOfflinePage, EmbedderChromePasswordManagerClientBindCredentialManager, EmbedderPermissionRequestManager, EmbedderModalDialog, EmbedderExtensions, EmbedderExtensionMessaging, EmbedderExtensionMessagingForOpenPort, EmbedderExtensionSentMessageToCachedFrame, RequestedByWebViewClient, PostMessageByWebViewClient, CacheControlNoStoreDeviceBoundSessionTerminated, CacheLimitPrunedOnModerateMemoryPressure, CacheLimitPrunedOnCriticalMemoryPressure
+	Context string                                    `json:"context,omitempty"` // Context associated with the reason. The meaning of this context is dependent on the reason: - EmbedderExtensionSentMessageToCachedFrame: the extension ID.
+	Details []*PageBackForwardCacheBlockingDetails    `json:"details,omitempty"` //
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageBackForwardCacheNotRestoredExplanationTree struct {
 	Url          string                                            `json:"url"`          // URL of each frame
 	Explanations []*PageBackForwardCacheNotRestoredExplanation     `json:"explanations"` // Not restored reasons of each frame
 	Children     []*PageBackForwardCacheNotRestoredExplanationTree `json:"children"`     // Array of children frame
 }
 
+// Event method names for the Page domain
+const (
+	EventPageDomContentEventFired            = "Page.domContentEventFired"
+	EventPageFileChooserOpened               = "Page.fileChooserOpened"
+	EventPageFrameAttached                   = "Page.frameAttached"
+	EventPageFrameClearedScheduledNavigation = "Page.frameClearedScheduledNavigation"
+	EventPageFrameDetached                   = "Page.frameDetached"
+	EventPageFrameSubtreeWillBeDetached      = "Page.frameSubtreeWillBeDetached"
+	EventPageFrameNavigated                  = "Page.frameNavigated"
+	EventPageDocumentOpened                  = "Page.documentOpened"
+	EventPageFrameResized                    = "Page.frameResized"
+	EventPageFrameStartedNavigating          = "Page.frameStartedNavigating"
+	EventPageFrameRequestedNavigation        = "Page.frameRequestedNavigation"
+	EventPageFrameScheduledNavigation        = "Page.frameScheduledNavigation"
+	EventPageFrameStartedLoading             = "Page.frameStartedLoading"
+	EventPageFrameStoppedLoading             = "Page.frameStoppedLoading"
+	EventPageDownloadWillBegin               = "Page.downloadWillBegin"
+	EventPageDownloadProgress                = "Page.downloadProgress"
+	EventPageInterstitialHidden              = "Page.interstitialHidden"
+	EventPageInterstitialShown               = "Page.interstitialShown"
+	EventPageJavascriptDialogClosed          = "Page.javascriptDialogClosed"
+	EventPageJavascriptDialogOpening         = "Page.javascriptDialogOpening"
+	EventPageLifecycleEvent                  = "Page.lifecycleEvent"
+	EventPageBackForwardCacheNotUsed         = "Page.backForwardCacheNotUsed"
+	EventPageLoadEventFired                  = "Page.loadEventFired"
+	EventPageNavigatedWithinDocument         = "Page.navigatedWithinDocument"
+	EventPageScreencastFrame                 = "Page.screencastFrame"
+	EventPageScreencastVisibilityChanged     = "Page.screencastVisibilityChanged"
+	EventPageWindowOpen                      = "Page.windowOpen"
+	EventPageCompilationCacheProduced        = "Page.compilationCacheProduced"
+)
+
+// PageEvents lists all event method names for the Page domain
+var PageEvents = []string{
+	EventPageDomContentEventFired,
+	EventPageFileChooserOpened,
+	EventPageFrameAttached,
+	EventPageFrameClearedScheduledNavigation,
+	EventPageFrameDetached,
+	EventPageFrameSubtreeWillBeDetached,
+	EventPageFrameNavigated,
+	EventPageDocumentOpened,
+	EventPageFrameResized,
+	EventPageFrameStartedNavigating,
+	EventPageFrameRequestedNavigation,
+	EventPageFrameScheduledNavigation,
+	EventPageFrameStartedLoading,
+	EventPageFrameStoppedLoading,
+	EventPageDownloadWillBegin,
+	EventPageDownloadProgress,
+	EventPageInterstitialHidden,
+	EventPageInterstitialShown,
+	EventPageJavascriptDialogClosed,
+	EventPageJavascriptDialogOpening,
+	EventPageLifecycleEvent,
+	EventPageBackForwardCacheNotUsed,
+	EventPageLoadEventFired,
+	EventPageNavigatedWithinDocument,
+	EventPageScreencastFrame,
+	EventPageScreencastVisibilityChanged,
+	EventPageWindowOpen,
+	EventPageCompilationCacheProduced,
+}
+
 type PageDomContentEventFiredEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -228,9 +1705,9 @@ type PageDomContentEventFiredEvent struct {
 type PageFileChooserOpenedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		FrameId       string `json:"frameId"`                 // Id of the frame containing input node.
-		Mode          string `json:"mode"`                    // Input mode.
-		BackendNodeId int    `json:"backendNodeId,omitempty"` // Input node id. Only present for file choosers opened via an <input type="file"> element.
+		FrameId       string                    `json:"frameId"`                 // Id of the frame containing input node.
+		Mode          PageFileChooserOpenedMode `json:"mode"`                    // Input mode.
+		BackendNodeId int                       `json:"backendNodeId,omitempty"` // Input node id. Only present for file choosers opened via an `<input type="file">` element.
 	} `json:"Params,omitempty"`
 }
 
@@ -245,6 +1722,8 @@ type PageFrameAttachedEvent struct {
 }
 
 // Fired when frame no longer has a scheduled navigation.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type PageFrameClearedScheduledNavigationEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -256,8 +1735,18 @@ type PageFrameClearedScheduledNavigationEvent struct {
 type PageFrameDetachedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		FrameId string `json:"frameId"` // Id of the frame that has been detached.
-		Reason  string `json:"reason"`  //
+		FrameId string                  `json:"frameId"` // Id of the frame that has been detached.
+		Reason  PageFrameDetachedReason `json:"reason"`  //
+	} `json:"Params,omitempty"`
+}
+
+// Fired before frame subtree is detached. Emitted before any frame of the subtree is actually detached.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageFrameSubtreeWillBeDetachedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		FrameId string `json:"frameId"` // Id of the frame that is the root of the subtree that will be detached.
 	} `json:"Params,omitempty"`
 }
 
@@ -265,12 +1754,14 @@ type PageFrameDetachedEvent struct {
 type PageFrameNavigatedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		Frame *PageFrame `json:"frame"` // Frame object.
-		Type  string     `json:"type"`  //  enum values: Navigation, BackForwardCacheRestore
+		Frame *PageFrame         `json:"frame"` // Frame object.
+		Type  PageNavigationType `json:"type"`  //  enum values: Navigation, BackForwardCacheRestore
 	} `json:"Params,omitempty"`
 }
 
 // Fired when opening document to write to.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageDocumentOpenedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -278,29 +1769,53 @@ type PageDocumentOpenedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Experimental: this may change or be removed in a future Chrome release.
+type PageFrameResizedEvent struct {
+	Method string `json:"method"`
+}
+
+// Fired when a navigation starts. This event is fired for both renderer-initiated and browser-initiated navigations. For renderer-initiated navigations, the event is fired after `frameRequestedNavigation`. Navigation may still be cancelled after the event is issued. Multiple events can be fired for a single navigation, for example, when a same-document navigation becomes a cross-document navigation (such as in the case of a frameset).
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PageFrameStartedNavigatingEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		FrameId        string                                   `json:"frameId"`        // ID of the frame that is being navigated.
+		Url            string                                   `json:"url"`            // The URL the navigation started with. The final URL can be different.
+		LoaderId       string                                   `json:"loaderId"`       // Loader identifier. Even though it is present in case of same-document navigation, the previously committed loaderId would not change unless the navigation changes from a same-document to a cross-document navigation.
+		NavigationType PageFrameStartedNavigatingNavigationType `json:"navigationType"` //
+	} `json:"Params,omitempty"`
+}
+
 // Fired when a renderer-initiated navigation is requested. Navigation may still be cancelled after the event is issued.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageFrameRequestedNavigationEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		FrameId     string `json:"frameId"`     // Id of the frame that is being navigated.
-		Reason      string `json:"reason"`      // The reason for the navigation. enum values: formSubmissionGet, formSubmissionPost, httpHeaderRefresh, scriptInitiated, metaTagRefresh, pageBlockInterstitial, reload, anchorClick
-		Url         string `json:"url"`         // The destination URL for the requested navigation.
-		Disposition string `json:"disposition"` // The disposition for the navigation. enum values: currentTab, newTab, newWindow, download
+		FrameId     string                          `json:"frameId"`     // Id of the frame that is being navigated.
+		Reason      PageClientNavigationReason      `json:"reason"`      // The reason for the navigation. enum values: anchorClick, formSubmissionGet, formSubmissionPost, httpHeaderRefresh, initialFrameNavigation, metaTagRefresh, other, pageBlockInterstitial, reload, scriptInitiated
+		Url         string                          `json:"url"`         // The destination URL for the requested navigation.
+		Disposition PageClientNavigationDisposition `json:"disposition"` // The disposition for the navigation. enum values: currentTab, newTab, newWindow, download
 	} `json:"Params,omitempty"`
 }
 
 // Fired when frame schedules a potential navigation.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type PageFrameScheduledNavigationEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		FrameId string  `json:"frameId"` // Id of the frame that has scheduled a navigation.
-		Delay   float64 `json:"delay"`   // Delay (in seconds) until the navigation is scheduled to begin. The navigation is not guaranteed to start.
-		Reason  string  `json:"reason"`  // The reason for the navigation. enum values: formSubmissionGet, formSubmissionPost, httpHeaderRefresh, scriptInitiated, metaTagRefresh, pageBlockInterstitial, reload, anchorClick
-		Url     string  `json:"url"`     // The destination URL for the scheduled navigation.
+		FrameId string                     `json:"frameId"` // Id of the frame that has scheduled a navigation.
+		Delay   float64                    `json:"delay"`   // Delay (in seconds) until the navigation is scheduled to begin. The navigation is not guaranteed to start.
+		Reason  PageClientNavigationReason `json:"reason"`  // The reason for the navigation. enum values: anchorClick, formSubmissionGet, formSubmissionPost, httpHeaderRefresh, initialFrameNavigation, metaTagRefresh, other, pageBlockInterstitial, reload, scriptInitiated
+		Url     string                     `json:"url"`     // The destination URL for the scheduled navigation.
 	} `json:"Params,omitempty"`
 }
 
 // Fired when frame has started loading.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageFrameStartedLoadingEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -309,6 +1824,8 @@ type PageFrameStartedLoadingEvent struct {
 }
 
 // Fired when frame has stopped loading.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageFrameStoppedLoadingEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -317,6 +1834,10 @@ type PageFrameStoppedLoadingEvent struct {
 }
 
 // Fired when page is about to start a download. Deprecated. Use Browser.downloadWillBegin instead.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageDownloadWillBeginEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -328,20 +1849,35 @@ type PageDownloadWillBeginEvent struct {
 }
 
 // Fired when download makes progress. Last call has |done| == true. Deprecated. Use Browser.downloadProgress instead.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageDownloadProgressEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		Guid          string  `json:"guid"`          // Global unique identifier of the download.
-		TotalBytes    float64 `json:"totalBytes"`    // Total expected bytes to download.
-		ReceivedBytes float64 `json:"receivedBytes"` // Total bytes received.
-		State         string  `json:"state"`         // Download status.
+		Guid          string                    `json:"guid"`          // Global unique identifier of the download.
+		TotalBytes    float64                   `json:"totalBytes"`    // Total expected bytes to download.
+		ReceivedBytes float64                   `json:"receivedBytes"` // Total bytes received.
+		State         PageDownloadProgressState `json:"state"`         // Download status.
 	} `json:"Params,omitempty"`
 }
 
+// Fired when interstitial page was hidden
+type PageInterstitialHiddenEvent struct {
+	Method string `json:"method"`
+}
+
+// Fired when interstitial page was shown
+type PageInterstitialShownEvent struct {
+	Method string `json:"method"`
+}
+
 // Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been closed.
 type PageJavascriptDialogClosedEvent struct {
 	Method string `json:"method"`
 	Params struct {
+		FrameId   string `json:"frameId"`   // Frame id.
 		Result    bool   `json:"result"`    // Whether dialog was confirmed.
 		UserInput string `json:"userInput"` // User input in case of prompt.
 	} `json:"Params,omitempty"`
@@ -351,15 +1887,16 @@ type PageJavascriptDialogClosedEvent struct {
 type PageJavascriptDialogOpeningEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		Url               string `json:"url"`                     // Frame url.
-		Message           string `json:"message"`                 // Message that will be displayed by the dialog.
-		Type              string `json:"type"`                    // Dialog type. enum values: alert, confirm, prompt, beforeunload
-		HasBrowserHandler bool   `json:"hasBrowserHandler"`       // True iff browser is capable showing or acting on the given dialog. When browser has no dialog handler for given target, calling alert while Page domain is engaged will stall the page execution. Execution can be resumed via calling Page.handleJavaScriptDialog.
-		DefaultPrompt     string `json:"defaultPrompt,omitempty"` // Default dialog prompt.
+		Url               string         `json:"url"`                     // Frame url.
+		FrameId           string         `json:"frameId"`                 // Frame id.
+		Message           string         `json:"message"`                 // Message that will be displayed by the dialog.
+		Type              PageDialogType `json:"type"`                    // Dialog type. enum values: alert, confirm, prompt, beforeunload
+		HasBrowserHandler bool           `json:"hasBrowserHandler"`       // True iff browser is capable showing or acting on the given dialog. When browser has no dialog handler for given target, calling alert while Page domain is engaged will stall the page execution. Execution can be resumed via calling Page.handleJavaScriptDialog.
+		DefaultPrompt     string         `json:"defaultPrompt,omitempty"` // Default dialog prompt.
 	} `json:"Params,omitempty"`
 }
 
-// Fired for top level page lifecycle events such as navigation, load, paint, etc.
+// Fired for lifecycle events (navigation, load, paint, etc) in the current target (including local frames).
 type PageLifecycleEventEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -371,10 +1908,12 @@ type PageLifecycleEventEvent struct {
 }
 
 // Fired for failed bfcache history navigations if BackForwardCache feature is enabled. Do not assume any ordering with the Page.frameNavigated event. This event is fired only for main-frame history navigation where the document changes (non-same-document navigations), when bfcache navigation fails.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageBackForwardCacheNotUsedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		LoaderId                    string                                          `json:"loaderId"`                              // The loader id for the associated navgation.
+		LoaderId                    string                                          `json:"loaderId"`                              // The loader id for the associated navigation.
 		FrameId                     string                                          `json:"frameId"`                               // The frame id of the associated frame.
 		NotRestoredExplanations     []*PageBackForwardCacheNotRestoredExplanation   `json:"notRestoredExplanations"`               // Array of reasons why the page could not be cached. This must not be empty.
 		NotRestoredExplanationsTree *PageBackForwardCacheNotRestoredExplanationTree `json:"notRestoredExplanationsTree,omitempty"` // Tree structure of reasons why the page could not be cached for each frame.
@@ -389,15 +1928,20 @@ type PageLoadEventFiredEvent struct {
 }
 
 // Fired when same-document navigation happens, e.g. due to history API usage or anchor navigation.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageNavigatedWithinDocumentEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		FrameId string `json:"frameId"` // Id of the frame.
-		Url     string `json:"url"`     // Frame's new url.
+		FrameId        string                                    `json:"frameId"`        // Id of the frame.
+		Url            string                                    `json:"url"`            // Frame's new url.
+		NavigationType PageNavigatedWithinDocumentNavigationType `json:"navigationType"` // Navigation type
 	} `json:"Params,omitempty"`
 }
 
 // Compressed image data requested by the `startScreencast`.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageScreencastFrameEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -408,6 +1952,8 @@ type PageScreencastFrameEvent struct {
 }
 
 // Fired when the page with currently enabled screencast was shown or hidden `.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageScreencastVisibilityChangedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -426,7 +1972,9 @@ type PageWindowOpenEvent struct {
 	} `json:"Params,omitempty"`
 }
 
-// Issued for every compilation cache generated. Is only available if Page.setGenerateCompilationCache is enabled.
+// Issued for every compilation cache generated.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PageCompilationCacheProducedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -444,6 +1992,407 @@ func NewPage(target gcdmessage.ChromeTargeter) *Page {
 	return c
 }
 
+// OnDomContentEventFired subscribes to Page.domContentEventFired events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnDomContentEventFired(callback func(*PageDomContentEventFiredEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageDomContentEventFired, func(payload []byte) error {
+		event := &PageDomContentEventFiredEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFileChooserOpened subscribes to Page.fileChooserOpened events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnFileChooserOpened(callback func(*PageFileChooserOpenedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFileChooserOpened, func(payload []byte) error {
+		event := &PageFileChooserOpenedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameAttached subscribes to Page.frameAttached events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnFrameAttached(callback func(*PageFrameAttachedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameAttached, func(payload []byte) error {
+		event := &PageFrameAttachedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameClearedScheduledNavigation subscribes to Page.frameClearedScheduledNavigation events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Page) OnFrameClearedScheduledNavigation(callback func(*PageFrameClearedScheduledNavigationEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameClearedScheduledNavigation, func(payload []byte) error {
+		event := &PageFrameClearedScheduledNavigationEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameDetached subscribes to Page.frameDetached events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnFrameDetached(callback func(*PageFrameDetachedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameDetached, func(payload []byte) error {
+		event := &PageFrameDetachedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameSubtreeWillBeDetached subscribes to Page.frameSubtreeWillBeDetached events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnFrameSubtreeWillBeDetached(callback func(*PageFrameSubtreeWillBeDetachedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameSubtreeWillBeDetached, func(payload []byte) error {
+		event := &PageFrameSubtreeWillBeDetachedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameNavigated subscribes to Page.frameNavigated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnFrameNavigated(callback func(*PageFrameNavigatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameNavigated, func(payload []byte) error {
+		event := &PageFrameNavigatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDocumentOpened subscribes to Page.documentOpened events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnDocumentOpened(callback func(*PageDocumentOpenedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageDocumentOpened, func(payload []byte) error {
+		event := &PageDocumentOpenedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameResized subscribes to Page.frameResized events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnFrameResized(callback func(*PageFrameResizedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameResized, func(payload []byte) error {
+		event := &PageFrameResizedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameStartedNavigating subscribes to Page.frameStartedNavigating events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnFrameStartedNavigating(callback func(*PageFrameStartedNavigatingEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameStartedNavigating, func(payload []byte) error {
+		event := &PageFrameStartedNavigatingEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameRequestedNavigation subscribes to Page.frameRequestedNavigation events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnFrameRequestedNavigation(callback func(*PageFrameRequestedNavigationEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameRequestedNavigation, func(payload []byte) error {
+		event := &PageFrameRequestedNavigationEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameScheduledNavigation subscribes to Page.frameScheduledNavigation events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Page) OnFrameScheduledNavigation(callback func(*PageFrameScheduledNavigationEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameScheduledNavigation, func(payload []byte) error {
+		event := &PageFrameScheduledNavigationEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameStartedLoading subscribes to Page.frameStartedLoading events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnFrameStartedLoading(callback func(*PageFrameStartedLoadingEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameStartedLoading, func(payload []byte) error {
+		event := &PageFrameStartedLoadingEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnFrameStoppedLoading subscribes to Page.frameStoppedLoading events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnFrameStoppedLoading(callback func(*PageFrameStoppedLoadingEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageFrameStoppedLoading, func(payload []byte) error {
+		event := &PageFrameStoppedLoadingEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDownloadWillBegin subscribes to Page.downloadWillBegin events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnDownloadWillBegin(callback func(*PageDownloadWillBeginEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageDownloadWillBegin, func(payload []byte) error {
+		event := &PageDownloadWillBeginEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDownloadProgress subscribes to Page.downloadProgress events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnDownloadProgress(callback func(*PageDownloadProgressEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageDownloadProgress, func(payload []byte) error {
+		event := &PageDownloadProgressEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnInterstitialHidden subscribes to Page.interstitialHidden events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnInterstitialHidden(callback func(*PageInterstitialHiddenEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageInterstitialHidden, func(payload []byte) error {
+		event := &PageInterstitialHiddenEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnInterstitialShown subscribes to Page.interstitialShown events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnInterstitialShown(callback func(*PageInterstitialShownEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageInterstitialShown, func(payload []byte) error {
+		event := &PageInterstitialShownEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnJavascriptDialogClosed subscribes to Page.javascriptDialogClosed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnJavascriptDialogClosed(callback func(*PageJavascriptDialogClosedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageJavascriptDialogClosed, func(payload []byte) error {
+		event := &PageJavascriptDialogClosedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnJavascriptDialogOpening subscribes to Page.javascriptDialogOpening events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnJavascriptDialogOpening(callback func(*PageJavascriptDialogOpeningEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageJavascriptDialogOpening, func(payload []byte) error {
+		event := &PageJavascriptDialogOpeningEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnLifecycleEvent subscribes to Page.lifecycleEvent events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnLifecycleEvent(callback func(*PageLifecycleEventEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageLifecycleEvent, func(payload []byte) error {
+		event := &PageLifecycleEventEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnBackForwardCacheNotUsed subscribes to Page.backForwardCacheNotUsed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnBackForwardCacheNotUsed(callback func(*PageBackForwardCacheNotUsedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageBackForwardCacheNotUsed, func(payload []byte) error {
+		event := &PageBackForwardCacheNotUsedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnLoadEventFired subscribes to Page.loadEventFired events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnLoadEventFired(callback func(*PageLoadEventFiredEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageLoadEventFired, func(payload []byte) error {
+		event := &PageLoadEventFiredEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnNavigatedWithinDocument subscribes to Page.navigatedWithinDocument events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnNavigatedWithinDocument(callback func(*PageNavigatedWithinDocumentEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageNavigatedWithinDocument, func(payload []byte) error {
+		event := &PageNavigatedWithinDocumentEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnScreencastFrame subscribes to Page.screencastFrame events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnScreencastFrame(callback func(*PageScreencastFrameEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageScreencastFrame, func(payload []byte) error {
+		event := &PageScreencastFrameEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnScreencastVisibilityChanged subscribes to Page.screencastVisibilityChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnScreencastVisibilityChanged(callback func(*PageScreencastVisibilityChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageScreencastVisibilityChanged, func(payload []byte) error {
+		event := &PageScreencastVisibilityChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWindowOpen subscribes to Page.windowOpen events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Page) OnWindowOpen(callback func(*PageWindowOpenEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageWindowOpen, func(payload []byte) error {
+		event := &PageWindowOpenEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnCompilationCacheProduced subscribes to Page.compilationCacheProduced events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) OnCompilationCacheProduced(callback func(*PageCompilationCacheProducedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPageCompilationCacheProduced, func(payload []byte) error {
+		event := &PageCompilationCacheProducedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// Deprecated: this command is deprecated in the protocol.
 type PageAddScriptToEvaluateOnLoadParams struct {
 	//
 	ScriptSource string `json:"scriptSource"`
@@ -451,6 +2400,10 @@ type PageAddScriptToEvaluateOnLoadParams struct {
 
 // AddScriptToEvaluateOnLoadWithParams - Deprecated, please use addScriptToEvaluateOnNewDocument instead.
 // Returns -  identifier - Identifier of the added script.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) AddScriptToEvaluateOnLoadWithParams(ctx context.Context, v *PageAddScriptToEvaluateOnLoadParams) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.addScriptToEvaluateOnLoad", Params: v})
 	if err != nil {
@@ -482,6 +2435,10 @@ func (c *Page) AddScriptToEvaluateOnLoadWithParams(ctx context.Context, v *PageA
 // AddScriptToEvaluateOnLoad - Deprecated, please use addScriptToEvaluateOnNewDocument instead.
 // scriptSource -
 // Returns -  identifier - Identifier of the added script.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) AddScriptToEvaluateOnLoad(ctx context.Context, scriptSource string) (string, error) {
 	var v PageAddScriptToEvaluateOnLoadParams
 	v.ScriptSource = scriptSource
@@ -492,9 +2449,17 @@ type PageAddScriptToEvaluateOnNewDocumentParams struct {
 	//
 	Source string `json:"source"`
 	// If specified, creates an isolated world with the given name and evaluates given script in it. This world name will be used as the ExecutionContextDescription::name when the corresponding event is emitted.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	WorldName string `json:"worldName,omitempty"`
 	// Specifies whether command line API should be available to the script, defaults to false.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// If true, runs the script immediately on existing execution contexts or worlds. Default: false.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	RunImmediately bool `json:"runImmediately,omitempty"`
 }
 
 // AddScriptToEvaluateOnNewDocumentWithParams - Evaluates given script in every frame upon creation (before loading frame's scripts).
@@ -531,12 +2496,14 @@ func (c *Page) AddScriptToEvaluateOnNewDocumentWithParams(ctx context.Context, v
 // source -
 // worldName - If specified, creates an isolated world with the given name and evaluates given script in it. This world name will be used as the ExecutionContextDescription::name when the corresponding event is emitted.
 // includeCommandLineAPI - Specifies whether command line API should be available to the script, defaults to false.
+// runImmediately - If true, runs the script immediately on existing execution contexts or worlds. Default: false.
 // Returns -  identifier - Identifier of the added script.
-func (c *Page) AddScriptToEvaluateOnNewDocument(ctx context.Context, source string, worldName string, includeCommandLineAPI bool) (string, error) {
+func (c *Page) AddScriptToEvaluateOnNewDocument(ctx context.Context, source string, worldName string, includeCommandLineAPI bool, runImmediately bool) (string, error) {
 	var v PageAddScriptToEvaluateOnNewDocumentParams
 	v.Source = source
 	v.WorldName = worldName
 	v.IncludeCommandLineAPI = includeCommandLineAPI
+	v.RunImmediately = runImmediately
 	return c.AddScriptToEvaluateOnNewDocumentWithParams(ctx, &v)
 }
 
@@ -547,16 +2514,22 @@ func (c *Page) BringToFront(ctx context.Context) (*gcdmessage.ChromeResponse, er
 
 type PageCaptureScreenshotParams struct {
 	// Image compression format (defaults to png).
-	Format string `json:"format,omitempty"`
+	Format PageCaptureScreenshotFormat `json:"format,omitempty"`
 	// Compression quality from range [0..100] (jpeg only).
 	Quality int `json:"quality,omitempty"`
 	// Capture the screenshot of a given region only.
 	Clip *PageViewport `json:"clip,omitempty"`
 	// Capture the screenshot from the surface, rather than the view. Defaults to true.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	FromSurface bool `json:"fromSurface,omitempty"`
 	// Capture the screenshot beyond the viewport. Defaults to false.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	CaptureBeyondViewport bool `json:"captureBeyondViewport,omitempty"`
 	// Optimize image encoding for speed, not for resulting size (defaults to false)
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	OptimizeForSpeed bool `json:"optimizeForSpeed,omitempty"`
 }
 
@@ -598,7 +2571,7 @@ func (c *Page) CaptureScreenshotWithParams(ctx context.Context, v *PageCaptureSc
 // captureBeyondViewport - Capture the screenshot beyond the viewport. Defaults to false.
 // optimizeForSpeed - Optimize image encoding for speed, not for resulting size (defaults to false)
 // Returns -  data - Base64-encoded image data. (Encoded as a base64 string when passed over JSON)
-func (c *Page) CaptureScreenshot(ctx context.Context, format string, quality int, clip *PageViewport, fromSurface bool, captureBeyondViewport bool, optimizeForSpeed bool) (string, error) {
+func (c *Page) CaptureScreenshot(ctx context.Context, format PageCaptureScreenshotFormat, quality int, clip *PageViewport, fromSurface bool, captureBeyondViewport bool, optimizeForSpeed bool) (string, error) {
 	var v PageCaptureScreenshotParams
 	v.Format = format
 	v.Quality = quality
@@ -611,11 +2584,13 @@ func (c *Page) CaptureScreenshot(ctx context.Context, format string, quality int
 
 type PageCaptureSnapshotParams struct {
 	// Format (defaults to mhtml).
-	Format string `json:"format,omitempty"`
+	Format PageCaptureSnapshotFormat `json:"format,omitempty"`
 }
 
 // CaptureSnapshotWithParams - Returns a snapshot of the page as a string. For MHTML format, the serialization includes iframes, shadow DOM, external resources, and element-inline styles.
 // Returns -  data - Serialized page data.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) CaptureSnapshotWithParams(ctx context.Context, v *PageCaptureSnapshotParams) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.captureSnapshot", Params: v})
 	if err != nil {
@@ -647,23 +2622,35 @@ func (c *Page) CaptureSnapshotWithParams(ctx context.Context, v *PageCaptureSnap
 // CaptureSnapshot - Returns a snapshot of the page as a string. For MHTML format, the serialization includes iframes, shadow DOM, external resources, and element-inline styles.
 // format - Format (defaults to mhtml).
 // Returns -  data - Serialized page data.
-func (c *Page) CaptureSnapshot(ctx context.Context, format string) (string, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) CaptureSnapshot(ctx context.Context, format PageCaptureSnapshotFormat) (string, error) {
 	var v PageCaptureSnapshotParams
 	v.Format = format
 	return c.CaptureSnapshotWithParams(ctx, &v)
 }
 
 // Clears the overridden device metrics.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) ClearDeviceMetricsOverride(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.clearDeviceMetricsOverride"})
 }
 
 // Clears the overridden Device Orientation.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) ClearDeviceOrientationOverride(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.clearDeviceOrientationOverride"})
 }
 
 // Clears the overridden Geolocation Position and Error.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Page) ClearGeolocationOverride(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.clearGeolocationOverride"})
 }
@@ -720,6 +2707,7 @@ func (c *Page) CreateIsolatedWorld(ctx context.Context, frameId string, worldNam
 	return c.CreateIsolatedWorldWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type PageDeleteCookieParams struct {
 	// Name of the cookie to remove.
 	CookieName string `json:"cookieName"`
@@ -728,6 +2716,10 @@ type PageDeleteCookieParams struct {
 }
 
 // DeleteCookieWithParams - Deletes browser cookie with given name, domain and path.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) DeleteCookieWithParams(ctx context.Context, v *PageDeleteCookieParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.deleteCookie", Params: v})
 }
@@ -735,6 +2727,10 @@ func (c *Page) DeleteCookieWithParams(ctx context.Context, v *PageDeleteCookiePa
 // DeleteCookie - Deletes browser cookie with given name, domain and path.
 // cookieName - Name of the cookie to remove.
 // url - URL to match cooke domain and path.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) DeleteCookie(ctx context.Context, cookieName string, url string) (*gcdmessage.ChromeResponse, error) {
 	var v PageDeleteCookieParams
 	v.CookieName = cookieName
@@ -747,46 +2743,78 @@ func (c *Page) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error)
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.disable"})
 }
 
-// Enables page domain notifications.
-func (c *Page) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.enable"})
+type PageEnableParams struct {
+	// If true, the `Page.fileChooserOpened` event will be emitted regardless of the state set by `Page.setInterceptFileChooserDialog` command (default: false).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	EnableFileChooserOpenedEvent bool `json:"enableFileChooserOpenedEvent,omitempty"`
+}
+
+// EnableWithParams - Enables page domain notifications.
+func (c *Page) EnableWithParams(ctx context.Context, v *PageEnableParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.enable", Params: v})
+}
+
+// Enable - Enables page domain notifications.
+// enableFileChooserOpenedEvent - If true, the `Page.fileChooserOpened` event will be emitted regardless of the state set by `Page.setInterceptFileChooserDialog` command (default: false).
+func (c *Page) Enable(ctx context.Context, enableFileChooserOpenedEvent bool) (*gcdmessage.ChromeResponse, error) {
+	var v PageEnableParams
+	v.EnableFileChooserOpenedEvent = enableFileChooserOpenedEvent
+	return c.EnableWithParams(ctx, &v)
 }
 
-// GetAppManifest -
-// Returns -  url - Manifest location. errors -  data - Manifest content. parsed - Parsed manifest properties
-func (c *Page) GetAppManifest(ctx context.Context) (string, []*PageAppManifestError, string, *PageAppManifestParsedProperties, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getAppManifest"})
+type PageGetAppManifestParams struct {
+	//
+	ManifestId string `json:"manifestId,omitempty"`
+}
+
+// GetAppManifestWithParams - Gets the processed manifest for this current document.   This API always waits for the manifest to be loaded.   If manifestId is provided, and it does not match the manifest of the     current document, this API errors out.   If there is not a loaded page, this API errors out immediately.
+// Returns -  url - Manifest location. errors -  data - Manifest content. parsed - Parsed manifest properties. Deprecated, use manifest instead. manifest -
+func (c *Page) GetAppManifestWithParams(ctx context.Context, v *PageGetAppManifestParams) (string, []*PageAppManifestError, string, *PageAppManifestParsedProperties, *PageWebAppManifest, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getAppManifest", Params: v})
 	if err != nil {
-		return "", nil, "", nil, err
+		return "", nil, "", nil, nil, err
 	}
 
 	var chromeData struct {
 		gcdmessage.ChromeErrorResponse
 		Result struct {
-			Url    string
-			Errors []*PageAppManifestError
-			Data   string
-			Parsed *PageAppManifestParsedProperties
+			Url      string
+			Errors   []*PageAppManifestError
+			Data     string
+			Parsed   *PageAppManifestParsedProperties
+			Manifest *PageWebAppManifest
 		}
 	}
 
 	if resp == nil {
-		return "", nil, "", nil, &gcdmessage.ChromeEmptyResponseErr{}
+		return "", nil, "", nil, nil, &gcdmessage.ChromeEmptyResponseErr{}
 	}
 
 	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return "", nil, "", nil, err
+		return "", nil, "", nil, nil, err
 	}
 
 	if chromeData.Error != nil {
-		return "", nil, "", nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+		return "", nil, "", nil, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
 	}
 
-	return chromeData.Result.Url, chromeData.Result.Errors, chromeData.Result.Data, chromeData.Result.Parsed, nil
+	return chromeData.Result.Url, chromeData.Result.Errors, chromeData.Result.Data, chromeData.Result.Parsed, chromeData.Result.Manifest, nil
+}
+
+// GetAppManifest - Gets the processed manifest for this current document.   This API always waits for the manifest to be loaded.   If manifestId is provided, and it does not match the manifest of the     current document, this API errors out.   If there is not a loaded page, this API errors out immediately.
+// manifestId -
+// Returns -  url - Manifest location. errors -  data - Manifest content. parsed - Parsed manifest properties. Deprecated, use manifest instead. manifest -
+func (c *Page) GetAppManifest(ctx context.Context, manifestId string) (string, []*PageAppManifestError, string, *PageAppManifestParsedProperties, *PageWebAppManifest, error) {
+	var v PageGetAppManifestParams
+	v.ManifestId = manifestId
+	return c.GetAppManifestWithParams(ctx, &v)
 }
 
 // GetInstallabilityErrors -
 // Returns -  installabilityErrors -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetInstallabilityErrors(ctx context.Context) ([]*PageInstallabilityError, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getInstallabilityErrors"})
 	if err != nil {
@@ -817,6 +2845,10 @@ func (c *Page) GetInstallabilityErrors(ctx context.Context) ([]*PageInstallabili
 
 // GetManifestIcons - Deprecated because it's not guaranteed that the returned icon is in fact the one used for PWA installation.
 // Returns -  primaryIcon -
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetManifestIcons(ctx context.Context) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getManifestIcons"})
 	if err != nil {
@@ -847,6 +2879,8 @@ func (c *Page) GetManifestIcons(ctx context.Context) (string, error) {
 
 // GetAppId - Returns the unique (PWA) app id. Only returns values if the feature flag 'WebAppEnableManifestId' is enabled
 // Returns -  appId - App id, either from manifest's id attribute or computed from start_url recommendedId - Recommendation for manifest's id attribute to match current id computed from start_url
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetAppId(ctx context.Context) (string, string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getAppId"})
 	if err != nil {
@@ -876,15 +2910,17 @@ func (c *Page) GetAppId(ctx context.Context) (string, string, error) {
 	return chromeData.Result.AppId, chromeData.Result.RecommendedId, nil
 }
 
-type PageGetAdScriptIdParams struct {
+type PageGetAdScriptAncestryParams struct {
 	//
 	FrameId string `json:"frameId"`
 }
 
-// GetAdScriptIdWithParams -
-// Returns -  adScriptId - Identifies the bottom-most script which caused the frame to be labelled as an ad. Only sent if frame is labelled as an ad and id is available.
-func (c *Page) GetAdScriptIdWithParams(ctx context.Context, v *PageGetAdScriptIdParams) (*PageAdScriptId, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getAdScriptId", Params: v})
+// GetAdScriptAncestryWithParams -
+// Returns -  adScriptAncestry - The ancestry chain of ad script identifiers leading to this frame's creation, along with the root script's filterlist rule. The ancestry chain is ordered from the most immediate script (in the frame creation stack) to more distant ancestors (that created the immediately preceding script). Only sent if frame is labelled as an ad and ids are available.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) GetAdScriptAncestryWithParams(ctx context.Context, v *PageGetAdScriptAncestryParams) (*PageAdScriptAncestry, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getAdScriptAncestry", Params: v})
 	if err != nil {
 		return nil, err
 	}
@@ -892,7 +2928,7 @@ func (c *Page) GetAdScriptIdWithParams(ctx context.Context, v *PageGetAdScriptId
 	var chromeData struct {
 		gcdmessage.ChromeErrorResponse
 		Result struct {
-			AdScriptId *PageAdScriptId
+			AdScriptAncestry *PageAdScriptAncestry
 		}
 	}
 
@@ -908,46 +2944,18 @@ func (c *Page) GetAdScriptIdWithParams(ctx context.Context, v *PageGetAdScriptId
 		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
 	}
 
-	return chromeData.Result.AdScriptId, nil
+	return chromeData.Result.AdScriptAncestry, nil
 }
 
-// GetAdScriptId -
+// GetAdScriptAncestry -
 // frameId -
-// Returns -  adScriptId - Identifies the bottom-most script which caused the frame to be labelled as an ad. Only sent if frame is labelled as an ad and id is available.
-func (c *Page) GetAdScriptId(ctx context.Context, frameId string) (*PageAdScriptId, error) {
-	var v PageGetAdScriptIdParams
+// Returns -  adScriptAncestry - The ancestry chain of ad script identifiers leading to this frame's creation, along with the root script's filterlist rule. The ancestry chain is ordered from the most immediate script (in the frame creation stack) to more distant ancestors (that created the immediately preceding script). Only sent if frame is labelled as an ad and ids are available.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) GetAdScriptAncestry(ctx context.Context, frameId string) (*PageAdScriptAncestry, error) {
+	var v PageGetAdScriptAncestryParams
 	v.FrameId = frameId
-	return c.GetAdScriptIdWithParams(ctx, &v)
-}
-
-// GetCookies - Returns all browser cookies for the page and all of its subframes. Depending on the backend support, will return detailed cookie information in the `cookies` field.
-// Returns -  cookies - Array of cookie objects.
-func (c *Page) GetCookies(ctx context.Context) ([]*NetworkCookie, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getCookies"})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Cookies []*NetworkCookie
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Cookies, nil
+	return c.GetAdScriptAncestryWithParams(ctx, &v)
 }
 
 // GetFrameTree - Returns present frame tree structure.
@@ -1060,6 +3068,8 @@ type PageGetResourceContentParams struct {
 
 // GetResourceContentWithParams - Returns content of the given resource.
 // Returns -  content - Resource content. base64Encoded - True, if content was served as base64.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetResourceContentWithParams(ctx context.Context, v *PageGetResourceContentParams) (string, bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getResourceContent", Params: v})
 	if err != nil {
@@ -1093,6 +3103,8 @@ func (c *Page) GetResourceContentWithParams(ctx context.Context, v *PageGetResou
 // frameId - Frame id to get resource for.
 // url - URL of the resource to get content for.
 // Returns -  content - Resource content. base64Encoded - True, if content was served as base64.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetResourceContent(ctx context.Context, frameId string, url string) (string, bool, error) {
 	var v PageGetResourceContentParams
 	v.FrameId = frameId
@@ -1102,6 +3114,8 @@ func (c *Page) GetResourceContent(ctx context.Context, frameId string, url strin
 
 // GetResourceTree - Returns present frame / resource tree structure.
 // Returns -  frameTree - Present frame / resource tree structure.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetResourceTree(ctx context.Context) (*PageFrameResourceTree, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getResourceTree"})
 	if err != nil {
@@ -1158,43 +3172,46 @@ type PageNavigateParams struct {
 	// Referrer URL.
 	Referrer string `json:"referrer,omitempty"`
 	// Intended transition type. enum values: link, typed, address_bar, auto_bookmark, auto_subframe, manual_subframe, generated, auto_toplevel, form_submit, reload, keyword, keyword_generated, other
-	TransitionType string `json:"transitionType,omitempty"`
+	TransitionType PageTransitionType `json:"transitionType,omitempty"`
 	// Frame id to navigate, if not specified navigates the top frame.
 	FrameId string `json:"frameId,omitempty"`
 	// Referrer-policy used for the navigation. enum values: noReferrer, noReferrerWhenDowngrade, origin, originWhenCrossOrigin, sameOrigin, strictOrigin, strictOriginWhenCrossOrigin, unsafeUrl
-	ReferrerPolicy string `json:"referrerPolicy,omitempty"`
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	ReferrerPolicy PageReferrerPolicy `json:"referrerPolicy,omitempty"`
 }
 
 // NavigateWithParams - Navigates current page to the given URL.
-// Returns -  frameId - Frame id that has navigated (or failed to navigate) loaderId - Loader identifier. This is omitted in case of same-document navigation, as the previously committed loaderId would not change. errorText - User friendly error message, present if and only if navigation has failed.
-func (c *Page) NavigateWithParams(ctx context.Context, v *PageNavigateParams) (string, string, string, error) {
+// Returns -  frameId - Frame id that has navigated (or failed to navigate) loaderId - Loader identifier. This is omitted in case of same-document navigation, as the previously committed loaderId would not change. errorText - User friendly error message, present if and only if navigation has failed. isDownload - Whether the navigation resulted in a download.
+func (c *Page) NavigateWithParams(ctx context.Context, v *PageNavigateParams) (string, string, string, bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.navigate", Params: v})
 	if err != nil {
-		return "", "", "", err
+		return "", "", "", false, err
 	}
 
 	var chromeData struct {
 		gcdmessage.ChromeErrorResponse
 		Result struct {
-			FrameId   string
-			LoaderId  string
-			ErrorText string
+			FrameId    string
+			LoaderId   string
+			ErrorText  string
+			IsDownload bool
 		}
 	}
 
 	if resp == nil {
-		return "", "", "", &gcdmessage.ChromeEmptyResponseErr{}
+		return "", "", "", false, &gcdmessage.ChromeEmptyResponseErr{}
 	}
 
 	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return "", "", "", err
+		return "", "", "", false, err
 	}
 
 	if chromeData.Error != nil {
-		return "", "", "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+		return "", "", "", false, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
 	}
 
-	return chromeData.Result.FrameId, chromeData.Result.LoaderId, chromeData.Result.ErrorText, nil
+	return chromeData.Result.FrameId, chromeData.Result.LoaderId, chromeData.Result.ErrorText, chromeData.Result.IsDownload, nil
 }
 
 // Navigate - Navigates current page to the given URL.
@@ -1203,8 +3220,8 @@ func (c *Page) NavigateWithParams(ctx context.Context, v *PageNavigateParams) (s
 // transitionType - Intended transition type. enum values: link, typed, address_bar, auto_bookmark, auto_subframe, manual_subframe, generated, auto_toplevel, form_submit, reload, keyword, keyword_generated, other
 // frameId - Frame id to navigate, if not specified navigates the top frame.
 // referrerPolicy - Referrer-policy used for the navigation. enum values: noReferrer, noReferrerWhenDowngrade, origin, originWhenCrossOrigin, sameOrigin, strictOrigin, strictOriginWhenCrossOrigin, unsafeUrl
-// Returns -  frameId - Frame id that has navigated (or failed to navigate) loaderId - Loader identifier. This is omitted in case of same-document navigation, as the previously committed loaderId would not change. errorText - User friendly error message, present if and only if navigation has failed.
-func (c *Page) Navigate(ctx context.Context, url string, referrer string, transitionType string, frameId string, referrerPolicy string) (string, string, string, error) {
+// Returns -  frameId - Frame id that has navigated (or failed to navigate) loaderId - Loader identifier. This is omitted in case of same-document navigation, as the previously committed loaderId would not change. errorText - User friendly error message, present if and only if navigation has failed. isDownload - Whether the navigation resulted in a download.
+func (c *Page) Navigate(ctx context.Context, url string, referrer string, transitionType PageTransitionType, frameId string, referrerPolicy PageReferrerPolicy) (string, string, string, bool, error) {
 	var v PageNavigateParams
 	v.Url = url
 	v.Referrer = referrer
@@ -1262,7 +3279,17 @@ type PagePrintToPDFParams struct {
 	// Whether or not to prefer page size as defined by css. Defaults to false, in which case the content will be scaled to fit the paper size.
 	PreferCSSPageSize bool `json:"preferCSSPageSize,omitempty"`
 	// return as stream
-	TransferMode string `json:"transferMode,omitempty"`
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	TransferMode PagePrintToPDFTransferMode `json:"transferMode,omitempty"`
+	// Whether or not to generate tagged (accessible) PDF. Defaults to embedder choice.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	GenerateTaggedPDF bool `json:"generateTaggedPDF,omitempty"`
+	// Whether or not to embed the document outline into the PDF.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	GenerateDocumentOutline bool `json:"generateDocumentOutline,omitempty"`
 }
 
 // PrintToPDFWithParams - Print page as PDF.
@@ -1312,8 +3339,10 @@ func (c *Page) PrintToPDFWithParams(ctx context.Context, v *PagePrintToPDFParams
 // footerTemplate - HTML template for the print footer. Should use the same format as the `headerTemplate`.
 // preferCSSPageSize - Whether or not to prefer page size as defined by css. Defaults to false, in which case the content will be scaled to fit the paper size.
 // transferMode - return as stream
+// generateTaggedPDF - Whether or not to generate tagged (accessible) PDF. Defaults to embedder choice.
+// generateDocumentOutline - Whether or not to embed the document outline into the PDF.
 // Returns -  data - Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as a base64 string when passed over JSON) stream - A handle of the stream that holds resulting PDF data.
-func (c *Page) PrintToPDF(ctx context.Context, landscape bool, displayHeaderFooter bool, printBackground bool, scale float64, paperWidth float64, paperHeight float64, marginTop float64, marginBottom float64, marginLeft float64, marginRight float64, pageRanges string, headerTemplate string, footerTemplate string, preferCSSPageSize bool, transferMode string) (string, string, error) {
+func (c *Page) PrintToPDF(ctx context.Context, landscape bool, displayHeaderFooter bool, printBackground bool, scale float64, paperWidth float64, paperHeight float64, marginTop float64, marginBottom float64, marginLeft float64, marginRight float64, pageRanges string, headerTemplate string, footerTemplate string, preferCSSPageSize bool, transferMode PagePrintToPDFTransferMode, generateTaggedPDF bool, generateDocumentOutline bool) (string, string, error) {
 	var v PagePrintToPDFParams
 	v.Landscape = landscape
 	v.DisplayHeaderFooter = displayHeaderFooter
@@ -1330,6 +3359,8 @@ func (c *Page) PrintToPDF(ctx context.Context, landscape bool, displayHeaderFoot
 	v.FooterTemplate = footerTemplate
 	v.PreferCSSPageSize = preferCSSPageSize
 	v.TransferMode = transferMode
+	v.GenerateTaggedPDF = generateTaggedPDF
+	v.GenerateDocumentOutline = generateDocumentOutline
 	return c.PrintToPDFWithParams(ctx, &v)
 }
 
@@ -1338,6 +3369,10 @@ type PageReloadParams struct {
 	IgnoreCache bool `json:"ignoreCache,omitempty"`
 	// If set, the script will be injected into all frames of the inspected page after reload. Argument will be ignored if reloading dataURL origin.
 	ScriptToEvaluateOnLoad string `json:"scriptToEvaluateOnLoad,omitempty"`
+	// If set, an error will be thrown if the target page's main frame's loader id does not match the provided id. This prevents accidentally reloading an unintended target in case there's a racing navigation.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	LoaderId string `json:"loaderId,omitempty"`
 }
 
 // ReloadWithParams - Reloads given page optionally ignoring the cache.
@@ -1348,25 +3383,36 @@ func (c *Page) ReloadWithParams(ctx context.Context, v *PageReloadParams) (*gcdm
 // Reload - Reloads given page optionally ignoring the cache.
 // ignoreCache - If true, browser cache is ignored (as if the user pressed Shift+refresh).
 // scriptToEvaluateOnLoad - If set, the script will be injected into all frames of the inspected page after reload. Argument will be ignored if reloading dataURL origin.
-func (c *Page) Reload(ctx context.Context, ignoreCache bool, scriptToEvaluateOnLoad string) (*gcdmessage.ChromeResponse, error) {
+// loaderId - If set, an error will be thrown if the target page's main frame's loader id does not match the provided id. This prevents accidentally reloading an unintended target in case there's a racing navigation.
+func (c *Page) Reload(ctx context.Context, ignoreCache bool, scriptToEvaluateOnLoad string, loaderId string) (*gcdmessage.ChromeResponse, error) {
 	var v PageReloadParams
 	v.IgnoreCache = ignoreCache
 	v.ScriptToEvaluateOnLoad = scriptToEvaluateOnLoad
+	v.LoaderId = loaderId
 	return c.ReloadWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type PageRemoveScriptToEvaluateOnLoadParams struct {
 	//
 	Identifier string `json:"identifier"`
 }
 
 // RemoveScriptToEvaluateOnLoadWithParams - Deprecated, please use removeScriptToEvaluateOnNewDocument instead.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) RemoveScriptToEvaluateOnLoadWithParams(ctx context.Context, v *PageRemoveScriptToEvaluateOnLoadParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.removeScriptToEvaluateOnLoad", Params: v})
 }
 
 // RemoveScriptToEvaluateOnLoad - Deprecated, please use removeScriptToEvaluateOnNewDocument instead.
 // identifier -
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) RemoveScriptToEvaluateOnLoad(ctx context.Context, identifier string) (*gcdmessage.ChromeResponse, error) {
 	var v PageRemoveScriptToEvaluateOnLoadParams
 	v.Identifier = identifier
@@ -1397,12 +3443,16 @@ type PageScreencastFrameAckParams struct {
 }
 
 // ScreencastFrameAckWithParams - Acknowledges that a screencast frame has been received by the frontend.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) ScreencastFrameAckWithParams(ctx context.Context, v *PageScreencastFrameAckParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.screencastFrameAck", Params: v})
 }
 
 // ScreencastFrameAck - Acknowledges that a screencast frame has been received by the frontend.
 // sessionId - Frame number.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) ScreencastFrameAck(ctx context.Context, sessionId int) (*gcdmessage.ChromeResponse, error) {
 	var v PageScreencastFrameAckParams
 	v.SessionId = sessionId
@@ -1424,6 +3474,8 @@ type PageSearchInResourceParams struct {
 
 // SearchInResourceWithParams - Searches for given string in resource content.
 // Returns -  result - List of search matches.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SearchInResourceWithParams(ctx context.Context, v *PageSearchInResourceParams) ([]*DebuggerSearchMatch, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.searchInResource", Params: v})
 	if err != nil {
@@ -1459,6 +3511,8 @@ func (c *Page) SearchInResourceWithParams(ctx context.Context, v *PageSearchInRe
 // caseSensitive - If true, search is case sensitive.
 // isRegex - If true, treats string parameter as regex.
 // Returns -  result - List of search matches.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SearchInResource(ctx context.Context, frameId string, url string, query string, caseSensitive bool, isRegex bool) ([]*DebuggerSearchMatch, error) {
 	var v PageSearchInResourceParams
 	v.FrameId = frameId
@@ -1475,12 +3529,16 @@ type PageSetAdBlockingEnabledParams struct {
 }
 
 // SetAdBlockingEnabledWithParams - Enable Chrome's experimental ad filter on all sites.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetAdBlockingEnabledWithParams(ctx context.Context, v *PageSetAdBlockingEnabledParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setAdBlockingEnabled", Params: v})
 }
 
 // SetAdBlockingEnabled - Enable Chrome's experimental ad filter on all sites.
 // enabled - Whether to block ads.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetAdBlockingEnabled(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetAdBlockingEnabledParams
 	v.Enabled = enabled
@@ -1512,6 +3570,8 @@ type PageGetPermissionsPolicyStateParams struct {
 
 // GetPermissionsPolicyStateWithParams - Get Permissions Policy state on given frame.
 // Returns -  states -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetPermissionsPolicyStateWithParams(ctx context.Context, v *PageGetPermissionsPolicyStateParams) ([]*PagePermissionsPolicyFeatureState, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getPermissionsPolicyState", Params: v})
 	if err != nil {
@@ -1543,6 +3603,8 @@ func (c *Page) GetPermissionsPolicyStateWithParams(ctx context.Context, v *PageG
 // GetPermissionsPolicyState - Get Permissions Policy state on given frame.
 // frameId -
 // Returns -  states -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetPermissionsPolicyState(ctx context.Context, frameId string) ([]*PagePermissionsPolicyFeatureState, error) {
 	var v PageGetPermissionsPolicyStateParams
 	v.FrameId = frameId
@@ -1556,6 +3618,8 @@ type PageGetOriginTrialsParams struct {
 
 // GetOriginTrialsWithParams - Get Origin Trials on given frame.
 // Returns -  originTrials -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetOriginTrialsWithParams(ctx context.Context, v *PageGetOriginTrialsParams) ([]*PageOriginTrial, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.getOriginTrials", Params: v})
 	if err != nil {
@@ -1587,12 +3651,15 @@ func (c *Page) GetOriginTrialsWithParams(ctx context.Context, v *PageGetOriginTr
 // GetOriginTrials - Get Origin Trials on given frame.
 // frameId -
 // Returns -  originTrials -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GetOriginTrials(ctx context.Context, frameId string) ([]*PageOriginTrial, error) {
 	var v PageGetOriginTrialsParams
 	v.FrameId = frameId
 	return c.GetOriginTrialsWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type PageSetDeviceMetricsOverrideParams struct {
 	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
 	Width int `json:"width"`
@@ -1621,6 +3688,10 @@ type PageSetDeviceMetricsOverrideParams struct {
 }
 
 // SetDeviceMetricsOverrideWithParams - Overrides the values of device screen dimensions (window.screen.width, window.screen.height, window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media query results).
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetDeviceMetricsOverrideWithParams(ctx context.Context, v *PageSetDeviceMetricsOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setDeviceMetricsOverride", Params: v})
 }
@@ -1638,6 +3709,10 @@ func (c *Page) SetDeviceMetricsOverrideWithParams(ctx context.Context, v *PageSe
 // dontSetVisibleSize - Do not set visible view size, rely upon explicit setVisibleSize call.
 // screenOrientation - Screen orientation override.
 // viewport - The viewport dimensions and scale. If not set, the override is cleared.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetDeviceMetricsOverride(ctx context.Context, width int, height int, deviceScaleFactor float64, mobile bool, scale float64, screenWidth int, screenHeight int, positionX int, positionY int, dontSetVisibleSize bool, screenOrientation *EmulationScreenOrientation, viewport *PageViewport) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetDeviceMetricsOverrideParams
 	v.Width = width
@@ -1655,6 +3730,7 @@ func (c *Page) SetDeviceMetricsOverride(ctx context.Context, width int, height i
 	return c.SetDeviceMetricsOverrideWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type PageSetDeviceOrientationOverrideParams struct {
 	// Mock alpha
 	Alpha float64 `json:"alpha"`
@@ -1665,6 +3741,10 @@ type PageSetDeviceOrientationOverrideParams struct {
 }
 
 // SetDeviceOrientationOverrideWithParams - Overrides the Device Orientation.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetDeviceOrientationOverrideWithParams(ctx context.Context, v *PageSetDeviceOrientationOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setDeviceOrientationOverride", Params: v})
 }
@@ -1673,6 +3753,10 @@ func (c *Page) SetDeviceOrientationOverrideWithParams(ctx context.Context, v *Pa
 // alpha - Mock alpha
 // beta - Mock beta
 // gamma - Mock gamma
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetDeviceOrientationOverride(ctx context.Context, alpha float64, beta float64, gamma float64) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetDeviceOrientationOverrideParams
 	v.Alpha = alpha
@@ -1689,6 +3773,8 @@ type PageSetFontFamiliesParams struct {
 }
 
 // SetFontFamiliesWithParams - Set generic font families.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetFontFamiliesWithParams(ctx context.Context, v *PageSetFontFamiliesParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setFontFamilies", Params: v})
 }
@@ -1696,6 +3782,8 @@ func (c *Page) SetFontFamiliesWithParams(ctx context.Context, v *PageSetFontFami
 // SetFontFamilies - Set generic font families.
 // fontFamilies - Specifies font families to set. If a font family is not specified, it won't be changed.
 // forScripts - Specifies font families to set for individual scripts.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetFontFamilies(ctx context.Context, fontFamilies *PageFontFamilies, forScripts []*PageScriptFontFamilies) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetFontFamiliesParams
 	v.FontFamilies = fontFamilies
@@ -1709,12 +3797,16 @@ type PageSetFontSizesParams struct {
 }
 
 // SetFontSizesWithParams - Set default font sizes.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetFontSizesWithParams(ctx context.Context, v *PageSetFontSizesParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setFontSizes", Params: v})
 }
 
 // SetFontSizes - Set default font sizes.
 // fontSizes - Specifies font sizes to set. If a font size is not specified, it won't be changed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetFontSizes(ctx context.Context, fontSizes *PageFontSizes) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetFontSizesParams
 	v.FontSizes = fontSizes
@@ -1743,14 +3835,19 @@ func (c *Page) SetDocumentContent(ctx context.Context, frameId string, html stri
 	return c.SetDocumentContentWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type PageSetDownloadBehaviorParams struct {
 	// Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny).
-	Behavior string `json:"behavior"`
+	Behavior PageSetDownloadBehaviorBehavior `json:"behavior"`
 	// The default path to save downloaded files to. This is required if behavior is set to 'allow'
 	DownloadPath string `json:"downloadPath,omitempty"`
 }
 
 // SetDownloadBehaviorWithParams - Set the behavior when downloading a file.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetDownloadBehaviorWithParams(ctx context.Context, v *PageSetDownloadBehaviorParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setDownloadBehavior", Params: v})
 }
@@ -1758,13 +3855,18 @@ func (c *Page) SetDownloadBehaviorWithParams(ctx context.Context, v *PageSetDown
 // SetDownloadBehavior - Set the behavior when downloading a file.
 // behavior - Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny).
 // downloadPath - The default path to save downloaded files to. This is required if behavior is set to 'allow'
-func (c *Page) SetDownloadBehavior(ctx context.Context, behavior string, downloadPath string) (*gcdmessage.ChromeResponse, error) {
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) SetDownloadBehavior(ctx context.Context, behavior PageSetDownloadBehaviorBehavior, downloadPath string) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetDownloadBehaviorParams
 	v.Behavior = behavior
 	v.DownloadPath = downloadPath
 	return c.SetDownloadBehaviorWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type PageSetGeolocationOverrideParams struct {
 	// Mock latitude
 	Latitude float64 `json:"latitude,omitempty"`
@@ -1775,6 +3877,8 @@ type PageSetGeolocationOverrideParams struct {
 }
 
 // SetGeolocationOverrideWithParams - Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position unavailable.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Page) SetGeolocationOverrideWithParams(ctx context.Context, v *PageSetGeolocationOverrideParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setGeolocationOverride", Params: v})
 }
@@ -1783,6 +3887,8 @@ func (c *Page) SetGeolocationOverrideWithParams(ctx context.Context, v *PageSetG
 // latitude - Mock latitude
 // longitude - Mock longitude
 // accuracy - Mock accuracy
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Page) SetGeolocationOverride(ctx context.Context, latitude float64, longitude float64, accuracy float64) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetGeolocationOverrideParams
 	v.Latitude = latitude
@@ -1809,14 +3915,19 @@ func (c *Page) SetLifecycleEventsEnabled(ctx context.Context, enabled bool) (*gc
 	return c.SetLifecycleEventsEnabledWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type PageSetTouchEmulationEnabledParams struct {
 	// Whether the touch event emulation should be enabled.
 	Enabled bool `json:"enabled"`
 	// Touch/gesture events configuration. Default: current platform.
-	Configuration string `json:"configuration,omitempty"`
+	Configuration PageSetTouchEmulationEnabledConfiguration `json:"configuration,omitempty"`
 }
 
 // SetTouchEmulationEnabledWithParams - Toggles mouse event-based touch event emulation.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetTouchEmulationEnabledWithParams(ctx context.Context, v *PageSetTouchEmulationEnabledParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setTouchEmulationEnabled", Params: v})
 }
@@ -1824,7 +3935,11 @@ func (c *Page) SetTouchEmulationEnabledWithParams(ctx context.Context, v *PageSe
 // SetTouchEmulationEnabled - Toggles mouse event-based touch event emulation.
 // enabled - Whether the touch event emulation should be enabled.
 // configuration - Touch/gesture events configuration. Default: current platform.
-func (c *Page) SetTouchEmulationEnabled(ctx context.Context, enabled bool, configuration string) (*gcdmessage.ChromeResponse, error) {
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) SetTouchEmulationEnabled(ctx context.Context, enabled bool, configuration PageSetTouchEmulationEnabledConfiguration) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetTouchEmulationEnabledParams
 	v.Enabled = enabled
 	v.Configuration = configuration
@@ -1833,7 +3948,7 @@ func (c *Page) SetTouchEmulationEnabled(ctx context.Context, enabled bool, confi
 
 type PageStartScreencastParams struct {
 	// Image compression format.
-	Format string `json:"format,omitempty"`
+	Format PageStartScreencastFormat `json:"format,omitempty"`
 	// Compression quality from range [0..100].
 	Quality int `json:"quality,omitempty"`
 	// Maximum screenshot width.
@@ -1845,6 +3960,8 @@ type PageStartScreencastParams struct {
 }
 
 // StartScreencastWithParams - Starts sending each frame using the `screencastFrame` event.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) StartScreencastWithParams(ctx context.Context, v *PageStartScreencastParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.startScreencast", Params: v})
 }
@@ -1855,7 +3972,9 @@ func (c *Page) StartScreencastWithParams(ctx context.Context, v *PageStartScreen
 // maxWidth - Maximum screenshot width.
 // maxHeight - Maximum screenshot height.
 // everyNthFrame - Send every n-th frame.
-func (c *Page) StartScreencast(ctx context.Context, format string, quality int, maxWidth int, maxHeight int, everyNthFrame int) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) StartScreencast(ctx context.Context, format PageStartScreencastFormat, quality int, maxWidth int, maxHeight int, everyNthFrame int) (*gcdmessage.ChromeResponse, error) {
 	var v PageStartScreencastParams
 	v.Format = format
 	v.Quality = quality
@@ -1871,6 +3990,8 @@ func (c *Page) StopLoading(ctx context.Context) (*gcdmessage.ChromeResponse, err
 }
 
 // Crashes renderer on the IO thread, generates minidumps.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) Crash(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.crash"})
 }
@@ -1882,23 +4003,29 @@ func (c *Page) Close(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 
 type PageSetWebLifecycleStateParams struct {
 	// Target lifecycle state
-	State string `json:"state"`
+	State PageSetWebLifecycleStateState `json:"state"`
 }
 
 // SetWebLifecycleStateWithParams - Tries to update the web lifecycle state of the page. It will transition the page to the given state according to: https://github.com/WICG/web-lifecycle/
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetWebLifecycleStateWithParams(ctx context.Context, v *PageSetWebLifecycleStateParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setWebLifecycleState", Params: v})
 }
 
 // SetWebLifecycleState - Tries to update the web lifecycle state of the page. It will transition the page to the given state according to: https://github.com/WICG/web-lifecycle/
 // state - Target lifecycle state
-func (c *Page) SetWebLifecycleState(ctx context.Context, state string) (*gcdmessage.ChromeResponse, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) SetWebLifecycleState(ctx context.Context, state PageSetWebLifecycleStateState) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetWebLifecycleStateParams
 	v.State = state
 	return c.SetWebLifecycleStateWithParams(ctx, &v)
 }
 
 // Stops sending each frame in the `screencastFrame`.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) StopScreencast(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.stopScreencast"})
 }
@@ -1908,13 +4035,17 @@ type PageProduceCompilationCacheParams struct {
 	Scripts []*PageCompilationCacheParams `json:"scripts"`
 }
 
-// ProduceCompilationCacheWithParams - Requests backend to produce compilation cache for the specified scripts. `scripts` are appeneded to the list of scripts for which the cache would be produced. The list may be reset during page navigation. When script with a matching URL is encountered, the cache is optionally produced upon backend discretion, based on internal heuristics. See also: `Page.compilationCacheProduced`.
+// ProduceCompilationCacheWithParams - Requests backend to produce compilation cache for the specified scripts. `scripts` are appended to the list of scripts for which the cache would be produced. The list may be reset during page navigation. When script with a matching URL is encountered, the cache is optionally produced upon backend discretion, based on internal heuristics. See also: `Page.compilationCacheProduced`.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) ProduceCompilationCacheWithParams(ctx context.Context, v *PageProduceCompilationCacheParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.produceCompilationCache", Params: v})
 }
 
-// ProduceCompilationCache - Requests backend to produce compilation cache for the specified scripts. `scripts` are appeneded to the list of scripts for which the cache would be produced. The list may be reset during page navigation. When script with a matching URL is encountered, the cache is optionally produced upon backend discretion, based on internal heuristics. See also: `Page.compilationCacheProduced`.
+// ProduceCompilationCache - Requests backend to produce compilation cache for the specified scripts. `scripts` are appended to the list of scripts for which the cache would be produced. The list may be reset during page navigation. When script with a matching URL is encountered, the cache is optionally produced upon backend discretion, based on internal heuristics. See also: `Page.compilationCacheProduced`.
 // scripts -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) ProduceCompilationCache(ctx context.Context, scripts []*PageCompilationCacheParams) (*gcdmessage.ChromeResponse, error) {
 	var v PageProduceCompilationCacheParams
 	v.Scripts = scripts
@@ -1929,6 +4060,8 @@ type PageAddCompilationCacheParams struct {
 }
 
 // AddCompilationCacheWithParams - Seeds compilation cache for given url. Compilation cache does not survive cross-process navigation.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) AddCompilationCacheWithParams(ctx context.Context, v *PageAddCompilationCacheParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.addCompilationCache", Params: v})
 }
@@ -1936,6 +4069,8 @@ func (c *Page) AddCompilationCacheWithParams(ctx context.Context, v *PageAddComp
 // AddCompilationCache - Seeds compilation cache for given url. Compilation cache does not survive cross-process navigation.
 // url -
 // data - Base64-encoded data (Encoded as a base64 string when passed over JSON)
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) AddCompilationCache(ctx context.Context, url string, data string) (*gcdmessage.ChromeResponse, error) {
 	var v PageAddCompilationCacheParams
 	v.Url = url
@@ -1944,41 +4079,51 @@ func (c *Page) AddCompilationCache(ctx context.Context, url string, data string)
 }
 
 // Clears seeded compilation cache.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) ClearCompilationCache(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.clearCompilationCache"})
 }
 
 type PageSetSPCTransactionModeParams struct {
-	//  enum values: none, autoAccept, autoReject, autoOptOut
-	Mode string `json:"mode"`
+	//
+	Mode PageSetSPCTransactionModeMode `json:"mode"`
 }
 
 // SetSPCTransactionModeWithParams - Sets the Secure Payment Confirmation transaction mode. https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetSPCTransactionModeWithParams(ctx context.Context, v *PageSetSPCTransactionModeParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setSPCTransactionMode", Params: v})
 }
 
 // SetSPCTransactionMode - Sets the Secure Payment Confirmation transaction mode. https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode
-// mode -  enum values: none, autoAccept, autoReject, autoOptOut
-func (c *Page) SetSPCTransactionMode(ctx context.Context, mode string) (*gcdmessage.ChromeResponse, error) {
+// mode -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) SetSPCTransactionMode(ctx context.Context, mode PageSetSPCTransactionModeMode) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetSPCTransactionModeParams
 	v.Mode = mode
 	return c.SetSPCTransactionModeWithParams(ctx, &v)
 }
 
 type PageSetRPHRegistrationModeParams struct {
-	//  enum values: none, autoAccept, autoReject, autoOptOut
-	Mode string `json:"mode"`
+	//
+	Mode PageSetRPHRegistrationModeMode `json:"mode"`
 }
 
 // SetRPHRegistrationModeWithParams - Extensions for Custom Handlers API: https://html.spec.whatwg.org/multipage/system-state.html#rph-automation
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) SetRPHRegistrationModeWithParams(ctx context.Context, v *PageSetRPHRegistrationModeParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setRPHRegistrationMode", Params: v})
 }
 
 // SetRPHRegistrationMode - Extensions for Custom Handlers API: https://html.spec.whatwg.org/multipage/system-state.html#rph-automation
-// mode -  enum values: none, autoAccept, autoReject, autoOptOut
-func (c *Page) SetRPHRegistrationMode(ctx context.Context, mode string) (*gcdmessage.ChromeResponse, error) {
+// mode -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) SetRPHRegistrationMode(ctx context.Context, mode PageSetRPHRegistrationModeMode) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetRPHRegistrationModeParams
 	v.Mode = mode
 	return c.SetRPHRegistrationModeWithParams(ctx, &v)
@@ -1992,6 +4137,8 @@ type PageGenerateTestReportParams struct {
 }
 
 // GenerateTestReportWithParams - Generates a report for testing.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GenerateTestReportWithParams(ctx context.Context, v *PageGenerateTestReportParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.generateTestReport", Params: v})
 }
@@ -1999,6 +4146,8 @@ func (c *Page) GenerateTestReportWithParams(ctx context.Context, v *PageGenerate
 // GenerateTestReport - Generates a report for testing.
 // message - Message to be displayed in the report.
 // group - Specifies the endpoint group to deliver the report to.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) GenerateTestReport(ctx context.Context, message string, group string) (*gcdmessage.ChromeResponse, error) {
 	var v PageGenerateTestReportParams
 	v.Message = message
@@ -2007,6 +4156,8 @@ func (c *Page) GenerateTestReport(ctx context.Context, message string, group str
 }
 
 // Pauses page execution. Can be resumed using generic Runtime.runIfWaitingForDebugger.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Page) WaitForDebugger(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.waitForDebugger"})
 }
@@ -2014,6 +4165,10 @@ func (c *Page) WaitForDebugger(ctx context.Context) (*gcdmessage.ChromeResponse,
 type PageSetInterceptFileChooserDialogParams struct {
 	//
 	Enabled bool `json:"enabled"`
+	// If true, cancels the dialog by emitting relevant events (if any) in addition to not showing it if the interception is enabled (default: false).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	Cancel bool `json:"cancel,omitempty"`
 }
 
 // SetInterceptFileChooserDialogWithParams - Intercept file chooser requests and transfer control to protocol clients. When file chooser interception is enabled, native file chooser dialog is not shown. Instead, a protocol event `Page.fileChooserOpened` is emitted.
@@ -2023,8 +4178,32 @@ func (c *Page) SetInterceptFileChooserDialogWithParams(ctx context.Context, v *P
 
 // SetInterceptFileChooserDialog - Intercept file chooser requests and transfer control to protocol clients. When file chooser interception is enabled, native file chooser dialog is not shown. Instead, a protocol event `Page.fileChooserOpened` is emitted.
 // enabled -
-func (c *Page) SetInterceptFileChooserDialog(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
+// cancel - If true, cancels the dialog by emitting relevant events (if any) in addition to not showing it if the interception is enabled (default: false).
+func (c *Page) SetInterceptFileChooserDialog(ctx context.Context, enabled bool, cancel bool) (*gcdmessage.ChromeResponse, error) {
 	var v PageSetInterceptFileChooserDialogParams
 	v.Enabled = enabled
+	v.Cancel = cancel
 	return c.SetInterceptFileChooserDialogWithParams(ctx, &v)
 }
+
+type PageSetPrerenderingAllowedParams struct {
+	//
+	IsAllowed bool `json:"isAllowed"`
+}
+
+// SetPrerenderingAllowedWithParams - Enable/disable prerendering manually.  This command is a short-term solution for https://crbug.com/1440085. See https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA for more details.  TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab targets.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) SetPrerenderingAllowedWithParams(ctx context.Context, v *PageSetPrerenderingAllowedParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Page.setPrerenderingAllowed", Params: v})
+}
+
+// SetPrerenderingAllowed - Enable/disable prerendering manually.  This command is a short-term solution for https://crbug.com/1440085. See https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA for more details.  TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab targets.
+// isAllowed -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Page) SetPrerenderingAllowed(ctx context.Context, isAllowed bool) (*gcdmessage.ChromeResponse, error) {
+	var v PageSetPrerenderingAllowedParams
+	v.IsAllowed = isAllowed
+	return c.SetPrerenderingAllowedWithParams(ctx, &v)
+}
@@ -9,12 +9,58 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Time domain to use for collecting and reporting duration metrics.
+type PerformanceEnableTimeDomain string
+
+const (
+	PerformanceEnableTimeDomainTimeTicks   PerformanceEnableTimeDomain = "timeTicks"
+	PerformanceEnableTimeDomainThreadTicks PerformanceEnableTimeDomain = "threadTicks"
+)
+
+// Valid returns true if the value is one of the PerformanceEnableTimeDomain enum values.
+func (e PerformanceEnableTimeDomain) Valid() bool {
+	switch e {
+	case PerformanceEnableTimeDomainTimeTicks,
+		PerformanceEnableTimeDomainThreadTicks:
+		return true
+	}
+	return false
+}
+
+// Time domain
+type PerformanceSetTimeDomainTimeDomain string
+
+const (
+	PerformanceSetTimeDomainTimeDomainTimeTicks   PerformanceSetTimeDomainTimeDomain = "timeTicks"
+	PerformanceSetTimeDomainTimeDomainThreadTicks PerformanceSetTimeDomainTimeDomain = "threadTicks"
+)
+
+// Valid returns true if the value is one of the PerformanceSetTimeDomainTimeDomain enum values.
+func (e PerformanceSetTimeDomainTimeDomain) Valid() bool {
+	switch e {
+	case PerformanceSetTimeDomainTimeDomainTimeTicks,
+		PerformanceSetTimeDomainTimeDomainThreadTicks:
+		return true
+	}
+	return false
+}
+
 // Run-time execution metric.
 type PerformanceMetric struct {
 	Name  string  `json:"name"`  // Metric name.
 	Value float64 `json:"value"` // Metric value.
 }
 
+// Event method names for the Performance domain
+const (
+	EventPerformanceMetrics = "Performance.metrics"
+)
+
+// PerformanceEvents lists all event method names for the Performance domain
+var PerformanceEvents = []string{
+	EventPerformanceMetrics,
+}
+
 // Current values of the metrics.
 type PerformanceMetricsEvent struct {
 	Method string `json:"method"`
@@ -33,6 +79,19 @@ func NewPerformance(target gcdmessage.ChromeTargeter) *Performance {
 	return c
 }
 
+// OnMetrics subscribes to Performance.metrics events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Performance) OnMetrics(callback func(*PerformanceMetricsEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPerformanceMetrics, func(payload []byte) error {
+		event := &PerformanceMetricsEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 // Disable collecting and reporting metrics.
 func (c *Performance) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Performance.disable"})
@@ -40,7 +99,7 @@ func (c *Performance) Disable(ctx context.Context) (*gcdmessage.ChromeResponse,
 
 type PerformanceEnableParams struct {
 	// Time domain to use for collecting and reporting duration metrics.
-	TimeDomain string `json:"timeDomain,omitempty"`
+	TimeDomain PerformanceEnableTimeDomain `json:"timeDomain,omitempty"`
 }
 
 // EnableWithParams - Enable collecting and reporting metrics.
@@ -50,25 +109,34 @@ func (c *Performance) EnableWithParams(ctx context.Context, v *PerformanceEnable
 
 // Enable - Enable collecting and reporting metrics.
 // timeDomain - Time domain to use for collecting and reporting duration metrics.
-func (c *Performance) Enable(ctx context.Context, timeDomain string) (*gcdmessage.ChromeResponse, error) {
+func (c *Performance) Enable(ctx context.Context, timeDomain PerformanceEnableTimeDomain) (*gcdmessage.ChromeResponse, error) {
 	var v PerformanceEnableParams
 	v.TimeDomain = timeDomain
 	return c.EnableWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type PerformanceSetTimeDomainParams struct {
 	// Time domain
-	TimeDomain string `json:"timeDomain"`
+	TimeDomain PerformanceSetTimeDomainTimeDomain `json:"timeDomain"`
 }
 
 // SetTimeDomainWithParams - Sets time domain to use for collecting and reporting duration metrics. Note that this must be called before enabling metrics collection. Calling this method while metrics collection is enabled returns an error.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Performance) SetTimeDomainWithParams(ctx context.Context, v *PerformanceSetTimeDomainParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Performance.setTimeDomain", Params: v})
 }
 
 // SetTimeDomain - Sets time domain to use for collecting and reporting duration metrics. Note that this must be called before enabling metrics collection. Calling this method while metrics collection is enabled returns an error.
 // timeDomain - Time domain
-func (c *Performance) SetTimeDomain(ctx context.Context, timeDomain string) (*gcdmessage.ChromeResponse, error) {
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Performance) SetTimeDomain(ctx context.Context, timeDomain PerformanceSetTimeDomainTimeDomain) (*gcdmessage.ChromeResponse, error) {
 	var v PerformanceSetTimeDomainParams
 	v.TimeDomain = timeDomain
 	return c.SetTimeDomainWithParams(ctx, &v)
@@ -5,7 +5,6 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
@@ -37,7 +36,7 @@ type PerformanceTimelineLayoutShift struct {
 // No Description.
 type PerformanceTimelineTimelineEvent struct {
 	FrameId            string                                     `json:"frameId"`                      // Identifies the frame that this event is related to. Empty for non-frame targets.
-	Type               string                                     `json:"type"`                         // The event type, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype This determines which of the optional "details" fiedls is present.
+	Type               string                                     `json:"type"`                         // The event type, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype This determines which of the optional "details" fields is present.
 	Name               string                                     `json:"name"`                         // Name may be empty depending on the type.
 	Time               float64                                    `json:"time"`                         // Time in seconds since Epoch, monotonically increasing within document lifetime.
 	Duration           float64                                    `json:"duration,omitempty"`           // Event duration, if applicable.
@@ -45,6 +44,16 @@ type PerformanceTimelineTimelineEvent struct {
 	LayoutShiftDetails *PerformanceTimelineLayoutShift            `json:"layoutShiftDetails,omitempty"` //
 }
 
+// Event method names for the PerformanceTimeline domain
+const (
+	EventPerformanceTimelineTimelineEventAdded = "PerformanceTimeline.timelineEventAdded"
+)
+
+// PerformanceTimelineEvents lists all event method names for the PerformanceTimeline domain
+var PerformanceTimelineEvents = []string{
+	EventPerformanceTimelineTimelineEventAdded,
+}
+
 // Sent when a performance timeline event is added. See reportPerformanceTimeline method.
 type PerformanceTimelineTimelineEventAddedEvent struct {
 	Method string `json:"method"`
@@ -53,6 +62,9 @@ type PerformanceTimelineTimelineEventAddedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// PerformanceTimeline domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type PerformanceTimeline struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -61,21 +73,3 @@ func NewPerformanceTimeline(target gcdmessage.ChromeTargeter) *PerformanceTimeli
 	c := &PerformanceTimeline{target: target}
 	return c
 }
-
-type PerformanceTimelineEnableParams struct {
-	// The types of event to report, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype The specified filter overrides any previous filters, passing empty filter disables recording. Note that not all types exposed to the web platform are currently supported.
-	EventTypes []string `json:"eventTypes"`
-}
-
-// EnableWithParams - Previously buffered events would be reported before method returns. See also: timelineEventAdded
-func (c *PerformanceTimeline) EnableWithParams(ctx context.Context, v *PerformanceTimelineEnableParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "PerformanceTimeline.enable", Params: v})
-}
-
-// Enable - Previously buffered events would be reported before method returns. See also: timelineEventAdded
-// eventTypes - The types of event to report, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype The specified filter overrides any previous filters, passing empty filter disables recording. Note that not all types exposed to the web platform are currently supported.
-func (c *PerformanceTimeline) Enable(ctx context.Context, eventTypes []string) (*gcdmessage.ChromeResponse, error) {
-	var v PerformanceTimelineEnableParams
-	v.EventTypes = eventTypes
-	return c.EnableWithParams(ctx, &v)
-}
@@ -0,0 +1,43 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains PerformanceTimeline functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnTimelineEventAdded subscribes to PerformanceTimeline.timelineEventAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *PerformanceTimeline) OnTimelineEventAdded(callback func(*PerformanceTimelineTimelineEventAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPerformanceTimelineTimelineEventAdded, func(payload []byte) error {
+		event := &PerformanceTimelineTimelineEventAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type PerformanceTimelineEnableParams struct {
+	// The types of event to report, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype The specified filter overrides any previous filters, passing empty filter disables recording. Note that not all types exposed to the web platform are currently supported.
+	EventTypes []string `json:"eventTypes"`
+}
+
+// EnableWithParams - Previously buffered events would be reported before method returns. See also: timelineEventAdded
+func (c *PerformanceTimeline) EnableWithParams(ctx context.Context, v *PerformanceTimelineEnableParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "PerformanceTimeline.enable", Params: v})
+}
+
+// Enable - Previously buffered events would be reported before method returns. See also: timelineEventAdded
+// eventTypes - The types of event to report, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype The specified filter overrides any previous filters, passing empty filter disables recording. Note that not all types exposed to the web platform are currently supported.
+func (c *PerformanceTimeline) Enable(ctx context.Context, eventTypes []string) (*gcdmessage.ChromeResponse, error) {
+	var v PerformanceTimelineEnableParams
+	v.EventTypes = eventTypes
+	return c.EnableWithParams(ctx, &v)
+}
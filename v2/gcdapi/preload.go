@@ -5,37 +5,390 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// No Description.
+type PreloadRuleSetErrorType string
+
+const (
+	PreloadRuleSetErrorTypeSourceIsNotJsonObject  PreloadRuleSetErrorType = "SourceIsNotJsonObject"
+	PreloadRuleSetErrorTypeInvalidRulesSkipped    PreloadRuleSetErrorType = "InvalidRulesSkipped"
+	PreloadRuleSetErrorTypeInvalidRulesetLevelTag PreloadRuleSetErrorType = "InvalidRulesetLevelTag"
+)
+
+// Valid returns true if the value is one of the PreloadRuleSetErrorType enum values.
+func (e PreloadRuleSetErrorType) Valid() bool {
+	switch e {
+	case PreloadRuleSetErrorTypeSourceIsNotJsonObject,
+		PreloadRuleSetErrorTypeInvalidRulesSkipped,
+		PreloadRuleSetErrorTypeInvalidRulesetLevelTag:
+		return true
+	}
+	return false
+}
+
+// The type of preloading attempted. It corresponds to mojom::SpeculationAction (although PrefetchWithSubresources is omitted as it isn't being used by clients).
+type PreloadSpeculationAction string
+
+const (
+	PreloadSpeculationActionPrefetch  PreloadSpeculationAction = "Prefetch"
+	PreloadSpeculationActionPrerender PreloadSpeculationAction = "Prerender"
+)
+
+// Valid returns true if the value is one of the PreloadSpeculationAction enum values.
+func (e PreloadSpeculationAction) Valid() bool {
+	switch e {
+	case PreloadSpeculationActionPrefetch,
+		PreloadSpeculationActionPrerender:
+		return true
+	}
+	return false
+}
+
+// Corresponds to mojom::SpeculationTargetHint. See https://github.com/WICG/nav-speculation/blob/main/triggers.md#window-name-targeting-hints
+type PreloadSpeculationTargetHint string
+
+const (
+	PreloadSpeculationTargetHintBlank PreloadSpeculationTargetHint = "Blank"
+	PreloadSpeculationTargetHintSelf  PreloadSpeculationTargetHint = "Self"
+)
+
+// Valid returns true if the value is one of the PreloadSpeculationTargetHint enum values.
+func (e PreloadSpeculationTargetHint) Valid() bool {
+	switch e {
+	case PreloadSpeculationTargetHintBlank,
+		PreloadSpeculationTargetHintSelf:
+		return true
+	}
+	return false
+}
+
+// List of FinalStatus reasons for Prerender2.
+type PreloadPrerenderFinalStatus string
+
+const (
+	PreloadPrerenderFinalStatusActivated                                                  PreloadPrerenderFinalStatus = "Activated"
+	PreloadPrerenderFinalStatusDestroyed                                                  PreloadPrerenderFinalStatus = "Destroyed"
+	PreloadPrerenderFinalStatusLowEndDevice                                               PreloadPrerenderFinalStatus = "LowEndDevice"
+	PreloadPrerenderFinalStatusInvalidSchemeRedirect                                      PreloadPrerenderFinalStatus = "InvalidSchemeRedirect"
+	PreloadPrerenderFinalStatusInvalidSchemeNavigation                                    PreloadPrerenderFinalStatus = "InvalidSchemeNavigation"
+	PreloadPrerenderFinalStatusNavigationRequestBlockedByCsp                              PreloadPrerenderFinalStatus = "NavigationRequestBlockedByCsp"
+	PreloadPrerenderFinalStatusMojoBinderPolicy                                           PreloadPrerenderFinalStatus = "MojoBinderPolicy"
+	PreloadPrerenderFinalStatusRendererProcessCrashed                                     PreloadPrerenderFinalStatus = "RendererProcessCrashed"
+	PreloadPrerenderFinalStatusRendererProcessKilled                                      PreloadPrerenderFinalStatus = "RendererProcessKilled"
+	PreloadPrerenderFinalStatusDownload                                                   PreloadPrerenderFinalStatus = "Download"
+	PreloadPrerenderFinalStatusTriggerDestroyed                                           PreloadPrerenderFinalStatus = "TriggerDestroyed"
+	PreloadPrerenderFinalStatusNavigationNotCommitted                                     PreloadPrerenderFinalStatus = "NavigationNotCommitted"
+	PreloadPrerenderFinalStatusNavigationBadHttpStatus                                    PreloadPrerenderFinalStatus = "NavigationBadHttpStatus"
+	PreloadPrerenderFinalStatusClientCertRequested                                        PreloadPrerenderFinalStatus = "ClientCertRequested"
+	PreloadPrerenderFinalStatusNavigationRequestNetworkError                              PreloadPrerenderFinalStatus = "NavigationRequestNetworkError"
+	PreloadPrerenderFinalStatusCancelAllHostsForTesting                                   PreloadPrerenderFinalStatus = "CancelAllHostsForTesting"
+	PreloadPrerenderFinalStatusDidFailLoad                                                PreloadPrerenderFinalStatus = "DidFailLoad"
+	PreloadPrerenderFinalStatusStop                                                       PreloadPrerenderFinalStatus = "Stop"
+	PreloadPrerenderFinalStatusSslCertificateError                                        PreloadPrerenderFinalStatus = "SslCertificateError"
+	PreloadPrerenderFinalStatusLoginAuthRequested                                         PreloadPrerenderFinalStatus = "LoginAuthRequested"
+	PreloadPrerenderFinalStatusUaChangeRequiresReload                                     PreloadPrerenderFinalStatus = "UaChangeRequiresReload"
+	PreloadPrerenderFinalStatusBlockedByClient                                            PreloadPrerenderFinalStatus = "BlockedByClient"
+	PreloadPrerenderFinalStatusAudioOutputDeviceRequested                                 PreloadPrerenderFinalStatus = "AudioOutputDeviceRequested"
+	PreloadPrerenderFinalStatusMixedContent                                               PreloadPrerenderFinalStatus = "MixedContent"
+	PreloadPrerenderFinalStatusTriggerBackgrounded                                        PreloadPrerenderFinalStatus = "TriggerBackgrounded"
+	PreloadPrerenderFinalStatusMemoryLimitExceeded                                        PreloadPrerenderFinalStatus = "MemoryLimitExceeded"
+	PreloadPrerenderFinalStatusDataSaverEnabled                                           PreloadPrerenderFinalStatus = "DataSaverEnabled"
+	PreloadPrerenderFinalStatusTriggerUrlHasEffectiveUrl                                  PreloadPrerenderFinalStatus = "TriggerUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusActivatedBeforeStarted                                     PreloadPrerenderFinalStatus = "ActivatedBeforeStarted"
+	PreloadPrerenderFinalStatusInactivePageRestriction                                    PreloadPrerenderFinalStatus = "InactivePageRestriction"
+	PreloadPrerenderFinalStatusStartFailed                                                PreloadPrerenderFinalStatus = "StartFailed"
+	PreloadPrerenderFinalStatusTimeoutBackgrounded                                        PreloadPrerenderFinalStatus = "TimeoutBackgrounded"
+	PreloadPrerenderFinalStatusCrossSiteRedirectInInitialNavigation                       PreloadPrerenderFinalStatus = "CrossSiteRedirectInInitialNavigation"
+	PreloadPrerenderFinalStatusCrossSiteNavigationInInitialNavigation                     PreloadPrerenderFinalStatus = "CrossSiteNavigationInInitialNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation     PreloadPrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInInitialNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation   PreloadPrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInInitialNavigation"
+	PreloadPrerenderFinalStatusActivationNavigationParameterMismatch                      PreloadPrerenderFinalStatus = "ActivationNavigationParameterMismatch"
+	PreloadPrerenderFinalStatusActivatedInBackground                                      PreloadPrerenderFinalStatus = "ActivatedInBackground"
+	PreloadPrerenderFinalStatusEmbedderHostDisallowed                                     PreloadPrerenderFinalStatus = "EmbedderHostDisallowed"
+	PreloadPrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess                 PreloadPrerenderFinalStatus = "ActivationNavigationDestroyedBeforeSuccess"
+	PreloadPrerenderFinalStatusTabClosedByUserGesture                                     PreloadPrerenderFinalStatus = "TabClosedByUserGesture"
+	PreloadPrerenderFinalStatusTabClosedWithoutUserGesture                                PreloadPrerenderFinalStatus = "TabClosedWithoutUserGesture"
+	PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed                     PreloadPrerenderFinalStatus = "PrimaryMainFrameRendererProcessCrashed"
+	PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessKilled                      PreloadPrerenderFinalStatus = "PrimaryMainFrameRendererProcessKilled"
+	PreloadPrerenderFinalStatusActivationFramePolicyNotCompatible                         PreloadPrerenderFinalStatus = "ActivationFramePolicyNotCompatible"
+	PreloadPrerenderFinalStatusPreloadingDisabled                                         PreloadPrerenderFinalStatus = "PreloadingDisabled"
+	PreloadPrerenderFinalStatusBatterySaverEnabled                                        PreloadPrerenderFinalStatus = "BatterySaverEnabled"
+	PreloadPrerenderFinalStatusActivatedDuringMainFrameNavigation                         PreloadPrerenderFinalStatus = "ActivatedDuringMainFrameNavigation"
+	PreloadPrerenderFinalStatusPreloadingUnsupportedByWebContents                         PreloadPrerenderFinalStatus = "PreloadingUnsupportedByWebContents"
+	PreloadPrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation                     PreloadPrerenderFinalStatus = "CrossSiteRedirectInMainFrameNavigation"
+	PreloadPrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation                   PreloadPrerenderFinalStatus = "CrossSiteNavigationInMainFrameNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation   PreloadPrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation PreloadPrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"
+	PreloadPrerenderFinalStatusMemoryPressureOnTrigger                                    PreloadPrerenderFinalStatus = "MemoryPressureOnTrigger"
+	PreloadPrerenderFinalStatusMemoryPressureAfterTriggered                               PreloadPrerenderFinalStatus = "MemoryPressureAfterTriggered"
+	PreloadPrerenderFinalStatusPrerenderingDisabledByDevTools                             PreloadPrerenderFinalStatus = "PrerenderingDisabledByDevTools"
+	PreloadPrerenderFinalStatusSpeculationRuleRemoved                                     PreloadPrerenderFinalStatus = "SpeculationRuleRemoved"
+	PreloadPrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts                     PreloadPrerenderFinalStatus = "ActivatedWithAuxiliaryBrowsingContexts"
+	PreloadPrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded                     PreloadPrerenderFinalStatus = "MaxNumOfRunningEagerPrerendersExceeded"
+	PreloadPrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded                  PreloadPrerenderFinalStatus = "MaxNumOfRunningNonEagerPrerendersExceeded"
+	PreloadPrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded                  PreloadPrerenderFinalStatus = "MaxNumOfRunningEmbedderPrerendersExceeded"
+	PreloadPrerenderFinalStatusPrerenderingUrlHasEffectiveUrl                             PreloadPrerenderFinalStatus = "PrerenderingUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusRedirectedPrerenderingUrlHasEffectiveUrl                   PreloadPrerenderFinalStatus = "RedirectedPrerenderingUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusActivationUrlHasEffectiveUrl                               PreloadPrerenderFinalStatus = "ActivationUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusJavaScriptInterfaceAdded                                   PreloadPrerenderFinalStatus = "JavaScriptInterfaceAdded"
+	PreloadPrerenderFinalStatusJavaScriptInterfaceRemoved                                 PreloadPrerenderFinalStatus = "JavaScriptInterfaceRemoved"
+	PreloadPrerenderFinalStatusAllPrerenderingCanceled                                    PreloadPrerenderFinalStatus = "AllPrerenderingCanceled"
+	PreloadPrerenderFinalStatusWindowClosed                                               PreloadPrerenderFinalStatus = "WindowClosed"
+	PreloadPrerenderFinalStatusSlowNetwork                                                PreloadPrerenderFinalStatus = "SlowNetwork"
+	PreloadPrerenderFinalStatusOtherPrerenderedPageActivated                              PreloadPrerenderFinalStatus = "OtherPrerenderedPageActivated"
+	PreloadPrerenderFinalStatusV8OptimizerDisabled                                        PreloadPrerenderFinalStatus = "V8OptimizerDisabled"
+	PreloadPrerenderFinalStatusPrerenderFailedDuringPrefetch                              PreloadPrerenderFinalStatus = "PrerenderFailedDuringPrefetch"
+	PreloadPrerenderFinalStatusBrowsingDataRemoved                                        PreloadPrerenderFinalStatus = "BrowsingDataRemoved"
+	PreloadPrerenderFinalStatusPrerenderHostReused                                        PreloadPrerenderFinalStatus = "PrerenderHostReused"
+)
+
+// Valid returns true if the value is one of the PreloadPrerenderFinalStatus enum values.
+func (e PreloadPrerenderFinalStatus) Valid() bool {
+	switch e {
+	case PreloadPrerenderFinalStatusActivated,
+		PreloadPrerenderFinalStatusDestroyed,
+		PreloadPrerenderFinalStatusLowEndDevice,
+		PreloadPrerenderFinalStatusInvalidSchemeRedirect,
+		PreloadPrerenderFinalStatusInvalidSchemeNavigation,
+		PreloadPrerenderFinalStatusNavigationRequestBlockedByCsp,
+		PreloadPrerenderFinalStatusMojoBinderPolicy,
+		PreloadPrerenderFinalStatusRendererProcessCrashed,
+		PreloadPrerenderFinalStatusRendererProcessKilled,
+		PreloadPrerenderFinalStatusDownload,
+		PreloadPrerenderFinalStatusTriggerDestroyed,
+		PreloadPrerenderFinalStatusNavigationNotCommitted,
+		PreloadPrerenderFinalStatusNavigationBadHttpStatus,
+		PreloadPrerenderFinalStatusClientCertRequested,
+		PreloadPrerenderFinalStatusNavigationRequestNetworkError,
+		PreloadPrerenderFinalStatusCancelAllHostsForTesting,
+		PreloadPrerenderFinalStatusDidFailLoad,
+		PreloadPrerenderFinalStatusStop,
+		PreloadPrerenderFinalStatusSslCertificateError,
+		PreloadPrerenderFinalStatusLoginAuthRequested,
+		PreloadPrerenderFinalStatusUaChangeRequiresReload,
+		PreloadPrerenderFinalStatusBlockedByClient,
+		PreloadPrerenderFinalStatusAudioOutputDeviceRequested,
+		PreloadPrerenderFinalStatusMixedContent,
+		PreloadPrerenderFinalStatusTriggerBackgrounded,
+		PreloadPrerenderFinalStatusMemoryLimitExceeded,
+		PreloadPrerenderFinalStatusDataSaverEnabled,
+		PreloadPrerenderFinalStatusTriggerUrlHasEffectiveUrl,
+		PreloadPrerenderFinalStatusActivatedBeforeStarted,
+		PreloadPrerenderFinalStatusInactivePageRestriction,
+		PreloadPrerenderFinalStatusStartFailed,
+		PreloadPrerenderFinalStatusTimeoutBackgrounded,
+		PreloadPrerenderFinalStatusCrossSiteRedirectInInitialNavigation,
+		PreloadPrerenderFinalStatusCrossSiteNavigationInInitialNavigation,
+		PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation,
+		PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation,
+		PreloadPrerenderFinalStatusActivationNavigationParameterMismatch,
+		PreloadPrerenderFinalStatusActivatedInBackground,
+		PreloadPrerenderFinalStatusEmbedderHostDisallowed,
+		PreloadPrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess,
+		PreloadPrerenderFinalStatusTabClosedByUserGesture,
+		PreloadPrerenderFinalStatusTabClosedWithoutUserGesture,
+		PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed,
+		PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessKilled,
+		PreloadPrerenderFinalStatusActivationFramePolicyNotCompatible,
+		PreloadPrerenderFinalStatusPreloadingDisabled,
+		PreloadPrerenderFinalStatusBatterySaverEnabled,
+		PreloadPrerenderFinalStatusActivatedDuringMainFrameNavigation,
+		PreloadPrerenderFinalStatusPreloadingUnsupportedByWebContents,
+		PreloadPrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation,
+		PreloadPrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation,
+		PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation,
+		PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation,
+		PreloadPrerenderFinalStatusMemoryPressureOnTrigger,
+		PreloadPrerenderFinalStatusMemoryPressureAfterTriggered,
+		PreloadPrerenderFinalStatusPrerenderingDisabledByDevTools,
+		PreloadPrerenderFinalStatusSpeculationRuleRemoved,
+		PreloadPrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts,
+		PreloadPrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded,
+		PreloadPrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded,
+		PreloadPrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded,
+		PreloadPrerenderFinalStatusPrerenderingUrlHasEffectiveUrl,
+		PreloadPrerenderFinalStatusRedirectedPrerenderingUrlHasEffectiveUrl,
+		PreloadPrerenderFinalStatusActivationUrlHasEffectiveUrl,
+		PreloadPrerenderFinalStatusJavaScriptInterfaceAdded,
+		PreloadPrerenderFinalStatusJavaScriptInterfaceRemoved,
+		PreloadPrerenderFinalStatusAllPrerenderingCanceled,
+		PreloadPrerenderFinalStatusWindowClosed,
+		PreloadPrerenderFinalStatusSlowNetwork,
+		PreloadPrerenderFinalStatusOtherPrerenderedPageActivated,
+		PreloadPrerenderFinalStatusV8OptimizerDisabled,
+		PreloadPrerenderFinalStatusPrerenderFailedDuringPrefetch,
+		PreloadPrerenderFinalStatusBrowsingDataRemoved,
+		PreloadPrerenderFinalStatusPrerenderHostReused:
+		return true
+	}
+	return false
+}
+
+// Preloading status values, see also PreloadingTriggeringOutcome. This status is shared by prefetchStatusUpdated and prerenderStatusUpdated.
+type PreloadPreloadingStatus string
+
+const (
+	PreloadPreloadingStatusPending      PreloadPreloadingStatus = "Pending"
+	PreloadPreloadingStatusRunning      PreloadPreloadingStatus = "Running"
+	PreloadPreloadingStatusReady        PreloadPreloadingStatus = "Ready"
+	PreloadPreloadingStatusSuccess      PreloadPreloadingStatus = "Success"
+	PreloadPreloadingStatusFailure      PreloadPreloadingStatus = "Failure"
+	PreloadPreloadingStatusNotSupported PreloadPreloadingStatus = "NotSupported"
+)
+
+// Valid returns true if the value is one of the PreloadPreloadingStatus enum values.
+func (e PreloadPreloadingStatus) Valid() bool {
+	switch e {
+	case PreloadPreloadingStatusPending,
+		PreloadPreloadingStatusRunning,
+		PreloadPreloadingStatusReady,
+		PreloadPreloadingStatusSuccess,
+		PreloadPreloadingStatusFailure,
+		PreloadPreloadingStatusNotSupported:
+		return true
+	}
+	return false
+}
+
+// TODO(https://crbug.com/1384419): revisit the list of PrefetchStatus and filter out the ones that aren't necessary to the developers.
+type PreloadPrefetchStatus string
+
+const (
+	PreloadPrefetchStatusPrefetchAllowed                                             PreloadPrefetchStatus = "PrefetchAllowed"
+	PreloadPrefetchStatusPrefetchFailedIneligibleRedirect                            PreloadPrefetchStatus = "PrefetchFailedIneligibleRedirect"
+	PreloadPrefetchStatusPrefetchFailedInvalidRedirect                               PreloadPrefetchStatus = "PrefetchFailedInvalidRedirect"
+	PreloadPrefetchStatusPrefetchFailedMIMENotSupported                              PreloadPrefetchStatus = "PrefetchFailedMIMENotSupported"
+	PreloadPrefetchStatusPrefetchFailedNetError                                      PreloadPrefetchStatus = "PrefetchFailedNetError"
+	PreloadPrefetchStatusPrefetchFailedNon2XX                                        PreloadPrefetchStatus = "PrefetchFailedNon2XX"
+	PreloadPrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved                     PreloadPrefetchStatus = "PrefetchEvictedAfterBrowsingDataRemoved"
+	PreloadPrefetchStatusPrefetchEvictedAfterCandidateRemoved                        PreloadPrefetchStatus = "PrefetchEvictedAfterCandidateRemoved"
+	PreloadPrefetchStatusPrefetchEvictedForNewerPrefetch                             PreloadPrefetchStatus = "PrefetchEvictedForNewerPrefetch"
+	PreloadPrefetchStatusPrefetchHeldback                                            PreloadPrefetchStatus = "PrefetchHeldback"
+	PreloadPrefetchStatusPrefetchIneligibleRetryAfter                                PreloadPrefetchStatus = "PrefetchIneligibleRetryAfter"
+	PreloadPrefetchStatusPrefetchIsPrivacyDecoy                                      PreloadPrefetchStatus = "PrefetchIsPrivacyDecoy"
+	PreloadPrefetchStatusPrefetchIsStale                                             PreloadPrefetchStatus = "PrefetchIsStale"
+	PreloadPrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord               PreloadPrefetchStatus = "PrefetchNotEligibleBrowserContextOffTheRecord"
+	PreloadPrefetchStatusPrefetchNotEligibleDataSaverEnabled                         PreloadPrefetchStatus = "PrefetchNotEligibleDataSaverEnabled"
+	PreloadPrefetchStatusPrefetchNotEligibleExistingProxy                            PreloadPrefetchStatus = "PrefetchNotEligibleExistingProxy"
+	PreloadPrefetchStatusPrefetchNotEligibleHostIsNonUnique                          PreloadPrefetchStatus = "PrefetchNotEligibleHostIsNonUnique"
+	PreloadPrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition               PreloadPrefetchStatus = "PrefetchNotEligibleNonDefaultStoragePartition"
+	PreloadPrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy PreloadPrefetchStatus = "PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"
+	PreloadPrefetchStatusPrefetchNotEligibleSchemeIsNotHttps                         PreloadPrefetchStatus = "PrefetchNotEligibleSchemeIsNotHttps"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasCookies                           PreloadPrefetchStatus = "PrefetchNotEligibleUserHasCookies"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorker                     PreloadPrefetchStatus = "PrefetchNotEligibleUserHasServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler       PreloadPrefetchStatus = "PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler"
+	PreloadPrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker                PreloadPrefetchStatus = "PrefetchNotEligibleRedirectFromServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleRedirectToServiceWorker                  PreloadPrefetchStatus = "PrefetchNotEligibleRedirectToServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleBatterySaverEnabled                      PreloadPrefetchStatus = "PrefetchNotEligibleBatterySaverEnabled"
+	PreloadPrefetchStatusPrefetchNotEligiblePreloadingDisabled                       PreloadPrefetchStatus = "PrefetchNotEligiblePreloadingDisabled"
+	PreloadPrefetchStatusPrefetchNotFinishedInTime                                   PreloadPrefetchStatus = "PrefetchNotFinishedInTime"
+	PreloadPrefetchStatusPrefetchNotStarted                                          PreloadPrefetchStatus = "PrefetchNotStarted"
+	PreloadPrefetchStatusPrefetchNotUsedCookiesChanged                               PreloadPrefetchStatus = "PrefetchNotUsedCookiesChanged"
+	PreloadPrefetchStatusPrefetchProxyNotAvailable                                   PreloadPrefetchStatus = "PrefetchProxyNotAvailable"
+	PreloadPrefetchStatusPrefetchResponseUsed                                        PreloadPrefetchStatus = "PrefetchResponseUsed"
+	PreloadPrefetchStatusPrefetchSuccessfulButNotUsed                                PreloadPrefetchStatus = "PrefetchSuccessfulButNotUsed"
+	PreloadPrefetchStatusPrefetchNotUsedProbeFailed                                  PreloadPrefetchStatus = "PrefetchNotUsedProbeFailed"
+)
+
+// Valid returns true if the value is one of the PreloadPrefetchStatus enum values.
+func (e PreloadPrefetchStatus) Valid() bool {
+	switch e {
+	case PreloadPrefetchStatusPrefetchAllowed,
+		PreloadPrefetchStatusPrefetchFailedIneligibleRedirect,
+		PreloadPrefetchStatusPrefetchFailedInvalidRedirect,
+		PreloadPrefetchStatusPrefetchFailedMIMENotSupported,
+		PreloadPrefetchStatusPrefetchFailedNetError,
+		PreloadPrefetchStatusPrefetchFailedNon2XX,
+		PreloadPrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved,
+		PreloadPrefetchStatusPrefetchEvictedAfterCandidateRemoved,
+		PreloadPrefetchStatusPrefetchEvictedForNewerPrefetch,
+		PreloadPrefetchStatusPrefetchHeldback,
+		PreloadPrefetchStatusPrefetchIneligibleRetryAfter,
+		PreloadPrefetchStatusPrefetchIsPrivacyDecoy,
+		PreloadPrefetchStatusPrefetchIsStale,
+		PreloadPrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord,
+		PreloadPrefetchStatusPrefetchNotEligibleDataSaverEnabled,
+		PreloadPrefetchStatusPrefetchNotEligibleExistingProxy,
+		PreloadPrefetchStatusPrefetchNotEligibleHostIsNonUnique,
+		PreloadPrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition,
+		PreloadPrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy,
+		PreloadPrefetchStatusPrefetchNotEligibleSchemeIsNotHttps,
+		PreloadPrefetchStatusPrefetchNotEligibleUserHasCookies,
+		PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorker,
+		PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler,
+		PreloadPrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker,
+		PreloadPrefetchStatusPrefetchNotEligibleRedirectToServiceWorker,
+		PreloadPrefetchStatusPrefetchNotEligibleBatterySaverEnabled,
+		PreloadPrefetchStatusPrefetchNotEligiblePreloadingDisabled,
+		PreloadPrefetchStatusPrefetchNotFinishedInTime,
+		PreloadPrefetchStatusPrefetchNotStarted,
+		PreloadPrefetchStatusPrefetchNotUsedCookiesChanged,
+		PreloadPrefetchStatusPrefetchProxyNotAvailable,
+		PreloadPrefetchStatusPrefetchResponseUsed,
+		PreloadPrefetchStatusPrefetchSuccessfulButNotUsed,
+		PreloadPrefetchStatusPrefetchNotUsedProbeFailed:
+		return true
+	}
+	return false
+}
+
 // Corresponds to SpeculationRuleSet
 type PreloadRuleSet struct {
-	Id            string `json:"id"`                      //
-	LoaderId      string `json:"loaderId"`                // Identifies a document which the rule set is associated with.
-	SourceText    string `json:"sourceText"`              // Source text of JSON representing the rule set. If it comes from <script> tag, it is the textContent of the node. Note that it is a JSON for valid case.  See also: - https://wicg.github.io/nav-speculation/speculation-rules.html - https://github.com/WICG/nav-speculation/blob/main/triggers.md
-	BackendNodeId int    `json:"backendNodeId,omitempty"` // A speculation rule set is either added through an inline <script> tag or through an external resource via the 'Speculation-Rules' HTTP header. For the first case, we include the BackendNodeId of the relevant <script> tag. For the second case, we include the external URL where the rule set was loaded from, and also RequestId if Network domain is enabled.  See also: - https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-script - https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-header
-	Url           string `json:"url,omitempty"`           //
-	RequestId     string `json:"requestId,omitempty"`     //
-	ErrorType     string `json:"errorType,omitempty"`     // Error information `errorMessage` is null iff `errorType` is null. enum values: SourceIsNotJsonObject, InvalidRulesSkipped
-	ErrorMessage  string `json:"errorMessage,omitempty"`  // TODO(https://crbug.com/1425354): Replace this property with structured error.
+	Id            string                  `json:"id"`                      //
+	LoaderId      string                  `json:"loaderId"`                // Identifies a document which the rule set is associated with.
+	SourceText    string                  `json:"sourceText"`              // Source text of JSON representing the rule set. If it comes from `<script>` tag, it is the textContent of the node. Note that it is a JSON for valid case.  See also: - https://wicg.github.io/nav-speculation/speculation-rules.html - https://github.com/WICG/nav-speculation/blob/main/triggers.md
+	BackendNodeId int                     `json:"backendNodeId,omitempty"` // A speculation rule set is either added through an inline `<script>` tag or through an external resource via the 'Speculation-Rules' HTTP header. For the first case, we include the BackendNodeId of the relevant `<script>` tag. For the second case, we include the external URL where the rule set was loaded from, and also RequestId if Network domain is enabled.  See also: - https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-script - https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-header
+	Url           string                  `json:"url,omitempty"`           //
+	RequestId     string                  `json:"requestId,omitempty"`     //
+	ErrorType     PreloadRuleSetErrorType `json:"errorType,omitempty"`     // Error information `errorMessage` is null iff `errorType` is null. enum values: SourceIsNotJsonObject, InvalidRulesSkipped, InvalidRulesetLevelTag
+	// Deprecated: this field is deprecated in the protocol.
+	ErrorMessage string `json:"errorMessage,omitempty"` // TODO(https://crbug.com/1425354): Replace this property with structured error.
 }
 
 // A key that identifies a preloading attempt.  The url used is the url specified by the trigger (i.e. the initial URL), and not the final url that is navigated to. For example, prerendering allows same-origin main frame navigations during the attempt, but the attempt is still keyed with the initial URL.
 type PreloadPreloadingAttemptKey struct {
-	LoaderId   string `json:"loaderId"`             //
-	Action     string `json:"action"`               //  enum values: Prefetch, Prerender
-	Url        string `json:"url"`                  //
-	TargetHint string `json:"targetHint,omitempty"` //  enum values: Blank, Self
+	LoaderId   string                       `json:"loaderId"`             //
+	Action     PreloadSpeculationAction     `json:"action"`               //  enum values: Prefetch, Prerender
+	Url        string                       `json:"url"`                  //
+	TargetHint PreloadSpeculationTargetHint `json:"targetHint,omitempty"` //  enum values: Blank, Self
 }
 
-// Lists sources for a preloading attempt, specifically the ids of rule sets that had a speculation rule that triggered the attempt, and the BackendNodeIds of <a href> or <area href> elements that triggered the attempt (in the case of attempts triggered by a document rule). It is possible for mulitple rule sets and links to trigger a single attempt.
+// Lists sources for a preloading attempt, specifically the ids of rule sets that had a speculation rule that triggered the attempt, and the BackendNodeIds of <a href> or <area href> elements that triggered the attempt (in the case of attempts triggered by a document rule). It is possible for multiple rule sets and links to trigger a single attempt.
 type PreloadPreloadingAttemptSource struct {
 	Key        *PreloadPreloadingAttemptKey `json:"key"`        //
 	RuleSetIds []string                     `json:"ruleSetIds"` //
 	NodeIds    []int                        `json:"nodeIds"`    //
 }
 
+// Information of headers to be displayed when the header mismatch occurred.
+type PreloadPrerenderMismatchedHeaders struct {
+	HeaderName      string `json:"headerName"`                //
+	InitialValue    string `json:"initialValue,omitempty"`    //
+	ActivationValue string `json:"activationValue,omitempty"` //
+}
+
+// Event method names for the Preload domain
+const (
+	EventPreloadRuleSetUpdated                  = "Preload.ruleSetUpdated"
+	EventPreloadRuleSetRemoved                  = "Preload.ruleSetRemoved"
+	EventPreloadPreloadEnabledStateUpdated      = "Preload.preloadEnabledStateUpdated"
+	EventPreloadPrefetchStatusUpdated           = "Preload.prefetchStatusUpdated"
+	EventPreloadPrerenderStatusUpdated          = "Preload.prerenderStatusUpdated"
+	EventPreloadPreloadingAttemptSourcesUpdated = "Preload.preloadingAttemptSourcesUpdated"
+)
+
+// PreloadEvents lists all event method names for the Preload domain
+var PreloadEvents = []string{
+	EventPreloadRuleSetUpdated,
+	EventPreloadRuleSetRemoved,
+	EventPreloadPreloadEnabledStateUpdated,
+	EventPreloadPrefetchStatusUpdated,
+	EventPreloadPrerenderStatusUpdated,
+	EventPreloadPreloadingAttemptSourcesUpdated,
+}
+
 // Upsert. Currently, it is only emitted when a rule set added.
 type PreloadRuleSetUpdatedEvent struct {
 	Method string `json:"method"`
@@ -51,23 +404,15 @@ type PreloadRuleSetRemovedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
-// Fired when a prerender attempt is completed.
-type PreloadPrerenderAttemptCompletedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		Key                 *PreloadPreloadingAttemptKey `json:"key"`                           //
-		InitiatingFrameId   string                       `json:"initiatingFrameId"`             // The frame id of the frame initiating prerendering.
-		PrerenderingUrl     string                       `json:"prerenderingUrl"`               //
-		FinalStatus         string                       `json:"finalStatus"`                   //  enum values: Activated, Destroyed, LowEndDevice, InvalidSchemeRedirect, InvalidSchemeNavigation, InProgressNavigation, NavigationRequestBlockedByCsp, MainFrameNavigation, MojoBinderPolicy, RendererProcessCrashed, RendererProcessKilled, Download, TriggerDestroyed, NavigationNotCommitted, NavigationBadHttpStatus, ClientCertRequested, NavigationRequestNetworkError, MaxNumOfRunningPrerendersExceeded, CancelAllHostsForTesting, DidFailLoad, Stop, SslCertificateError, LoginAuthRequested, UaChangeRequiresReload, BlockedByClient, AudioOutputDeviceRequested, MixedContent, TriggerBackgrounded, EmbedderTriggeredAndCrossOriginRedirected, MemoryLimitExceeded, FailToGetMemoryUsage, DataSaverEnabled, HasEffectiveUrl, ActivatedBeforeStarted, InactivePageRestriction, StartFailed, TimeoutBackgrounded, CrossSiteRedirectInInitialNavigation, CrossSiteNavigationInInitialNavigation, SameSiteCrossOriginRedirectNotOptInInInitialNavigation, SameSiteCrossOriginNavigationNotOptInInInitialNavigation, ActivationNavigationParameterMismatch, ActivatedInBackground, EmbedderHostDisallowed, ActivationNavigationDestroyedBeforeSuccess, TabClosedByUserGesture, TabClosedWithoutUserGesture, PrimaryMainFrameRendererProcessCrashed, PrimaryMainFrameRendererProcessKilled, ActivationFramePolicyNotCompatible, PreloadingDisabled, BatterySaverEnabled, ActivatedDuringMainFrameNavigation, PreloadingUnsupportedByWebContents, CrossSiteRedirectInMainFrameNavigation, CrossSiteNavigationInMainFrameNavigation, SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation, SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation, MemoryPressureOnTrigger, MemoryPressureAfterTriggered
-		DisallowedApiMethod string                       `json:"disallowedApiMethod,omitempty"` // This is used to give users more information about the name of the API call that is incompatible with prerender and has caused the cancellation of the attempt
-	} `json:"Params,omitempty"`
-}
-
 // Fired when a preload enabled state is updated.
 type PreloadPreloadEnabledStateUpdatedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		State string `json:"state"` //  enum values: Enabled, DisabledByDataSaver, DisabledByBatterySaver, DisabledByPreference, NotSupported
+		DisabledByPreference                        bool `json:"disabledByPreference"`                        //
+		DisabledByDataSaver                         bool `json:"disabledByDataSaver"`                         //
+		DisabledByBatterySaver                      bool `json:"disabledByBatterySaver"`                      //
+		DisabledByHoldbackPrefetchSpeculationRules  bool `json:"disabledByHoldbackPrefetchSpeculationRules"`  //
+		DisabledByHoldbackPrerenderSpeculationRules bool `json:"disabledByHoldbackPrerenderSpeculationRules"` //
 	} `json:"Params,omitempty"`
 }
 
@@ -76,9 +421,12 @@ type PreloadPrefetchStatusUpdatedEvent struct {
 	Method string `json:"method"`
 	Params struct {
 		Key               *PreloadPreloadingAttemptKey `json:"key"`               //
+		PipelineId        string                       `json:"pipelineId"`        //
 		InitiatingFrameId string                       `json:"initiatingFrameId"` // The frame id of the frame initiating prefetch.
 		PrefetchUrl       string                       `json:"prefetchUrl"`       //
-		Status            string                       `json:"status"`            //  enum values: Pending, Running, Ready, Success, Failure, NotSupported
+		Status            PreloadPreloadingStatus      `json:"status"`            //  enum values: Pending, Running, Ready, Success, Failure, NotSupported
+		PrefetchStatus    PreloadPrefetchStatus        `json:"prefetchStatus"`    //  enum values: PrefetchAllowed, PrefetchFailedIneligibleRedirect, PrefetchFailedInvalidRedirect, PrefetchFailedMIMENotSupported, PrefetchFailedNetError, PrefetchFailedNon2XX, PrefetchEvictedAfterBrowsingDataRemoved, PrefetchEvictedAfterCandidateRemoved, PrefetchEvictedForNewerPrefetch, PrefetchHeldback, PrefetchIneligibleRetryAfter, PrefetchIsPrivacyDecoy, PrefetchIsStale, PrefetchNotEligibleBrowserContextOffTheRecord, PrefetchNotEligibleDataSaverEnabled, PrefetchNotEligibleExistingProxy, PrefetchNotEligibleHostIsNonUnique, PrefetchNotEligibleNonDefaultStoragePartition, PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy, PrefetchNotEligibleSchemeIsNotHttps, PrefetchNotEligibleUserHasCookies, PrefetchNotEligibleUserHasServiceWorker, PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler, PrefetchNotEligibleRedirectFromServiceWorker, PrefetchNotEligibleRedirectToServiceWorker, PrefetchNotEligibleBatterySaverEnabled, PrefetchNotEligiblePreloadingDisabled, PrefetchNotFinishedInTime, PrefetchNotStarted, PrefetchNotUsedCookiesChanged, PrefetchProxyNotAvailable, PrefetchResponseUsed, PrefetchSuccessfulButNotUsed, PrefetchNotUsedProbeFailed
+		RequestId         string                       `json:"requestId"`         //
 	} `json:"Params,omitempty"`
 }
 
@@ -86,10 +434,12 @@ type PreloadPrefetchStatusUpdatedEvent struct {
 type PreloadPrerenderStatusUpdatedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		Key               *PreloadPreloadingAttemptKey `json:"key"`               //
-		InitiatingFrameId string                       `json:"initiatingFrameId"` // The frame id of the frame initiating prerender.
-		PrerenderingUrl   string                       `json:"prerenderingUrl"`   //
-		Status            string                       `json:"status"`            //  enum values: Pending, Running, Ready, Success, Failure, NotSupported
+		Key                     *PreloadPreloadingAttemptKey         `json:"key"`                               //
+		PipelineId              string                               `json:"pipelineId"`                        //
+		Status                  PreloadPreloadingStatus              `json:"status"`                            //  enum values: Pending, Running, Ready, Success, Failure, NotSupported
+		PrerenderStatus         PreloadPrerenderFinalStatus          `json:"prerenderStatus,omitempty"`         //  enum values: Activated, Destroyed, LowEndDevice, InvalidSchemeRedirect, InvalidSchemeNavigation, NavigationRequestBlockedByCsp, MojoBinderPolicy, RendererProcessCrashed, RendererProcessKilled, Download, TriggerDestroyed, NavigationNotCommitted, NavigationBadHttpStatus, ClientCertRequested, NavigationRequestNetworkError, CancelAllHostsForTesting, DidFailLoad, Stop, SslCertificateError, LoginAuthRequested, UaChangeRequiresReload, BlockedByClient, AudioOutputDeviceRequested, MixedContent, TriggerBackgrounded, MemoryLimitExceeded, DataSaverEnabled, TriggerUrlHasEffectiveUrl, ActivatedBeforeStarted, InactivePageRestriction, StartFailed, TimeoutBackgrounded, CrossSiteRedirectInInitialNavigation, CrossSiteNavigationInInitialNavigation, SameSiteCrossOriginRedirectNotOptInInInitialNavigation, SameSiteCrossOriginNavigationNotOptInInInitialNavigation, ActivationNavigationParameterMismatch, ActivatedInBackground, EmbedderHostDisallowed, ActivationNavigationDestroyedBeforeSuccess, TabClosedByUserGesture, TabClosedWithoutUserGesture, PrimaryMainFrameRendererProcessCrashed, PrimaryMainFrameRendererProcessKilled, ActivationFramePolicyNotCompatible, PreloadingDisabled, BatterySaverEnabled, ActivatedDuringMainFrameNavigation, PreloadingUnsupportedByWebContents, CrossSiteRedirectInMainFrameNavigation, CrossSiteNavigationInMainFrameNavigation, SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation, SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation, MemoryPressureOnTrigger, MemoryPressureAfterTriggered, PrerenderingDisabledByDevTools, SpeculationRuleRemoved, ActivatedWithAuxiliaryBrowsingContexts, MaxNumOfRunningEagerPrerendersExceeded, MaxNumOfRunningNonEagerPrerendersExceeded, MaxNumOfRunningEmbedderPrerendersExceeded, PrerenderingUrlHasEffectiveUrl, RedirectedPrerenderingUrlHasEffectiveUrl, ActivationUrlHasEffectiveUrl, JavaScriptInterfaceAdded, JavaScriptInterfaceRemoved, AllPrerenderingCanceled, WindowClosed, SlowNetwork, OtherPrerenderedPageActivated, V8OptimizerDisabled, PrerenderFailedDuringPrefetch, BrowsingDataRemoved, PrerenderHostReused
+		DisallowedMojoInterface string                               `json:"disallowedMojoInterface,omitempty"` // This is used to give users more information about the name of Mojo interface that is incompatible with prerender and has caused the cancellation of the attempt.
+		MismatchedHeaders       []*PreloadPrerenderMismatchedHeaders `json:"mismatchedHeaders,omitempty"`       //
 	} `json:"Params,omitempty"`
 }
 
@@ -102,6 +452,9 @@ type PreloadPreloadingAttemptSourcesUpdatedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Preload domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type Preload struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -110,11 +463,3 @@ func NewPreload(target gcdmessage.ChromeTargeter) *Preload {
 	c := &Preload{target: target}
 	return c
 }
-
-func (c *Preload) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Preload.enable"})
-}
-
-func (c *Preload) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Preload.disable"})
-}
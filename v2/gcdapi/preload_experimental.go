@@ -0,0 +1,98 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Preload functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnRuleSetUpdated subscribes to Preload.ruleSetUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Preload) OnRuleSetUpdated(callback func(*PreloadRuleSetUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPreloadRuleSetUpdated, func(payload []byte) error {
+		event := &PreloadRuleSetUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnRuleSetRemoved subscribes to Preload.ruleSetRemoved events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Preload) OnRuleSetRemoved(callback func(*PreloadRuleSetRemovedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPreloadRuleSetRemoved, func(payload []byte) error {
+		event := &PreloadRuleSetRemovedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPreloadEnabledStateUpdated subscribes to Preload.preloadEnabledStateUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Preload) OnPreloadEnabledStateUpdated(callback func(*PreloadPreloadEnabledStateUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPreloadPreloadEnabledStateUpdated, func(payload []byte) error {
+		event := &PreloadPreloadEnabledStateUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPrefetchStatusUpdated subscribes to Preload.prefetchStatusUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Preload) OnPrefetchStatusUpdated(callback func(*PreloadPrefetchStatusUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPreloadPrefetchStatusUpdated, func(payload []byte) error {
+		event := &PreloadPrefetchStatusUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPrerenderStatusUpdated subscribes to Preload.prerenderStatusUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Preload) OnPrerenderStatusUpdated(callback func(*PreloadPrerenderStatusUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPreloadPrerenderStatusUpdated, func(payload []byte) error {
+		event := &PreloadPrerenderStatusUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPreloadingAttemptSourcesUpdated subscribes to Preload.preloadingAttemptSourcesUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Preload) OnPreloadingAttemptSourcesUpdated(callback func(*PreloadPreloadingAttemptSourcesUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventPreloadPreloadingAttemptSourcesUpdated, func(payload []byte) error {
+		event := &PreloadPreloadingAttemptSourcesUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+func (c *Preload) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Preload.enable"})
+}
+
+func (c *Preload) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Preload.disable"})
+}
@@ -55,6 +55,20 @@ type ProfilerScriptCoverage struct {
 	Functions []*ProfilerFunctionCoverage `json:"functions"` // Functions contained in the script that has coverage data.
 }
 
+// Event method names for the Profiler domain
+const (
+	EventProfilerConsoleProfileFinished     = "Profiler.consoleProfileFinished"
+	EventProfilerConsoleProfileStarted      = "Profiler.consoleProfileStarted"
+	EventProfilerPreciseCoverageDeltaUpdate = "Profiler.preciseCoverageDeltaUpdate"
+)
+
+// ProfilerEvents lists all event method names for the Profiler domain
+var ProfilerEvents = []string{
+	EventProfilerConsoleProfileFinished,
+	EventProfilerConsoleProfileStarted,
+	EventProfilerPreciseCoverageDeltaUpdate,
+}
+
 type ProfilerConsoleProfileFinishedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -76,6 +90,8 @@ type ProfilerConsoleProfileStartedEvent struct {
 }
 
 // Reports coverage delta since the last poll (either from an event like this, or from `takePreciseCoverage` for the current isolate. May only be sent if precise code coverage has been started. This event can be trigged by the embedder to, for example, trigger collection of coverage data immediately at a certain point in time.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type ProfilerPreciseCoverageDeltaUpdateEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -94,6 +110,47 @@ func NewProfiler(target gcdmessage.ChromeTargeter) *Profiler {
 	return c
 }
 
+// OnConsoleProfileFinished subscribes to Profiler.consoleProfileFinished events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Profiler) OnConsoleProfileFinished(callback func(*ProfilerConsoleProfileFinishedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventProfilerConsoleProfileFinished, func(payload []byte) error {
+		event := &ProfilerConsoleProfileFinishedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnConsoleProfileStarted subscribes to Profiler.consoleProfileStarted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Profiler) OnConsoleProfileStarted(callback func(*ProfilerConsoleProfileStartedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventProfilerConsoleProfileStarted, func(payload []byte) error {
+		event := &ProfilerConsoleProfileStartedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnPreciseCoverageDeltaUpdate subscribes to Profiler.preciseCoverageDeltaUpdate events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Profiler) OnPreciseCoverageDeltaUpdate(callback func(*ProfilerPreciseCoverageDeltaUpdateEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventProfilerPreciseCoverageDeltaUpdate, func(payload []byte) error {
+		event := &ProfilerPreciseCoverageDeltaUpdateEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 func (c *Profiler) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Profiler.disable"})
 }
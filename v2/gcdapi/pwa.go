@@ -0,0 +1,52 @@
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains PWA functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// If user prefers opening the app in browser or an app window.
+type PWADisplayMode string
+
+const (
+	PWADisplayModeStandalone PWADisplayMode = "standalone"
+	PWADisplayModeBrowser    PWADisplayMode = "browser"
+)
+
+// Valid returns true if the value is one of the PWADisplayMode enum values.
+func (e PWADisplayMode) Valid() bool {
+	switch e {
+	case PWADisplayModeStandalone,
+		PWADisplayModeBrowser:
+		return true
+	}
+	return false
+}
+
+// The following types are the replica of https://crsrc.org/c/chrome/browser/web_applications/proto/web_app_os_integration_state.proto;drc=9910d3be894c8f142c977ba1023f30a656bc13fc;l=67
+type PWAFileHandlerAccept struct {
+	MediaType      string   `json:"mediaType"`      // New name of the mimetype according to https://www.iana.org/assignments/media-types/media-types.xhtml
+	FileExtensions []string `json:"fileExtensions"` //
+}
+
+// No Description.
+type PWAFileHandler struct {
+	Action      string                  `json:"action"`      //
+	Accepts     []*PWAFileHandlerAccept `json:"accepts"`     //
+	DisplayName string                  `json:"displayName"` //
+}
+
+// PWA domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type PWA struct {
+	target gcdmessage.ChromeTargeter
+}
+
+func NewPWA(target gcdmessage.ChromeTargeter) *PWA {
+	c := &PWA{target: target}
+	return c
+}
@@ -0,0 +1,8485 @@
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains the protocol registry, runtime metadata of every domain, command and event.
+
+package gcdapi
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/goccy/go-json"
+)
+
+// ParamInfo describes a command parameter, command return value or event parameter.
+type ParamInfo struct {
+	Name        string
+	Description string
+	Type        string       // protocol type, a basic type such as "string" or a reference such as "Page.FrameId"
+	Items       string       // protocol type of the elements if Type is "array"
+	Optional    bool         // may be omitted
+	GoType      reflect.Type // Go type used in the generated params, returns and event structs
+}
+
+// CommandInfo describes a protocol command.
+type CommandInfo struct {
+	Domain       string
+	Name         string
+	Method       string // method name sent to the debugger, such as "Page.navigate"
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Params       []*ParamInfo
+	Returns      []*ParamInfo
+}
+
+// EventInfo describes a protocol event.
+type EventInfo struct {
+	Domain       string
+	Name         string
+	Method       string // method name sent by the debugger, such as "Page.loadEventFired"
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Params       []*ParamInfo
+	Type         reflect.Type // generated event struct, such as PageLoadEventFiredEvent
+}
+
+// DomainInfo describes a protocol domain and its commands and events.
+type DomainInfo struct {
+	Name         string
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Dependencies []string
+	Commands     []*CommandInfo
+	Events       []*EventInfo
+}
+
+// ParamError is returned by CommandInfo.Validate when params do not match the command's schema.
+type ParamError struct {
+	Method string // command method
+	Param  string // offending param name, empty if the params as a whole are invalid
+	Reason string
+}
+
+func (e *ParamError) Error() string {
+	if e.Param == "" {
+		return fmt.Sprintf("%s: %s", e.Method, e.Reason)
+	}
+	return fmt.Sprintf("%s: param %s %s", e.Method, e.Param, e.Reason)
+}
+
+var (
+	domainRegistry  map[string]*DomainInfo
+	commandRegistry map[string]*CommandInfo
+	eventRegistry   map[string]*EventInfo
+)
+
+func init() {
+	domainRegistry = make(map[string]*DomainInfo, len(protocolDomains))
+	commandRegistry = make(map[string]*CommandInfo)
+	eventRegistry = make(map[string]*EventInfo)
+
+	for _, domain := range protocolDomains {
+		domainRegistry[domain.Name] = domain
+		for _, cmd := range domain.Commands {
+			cmd.Domain = domain.Name
+			cmd.Method = domain.Name + "." + cmd.Name
+			commandRegistry[cmd.Method] = cmd
+		}
+		for _, event := range domain.Events {
+			event.Domain = domain.Name
+			event.Method = domain.Name + "." + event.Name
+			eventRegistry[event.Method] = event
+		}
+	}
+}
+
+// Domains returns the metadata of every domain in the protocol, the returned
+// values are shared and must not be modified.
+func Domains() []*DomainInfo {
+	return protocolDomains
+}
+
+// LookupDomain returns the metadata of a domain by name, such as "Page".
+func LookupDomain(name string) (*DomainInfo, bool) {
+	domain, ok := domainRegistry[name]
+	return domain, ok
+}
+
+// LookupCommand returns the metadata of a command by method name, such as "Page.navigate".
+func LookupCommand(method string) (*CommandInfo, bool) {
+	cmd, ok := commandRegistry[method]
+	return cmd, ok
+}
+
+// LookupEvent returns the metadata of an event by method name, such as "Page.loadEventFired".
+func LookupEvent(method string) (*EventInfo, bool) {
+	event, ok := eventRegistry[method]
+	return event, ok
+}
+
+// New returns a pointer to a new event of the generated type, such as *PageLoadEventFiredEvent.
+func (e *EventInfo) New() interface{} {
+	return reflect.New(e.Type).Interface()
+}
+
+// DecodeEvent decodes a raw event message, as given to ChromeTarget.Subscribe callbacks,
+// into a pointer of its generated event type by looking up the message's method.
+func DecodeEvent(payload []byte) (interface{}, error) {
+	var header struct {
+		Method string `json:"method"`
+	}
+
+	if err := jsonUnmarshal(payload, &header); err != nil {
+		return nil, err
+	}
+
+	info, ok := LookupEvent(header.Method)
+	if !ok {
+		return nil, fmt.Errorf("unknown event method %q", header.Method)
+	}
+
+	event := info.New()
+	if err := jsonUnmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// Param returns the parameter of the command by name, or nil if it has no such parameter.
+func (c *CommandInfo) Param(name string) *ParamInfo {
+	for _, param := range c.Params {
+		if param.Name == name {
+			return param
+		}
+	}
+	return nil
+}
+
+// Validate checks params, such as those given to SendDefaultRequest, against the command's
+// schema. Required params must be set, unknown params are rejected and values must be of
+// the parameter's JSON type. params may be nil, raw JSON or any value marshaling to a JSON object.
+func (c *CommandInfo) Validate(params interface{}) error {
+	fields := make(map[string]json.RawMessage)
+
+	if params != nil {
+		var data []byte
+		switch v := params.(type) {
+		case []byte:
+			data = v
+		case json.RawMessage:
+			data = v
+		default:
+			var err error
+			if data, err = json.Marshal(params); err != nil {
+				return &ParamError{Method: c.Method, Reason: err.Error()}
+			}
+		}
+
+		if err := jsonUnmarshal(data, &fields); err != nil {
+			return &ParamError{Method: c.Method, Reason: "params must be a JSON object"}
+		}
+	}
+
+	for _, param := range c.Params {
+		raw, ok := fields[param.Name]
+		if !ok || string(bytes.TrimSpace(raw)) == "null" {
+			if !param.Optional {
+				return &ParamError{Method: c.Method, Param: param.Name, Reason: "is required"}
+			}
+			continue
+		}
+
+		if !jsonTypeMatches(param.GoType, bytes.TrimSpace(raw)) {
+			return &ParamError{Method: c.Method, Param: param.Name, Reason: fmt.Sprintf("is not of type %s", param.Type)}
+		}
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if c.Param(name) == nil {
+			return &ParamError{Method: c.Method, Param: name, Reason: "is unknown"}
+		}
+	}
+	return nil
+}
+
+// jsonTypeMatches reports whether the raw JSON value can be decoded into goType.
+func jsonTypeMatches(goType reflect.Type, raw []byte) bool {
+	if len(raw) == 0 {
+		return false
+	}
+
+	switch goType.Kind() {
+	case reflect.Ptr:
+		return jsonTypeMatches(goType.Elem(), raw)
+	case reflect.String:
+		return raw[0] == '"'
+	case reflect.Bool:
+		return raw[0] == 't' || raw[0] == 'f'
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return isJSONNumber(raw) && !bytes.ContainsAny(raw, ".eE")
+	case reflect.Float32, reflect.Float64:
+		return isJSONNumber(raw)
+	case reflect.Slice, reflect.Array:
+		return raw[0] == '['
+	case reflect.Struct, reflect.Map:
+		return raw[0] == '{'
+	}
+	return true
+}
+
+func isJSONNumber(raw []byte) bool {
+	return raw[0] == '-' || (raw[0] >= '0' && raw[0] <= '9')
+}
+
+var protocolDomains = []*DomainInfo{
+	{
+		Name:         "Accessibility",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"DOM"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "Disables the accessibility domain.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables the accessibility domain which causes `AXNodeId`s to remain consistent between method calls. This turns on accessibility for the page, which can impact performance until accessibility is disabled.",
+			},
+			{
+				Name:         "getPartialAXTree",
+				Description:  "Fetches the accessibility node and partial accessibility tree for this DOM node, if it exists.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node to get the partial accessibility tree for.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node to get the partial accessibility tree for.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper to get the partial accessibility tree for.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "fetchRelatives", Description: "Whether to fetch this node's ancestors, siblings and children. Defaults to true.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodes", Description: "The `Accessibility.AXNode` for this DOM node, if it exists, plus its ancestors, siblings and children, if requested.", Type: "array", Items: "Accessibility.AXNode", GoType: reflect.TypeOf((*[]*AccessibilityAXNode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getFullAXTree",
+				Description:  "Fetches the entire accessibility tree for the root Document",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "depth", Description: "The maximum depth at which descendants of the root node should be retrieved. If omitted, the full tree is returned.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "frameId", Description: "The frame for whose document the AX tree should be retrieved. If omitted, the root frame is used.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodes", Description: "", Type: "array", Items: "Accessibility.AXNode", GoType: reflect.TypeOf((*[]*AccessibilityAXNode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getRootAXNode",
+				Description:  "Fetches the root node. Requires `enable()` to have been called previously.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "The frame in whose document the node resides. If omitted, the root frame is used.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "node", Description: "", Type: "Accessibility.AXNode", GoType: reflect.TypeOf((**AccessibilityAXNode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getAXNodeAndAncestors",
+				Description:  "Fetches a node and all ancestors up to and including the root. Requires `enable()` to have been called previously.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node to get.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node to get.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper to get.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodes", Description: "", Type: "array", Items: "Accessibility.AXNode", GoType: reflect.TypeOf((*[]*AccessibilityAXNode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getChildAXNodes",
+				Description:  "Fetches a particular accessibility node by AXNodeId. Requires `enable()` to have been called previously.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "id", Description: "", Type: "Accessibility.AXNodeId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "frameId", Description: "The frame in whose document the node resides. If omitted, the root frame is used.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodes", Description: "", Type: "array", Items: "Accessibility.AXNode", GoType: reflect.TypeOf((*[]*AccessibilityAXNode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "queryAXTree",
+				Description:  "Query a DOM node's accessibility subtree for accessible name and role. This command computes the name and role for all nodes in the subtree, including those that are ignored for accessibility, and returns those that match the specified name and role. If no DOM node is specified, or the DOM node does not exist, the command returns an error. If neither `accessibleName` or `role` is specified, it returns all the accessibility nodes in the subtree.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node for the root to query.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node for the root to query.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper for the root to query.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "accessibleName", Description: "Find nodes with this computed name.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "role", Description: "Find nodes with this computed role.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodes", Description: "A list of `Accessibility.AXNode` matching the specified attributes, including nodes that are ignored for accessibility.", Type: "array", Items: "Accessibility.AXNode", GoType: reflect.TypeOf((*[]*AccessibilityAXNode)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:         "loadComplete",
+				Description:  "The loadComplete event mirrors the load complete event sent by the browser to assistive technology when the web page has finished loading.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "root", Description: "New document root node.", Type: "Accessibility.AXNode", GoType: reflect.TypeOf((**AccessibilityAXNode)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*AccessibilityLoadCompleteEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "nodesUpdated",
+				Description:  "The nodesUpdated event is sent every time a previously requested node has changed the in tree.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodes", Description: "Updated node data.", Type: "array", Items: "Accessibility.AXNode", GoType: reflect.TypeOf((*[]*AccessibilityAXNode)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*AccessibilityNodesUpdatedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Animation",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"Runtime", "DOM"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "Disables animation domain notifications.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables animation domain notifications.",
+			},
+			{
+				Name:        "getCurrentTime",
+				Description: "Returns the current time of the an animation.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "Id of animation.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "currentTime", Description: "Current time of the page.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getPlaybackRate",
+				Description: "Gets the playback rate of the document timeline.",
+				Returns: []*ParamInfo{
+					{Name: "playbackRate", Description: "Playback rate for animations on page.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "releaseAnimations",
+				Description: "Releases a set of animations to no longer be manipulated.",
+				Params: []*ParamInfo{
+					{Name: "animations", Description: "List of animation ids to seek.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "resolveAnimation",
+				Description: "Gets the remote object of the Animation.",
+				Params: []*ParamInfo{
+					{Name: "animationId", Description: "Animation id.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "remoteObject", Description: "Corresponding remote object.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "seekAnimations",
+				Description: "Seek a set of animations to a particular time within each animation.",
+				Params: []*ParamInfo{
+					{Name: "animations", Description: "List of animation ids to seek.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "currentTime", Description: "Set the current time of each animation.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setPaused",
+				Description: "Sets the paused state of a set of animations.",
+				Params: []*ParamInfo{
+					{Name: "animations", Description: "Animations to set the pause state of.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "paused", Description: "Paused state to set to.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setPlaybackRate",
+				Description: "Sets the playback rate of the document timeline.",
+				Params: []*ParamInfo{
+					{Name: "playbackRate", Description: "Playback rate for animations on page", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setTiming",
+				Description: "Sets the timing of an animation node.",
+				Params: []*ParamInfo{
+					{Name: "animationId", Description: "Animation id.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "duration", Description: "Duration of the animation.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "delay", Description: "Delay of the animation.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "animationCanceled",
+				Description: "Event for when an animation has been cancelled.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "Id of the animation that was cancelled.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*AnimationAnimationCanceledEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "animationCreated",
+				Description: "Event for each animation that has been created.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "Id of the animation that was created.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*AnimationAnimationCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "animationStarted",
+				Description: "Event for animation that has been started.",
+				Params: []*ParamInfo{
+					{Name: "animation", Description: "Animation that was started.", Type: "Animation.Animation", GoType: reflect.TypeOf((**AnimationAnimation)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*AnimationAnimationStartedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "animationUpdated",
+				Description: "Event for animation that has been updated.",
+				Params: []*ParamInfo{
+					{Name: "animation", Description: "Animation that was updated.", Type: "Animation.Animation", GoType: reflect.TypeOf((**AnimationAnimation)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*AnimationAnimationUpdatedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Audits",
+		Description:  "Audits domain allows investigation of page violations and possible improvements.",
+		Experimental: true,
+		Dependencies: []string{"Network"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "getEncodedResponse",
+				Description: "Returns the response body and size if it were re-encoded with the specified settings. Only applies to images.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Identifier of the network request to get content for.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "encoding", Description: "The encoding to use.", Type: "string", GoType: reflect.TypeOf((*AuditsGetEncodedResponseEncoding)(nil)).Elem()},
+					{Name: "quality", Description: "The quality of the encoding (0-1). (defaults to 1)", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "sizeOnly", Description: "Whether to only return the size information (defaults to false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "body", Description: "The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "originalSize", Description: "Size before re-encoding.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "encodedSize", Description: "Size after re-encoding.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables issues domain, prevents further issues from being reported to the client.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables issues domain, sends the issues collected so far to the client by means of the `issueAdded` event.",
+			},
+			{
+				Name:        "checkContrast",
+				Description: "Runs the contrast check for the target page. Found issues are reported using Audits.issueAdded event.",
+				Params: []*ParamInfo{
+					{Name: "reportAAA", Description: "Whether to report WCAG AAA level issues. Default is false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "checkFormsIssues",
+				Description: "Runs the form issues check for the target page. Found issues are reported using Audits.issueAdded event.",
+				Returns: []*ParamInfo{
+					{Name: "formIssues", Description: "", Type: "array", Items: "Audits.GenericIssueDetails", GoType: reflect.TypeOf((*[]*AuditsGenericIssueDetails)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "issueAdded",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "issue", Description: "", Type: "Audits.InspectorIssue", GoType: reflect.TypeOf((**AuditsInspectorIssue)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*AuditsIssueAddedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Extensions",
+		Description:  "Defines commands and events for browser extensions.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "loadUnpacked",
+				Description: "Installs an unpacked extension from the filesystem similar to --load-extension CLI flags. Returns extension ID once the extension has been installed. Available if the client is connected using the --remote-debugging-pipe flag and the --enable-unsafe-extension-debugging flag is set.",
+				Params: []*ParamInfo{
+					{Name: "path", Description: "Absolute file path.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "id", Description: "Extension id.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "uninstall",
+				Description: "Uninstalls an unpacked extension (others not supported) from the profile. Available if the client is connected using the --remote-debugging-pipe flag and the --enable-unsafe-extension-debugging.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "Extension id.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getStorageItems",
+				Description: "Gets data from extension storage in the given `storageArea`. If `keys` is specified, these are used to filter the result.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "ID of extension.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageArea", Description: "StorageArea to retrieve data from. enum values: session, local, sync, managed", Type: "Extensions.StorageArea", GoType: reflect.TypeOf((*ExtensionsStorageArea)(nil)).Elem()},
+					{Name: "keys", Description: "Keys to retrieve.", Type: "array", Items: "string", Optional: true, GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "data", Description: "", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeStorageItems",
+				Description: "Removes `keys` from extension storage in the given `storageArea`.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "ID of extension.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageArea", Description: "StorageArea to remove data from. enum values: session, local, sync, managed", Type: "Extensions.StorageArea", GoType: reflect.TypeOf((*ExtensionsStorageArea)(nil)).Elem()},
+					{Name: "keys", Description: "Keys to remove.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearStorageItems",
+				Description: "Clears extension storage in the given `storageArea`.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "ID of extension.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageArea", Description: "StorageArea to remove data from. enum values: session, local, sync, managed", Type: "Extensions.StorageArea", GoType: reflect.TypeOf((*ExtensionsStorageArea)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setStorageItems",
+				Description: "Sets `values` in extension storage in the given `storageArea`. The provided `values` will be merged with existing values in the storage area.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "ID of extension.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageArea", Description: "StorageArea to set data in. enum values: session, local, sync, managed", Type: "Extensions.StorageArea", GoType: reflect.TypeOf((*ExtensionsStorageArea)(nil)).Elem()},
+					{Name: "values", Description: "Values to set.", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "Autofill",
+		Description:  "Defines commands and events for Autofill.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "trigger",
+				Description: "Trigger autofill on a form identified by the fieldId. If the field and related form cannot be autofilled, returns an error.",
+				Params: []*ParamInfo{
+					{Name: "fieldId", Description: "Identifies a field that serves as an anchor for autofill.", Type: "DOM.BackendNodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "frameId", Description: "Identifies the frame that field belongs to.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "card", Description: "Credit card information to fill out the form. Credit card data is not saved.", Type: "Autofill.CreditCard", GoType: reflect.TypeOf((**AutofillCreditCard)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setAddresses",
+				Description: "Set addresses so that developers can verify their forms implementation.",
+				Params: []*ParamInfo{
+					{Name: "addresses", Description: "", Type: "array", Items: "Autofill.Address", GoType: reflect.TypeOf((*[]*AutofillAddress)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables autofill domain notifications.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables autofill domain notifications.",
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "addressFormFilled",
+				Description: "Emitted when an address form is filled.",
+				Params: []*ParamInfo{
+					{Name: "filledFields", Description: "Information about the fields that were filled", Type: "array", Items: "Autofill.FilledField", GoType: reflect.TypeOf((*[]*AutofillFilledField)(nil)).Elem()},
+					{Name: "addressUi", Description: "An UI representation of the address used to fill the form. Consists of a 2D array where each child represents an address/profile line.", Type: "Autofill.AddressUI", GoType: reflect.TypeOf((**AutofillAddressUI)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*AutofillAddressFormFilledEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "BackgroundService",
+		Description:  "Defines events for background web platform features.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "startObserving",
+				Description: "Enables event updates for the service.",
+				Params: []*ParamInfo{
+					{Name: "service", Description: " enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync", Type: "BackgroundService.ServiceName", GoType: reflect.TypeOf((*BackgroundServiceServiceName)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopObserving",
+				Description: "Disables event updates for the service.",
+				Params: []*ParamInfo{
+					{Name: "service", Description: " enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync", Type: "BackgroundService.ServiceName", GoType: reflect.TypeOf((*BackgroundServiceServiceName)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setRecording",
+				Description: "Set the recording state for the service.",
+				Params: []*ParamInfo{
+					{Name: "shouldRecord", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "service", Description: " enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync", Type: "BackgroundService.ServiceName", GoType: reflect.TypeOf((*BackgroundServiceServiceName)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearEvents",
+				Description: "Clears all stored data for the service.",
+				Params: []*ParamInfo{
+					{Name: "service", Description: " enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync", Type: "BackgroundService.ServiceName", GoType: reflect.TypeOf((*BackgroundServiceServiceName)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "recordingStateChanged",
+				Description: "Called when the recording state for the service has been updated.",
+				Params: []*ParamInfo{
+					{Name: "isRecording", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "service", Description: " enum values: backgroundFetch, backgroundSync, pushMessaging, notifications, paymentHandler, periodicBackgroundSync", Type: "BackgroundService.ServiceName", GoType: reflect.TypeOf((*BackgroundServiceServiceName)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*BackgroundServiceRecordingStateChangedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "backgroundServiceEventReceived",
+				Description: "Called with all existing backgroundServiceEvents when enabled, and all new events afterwards if enabled and recording.",
+				Params: []*ParamInfo{
+					{Name: "backgroundServiceEvent", Description: "", Type: "BackgroundService.BackgroundServiceEvent", GoType: reflect.TypeOf((**BackgroundServiceBackgroundServiceEvent)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*BackgroundServiceBackgroundServiceEventReceivedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:        "Browser",
+		Description: "The Browser domain defines methods and events for browser managing.",
+		Commands: []*CommandInfo{
+			{
+				Name:         "setPermission",
+				Description:  "Set permission settings for given origin.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "permission", Description: "Descriptor of permission to override.", Type: "Browser.PermissionDescriptor", GoType: reflect.TypeOf((**BrowserPermissionDescriptor)(nil)).Elem()},
+					{Name: "setting", Description: "Setting of the permission. enum values: granted, denied, prompt", Type: "Browser.PermissionSetting", GoType: reflect.TypeOf((*BrowserPermissionSetting)(nil)).Elem()},
+					{Name: "origin", Description: "Origin the permission applies to, all origins if not specified.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "browserContextId", Description: "Context to override. When omitted, default browser context is used.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "grantPermissions",
+				Description:  "Grant specific permissions to the given origin and reject all others.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "permissions", Description: " enum values: ar, audioCapture, automaticFullscreen, backgroundFetch, backgroundSync, cameraPanTiltZoom, capturedSurfaceControl, clipboardReadWrite, clipboardSanitizedWrite, displayCapture, durableStorage, geolocation, handTracking, idleDetection, keyboardLock, localFonts, localNetworkAccess, midi, midiSysex, nfc, notifications, paymentHandler, periodicBackgroundSync, pointerLock, protectedMediaIdentifier, sensors, smartCard, speakerSelection, storageAccess, topLevelStorageAccess, videoCapture, vr, wakeLockScreen, wakeLockSystem, webAppInstallation, webPrinting, windowManagement", Type: "array", Items: "Browser.PermissionType", GoType: reflect.TypeOf((*[]BrowserPermissionType)(nil)).Elem()},
+					{Name: "origin", Description: "Origin the permission applies to, all origins if not specified.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "browserContextId", Description: "BrowserContext to override permissions. When omitted, default browser context is used.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "resetPermissions",
+				Description: "Reset all permission management for all origins.",
+				Params: []*ParamInfo{
+					{Name: "browserContextId", Description: "BrowserContext to reset permissions. When omitted, default browser context is used.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDownloadBehavior",
+				Description:  "Set the behavior when downloading a file.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "behavior", Description: "Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny). |allowAndName| allows download and names files according to their download guids.", Type: "string", GoType: reflect.TypeOf((*BrowserSetDownloadBehaviorBehavior)(nil)).Elem()},
+					{Name: "browserContextId", Description: "BrowserContext to set download behavior. When omitted, default browser context is used.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "downloadPath", Description: "The default path to save downloaded files to. This is required if behavior is set to 'allow' or 'allowAndName'.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "eventsEnabled", Description: "Whether to emit download events (defaults to false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "cancelDownload",
+				Description:  "Cancel a download if in progress",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "guid", Description: "Global unique identifier of the download.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "browserContextId", Description: "BrowserContext to perform the action in. When omitted, default browser context is used.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "close",
+				Description: "Close browser gracefully.",
+			},
+			{
+				Name:         "crash",
+				Description:  "Crashes browser on the main thread.",
+				Experimental: true,
+			},
+			{
+				Name:         "crashGpuProcess",
+				Description:  "Crashes GPU process.",
+				Experimental: true,
+			},
+			{
+				Name:        "getVersion",
+				Description: "Returns version information.",
+				Returns: []*ParamInfo{
+					{Name: "protocolVersion", Description: "Protocol version.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "product", Description: "Product name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "revision", Description: "Product revision.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "userAgent", Description: "User-Agent.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "jsVersion", Description: "V8 version.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getBrowserCommandLine",
+				Description:  "Returns the command line switches for the browser process if, and only if --enable-automation is on the commandline.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "arguments", Description: "Commandline parameters", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getHistograms",
+				Description:  "Get Chrome histograms.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "query", Description: "Requested substring in name. Only histograms which have query as a substring in their name are extracted. An empty or absent query returns all histograms.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "delta", Description: "If true, retrieve delta since last delta call.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "histograms", Description: "Histograms.", Type: "array", Items: "Browser.Histogram", GoType: reflect.TypeOf((*[]*BrowserHistogram)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getHistogram",
+				Description:  "Get a Chrome histogram by name.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "name", Description: "Requested histogram name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "delta", Description: "If true, retrieve delta since last delta call.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "histogram", Description: "Histogram.", Type: "Browser.Histogram", GoType: reflect.TypeOf((**BrowserHistogram)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getWindowBounds",
+				Description:  "Get position and size of the browser window.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "windowId", Description: "Browser window id.", Type: "Browser.WindowID", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "bounds", Description: "Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.", Type: "Browser.Bounds", GoType: reflect.TypeOf((**BrowserBounds)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getWindowForTarget",
+				Description:  "Get the browser window that contains the devtools target.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "Devtools agent host id. If called as a part of the session, associated targetId is used.", Type: "Target.TargetID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "windowId", Description: "Browser window id.", Type: "Browser.WindowID", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "bounds", Description: "Bounds information of the window. When window state is 'minimized', the restored window position and size are returned.", Type: "Browser.Bounds", GoType: reflect.TypeOf((**BrowserBounds)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setWindowBounds",
+				Description:  "Set position and/or size of the browser window.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "windowId", Description: "Browser window id.", Type: "Browser.WindowID", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "bounds", Description: "New window bounds. The 'minimized', 'maximized' and 'fullscreen' states cannot be combined with 'left', 'top', 'width' or 'height'. Leaves unspecified fields unchanged.", Type: "Browser.Bounds", GoType: reflect.TypeOf((**BrowserBounds)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setContentsSize",
+				Description:  "Set size of the browser contents resizing browser window as necessary.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "windowId", Description: "Browser window id.", Type: "Browser.WindowID", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "width", Description: "The window contents width in DIP. Assumes current width if omitted. Must be specified if 'height' is omitted.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "height", Description: "The window contents height in DIP. Assumes current height if omitted. Must be specified if 'width' is omitted.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDockTile",
+				Description:  "Set dock tile details, platform-specific.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "badgeLabel", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "image", Description: "Png encoded image. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "executeBrowserCommand",
+				Description:  "Invoke custom browser commands used by telemetry.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "commandId", Description: " enum values: openTabSearch, closeTabSearch, openGlic", Type: "Browser.BrowserCommandId", GoType: reflect.TypeOf((*BrowserBrowserCommandId)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "addPrivacySandboxEnrollmentOverride",
+				Description: "Allows a site to use privacy sandbox features that require enrollment without the site actually being enrolled. Only supported on page targets.",
+				Params: []*ParamInfo{
+					{Name: "url", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "addPrivacySandboxCoordinatorKeyConfig",
+				Description: "Configures encryption keys used with a given privacy sandbox API to talk to a trusted coordinator.  Since this is intended for test automation only, coordinatorOrigin must be a .test domain. No existing coordinator configuration for the origin may exist.",
+				Params: []*ParamInfo{
+					{Name: "api", Description: " enum values: BiddingAndAuctionServices, TrustedKeyValue", Type: "Browser.PrivacySandboxAPI", GoType: reflect.TypeOf((*BrowserPrivacySandboxAPI)(nil)).Elem()},
+					{Name: "coordinatorOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "keyConfig", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "browserContextId", Description: "BrowserContext to perform the action in. When omitted, default browser context is used.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:         "downloadWillBegin",
+				Description:  "Fired when page is about to start a download.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that caused the download to begin.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "guid", Description: "Global unique identifier of the download.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "URL of the resource being downloaded.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "suggestedFilename", Description: "Suggested file name of the resource (the actual name of the file saved on disk may differ).", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*BrowserDownloadWillBeginEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "downloadProgress",
+				Description:  "Fired when download makes progress. Last call has |done| == true.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "guid", Description: "Global unique identifier of the download.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "totalBytes", Description: "Total expected bytes to download.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "receivedBytes", Description: "Total bytes received.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "state", Description: "Download status.", Type: "string", GoType: reflect.TypeOf((*BrowserDownloadProgressState)(nil)).Elem()},
+					{Name: "filePath", Description: "If download is \"completed\", provides the path of the downloaded file. Depending on the platform, it is not guaranteed to be set, nor the file is guaranteed to exist.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*BrowserDownloadProgressEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "CSS",
+		Description:  "This domain exposes CSS read/write operations. All CSS objects (stylesheets, rules, and styles) have an associated `id` used in subsequent operations on the related object. Each object type has a specific `id` structure, and those are not interchangeable between objects of different kinds. CSS objects can be loaded using the `get*ForNode()` calls (which accept a DOM node id). A client can also keep track of stylesheets via the `styleSheetAdded`/`styleSheetRemoved` events and subsequently load the required stylesheet contents using the `getStyleSheet[Text]()` methods.",
+		Experimental: true,
+		Dependencies: []string{"DOM", "Page"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "addRule",
+				Description: "Inserts a new rule with the given `ruleText` in a stylesheet with given `styleSheetId`, at the position specified by `location`.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "The css style sheet identifier where a new rule should be inserted.", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "ruleText", Description: "The text of a new rule.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "location", Description: "Text position of a new rule in the target style sheet.", Type: "CSS.SourceRange", GoType: reflect.TypeOf((**CSSSourceRange)(nil)).Elem()},
+					{Name: "nodeForPropertySyntaxValidation", Description: "NodeId for the DOM node in whose context custom property declarations for registered properties should be validated. If omitted, declarations in the new rule text can only be validated statically, which may produce incorrect results if the declaration contains a var() for example.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "rule", Description: "The newly created rule.", Type: "CSS.CSSRule", GoType: reflect.TypeOf((**CSSCSSRule)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "collectClassNames",
+				Description: "Returns all class names from specified stylesheet.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "classNames", Description: "Class name list.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "createStyleSheet",
+				Description: "Creates a new special \"via-inspector\" stylesheet in the frame with given `frameId`.",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Identifier of the frame where \"via-inspector\" stylesheet should be created.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "force", Description: "If true, creates a new stylesheet for every call. If false, returns a stylesheet previously created by a call with force=false for the frame's document if it exists or creates a new stylesheet (default: false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "styleSheetId", Description: "Identifier of the created \"via-inspector\" stylesheet.", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables the CSS agent for the given page.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables the CSS agent for the given page. Clients should not assume that the CSS agent has been enabled until the result of this command is received.",
+			},
+			{
+				Name:        "forcePseudoState",
+				Description: "Ensures that the given node will have specified pseudo-classes whenever its style is computed by the browser.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "The element id for which to force the pseudo state.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "forcedPseudoClasses", Description: "Element pseudo classes to force when computing the element's style.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "forceStartingStyle",
+				Description: "Ensures that the given node is in its starting-style state.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "The element id for which to force the starting-style state.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "forced", Description: "Boolean indicating if this is on or off.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getBackgroundColors",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to get background colors for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "backgroundColors", Description: "The range of background colors behind this element, if it contains any visible text. If no visible text is present, this will be undefined. In the case of a flat background color, this will consist of simply that color. In the case of a gradient, this will consist of each of the color stops. For anything more complicated, this will be an empty array. Images will be ignored (as if the image had failed to load).", Type: "array", Items: "string", Optional: true, GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "computedFontSize", Description: "The computed font size for this node, as a CSS computed value string (e.g. '12px').", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "computedFontWeight", Description: "The computed font weight for this node, as a CSS computed value string (e.g. 'normal' or '100').", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getComputedStyleForNode",
+				Description: "Returns the computed style for a DOM node identified by `nodeId`.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "computedStyle", Description: "Computed style for the specified DOM node.", Type: "array", Items: "CSS.CSSComputedStyleProperty", GoType: reflect.TypeOf((*[]*CSSCSSComputedStyleProperty)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "resolveValues",
+				Description:  "Resolve the specified values in the context of the provided element. For example, a value of '1em' is evaluated according to the computed 'font-size' of the element and a value 'calc(1px + 2px)' will be resolved to '3px'. If the `propertyName` was specified the `values` are resolved as if they were property's declaration. If a value cannot be parsed according to the provided property syntax, the value is parsed using combined syntax as if null `propertyName` was provided. If the value cannot be resolved even then, return the provided value without any changes.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "values", Description: "Substitution functions (var()/env()/attr()) and cascade-dependent keywords (revert/revert-layer) do not work.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "nodeId", Description: "Id of the node in whose context the expression is evaluated", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "propertyName", Description: "Only longhands and custom property names are accepted.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "pseudoType", Description: "Pseudo element type, only works for pseudo elements that generate elements in the tree, such as ::before and ::after. enum values: first-line, first-letter, checkmark, before, after, picker-icon, marker, backdrop, column, selection, search-text, target-text, spelling-error, grammar-error, highlight, first-line-inherited, scroll-marker, scroll-marker-group, scroll-button, scrollbar, scrollbar-thumb, scrollbar-button, scrollbar-track, scrollbar-track-piece, scrollbar-corner, resizer, input-list-button, view-transition, view-transition-group, view-transition-image-pair, view-transition-group-children, view-transition-old, view-transition-new, placeholder, file-selector-button, details-content, picker, permission-icon", Type: "DOM.PseudoType", Optional: true, GoType: reflect.TypeOf((*DOMPseudoType)(nil)).Elem()},
+					{Name: "pseudoIdentifier", Description: "Pseudo element custom ident.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "results", Description: "", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getLonghandProperties",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "shorthandName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "value", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "longhandProperties", Description: "", Type: "array", Items: "CSS.CSSProperty", GoType: reflect.TypeOf((*[]*CSSCSSProperty)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getInlineStylesForNode",
+				Description: "Returns the styles defined inline (explicitly in the \"style\" attribute and implicitly, using DOM attributes) for a DOM node identified by `nodeId`.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "inlineStyle", Description: "Inline style for the specified DOM node.", Type: "CSS.CSSStyle", Optional: true, GoType: reflect.TypeOf((**CSSCSSStyle)(nil)).Elem()},
+					{Name: "attributesStyle", Description: "Attribute-defined element style (e.g. resulting from \"width=20 height=100%\").", Type: "CSS.CSSStyle", Optional: true, GoType: reflect.TypeOf((**CSSCSSStyle)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getAnimatedStylesForNode",
+				Description:  "Returns the styles coming from animations & transitions including the animation & transition styles coming from inheritance chain.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "animationStyles", Description: "Styles coming from animations.", Type: "array", Items: "CSS.CSSAnimationStyle", Optional: true, GoType: reflect.TypeOf((*[]*CSSCSSAnimationStyle)(nil)).Elem()},
+					{Name: "transitionsStyle", Description: "Style coming from transitions.", Type: "CSS.CSSStyle", Optional: true, GoType: reflect.TypeOf((**CSSCSSStyle)(nil)).Elem()},
+					{Name: "inherited", Description: "Inherited style entries for animationsStyle and transitionsStyle from the inheritance chain of the element.", Type: "array", Items: "CSS.InheritedAnimatedStyleEntry", Optional: true, GoType: reflect.TypeOf((*[]*CSSInheritedAnimatedStyleEntry)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getMatchedStylesForNode",
+				Description: "Returns requested styles for a DOM node identified by `nodeId`.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "inlineStyle", Description: "Inline style for the specified DOM node.", Type: "CSS.CSSStyle", Optional: true, GoType: reflect.TypeOf((**CSSCSSStyle)(nil)).Elem()},
+					{Name: "attributesStyle", Description: "Attribute-defined element style (e.g. resulting from \"width=20 height=100%\").", Type: "CSS.CSSStyle", Optional: true, GoType: reflect.TypeOf((**CSSCSSStyle)(nil)).Elem()},
+					{Name: "matchedCSSRules", Description: "CSS rules matching this node, from all applicable stylesheets.", Type: "array", Items: "CSS.RuleMatch", Optional: true, GoType: reflect.TypeOf((*[]*CSSRuleMatch)(nil)).Elem()},
+					{Name: "pseudoElements", Description: "Pseudo style matches for this node.", Type: "array", Items: "CSS.PseudoElementMatches", Optional: true, GoType: reflect.TypeOf((*[]*CSSPseudoElementMatches)(nil)).Elem()},
+					{Name: "inherited", Description: "A chain of inherited styles (from the immediate node parent up to the DOM tree root).", Type: "array", Items: "CSS.InheritedStyleEntry", Optional: true, GoType: reflect.TypeOf((*[]*CSSInheritedStyleEntry)(nil)).Elem()},
+					{Name: "inheritedPseudoElements", Description: "A chain of inherited pseudo element styles (from the immediate node parent up to the DOM tree root).", Type: "array", Items: "CSS.InheritedPseudoElementMatches", Optional: true, GoType: reflect.TypeOf((*[]*CSSInheritedPseudoElementMatches)(nil)).Elem()},
+					{Name: "cssKeyframesRules", Description: "A list of CSS keyframed animations matching this node.", Type: "array", Items: "CSS.CSSKeyframesRule", Optional: true, GoType: reflect.TypeOf((*[]*CSSCSSKeyframesRule)(nil)).Elem()},
+					{Name: "cssPositionTryRules", Description: "A list of CSS @position-try rules matching this node, based on the position-try-fallbacks property.", Type: "array", Items: "CSS.CSSPositionTryRule", Optional: true, GoType: reflect.TypeOf((*[]*CSSCSSPositionTryRule)(nil)).Elem()},
+					{Name: "activePositionFallbackIndex", Description: "Index of the active fallback in the applied position-try-fallback property, will not be set if there is no active position-try fallback.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "cssPropertyRules", Description: "A list of CSS at-property rules matching this node.", Type: "array", Items: "CSS.CSSPropertyRule", Optional: true, GoType: reflect.TypeOf((*[]*CSSCSSPropertyRule)(nil)).Elem()},
+					{Name: "cssPropertyRegistrations", Description: "A list of CSS property registrations matching this node.", Type: "array", Items: "CSS.CSSPropertyRegistration", Optional: true, GoType: reflect.TypeOf((*[]*CSSCSSPropertyRegistration)(nil)).Elem()},
+					{Name: "cssFontPaletteValuesRule", Description: "A font-palette-values rule matching this node.", Type: "CSS.CSSFontPaletteValuesRule", Optional: true, GoType: reflect.TypeOf((**CSSCSSFontPaletteValuesRule)(nil)).Elem()},
+					{Name: "parentLayoutNodeId", Description: "Id of the first parent element that does not have display: contents.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "cssFunctionRules", Description: "A list of CSS at-function rules referenced by styles of this node.", Type: "array", Items: "CSS.CSSFunctionRule", Optional: true, GoType: reflect.TypeOf((*[]*CSSCSSFunctionRule)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getEnvironmentVariables",
+				Description:  "Returns the values of the default UA-defined environment variables used in env()",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "environmentVariables", Description: "", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getMediaQueries",
+				Description: "Returns all media queries parsed by the rendering engine.",
+				Returns: []*ParamInfo{
+					{Name: "medias", Description: "", Type: "array", Items: "CSS.CSSMedia", GoType: reflect.TypeOf((*[]*CSSCSSMedia)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getPlatformFontsForNode",
+				Description: "Requests information about platform fonts which we used to render child TextNodes in the given node.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "fonts", Description: "Usage statistics for every employed platform font.", Type: "array", Items: "CSS.PlatformFontUsage", GoType: reflect.TypeOf((*[]*CSSPlatformFontUsage)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getStyleSheetText",
+				Description: "Returns the current textual content for a stylesheet.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "text", Description: "The stylesheet text.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getLayersForNode",
+				Description:  "Returns all layers parsed by the rendering engine for the tree scope of a node. Given a DOM element identified by nodeId, getLayersForNode returns the root layer for the nearest ancestor document or shadow root. The layer root contains the full layer tree for the tree scope and their ordering.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "rootLayer", Description: "", Type: "CSS.CSSLayerData", GoType: reflect.TypeOf((**CSSCSSLayerData)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getLocationForSelector",
+				Description:  "Given a CSS selector text and a style sheet ID, getLocationForSelector returns an array of locations of the CSS selector in the style sheet.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "selectorText", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "ranges", Description: "", Type: "array", Items: "CSS.SourceRange", GoType: reflect.TypeOf((*[]*CSSSourceRange)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "trackComputedStyleUpdatesForNode",
+				Description:  "Starts tracking the given node for the computed style updates and whenever the computed style is updated for node, it queues a `computedStyleUpdated` event with throttling. There can only be 1 node tracked for computed style updates so passing a new node id removes tracking from the previous node. Pass `undefined` to disable tracking.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "trackComputedStyleUpdates",
+				Description:  "Starts tracking the given computed styles for updates. The specified array of properties replaces the one previously specified. Pass empty array to disable tracking. Use takeComputedStyleUpdates to retrieve the list of nodes that had properties modified. The changes to computed style properties are only tracked for nodes pushed to the front-end by the DOM agent. If no changes to the tracked properties occur after the node has been pushed to the front-end, no updates will be issued for the node.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "propertiesToTrack", Description: "", Type: "array", Items: "CSS.CSSComputedStyleProperty", GoType: reflect.TypeOf((*[]*CSSCSSComputedStyleProperty)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "takeComputedStyleUpdates",
+				Description:  "Polls the next batch of computed style updates.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "nodeIds", Description: "The list of node Ids that have their tracked computed styles updated.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setEffectivePropertyValueForNode",
+				Description: "Find a rule with the given active property for the given node and set the new value for this property",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "The element id for which to set property.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "propertyName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "value", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setPropertyRulePropertyName",
+				Description: "Modifies the property rule property name.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "range", Description: "", Type: "CSS.SourceRange", GoType: reflect.TypeOf((**CSSSourceRange)(nil)).Elem()},
+					{Name: "propertyName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "propertyName", Description: "The resulting key text after modification.", Type: "CSS.Value", GoType: reflect.TypeOf((**CSSValue)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setKeyframeKey",
+				Description: "Modifies the keyframe rule key text.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "range", Description: "", Type: "CSS.SourceRange", GoType: reflect.TypeOf((**CSSSourceRange)(nil)).Elem()},
+					{Name: "keyText", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "keyText", Description: "The resulting key text after modification.", Type: "CSS.Value", GoType: reflect.TypeOf((**CSSValue)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setMediaText",
+				Description: "Modifies the rule selector.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "range", Description: "", Type: "CSS.SourceRange", GoType: reflect.TypeOf((**CSSSourceRange)(nil)).Elem()},
+					{Name: "text", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "media", Description: "The resulting CSS media rule after modification.", Type: "CSS.CSSMedia", GoType: reflect.TypeOf((**CSSCSSMedia)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setContainerQueryText",
+				Description:  "Modifies the expression of a container query.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "range", Description: "", Type: "CSS.SourceRange", GoType: reflect.TypeOf((**CSSSourceRange)(nil)).Elem()},
+					{Name: "text", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "containerQuery", Description: "The resulting CSS container query rule after modification.", Type: "CSS.CSSContainerQuery", GoType: reflect.TypeOf((**CSSCSSContainerQuery)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setSupportsText",
+				Description:  "Modifies the expression of a supports at-rule.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "range", Description: "", Type: "CSS.SourceRange", GoType: reflect.TypeOf((**CSSSourceRange)(nil)).Elem()},
+					{Name: "text", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "supports", Description: "The resulting CSS Supports rule after modification.", Type: "CSS.CSSSupports", GoType: reflect.TypeOf((**CSSCSSSupports)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setScopeText",
+				Description:  "Modifies the expression of a scope at-rule.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "range", Description: "", Type: "CSS.SourceRange", GoType: reflect.TypeOf((**CSSSourceRange)(nil)).Elem()},
+					{Name: "text", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "scope", Description: "The resulting CSS Scope rule after modification.", Type: "CSS.CSSScope", GoType: reflect.TypeOf((**CSSCSSScope)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setRuleSelector",
+				Description: "Modifies the rule selector.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "range", Description: "", Type: "CSS.SourceRange", GoType: reflect.TypeOf((**CSSSourceRange)(nil)).Elem()},
+					{Name: "selector", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "selectorList", Description: "The resulting selector list after modification.", Type: "CSS.SelectorList", GoType: reflect.TypeOf((**CSSSelectorList)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setStyleSheetText",
+				Description: "Sets the new stylesheet text.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "text", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "sourceMapURL", Description: "URL of source map associated with script (if any).", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setStyleTexts",
+				Description: "Applies specified style edits one after another in the given order.",
+				Params: []*ParamInfo{
+					{Name: "edits", Description: "", Type: "array", Items: "CSS.StyleDeclarationEdit", GoType: reflect.TypeOf((*[]*CSSStyleDeclarationEdit)(nil)).Elem()},
+					{Name: "nodeForPropertySyntaxValidation", Description: "NodeId for the DOM node in whose context custom property declarations for registered properties should be validated. If omitted, declarations in the new rule text can only be validated statically, which may produce incorrect results if the declaration contains a var() for example.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "styles", Description: "The resulting styles after modification.", Type: "array", Items: "CSS.CSSStyle", GoType: reflect.TypeOf((*[]*CSSCSSStyle)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "startRuleUsageTracking",
+				Description: "Enables the selector recording.",
+			},
+			{
+				Name:        "stopRuleUsageTracking",
+				Description: "Stop tracking rule usage and return the list of rules that were used since last call to `takeCoverageDelta` (or since start of coverage instrumentation).",
+				Returns: []*ParamInfo{
+					{Name: "ruleUsage", Description: "", Type: "array", Items: "CSS.RuleUsage", GoType: reflect.TypeOf((*[]*CSSRuleUsage)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "takeCoverageDelta",
+				Description: "Obtain list of rules that became used since last call to this method (or since start of coverage instrumentation).",
+				Returns: []*ParamInfo{
+					{Name: "coverage", Description: "", Type: "array", Items: "CSS.RuleUsage", GoType: reflect.TypeOf((*[]*CSSRuleUsage)(nil)).Elem()},
+					{Name: "timestamp", Description: "Monotonically increasing time, in seconds.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setLocalFontsEnabled",
+				Description:  "Enables/disables rendering of local CSS fonts (enabled by default).",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether rendering of local fonts is enabled.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "fontsUpdated",
+				Description: "Fires whenever a web font is updated.  A non-empty font parameter indicates a successfully loaded web font.",
+				Params: []*ParamInfo{
+					{Name: "font", Description: "The web font that has loaded.", Type: "CSS.FontFace", Optional: true, GoType: reflect.TypeOf((**CSSFontFace)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*CSSFontsUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "mediaQueryResultChanged",
+				Description: "Fires whenever a MediaQuery result changes (for example, after a browser window has been resized.) The current implementation considers only viewport-dependent media features.",
+				Type:        reflect.TypeOf((*CSSMediaQueryResultChangedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "styleSheetAdded",
+				Description: "Fired whenever an active document stylesheet is added.",
+				Params: []*ParamInfo{
+					{Name: "header", Description: "Added stylesheet metainfo.", Type: "CSS.CSSStyleSheetHeader", GoType: reflect.TypeOf((**CSSCSSStyleSheetHeader)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*CSSStyleSheetAddedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "styleSheetChanged",
+				Description: "Fired whenever a stylesheet is changed as a result of the client operation.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*CSSStyleSheetChangedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "styleSheetRemoved",
+				Description: "Fired whenever an active document stylesheet is removed.",
+				Params: []*ParamInfo{
+					{Name: "styleSheetId", Description: "Identifier of the removed stylesheet.", Type: "CSS.StyleSheetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*CSSStyleSheetRemovedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "computedStyleUpdated",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "The node id that has updated computed styles.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*CSSComputedStyleUpdatedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "CacheStorage",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"Storage"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "deleteCache",
+				Description: "Deletes a cache.",
+				Params: []*ParamInfo{
+					{Name: "cacheId", Description: "Id of cache for deletion.", Type: "CacheStorage.CacheId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "deleteEntry",
+				Description: "Deletes a cache entry.",
+				Params: []*ParamInfo{
+					{Name: "cacheId", Description: "Id of cache where the entry will be deleted.", Type: "CacheStorage.CacheId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "request", Description: "URL spec of the request.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "requestCacheNames",
+				Description: "Requests cache names.",
+				Params: []*ParamInfo{
+					{Name: "securityOrigin", Description: "At least and at most one of securityOrigin, storageKey, storageBucket must be specified. Security origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageBucket", Description: "Storage bucket. If not specified, it uses the default bucket.", Type: "Storage.StorageBucket", Optional: true, GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "caches", Description: "Caches for the security origin.", Type: "array", Items: "CacheStorage.Cache", GoType: reflect.TypeOf((*[]*CacheStorageCache)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "requestCachedResponse",
+				Description: "Fetches cache entry.",
+				Params: []*ParamInfo{
+					{Name: "cacheId", Description: "Id of cache that contains the entry.", Type: "CacheStorage.CacheId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "requestURL", Description: "URL spec of the request.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "requestHeaders", Description: "headers of the request.", Type: "array", Items: "CacheStorage.Header", GoType: reflect.TypeOf((*[]*CacheStorageHeader)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "response", Description: "Response read from the cache.", Type: "CacheStorage.CachedResponse", GoType: reflect.TypeOf((**CacheStorageCachedResponse)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "requestEntries",
+				Description: "Requests data from cache.",
+				Params: []*ParamInfo{
+					{Name: "cacheId", Description: "ID of cache to get entries from.", Type: "CacheStorage.CacheId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "skipCount", Description: "Number of records to skip.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pageSize", Description: "Number of records to fetch.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pathFilter", Description: "If present, only return the entries containing this substring in the path", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "cacheDataEntries", Description: "Array of object store data entries.", Type: "array", Items: "CacheStorage.DataEntry", GoType: reflect.TypeOf((*[]*CacheStorageDataEntry)(nil)).Elem()},
+					{Name: "returnCount", Description: "Count of returned entries from this storage. If pathFilter is empty, it is the count of all entries from this storage.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "Cast",
+		Description:  "A domain for interacting with Cast, Presentation API, and Remote Playback API functionalities.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "Starts observing for sinks that can be used for tab mirroring, and if set, sinks compatible with |presentationUrl| as well. When sinks are found, a |sinksUpdated| event is fired. Also starts observing for issue messages. When an issue is added or removed, an |issueUpdated| event is fired.",
+				Params: []*ParamInfo{
+					{Name: "presentationUrl", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Stops observing for sinks and issues.",
+			},
+			{
+				Name:        "setSinkToUse",
+				Description: "Sets a sink to be used when the web page requests the browser to choose a sink via Presentation API, Remote Playback API, or Cast SDK.",
+				Params: []*ParamInfo{
+					{Name: "sinkName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "startDesktopMirroring",
+				Description: "Starts mirroring the desktop to the sink.",
+				Params: []*ParamInfo{
+					{Name: "sinkName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "startTabMirroring",
+				Description: "Starts mirroring the tab to the sink.",
+				Params: []*ParamInfo{
+					{Name: "sinkName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopCasting",
+				Description: "Stops the active Cast session on the sink.",
+				Params: []*ParamInfo{
+					{Name: "sinkName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "sinksUpdated",
+				Description: "This is fired whenever the list of available sinks changes. A sink is a device or a software surface that you can cast to.",
+				Params: []*ParamInfo{
+					{Name: "sinks", Description: "", Type: "array", Items: "Cast.Sink", GoType: reflect.TypeOf((*[]*CastSink)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*CastSinksUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "issueUpdated",
+				Description: "This is fired whenever the outstanding issue/error message changes. |issueMessage| is empty if there is no issue.",
+				Params: []*ParamInfo{
+					{Name: "issueMessage", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*CastIssueUpdatedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "DOM",
+		Description:  "This domain exposes DOM read/write operations. Each DOM Node is represented with its mirror object that has an `id`. This `id` can be used to get additional information on the Node, resolve it into the JavaScript object wrapper, etc. It is important that client receives DOM events only for the nodes that are known to the client. Backend keeps track of the nodes that were sent to the client and never sends the same node twice. It is client's responsibility to collect information about the nodes that were sent to the client. Note that `iframe` owner elements will return corresponding document elements as their child nodes.",
+		Dependencies: []string{"Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:         "collectClassNamesFromSubtree",
+				Description:  "Collects class names for the node with given id and all of it's child nodes.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to collect class names.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "classNames", Description: "Class name list.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "copyTo",
+				Description:  "Creates a deep copy of the specified node and places it into the target container before the given anchor.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to copy.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "targetNodeId", Description: "Id of the element to drop the copy into.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "insertBeforeNodeId", Description: "Drop the copy before this node (if absent, the copy becomes the last child of `targetNodeId`).", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node clone.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "describeNode",
+				Description: "Describes node given its id, does not require domain to be enabled. Does not start tracking any objects, can be used for automation.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "depth", Description: "The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pierce", Description: "Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "node", Description: "Node description.", Type: "DOM.Node", GoType: reflect.TypeOf((**DOMNode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "scrollIntoViewIfNeeded",
+				Description: "Scrolls the specified rect of the given node into view if not already visible. Note: exactly one between nodeId, backendNodeId and objectId should be passed to identify the node.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "rect", Description: "The rect to be scrolled into view, relative to the node's border box, in CSS pixels. When omitted, center of the node will be used, similar to Element.scrollIntoView.", Type: "DOM.Rect", Optional: true, GoType: reflect.TypeOf((**DOMRect)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables DOM agent for the given page.",
+			},
+			{
+				Name:         "discardSearchResults",
+				Description:  "Discards search results from the session with the given id. `getSearchResults` should no longer be called for that search.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "searchId", Description: "Unique search session identifier.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "enable",
+				Description: "Enables DOM agent for the given page.",
+				Params: []*ParamInfo{
+					{Name: "includeWhitespace", Description: "Whether to include whitespaces in the children array of returned Nodes.", Type: "string", Optional: true, GoType: reflect.TypeOf((*DOMEnableIncludeWhitespace)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "focus",
+				Description: "Focuses the given element.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getAttributes",
+				Description: "Returns attributes for the specified node.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to retrieve attributes for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "attributes", Description: "An interleaved array of node attribute names and values.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getBoxModel",
+				Description: "Returns boxes for the given node.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "model", Description: "Box model for the node.", Type: "DOM.BoxModel", GoType: reflect.TypeOf((**DOMBoxModel)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getContentQuads",
+				Description:  "Returns quads that describe node position on the page. This method might return multiple quads for inline nodes.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "quads", Description: "Quads that describe node layout relative to viewport.", Type: "array", Items: "DOM.Quad", GoType: reflect.TypeOf((*[]float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getDocument",
+				Description: "Returns the root DOM node (and optionally the subtree) to the caller. Implicitly enables the DOM domain events for the current target.",
+				Params: []*ParamInfo{
+					{Name: "depth", Description: "The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pierce", Description: "Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "root", Description: "Resulting node.", Type: "DOM.Node", GoType: reflect.TypeOf((**DOMNode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getFlattenedDocument",
+				Description: "Returns the root DOM node (and optionally the subtree) to the caller. Deprecated, as it is not designed to work well with the rest of the DOM agent. Use DOMSnapshot.captureSnapshot instead.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "depth", Description: "The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pierce", Description: "Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodes", Description: "Resulting node.", Type: "array", Items: "DOM.Node", GoType: reflect.TypeOf((*[]*DOMNode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getNodesForSubtreeByStyle",
+				Description:  "Finds nodes with a given computed style in a subtree.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Node ID pointing to the root of a subtree.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "computedStyles", Description: "The style to filter nodes by (includes nodes if any of properties matches).", Type: "array", Items: "DOM.CSSComputedStyleProperty", GoType: reflect.TypeOf((*[]*DOMCSSComputedStyleProperty)(nil)).Elem()},
+					{Name: "pierce", Description: "Whether or not iframes and shadow roots in the same target should be traversed when returning the results (default is false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeIds", Description: "Resulting nodes.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getNodeForLocation",
+				Description: "Returns node id at given location. Depending on whether DOM domain is enabled, nodeId is either returned or not.",
+				Params: []*ParamInfo{
+					{Name: "x", Description: "X coordinate.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "y", Description: "Y coordinate.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "includeUserAgentShadowDOM", Description: "False to skip to the nearest non-UA shadow root ancestor (default: false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "ignorePointerEventsNone", Description: "Whether to ignore pointer-events: none on elements and hit test them.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "backendNodeId", Description: "Resulting node.", Type: "DOM.BackendNodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "frameId", Description: "Frame this node belongs to.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "nodeId", Description: "Id of the node at given coordinates, only when enabled and requested document.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getOuterHTML",
+				Description: "Returns node's HTML markup.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "includeShadowDOM", Description: "Include all shadow roots. Equals to false if not specified.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "outerHTML", Description: "Outer HTML markup.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getRelayoutBoundary",
+				Description:  "Returns the id of the nearest ancestor that is a relayout boundary.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "Relayout boundary node id for the given node.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getSearchResults",
+				Description:  "Returns search results from given `fromIndex` to given `toIndex` from the search with the given identifier.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "searchId", Description: "Unique search session identifier.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "fromIndex", Description: "Start index of the search result to be returned.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "toIndex", Description: "End index of the search result to be returned.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeIds", Description: "Ids of the search result nodes.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "hideHighlight",
+				Description: "Hides any highlight.",
+			},
+			{
+				Name:        "highlightNode",
+				Description: "Highlights DOM node.",
+			},
+			{
+				Name:        "highlightRect",
+				Description: "Highlights given rectangle.",
+			},
+			{
+				Name:         "markUndoableState",
+				Description:  "Marks last undoable state.",
+				Experimental: true,
+			},
+			{
+				Name:        "moveTo",
+				Description: "Moves node into the new container, places it before the given anchor.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to move.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "targetNodeId", Description: "Id of the element to drop the moved node into.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "insertBeforeNodeId", Description: "Drop node before this one (if absent, the moved node becomes the last child of `targetNodeId`).", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "New id of the moved node.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "performSearch",
+				Description:  "Searches for a given string in the DOM tree. Use `getSearchResults` to access search results or `cancelSearch` to end this search session.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "query", Description: "Plain text or query selector or XPath search query.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "includeUserAgentShadowDOM", Description: "True to search in user agent shadow DOM.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "searchId", Description: "Unique search session identifier.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "resultCount", Description: "Number of search results.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "pushNodeByPathToFrontend",
+				Description:  "Requests that the node is sent to the caller given its path. // FIXME, use XPath",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "path", Description: "Path to node in the proprietary format.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node for given path.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "pushNodesByBackendIdsToFrontend",
+				Description:  "Requests that a batch of nodes is sent to the caller given their backend node ids.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "backendNodeIds", Description: "The array of backend node ids.", Type: "array", Items: "DOM.BackendNodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeIds", Description: "The array of ids of pushed nodes that correspond to the backend ids specified in backendNodeIds.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "querySelector",
+				Description: "Executes `querySelector` on a given node.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to query upon.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "selector", Description: "Selector string.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "Query selector result.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "querySelectorAll",
+				Description: "Executes `querySelectorAll` on a given node.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to query upon.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "selector", Description: "Selector string.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeIds", Description: "Query selector result.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getTopLayerElements",
+				Description:  "Returns NodeIds of current top layer elements. Top layer is rendered closest to the user within a viewport, therefore its elements always appear on top of all other content.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "nodeIds", Description: "NodeIds of top layer elements", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getElementByRelation",
+				Description:  "Returns the NodeId of the matched element according to certain relations.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node from which to query the relation.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "relation", Description: "Type of relation to get.", Type: "string", GoType: reflect.TypeOf((*DOMGetElementByRelationRelation)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "NodeId of the element matching the queried relation.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "redo",
+				Description:  "Re-does the last undone action.",
+				Experimental: true,
+			},
+			{
+				Name:        "removeAttribute",
+				Description: "Removes attribute with given name from an element with given id.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the element to remove attribute from.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "name", Description: "Name of the attribute to remove.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeNode",
+				Description: "Removes node with given id.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to remove.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "requestChildNodes",
+				Description: "Requests that children of the node with given id are returned to the caller in form of `setChildNodes` events where not only immediate children are retrieved, but all children down to the specified depth.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to get children for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "depth", Description: "The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pierce", Description: "Whether or not iframes and shadow roots should be traversed when returning the sub-tree (default is false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "requestNode",
+				Description: "Requests that the node is sent to the caller given the JavaScript node object reference. All nodes that form the path from the node to the root are also sent to the client as a series of `setChildNodes` notifications.",
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "JavaScript object id to convert into node.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "Node id for given object.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "resolveNode",
+				Description: "Resolves the JavaScript node object for a given NodeId or BackendNodeId.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to resolve.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Backend identifier of the node to resolve.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectGroup", Description: "Symbolic group name that can be used to release multiple objects.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "executionContextId", Description: "Execution context in which to resolve the node.", Type: "Runtime.ExecutionContextId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "object", Description: "JavaScript object wrapper for given node.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setAttributeValue",
+				Description: "Sets attribute for an element with given id.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the element to set attribute for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "name", Description: "Attribute name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "value", Description: "Attribute value.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setAttributesAsText",
+				Description: "Sets attributes on element with given id. This method is useful when user edits some existing attribute value and types in several attribute name/value pairs.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the element to set attributes for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "text", Description: "Text with a number of attributes. Will parse this text using HTML parser.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "name", Description: "Attribute name to replace with new attributes derived from text in case text parsed successfully.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setFileInputFiles",
+				Description: "Sets files for the given file input element.",
+				Params: []*ParamInfo{
+					{Name: "files", Description: "Array of file paths to set.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "nodeId", Description: "Identifier of the node.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setNodeStackTracesEnabled",
+				Description:  "Sets if stack traces should be captured for Nodes. See `Node.getNodeStackTraces`. Default is disabled.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enable", Description: "Enable or disable.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getNodeStackTraces",
+				Description:  "Gets stack traces associated with a Node. As of now, only provides stack trace for Node creation.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to get stack traces for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "creation", Description: "Creation stack trace, if available.", Type: "Runtime.StackTrace", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getFileInfo",
+				Description:  "Returns file information for the given File wrapper.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "JavaScript object id of the node wrapper.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "path", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getDetachedDomNodes",
+				Description:  "Returns list of detached nodes",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "detachedNodes", Description: "The list of detached nodes", Type: "array", Items: "DOM.DetachedElementInfo", GoType: reflect.TypeOf((*[]*DOMDetachedElementInfo)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setInspectedNode",
+				Description:  "Enables console to refer to the node with given id via $x (see Command Line API for more details $x functions).",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "DOM node id to be accessible by means of $x command line API.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setNodeName",
+				Description: "Sets node name for a node with given id.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to set name for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "name", Description: "New node's name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "New node's id.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setNodeValue",
+				Description: "Sets node value for a node with given id.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to set value for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "value", Description: "New node's value.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setOuterHTML",
+				Description: "Sets node HTML markup, returns new node id.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to set markup for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "outerHTML", Description: "Outer HTML markup to set.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "undo",
+				Description:  "Undoes the last performed action.",
+				Experimental: true,
+			},
+			{
+				Name:         "getFrameOwner",
+				Description:  "Returns iframe node that owns iframe with the given domain.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "backendNodeId", Description: "Resulting node.", Type: "DOM.BackendNodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "nodeId", Description: "Id of the node at given coordinates, only when enabled and requested document.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getContainerForNode",
+				Description:  "Returns the query container of the given node based on container query conditions: containerName, physical and logical axes, and whether it queries scroll-state or anchored elements. If no axes are provided and queriesScrollState is false, the style container is returned, which is the direct parent or the closest element with a matching container-name.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "containerName", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "physicalAxes", Description: " enum values: Horizontal, Vertical, Both", Type: "DOM.PhysicalAxes", Optional: true, GoType: reflect.TypeOf((*DOMPhysicalAxes)(nil)).Elem()},
+					{Name: "logicalAxes", Description: " enum values: Inline, Block, Both", Type: "DOM.LogicalAxes", Optional: true, GoType: reflect.TypeOf((*DOMLogicalAxes)(nil)).Elem()},
+					{Name: "queriesScrollState", Description: "", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "queriesAnchored", Description: "", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "The container node for the given node, or null if not found.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getQueryingDescendantsForContainer",
+				Description:  "Returns the descendants of a container query container that have container queries against this container.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the container node to find querying descendants from.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeIds", Description: "Descendant nodes with container queries against the given container.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getAnchorElement",
+				Description:  "Returns the target anchor element of the given anchor query according to https://www.w3.org/TR/css-anchor-position-1/#target.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the positioned element from which to find the anchor.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "anchorSpecifier", Description: "An optional anchor specifier, as defined in https://www.w3.org/TR/css-anchor-position-1/#anchor-specifier. If not provided, it will return the implicit anchor element for the given positioned element.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeId", Description: "The anchor element of the given anchor query.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "forceShowPopover",
+				Description:  "When enabling, this API force-opens the popover identified by nodeId and keeps it open until disabled.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the popover HTMLElement", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "enable", Description: "If true, opens the popover and keeps it open. If false, closes the popover if it was previously force-opened.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "nodeIds", Description: "List of popovers that were closed in order to respect popover stacking order.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "attributeModified",
+				Description: "Fired when `Element`'s attribute is modified.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node that has changed.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "name", Description: "Attribute name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "value", Description: "Attribute value.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMAttributeModifiedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "attributeRemoved",
+				Description: "Fired when `Element`'s attribute is removed.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node that has changed.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "name", Description: "A ttribute name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMAttributeRemovedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "characterDataModified",
+				Description: "Mirrors `DOMCharacterDataModified` event.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node that has changed.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "characterData", Description: "New text value.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMCharacterDataModifiedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "childNodeCountUpdated",
+				Description: "Fired when `Container`'s child node count has changed.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node that has changed.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "childNodeCount", Description: "New node count.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMChildNodeCountUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "childNodeInserted",
+				Description: "Mirrors `DOMNodeInserted` event.",
+				Params: []*ParamInfo{
+					{Name: "parentNodeId", Description: "Id of the node that has changed.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "previousNodeId", Description: "Id of the previous sibling.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "node", Description: "Inserted node data.", Type: "DOM.Node", GoType: reflect.TypeOf((**DOMNode)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMChildNodeInsertedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "childNodeRemoved",
+				Description: "Mirrors `DOMNodeRemoved` event.",
+				Params: []*ParamInfo{
+					{Name: "parentNodeId", Description: "Parent id.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "nodeId", Description: "Id of the node that has been removed.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMChildNodeRemovedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "distributedNodesUpdated",
+				Description:  "Called when distribution is changed.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "insertionPointId", Description: "Insertion point where distributed nodes were updated.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "distributedNodes", Description: "Distributed nodes for given insertion point.", Type: "array", Items: "DOM.BackendNode", GoType: reflect.TypeOf((*[]*DOMBackendNode)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMDistributedNodesUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "documentUpdated",
+				Description: "Fired when `Document` has been totally updated. Node ids are no longer valid.",
+				Type:        reflect.TypeOf((*DOMDocumentUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "inlineStyleInvalidated",
+				Description:  "Fired when `Element`'s inline style is modified via a CSS property modification.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeIds", Description: "Ids of the nodes for which the inline styles have been invalidated.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMInlineStyleInvalidatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "pseudoElementAdded",
+				Description:  "Called when a pseudo element is added to an element.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "parentId", Description: "Pseudo element's parent element id.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pseudoElement", Description: "The added pseudo element.", Type: "DOM.Node", GoType: reflect.TypeOf((**DOMNode)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMPseudoElementAddedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "topLayerElementsUpdated",
+				Description:  "Called when top layer elements are changed.",
+				Experimental: true,
+				Type:         reflect.TypeOf((*DOMTopLayerElementsUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "scrollableFlagUpdated",
+				Description:  "Fired when a node's scrollability state changes.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "The id of the node.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "isScrollable", Description: "If the node is scrollable.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMScrollableFlagUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "pseudoElementRemoved",
+				Description:  "Called when a pseudo element is removed from an element.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "parentId", Description: "Pseudo element's parent element id.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pseudoElementId", Description: "The removed pseudo element id.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMPseudoElementRemovedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "setChildNodes",
+				Description: "Fired when backend wants to provide client with the missing DOM structure. This happens upon most of the calls requesting node ids.",
+				Params: []*ParamInfo{
+					{Name: "parentId", Description: "Parent node id to populate with children.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "nodes", Description: "Child nodes array.", Type: "array", Items: "DOM.Node", GoType: reflect.TypeOf((*[]*DOMNode)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMSetChildNodesEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "shadowRootPopped",
+				Description:  "Called when shadow root is popped from the element.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "hostId", Description: "Host element id.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "rootId", Description: "Shadow root id.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMShadowRootPoppedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "shadowRootPushed",
+				Description:  "Called when shadow root is pushed into the element.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "hostId", Description: "Host element id.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "root", Description: "Shadow root.", Type: "DOM.Node", GoType: reflect.TypeOf((**DOMNode)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMShadowRootPushedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "DOMDebugger",
+		Description:  "DOM debugging allows setting breakpoints on particular DOM operations and events. JavaScript execution will stop on these operations as if there was a regular breakpoint set.",
+		Dependencies: []string{"DOM", "Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "getEventListeners",
+				Description: "Returns event listeners of the given object.",
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "Identifier of the object to return listeners for.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "depth", Description: "The maximum depth at which Node children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pierce", Description: "Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false). Reports listeners for all contexts if pierce is enabled.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "listeners", Description: "Array of relevant listeners.", Type: "array", Items: "DOMDebugger.EventListener", GoType: reflect.TypeOf((*[]*DOMDebuggerEventListener)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeDOMBreakpoint",
+				Description: "Removes DOM breakpoint that was set using `setDOMBreakpoint`.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node to remove breakpoint from.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "type", Description: "Type of the breakpoint to remove. enum values: subtree-modified, attribute-modified, node-removed", Type: "DOMDebugger.DOMBreakpointType", GoType: reflect.TypeOf((*DOMDebuggerDOMBreakpointType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeEventListenerBreakpoint",
+				Description: "Removes breakpoint on particular DOM event.",
+				Params: []*ParamInfo{
+					{Name: "eventName", Description: "Event name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "targetName", Description: "EventTarget interface name.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "removeInstrumentationBreakpoint",
+				Description:  "Removes breakpoint on particular native event.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "eventName", Description: "Instrumentation name to stop on.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeXHRBreakpoint",
+				Description: "Removes breakpoint from XMLHttpRequest.",
+				Params: []*ParamInfo{
+					{Name: "url", Description: "Resource URL substring.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setBreakOnCSPViolation",
+				Description:  "Sets breakpoint on particular CSP violations.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "violationTypes", Description: "CSP Violations to stop upon. enum values: trustedtype-sink-violation, trustedtype-policy-violation", Type: "array", Items: "DOMDebugger.CSPViolationType", GoType: reflect.TypeOf((*[]DOMDebuggerCSPViolationType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setDOMBreakpoint",
+				Description: "Sets breakpoint on particular operation with DOM.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Identifier of the node to set breakpoint on.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "type", Description: "Type of the operation to stop upon. enum values: subtree-modified, attribute-modified, node-removed", Type: "DOMDebugger.DOMBreakpointType", GoType: reflect.TypeOf((*DOMDebuggerDOMBreakpointType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setEventListenerBreakpoint",
+				Description: "Sets breakpoint on particular DOM event.",
+				Params: []*ParamInfo{
+					{Name: "eventName", Description: "DOM Event name to stop on (any DOM event will do).", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "targetName", Description: "EventTarget interface name to stop on. If equal to `\"*\"` or not provided, will stop on any EventTarget.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setInstrumentationBreakpoint",
+				Description:  "Sets breakpoint on particular native event.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "eventName", Description: "Instrumentation name to stop on.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setXHRBreakpoint",
+				Description: "Sets breakpoint on XMLHttpRequest.",
+				Params: []*ParamInfo{
+					{Name: "url", Description: "Resource URL substring. All XHRs having this substring in the URL will get stopped upon.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "EventBreakpoints",
+		Description:  "EventBreakpoints permits setting JavaScript breakpoints on operations and events occurring in native code invoked from JavaScript. Once breakpoint is hit, it is reported through Debugger domain, similarly to regular breakpoints being hit.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "setInstrumentationBreakpoint",
+				Description: "Sets breakpoint on particular native event.",
+				Params: []*ParamInfo{
+					{Name: "eventName", Description: "Instrumentation name to stop on.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeInstrumentationBreakpoint",
+				Description: "Removes breakpoint on particular native event.",
+				Params: []*ParamInfo{
+					{Name: "eventName", Description: "Instrumentation name to stop on.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Removes all breakpoints",
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "DOMSnapshot",
+		Description:  "This domain facilitates obtaining document snapshots with DOM, layout, and style information.",
+		Experimental: true,
+		Dependencies: []string{"CSS", "DOM", "DOMDebugger", "Page"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "Disables DOM snapshot agent for the given page.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables DOM snapshot agent for the given page.",
+			},
+			{
+				Name:        "getSnapshot",
+				Description: "Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "computedStyleWhitelist", Description: "Whitelist of computed styles to return.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "includeEventListeners", Description: "Whether or not to retrieve details of DOM listeners (default false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "includePaintOrder", Description: "Whether to determine and include the paint order index of LayoutTreeNodes (default false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "includeUserAgentShadowTree", Description: "Whether to include UA shadow tree in the snapshot (default false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "domNodes", Description: "The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document.", Type: "array", Items: "DOMSnapshot.DOMNode", GoType: reflect.TypeOf((*[]*DOMSnapshotDOMNode)(nil)).Elem()},
+					{Name: "layoutTreeNodes", Description: "The nodes in the layout tree.", Type: "array", Items: "DOMSnapshot.LayoutTreeNode", GoType: reflect.TypeOf((*[]*DOMSnapshotLayoutTreeNode)(nil)).Elem()},
+					{Name: "computedStyles", Description: "Whitelisted ComputedStyle properties for each node in the layout tree.", Type: "array", Items: "DOMSnapshot.ComputedStyle", GoType: reflect.TypeOf((*[]*DOMSnapshotComputedStyle)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "captureSnapshot",
+				Description: "Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.",
+				Params: []*ParamInfo{
+					{Name: "computedStyles", Description: "Whitelist of computed styles to return.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "includePaintOrder", Description: "Whether to include layout object paint orders into the snapshot.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "includeDOMRects", Description: "Whether to include DOM rectangles (offsetRects, clientRects, scrollRects) into the snapshot", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "includeBlendedBackgroundColors", Description: "Whether to include blended background colors in the snapshot (default: false). Blended background color is achieved by blending background colors of all elements that overlap with the current element.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "includeTextColorOpacities", Description: "Whether to include text color opacity in the snapshot (default: false). An element might have the opacity property set that affects the text color of the element. The final text color opacity is computed based on the opacity of all overlapping elements.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "documents", Description: "The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document.", Type: "array", Items: "DOMSnapshot.DocumentSnapshot", GoType: reflect.TypeOf((*[]*DOMSnapshotDocumentSnapshot)(nil)).Elem()},
+					{Name: "strings", Description: "Shared string table that all string properties refer to with indexes.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "DOMStorage",
+		Description:  "Query and modify DOM storage.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "clear",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "storageId", Description: "", Type: "DOMStorage.StorageId", GoType: reflect.TypeOf((**DOMStorageStorageId)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables storage tracking, prevents storage events from being sent to the client.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables storage tracking, storage events will now be delivered to the client.",
+			},
+			{
+				Name:        "getDOMStorageItems",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "storageId", Description: "", Type: "DOMStorage.StorageId", GoType: reflect.TypeOf((**DOMStorageStorageId)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "entries", Description: "", Type: "array", Items: "DOMStorage.Item", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeDOMStorageItem",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "storageId", Description: "", Type: "DOMStorage.StorageId", GoType: reflect.TypeOf((**DOMStorageStorageId)(nil)).Elem()},
+					{Name: "key", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setDOMStorageItem",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "storageId", Description: "", Type: "DOMStorage.StorageId", GoType: reflect.TypeOf((**DOMStorageStorageId)(nil)).Elem()},
+					{Name: "key", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "value", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "domStorageItemAdded",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "storageId", Description: "", Type: "DOMStorage.StorageId", GoType: reflect.TypeOf((**DOMStorageStorageId)(nil)).Elem()},
+					{Name: "key", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "newValue", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMStorageDomStorageItemAddedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "domStorageItemRemoved",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "storageId", Description: "", Type: "DOMStorage.StorageId", GoType: reflect.TypeOf((**DOMStorageStorageId)(nil)).Elem()},
+					{Name: "key", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMStorageDomStorageItemRemovedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "domStorageItemUpdated",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "storageId", Description: "", Type: "DOMStorage.StorageId", GoType: reflect.TypeOf((**DOMStorageStorageId)(nil)).Elem()},
+					{Name: "key", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "oldValue", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "newValue", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMStorageDomStorageItemUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "domStorageItemsCleared",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "storageId", Description: "", Type: "DOMStorage.StorageId", GoType: reflect.TypeOf((**DOMStorageStorageId)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DOMStorageDomStorageItemsClearedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "DeviceOrientation",
+		Description:  "",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "clearDeviceOrientationOverride",
+				Description: "Clears the overridden Device Orientation.",
+			},
+			{
+				Name:        "setDeviceOrientationOverride",
+				Description: "Overrides the Device Orientation.",
+				Params: []*ParamInfo{
+					{Name: "alpha", Description: "Mock alpha", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "beta", Description: "Mock beta", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "gamma", Description: "Mock gamma", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "Emulation",
+		Description:  "This domain emulates different environments for the page.",
+		Dependencies: []string{"DOM", "Page", "Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "canEmulate",
+				Description: "Tells whether emulation is supported.",
+				Deprecated:  true,
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "True if emulation is supported.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearDeviceMetricsOverride",
+				Description: "Clears the overridden device metrics.",
+			},
+			{
+				Name:        "clearGeolocationOverride",
+				Description: "Clears the overridden Geolocation Position and Error.",
+			},
+			{
+				Name:         "resetPageScaleFactor",
+				Description:  "Requests that page scale factor is reset to initial values.",
+				Experimental: true,
+			},
+			{
+				Name:         "setFocusEmulationEnabled",
+				Description:  "Enables or disables simulating a focused and active page.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether to enable to disable focus emulation.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setAutoDarkModeOverride",
+				Description:  "Automatically render all web contents using a dark theme.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether to enable or disable automatic dark mode. If not specified, any existing override will be cleared.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setCPUThrottlingRate",
+				Description: "Enables CPU throttling to emulate slow CPUs.",
+				Params: []*ParamInfo{
+					{Name: "rate", Description: "Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setDefaultBackgroundColorOverride",
+				Description: "Sets or clears an override of the default background color of the frame. This override is used if the content does not specify one.",
+				Params: []*ParamInfo{
+					{Name: "color", Description: "RGBA of the default background color. If not specified, any existing override will be cleared.", Type: "DOM.RGBA", Optional: true, GoType: reflect.TypeOf((**DOMRGBA)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setSafeAreaInsetsOverride",
+				Description:  "Overrides the values for env(safe-area-inset-*) and env(safe-area-max-inset-*). Unset values will cause the respective variables to be undefined, even if previously overridden.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "insets", Description: "", Type: "Emulation.SafeAreaInsets", GoType: reflect.TypeOf((**EmulationSafeAreaInsets)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setDeviceMetricsOverride",
+				Description: "Overrides the values of device screen dimensions (window.screen.width, window.screen.height, window.innerWidth, window.innerHeight, and \"device-width\"/\"device-height\"-related CSS media query results).",
+				Params: []*ParamInfo{
+					{Name: "width", Description: "Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "height", Description: "Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "deviceScaleFactor", Description: "Overriding device scale factor value. 0 disables the override.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "mobile", Description: "Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "scale", Description: "Scale to apply to resulting view image.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "screenWidth", Description: "Overriding screen width value in pixels (minimum 0, maximum 10000000).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "screenHeight", Description: "Overriding screen height value in pixels (minimum 0, maximum 10000000).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "positionX", Description: "Overriding view X position on screen in pixels (minimum 0, maximum 10000000).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "positionY", Description: "Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "dontSetVisibleSize", Description: "Do not set visible view size, rely upon explicit setVisibleSize call.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "screenOrientation", Description: "Screen orientation override.", Type: "Emulation.ScreenOrientation", Optional: true, GoType: reflect.TypeOf((**EmulationScreenOrientation)(nil)).Elem()},
+					{Name: "viewport", Description: "If set, the visible area of the page will be overridden to this viewport. This viewport change is not observed by the page, e.g. viewport-relative elements do not change positions.", Type: "Page.Viewport", Optional: true, GoType: reflect.TypeOf((**PageViewport)(nil)).Elem()},
+					{Name: "displayFeature", Description: "If set, the display feature of a multi-segment screen. If not set, multi-segment support is turned-off. Deprecated, use Emulation.setDisplayFeaturesOverride.", Type: "Emulation.DisplayFeature", Optional: true, GoType: reflect.TypeOf((**EmulationDisplayFeature)(nil)).Elem()},
+					{Name: "devicePosture", Description: "If set, the posture of a foldable device. If not set the posture is set to continuous. Deprecated, use Emulation.setDevicePostureOverride.", Type: "Emulation.DevicePosture", Optional: true, GoType: reflect.TypeOf((**EmulationDevicePosture)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDevicePostureOverride",
+				Description:  "Start reporting the given posture value to the Device Posture API. This override can also be set in setDeviceMetricsOverride().",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "posture", Description: "", Type: "Emulation.DevicePosture", GoType: reflect.TypeOf((**EmulationDevicePosture)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "clearDevicePostureOverride",
+				Description:  "Clears a device posture override set with either setDeviceMetricsOverride() or setDevicePostureOverride() and starts using posture information from the platform again. Does nothing if no override is set.",
+				Experimental: true,
+			},
+			{
+				Name:         "setDisplayFeaturesOverride",
+				Description:  "Start using the given display features to pupulate the Viewport Segments API. This override can also be set in setDeviceMetricsOverride().",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "features", Description: "", Type: "array", Items: "Emulation.DisplayFeature", GoType: reflect.TypeOf((*[]*EmulationDisplayFeature)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "clearDisplayFeaturesOverride",
+				Description:  "Clears the display features override set with either setDeviceMetricsOverride() or setDisplayFeaturesOverride() and starts using display features from the platform again. Does nothing if no override is set.",
+				Experimental: true,
+			},
+			{
+				Name:         "setScrollbarsHidden",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "hidden", Description: "Whether scrollbars should be always hidden.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDocumentCookieDisabled",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "disabled", Description: "Whether document.coookie API should be disabled.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setEmitTouchEventsForMouse",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether touch emulation based on mouse input should be enabled.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "configuration", Description: "Touch/gesture events configuration. Default: current platform.", Type: "string", Optional: true, GoType: reflect.TypeOf((*EmulationSetEmitTouchEventsForMouseConfiguration)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setEmulatedMedia",
+				Description: "Emulates the given media type or media feature for CSS media queries.",
+				Params: []*ParamInfo{
+					{Name: "media", Description: "Media type to emulate. Empty string disables the override.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "features", Description: "Media features to emulate.", Type: "array", Items: "Emulation.MediaFeature", Optional: true, GoType: reflect.TypeOf((*[]*EmulationMediaFeature)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setEmulatedVisionDeficiency",
+				Description: "Emulates the given vision deficiency.",
+				Params: []*ParamInfo{
+					{Name: "type", Description: "Vision deficiency to emulate. Order: best-effort emulations come first, followed by any physiologically accurate emulations for medically recognized color vision deficiencies.", Type: "string", GoType: reflect.TypeOf((*EmulationSetEmulatedVisionDeficiencyType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setEmulatedOSTextScale",
+				Description: "Emulates the given OS text scale.",
+				Params: []*ParamInfo{
+					{Name: "scale", Description: "", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setGeolocationOverride",
+				Description: "Overrides the Geolocation Position or Error. Omitting latitude, longitude or accuracy emulates position unavailable.",
+				Params: []*ParamInfo{
+					{Name: "latitude", Description: "Mock latitude", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "longitude", Description: "Mock longitude", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "accuracy", Description: "Mock accuracy", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "altitude", Description: "Mock altitude", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "altitudeAccuracy", Description: "Mock altitudeAccuracy", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "heading", Description: "Mock heading", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "speed", Description: "Mock speed", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getOverriddenSensorInformation",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "type", Description: " enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation", Type: "Emulation.SensorType", GoType: reflect.TypeOf((*EmulationSensorType)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "requestedSamplingFrequency", Description: "", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setSensorOverrideEnabled",
+				Description:  "Overrides a platform sensor of a given type. If |enabled| is true, calls to Sensor.start() will use a virtual sensor as backend rather than fetching data from a real hardware sensor. Otherwise, existing virtual sensor-backend Sensor objects will fire an error event and new calls to Sensor.start() will attempt to use a real sensor instead.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "type", Description: " enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation", Type: "Emulation.SensorType", GoType: reflect.TypeOf((*EmulationSensorType)(nil)).Elem()},
+					{Name: "metadata", Description: "", Type: "Emulation.SensorMetadata", Optional: true, GoType: reflect.TypeOf((**EmulationSensorMetadata)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setSensorOverrideReadings",
+				Description:  "Updates the sensor readings reported by a sensor type previously overridden by setSensorOverrideEnabled.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "type", Description: " enum values: absolute-orientation, accelerometer, ambient-light, gravity, gyroscope, linear-acceleration, magnetometer, relative-orientation", Type: "Emulation.SensorType", GoType: reflect.TypeOf((*EmulationSensorType)(nil)).Elem()},
+					{Name: "reading", Description: "", Type: "Emulation.SensorReading", GoType: reflect.TypeOf((**EmulationSensorReading)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setPressureSourceOverrideEnabled",
+				Description:  "Overrides a pressure source of a given type, as used by the Compute Pressure API, so that updates to PressureObserver.observe() are provided via setPressureStateOverride instead of being retrieved from platform-provided telemetry data.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "source", Description: " enum values: cpu", Type: "Emulation.PressureSource", GoType: reflect.TypeOf((*EmulationPressureSource)(nil)).Elem()},
+					{Name: "metadata", Description: "", Type: "Emulation.PressureMetadata", Optional: true, GoType: reflect.TypeOf((**EmulationPressureMetadata)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setPressureStateOverride",
+				Description:  "TODO: OBSOLETE: To remove when setPressureDataOverride is merged. Provides a given pressure state that will be processed and eventually be delivered to PressureObserver users. |source| must have been previously overridden by setPressureSourceOverrideEnabled.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "source", Description: " enum values: cpu", Type: "Emulation.PressureSource", GoType: reflect.TypeOf((*EmulationPressureSource)(nil)).Elem()},
+					{Name: "state", Description: " enum values: nominal, fair, serious, critical", Type: "Emulation.PressureState", GoType: reflect.TypeOf((*EmulationPressureState)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setPressureDataOverride",
+				Description:  "Provides a given pressure data set that will be processed and eventually be delivered to PressureObserver users. |source| must have been previously overridden by setPressureSourceOverrideEnabled.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "source", Description: " enum values: cpu", Type: "Emulation.PressureSource", GoType: reflect.TypeOf((*EmulationPressureSource)(nil)).Elem()},
+					{Name: "state", Description: " enum values: nominal, fair, serious, critical", Type: "Emulation.PressureState", GoType: reflect.TypeOf((*EmulationPressureState)(nil)).Elem()},
+					{Name: "ownContributionEstimate", Description: "", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setIdleOverride",
+				Description: "Overrides the Idle state.",
+				Params: []*ParamInfo{
+					{Name: "isUserActive", Description: "Mock isUserActive", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isScreenUnlocked", Description: "Mock isScreenUnlocked", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearIdleOverride",
+				Description: "Clears Idle state overrides.",
+			},
+			{
+				Name:         "setNavigatorOverrides",
+				Description:  "Overrides value returned by the javascript navigator object.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "platform", Description: "The platform navigator.platform should return.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setPageScaleFactor",
+				Description:  "Sets a specified page scale factor.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "pageScaleFactor", Description: "Page scale factor.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setScriptExecutionDisabled",
+				Description: "Switches script execution in the page.",
+				Params: []*ParamInfo{
+					{Name: "value", Description: "Whether script execution should be disabled in the page.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setTouchEmulationEnabled",
+				Description: "Enables touch on platforms which do not support them.",
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether the touch event emulation should be enabled.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "maxTouchPoints", Description: "Maximum touch points supported. Defaults to one.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setVirtualTimePolicy",
+				Description:  "Turns on virtual time for all frames (replacing real-time with a synthetic time source) and sets the current virtual time policy.  Note this supersedes any previous time budget.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "policy", Description: " enum values: advance, pause, pauseIfNetworkFetchesPending", Type: "Emulation.VirtualTimePolicy", GoType: reflect.TypeOf((*EmulationVirtualTimePolicy)(nil)).Elem()},
+					{Name: "budget", Description: "If set, after this many virtual milliseconds have elapsed virtual time will be paused and a virtualTimeBudgetExpired event is sent.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "maxVirtualTimeTaskStarvationCount", Description: "If set this specifies the maximum number of tasks that can be run before virtual is forced forwards to prevent deadlock.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "initialVirtualTime", Description: "If set, base::Time::Now will be overridden to initially return this value.", Type: "Network.TimeSinceEpoch", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "virtualTimeTicksBase", Description: "Absolute timestamp at which virtual time was first enabled (up time in milliseconds).", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setLocaleOverride",
+				Description:  "Overrides default host system locale with the specified one.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "locale", Description: "ICU style C locale (e.g. \"en_US\"). If not specified or empty, disables the override and restores default host system locale.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setTimezoneOverride",
+				Description: "Overrides default host system timezone with the specified one.",
+				Params: []*ParamInfo{
+					{Name: "timezoneId", Description: "The timezone identifier. List of supported timezones: https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt If empty, disables the override and restores default host system timezone.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setVisibleSize",
+				Description:  "Resizes the frame/viewport of the page. Note that this does not affect the frame's container (e.g. browser window). Can be used to produce screenshots of the specified size. Not supported on Android.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "width", Description: "Frame width (DIP).", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "height", Description: "Frame height (DIP).", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDisabledImageTypes",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "imageTypes", Description: "Image types to disable. enum values: avif, webp", Type: "array", Items: "Emulation.DisabledImageType", GoType: reflect.TypeOf((*[]EmulationDisabledImageType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDataSaverOverride",
+				Description:  "Override the value of navigator.connection.saveData",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "dataSaverEnabled", Description: "Override value. Omitting the parameter disables the override.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setHardwareConcurrencyOverride",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "hardwareConcurrency", Description: "Hardware concurrency to report", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setUserAgentOverride",
+				Description: "Allows overriding user agent with the given string. `userAgentMetadata` must be set for Client Hint headers to be sent.",
+				Params: []*ParamInfo{
+					{Name: "userAgent", Description: "User agent to use.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "acceptLanguage", Description: "Browser language to emulate.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "platform", Description: "The platform navigator.platform should return.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "userAgentMetadata", Description: "To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData", Type: "Emulation.UserAgentMetadata", Optional: true, GoType: reflect.TypeOf((**EmulationUserAgentMetadata)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setAutomationOverride",
+				Description:  "Allows overriding the automation flag.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether the override should be enabled.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setSmallViewportHeightDifferenceOverride",
+				Description:  "Allows overriding the difference between the small and large viewport sizes, which determine the value of the `svh` and `lvh` unit, respectively. Only supported for top-level frames.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "difference", Description: "This will cause an element of size 100svh to be `difference` pixels smaller than an element of size 100lvh.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:         "virtualTimeBudgetExpired",
+				Description:  "Notification sent after the virtual time budget for the current VirtualTimePolicy has run out.",
+				Experimental: true,
+				Type:         reflect.TypeOf((*EmulationVirtualTimeBudgetExpiredEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "HeadlessExperimental",
+		Description:  "This domain provides experimental commands only supported in headless mode.",
+		Experimental: true,
+		Dependencies: []string{"Page", "Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "beginFrame",
+				Description: "Sends a BeginFrame to the target and returns when the frame was completed. Optionally captures a screenshot from the resulting frame. Requires that the target was created with enabled BeginFrameControl. Designed for use with --run-all-compositor-stages-before-draw, see also https://goo.gle/chrome-headless-rendering for more background.",
+				Params: []*ParamInfo{
+					{Name: "frameTimeTicks", Description: "Timestamp of this BeginFrame in Renderer TimeTicks (milliseconds of uptime). If not set, the current time will be used.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "interval", Description: "The interval between BeginFrames that is reported to the compositor, in milliseconds. Defaults to a 60 frames/second interval, i.e. about 16.666 milliseconds.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "noDisplayUpdates", Description: "Whether updates should not be committed and drawn onto the display. False by default. If true, only side effects of the BeginFrame will be run, such as layout and animations, but any visual updates may not be visible on the display or in screenshots.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "screenshot", Description: "If set, a screenshot of the frame will be captured and returned in the response. Otherwise, no screenshot will be captured. Note that capturing a screenshot can fail, for example, during renderer initialization. In such a case, no screenshot data will be returned.", Type: "HeadlessExperimental.ScreenshotParams", Optional: true, GoType: reflect.TypeOf((**HeadlessExperimentalScreenshotParams)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "hasDamage", Description: "Whether the BeginFrame resulted in damage and, thus, a new frame was committed to the display. Reported for diagnostic uses, may be removed in the future.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "screenshotData", Description: "Base64-encoded image data of the screenshot, if one was requested and successfully taken. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables headless events for the target.",
+				Deprecated:  true,
+			},
+			{
+				Name:        "enable",
+				Description: "Enables headless events for the target.",
+				Deprecated:  true,
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:        "IO",
+		Description: "Input/Output operations for streams produced by DevTools.",
+		Commands: []*CommandInfo{
+			{
+				Name:        "close",
+				Description: "Close the stream, discard any temporary backing storage.",
+				Params: []*ParamInfo{
+					{Name: "handle", Description: "Handle of the stream to close.", Type: "IO.StreamHandle", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "read",
+				Description: "Read a chunk of the stream",
+				Params: []*ParamInfo{
+					{Name: "handle", Description: "Handle of the stream to read.", Type: "IO.StreamHandle", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "offset", Description: "Seek to the specified offset before reading (if not specified, proceed with offset following the last read). Some types of streams may only support sequential reads.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "size", Description: "Maximum number of bytes to read (left upon the agent discretion if not specified).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "base64Encoded", Description: "Set if the data is base64-encoded", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "data", Description: "Data that were read.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "eof", Description: "Set if the end-of-file condition occurred while reading.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "resolveBlob",
+				Description: "Return UUID of Blob object specified by a remote object id.",
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "Object id of a Blob object wrapper.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "uuid", Description: "UUID of the specified Blob.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "FileSystem",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"Network", "Storage"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "getDirectory",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "bucketFileSystemLocator", Description: "", Type: "FileSystem.BucketFileSystemLocator", GoType: reflect.TypeOf((**FileSystemBucketFileSystemLocator)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "directory", Description: "Returns the directory object at the path.", Type: "FileSystem.Directory", GoType: reflect.TypeOf((**FileSystemDirectory)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "IndexedDB",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"Runtime", "Storage"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "clearObjectStore",
+				Description: "Clears all entries from an object store.",
+				Params: []*ParamInfo{
+					{Name: "securityOrigin", Description: "At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageBucket", Description: "Storage bucket. If not specified, it uses the default bucket.", Type: "Storage.StorageBucket", Optional: true, GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+					{Name: "databaseName", Description: "Database name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectStoreName", Description: "Object store name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "deleteDatabase",
+				Description: "Deletes a database.",
+				Params: []*ParamInfo{
+					{Name: "securityOrigin", Description: "At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageBucket", Description: "Storage bucket. If not specified, it uses the default bucket.", Type: "Storage.StorageBucket", Optional: true, GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+					{Name: "databaseName", Description: "Database name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "deleteObjectStoreEntries",
+				Description: "Delete a range of entries from an object store",
+				Params: []*ParamInfo{
+					{Name: "securityOrigin", Description: "At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageBucket", Description: "Storage bucket. If not specified, it uses the default bucket.", Type: "Storage.StorageBucket", Optional: true, GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+					{Name: "databaseName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectStoreName", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "keyRange", Description: "Range of entry keys to delete", Type: "IndexedDB.KeyRange", GoType: reflect.TypeOf((**IndexedDBKeyRange)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables events from backend.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables events from backend.",
+			},
+			{
+				Name:        "requestData",
+				Description: "Requests data from object store or index.",
+				Params: []*ParamInfo{
+					{Name: "securityOrigin", Description: "At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageBucket", Description: "Storage bucket. If not specified, it uses the default bucket.", Type: "Storage.StorageBucket", Optional: true, GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+					{Name: "databaseName", Description: "Database name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectStoreName", Description: "Object store name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "indexName", Description: "Index name, empty string for object store data requests.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "skipCount", Description: "Number of records to skip.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "pageSize", Description: "Number of records to fetch.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "keyRange", Description: "Key range.", Type: "IndexedDB.KeyRange", Optional: true, GoType: reflect.TypeOf((**IndexedDBKeyRange)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "objectStoreDataEntries", Description: "Array of object store data entries.", Type: "array", Items: "IndexedDB.DataEntry", GoType: reflect.TypeOf((*[]*IndexedDBDataEntry)(nil)).Elem()},
+					{Name: "hasMore", Description: "If true, there are more entries to fetch in the given range.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getMetadata",
+				Description: "Gets metadata of an object store.",
+				Params: []*ParamInfo{
+					{Name: "securityOrigin", Description: "At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageBucket", Description: "Storage bucket. If not specified, it uses the default bucket.", Type: "Storage.StorageBucket", Optional: true, GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+					{Name: "databaseName", Description: "Database name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectStoreName", Description: "Object store name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "entriesCount", Description: "the entries count", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "keyGeneratorValue", Description: "the current value of key generator, to become the next inserted key into the object store. Valid if objectStore.autoIncrement is true.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "requestDatabase",
+				Description: "Requests database with given name in given frame.",
+				Params: []*ParamInfo{
+					{Name: "securityOrigin", Description: "At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageBucket", Description: "Storage bucket. If not specified, it uses the default bucket.", Type: "Storage.StorageBucket", Optional: true, GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+					{Name: "databaseName", Description: "Database name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "databaseWithObjectStores", Description: "Database with an array of object stores.", Type: "IndexedDB.DatabaseWithObjectStores", GoType: reflect.TypeOf((**IndexedDBDatabaseWithObjectStores)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "requestDatabaseNames",
+				Description: "Requests database names for given security origin.",
+				Params: []*ParamInfo{
+					{Name: "securityOrigin", Description: "At least and at most one of securityOrigin, storageKey, or storageBucket must be specified. Security origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageBucket", Description: "Storage bucket. If not specified, it uses the default bucket.", Type: "Storage.StorageBucket", Optional: true, GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "databaseNames", Description: "Database names for origin.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:        "Input",
+		Description: "",
+		Commands: []*CommandInfo{
+			{
+				Name:         "dispatchDragEvent",
+				Description:  "Dispatches a drag event into the page.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "type", Description: "Type of the drag event.", Type: "string", GoType: reflect.TypeOf((*InputDispatchDragEventType)(nil)).Elem()},
+					{Name: "x", Description: "X coordinate of the event relative to the main frame's viewport in CSS pixels.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "y", Description: "Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "data", Description: "", Type: "Input.DragData", GoType: reflect.TypeOf((**InputDragData)(nil)).Elem()},
+					{Name: "modifiers", Description: "Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "dispatchKeyEvent",
+				Description: "Dispatches a key event to the page.",
+				Params: []*ParamInfo{
+					{Name: "type", Description: "Type of the key event.", Type: "string", GoType: reflect.TypeOf((*InputDispatchKeyEventType)(nil)).Elem()},
+					{Name: "modifiers", Description: "Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "timestamp", Description: "Time at which the event occurred.", Type: "Input.TimeSinceEpoch", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "text", Description: "Text as generated by processing a virtual key code with a keyboard layout. Not needed for for `keyUp` and `rawKeyDown` events (default: \"\")", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "unmodifiedText", Description: "Text that would have been generated by the keyboard if no modifiers were pressed (except for shift). Useful for shortcut (accelerator) key handling (default: \"\").", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "keyIdentifier", Description: "Unique key identifier (e.g., 'U+0041') (default: \"\").", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "code", Description: "Unique DOM defined string value for each physical key (e.g., 'KeyA') (default: \"\").", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "key", Description: "Unique DOM defined string value describing the meaning of the key in the context of active modifiers, keyboard layout, etc (e.g., 'AltGr') (default: \"\").", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "windowsVirtualKeyCode", Description: "Windows virtual key code (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "nativeVirtualKeyCode", Description: "Native virtual key code (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "autoRepeat", Description: "Whether the event was generated from auto repeat (default: false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isKeypad", Description: "Whether the event was generated from the keypad (default: false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isSystemKey", Description: "Whether the event was a system key event (default: false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "location", Description: "Whether the event was from the left or right side of the keyboard. 1=Left, 2=Right (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "commands", Description: "Editing commands to send with the key event (e.g., 'selectAll') (default: []). These are related to but not equal the command names used in `document.execCommand` and NSStandardKeyBindingResponding. See https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/editing/commands/editor_command_names.h for valid command names.", Type: "array", Items: "string", Optional: true, GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "insertText",
+				Description:  "This method emulates inserting text that doesn't come from a key press, for example an emoji keyboard or an IME.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "text", Description: "The text to insert.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "imeSetComposition",
+				Description:  "This method sets the current candidate text for IME. Use imeCommitComposition to commit the final text. Use imeSetComposition with empty string as text to cancel composition.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "text", Description: "The text to insert", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "selectionStart", Description: "selection start", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "selectionEnd", Description: "selection end", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "replacementStart", Description: "replacement start", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "replacementEnd", Description: "replacement end", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "dispatchMouseEvent",
+				Description: "Dispatches a mouse event to the page.",
+				Params: []*ParamInfo{
+					{Name: "type", Description: "Type of the mouse event.", Type: "string", GoType: reflect.TypeOf((*InputDispatchMouseEventType)(nil)).Elem()},
+					{Name: "x", Description: "X coordinate of the event relative to the main frame's viewport in CSS pixels.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "y", Description: "Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "modifiers", Description: "Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "timestamp", Description: "Time at which the event occurred.", Type: "Input.TimeSinceEpoch", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "button", Description: "Mouse button (default: \"none\"). enum values: none, left, middle, right, back, forward", Type: "Input.MouseButton", Optional: true, GoType: reflect.TypeOf((*InputMouseButton)(nil)).Elem()},
+					{Name: "buttons", Description: "A number indicating which buttons are pressed on the mouse when a mouse event is triggered. Left=1, Right=2, Middle=4, Back=8, Forward=16, None=0.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "clickCount", Description: "Number of times the mouse button was clicked (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "force", Description: "The normalized pressure, which has a range of [0,1] (default: 0).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "tangentialPressure", Description: "The normalized tangential pressure, which has a range of [-1,1] (default: 0).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "tiltX", Description: "The plane angle between the Y-Z plane and the plane containing both the stylus axis and the Y axis, in degrees of the range [-90,90], a positive tiltX is to the right (default: 0).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "tiltY", Description: "The plane angle between the X-Z plane and the plane containing both the stylus axis and the X axis, in degrees of the range [-90,90], a positive tiltY is towards the user (default: 0).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "twist", Description: "The clockwise rotation of a pen stylus around its own major axis, in degrees in the range [0,359] (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "deltaX", Description: "X delta in CSS pixels for mouse wheel event (default: 0).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "deltaY", Description: "Y delta in CSS pixels for mouse wheel event (default: 0).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "pointerType", Description: "Pointer type (default: \"mouse\").", Type: "string", Optional: true, GoType: reflect.TypeOf((*InputDispatchMouseEventPointerType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "dispatchTouchEvent",
+				Description: "Dispatches a touch event to the page.",
+				Params: []*ParamInfo{
+					{Name: "type", Description: "Type of the touch event. TouchEnd and TouchCancel must not contain any touch points, while TouchStart and TouchMove must contains at least one.", Type: "string", GoType: reflect.TypeOf((*InputDispatchTouchEventType)(nil)).Elem()},
+					{Name: "touchPoints", Description: "Active touch points on the touch device. One event per any changed point (compared to previous touch event in a sequence) is generated, emulating pressing/moving/releasing points one by one.", Type: "array", Items: "Input.TouchPoint", GoType: reflect.TypeOf((*[]*InputTouchPoint)(nil)).Elem()},
+					{Name: "modifiers", Description: "Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "timestamp", Description: "Time at which the event occurred.", Type: "Input.TimeSinceEpoch", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "cancelDragging",
+				Description: "Cancels any active dragging in the page.",
+			},
+			{
+				Name:         "emulateTouchFromMouseEvent",
+				Description:  "Emulates touch event from the mouse event parameters.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "type", Description: "Type of the mouse event.", Type: "string", GoType: reflect.TypeOf((*InputEmulateTouchFromMouseEventType)(nil)).Elem()},
+					{Name: "x", Description: "X coordinate of the mouse pointer in DIP.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "y", Description: "Y coordinate of the mouse pointer in DIP.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "button", Description: "Mouse button. Only \"none\", \"left\", \"right\" are supported. enum values: none, left, middle, right, back, forward", Type: "Input.MouseButton", GoType: reflect.TypeOf((*InputMouseButton)(nil)).Elem()},
+					{Name: "timestamp", Description: "Time at which the event occurred (default: current time).", Type: "Input.TimeSinceEpoch", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "deltaX", Description: "X delta in DIP for mouse wheel event (default: 0).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "deltaY", Description: "Y delta in DIP for mouse wheel event (default: 0).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "modifiers", Description: "Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "clickCount", Description: "Number of times the mouse button was clicked (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setIgnoreInputEvents",
+				Description: "Ignores input events (useful while auditing page).",
+				Params: []*ParamInfo{
+					{Name: "ignore", Description: "Ignores input events processing when set to true.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setInterceptDrags",
+				Description:  "Prevents default drag and drop behavior and instead emits `Input.dragIntercepted` events. Drag and drop behavior can be directly controlled via `Input.dispatchDragEvent`.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "synthesizePinchGesture",
+				Description:  "Synthesizes a pinch gesture over a time period by issuing appropriate touch events.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "x", Description: "X coordinate of the start of the gesture in CSS pixels.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "y", Description: "Y coordinate of the start of the gesture in CSS pixels.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "scaleFactor", Description: "Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out).", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "relativeSpeed", Description: "Relative pointer speed in pixels per second (default: 800).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "gestureSourceType", Description: "Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). enum values: default, touch, mouse", Type: "Input.GestureSourceType", Optional: true, GoType: reflect.TypeOf((*InputGestureSourceType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "synthesizeScrollGesture",
+				Description:  "Synthesizes a scroll gesture over a time period by issuing appropriate touch events.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "x", Description: "X coordinate of the start of the gesture in CSS pixels.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "y", Description: "Y coordinate of the start of the gesture in CSS pixels.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "xDistance", Description: "The distance to scroll along the X axis (positive to scroll left).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "yDistance", Description: "The distance to scroll along the Y axis (positive to scroll up).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "xOverscroll", Description: "The number of additional pixels to scroll back along the X axis, in addition to the given distance.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "yOverscroll", Description: "The number of additional pixels to scroll back along the Y axis, in addition to the given distance.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "preventFling", Description: "Prevent fling (default: true).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "speed", Description: "Swipe speed in pixels per second (default: 800).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "gestureSourceType", Description: "Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). enum values: default, touch, mouse", Type: "Input.GestureSourceType", Optional: true, GoType: reflect.TypeOf((*InputGestureSourceType)(nil)).Elem()},
+					{Name: "repeatCount", Description: "The number of times to repeat the gesture (default: 0).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "repeatDelayMs", Description: "The number of milliseconds delay between each repeat. (default: 250).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "interactionMarkerName", Description: "The name of the interaction markers to generate, if not empty (default: \"\").", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "synthesizeTapGesture",
+				Description:  "Synthesizes a tap gesture over a time period by issuing appropriate touch events.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "x", Description: "X coordinate of the start of the gesture in CSS pixels.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "y", Description: "Y coordinate of the start of the gesture in CSS pixels.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "duration", Description: "Duration between touchdown and touchup events in ms (default: 50).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "tapCount", Description: "Number of times to perform the tap (e.g. 2 for double tap, default: 1).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "gestureSourceType", Description: "Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). enum values: default, touch, mouse", Type: "Input.GestureSourceType", Optional: true, GoType: reflect.TypeOf((*InputGestureSourceType)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:         "dragIntercepted",
+				Description:  "Emitted only when `Input.setInterceptDrags` is enabled. Use this data with `Input.dispatchDragEvent` to restore normal drag and drop behavior.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "data", Description: "", Type: "Input.DragData", GoType: reflect.TypeOf((**InputDragData)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*InputDragInterceptedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Inspector",
+		Description:  "",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "Disables inspector domain notifications.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables inspector domain notifications.",
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "detached",
+				Description: "Fired when remote debugging connection is about to be terminated. Contains detach reason.",
+				Params: []*ParamInfo{
+					{Name: "reason", Description: "The reason why connection has been terminated.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*InspectorDetachedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "targetCrashed",
+				Description: "Fired when debugging target has crashed",
+				Type:        reflect.TypeOf((*InspectorTargetCrashedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "targetReloadedAfterCrash",
+				Description: "Fired when debugging target has reloaded after crash",
+				Type:        reflect.TypeOf((*InspectorTargetReloadedAfterCrashEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "LayerTree",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"DOM"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "compositingReasons",
+				Description: "Provides the reasons why the given layer was composited.",
+				Params: []*ParamInfo{
+					{Name: "layerId", Description: "The id of the layer for which we want to get the reasons it was composited.", Type: "LayerTree.LayerId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "compositingReasons", Description: "A list of strings specifying reasons for the given layer to become composited.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "compositingReasonIds", Description: "A list of strings specifying reason IDs for the given layer to become composited.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables compositing tree inspection.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables compositing tree inspection.",
+			},
+			{
+				Name:        "loadSnapshot",
+				Description: "Returns the snapshot identifier.",
+				Params: []*ParamInfo{
+					{Name: "tiles", Description: "An array of tiles composing the snapshot.", Type: "array", Items: "LayerTree.PictureTile", GoType: reflect.TypeOf((*[]*LayerTreePictureTile)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "snapshotId", Description: "The id of the snapshot.", Type: "LayerTree.SnapshotId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "makeSnapshot",
+				Description: "Returns the layer snapshot identifier.",
+				Params: []*ParamInfo{
+					{Name: "layerId", Description: "The id of the layer.", Type: "LayerTree.LayerId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "snapshotId", Description: "The id of the layer snapshot.", Type: "LayerTree.SnapshotId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "profileSnapshot",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "snapshotId", Description: "The id of the layer snapshot.", Type: "LayerTree.SnapshotId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "minRepeatCount", Description: "The maximum number of times to replay the snapshot (1, if not specified).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "minDuration", Description: "The minimum duration (in seconds) to replay the snapshot.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "clipRect", Description: "The clip rectangle to apply when replaying the snapshot.", Type: "DOM.Rect", Optional: true, GoType: reflect.TypeOf((**DOMRect)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "timings", Description: "The array of paint profiles, one per run.", Type: "array", Items: "LayerTree.PaintProfile", GoType: reflect.TypeOf((*[]float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "releaseSnapshot",
+				Description: "Releases layer snapshot captured by the back-end.",
+				Params: []*ParamInfo{
+					{Name: "snapshotId", Description: "The id of the layer snapshot.", Type: "LayerTree.SnapshotId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "replaySnapshot",
+				Description: "Replays the layer snapshot and returns the resulting bitmap.",
+				Params: []*ParamInfo{
+					{Name: "snapshotId", Description: "The id of the layer snapshot.", Type: "LayerTree.SnapshotId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "fromStep", Description: "The first step to replay from (replay from the very start if not specified).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "toStep", Description: "The last step to replay to (replay till the end if not specified).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "scale", Description: "The scale to apply while replaying (defaults to 1).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "dataURL", Description: "A data: URL for resulting image.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "snapshotCommandLog",
+				Description: "Replays the layer snapshot and returns canvas log.",
+				Params: []*ParamInfo{
+					{Name: "snapshotId", Description: "The id of the layer snapshot.", Type: "LayerTree.SnapshotId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "layerPainted",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "layerId", Description: "The id of the painted layer.", Type: "LayerTree.LayerId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "clip", Description: "Clip rectangle.", Type: "DOM.Rect", GoType: reflect.TypeOf((**DOMRect)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*LayerTreeLayerPaintedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "layerTreeDidChange",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "layers", Description: "Layer tree, absent if not in the compositing mode.", Type: "array", Items: "LayerTree.Layer", Optional: true, GoType: reflect.TypeOf((*[]*LayerTreeLayer)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*LayerTreeLayerTreeDidChangeEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Log",
+		Description:  "Provides access to log entries.",
+		Dependencies: []string{"Runtime", "Network"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "clear",
+				Description: "Clears the log.",
+			},
+			{
+				Name:        "disable",
+				Description: "Disables log domain, prevents further log entries from being reported to the client.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables log domain, sends the entries collected so far to the client by means of the `entryAdded` notification.",
+			},
+			{
+				Name:        "startViolationsReport",
+				Description: "start violation reporting.",
+				Params: []*ParamInfo{
+					{Name: "config", Description: "Configuration for violations.", Type: "array", Items: "Log.ViolationSetting", GoType: reflect.TypeOf((*[]*LogViolationSetting)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopViolationsReport",
+				Description: "Stop violation reporting.",
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "entryAdded",
+				Description: "Issued when new message was logged.",
+				Params: []*ParamInfo{
+					{Name: "entry", Description: "The entry.", Type: "Log.LogEntry", GoType: reflect.TypeOf((**LogLogEntry)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*LogEntryAddedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Memory",
+		Description:  "",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "getDOMCounters",
+				Description: "Retruns current DOM object counters.",
+				Returns: []*ParamInfo{
+					{Name: "documents", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "nodes", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "jsEventListeners", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getDOMCountersForLeakDetection",
+				Description: "Retruns DOM object counters after preparing renderer for leak detection.",
+				Returns: []*ParamInfo{
+					{Name: "counters", Description: "DOM object counters.", Type: "array", Items: "Memory.DOMCounter", GoType: reflect.TypeOf((*[]*MemoryDOMCounter)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "prepareForLeakDetection",
+				Description: "Prepares for leak detection by terminating workers, stopping spellcheckers, dropping non-essential internal caches, running garbage collections, etc.",
+			},
+			{
+				Name:        "forciblyPurgeJavaScriptMemory",
+				Description: "Simulate OomIntervention by purging V8 memory.",
+			},
+			{
+				Name:        "setPressureNotificationsSuppressed",
+				Description: "Enable/disable suppressing memory pressure notifications in all processes.",
+				Params: []*ParamInfo{
+					{Name: "suppressed", Description: "If true, memory pressure notifications will be suppressed.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "simulatePressureNotification",
+				Description: "Simulate a memory pressure notification in all processes.",
+				Params: []*ParamInfo{
+					{Name: "level", Description: "Memory pressure level of the notification. enum values: moderate, critical", Type: "Memory.PressureLevel", GoType: reflect.TypeOf((*MemoryPressureLevel)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "startSampling",
+				Description: "Start collecting native memory profile.",
+				Params: []*ParamInfo{
+					{Name: "samplingInterval", Description: "Average number of bytes between samples.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "suppressRandomness", Description: "Do not randomize intervals between samples.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopSampling",
+				Description: "Stop collecting native memory profile.",
+			},
+			{
+				Name:        "getAllTimeSamplingProfile",
+				Description: "Retrieve native memory allocations profile collected since renderer process startup.",
+				Returns: []*ParamInfo{
+					{Name: "profile", Description: "", Type: "Memory.SamplingProfile", GoType: reflect.TypeOf((**MemorySamplingProfile)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getBrowserSamplingProfile",
+				Description: "Retrieve native memory allocations profile collected since browser process startup.",
+				Returns: []*ParamInfo{
+					{Name: "profile", Description: "", Type: "Memory.SamplingProfile", GoType: reflect.TypeOf((**MemorySamplingProfile)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getSamplingProfile",
+				Description: "Retrieve native memory allocations profile collected since last `startSampling` call.",
+				Returns: []*ParamInfo{
+					{Name: "profile", Description: "", Type: "Memory.SamplingProfile", GoType: reflect.TypeOf((**MemorySamplingProfile)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "Network",
+		Description:  "Network domain allows tracking network activities of the page. It exposes information about http, file, data and other requests and responses, their headers, bodies, timing, etc.",
+		Dependencies: []string{"Debugger", "Runtime", "Security"},
+		Commands: []*CommandInfo{
+			{
+				Name:         "setAcceptedEncodings",
+				Description:  "Sets a list of content encodings that will be accepted. Empty list means no encoding is accepted.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "encodings", Description: "List of accepted content encodings. enum values: deflate, gzip, br, zstd", Type: "array", Items: "Network.ContentEncoding", GoType: reflect.TypeOf((*[]NetworkContentEncoding)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "clearAcceptedEncodingsOverride",
+				Description:  "Clears accepted encodings set by setAcceptedEncodings",
+				Experimental: true,
+			},
+			{
+				Name:        "canClearBrowserCache",
+				Description: "Tells whether clearing browser cache is supported.",
+				Deprecated:  true,
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "True if browser cache can be cleared.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "canClearBrowserCookies",
+				Description: "Tells whether clearing browser cookies is supported.",
+				Deprecated:  true,
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "True if browser cookies can be cleared.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "canEmulateNetworkConditions",
+				Description: "Tells whether emulation of network conditions is supported.",
+				Deprecated:  true,
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "True if emulation of network conditions is supported.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearBrowserCache",
+				Description: "Clears browser cache.",
+			},
+			{
+				Name:        "clearBrowserCookies",
+				Description: "Clears browser cookies.",
+			},
+			{
+				Name:         "continueInterceptedRequest",
+				Description:  "Response to Network.requestIntercepted which either modifies the request to continue with any modifications, or blocks it, or completes it with the provided response bytes. If a network fetch occurs as a result which encounters a redirect an additional Network.requestIntercepted event will be sent with the same InterceptionId. Deprecated, use Fetch.continueRequest, Fetch.fulfillRequest and Fetch.failRequest instead.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "interceptionId", Description: "", Type: "Network.InterceptionId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errorReason", Description: "If set this causes the request to fail with the given reason. Passing `Aborted` for requests marked with `isNavigationRequest` also cancels the navigation. Must not be set in response to an authChallenge. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse", Type: "Network.ErrorReason", Optional: true, GoType: reflect.TypeOf((*NetworkErrorReason)(nil)).Elem()},
+					{Name: "rawResponse", Description: "If set the requests completes using with the provided base64 encoded raw response, including HTTP status line and headers etc... Must not be set in response to an authChallenge. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "If set the request url will be modified in a way that's not observable by page. Must not be set in response to an authChallenge.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "method", Description: "If set this allows the request method to be overridden. Must not be set in response to an authChallenge.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "postData", Description: "If set this allows postData to be set. Must not be set in response to an authChallenge.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "headers", Description: "If set this allows the request headers to be changed. Must not be set in response to an authChallenge.", Type: "Network.Headers", Optional: true, GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "authChallengeResponse", Description: "Response to a requestIntercepted with an authChallenge. Must not be set otherwise.", Type: "Network.AuthChallengeResponse", Optional: true, GoType: reflect.TypeOf((**NetworkAuthChallengeResponse)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "deleteCookies",
+				Description: "Deletes browser cookies with matching name and url or domain/path/partitionKey pair.",
+				Params: []*ParamInfo{
+					{Name: "name", Description: "Name of the cookies to remove.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "If specified, deletes all the cookies with the given name where domain and path match provided URL.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "domain", Description: "If specified, deletes only cookies with the exact domain.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "path", Description: "If specified, deletes only cookies with the exact path.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "partitionKey", Description: "If specified, deletes only cookies with the the given name and partitionKey where all partition key attributes match the cookie partition key attribute.", Type: "Network.CookiePartitionKey", Optional: true, GoType: reflect.TypeOf((**NetworkCookiePartitionKey)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables network tracking, prevents network events from being sent to the client.",
+			},
+			{
+				Name:        "emulateNetworkConditions",
+				Description: "Activates emulation of network conditions.",
+				Params: []*ParamInfo{
+					{Name: "offline", Description: "True to emulate internet disconnection.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "latency", Description: "Minimum latency from request sent to response headers received (ms).", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "downloadThroughput", Description: "Maximal aggregated download throughput (bytes/sec). -1 disables download throttling.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "uploadThroughput", Description: "Maximal aggregated upload throughput (bytes/sec).  -1 disables upload throttling.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "connectionType", Description: "Connection type if known. enum values: none, cellular2g, cellular3g, cellular4g, bluetooth, ethernet, wifi, wimax, other", Type: "Network.ConnectionType", Optional: true, GoType: reflect.TypeOf((*NetworkConnectionType)(nil)).Elem()},
+					{Name: "packetLoss", Description: "WebRTC packet loss (percent, 0-100). 0 disables packet loss emulation, 100 drops all the packets.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "packetQueueLength", Description: "WebRTC packet queue length (packet). 0 removes any queue length limitations.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "packetReordering", Description: "WebRTC packetReordering feature.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "enable",
+				Description: "Enables network tracking, network events will now be delivered to the client.",
+				Params: []*ParamInfo{
+					{Name: "maxTotalBufferSize", Description: "Buffer size in bytes to use when preserving network payloads (XHRs, etc).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "maxResourceBufferSize", Description: "Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "maxPostDataSize", Description: "Longest post body size (in bytes) that would be included in requestWillBeSent notification", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "reportDirectSocketTraffic", Description: "Whether DirectSocket chunk send/receive events should be reported.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getAllCookies",
+				Description: "Returns all browser cookies. Depending on the backend support, will return detailed cookie information in the `cookies` field. Deprecated. Use Storage.getCookies instead.",
+				Deprecated:  true,
+				Returns: []*ParamInfo{
+					{Name: "cookies", Description: "Array of cookie objects.", Type: "array", Items: "Network.Cookie", GoType: reflect.TypeOf((*[]*NetworkCookie)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getCertificate",
+				Description:  "Returns the DER-encoded certificate.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Origin to get certificate for.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "tableNames", Description: "", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getCookies",
+				Description: "Returns all browser cookies for the current URL. Depending on the backend support, will return detailed cookie information in the `cookies` field.",
+				Params: []*ParamInfo{
+					{Name: "urls", Description: "The list of URLs for which applicable cookies will be fetched. If not specified, it's assumed to be set to the list containing the URLs of the page and all of its subframes.", Type: "array", Items: "string", Optional: true, GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "cookies", Description: "Array of cookie objects.", Type: "array", Items: "Network.Cookie", GoType: reflect.TypeOf((*[]*NetworkCookie)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getResponseBody",
+				Description: "Returns content served for the given request.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Identifier of the network request to get content for.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "body", Description: "Response body.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "base64Encoded", Description: "True, if content was sent as base64.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getRequestPostData",
+				Description: "Returns post data sent with the request. Returns an error when no data was sent with the request.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Identifier of the network request to get content for.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "postData", Description: "Request body string, omitting files from multipart requests", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getResponseBodyForInterception",
+				Description:  "Returns content served for the given currently intercepted request.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "interceptionId", Description: "Identifier for the intercepted request to get body for.", Type: "Network.InterceptionId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "body", Description: "Response body.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "base64Encoded", Description: "True, if content was sent as base64.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "takeResponseBodyForInterceptionAsStream",
+				Description:  "Returns a handle to the stream representing the response body. Note that after this command, the intercepted request can't be continued as is -- you either need to cancel it or to provide the response body. The stream only supports sequential read, IO.read will fail if the position is specified.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "interceptionId", Description: "", Type: "Network.InterceptionId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "stream", Description: "", Type: "IO.StreamHandle", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "replayXHR",
+				Description:  "This method sends a new XMLHttpRequest which is identical to the original one. The following parameters should be identical: method, url, async, request body, extra headers, withCredentials attribute, user, password.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Identifier of XHR to replay.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "searchInResponseBody",
+				Description:  "Searches for given string in response content.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Identifier of the network response to search.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "query", Description: "String to search for.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "caseSensitive", Description: "If true, search is case sensitive.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isRegex", Description: "If true, treats string parameter as regex.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "List of search matches.", Type: "array", Items: "Debugger.SearchMatch", GoType: reflect.TypeOf((*[]*DebuggerSearchMatch)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setBlockedURLs",
+				Description:  "Blocks URLs from loading.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "urls", Description: "URL patterns to block. Wildcards ('*') are allowed.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setBypassServiceWorker",
+				Description: "Toggles ignoring of service worker for each request.",
+				Params: []*ParamInfo{
+					{Name: "bypass", Description: "Bypass service worker and load from network.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setCacheDisabled",
+				Description: "Toggles ignoring cache for each request. If `true`, cache will not be used.",
+				Params: []*ParamInfo{
+					{Name: "cacheDisabled", Description: "Cache disabled state.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setCookie",
+				Description: "Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.",
+				Params: []*ParamInfo{
+					{Name: "name", Description: "Cookie name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "value", Description: "Cookie value.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "The request-URI to associate with the setting of the cookie. This value can affect the default domain, path, source port, and source scheme values of the created cookie.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "domain", Description: "Cookie domain.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "path", Description: "Cookie path.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "secure", Description: "True if cookie is secure.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "httpOnly", Description: "True if cookie is http-only.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "sameSite", Description: "Cookie SameSite type. enum values: Strict, Lax, None", Type: "Network.CookieSameSite", Optional: true, GoType: reflect.TypeOf((*NetworkCookieSameSite)(nil)).Elem()},
+					{Name: "expires", Description: "Cookie expiration date, session cookie if not set", Type: "Network.TimeSinceEpoch", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "priority", Description: "Cookie Priority type. enum values: Low, Medium, High", Type: "Network.CookiePriority", Optional: true, GoType: reflect.TypeOf((*NetworkCookiePriority)(nil)).Elem()},
+					{Name: "sameParty", Description: "True if cookie is SameParty.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "sourceScheme", Description: "Cookie source scheme type. enum values: Unset, NonSecure, Secure", Type: "Network.CookieSourceScheme", Optional: true, GoType: reflect.TypeOf((*NetworkCookieSourceScheme)(nil)).Elem()},
+					{Name: "sourcePort", Description: "Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port. An unspecified port value allows protocol clients to emulate legacy cookie scope for the port. This is a temporary ability and it will be removed in the future.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "partitionKey", Description: "Cookie partition key. If not set, the cookie will be set as not partitioned.", Type: "Network.CookiePartitionKey", Optional: true, GoType: reflect.TypeOf((**NetworkCookiePartitionKey)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "success", Description: "Always set to true. If an error occurs, the response indicates protocol error.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setCookies",
+				Description: "Sets given cookies.",
+				Params: []*ParamInfo{
+					{Name: "cookies", Description: "Cookies to be set.", Type: "array", Items: "Network.CookieParam", GoType: reflect.TypeOf((*[]*NetworkCookieParam)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setExtraHTTPHeaders",
+				Description: "Specifies whether to always send extra HTTP headers with the requests from this page.",
+				Params: []*ParamInfo{
+					{Name: "headers", Description: "Map with extra HTTP headers.", Type: "Network.Headers", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setAttachDebugStack",
+				Description:  "Specifies whether to attach a page script stack id in requests",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether to attach a page script stack for debugging purpose.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setRequestInterception",
+				Description:  "Sets the requests to intercept that match the provided patterns and optionally resource types. Deprecated, please use Fetch.enable instead.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "patterns", Description: "Requests matching any of these patterns will be forwarded and wait for the corresponding continueInterceptedRequest call.", Type: "array", Items: "Network.RequestPattern", GoType: reflect.TypeOf((*[]*NetworkRequestPattern)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setUserAgentOverride",
+				Description: "Allows overriding user agent with the given string.",
+				Params: []*ParamInfo{
+					{Name: "userAgent", Description: "User agent to use.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "acceptLanguage", Description: "Browser language to emulate.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "platform", Description: "The platform navigator.platform should return.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "userAgentMetadata", Description: "To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData", Type: "Emulation.UserAgentMetadata", Optional: true, GoType: reflect.TypeOf((**EmulationUserAgentMetadata)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "streamResourceContent",
+				Description:  "Enables streaming of the response for the given requestId. If enabled, the dataReceived event contains the data that was received during streaming.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Identifier of the request to stream.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "bufferedData", Description: "Data that has been buffered until streaming is enabled. (Encoded as a base64 string when passed over JSON)", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getSecurityIsolationStatus",
+				Description:  "Returns information about the COEP/COOP isolation status.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "If no frameId is provided, the status of the target is provided.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "status", Description: "", Type: "Network.SecurityIsolationStatus", GoType: reflect.TypeOf((**NetworkSecurityIsolationStatus)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "enableReportingApi",
+				Description:  "Enables tracking for the Reporting API, events generated by the Reporting API will now be delivered to the client. Enabling triggers 'reportingApiReportAdded' for all existing reports.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enable", Description: "Whether to enable or disable events for the Reporting API", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "loadNetworkResource",
+				Description:  "Fetches the resource and returns the content.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Frame id to get the resource for. Mandatory for frame targets, and should be omitted for worker targets.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "URL of the resource to get content for.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "options", Description: "Options for the request.", Type: "Network.LoadNetworkResourceOptions", GoType: reflect.TypeOf((**NetworkLoadNetworkResourceOptions)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "resource", Description: "", Type: "Network.LoadNetworkResourcePageResult", GoType: reflect.TypeOf((**NetworkLoadNetworkResourcePageResult)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setCookieControls",
+				Description:  "Sets Controls for third-party cookie access Page reload is required before the new cookie behavior will be observed",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enableThirdPartyCookieRestriction", Description: "Whether 3pc restriction is enabled.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "disableThirdPartyCookieMetadata", Description: "Whether 3pc grace period exception should be enabled; false by default.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "disableThirdPartyCookieHeuristics", Description: "Whether 3pc heuristics exceptions should be enabled; false by default.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "dataReceived",
+				Description: "Fired when data chunk was received over the network.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "dataLength", Description: "Data chunk length.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "encodedDataLength", Description: "Actual bytes received (might be less than dataLength for compressed encodings).", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "data", Description: "Data that was received. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDataReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "eventSourceMessageReceived",
+				Description: "Fired when EventSource message is received.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "eventName", Description: "Message type.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "eventId", Description: "Message identifier.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "data", Description: "Message content.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkEventSourceMessageReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "loadingFailed",
+				Description: "Fired when HTTP request has failed to load.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "type", Description: "Resource type. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other", Type: "Network.ResourceType", GoType: reflect.TypeOf((*NetworkResourceType)(nil)).Elem()},
+					{Name: "errorText", Description: "Error message. List of network errors: https://cs.chromium.org/chromium/src/net/base/net_error_list.h", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "canceled", Description: "True if loading was canceled.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "blockedReason", Description: "The reason why loading was blocked, if any. enum values: other, csp, mixed-content, origin, inspector, integrity, subresource-filter, content-type, coep-frame-resource-needs-coep-header, coop-sandboxed-iframe-cannot-navigate-to-coop-page, corp-not-same-origin, corp-not-same-origin-after-defaulted-to-same-origin-by-coep, corp-not-same-origin-after-defaulted-to-same-origin-by-dip, corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip, corp-not-same-site, sri-message-signature-mismatch", Type: "Network.BlockedReason", Optional: true, GoType: reflect.TypeOf((*NetworkBlockedReason)(nil)).Elem()},
+					{Name: "corsErrorStatus", Description: "The reason why loading was blocked by CORS, if any.", Type: "Network.CorsErrorStatus", Optional: true, GoType: reflect.TypeOf((**NetworkCorsErrorStatus)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkLoadingFailedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "loadingFinished",
+				Description: "Fired when HTTP request has finished loading.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "encodedDataLength", Description: "Total number of bytes received for this request.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkLoadingFinishedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "requestIntercepted",
+				Description:  "Details of an intercepted HTTP request, which must be either allowed, blocked, modified or mocked. Deprecated, use Fetch.requestPaused instead.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "interceptionId", Description: "Each request the page makes will have a unique id, however if any redirects are encountered while processing that fetch, they will be reported with the same id as the original fetch. Likewise if HTTP authentication is needed then the same fetch id will be used.", Type: "Network.InterceptionId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "request", Description: "", Type: "Network.Request", GoType: reflect.TypeOf((**NetworkRequest)(nil)).Elem()},
+					{Name: "frameId", Description: "The id of the frame that initiated the request.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "resourceType", Description: "How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other", Type: "Network.ResourceType", GoType: reflect.TypeOf((*NetworkResourceType)(nil)).Elem()},
+					{Name: "isNavigationRequest", Description: "Whether this is a navigation request, which can abort the navigation completely.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isDownload", Description: "Set if the request is a navigation that will result in a download. Only present after response is received from the server (i.e. HeadersReceived stage).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "redirectUrl", Description: "Redirect location, only sent if a redirect was intercepted.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "authChallenge", Description: "Details of the Authorization Challenge encountered. If this is set then continueInterceptedRequest must contain an authChallengeResponse.", Type: "Network.AuthChallenge", Optional: true, GoType: reflect.TypeOf((**NetworkAuthChallenge)(nil)).Elem()},
+					{Name: "responseErrorReason", Description: "Response error if intercepted at response stage or if redirect occurred while intercepting request. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse", Type: "Network.ErrorReason", Optional: true, GoType: reflect.TypeOf((*NetworkErrorReason)(nil)).Elem()},
+					{Name: "responseStatusCode", Description: "Response code if intercepted at response stage or if redirect occurred while intercepting request or auth retry occurred.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "responseHeaders", Description: "Response headers if intercepted at the response stage or if redirect occurred while intercepting request or auth retry occurred.", Type: "Network.Headers", Optional: true, GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "requestId", Description: "If the intercepted request had a corresponding requestWillBeSent event fired for it, then this requestId will be the same as the requestId present in the requestWillBeSent event.", Type: "Network.RequestId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkRequestInterceptedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "requestServedFromCache",
+				Description: "Fired if request ended up loading from cache.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkRequestServedFromCacheEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "requestWillBeSent",
+				Description: "Fired when page is about to send HTTP request.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "loaderId", Description: "Loader identifier. Empty string if the request is fetched from worker.", Type: "Network.LoaderId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "documentURL", Description: "URL of the document this request is loaded for.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "request", Description: "Request data.", Type: "Network.Request", GoType: reflect.TypeOf((**NetworkRequest)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "wallTime", Description: "Timestamp.", Type: "Network.TimeSinceEpoch", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "initiator", Description: "Request initiator.", Type: "Network.Initiator", GoType: reflect.TypeOf((**NetworkInitiator)(nil)).Elem()},
+					{Name: "redirectHasExtraInfo", Description: "In the case that redirectResponse is populated, this flag indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted for the request which was just redirected.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "redirectResponse", Description: "Redirect response data.", Type: "Network.Response", Optional: true, GoType: reflect.TypeOf((**NetworkResponse)(nil)).Elem()},
+					{Name: "type", Description: "Type of this resource. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other", Type: "Network.ResourceType", Optional: true, GoType: reflect.TypeOf((*NetworkResourceType)(nil)).Elem()},
+					{Name: "frameId", Description: "Frame identifier.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "hasUserGesture", Description: "Whether the request is initiated by a user gesture. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkRequestWillBeSentEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "resourceChangedPriority",
+				Description:  "Fired when resource loading priority is changed",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "newPriority", Description: "New priority enum values: VeryLow, Low, Medium, High, VeryHigh", Type: "Network.ResourcePriority", GoType: reflect.TypeOf((*NetworkResourcePriority)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkResourceChangedPriorityEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "signedExchangeReceived",
+				Description:  "Fired when a signed exchange was received over the network",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "info", Description: "Information about the signed exchange response.", Type: "Network.SignedExchangeInfo", GoType: reflect.TypeOf((**NetworkSignedExchangeInfo)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkSignedExchangeReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "responseReceived",
+				Description: "Fired when HTTP response is available.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "loaderId", Description: "Loader identifier. Empty string if the request is fetched from worker.", Type: "Network.LoaderId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "type", Description: "Resource type. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other", Type: "Network.ResourceType", GoType: reflect.TypeOf((*NetworkResourceType)(nil)).Elem()},
+					{Name: "response", Description: "Response data.", Type: "Network.Response", GoType: reflect.TypeOf((**NetworkResponse)(nil)).Elem()},
+					{Name: "hasExtraInfo", Description: "Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted for this request.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "frameId", Description: "Frame identifier.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkResponseReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webSocketClosed",
+				Description: "Fired when WebSocket is closed.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebSocketClosedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webSocketCreated",
+				Description: "Fired upon WebSocket creation.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "WebSocket request URL.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "initiator", Description: "Request initiator.", Type: "Network.Initiator", Optional: true, GoType: reflect.TypeOf((**NetworkInitiator)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebSocketCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webSocketFrameError",
+				Description: "Fired when WebSocket message error occurs.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "errorMessage", Description: "WebSocket error message.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebSocketFrameErrorEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webSocketFrameReceived",
+				Description: "Fired when WebSocket message is received.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "response", Description: "WebSocket response data.", Type: "Network.WebSocketFrame", GoType: reflect.TypeOf((**NetworkWebSocketFrame)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebSocketFrameReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webSocketFrameSent",
+				Description: "Fired when WebSocket message is sent.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "response", Description: "WebSocket response data.", Type: "Network.WebSocketFrame", GoType: reflect.TypeOf((**NetworkWebSocketFrame)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebSocketFrameSentEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webSocketHandshakeResponseReceived",
+				Description: "Fired when WebSocket handshake response becomes available.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "response", Description: "WebSocket response data.", Type: "Network.WebSocketResponse", GoType: reflect.TypeOf((**NetworkWebSocketResponse)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebSocketHandshakeResponseReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webSocketWillSendHandshakeRequest",
+				Description: "Fired when WebSocket is about to initiate handshake.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "wallTime", Description: "UTC Timestamp.", Type: "Network.TimeSinceEpoch", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "request", Description: "WebSocket request data.", Type: "Network.WebSocketRequest", GoType: reflect.TypeOf((**NetworkWebSocketRequest)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebSocketWillSendHandshakeRequestEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webTransportCreated",
+				Description: "Fired upon WebTransport creation.",
+				Params: []*ParamInfo{
+					{Name: "transportId", Description: "WebTransport identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "WebTransport request URL.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "initiator", Description: "Request initiator.", Type: "Network.Initiator", Optional: true, GoType: reflect.TypeOf((**NetworkInitiator)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebTransportCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webTransportConnectionEstablished",
+				Description: "Fired when WebTransport handshake is finished.",
+				Params: []*ParamInfo{
+					{Name: "transportId", Description: "WebTransport identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebTransportConnectionEstablishedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "webTransportClosed",
+				Description: "Fired when WebTransport is disposed.",
+				Params: []*ParamInfo{
+					{Name: "transportId", Description: "WebTransport identifier.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "Timestamp.", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkWebTransportClosedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directTCPSocketCreated",
+				Description:  "Fired upon direct_socket.TCPSocket creation.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "remoteAddr", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "remotePort", Description: "Unsigned int 16.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "options", Description: "", Type: "Network.DirectTCPSocketOptions", GoType: reflect.TypeOf((**NetworkDirectTCPSocketOptions)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "initiator", Description: "", Type: "Network.Initiator", Optional: true, GoType: reflect.TypeOf((**NetworkInitiator)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectTCPSocketCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directTCPSocketOpened",
+				Description:  "Fired when direct_socket.TCPSocket connection is opened.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "remoteAddr", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "remotePort", Description: "Expected to be unsigned integer.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "localAddr", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "localPort", Description: "Expected to be unsigned integer.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectTCPSocketOpenedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directTCPSocketAborted",
+				Description:  "Fired when direct_socket.TCPSocket is aborted.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errorMessage", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectTCPSocketAbortedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directTCPSocketClosed",
+				Description:  "Fired when direct_socket.TCPSocket is closed.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectTCPSocketClosedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directTCPSocketChunkSent",
+				Description:  "Fired when data is sent to tcp direct socket stream.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "data", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectTCPSocketChunkSentEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directTCPSocketChunkReceived",
+				Description:  "Fired when data is received from tcp direct socket stream.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "data", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectTCPSocketChunkReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directUDPSocketCreated",
+				Description:  "Fired upon direct_socket.UDPSocket creation.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "options", Description: "", Type: "Network.DirectUDPSocketOptions", GoType: reflect.TypeOf((**NetworkDirectUDPSocketOptions)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "initiator", Description: "", Type: "Network.Initiator", Optional: true, GoType: reflect.TypeOf((**NetworkInitiator)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectUDPSocketCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directUDPSocketOpened",
+				Description:  "Fired when direct_socket.UDPSocket connection is opened.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "localAddr", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "localPort", Description: "Expected to be unsigned integer.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "remoteAddr", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "remotePort", Description: "Expected to be unsigned integer.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectUDPSocketOpenedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directUDPSocketAborted",
+				Description:  "Fired when direct_socket.UDPSocket is aborted.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errorMessage", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectUDPSocketAbortedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directUDPSocketClosed",
+				Description:  "Fired when direct_socket.UDPSocket is closed.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectUDPSocketClosedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directUDPSocketChunkSent",
+				Description:  "Fired when message is sent to udp direct socket stream.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "message", Description: "", Type: "Network.DirectUDPMessage", GoType: reflect.TypeOf((**NetworkDirectUDPMessage)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectUDPSocketChunkSentEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "directUDPSocketChunkReceived",
+				Description:  "Fired when message is received from udp direct socket stream.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "message", Description: "", Type: "Network.DirectUDPMessage", GoType: reflect.TypeOf((**NetworkDirectUDPMessage)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkDirectUDPSocketChunkReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "requestWillBeSentExtraInfo",
+				Description:  "Fired when additional information about a requestWillBeSent event is available from the network stack. Not every requestWillBeSent event will have an additional requestWillBeSentExtraInfo fired for it, and there is no guarantee whether requestWillBeSent or requestWillBeSentExtraInfo will be fired first for the same request.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier. Used to match this information to an existing requestWillBeSent event.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "associatedCookies", Description: "A list of cookies potentially associated to the requested URL. This includes both cookies sent with the request and the ones not sent; the latter are distinguished by having blockedReasons field set.", Type: "array", Items: "Network.AssociatedCookie", GoType: reflect.TypeOf((*[]*NetworkAssociatedCookie)(nil)).Elem()},
+					{Name: "headers", Description: "Raw request headers as they will be sent over the wire.", Type: "Network.Headers", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "connectTiming", Description: "Connection timing information for the request.", Type: "Network.ConnectTiming", GoType: reflect.TypeOf((**NetworkConnectTiming)(nil)).Elem()},
+					{Name: "clientSecurityState", Description: "The client security state set for the request.", Type: "Network.ClientSecurityState", Optional: true, GoType: reflect.TypeOf((**NetworkClientSecurityState)(nil)).Elem()},
+					{Name: "siteHasCookieInOtherPartition", Description: "Whether the site has partitioned cookies stored in a partition different than the current one.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkRequestWillBeSentExtraInfoEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "responseReceivedExtraInfo",
+				Description:  "Fired when additional information about a responseReceived event is available from the network stack. Not every responseReceived event will have an additional responseReceivedExtraInfo for it, and responseReceivedExtraInfo may be fired before or after responseReceived.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier. Used to match this information to another responseReceived event.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "blockedCookies", Description: "A list of cookies which were not stored from the response along with the corresponding reasons for blocking. The cookies here may not be valid due to syntax errors, which are represented by the invalid cookie line string instead of a proper cookie.", Type: "array", Items: "Network.BlockedSetCookieWithReason", GoType: reflect.TypeOf((*[]*NetworkBlockedSetCookieWithReason)(nil)).Elem()},
+					{Name: "headers", Description: "Raw response headers as they were received over the wire. Duplicate headers in the response are represented as a single key with their values concatentated using `\\n` as the separator. See also `headersText` that contains verbatim text for HTTP/1.*.", Type: "Network.Headers", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "resourceIPAddressSpace", Description: "The IP address space of the resource. The address space can only be determined once the transport established the connection, so we can't send it in `requestWillBeSentExtraInfo`. enum values: Loopback, Local, Public, Unknown", Type: "Network.IPAddressSpace", GoType: reflect.TypeOf((*NetworkIPAddressSpace)(nil)).Elem()},
+					{Name: "statusCode", Description: "The status code of the response. This is useful in cases the request failed and no responseReceived event is triggered, which is the case for, e.g., CORS errors. This is also the correct status code for cached requests, where the status in responseReceived is a 200 and this will be 304.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "headersText", Description: "Raw response header text as it was received over the wire. The raw text may not always be available, such as in the case of HTTP/2 or QUIC.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "cookiePartitionKey", Description: "The cookie partition key that will be used to store partitioned cookies set in this response. Only sent when partitioned cookies are enabled.", Type: "Network.CookiePartitionKey", Optional: true, GoType: reflect.TypeOf((**NetworkCookiePartitionKey)(nil)).Elem()},
+					{Name: "cookiePartitionKeyOpaque", Description: "True if partitioned cookies are enabled, but the partition key is not serializable to string.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "exemptedCookies", Description: "A list of cookies which should have been blocked by 3PCD but are exempted and stored from the response with the corresponding reason.", Type: "array", Items: "Network.ExemptedSetCookieWithReason", Optional: true, GoType: reflect.TypeOf((*[]*NetworkExemptedSetCookieWithReason)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkResponseReceivedExtraInfoEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "responseReceivedEarlyHints",
+				Description:  "Fired when 103 Early Hints headers is received in addition to the common response. Not every responseReceived event will have an responseReceivedEarlyHints fired. Only one responseReceivedEarlyHints may be fired for eached responseReceived event.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier. Used to match this information to another responseReceived event.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "headers", Description: "Raw response headers as they were received over the wire. Duplicate headers in the response are represented as a single key with their values concatentated using `\\n` as the separator. See also `headersText` that contains verbatim text for HTTP/1.*.", Type: "Network.Headers", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkResponseReceivedEarlyHintsEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "trustTokenOperationDone",
+				Description:  "Fired exactly once for each Trust Token operation. Depending on the type of the operation and whether the operation succeeded or failed, the event is fired before the corresponding request was sent or after the response was received.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "status", Description: "Detailed success or error status of the operation. 'AlreadyExists' also signifies a successful operation, as the result of the operation already exists und thus, the operation was abort preemptively (e.g. a cache hit).", Type: "string", GoType: reflect.TypeOf((*NetworkTrustTokenOperationDoneStatus)(nil)).Elem()},
+					{Name: "type", Description: " enum values: Issuance, Redemption, Signing", Type: "Network.TrustTokenOperationType", GoType: reflect.TypeOf((*NetworkTrustTokenOperationType)(nil)).Elem()},
+					{Name: "requestId", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "topLevelOrigin", Description: "Top level origin. The context in which the operation was attempted.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "issuerOrigin", Description: "Origin of the issuer in case of a \"Issuance\" or \"Redemption\" operation.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "issuedTokenCount", Description: "The number of obtained Trust Tokens on a successful \"Issuance\" operation.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkTrustTokenOperationDoneEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "policyUpdated",
+				Description:  "Fired once security policy has been updated.",
+				Experimental: true,
+				Type:         reflect.TypeOf((*NetworkPolicyUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "subresourceWebBundleMetadataReceived",
+				Description:  "Fired once when parsing the .wbn file has succeeded. The event contains the information about the web bundle contents.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier. Used to match this information to another event.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "urls", Description: "A list of URLs of resources in the subresource Web Bundle.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkSubresourceWebBundleMetadataReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "subresourceWebBundleMetadataError",
+				Description:  "Fired once when parsing the .wbn file has failed.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Request identifier. Used to match this information to another event.", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errorMessage", Description: "Error message", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkSubresourceWebBundleMetadataErrorEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "subresourceWebBundleInnerResponseParsed",
+				Description:  "Fired when handling requests for resources within a .wbn file. Note: this will only be fired for resources that are requested by the webpage.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "innerRequestId", Description: "Request identifier of the subresource request", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "innerRequestURL", Description: "URL of the subresource resource.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bundleRequestId", Description: "Bundle request identifier. Used to match this information to another event. This made be absent in case when the instrumentation was enabled only after webbundle was parsed.", Type: "Network.RequestId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkSubresourceWebBundleInnerResponseParsedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "subresourceWebBundleInnerResponseError",
+				Description:  "Fired when request for resources within a .wbn file failed.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "innerRequestId", Description: "Request identifier of the subresource request", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "innerRequestURL", Description: "URL of the subresource resource.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errorMessage", Description: "Error message", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bundleRequestId", Description: "Bundle request identifier. Used to match this information to another event. This made be absent in case when the instrumentation was enabled only after webbundle was parsed.", Type: "Network.RequestId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkSubresourceWebBundleInnerResponseErrorEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "reportingApiReportAdded",
+				Description:  "Is sent whenever a new report is added. And after 'enableReportingApi' for all existing reports.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "report", Description: "", Type: "Network.ReportingApiReport", GoType: reflect.TypeOf((**NetworkReportingApiReport)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkReportingApiReportAddedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "reportingApiReportUpdated",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "report", Description: "", Type: "Network.ReportingApiReport", GoType: reflect.TypeOf((**NetworkReportingApiReport)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkReportingApiReportUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "reportingApiEndpointsChangedForOrigin",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Origin of the document(s) which configured the endpoints.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "endpoints", Description: "", Type: "array", Items: "Network.ReportingApiEndpoint", GoType: reflect.TypeOf((*[]*NetworkReportingApiEndpoint)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*NetworkReportingApiEndpointsChangedForOriginEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Overlay",
+		Description:  "This domain provides various functionality related to drawing atop the inspected page.",
+		Experimental: true,
+		Dependencies: []string{"DOM", "Page", "Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "Disables domain notifications.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables domain notifications.",
+			},
+			{
+				Name:        "getHighlightObjectForTest",
+				Description: "For testing.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to get highlight object for.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "includeDistance", Description: "Whether to include distance info.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "includeStyle", Description: "Whether to include style info.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "colorFormat", Description: "The color format to get config with (default: hex). enum values: rgb, hsl, hwb, hex", Type: "Overlay.ColorFormat", Optional: true, GoType: reflect.TypeOf((*OverlayColorFormat)(nil)).Elem()},
+					{Name: "showAccessibilityInfo", Description: "Whether to show accessibility info (default: true).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "highlight", Description: "Highlight data for the node.", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getGridHighlightObjectsForTest",
+				Description: "For Persistent Grid testing.",
+				Params: []*ParamInfo{
+					{Name: "nodeIds", Description: "Ids of the node to get highlight object for.", Type: "array", Items: "DOM.NodeId", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "highlights", Description: "Grid Highlight data for the node ids provided.", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getSourceOrderHighlightObjectForTest",
+				Description: "For Source Order Viewer testing.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "Id of the node to highlight.", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "highlight", Description: "Source order highlight data for the node id provided.", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "hideHighlight",
+				Description: "Hides any highlight.",
+			},
+			{
+				Name:        "highlightFrame",
+				Description: "Highlights owner element of the frame with given id. Deprecated: Doesn't work reliably and cannot be fixed due to process separation (the owner node might be in a different process). Determine the owner node in the client and use highlightNode.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Identifier of the frame to highlight.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "contentColor", Description: "The content box highlight fill color (default: transparent).", Type: "DOM.RGBA", Optional: true, GoType: reflect.TypeOf((**DOMRGBA)(nil)).Elem()},
+					{Name: "contentOutlineColor", Description: "The content box highlight outline color (default: transparent).", Type: "DOM.RGBA", Optional: true, GoType: reflect.TypeOf((**DOMRGBA)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "highlightNode",
+				Description: "Highlights DOM node with given id or with the given JavaScript object wrapper. Either nodeId or objectId must be specified.",
+				Params: []*ParamInfo{
+					{Name: "highlightConfig", Description: "A descriptor for the highlight appearance.", Type: "Overlay.HighlightConfig", GoType: reflect.TypeOf((**OverlayHighlightConfig)(nil)).Elem()},
+					{Name: "nodeId", Description: "Identifier of the node to highlight.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node to highlight.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node to be highlighted.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "selector", Description: "Selectors to highlight relevant nodes.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "highlightQuad",
+				Description: "Highlights given quad. Coordinates are absolute with respect to the main frame viewport.",
+				Params: []*ParamInfo{
+					{Name: "quad", Description: "Quad to highlight", Type: "DOM.Quad", GoType: reflect.TypeOf((*[]float64)(nil)).Elem()},
+					{Name: "color", Description: "The highlight fill color (default: transparent).", Type: "DOM.RGBA", Optional: true, GoType: reflect.TypeOf((**DOMRGBA)(nil)).Elem()},
+					{Name: "outlineColor", Description: "The highlight outline color (default: transparent).", Type: "DOM.RGBA", Optional: true, GoType: reflect.TypeOf((**DOMRGBA)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "highlightRect",
+				Description: "Highlights given rectangle. Coordinates are absolute with respect to the main frame viewport.",
+				Params: []*ParamInfo{
+					{Name: "x", Description: "X coordinate", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "y", Description: "Y coordinate", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "width", Description: "Rectangle width", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "height", Description: "Rectangle height", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "color", Description: "The highlight fill color (default: transparent).", Type: "DOM.RGBA", Optional: true, GoType: reflect.TypeOf((**DOMRGBA)(nil)).Elem()},
+					{Name: "outlineColor", Description: "The highlight outline color (default: transparent).", Type: "DOM.RGBA", Optional: true, GoType: reflect.TypeOf((**DOMRGBA)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "highlightSourceOrder",
+				Description: "Highlights the source order of the children of the DOM node with given id or with the given JavaScript object wrapper. Either nodeId or objectId must be specified.",
+				Params: []*ParamInfo{
+					{Name: "sourceOrderConfig", Description: "A descriptor for the appearance of the overlay drawing.", Type: "Overlay.SourceOrderConfig", GoType: reflect.TypeOf((**OverlaySourceOrderConfig)(nil)).Elem()},
+					{Name: "nodeId", Description: "Identifier of the node to highlight.", Type: "DOM.NodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Identifier of the backend node to highlight.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectId", Description: "JavaScript object id of the node to be highlighted.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setInspectMode",
+				Description: "Enters the 'inspect' mode. In this mode, elements that user is hovering over are highlighted. Backend then generates 'inspectNodeRequested' event upon element selection.",
+				Params: []*ParamInfo{
+					{Name: "mode", Description: "Set an inspection mode. enum values: searchForNode, searchForUAShadowDOM, captureAreaScreenshot, none", Type: "Overlay.InspectMode", GoType: reflect.TypeOf((*OverlayInspectMode)(nil)).Elem()},
+					{Name: "highlightConfig", Description: "A descriptor for the highlight appearance of hovered-over nodes. May be omitted if `enabled == false`.", Type: "Overlay.HighlightConfig", Optional: true, GoType: reflect.TypeOf((**OverlayHighlightConfig)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowAdHighlights",
+				Description: "Highlights owner element of all frames detected to be ads.",
+				Params: []*ParamInfo{
+					{Name: "show", Description: "True for showing ad highlights", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setPausedInDebuggerMessage",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "message", Description: "The message to display, also triggers resume and step over controls.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowDebugBorders",
+				Description: "Requests that backend shows debug borders on layers",
+				Params: []*ParamInfo{
+					{Name: "show", Description: "True for showing debug borders", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowFPSCounter",
+				Description: "Requests that backend shows the FPS counter",
+				Params: []*ParamInfo{
+					{Name: "show", Description: "True for showing the FPS counter", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowGridOverlays",
+				Description: "Highlight multiple elements with the CSS Grid overlay.",
+				Params: []*ParamInfo{
+					{Name: "gridNodeHighlightConfigs", Description: "An array of node identifiers and descriptors for the highlight appearance.", Type: "array", Items: "Overlay.GridNodeHighlightConfig", GoType: reflect.TypeOf((*[]*OverlayGridNodeHighlightConfig)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowFlexOverlays",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "flexNodeHighlightConfigs", Description: "An array of node identifiers and descriptors for the highlight appearance.", Type: "array", Items: "Overlay.FlexNodeHighlightConfig", GoType: reflect.TypeOf((*[]*OverlayFlexNodeHighlightConfig)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowScrollSnapOverlays",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "scrollSnapHighlightConfigs", Description: "An array of node identifiers and descriptors for the highlight appearance.", Type: "array", Items: "Overlay.ScrollSnapHighlightConfig", GoType: reflect.TypeOf((*[]*OverlayScrollSnapHighlightConfig)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowContainerQueryOverlays",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "containerQueryHighlightConfigs", Description: "An array of node identifiers and descriptors for the highlight appearance.", Type: "array", Items: "Overlay.ContainerQueryHighlightConfig", GoType: reflect.TypeOf((*[]*OverlayContainerQueryHighlightConfig)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowPaintRects",
+				Description: "Requests that backend shows paint rectangles",
+				Params: []*ParamInfo{
+					{Name: "result", Description: "True for showing paint rectangles", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowLayoutShiftRegions",
+				Description: "Requests that backend shows layout shift regions",
+				Params: []*ParamInfo{
+					{Name: "result", Description: "True for showing layout shift regions", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowScrollBottleneckRects",
+				Description: "Requests that backend shows scroll bottleneck rects",
+				Params: []*ParamInfo{
+					{Name: "show", Description: "True for showing scroll bottleneck rects", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowHitTestBorders",
+				Description: "Deprecated, no longer has any effect.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "show", Description: "True for showing hit-test borders", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowWebVitals",
+				Description: "Deprecated, no longer has any effect.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "show", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowViewportSizeOnResize",
+				Description: "Paints viewport size upon main frame resize.",
+				Params: []*ParamInfo{
+					{Name: "show", Description: "Whether to paint size or not.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowHinge",
+				Description: "Add a dual screen device hinge",
+				Params: []*ParamInfo{
+					{Name: "hingeConfig", Description: "hinge data, null means hideHinge", Type: "Overlay.HingeConfig", Optional: true, GoType: reflect.TypeOf((**OverlayHingeConfig)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowIsolatedElements",
+				Description: "Show elements in isolation mode with overlays.",
+				Params: []*ParamInfo{
+					{Name: "isolatedElementHighlightConfigs", Description: "An array of node identifiers and descriptors for the highlight appearance.", Type: "array", Items: "Overlay.IsolatedElementHighlightConfig", GoType: reflect.TypeOf((*[]*OverlayIsolatedElementHighlightConfig)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setShowWindowControlsOverlay",
+				Description: "Show Window Controls Overlay for PWA",
+				Params: []*ParamInfo{
+					{Name: "windowControlsOverlayConfig", Description: "Window Controls Overlay data, null means hide Window Controls Overlay", Type: "Overlay.WindowControlsOverlayConfig", Optional: true, GoType: reflect.TypeOf((**OverlayWindowControlsOverlayConfig)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "inspectNodeRequested",
+				Description: "Fired when the node should be inspected. This happens after call to `setInspectMode` or when user manually inspects an element.",
+				Params: []*ParamInfo{
+					{Name: "backendNodeId", Description: "Id of the node to inspect.", Type: "DOM.BackendNodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*OverlayInspectNodeRequestedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "nodeHighlightRequested",
+				Description: "Fired when the node should be highlighted. This happens after call to `setInspectMode`.",
+				Params: []*ParamInfo{
+					{Name: "nodeId", Description: "", Type: "DOM.NodeId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*OverlayNodeHighlightRequestedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "screenshotRequested",
+				Description: "Fired when user asks to capture screenshot of some area on the page.",
+				Params: []*ParamInfo{
+					{Name: "viewport", Description: "Viewport to capture, in device independent pixels (dip).", Type: "Page.Viewport", GoType: reflect.TypeOf((**PageViewport)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*OverlayScreenshotRequestedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "inspectModeCanceled",
+				Description: "Fired when user cancels the inspect mode.",
+				Type:        reflect.TypeOf((*OverlayInspectModeCanceledEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Page",
+		Description:  "Actions and events related to the inspected page belong to the page domain.",
+		Dependencies: []string{"Debugger", "DOM", "IO", "Network", "Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:         "addScriptToEvaluateOnLoad",
+				Description:  "Deprecated, please use addScriptToEvaluateOnNewDocument instead.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "scriptSource", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "identifier", Description: "Identifier of the added script.", Type: "Page.ScriptIdentifier", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "addScriptToEvaluateOnNewDocument",
+				Description: "Evaluates given script in every frame upon creation (before loading frame's scripts).",
+				Params: []*ParamInfo{
+					{Name: "source", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "worldName", Description: "If specified, creates an isolated world with the given name and evaluates given script in it. This world name will be used as the ExecutionContextDescription::name when the corresponding event is emitted.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "includeCommandLineAPI", Description: "Specifies whether command line API should be available to the script, defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "runImmediately", Description: "If true, runs the script immediately on existing execution contexts or worlds. Default: false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "identifier", Description: "Identifier of the added script.", Type: "Page.ScriptIdentifier", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "bringToFront",
+				Description: "Brings page to front (activates tab).",
+			},
+			{
+				Name:        "captureScreenshot",
+				Description: "Capture page screenshot.",
+				Params: []*ParamInfo{
+					{Name: "format", Description: "Image compression format (defaults to png).", Type: "string", Optional: true, GoType: reflect.TypeOf((*PageCaptureScreenshotFormat)(nil)).Elem()},
+					{Name: "quality", Description: "Compression quality from range [0..100] (jpeg only).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "clip", Description: "Capture the screenshot of a given region only.", Type: "Page.Viewport", Optional: true, GoType: reflect.TypeOf((**PageViewport)(nil)).Elem()},
+					{Name: "fromSurface", Description: "Capture the screenshot from the surface, rather than the view. Defaults to true.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "captureBeyondViewport", Description: "Capture the screenshot beyond the viewport. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "optimizeForSpeed", Description: "Optimize image encoding for speed, not for resulting size (defaults to false)", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "data", Description: "Base64-encoded image data. (Encoded as a base64 string when passed over JSON)", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "captureSnapshot",
+				Description:  "Returns a snapshot of the page as a string. For MHTML format, the serialization includes iframes, shadow DOM, external resources, and element-inline styles.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "format", Description: "Format (defaults to mhtml).", Type: "string", Optional: true, GoType: reflect.TypeOf((*PageCaptureSnapshotFormat)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "data", Description: "Serialized page data.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "clearDeviceMetricsOverride",
+				Description:  "Clears the overridden device metrics.",
+				Experimental: true,
+				Deprecated:   true,
+			},
+			{
+				Name:         "clearDeviceOrientationOverride",
+				Description:  "Clears the overridden Device Orientation.",
+				Experimental: true,
+				Deprecated:   true,
+			},
+			{
+				Name:        "clearGeolocationOverride",
+				Description: "Clears the overridden Geolocation Position and Error.",
+				Deprecated:  true,
+			},
+			{
+				Name:        "createIsolatedWorld",
+				Description: "Creates an isolated world for the given frame.",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame in which the isolated world should be created.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "worldName", Description: "An optional name which is reported in the Execution Context.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "grantUniveralAccess", Description: "Whether or not universal access should be granted to the isolated world. This is a powerful option, use with caution.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "executionContextId", Description: "Execution context of the isolated world.", Type: "Runtime.ExecutionContextId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "deleteCookie",
+				Description:  "Deletes browser cookie with given name, domain and path.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "cookieName", Description: "Name of the cookie to remove.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "URL to match cooke domain and path.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables page domain notifications.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables page domain notifications.",
+				Params: []*ParamInfo{
+					{Name: "enableFileChooserOpenedEvent", Description: "If true, the `Page.fileChooserOpened` event will be emitted regardless of the state set by `Page.setInterceptFileChooserDialog` command (default: false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getAppManifest",
+				Description: "Gets the processed manifest for this current document.   This API always waits for the manifest to be loaded.   If manifestId is provided, and it does not match the manifest of the     current document, this API errors out.   If there is not a loaded page, this API errors out immediately.",
+				Params: []*ParamInfo{
+					{Name: "manifestId", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "url", Description: "Manifest location.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errors", Description: "", Type: "array", Items: "Page.AppManifestError", GoType: reflect.TypeOf((*[]*PageAppManifestError)(nil)).Elem()},
+					{Name: "data", Description: "Manifest content.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "parsed", Description: "Parsed manifest properties. Deprecated, use manifest instead.", Type: "Page.AppManifestParsedProperties", Optional: true, GoType: reflect.TypeOf((**PageAppManifestParsedProperties)(nil)).Elem()},
+					{Name: "manifest", Description: "", Type: "Page.WebAppManifest", GoType: reflect.TypeOf((**PageWebAppManifest)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getInstallabilityErrors",
+				Description:  "",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "installabilityErrors", Description: "", Type: "array", Items: "Page.InstallabilityError", GoType: reflect.TypeOf((*[]*PageInstallabilityError)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getManifestIcons",
+				Description:  "Deprecated because it's not guaranteed that the returned icon is in fact the one used for PWA installation.",
+				Experimental: true,
+				Deprecated:   true,
+				Returns: []*ParamInfo{
+					{Name: "primaryIcon", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getAppId",
+				Description:  "Returns the unique (PWA) app id. Only returns values if the feature flag 'WebAppEnableManifestId' is enabled",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "appId", Description: "App id, either from manifest's id attribute or computed from start_url", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "recommendedId", Description: "Recommendation for manifest's id attribute to match current id computed from start_url", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getAdScriptAncestry",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "adScriptAncestry", Description: "The ancestry chain of ad script identifiers leading to this frame's creation, along with the root script's filterlist rule. The ancestry chain is ordered from the most immediate script (in the frame creation stack) to more distant ancestors (that created the immediately preceding script). Only sent if frame is labelled as an ad and ids are available.", Type: "Page.AdScriptAncestry", Optional: true, GoType: reflect.TypeOf((**PageAdScriptAncestry)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getFrameTree",
+				Description: "Returns present frame tree structure.",
+				Returns: []*ParamInfo{
+					{Name: "frameTree", Description: "Present frame tree structure.", Type: "Page.FrameTree", GoType: reflect.TypeOf((**PageFrameTree)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getLayoutMetrics",
+				Description: "Returns metrics relating to the layouting of the page, such as viewport bounds/scale.",
+				Returns: []*ParamInfo{
+					{Name: "layoutViewport", Description: "Deprecated metrics relating to the layout viewport. Is in device pixels. Use `cssLayoutViewport` instead.", Type: "Page.LayoutViewport", GoType: reflect.TypeOf((**PageLayoutViewport)(nil)).Elem()},
+					{Name: "visualViewport", Description: "Deprecated metrics relating to the visual viewport. Is in device pixels. Use `cssVisualViewport` instead.", Type: "Page.VisualViewport", GoType: reflect.TypeOf((**PageVisualViewport)(nil)).Elem()},
+					{Name: "contentSize", Description: "Deprecated size of scrollable area. Is in DP. Use `cssContentSize` instead.", Type: "DOM.Rect", GoType: reflect.TypeOf((**DOMRect)(nil)).Elem()},
+					{Name: "cssLayoutViewport", Description: "Metrics relating to the layout viewport in CSS pixels.", Type: "Page.LayoutViewport", GoType: reflect.TypeOf((**PageLayoutViewport)(nil)).Elem()},
+					{Name: "cssVisualViewport", Description: "Metrics relating to the visual viewport in CSS pixels.", Type: "Page.VisualViewport", GoType: reflect.TypeOf((**PageVisualViewport)(nil)).Elem()},
+					{Name: "cssContentSize", Description: "Size of scrollable area in CSS pixels.", Type: "DOM.Rect", GoType: reflect.TypeOf((**DOMRect)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getNavigationHistory",
+				Description: "Returns navigation history for the current page.",
+				Returns: []*ParamInfo{
+					{Name: "currentIndex", Description: "Index of the current navigation history entry.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "entries", Description: "Array of navigation history entries.", Type: "array", Items: "Page.NavigationEntry", GoType: reflect.TypeOf((*[]*PageNavigationEntry)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "resetNavigationHistory",
+				Description: "Resets navigation history for the current page.",
+			},
+			{
+				Name:         "getResourceContent",
+				Description:  "Returns content of the given resource.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Frame id to get resource for.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "URL of the resource to get content for.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "content", Description: "Resource content.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "base64Encoded", Description: "True, if content was served as base64.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getResourceTree",
+				Description:  "Returns present frame / resource tree structure.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "frameTree", Description: "Present frame / resource tree structure.", Type: "Page.FrameResourceTree", GoType: reflect.TypeOf((**PageFrameResourceTree)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "handleJavaScriptDialog",
+				Description: "Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).",
+				Params: []*ParamInfo{
+					{Name: "accept", Description: "Whether to accept or dismiss the dialog.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "promptText", Description: "The text to enter into the dialog prompt before accepting. Used only if this is a prompt dialog.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "navigate",
+				Description: "Navigates current page to the given URL.",
+				Params: []*ParamInfo{
+					{Name: "url", Description: "URL to navigate the page to.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "referrer", Description: "Referrer URL.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "transitionType", Description: "Intended transition type. enum values: link, typed, address_bar, auto_bookmark, auto_subframe, manual_subframe, generated, auto_toplevel, form_submit, reload, keyword, keyword_generated, other", Type: "Page.TransitionType", Optional: true, GoType: reflect.TypeOf((*PageTransitionType)(nil)).Elem()},
+					{Name: "frameId", Description: "Frame id to navigate, if not specified navigates the top frame.", Type: "Page.FrameId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "referrerPolicy", Description: "Referrer-policy used for the navigation. enum values: noReferrer, noReferrerWhenDowngrade, origin, originWhenCrossOrigin, sameOrigin, strictOrigin, strictOriginWhenCrossOrigin, unsafeUrl", Type: "Page.ReferrerPolicy", Optional: true, GoType: reflect.TypeOf((*PageReferrerPolicy)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "frameId", Description: "Frame id that has navigated (or failed to navigate)", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "loaderId", Description: "Loader identifier. This is omitted in case of same-document navigation, as the previously committed loaderId would not change.", Type: "Network.LoaderId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errorText", Description: "User friendly error message, present if and only if navigation has failed.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "isDownload", Description: "Whether the navigation resulted in a download.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "navigateToHistoryEntry",
+				Description: "Navigates current page to the given history entry.",
+				Params: []*ParamInfo{
+					{Name: "entryId", Description: "Unique id of the entry to navigate to.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "printToPDF",
+				Description: "Print page as PDF.",
+				Params: []*ParamInfo{
+					{Name: "landscape", Description: "Paper orientation. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "displayHeaderFooter", Description: "Display header and footer. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "printBackground", Description: "Print background graphics. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "scale", Description: "Scale of the webpage rendering. Defaults to 1.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "paperWidth", Description: "Paper width in inches. Defaults to 8.5 inches.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "paperHeight", Description: "Paper height in inches. Defaults to 11 inches.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "marginTop", Description: "Top margin in inches. Defaults to 1cm (~0.4 inches).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "marginBottom", Description: "Bottom margin in inches. Defaults to 1cm (~0.4 inches).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "marginLeft", Description: "Left margin in inches. Defaults to 1cm (~0.4 inches).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "marginRight", Description: "Right margin in inches. Defaults to 1cm (~0.4 inches).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "pageRanges", Description: "Paper ranges to print, one based, e.g., '1-5, 8, 11-13'. Pages are printed in the document order, not in the order specified, and no more than once. Defaults to empty string, which implies the entire document is printed. The page numbers are quietly capped to actual page count of the document, and ranges beyond the end of the document are ignored. If this results in no pages to print, an error is reported. It is an error to specify a range with start greater than end.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "headerTemplate", Description: "HTML template for the print header. Should be valid HTML markup with following classes used to inject printing values into them: - `date`: formatted print date - `title`: document title - `url`: document location - `pageNumber`: current page number - `totalPages`: total pages in the document  For example, `<span class=title></span>` would generate span containing the title.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "footerTemplate", Description: "HTML template for the print footer. Should use the same format as the `headerTemplate`.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "preferCSSPageSize", Description: "Whether or not to prefer page size as defined by css. Defaults to false, in which case the content will be scaled to fit the paper size.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "transferMode", Description: "return as stream", Type: "string", Optional: true, GoType: reflect.TypeOf((*PagePrintToPDFTransferMode)(nil)).Elem()},
+					{Name: "generateTaggedPDF", Description: "Whether or not to generate tagged (accessible) PDF. Defaults to embedder choice.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "generateDocumentOutline", Description: "Whether or not to embed the document outline into the PDF.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "data", Description: "Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as a base64 string when passed over JSON)", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "stream", Description: "A handle of the stream that holds resulting PDF data.", Type: "IO.StreamHandle", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "reload",
+				Description: "Reloads given page optionally ignoring the cache.",
+				Params: []*ParamInfo{
+					{Name: "ignoreCache", Description: "If true, browser cache is ignored (as if the user pressed Shift+refresh).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "scriptToEvaluateOnLoad", Description: "If set, the script will be injected into all frames of the inspected page after reload. Argument will be ignored if reloading dataURL origin.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "loaderId", Description: "If set, an error will be thrown if the target page's main frame's loader id does not match the provided id. This prevents accidentally reloading an unintended target in case there's a racing navigation.", Type: "Network.LoaderId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "removeScriptToEvaluateOnLoad",
+				Description:  "Deprecated, please use removeScriptToEvaluateOnNewDocument instead.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Page.ScriptIdentifier", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeScriptToEvaluateOnNewDocument",
+				Description: "Removes given script from the list.",
+				Params: []*ParamInfo{
+					{Name: "identifier", Description: "", Type: "Page.ScriptIdentifier", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "screencastFrameAck",
+				Description:  "Acknowledges that a screencast frame has been received by the frontend.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "sessionId", Description: "Frame number.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "searchInResource",
+				Description:  "Searches for given string in resource content.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Frame id for resource to search in.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "URL of the resource to search in.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "query", Description: "String to search for.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "caseSensitive", Description: "If true, search is case sensitive.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isRegex", Description: "If true, treats string parameter as regex.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "List of search matches.", Type: "array", Items: "Debugger.SearchMatch", GoType: reflect.TypeOf((*[]*DebuggerSearchMatch)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setAdBlockingEnabled",
+				Description:  "Enable Chrome's experimental ad filter on all sites.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether to block ads.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setBypassCSP",
+				Description: "Enable page Content Security Policy by-passing.",
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether to bypass page CSP.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getPermissionsPolicyState",
+				Description:  "Get Permissions Policy state on given frame.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "states", Description: "", Type: "array", Items: "Page.PermissionsPolicyFeatureState", GoType: reflect.TypeOf((*[]*PagePermissionsPolicyFeatureState)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getOriginTrials",
+				Description:  "Get Origin Trials on given frame.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "originTrials", Description: "", Type: "array", Items: "Page.OriginTrial", GoType: reflect.TypeOf((*[]*PageOriginTrial)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDeviceMetricsOverride",
+				Description:  "Overrides the values of device screen dimensions (window.screen.width, window.screen.height, window.innerWidth, window.innerHeight, and \"device-width\"/\"device-height\"-related CSS media query results).",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "width", Description: "Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "height", Description: "Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "deviceScaleFactor", Description: "Overriding device scale factor value. 0 disables the override.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "mobile", Description: "Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "scale", Description: "Scale to apply to resulting view image.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "screenWidth", Description: "Overriding screen width value in pixels (minimum 0, maximum 10000000).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "screenHeight", Description: "Overriding screen height value in pixels (minimum 0, maximum 10000000).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "positionX", Description: "Overriding view X position on screen in pixels (minimum 0, maximum 10000000).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "positionY", Description: "Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "dontSetVisibleSize", Description: "Do not set visible view size, rely upon explicit setVisibleSize call.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "screenOrientation", Description: "Screen orientation override.", Type: "Emulation.ScreenOrientation", Optional: true, GoType: reflect.TypeOf((**EmulationScreenOrientation)(nil)).Elem()},
+					{Name: "viewport", Description: "The viewport dimensions and scale. If not set, the override is cleared.", Type: "Page.Viewport", Optional: true, GoType: reflect.TypeOf((**PageViewport)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDeviceOrientationOverride",
+				Description:  "Overrides the Device Orientation.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "alpha", Description: "Mock alpha", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "beta", Description: "Mock beta", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "gamma", Description: "Mock gamma", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setFontFamilies",
+				Description:  "Set generic font families.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "fontFamilies", Description: "Specifies font families to set. If a font family is not specified, it won't be changed.", Type: "Page.FontFamilies", GoType: reflect.TypeOf((**PageFontFamilies)(nil)).Elem()},
+					{Name: "forScripts", Description: "Specifies font families to set for individual scripts.", Type: "array", Items: "Page.ScriptFontFamilies", Optional: true, GoType: reflect.TypeOf((*[]*PageScriptFontFamilies)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setFontSizes",
+				Description:  "Set default font sizes.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "fontSizes", Description: "Specifies font sizes to set. If a font size is not specified, it won't be changed.", Type: "Page.FontSizes", GoType: reflect.TypeOf((**PageFontSizes)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setDocumentContent",
+				Description: "Sets given markup as the document's HTML.",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Frame id to set HTML for.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "html", Description: "HTML content to set.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setDownloadBehavior",
+				Description:  "Set the behavior when downloading a file.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "behavior", Description: "Whether to allow all or deny all download requests, or use default Chrome behavior if available (otherwise deny).", Type: "string", GoType: reflect.TypeOf((*PageSetDownloadBehaviorBehavior)(nil)).Elem()},
+					{Name: "downloadPath", Description: "The default path to save downloaded files to. This is required if behavior is set to 'allow'", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setGeolocationOverride",
+				Description: "Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position unavailable.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "latitude", Description: "Mock latitude", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "longitude", Description: "Mock longitude", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "accuracy", Description: "Mock accuracy", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setLifecycleEventsEnabled",
+				Description: "Controls whether page will emit lifecycle events.",
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "If true, starts emitting lifecycle events.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setTouchEmulationEnabled",
+				Description:  "Toggles mouse event-based touch event emulation.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "Whether the touch event emulation should be enabled.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "configuration", Description: "Touch/gesture events configuration. Default: current platform.", Type: "string", Optional: true, GoType: reflect.TypeOf((*PageSetTouchEmulationEnabledConfiguration)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "startScreencast",
+				Description:  "Starts sending each frame using the `screencastFrame` event.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "format", Description: "Image compression format.", Type: "string", Optional: true, GoType: reflect.TypeOf((*PageStartScreencastFormat)(nil)).Elem()},
+					{Name: "quality", Description: "Compression quality from range [0..100].", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "maxWidth", Description: "Maximum screenshot width.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "maxHeight", Description: "Maximum screenshot height.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "everyNthFrame", Description: "Send every n-th frame.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopLoading",
+				Description: "Force the page stop all navigations and pending resource fetches.",
+			},
+			{
+				Name:         "crash",
+				Description:  "Crashes renderer on the IO thread, generates minidumps.",
+				Experimental: true,
+			},
+			{
+				Name:        "close",
+				Description: "Tries to close page, running its beforeunload hooks, if any.",
+			},
+			{
+				Name:         "setWebLifecycleState",
+				Description:  "Tries to update the web lifecycle state of the page. It will transition the page to the given state according to: https://github.com/WICG/web-lifecycle/",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "state", Description: "Target lifecycle state", Type: "string", GoType: reflect.TypeOf((*PageSetWebLifecycleStateState)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "stopScreencast",
+				Description:  "Stops sending each frame in the `screencastFrame`.",
+				Experimental: true,
+			},
+			{
+				Name:         "produceCompilationCache",
+				Description:  "Requests backend to produce compilation cache for the specified scripts. `scripts` are appended to the list of scripts for which the cache would be produced. The list may be reset during page navigation. When script with a matching URL is encountered, the cache is optionally produced upon backend discretion, based on internal heuristics. See also: `Page.compilationCacheProduced`.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "scripts", Description: "", Type: "array", Items: "Page.CompilationCacheParams", GoType: reflect.TypeOf((*[]*PageCompilationCacheParams)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "addCompilationCache",
+				Description:  "Seeds compilation cache for given url. Compilation cache does not survive cross-process navigation.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "url", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "data", Description: "Base64-encoded data (Encoded as a base64 string when passed over JSON)", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "clearCompilationCache",
+				Description:  "Clears seeded compilation cache.",
+				Experimental: true,
+			},
+			{
+				Name:         "setSPCTransactionMode",
+				Description:  "Sets the Secure Payment Confirmation transaction mode. https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "mode", Description: "", Type: "string", GoType: reflect.TypeOf((*PageSetSPCTransactionModeMode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setRPHRegistrationMode",
+				Description:  "Extensions for Custom Handlers API: https://html.spec.whatwg.org/multipage/system-state.html#rph-automation",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "mode", Description: "", Type: "string", GoType: reflect.TypeOf((*PageSetRPHRegistrationModeMode)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "generateTestReport",
+				Description:  "Generates a report for testing.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "message", Description: "Message to be displayed in the report.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "group", Description: "Specifies the endpoint group to deliver the report to.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "waitForDebugger",
+				Description:  "Pauses page execution. Can be resumed using generic Runtime.runIfWaitingForDebugger.",
+				Experimental: true,
+			},
+			{
+				Name:        "setInterceptFileChooserDialog",
+				Description: "Intercept file chooser requests and transfer control to protocol clients. When file chooser interception is enabled, native file chooser dialog is not shown. Instead, a protocol event `Page.fileChooserOpened` is emitted.",
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "cancel", Description: "If true, cancels the dialog by emitting relevant events (if any) in addition to not showing it if the interception is enabled (default: false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setPrerenderingAllowed",
+				Description:  "Enable/disable prerendering manually.  This command is a short-term solution for https://crbug.com/1440085. See https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA for more details.  TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab targets.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "isAllowed", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "domContentEventFired",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageDomContentEventFiredEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "fileChooserOpened",
+				Description: "Emitted only when `page.interceptFileChooser` is enabled.",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame containing input node.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "mode", Description: "Input mode.", Type: "string", GoType: reflect.TypeOf((*PageFileChooserOpenedMode)(nil)).Elem()},
+					{Name: "backendNodeId", Description: "Input node id. Only present for file choosers opened via an `<input type=\"file\">` element.", Type: "DOM.BackendNodeId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFileChooserOpenedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "frameAttached",
+				Description: "Fired when frame has been attached to its parent.",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that has been attached.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "parentFrameId", Description: "Parent frame identifier.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "stack", Description: "JavaScript stack trace of when frame was attached, only set if frame initiated from script.", Type: "Runtime.StackTrace", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameAttachedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "frameClearedScheduledNavigation",
+				Description: "Fired when frame no longer has a scheduled navigation.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that has cleared its scheduled navigation.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameClearedScheduledNavigationEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "frameDetached",
+				Description: "Fired when frame has been detached from its parent.",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that has been detached.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "reason", Description: "", Type: "string", GoType: reflect.TypeOf((*PageFrameDetachedReason)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameDetachedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "frameSubtreeWillBeDetached",
+				Description:  "Fired before frame subtree is detached. Emitted before any frame of the subtree is actually detached.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that is the root of the subtree that will be detached.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameSubtreeWillBeDetachedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "frameNavigated",
+				Description: "Fired once navigation of the frame has completed. Frame is now associated with the new loader.",
+				Params: []*ParamInfo{
+					{Name: "frame", Description: "Frame object.", Type: "Page.Frame", GoType: reflect.TypeOf((**PageFrame)(nil)).Elem()},
+					{Name: "type", Description: " enum values: Navigation, BackForwardCacheRestore", Type: "Page.NavigationType", GoType: reflect.TypeOf((*PageNavigationType)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameNavigatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "documentOpened",
+				Description:  "Fired when opening document to write to.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frame", Description: "Frame object.", Type: "Page.Frame", GoType: reflect.TypeOf((**PageFrame)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageDocumentOpenedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "frameResized",
+				Description:  "",
+				Experimental: true,
+				Type:         reflect.TypeOf((*PageFrameResizedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "frameStartedNavigating",
+				Description:  "Fired when a navigation starts. This event is fired for both renderer-initiated and browser-initiated navigations. For renderer-initiated navigations, the event is fired after `frameRequestedNavigation`. Navigation may still be cancelled after the event is issued. Multiple events can be fired for a single navigation, for example, when a same-document navigation becomes a cross-document navigation (such as in the case of a frameset).",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "ID of the frame that is being navigated.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "The URL the navigation started with. The final URL can be different.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "loaderId", Description: "Loader identifier. Even though it is present in case of same-document navigation, the previously committed loaderId would not change unless the navigation changes from a same-document to a cross-document navigation.", Type: "Network.LoaderId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "navigationType", Description: "", Type: "string", GoType: reflect.TypeOf((*PageFrameStartedNavigatingNavigationType)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameStartedNavigatingEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "frameRequestedNavigation",
+				Description:  "Fired when a renderer-initiated navigation is requested. Navigation may still be cancelled after the event is issued.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that is being navigated.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "reason", Description: "The reason for the navigation. enum values: anchorClick, formSubmissionGet, formSubmissionPost, httpHeaderRefresh, initialFrameNavigation, metaTagRefresh, other, pageBlockInterstitial, reload, scriptInitiated", Type: "Page.ClientNavigationReason", GoType: reflect.TypeOf((*PageClientNavigationReason)(nil)).Elem()},
+					{Name: "url", Description: "The destination URL for the requested navigation.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "disposition", Description: "The disposition for the navigation. enum values: currentTab, newTab, newWindow, download", Type: "Page.ClientNavigationDisposition", GoType: reflect.TypeOf((*PageClientNavigationDisposition)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameRequestedNavigationEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "frameScheduledNavigation",
+				Description: "Fired when frame schedules a potential navigation.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that has scheduled a navigation.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "delay", Description: "Delay (in seconds) until the navigation is scheduled to begin. The navigation is not guaranteed to start.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "reason", Description: "The reason for the navigation. enum values: anchorClick, formSubmissionGet, formSubmissionPost, httpHeaderRefresh, initialFrameNavigation, metaTagRefresh, other, pageBlockInterstitial, reload, scriptInitiated", Type: "Page.ClientNavigationReason", GoType: reflect.TypeOf((*PageClientNavigationReason)(nil)).Elem()},
+					{Name: "url", Description: "The destination URL for the scheduled navigation.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameScheduledNavigationEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "frameStartedLoading",
+				Description:  "Fired when frame has started loading.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that has started loading.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameStartedLoadingEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "frameStoppedLoading",
+				Description:  "Fired when frame has stopped loading.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that has stopped loading.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageFrameStoppedLoadingEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "downloadWillBegin",
+				Description:  "Fired when page is about to start a download. Deprecated. Use Browser.downloadWillBegin instead.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame that caused download to begin.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "guid", Description: "Global unique identifier of the download.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "URL of the resource being downloaded.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "suggestedFilename", Description: "Suggested file name of the resource (the actual name of the file saved on disk may differ).", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageDownloadWillBeginEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "downloadProgress",
+				Description:  "Fired when download makes progress. Last call has |done| == true. Deprecated. Use Browser.downloadProgress instead.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "guid", Description: "Global unique identifier of the download.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "totalBytes", Description: "Total expected bytes to download.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "receivedBytes", Description: "Total bytes received.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "state", Description: "Download status.", Type: "string", GoType: reflect.TypeOf((*PageDownloadProgressState)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageDownloadProgressEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "interstitialHidden",
+				Description: "Fired when interstitial page was hidden",
+				Type:        reflect.TypeOf((*PageInterstitialHiddenEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "interstitialShown",
+				Description: "Fired when interstitial page was shown",
+				Type:        reflect.TypeOf((*PageInterstitialShownEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "javascriptDialogClosed",
+				Description: "Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been closed.",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Frame id.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "result", Description: "Whether dialog was confirmed.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "userInput", Description: "User input in case of prompt.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageJavascriptDialogClosedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "javascriptDialogOpening",
+				Description: "Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to open.",
+				Params: []*ParamInfo{
+					{Name: "url", Description: "Frame url.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "frameId", Description: "Frame id.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "message", Description: "Message that will be displayed by the dialog.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "type", Description: "Dialog type. enum values: alert, confirm, prompt, beforeunload", Type: "Page.DialogType", GoType: reflect.TypeOf((*PageDialogType)(nil)).Elem()},
+					{Name: "hasBrowserHandler", Description: "True iff browser is capable showing or acting on the given dialog. When browser has no dialog handler for given target, calling alert while Page domain is engaged will stall the page execution. Execution can be resumed via calling Page.handleJavaScriptDialog.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "defaultPrompt", Description: "Default dialog prompt.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageJavascriptDialogOpeningEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "lifecycleEvent",
+				Description: "Fired for lifecycle events (navigation, load, paint, etc) in the current target (including local frames).",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "loaderId", Description: "Loader identifier. Empty string if the request is fetched from worker.", Type: "Network.LoaderId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "name", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageLifecycleEventEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "backForwardCacheNotUsed",
+				Description:  "Fired for failed bfcache history navigations if BackForwardCache feature is enabled. Do not assume any ordering with the Page.frameNavigated event. This event is fired only for main-frame history navigation where the document changes (non-same-document navigations), when bfcache navigation fails.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "loaderId", Description: "The loader id for the associated navigation.", Type: "Network.LoaderId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "frameId", Description: "The frame id of the associated frame.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "notRestoredExplanations", Description: "Array of reasons why the page could not be cached. This must not be empty.", Type: "array", Items: "Page.BackForwardCacheNotRestoredExplanation", GoType: reflect.TypeOf((*[]*PageBackForwardCacheNotRestoredExplanation)(nil)).Elem()},
+					{Name: "notRestoredExplanationsTree", Description: "Tree structure of reasons why the page could not be cached for each frame.", Type: "Page.BackForwardCacheNotRestoredExplanationTree", Optional: true, GoType: reflect.TypeOf((**PageBackForwardCacheNotRestoredExplanationTree)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageBackForwardCacheNotUsedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "loadEventFired",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "timestamp", Description: "", Type: "Network.MonotonicTime", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageLoadEventFiredEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "navigatedWithinDocument",
+				Description:  "Fired when same-document navigation happens, e.g. due to history API usage or anchor navigation.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "Id of the frame.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "Frame's new url.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "navigationType", Description: "Navigation type", Type: "string", GoType: reflect.TypeOf((*PageNavigatedWithinDocumentNavigationType)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageNavigatedWithinDocumentEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "screencastFrame",
+				Description:  "Compressed image data requested by the `startScreencast`.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "data", Description: "Base64-encoded compressed image. (Encoded as a base64 string when passed over JSON)", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "metadata", Description: "Screencast frame metadata.", Type: "Page.ScreencastFrameMetadata", GoType: reflect.TypeOf((**PageScreencastFrameMetadata)(nil)).Elem()},
+					{Name: "sessionId", Description: "Frame number.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageScreencastFrameEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "screencastVisibilityChanged",
+				Description:  "Fired when the page with currently enabled screencast was shown or hidden `.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "visible", Description: "True if the page is visible.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageScreencastVisibilityChangedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "windowOpen",
+				Description: "Fired when a new window is going to be opened, via window.open(), link click, form submission, etc.",
+				Params: []*ParamInfo{
+					{Name: "url", Description: "The URL for the new window.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "windowName", Description: "Window name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "windowFeatures", Description: "An array of enabled window features.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "userGesture", Description: "Whether or not it was triggered by user gesture.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageWindowOpenEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "compilationCacheProduced",
+				Description:  "Issued for every compilation cache generated.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "url", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "data", Description: "Base64-encoded data (Encoded as a base64 string when passed over JSON)", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PageCompilationCacheProducedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:        "Performance",
+		Description: "",
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "Disable collecting and reporting metrics.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enable collecting and reporting metrics.",
+				Params: []*ParamInfo{
+					{Name: "timeDomain", Description: "Time domain to use for collecting and reporting duration metrics.", Type: "string", Optional: true, GoType: reflect.TypeOf((*PerformanceEnableTimeDomain)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setTimeDomain",
+				Description:  "Sets time domain to use for collecting and reporting duration metrics. Note that this must be called before enabling metrics collection. Calling this method while metrics collection is enabled returns an error.",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "timeDomain", Description: "Time domain", Type: "string", GoType: reflect.TypeOf((*PerformanceSetTimeDomainTimeDomain)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getMetrics",
+				Description: "Retrieve current values of run-time metrics.",
+				Returns: []*ParamInfo{
+					{Name: "metrics", Description: "Current values for run-time metrics.", Type: "array", Items: "Performance.Metric", GoType: reflect.TypeOf((*[]*PerformanceMetric)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "metrics",
+				Description: "Current values of the metrics.",
+				Params: []*ParamInfo{
+					{Name: "metrics", Description: "Current values of the metrics.", Type: "array", Items: "Performance.Metric", GoType: reflect.TypeOf((*[]*PerformanceMetric)(nil)).Elem()},
+					{Name: "title", Description: "Timestamp title.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PerformanceMetricsEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "PerformanceTimeline",
+		Description:  "Reporting of performance timeline events, as specified in https://w3c.github.io/performance-timeline/#dom-performanceobserver.",
+		Experimental: true,
+		Dependencies: []string{"DOM", "Network"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "Previously buffered events would be reported before method returns. See also: timelineEventAdded",
+				Params: []*ParamInfo{
+					{Name: "eventTypes", Description: "The types of event to report, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype The specified filter overrides any previous filters, passing empty filter disables recording. Note that not all types exposed to the web platform are currently supported.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "timelineEventAdded",
+				Description: "Sent when a performance timeline event is added. See reportPerformanceTimeline method.",
+				Params: []*ParamInfo{
+					{Name: "event", Description: "", Type: "PerformanceTimeline.TimelineEvent", GoType: reflect.TypeOf((**PerformanceTimelineTimelineEvent)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PerformanceTimelineTimelineEventAddedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:        "Security",
+		Description: "Security",
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "Disables tracking security state changes.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables tracking security state changes.",
+			},
+			{
+				Name:        "setIgnoreCertificateErrors",
+				Description: "Enable/disable whether all certificate errors should be ignored.",
+				Params: []*ParamInfo{
+					{Name: "ignore", Description: "If true, all certificate errors will be ignored.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "handleCertificateError",
+				Description: "Handles a certificate error that fired a certificateError event.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "eventId", Description: "The ID of the event.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "action", Description: "The action to take on the certificate error. enum values: continue, cancel", Type: "Security.CertificateErrorAction", GoType: reflect.TypeOf((*SecurityCertificateErrorAction)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setOverrideCertificateErrors",
+				Description: "Enable/disable overriding certificate errors. If enabled, all certificate error events need to be handled by the DevTools client and should be answered with `handleCertificateError` commands.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "override", Description: "If true, certificate errors will be overridden.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "certificateError",
+				Description: "There is a certificate error. If overriding certificate errors is enabled, then it should be handled with the `handleCertificateError` command. Note: this event does not fire if the certificate error has been allowed internally. Only one client per target should override certificate errors at the same time.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "eventId", Description: "The ID of the event.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "errorType", Description: "The type of the error.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "requestURL", Description: "The url that was requested.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*SecurityCertificateErrorEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "visibleSecurityStateChanged",
+				Description:  "The security state of the page changed.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "visibleSecurityState", Description: "Security state information about the page.", Type: "Security.VisibleSecurityState", GoType: reflect.TypeOf((**SecurityVisibleSecurityState)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*SecurityVisibleSecurityStateChangedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "securityStateChanged",
+				Description: "The security state of the page changed. No longer being sent.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "securityState", Description: "Security state. enum values: unknown, neutral, insecure, secure, info, insecure-broken", Type: "Security.SecurityState", GoType: reflect.TypeOf((*SecuritySecurityState)(nil)).Elem()},
+					{Name: "schemeIsCryptographic", Description: "True if the page was loaded over cryptographic transport such as HTTPS.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "explanations", Description: "Previously a list of explanations for the security state. Now always empty.", Type: "array", Items: "Security.SecurityStateExplanation", GoType: reflect.TypeOf((*[]*SecuritySecurityStateExplanation)(nil)).Elem()},
+					{Name: "insecureContentStatus", Description: "Information about insecure content on the page.", Type: "Security.InsecureContentStatus", GoType: reflect.TypeOf((**SecurityInsecureContentStatus)(nil)).Elem()},
+					{Name: "summary", Description: "Overrides user-visible description of the state. Always omitted.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*SecuritySecurityStateChangedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "ServiceWorker",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"Target"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "deliverPushMessage",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "registrationId", Description: "", Type: "ServiceWorker.RegistrationID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "data", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "",
+			},
+			{
+				Name:        "dispatchSyncEvent",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "registrationId", Description: "", Type: "ServiceWorker.RegistrationID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "tag", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "lastChance", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "dispatchPeriodicSyncEvent",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "registrationId", Description: "", Type: "ServiceWorker.RegistrationID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "tag", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "enable",
+				Description: "",
+			},
+			{
+				Name:        "setForceUpdateOnPageLoad",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "forceUpdateOnPageLoad", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "skipWaiting",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "scopeURL", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "startWorker",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "scopeURL", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopAllWorkers",
+				Description: "",
+			},
+			{
+				Name:        "stopWorker",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "versionId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "unregister",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "scopeURL", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "updateRegistration",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "scopeURL", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "workerErrorReported",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "errorMessage", Description: "", Type: "ServiceWorker.ServiceWorkerErrorMessage", GoType: reflect.TypeOf((**ServiceWorkerServiceWorkerErrorMessage)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*ServiceWorkerWorkerErrorReportedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "workerRegistrationUpdated",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "registrations", Description: "", Type: "array", Items: "ServiceWorker.ServiceWorkerRegistration", GoType: reflect.TypeOf((*[]*ServiceWorkerServiceWorkerRegistration)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*ServiceWorkerWorkerRegistrationUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "workerVersionUpdated",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "versions", Description: "", Type: "array", Items: "ServiceWorker.ServiceWorkerVersion", GoType: reflect.TypeOf((*[]*ServiceWorkerServiceWorkerVersion)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*ServiceWorkerWorkerVersionUpdatedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Storage",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"Browser", "Network"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "getStorageKeyForFrame",
+				Description: "Returns a storage key given a frame id.",
+				Params: []*ParamInfo{
+					{Name: "frameId", Description: "", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "storageKey", Description: "", Type: "Storage.SerializedStorageKey", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearDataForOrigin",
+				Description: "Clears storage for origin.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Security origin.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageTypes", Description: "Comma separated list of StorageType to clear.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearDataForStorageKey",
+				Description: "Clears storage for storage key.",
+				Params: []*ParamInfo{
+					{Name: "storageKey", Description: "Storage key.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageTypes", Description: "Comma separated list of StorageType to clear.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getCookies",
+				Description: "Returns all browser cookies.",
+				Params: []*ParamInfo{
+					{Name: "browserContextId", Description: "Browser context to use when called on the browser endpoint.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "cookies", Description: "Array of cookie objects.", Type: "array", Items: "Network.Cookie", GoType: reflect.TypeOf((*[]*NetworkCookie)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setCookies",
+				Description: "Sets given cookies.",
+				Params: []*ParamInfo{
+					{Name: "cookies", Description: "Cookies to be set.", Type: "array", Items: "Network.CookieParam", GoType: reflect.TypeOf((*[]*NetworkCookieParam)(nil)).Elem()},
+					{Name: "browserContextId", Description: "Browser context to use when called on the browser endpoint.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearCookies",
+				Description: "Clears cookies.",
+				Params: []*ParamInfo{
+					{Name: "browserContextId", Description: "Browser context to use when called on the browser endpoint.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getUsageAndQuota",
+				Description: "Returns usage and quota in bytes.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Security origin.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "usage", Description: "Storage usage (bytes).", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "quota", Description: "Storage quota (bytes).", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "overrideActive", Description: "Whether or not the origin has an active storage quota override", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "usageBreakdown", Description: "Storage usage per type (bytes).", Type: "array", Items: "Storage.UsageForType", GoType: reflect.TypeOf((*[]*StorageUsageForType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "overrideQuotaForOrigin",
+				Description:  "Override quota for the specified origin",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Security origin.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "quotaSize", Description: "The quota size (in bytes) to override the original quota with. If this is called multiple times, the overridden quota will be equal to the quotaSize provided in the final call. If this is called without specifying a quotaSize, the quota will be reset to the default value for the specified origin. If this is called multiple times with different origins, the override will be maintained for each origin until it is disabled (called without a quotaSize).", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "trackCacheStorageForOrigin",
+				Description: "Registers origin to be notified when an update occurs to its cache storage list.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Security origin.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "trackCacheStorageForStorageKey",
+				Description: "Registers storage key to be notified when an update occurs to its cache storage list.",
+				Params: []*ParamInfo{
+					{Name: "storageKey", Description: "Storage key.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "trackIndexedDBForOrigin",
+				Description: "Registers origin to be notified when an update occurs to its IndexedDB.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Security origin.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "trackIndexedDBForStorageKey",
+				Description: "Registers storage key to be notified when an update occurs to its IndexedDB.",
+				Params: []*ParamInfo{
+					{Name: "storageKey", Description: "Storage key.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "untrackCacheStorageForOrigin",
+				Description: "Unregisters origin from receiving notifications for cache storage.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Security origin.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "untrackCacheStorageForStorageKey",
+				Description: "Unregisters storage key from receiving notifications for cache storage.",
+				Params: []*ParamInfo{
+					{Name: "storageKey", Description: "Storage key.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "untrackIndexedDBForOrigin",
+				Description: "Unregisters origin from receiving notifications for IndexedDB.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Security origin.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "untrackIndexedDBForStorageKey",
+				Description: "Unregisters storage key from receiving notifications for IndexedDB.",
+				Params: []*ParamInfo{
+					{Name: "storageKey", Description: "Storage key.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getTrustTokens",
+				Description:  "Returns the number of stored Trust Tokens per issuer for the current browsing context.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "tokens", Description: "", Type: "array", Items: "Storage.TrustTokens", GoType: reflect.TypeOf((*[]*StorageTrustTokens)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "clearTrustTokens",
+				Description:  "Removes all Trust Tokens issued by the provided issuerOrigin. Leaves other stored data, including the issuer's Redemption Records, intact.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "issuerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "didDeleteTokens", Description: "True if any tokens were deleted, false otherwise.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getInterestGroupDetails",
+				Description:  "Gets details for a named interest group.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "ownerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "name", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "details", Description: "This largely corresponds to: https://wicg.github.io/turtledove/#dictdef-generatebidinterestgroup but has absolute expirationTime instead of relative lifetimeMs and also adds joiningOrigin.", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setInterestGroupTracking",
+				Description:  "Enables/Disables issuing of interestGroupAccessed events.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enable", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setInterestGroupAuctionTracking",
+				Description:  "Enables/Disables issuing of interestGroupAuctionEventOccurred and interestGroupAuctionNetworkRequestCreated.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enable", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getSharedStorageMetadata",
+				Description:  "Gets metadata for an origin's shared storage.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "ownerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "metadata", Description: "", Type: "Storage.SharedStorageMetadata", GoType: reflect.TypeOf((**StorageSharedStorageMetadata)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getSharedStorageEntries",
+				Description:  "Gets the entries in an given origin's shared storage.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "ownerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "entries", Description: "", Type: "array", Items: "Storage.SharedStorageEntry", GoType: reflect.TypeOf((*[]*StorageSharedStorageEntry)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setSharedStorageEntry",
+				Description:  "Sets entry with `key` and `value` for a given origin's shared storage.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "ownerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "key", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "value", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "ignoreIfPresent", Description: "If `ignoreIfPresent` is included and true, then only sets the entry if `key` doesn't already exist.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "deleteSharedStorageEntry",
+				Description:  "Deletes entry for `key` (if it exists) for a given origin's shared storage.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "ownerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "key", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "clearSharedStorageEntries",
+				Description:  "Clears all entries for a given origin's shared storage.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "ownerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "resetSharedStorageBudget",
+				Description:  "Resets the budget for `ownerOrigin` by clearing all budget withdrawals.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "ownerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setSharedStorageTracking",
+				Description:  "Enables/disables issuing of sharedStorageAccessed events.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enable", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setStorageBucketTracking",
+				Description:  "Set tracking for a storage key's buckets.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "storageKey", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "enable", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "deleteStorageBucket",
+				Description:  "Deletes the Storage Bucket with the given storage key and bucket name.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "bucket", Description: "", Type: "Storage.StorageBucket", GoType: reflect.TypeOf((**StorageStorageBucket)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "runBounceTrackingMitigations",
+				Description:  "Deletes state for sites identified as potential bounce trackers, immediately.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "deletedSites", Description: "", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setAttributionReportingLocalTestingMode",
+				Description:  "https://wicg.github.io/attribution-reporting-api/",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "If enabled, noise is suppressed and reports are sent immediately.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setAttributionReportingTracking",
+				Description:  "Enables/disables issuing of Attribution Reporting events.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enable", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "sendPendingAttributionReports",
+				Description:  "Sends all pending Attribution Reports immediately, regardless of their scheduled report time.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "numSent", Description: "The number of reports that were sent.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getRelatedWebsiteSets",
+				Description:  "Returns the effective Related Website Sets in use by this profile for the browser session. The effective Related Website Sets will not change during a browser session.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "sets", Description: "", Type: "array", Items: "Storage.RelatedWebsiteSet", GoType: reflect.TypeOf((*[]*StorageRelatedWebsiteSet)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getAffectedUrlsForThirdPartyCookieMetadata",
+				Description:  "Returns the list of URLs from a page and its embedded resources that match existing grace period URL pattern rules. https://developers.google.com/privacy-sandbox/cookies/temporary-exceptions/grace-period",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "firstPartyUrl", Description: "The URL of the page currently being visited.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "thirdPartyUrls", Description: "The list of embedded resource URLs from the page.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "matchedUrls", Description: "Array of matching URLs. If there is a primary pattern match for the first- party URL, only the first-party URL is returned in the array.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setProtectedAudienceKAnonymity",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "owner", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "name", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "hashes", Description: "", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "cacheStorageContentUpdated",
+				Description: "A cache's contents have been modified.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Origin to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bucketId", Description: "Storage bucket to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "cacheName", Description: "Name of cache in origin.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageCacheStorageContentUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "cacheStorageListUpdated",
+				Description: "A cache has been added/deleted.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Origin to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bucketId", Description: "Storage bucket to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageCacheStorageListUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "indexedDBContentUpdated",
+				Description: "The origin's IndexedDB object store has been modified.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Origin to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bucketId", Description: "Storage bucket to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "databaseName", Description: "Database to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectStoreName", Description: "ObjectStore to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageIndexedDBContentUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "indexedDBListUpdated",
+				Description: "The origin's IndexedDB database list has been modified.",
+				Params: []*ParamInfo{
+					{Name: "origin", Description: "Origin to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "storageKey", Description: "Storage key to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bucketId", Description: "Storage bucket to update.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageIndexedDBListUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "interestGroupAccessed",
+				Description: "One of the interest groups was accessed. Note that these events are global to all targets sharing an interest group store.",
+				Params: []*ParamInfo{
+					{Name: "accessTime", Description: "", Type: "Network.TimeSinceEpoch", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "type", Description: " enum values: join, leave, update, loaded, bid, win, additionalBid, additionalBidWin, topLevelBid, topLevelAdditionalBid, clear", Type: "Storage.InterestGroupAccessType", GoType: reflect.TypeOf((*StorageInterestGroupAccessType)(nil)).Elem()},
+					{Name: "ownerOrigin", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "name", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "componentSellerOrigin", Description: "For topLevelBid/topLevelAdditionalBid, and when appropriate, win and additionalBidWin", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bid", Description: "For bid or somethingBid event, if done locally and not on a server.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "bidCurrency", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "uniqueAuctionId", Description: "For non-global events --- links to interestGroupAuctionEvent", Type: "Storage.InterestGroupAuctionId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageInterestGroupAccessedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "interestGroupAuctionEventOccurred",
+				Description: "An auction involving interest groups is taking place. These events are target-specific.",
+				Params: []*ParamInfo{
+					{Name: "eventTime", Description: "", Type: "Network.TimeSinceEpoch", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "type", Description: " enum values: started, configResolved", Type: "Storage.InterestGroupAuctionEventType", GoType: reflect.TypeOf((*StorageInterestGroupAuctionEventType)(nil)).Elem()},
+					{Name: "uniqueAuctionId", Description: "", Type: "Storage.InterestGroupAuctionId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "parentAuctionId", Description: "Set for child auctions.", Type: "Storage.InterestGroupAuctionId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "auctionConfig", Description: "Set for started and configResolved", Type: "object", Optional: true, GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageInterestGroupAuctionEventOccurredEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "interestGroupAuctionNetworkRequestCreated",
+				Description: "Specifies which auctions a particular network fetch may be related to, and in what role. Note that it is not ordered with respect to Network.requestWillBeSent (but will happen before loadingFinished loadingFailed).",
+				Params: []*ParamInfo{
+					{Name: "type", Description: " enum values: bidderJs, bidderWasm, sellerJs, bidderTrustedSignals, sellerTrustedSignals", Type: "Storage.InterestGroupAuctionFetchType", GoType: reflect.TypeOf((*StorageInterestGroupAuctionFetchType)(nil)).Elem()},
+					{Name: "requestId", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "auctions", Description: "This is the set of the auctions using the worklet that issued this request.  In the case of trusted signals, it's possible that only some of them actually care about the keys being queried.", Type: "array", Items: "Storage.InterestGroupAuctionId", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageInterestGroupAuctionNetworkRequestCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "sharedStorageAccessed",
+				Description: "Shared storage was accessed by the associated page. The following parameters are included in all events.",
+				Params: []*ParamInfo{
+					{Name: "accessTime", Description: "Time of the access.", Type: "Network.TimeSinceEpoch", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "scope", Description: "Enum value indicating the access scope. enum values: window, sharedStorageWorklet, protectedAudienceWorklet, header", Type: "Storage.SharedStorageAccessScope", GoType: reflect.TypeOf((*StorageSharedStorageAccessScope)(nil)).Elem()},
+					{Name: "method", Description: "Enum value indicating the Shared Storage API method invoked. enum values: addModule, createWorklet, selectURL, run, batchUpdate, set, append, delete, clear, get, keys, values, entries, length, remainingBudget", Type: "Storage.SharedStorageAccessMethod", GoType: reflect.TypeOf((*StorageSharedStorageAccessMethod)(nil)).Elem()},
+					{Name: "mainFrameId", Description: "DevTools Frame Token for the primary frame tree's root.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "ownerOrigin", Description: "Serialization of the origin owning the Shared Storage data.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "ownerSite", Description: "Serialization of the site owning the Shared Storage data.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "params", Description: "The sub-parameters wrapped by `params` are all optional and their presence/absence depends on `type`.", Type: "Storage.SharedStorageAccessParams", GoType: reflect.TypeOf((**StorageSharedStorageAccessParams)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageSharedStorageAccessedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "sharedStorageWorkletOperationExecutionFinished",
+				Description: "A shared storage run or selectURL operation finished its execution. The following parameters are included in all events.",
+				Params: []*ParamInfo{
+					{Name: "finishedTime", Description: "Time that the operation finished.", Type: "Network.TimeSinceEpoch", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "executionTime", Description: "Time, in microseconds, from start of shared storage JS API call until end of operation execution in the worklet.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "method", Description: "Enum value indicating the Shared Storage API method invoked. enum values: addModule, createWorklet, selectURL, run, batchUpdate, set, append, delete, clear, get, keys, values, entries, length, remainingBudget", Type: "Storage.SharedStorageAccessMethod", GoType: reflect.TypeOf((*StorageSharedStorageAccessMethod)(nil)).Elem()},
+					{Name: "operationId", Description: "ID of the operation call.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "workletTargetId", Description: "Hex representation of the DevTools token used as the TargetID for the associated shared storage worklet.", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "mainFrameId", Description: "DevTools Frame Token for the primary frame tree's root.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "ownerOrigin", Description: "Serialization of the origin owning the Shared Storage data.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageSharedStorageWorkletOperationExecutionFinishedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "storageBucketCreatedOrUpdated",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "bucketInfo", Description: "", Type: "Storage.StorageBucketInfo", GoType: reflect.TypeOf((**StorageStorageBucketInfo)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageStorageBucketCreatedOrUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "storageBucketDeleted",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "bucketId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageStorageBucketDeletedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "attributionReportingSourceRegistered",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "registration", Description: "", Type: "Storage.AttributionReportingSourceRegistration", GoType: reflect.TypeOf((**StorageAttributionReportingSourceRegistration)(nil)).Elem()},
+					{Name: "result", Description: " enum values: success, internalError, insufficientSourceCapacity, insufficientUniqueDestinationCapacity, excessiveReportingOrigins, prohibitedByBrowserPolicy, successNoised, destinationReportingLimitReached, destinationGlobalLimitReached, destinationBothLimitsReached, reportingOriginsPerSiteLimitReached, exceedsMaxChannelCapacity, exceedsMaxScopesChannelCapacity, exceedsMaxTriggerStateCardinality, exceedsMaxEventStatesLimit, destinationPerDayReportingLimitReached", Type: "Storage.AttributionReportingSourceRegistrationResult", GoType: reflect.TypeOf((*StorageAttributionReportingSourceRegistrationResult)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageAttributionReportingSourceRegisteredEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "attributionReportingTriggerRegistered",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "registration", Description: "", Type: "Storage.AttributionReportingTriggerRegistration", GoType: reflect.TypeOf((**StorageAttributionReportingTriggerRegistration)(nil)).Elem()},
+					{Name: "eventLevel", Description: " enum values: success, successDroppedLowerPriority, internalError, noCapacityForAttributionDestination, noMatchingSources, deduplicated, excessiveAttributions, priorityTooLow, neverAttributedSource, excessiveReportingOrigins, noMatchingSourceFilterData, prohibitedByBrowserPolicy, noMatchingConfigurations, excessiveReports, falselyAttributedSource, reportWindowPassed, notRegistered, reportWindowNotStarted, noMatchingTriggerData", Type: "Storage.AttributionReportingEventLevelResult", GoType: reflect.TypeOf((*StorageAttributionReportingEventLevelResult)(nil)).Elem()},
+					{Name: "aggregatable", Description: " enum values: success, internalError, noCapacityForAttributionDestination, noMatchingSources, excessiveAttributions, excessiveReportingOrigins, noHistograms, insufficientBudget, insufficientNamedBudget, noMatchingSourceFilterData, notRegistered, prohibitedByBrowserPolicy, deduplicated, reportWindowPassed, excessiveReports", Type: "Storage.AttributionReportingAggregatableResult", GoType: reflect.TypeOf((*StorageAttributionReportingAggregatableResult)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageAttributionReportingTriggerRegisteredEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "attributionReportingReportSent",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "url", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "body", Description: "", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "result", Description: " enum values: sent, prohibited, failedToAssemble, expired", Type: "Storage.AttributionReportingReportResult", GoType: reflect.TypeOf((*StorageAttributionReportingReportResult)(nil)).Elem()},
+					{Name: "netError", Description: "If result is `sent`, populated with net/HTTP status.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "netErrorName", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "httpStatusCode", Description: "", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageAttributionReportingReportSentEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "attributionReportingVerboseDebugReportSent",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "url", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "body", Description: "", Type: "array", Items: "object", Optional: true, GoType: reflect.TypeOf((*[]map[string]interface{})(nil)).Elem()},
+					{Name: "netError", Description: "", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "netErrorName", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "httpStatusCode", Description: "", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*StorageAttributionReportingVerboseDebugReportSentEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "SystemInfo",
+		Description:  "The SystemInfo domain defines methods and events for querying low-level system information.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "getInfo",
+				Description: "Returns information about the system.",
+				Returns: []*ParamInfo{
+					{Name: "gpu", Description: "Information about the GPUs on the system.", Type: "SystemInfo.GPUInfo", GoType: reflect.TypeOf((**SystemInfoGPUInfo)(nil)).Elem()},
+					{Name: "modelName", Description: "A platform-dependent description of the model of the machine. On Mac OS, this is, for example, 'MacBookPro'. Will be the empty string if not supported.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "modelVersion", Description: "A platform-dependent description of the version of the machine. On Mac OS, this is, for example, '10.1'. Will be the empty string if not supported.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "commandLine", Description: "The command line string used to launch the browser. Will be the empty string if not supported.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getFeatureState",
+				Description: "Returns information about the feature state.",
+				Params: []*ParamInfo{
+					{Name: "featureState", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "featureEnabled", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getProcessInfo",
+				Description: "Returns information about all running processes.",
+				Returns: []*ParamInfo{
+					{Name: "processInfo", Description: "An array of process info blocks.", Type: "array", Items: "SystemInfo.ProcessInfo", GoType: reflect.TypeOf((*[]*SystemInfoProcessInfo)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:        "Target",
+		Description: "Supports additional targets discovery and allows to attach to them.",
+		Commands: []*CommandInfo{
+			{
+				Name:        "activateTarget",
+				Description: "Activates (focuses) the target.",
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "attachToTarget",
+				Description: "Attaches to the target with given id.",
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "flatten", Description: "Enables \"flat\" access to the session via specifying sessionId attribute in the commands. We plan to make this the default, deprecate non-flattened mode, and eventually retire it. See crbug.com/991325.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "sessionId", Description: "Id assigned to the session.", Type: "Target.SessionID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "attachToBrowserTarget",
+				Description:  "Attaches to the browser target, only uses flat sessionId mode.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "sessionId", Description: "Id assigned to the session.", Type: "Target.SessionID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "closeTarget",
+				Description: "Closes the target. If the target is a page that gets closed too.",
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "success", Description: "Always set to true. If an error occurs, the response indicates protocol error.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "exposeDevToolsProtocol",
+				Description:  "Inject object to the target's main frame that provides a communication channel with browser target.  Injected object will be available as `window[bindingName]`.  The object has the following API: - `binding.send(json)` - a method to send messages over the remote debugging protocol - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bindingName", Description: "Binding name, 'cdp' if not specified.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "inheritPermissions", Description: "If true, inherits the current root session's permissions (default: false).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "createBrowserContext",
+				Description: "Creates a new empty BrowserContext. Similar to an incognito profile but you can have more than one.",
+				Params: []*ParamInfo{
+					{Name: "disposeOnDetach", Description: "If specified, disposes this context when debugging session disconnects.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "proxyServer", Description: "Proxy server, similar to the one passed to --proxy-server", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "proxyBypassList", Description: "Proxy bypass list, similar to the one passed to --proxy-bypass-list", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "originsWithUniversalNetworkAccess", Description: "An optional list of origins to grant unlimited cross-origin access to. Parts of the URL other than those constituting origin are ignored.", Type: "array", Items: "string", Optional: true, GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "browserContextId", Description: "The id of the context created.", Type: "Browser.BrowserContextID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getBrowserContexts",
+				Description: "Returns all browser contexts created with `Target.createBrowserContext` method.",
+				Returns: []*ParamInfo{
+					{Name: "browserContextIds", Description: "An array of browser context ids.", Type: "array", Items: "Browser.BrowserContextID", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "createTarget",
+				Description: "Creates a new page.",
+				Params: []*ParamInfo{
+					{Name: "url", Description: "The initial URL the page will be navigated to. An empty string indicates about:blank.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "left", Description: "Frame left origin in DIP (requires newWindow to be true or headless shell).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "top", Description: "Frame top origin in DIP (requires newWindow to be true or headless shell).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "width", Description: "Frame width in DIP (requires newWindow to be true or headless shell).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "height", Description: "Frame height in DIP (requires newWindow to be true or headless shell).", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "windowState", Description: "Frame window state (requires newWindow to be true or headless shell). Default is normal. enum values: normal, minimized, maximized, fullscreen", Type: "Target.WindowState", Optional: true, GoType: reflect.TypeOf((*TargetWindowState)(nil)).Elem()},
+					{Name: "browserContextId", Description: "The browser context to create the page in.", Type: "Browser.BrowserContextID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "enableBeginFrameControl", Description: "Whether BeginFrames for this target will be controlled via DevTools (headless shell only, not supported on MacOS yet, false by default).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "newWindow", Description: "Whether to create a new Window or Tab (false by default, not supported by headless shell).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "background", Description: "Whether to create the target in background or foreground (false by default, not supported by headless shell).", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "forTab", Description: "Whether to create the target of type \"tab\".", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "hidden", Description: "Whether to create a hidden target. The hidden target is observable via protocol, but not present in the tab UI strip. Cannot be created with `forTab: true`, `newWindow: true` or `background: false`. The life-time of the tab is limited to the life-time of the session.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "targetId", Description: "The id of the page opened.", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "detachFromTarget",
+				Description: "Detaches session with given id.",
+				Params: []*ParamInfo{
+					{Name: "sessionId", Description: "Session to detach.", Type: "Target.SessionID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "targetId", Description: "Deprecated.", Type: "Target.TargetID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disposeBrowserContext",
+				Description: "Deletes a BrowserContext. All the belonging pages will be closed without calling their beforeunload hooks.",
+				Params: []*ParamInfo{
+					{Name: "browserContextId", Description: "", Type: "Browser.BrowserContextID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getTargetInfo",
+				Description:  "Returns information about a target.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "", Type: "Target.TargetID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "targetInfo", Description: "", Type: "Target.TargetInfo", GoType: reflect.TypeOf((**TargetTargetInfo)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getTargets",
+				Description: "Retrieves a list of available targets.",
+				Params: []*ParamInfo{
+					{Name: "filter", Description: "Only targets matching filter will be reported. If filter is not specified and target discovery is currently enabled, a filter used for target discovery is used for consistency.", Type: "Target.TargetFilter", Optional: true, GoType: reflect.TypeOf((*[]*TargetFilterEntry)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "targetInfos", Description: "The list of targets.", Type: "array", Items: "Target.TargetInfo", GoType: reflect.TypeOf((*[]*TargetTargetInfo)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "sendMessageToTarget",
+				Description: "Sends protocol message over session with given id. Consider using flat mode instead; see commands attachToTarget, setAutoAttach, and crbug.com/991325.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "message", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sessionId", Description: "Identifier of the session.", Type: "Target.SessionID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "targetId", Description: "Deprecated.", Type: "Target.TargetID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setAutoAttach",
+				Description: "Controls whether to automatically attach to new targets which are considered to be directly related to this one (for example, iframes or workers). When turned on, attaches to all existing related targets as well. When turned off, automatically detaches from all currently attached targets. This also clears all targets added by `autoAttachRelated` from the list of targets to watch for creation of related targets. You might want to call this recursively for auto-attached targets to attach to all available targets.",
+				Params: []*ParamInfo{
+					{Name: "autoAttach", Description: "Whether to auto-attach to related targets.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "waitForDebuggerOnStart", Description: "Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "flatten", Description: "Enables \"flat\" access to the session via specifying sessionId attribute in the commands. We plan to make this the default, deprecate non-flattened mode, and eventually retire it. See crbug.com/991325.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "filter", Description: "Only targets matching filter will be attached.", Type: "Target.TargetFilter", Optional: true, GoType: reflect.TypeOf((*[]*TargetFilterEntry)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "autoAttachRelated",
+				Description:  "Adds the specified target to the list of targets that will be monitored for any related target creation (such as child frames, child workers and new versions of service worker) and reported through `attachedToTarget`. The specified target is also auto-attached. This cancels the effect of any previous `setAutoAttach` and is also cancelled by subsequent `setAutoAttach`. Only available at the Browser target.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "waitForDebuggerOnStart", Description: "Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "filter", Description: "Only targets matching filter will be attached.", Type: "Target.TargetFilter", Optional: true, GoType: reflect.TypeOf((*[]*TargetFilterEntry)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setDiscoverTargets",
+				Description: "Controls whether to discover available targets and notify via `targetCreated/targetInfoChanged/targetDestroyed` events.",
+				Params: []*ParamInfo{
+					{Name: "discover", Description: "Whether to discover available targets.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "filter", Description: "Only targets matching filter will be attached. If `discover` is false, `filter` must be omitted or empty.", Type: "Target.TargetFilter", Optional: true, GoType: reflect.TypeOf((*[]*TargetFilterEntry)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setRemoteLocations",
+				Description:  "Enables target discovery for the specified locations, when `setDiscoverTargets` was set to `true`.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "locations", Description: "List of remote locations.", Type: "array", Items: "Target.RemoteLocation", GoType: reflect.TypeOf((*[]*TargetRemoteLocation)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "openDevTools",
+				Description:  "Opens a DevTools window for the target.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "This can be the page or tab target ID.", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "targetId", Description: "The targetId of DevTools page target.", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:         "attachedToTarget",
+				Description:  "Issued when attached to target because of auto-attach or `attachToTarget` command.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "sessionId", Description: "Identifier assigned to the session used to send/receive messages.", Type: "Target.SessionID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "targetInfo", Description: "", Type: "Target.TargetInfo", GoType: reflect.TypeOf((**TargetTargetInfo)(nil)).Elem()},
+					{Name: "waitingForDebugger", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TargetAttachedToTargetEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "detachedFromTarget",
+				Description:  "Issued when detached from target for any reason (including `detachFromTarget` command). Can be issued multiple times per target if multiple sessions have been attached to it.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "sessionId", Description: "Detached session identifier.", Type: "Target.SessionID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "targetId", Description: "Deprecated.", Type: "Target.TargetID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TargetDetachedFromTargetEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "receivedMessageFromTarget",
+				Description: "Notifies about a new protocol message received from the session (as reported in `attachedToTarget` event).",
+				Params: []*ParamInfo{
+					{Name: "sessionId", Description: "Identifier of a session which sends a message.", Type: "Target.SessionID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "message", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "targetId", Description: "Deprecated.", Type: "Target.TargetID", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TargetReceivedMessageFromTargetEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "targetCreated",
+				Description: "Issued when a possible inspection target is created.",
+				Params: []*ParamInfo{
+					{Name: "targetInfo", Description: "", Type: "Target.TargetInfo", GoType: reflect.TypeOf((**TargetTargetInfo)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TargetTargetCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "targetDestroyed",
+				Description: "Issued when a target is destroyed.",
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TargetTargetDestroyedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "targetCrashed",
+				Description: "Issued when a target has crashed.",
+				Params: []*ParamInfo{
+					{Name: "targetId", Description: "", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "status", Description: "Termination status type.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errorCode", Description: "Termination error code.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TargetTargetCrashedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "targetInfoChanged",
+				Description: "Issued when some information about a target has changed. This only happens between `targetCreated` and `targetDestroyed`.",
+				Params: []*ParamInfo{
+					{Name: "targetInfo", Description: "", Type: "Target.TargetInfo", GoType: reflect.TypeOf((**TargetTargetInfo)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TargetTargetInfoChangedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Tethering",
+		Description:  "The Tethering domain defines methods and events for browser port binding.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "bind",
+				Description: "Request browser port binding.",
+				Params: []*ParamInfo{
+					{Name: "port", Description: "Port number to bind.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "unbind",
+				Description: "Request browser port unbinding.",
+				Params: []*ParamInfo{
+					{Name: "port", Description: "Port number to unbind.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "accepted",
+				Description: "Informs that port was successfully bound and got a specified connection id.",
+				Params: []*ParamInfo{
+					{Name: "port", Description: "Port number that was successfully bound.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "connectionId", Description: "Connection id to be used.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TetheringAcceptedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Tracing",
+		Description:  "",
+		Dependencies: []string{"IO"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "end",
+				Description: "Stop trace events collection.",
+			},
+			{
+				Name:         "getCategories",
+				Description:  "Gets supported tracing categories.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "categories", Description: "A list of supported tracing categories.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "recordClockSyncMarker",
+				Description:  "Record a clock sync marker in the trace.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "syncId", Description: "The ID of this clock sync marker", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "requestMemoryDump",
+				Description:  "Request a global memory dump.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "deterministic", Description: "Enables more deterministic results by forcing garbage collection", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "levelOfDetail", Description: "Specifies level of details in memory dump. Defaults to \"detailed\". enum values: background, light, detailed", Type: "Tracing.MemoryDumpLevelOfDetail", Optional: true, GoType: reflect.TypeOf((*TracingMemoryDumpLevelOfDetail)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "dumpGuid", Description: "GUID of the resulting global memory dump.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "success", Description: "True iff the global memory dump succeeded.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "start",
+				Description: "Start trace events collection.",
+				Params: []*ParamInfo{
+					{Name: "categories", Description: "Category/tag filter", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "options", Description: "Tracing options", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bufferUsageReportingInterval", Description: "If set, the agent will issue bufferUsage events at this interval, specified in milliseconds", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "transferMode", Description: "Whether to report trace events as series of dataCollected events or to save trace to a stream (defaults to `ReportEvents`).", Type: "string", Optional: true, GoType: reflect.TypeOf((*TracingStartTransferMode)(nil)).Elem()},
+					{Name: "streamFormat", Description: "Trace data format to use. This only applies when using `ReturnAsStream` transfer mode (defaults to `json`). enum values: json, proto", Type: "Tracing.StreamFormat", Optional: true, GoType: reflect.TypeOf((*TracingStreamFormat)(nil)).Elem()},
+					{Name: "streamCompression", Description: "Compression format to use. This only applies when using `ReturnAsStream` transfer mode (defaults to `none`) enum values: none, gzip", Type: "Tracing.StreamCompression", Optional: true, GoType: reflect.TypeOf((*TracingStreamCompression)(nil)).Elem()},
+					{Name: "traceConfig", Description: "", Type: "Tracing.TraceConfig", Optional: true, GoType: reflect.TypeOf((**TracingTraceConfig)(nil)).Elem()},
+					{Name: "perfettoConfig", Description: "Base64-encoded serialized perfetto.protos.TraceConfig protobuf message When specified, the parameters `categories`, `options`, `traceConfig` are ignored. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "tracingBackend", Description: "Backend type (defaults to `auto`) enum values: auto, chrome, system", Type: "Tracing.TracingBackend", Optional: true, GoType: reflect.TypeOf((*TracingTracingBackend)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:         "bufferUsage",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "percentFull", Description: "A number in range [0..1] that indicates the used size of event buffer as a fraction of its total size.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "eventCount", Description: "An approximate number of events in the trace log.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "value", Description: "A number in range [0..1] that indicates the used size of event buffer as a fraction of its total size.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TracingBufferUsageEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "dataCollected",
+				Description:  "Contains a bucket of collected trace events. When tracing is stopped collected events will be sent as a sequence of dataCollected events followed by tracingComplete event.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "value", Description: "", Type: "array", Items: "object", GoType: reflect.TypeOf((*[]map[string]interface{})(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TracingDataCollectedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "tracingComplete",
+				Description: "Signals that tracing is stopped and there is no trace buffers pending flush, all data were delivered via dataCollected events.",
+				Params: []*ParamInfo{
+					{Name: "dataLossOccurred", Description: "Indicates whether some trace data is known to have been lost, e.g. because the trace ring buffer wrapped around.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "stream", Description: "A handle of the stream that holds resulting trace data.", Type: "IO.StreamHandle", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "traceFormat", Description: "Trace data format of returned stream. enum values: json, proto", Type: "Tracing.StreamFormat", Optional: true, GoType: reflect.TypeOf((*TracingStreamFormat)(nil)).Elem()},
+					{Name: "streamCompression", Description: "Compression format of returned stream. enum values: none, gzip", Type: "Tracing.StreamCompression", Optional: true, GoType: reflect.TypeOf((*TracingStreamCompression)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*TracingTracingCompleteEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Fetch",
+		Description:  "A domain for letting clients substitute browser's network layer with client code.",
+		Dependencies: []string{"Network", "IO", "Page"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "Disables the fetch domain.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables issuing of requestPaused events. A request will be paused until client calls one of failRequest, fulfillRequest or continueRequest/continueWithAuth.",
+				Params: []*ParamInfo{
+					{Name: "patterns", Description: "If specified, only requests matching any of these patterns will produce fetchRequested event and will be paused until clients response. If not set, all requests will be affected.", Type: "array", Items: "Fetch.RequestPattern", Optional: true, GoType: reflect.TypeOf((*[]*FetchRequestPattern)(nil)).Elem()},
+					{Name: "handleAuthRequests", Description: "If true, authRequired events will be issued and requests will be paused expecting a call to continueWithAuth.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "failRequest",
+				Description: "Causes the request to fail with specified reason.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "An id the client received in requestPaused event.", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errorReason", Description: "Causes the request to fail with the given reason. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse", Type: "Network.ErrorReason", GoType: reflect.TypeOf((*NetworkErrorReason)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "fulfillRequest",
+				Description: "Provides response to the request.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "An id the client received in requestPaused event.", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "responseCode", Description: "An HTTP response code.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "responseHeaders", Description: "Response headers.", Type: "array", Items: "Fetch.HeaderEntry", Optional: true, GoType: reflect.TypeOf((*[]*FetchHeaderEntry)(nil)).Elem()},
+					{Name: "binaryResponseHeaders", Description: "Alternative way of specifying response headers as a \\0-separated series of name: value pairs. Prefer the above method unless you need to represent some non-UTF8 values that can't be transmitted over the protocol as text. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "body", Description: "A response body. If absent, original response body will be used if the request is intercepted at the response stage and empty body will be used if the request is intercepted at the request stage. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "responsePhrase", Description: "A textual representation of responseCode. If absent, a standard phrase matching responseCode is used.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "continueRequest",
+				Description: "Continues the request, optionally modifying some of its parameters.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "An id the client received in requestPaused event.", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "If set, the request url will be modified in a way that's not observable by page.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "method", Description: "If set, the request method is overridden.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "postData", Description: "If set, overrides the post data in the request. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "headers", Description: "If set, overrides the request headers. Note that the overrides do not extend to subsequent redirect hops, if a redirect happens. Another override may be applied to a different request produced by a redirect.", Type: "array", Items: "Fetch.HeaderEntry", Optional: true, GoType: reflect.TypeOf((*[]*FetchHeaderEntry)(nil)).Elem()},
+					{Name: "interceptResponse", Description: "If set, overrides response interception behavior for this request.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "continueWithAuth",
+				Description: "Continues a request supplying authChallengeResponse following authRequired event.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "An id the client received in authRequired event.", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "authChallengeResponse", Description: "Response to  with an authChallenge.", Type: "Fetch.AuthChallengeResponse", GoType: reflect.TypeOf((**FetchAuthChallengeResponse)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "continueResponse",
+				Description:  "Continues loading of the paused response, optionally modifying the response headers. If either responseCode or headers are modified, all of them must be present.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "An id the client received in requestPaused event.", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "responseCode", Description: "An HTTP response code. If absent, original response code will be used.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "responsePhrase", Description: "A textual representation of responseCode. If absent, a standard phrase matching responseCode is used.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "responseHeaders", Description: "Response headers. If absent, original response headers will be used.", Type: "array", Items: "Fetch.HeaderEntry", Optional: true, GoType: reflect.TypeOf((*[]*FetchHeaderEntry)(nil)).Elem()},
+					{Name: "binaryResponseHeaders", Description: "Alternative way of specifying response headers as a \\0-separated series of name: value pairs. Prefer the above method unless you need to represent some non-UTF8 values that can't be transmitted over the protocol as text. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getResponseBody",
+				Description: "Causes the body of the response to be received from the server and returned as a single string. May only be issued for a request that is paused in the Response stage and is mutually exclusive with takeResponseBodyForInterceptionAsStream. Calling other methods that affect the request or disabling fetch domain before body is received results in an undefined behavior. Note that the response body is not available for redirects. Requests paused in the _redirect received_ state may be differentiated by `responseCode` and presence of `location` response header, see comments to `requestPaused` for details.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Identifier for the intercepted request to get body for.", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "body", Description: "Response body.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "base64Encoded", Description: "True, if content was sent as base64.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "takeResponseBodyAsStream",
+				Description: "Returns a handle to the stream representing the response body. The request must be paused in the HeadersReceived stage. Note that after this command the request can't be continued as is -- client either needs to cancel it or to provide the response body. The stream only supports sequential read, IO.read will fail if the position is specified. This method is mutually exclusive with getResponseBody. Calling other methods that affect the request or disabling fetch domain before body is received results in an undefined behavior.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "stream", Description: "", Type: "IO.StreamHandle", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "requestPaused",
+				Description: "Issued when the domain is enabled and the request URL matches the specified filter. The request is paused until the client responds with one of continueRequest, failRequest or fulfillRequest. The stage of the request can be determined by presence of responseErrorReason and responseStatusCode -- the request is at the response stage if either of these fields is present and in the request stage otherwise. Redirect responses and subsequent requests are reported similarly to regular responses and requests. Redirect responses may be distinguished by the value of `responseStatusCode` (which is one of 301, 302, 303, 307, 308) along with presence of the `location` header. Requests resulting from a redirect will have `redirectedRequestId` field set.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Each request the page makes will have a unique id.", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "request", Description: "The details of the request.", Type: "Network.Request", GoType: reflect.TypeOf((**NetworkRequest)(nil)).Elem()},
+					{Name: "frameId", Description: "The id of the frame that initiated the request.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "resourceType", Description: "How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other", Type: "Network.ResourceType", GoType: reflect.TypeOf((*NetworkResourceType)(nil)).Elem()},
+					{Name: "responseErrorReason", Description: "Response error if intercepted at response stage. enum values: Failed, Aborted, TimedOut, AccessDenied, ConnectionClosed, ConnectionReset, ConnectionRefused, ConnectionAborted, ConnectionFailed, NameNotResolved, InternetDisconnected, AddressUnreachable, BlockedByClient, BlockedByResponse", Type: "Network.ErrorReason", Optional: true, GoType: reflect.TypeOf((*NetworkErrorReason)(nil)).Elem()},
+					{Name: "responseStatusCode", Description: "Response code if intercepted at response stage.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "responseStatusText", Description: "Response status text if intercepted at response stage.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "responseHeaders", Description: "Response headers if intercepted at the response stage.", Type: "array", Items: "Fetch.HeaderEntry", Optional: true, GoType: reflect.TypeOf((*[]*FetchHeaderEntry)(nil)).Elem()},
+					{Name: "networkId", Description: "If the intercepted request had a corresponding Network.requestWillBeSent event fired for it, then this networkId will be the same as the requestId present in the requestWillBeSent event.", Type: "Network.RequestId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "redirectedRequestId", Description: "If the request is due to a redirect response from the server, the id of the request that has caused the redirect.", Type: "Fetch.RequestId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*FetchRequestPausedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "authRequired",
+				Description: "Issued when the domain is enabled with handleAuthRequests set to true. The request is paused until client responds with continueWithAuth.",
+				Params: []*ParamInfo{
+					{Name: "requestId", Description: "Each request the page makes will have a unique id.", Type: "Fetch.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "request", Description: "The details of the request.", Type: "Network.Request", GoType: reflect.TypeOf((**NetworkRequest)(nil)).Elem()},
+					{Name: "frameId", Description: "The id of the frame that initiated the request.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "resourceType", Description: "How the requested resource will be used. enum values: Document, Stylesheet, Image, Media, Font, Script, TextTrack, XHR, Fetch, Prefetch, EventSource, WebSocket, Manifest, SignedExchange, Ping, CSPViolationReport, Preflight, FedCM, Other", Type: "Network.ResourceType", GoType: reflect.TypeOf((*NetworkResourceType)(nil)).Elem()},
+					{Name: "authChallenge", Description: "Details of the Authorization Challenge encountered. If this is set, client should respond with continueRequest that contains AuthChallengeResponse.", Type: "Fetch.AuthChallenge", GoType: reflect.TypeOf((**FetchAuthChallenge)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*FetchAuthRequiredEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "WebAudio",
+		Description:  "This domain allows inspection of Web Audio API. https://webaudio.github.io/web-audio-api/",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "Enables the WebAudio domain and starts sending context lifetime events.",
+			},
+			{
+				Name:        "disable",
+				Description: "Disables the WebAudio domain.",
+			},
+			{
+				Name:        "getRealtimeData",
+				Description: "Fetch the realtime data from the registered contexts.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "realtimeData", Description: "", Type: "WebAudio.ContextRealtimeData", GoType: reflect.TypeOf((**WebAudioContextRealtimeData)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "contextCreated",
+				Description: "Notifies that a new BaseAudioContext has been created.",
+				Params: []*ParamInfo{
+					{Name: "context", Description: "", Type: "WebAudio.BaseAudioContext", GoType: reflect.TypeOf((**WebAudioBaseAudioContext)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioContextCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "contextWillBeDestroyed",
+				Description: "Notifies that an existing BaseAudioContext will be destroyed.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioContextWillBeDestroyedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "contextChanged",
+				Description: "Notifies that existing BaseAudioContext has changed some properties (id stays the same)..",
+				Params: []*ParamInfo{
+					{Name: "context", Description: "", Type: "WebAudio.BaseAudioContext", GoType: reflect.TypeOf((**WebAudioBaseAudioContext)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioContextChangedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "audioListenerCreated",
+				Description: "Notifies that the construction of an AudioListener has finished.",
+				Params: []*ParamInfo{
+					{Name: "listener", Description: "", Type: "WebAudio.AudioListener", GoType: reflect.TypeOf((**WebAudioAudioListener)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioAudioListenerCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "audioListenerWillBeDestroyed",
+				Description: "Notifies that a new AudioListener has been created.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "listenerId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioAudioListenerWillBeDestroyedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "audioNodeCreated",
+				Description: "Notifies that a new AudioNode has been created.",
+				Params: []*ParamInfo{
+					{Name: "node", Description: "", Type: "WebAudio.AudioNode", GoType: reflect.TypeOf((**WebAudioAudioNode)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioAudioNodeCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "audioNodeWillBeDestroyed",
+				Description: "Notifies that an existing AudioNode has been destroyed.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "nodeId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioAudioNodeWillBeDestroyedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "audioParamCreated",
+				Description: "Notifies that a new AudioParam has been created.",
+				Params: []*ParamInfo{
+					{Name: "param", Description: "", Type: "WebAudio.AudioParam", GoType: reflect.TypeOf((**WebAudioAudioParam)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioAudioParamCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "audioParamWillBeDestroyed",
+				Description: "Notifies that an existing AudioParam has been destroyed.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "nodeId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "paramId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioAudioParamWillBeDestroyedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "nodesConnected",
+				Description: "Notifies that two AudioNodes are connected.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "destinationId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceOutputIndex", Description: "", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "destinationInputIndex", Description: "", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioNodesConnectedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "nodesDisconnected",
+				Description: "Notifies that AudioNodes are disconnected. The destination can be null, and it means all the outgoing connections from the source are disconnected.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "destinationId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceOutputIndex", Description: "", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "destinationInputIndex", Description: "", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioNodesDisconnectedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "nodeParamConnected",
+				Description: "Notifies that an AudioNode is connected to an AudioParam.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "destinationId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceOutputIndex", Description: "", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioNodeParamConnectedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "nodeParamDisconnected",
+				Description: "Notifies that an AudioNode is disconnected to an AudioParam.",
+				Params: []*ParamInfo{
+					{Name: "contextId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "destinationId", Description: "", Type: "WebAudio.GraphObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceOutputIndex", Description: "", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAudioNodeParamDisconnectedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "WebAuthn",
+		Description:  "This domain allows configuring virtual authenticators to test the WebAuthn API.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "Enable the WebAuthn domain and start intercepting credential storage and retrieval with a virtual authenticator.",
+				Params: []*ParamInfo{
+					{Name: "enableUI", Description: "Whether to enable the WebAuthn user interface. Enabling the UI is recommended for debugging and demo purposes, as it is closer to the real experience. Disabling the UI is recommended for automated testing. Supported at the embedder's discretion if UI is available. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disable the WebAuthn domain.",
+			},
+			{
+				Name:        "addVirtualAuthenticator",
+				Description: "Creates and adds a virtual authenticator.",
+				Params: []*ParamInfo{
+					{Name: "options", Description: "", Type: "WebAuthn.VirtualAuthenticatorOptions", GoType: reflect.TypeOf((**WebAuthnVirtualAuthenticatorOptions)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setResponseOverrideBits",
+				Description: "Resets parameters isBogusSignature, isBadUV, isBadUP to false if they are not present.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "isBogusSignature", Description: "If isBogusSignature is set, overrides the signature in the authenticator response to be zero. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isBadUV", Description: "If isBadUV is set, overrides the UV bit in the flags in the authenticator response to be zero. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isBadUP", Description: "If isBadUP is set, overrides the UP bit in the flags in the authenticator response to be zero. Defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeVirtualAuthenticator",
+				Description: "Removes the given authenticator.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "addCredential",
+				Description: "Adds the credential to the specified authenticator.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "credential", Description: "", Type: "WebAuthn.Credential", GoType: reflect.TypeOf((**WebAuthnCredential)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getCredential",
+				Description: "Returns a single credential stored in the given virtual authenticator that matches the credential ID.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "credentialId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "credential", Description: "", Type: "WebAuthn.Credential", GoType: reflect.TypeOf((**WebAuthnCredential)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getCredentials",
+				Description: "Returns all the credentials stored in the given virtual authenticator.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "credentials", Description: "", Type: "array", Items: "WebAuthn.Credential", GoType: reflect.TypeOf((*[]*WebAuthnCredential)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeCredential",
+				Description: "Removes a credential from the authenticator.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "credentialId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clearCredentials",
+				Description: "Clears all the credentials from the specified device.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setUserVerified",
+				Description: "Sets whether User Verification succeeds or fails for an authenticator. The default is true.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "isUserVerified", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setAutomaticPresenceSimulation",
+				Description: "Sets whether tests of user presence will succeed immediately (if true) or fail to resolve (if false) for an authenticator. The default is true.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "enabled", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setCredentialProperties",
+				Description: "Allows setting credential properties. https://w3c.github.io/webauthn/#sctn-automation-set-credential-properties",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "credentialId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "backupEligibility", Description: "", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "backupState", Description: "", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "credentialAdded",
+				Description: "Triggered when a credential is added to an authenticator.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "credential", Description: "", Type: "WebAuthn.Credential", GoType: reflect.TypeOf((**WebAuthnCredential)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAuthnCredentialAddedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "credentialDeleted",
+				Description: "Triggered when a credential is deleted, e.g. through PublicKeyCredential.signalUnknownCredential().",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "credentialId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAuthnCredentialDeletedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "credentialUpdated",
+				Description: "Triggered when a credential is updated, e.g. through PublicKeyCredential.signalCurrentUserDetails().",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "credential", Description: "", Type: "WebAuthn.Credential", GoType: reflect.TypeOf((**WebAuthnCredential)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAuthnCredentialUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "credentialAsserted",
+				Description: "Triggered when a credential is used in a webauthn assertion.",
+				Params: []*ParamInfo{
+					{Name: "authenticatorId", Description: "", Type: "WebAuthn.AuthenticatorId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "credential", Description: "", Type: "WebAuthn.Credential", GoType: reflect.TypeOf((**WebAuthnCredential)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*WebAuthnCredentialAssertedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Media",
+		Description:  "This domain allows detailed inspection of media elements",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "Enables the Media domain",
+			},
+			{
+				Name:        "disable",
+				Description: "Disables the Media domain.",
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "playerPropertiesChanged",
+				Description: "This can be called multiple times, and can be used to set / override / remove player properties. A null propValue indicates removal.",
+				Params: []*ParamInfo{
+					{Name: "playerId", Description: "", Type: "Media.PlayerId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "properties", Description: "", Type: "array", Items: "Media.PlayerProperty", GoType: reflect.TypeOf((*[]*MediaPlayerProperty)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*MediaPlayerPropertiesChangedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "playerEventsAdded",
+				Description: "Send events as a list, allowing them to be batched on the browser for less congestion. If batched, events must ALWAYS be in chronological order.",
+				Params: []*ParamInfo{
+					{Name: "playerId", Description: "", Type: "Media.PlayerId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "events", Description: "", Type: "array", Items: "Media.PlayerEvent", GoType: reflect.TypeOf((*[]*MediaPlayerEvent)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*MediaPlayerEventsAddedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "playerMessagesLogged",
+				Description: "Send a list of any messages that need to be delivered.",
+				Params: []*ParamInfo{
+					{Name: "playerId", Description: "", Type: "Media.PlayerId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "messages", Description: "", Type: "array", Items: "Media.PlayerMessage", GoType: reflect.TypeOf((*[]*MediaPlayerMessage)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*MediaPlayerMessagesLoggedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "playerErrorsRaised",
+				Description: "Send a list of any errors that need to be delivered.",
+				Params: []*ParamInfo{
+					{Name: "playerId", Description: "", Type: "Media.PlayerId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "errors", Description: "", Type: "array", Items: "Media.PlayerError", GoType: reflect.TypeOf((*[]*MediaPlayerError)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*MediaPlayerErrorsRaisedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "playersCreated",
+				Description: "Called whenever a player is created, or when a new agent joins and receives a list of active players. If an agent is restored, it will receive the full list of player ids and all events again.",
+				Params: []*ParamInfo{
+					{Name: "players", Description: "", Type: "array", Items: "Media.PlayerId", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*MediaPlayersCreatedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "DeviceAccess",
+		Description:  "",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "Enable events in this domain.",
+			},
+			{
+				Name:        "disable",
+				Description: "Disable events in this domain.",
+			},
+			{
+				Name:        "selectPrompt",
+				Description: "Select a device in response to a DeviceAccess.deviceRequestPrompted event.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "", Type: "DeviceAccess.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "deviceId", Description: "", Type: "DeviceAccess.DeviceId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "cancelPrompt",
+				Description: "Cancel a prompt in response to a DeviceAccess.deviceRequestPrompted event.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "", Type: "DeviceAccess.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "deviceRequestPrompted",
+				Description: "A device request opened a user prompt to select a device. Respond with the selectPrompt or cancelPrompt command.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "", Type: "DeviceAccess.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "devices", Description: "", Type: "array", Items: "DeviceAccess.PromptDevice", GoType: reflect.TypeOf((*[]*DeviceAccessPromptDevice)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DeviceAccessDeviceRequestPromptedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Preload",
+		Description:  "",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "",
+			},
+			{
+				Name:        "disable",
+				Description: "",
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "ruleSetUpdated",
+				Description: "Upsert. Currently, it is only emitted when a rule set added.",
+				Params: []*ParamInfo{
+					{Name: "ruleSet", Description: "", Type: "Preload.RuleSet", GoType: reflect.TypeOf((**PreloadRuleSet)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PreloadRuleSetUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "ruleSetRemoved",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "", Type: "Preload.RuleSetId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PreloadRuleSetRemovedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "preloadEnabledStateUpdated",
+				Description: "Fired when a preload enabled state is updated.",
+				Params: []*ParamInfo{
+					{Name: "disabledByPreference", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "disabledByDataSaver", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "disabledByBatterySaver", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "disabledByHoldbackPrefetchSpeculationRules", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "disabledByHoldbackPrerenderSpeculationRules", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PreloadPreloadEnabledStateUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "prefetchStatusUpdated",
+				Description: "Fired when a prefetch attempt is updated.",
+				Params: []*ParamInfo{
+					{Name: "key", Description: "", Type: "Preload.PreloadingAttemptKey", GoType: reflect.TypeOf((**PreloadPreloadingAttemptKey)(nil)).Elem()},
+					{Name: "pipelineId", Description: "", Type: "Preload.PreloadPipelineId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "initiatingFrameId", Description: "The frame id of the frame initiating prefetch.", Type: "Page.FrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "prefetchUrl", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "status", Description: " enum values: Pending, Running, Ready, Success, Failure, NotSupported", Type: "Preload.PreloadingStatus", GoType: reflect.TypeOf((*PreloadPreloadingStatus)(nil)).Elem()},
+					{Name: "prefetchStatus", Description: " enum values: PrefetchAllowed, PrefetchFailedIneligibleRedirect, PrefetchFailedInvalidRedirect, PrefetchFailedMIMENotSupported, PrefetchFailedNetError, PrefetchFailedNon2XX, PrefetchEvictedAfterBrowsingDataRemoved, PrefetchEvictedAfterCandidateRemoved, PrefetchEvictedForNewerPrefetch, PrefetchHeldback, PrefetchIneligibleRetryAfter, PrefetchIsPrivacyDecoy, PrefetchIsStale, PrefetchNotEligibleBrowserContextOffTheRecord, PrefetchNotEligibleDataSaverEnabled, PrefetchNotEligibleExistingProxy, PrefetchNotEligibleHostIsNonUnique, PrefetchNotEligibleNonDefaultStoragePartition, PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy, PrefetchNotEligibleSchemeIsNotHttps, PrefetchNotEligibleUserHasCookies, PrefetchNotEligibleUserHasServiceWorker, PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler, PrefetchNotEligibleRedirectFromServiceWorker, PrefetchNotEligibleRedirectToServiceWorker, PrefetchNotEligibleBatterySaverEnabled, PrefetchNotEligiblePreloadingDisabled, PrefetchNotFinishedInTime, PrefetchNotStarted, PrefetchNotUsedCookiesChanged, PrefetchProxyNotAvailable, PrefetchResponseUsed, PrefetchSuccessfulButNotUsed, PrefetchNotUsedProbeFailed", Type: "Preload.PrefetchStatus", GoType: reflect.TypeOf((*PreloadPrefetchStatus)(nil)).Elem()},
+					{Name: "requestId", Description: "", Type: "Network.RequestId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PreloadPrefetchStatusUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "prerenderStatusUpdated",
+				Description: "Fired when a prerender attempt is updated.",
+				Params: []*ParamInfo{
+					{Name: "key", Description: "", Type: "Preload.PreloadingAttemptKey", GoType: reflect.TypeOf((**PreloadPreloadingAttemptKey)(nil)).Elem()},
+					{Name: "pipelineId", Description: "", Type: "Preload.PreloadPipelineId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "status", Description: " enum values: Pending, Running, Ready, Success, Failure, NotSupported", Type: "Preload.PreloadingStatus", GoType: reflect.TypeOf((*PreloadPreloadingStatus)(nil)).Elem()},
+					{Name: "prerenderStatus", Description: " enum values: Activated, Destroyed, LowEndDevice, InvalidSchemeRedirect, InvalidSchemeNavigation, NavigationRequestBlockedByCsp, MojoBinderPolicy, RendererProcessCrashed, RendererProcessKilled, Download, TriggerDestroyed, NavigationNotCommitted, NavigationBadHttpStatus, ClientCertRequested, NavigationRequestNetworkError, CancelAllHostsForTesting, DidFailLoad, Stop, SslCertificateError, LoginAuthRequested, UaChangeRequiresReload, BlockedByClient, AudioOutputDeviceRequested, MixedContent, TriggerBackgrounded, MemoryLimitExceeded, DataSaverEnabled, TriggerUrlHasEffectiveUrl, ActivatedBeforeStarted, InactivePageRestriction, StartFailed, TimeoutBackgrounded, CrossSiteRedirectInInitialNavigation, CrossSiteNavigationInInitialNavigation, SameSiteCrossOriginRedirectNotOptInInInitialNavigation, SameSiteCrossOriginNavigationNotOptInInInitialNavigation, ActivationNavigationParameterMismatch, ActivatedInBackground, EmbedderHostDisallowed, ActivationNavigationDestroyedBeforeSuccess, TabClosedByUserGesture, TabClosedWithoutUserGesture, PrimaryMainFrameRendererProcessCrashed, PrimaryMainFrameRendererProcessKilled, ActivationFramePolicyNotCompatible, PreloadingDisabled, BatterySaverEnabled, ActivatedDuringMainFrameNavigation, PreloadingUnsupportedByWebContents, CrossSiteRedirectInMainFrameNavigation, CrossSiteNavigationInMainFrameNavigation, SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation, SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation, MemoryPressureOnTrigger, MemoryPressureAfterTriggered, PrerenderingDisabledByDevTools, SpeculationRuleRemoved, ActivatedWithAuxiliaryBrowsingContexts, MaxNumOfRunningEagerPrerendersExceeded, MaxNumOfRunningNonEagerPrerendersExceeded, MaxNumOfRunningEmbedderPrerendersExceeded, PrerenderingUrlHasEffectiveUrl, RedirectedPrerenderingUrlHasEffectiveUrl, ActivationUrlHasEffectiveUrl, JavaScriptInterfaceAdded, JavaScriptInterfaceRemoved, AllPrerenderingCanceled, WindowClosed, SlowNetwork, OtherPrerenderedPageActivated, V8OptimizerDisabled, PrerenderFailedDuringPrefetch, BrowsingDataRemoved, PrerenderHostReused", Type: "Preload.PrerenderFinalStatus", Optional: true, GoType: reflect.TypeOf((*PreloadPrerenderFinalStatus)(nil)).Elem()},
+					{Name: "disallowedMojoInterface", Description: "This is used to give users more information about the name of Mojo interface that is incompatible with prerender and has caused the cancellation of the attempt.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "mismatchedHeaders", Description: "", Type: "array", Items: "Preload.PrerenderMismatchedHeaders", Optional: true, GoType: reflect.TypeOf((*[]*PreloadPrerenderMismatchedHeaders)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PreloadPrerenderStatusUpdatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "preloadingAttemptSourcesUpdated",
+				Description: "Send a list of sources for all preloading attempts in a document.",
+				Params: []*ParamInfo{
+					{Name: "loaderId", Description: "", Type: "Network.LoaderId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "preloadingAttemptSources", Description: "", Type: "array", Items: "Preload.PreloadingAttemptSource", GoType: reflect.TypeOf((*[]*PreloadPreloadingAttemptSource)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*PreloadPreloadingAttemptSourcesUpdatedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "FedCm",
+		Description:  "This domain allows interacting with the FedCM dialog.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "disableRejectionDelay", Description: "Allows callers to disable the promise rejection delay that would normally happen, if this is unimportant to what's being tested. (step 4 of https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "",
+			},
+			{
+				Name:        "selectAccount",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "dialogId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "accountIndex", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "clickDialogButton",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "dialogId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "dialogButton", Description: " enum values: ConfirmIdpLoginContinue, ErrorGotIt, ErrorMoreDetails", Type: "FedCm.DialogButton", GoType: reflect.TypeOf((*FedCmDialogButton)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "openUrl",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "dialogId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "accountIndex", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "accountUrlType", Description: " enum values: TermsOfService, PrivacyPolicy", Type: "FedCm.AccountUrlType", GoType: reflect.TypeOf((*FedCmAccountUrlType)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "dismissDialog",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "dialogId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "triggerCooldown", Description: "", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "resetCooldown",
+				Description: "Resets the cooldown time, if any, to allow the next FedCM call to show a dialog even if one was recently dismissed by the user.",
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "dialogShown",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "dialogId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "dialogType", Description: " enum values: AccountChooser, AutoReauthn, ConfirmIdpLogin, Error", Type: "FedCm.DialogType", GoType: reflect.TypeOf((*FedCmDialogType)(nil)).Elem()},
+					{Name: "accounts", Description: "", Type: "array", Items: "FedCm.Account", GoType: reflect.TypeOf((*[]*FedCmAccount)(nil)).Elem()},
+					{Name: "title", Description: "These exist primarily so that the caller can verify the RP context was used appropriately.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "subtitle", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*FedCmDialogShownEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "dialogClosed",
+				Description: "Triggered when a dialog is closed, either by user action, JS abort, or a command below.",
+				Params: []*ParamInfo{
+					{Name: "dialogId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*FedCmDialogClosedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "PWA",
+		Description:  "This domain allows interacting with the browser to control PWAs.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "getOsAppState",
+				Description: "Returns the following OS state for the given manifest id.",
+				Params: []*ParamInfo{
+					{Name: "manifestId", Description: "The id from the webapp's manifest file, commonly it's the url of the site installing the webapp. See https://web.dev/learn/pwa/web-app-manifest.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "badgeCount", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "fileHandlers", Description: "", Type: "array", Items: "PWA.FileHandler", GoType: reflect.TypeOf((*[]*PWAFileHandler)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "install",
+				Description: "Installs the given manifest identity, optionally using the given installUrlOrBundleUrl  IWA-specific install description: manifestId corresponds to isolated-app:// + web_package::SignedWebBundleId  File installation mode: The installUrlOrBundleUrl can be either file:// or http(s):// pointing to a signed web bundle (.swbn). In this case SignedWebBundleId must correspond to The .swbn file's signing key.  Dev proxy installation mode: installUrlOrBundleUrl must be http(s):// that serves dev mode IWA. web_package::SignedWebBundleId must be of type dev proxy.  The advantage of dev proxy mode is that all changes to IWA automatically will be reflected in the running app without reinstallation.  To generate bundle id for proxy mode: 1. Generate 32 random bytes. 2. Add a specific suffix 0x00 at the end. 3. Encode the entire sequence using Base32 without padding.  If Chrome is not in IWA dev mode, the installation will fail, regardless of the state of the allowlist.",
+				Params: []*ParamInfo{
+					{Name: "manifestId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "installUrlOrBundleUrl", Description: "The location of the app or bundle overriding the one derived from the manifestId.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "uninstall",
+				Description: "Uninstalls the given manifest_id and closes any opened app windows.",
+				Params: []*ParamInfo{
+					{Name: "manifestId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "launch",
+				Description: "Launches the installed web app, or an url in the same web app instead of the default start url if it is provided. Returns a page Target.TargetID which can be used to attach to via Target.attachToTarget or similar APIs.",
+				Params: []*ParamInfo{
+					{Name: "manifestId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "targetId", Description: "ID of the tab target created as a result.", Type: "Target.TargetID", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "launchFilesInApp",
+				Description: "Opens one or more local files from an installed web app identified by its manifestId. The web app needs to have file handlers registered to process the files. The API returns one or more page Target.TargetIDs which can be used to attach to via Target.attachToTarget or similar APIs. If some files in the parameters cannot be handled by the web app, they will be ignored. If none of the files can be handled, this API returns an error. If no files are provided as the parameter, this API also returns an error.  According to the definition of the file handlers in the manifest file, one Target.TargetID may represent a page handling one or more files. The order of the returned Target.TargetIDs is not guaranteed.  TODO(crbug.com/339454034): Check the existences of the input files.",
+				Params: []*ParamInfo{
+					{Name: "manifestId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "files", Description: "", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "targetIds", Description: "IDs of the tab targets created as the result.", Type: "array", Items: "Target.TargetID", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "openCurrentPageInApp",
+				Description: "Opens the current page in its web app identified by the manifest id, needs to be called on a page target. This function returns immediately without waiting for the app to finish loading.",
+				Params: []*ParamInfo{
+					{Name: "manifestId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "changeAppUserSettings",
+				Description: "Changes user settings of the web app identified by its manifestId. If the app was not installed, this command returns an error. Unset parameters will be ignored; unrecognized values will cause an error.  Unlike the ones defined in the manifest files of the web apps, these settings are provided by the browser and controlled by the users, they impact the way the browser handling the web apps.  See the comment of each parameter.",
+				Params: []*ParamInfo{
+					{Name: "manifestId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "linkCapturing", Description: "If user allows the links clicked on by the user in the app's scope, or extended scope if the manifest has scope extensions and the flags `DesktopPWAsLinkCapturingWithScopeExtensions` and `WebAppEnableScopeExtensions` are enabled.  Note, the API does not support resetting the linkCapturing to the initial value, uninstalling and installing the web app again will reset it.  TODO(crbug.com/339453269): Setting this value on ChromeOS is not supported yet.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "displayMode", Description: " enum values: standalone, browser", Type: "PWA.DisplayMode", Optional: true, GoType: reflect.TypeOf((*PWADisplayMode)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+	{
+		Name:         "BluetoothEmulation",
+		Description:  "This domain allows configuring virtual Bluetooth devices to test the web-bluetooth API.",
+		Experimental: true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "enable",
+				Description: "Enable the BluetoothEmulation domain.",
+				Params: []*ParamInfo{
+					{Name: "state", Description: "State of the simulated central. enum values: absent, powered-off, powered-on", Type: "BluetoothEmulation.CentralState", GoType: reflect.TypeOf((*BluetoothEmulationCentralState)(nil)).Elem()},
+					{Name: "leSupported", Description: "If the simulated central supports low-energy.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setSimulatedCentralState",
+				Description: "Set the state of the simulated central.",
+				Params: []*ParamInfo{
+					{Name: "state", Description: "State of the simulated central. enum values: absent, powered-off, powered-on", Type: "BluetoothEmulation.CentralState", GoType: reflect.TypeOf((*BluetoothEmulationCentralState)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disable the BluetoothEmulation domain.",
+			},
+			{
+				Name:        "simulatePreconnectedPeripheral",
+				Description: "Simulates a peripheral with |address|, |name| and |knownServiceUuids| that has already been connected to the system.",
+				Params: []*ParamInfo{
+					{Name: "address", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "name", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "manufacturerData", Description: "", Type: "array", Items: "BluetoothEmulation.ManufacturerData", GoType: reflect.TypeOf((*[]*BluetoothEmulationManufacturerData)(nil)).Elem()},
+					{Name: "knownServiceUuids", Description: "", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "simulateAdvertisement",
+				Description: "Simulates an advertisement packet described in |entry| being received by the central.",
+				Params: []*ParamInfo{
+					{Name: "entry", Description: "", Type: "BluetoothEmulation.ScanEntry", GoType: reflect.TypeOf((**BluetoothEmulationScanEntry)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "simulateGATTOperationResponse",
+				Description: "Simulates the response code from the peripheral with |address| for a GATT operation of |type|. The |code| value follows the HCI Error Codes from Bluetooth Core Specification Vol 2 Part D 1.3 List Of Error Codes.",
+				Params: []*ParamInfo{
+					{Name: "address", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "type", Description: " enum values: connection, discovery", Type: "BluetoothEmulation.GATTOperationType", GoType: reflect.TypeOf((*BluetoothEmulationGATTOperationType)(nil)).Elem()},
+					{Name: "code", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "simulateCharacteristicOperationResponse",
+				Description: "Simulates the response from the characteristic with |characteristicId| for a characteristic operation of |type|. The |code| value follows the Error Codes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The |data| is expected to exist when simulating a successful read operation response.",
+				Params: []*ParamInfo{
+					{Name: "characteristicId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "type", Description: " enum values: read, write, subscribe-to-notifications, unsubscribe-from-notifications", Type: "BluetoothEmulation.CharacteristicOperationType", GoType: reflect.TypeOf((*BluetoothEmulationCharacteristicOperationType)(nil)).Elem()},
+					{Name: "code", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "data", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "simulateDescriptorOperationResponse",
+				Description: "Simulates the response from the descriptor with |descriptorId| for a descriptor operation of |type|. The |code| value follows the Error Codes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The |data| is expected to exist when simulating a successful read operation response.",
+				Params: []*ParamInfo{
+					{Name: "descriptorId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "type", Description: " enum values: read, write", Type: "BluetoothEmulation.DescriptorOperationType", GoType: reflect.TypeOf((*BluetoothEmulationDescriptorOperationType)(nil)).Elem()},
+					{Name: "code", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "data", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "addService",
+				Description: "Adds a service with |serviceUuid| to the peripheral with |address|.",
+				Params: []*ParamInfo{
+					{Name: "address", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "serviceUuid", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "serviceId", Description: "An identifier that uniquely represents this service.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeService",
+				Description: "Removes the service respresented by |serviceId| from the simulated central.",
+				Params: []*ParamInfo{
+					{Name: "serviceId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "addCharacteristic",
+				Description: "Adds a characteristic with |characteristicUuid| and |properties| to the service represented by |serviceId|.",
+				Params: []*ParamInfo{
+					{Name: "serviceId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "characteristicUuid", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "properties", Description: "", Type: "BluetoothEmulation.CharacteristicProperties", GoType: reflect.TypeOf((**BluetoothEmulationCharacteristicProperties)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "characteristicId", Description: "An identifier that uniquely represents this characteristic.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeCharacteristic",
+				Description: "Removes the characteristic respresented by |characteristicId| from the simulated central.",
+				Params: []*ParamInfo{
+					{Name: "characteristicId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "addDescriptor",
+				Description: "Adds a descriptor with |descriptorUuid| to the characteristic respresented by |characteristicId|.",
+				Params: []*ParamInfo{
+					{Name: "characteristicId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "descriptorUuid", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "descriptorId", Description: "An identifier that uniquely represents this descriptor.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeDescriptor",
+				Description: "Removes the descriptor with |descriptorId| from the simulated central.",
+				Params: []*ParamInfo{
+					{Name: "descriptorId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "simulateGATTDisconnection",
+				Description: "Simulates a GATT disconnection from the peripheral with |address|.",
+				Params: []*ParamInfo{
+					{Name: "address", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "gattOperationReceived",
+				Description: "Event for when a GATT operation of |type| to the peripheral with |address| happened.",
+				Params: []*ParamInfo{
+					{Name: "address", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "type", Description: " enum values: connection, discovery", Type: "BluetoothEmulation.GATTOperationType", GoType: reflect.TypeOf((*BluetoothEmulationGATTOperationType)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*BluetoothEmulationGattOperationReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "characteristicOperationReceived",
+				Description: "Event for when a characteristic operation of |type| to the characteristic respresented by |characteristicId| happened. |data| and |writeType| is expected to exist when |type| is write.",
+				Params: []*ParamInfo{
+					{Name: "characteristicId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "type", Description: " enum values: read, write, subscribe-to-notifications, unsubscribe-from-notifications", Type: "BluetoothEmulation.CharacteristicOperationType", GoType: reflect.TypeOf((*BluetoothEmulationCharacteristicOperationType)(nil)).Elem()},
+					{Name: "data", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "writeType", Description: " enum values: write-default-deprecated, write-with-response, write-without-response", Type: "BluetoothEmulation.CharacteristicWriteType", Optional: true, GoType: reflect.TypeOf((*BluetoothEmulationCharacteristicWriteType)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*BluetoothEmulationCharacteristicOperationReceivedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "descriptorOperationReceived",
+				Description: "Event for when a descriptor operation of |type| to the descriptor respresented by |descriptorId| happened. |data| is expected to exist when |type| is write.",
+				Params: []*ParamInfo{
+					{Name: "descriptorId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "type", Description: " enum values: read, write", Type: "BluetoothEmulation.DescriptorOperationType", GoType: reflect.TypeOf((*BluetoothEmulationDescriptorOperationType)(nil)).Elem()},
+					{Name: "data", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*BluetoothEmulationDescriptorOperationReceivedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Console",
+		Description:  "This domain is deprecated - use Runtime or Log instead.",
+		Deprecated:   true,
+		Dependencies: []string{"Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "clearMessages",
+				Description: "Does nothing.",
+			},
+			{
+				Name:        "disable",
+				Description: "Disables console domain, prevents further console messages from being reported to the client.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables console domain, sends the messages collected so far to the client by means of the `messageAdded` notification.",
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "messageAdded",
+				Description: "Issued when new console message is added.",
+				Params: []*ParamInfo{
+					{Name: "message", Description: "Console message that has been added.", Type: "Console.ConsoleMessage", GoType: reflect.TypeOf((**ConsoleConsoleMessage)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*ConsoleMessageAddedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Debugger",
+		Description:  "Debugger domain exposes JavaScript debugging capabilities. It allows setting and removing breakpoints, stepping through execution, exploring stack traces, etc.",
+		Dependencies: []string{"Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "continueToLocation",
+				Description: "Continues execution until specific location is reached.",
+				Params: []*ParamInfo{
+					{Name: "location", Description: "Location to continue to.", Type: "Debugger.Location", GoType: reflect.TypeOf((**DebuggerLocation)(nil)).Elem()},
+					{Name: "targetCallFrames", Description: "", Type: "string", Optional: true, GoType: reflect.TypeOf((*DebuggerContinueToLocationTargetCallFrames)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables debugger for given page.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables debugger for the given page. Clients should not assume that the debugging has been enabled until the result for this command is received.",
+				Params: []*ParamInfo{
+					{Name: "maxScriptsCacheSize", Description: "The maximum size in bytes of collected scripts (not referenced by other heap objects) the debugger can hold. Puts no limit if parameter is omitted.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "debuggerId", Description: "Unique identifier of the debugger.", Type: "Runtime.UniqueDebuggerId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "evaluateOnCallFrame",
+				Description: "Evaluates expression on a given call frame.",
+				Params: []*ParamInfo{
+					{Name: "callFrameId", Description: "Call frame identifier to evaluate on.", Type: "Debugger.CallFrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "expression", Description: "Expression to evaluate.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectGroup", Description: "String object group name to put result into (allows rapid releasing resulting object handles using `releaseObjectGroup`).", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "includeCommandLineAPI", Description: "Specifies whether command line API should be available to the evaluated expression, defaults to false.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "silent", Description: "In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "returnByValue", Description: "Whether the result is expected to be a JSON object that should be sent by value.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "generatePreview", Description: "Whether preview should be generated for the result.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "throwOnSideEffect", Description: "Whether to throw an exception if side effect cannot be ruled out during evaluation.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "timeout", Description: "Terminate execution after timing out (number of milliseconds).", Type: "Runtime.TimeDelta", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Object wrapper for the evaluation result.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "Exception details.", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getPossibleBreakpoints",
+				Description: "Returns possible locations for breakpoint. scriptId in start and end range locations should be the same.",
+				Params: []*ParamInfo{
+					{Name: "start", Description: "Start of range to search possible breakpoint locations in.", Type: "Debugger.Location", GoType: reflect.TypeOf((**DebuggerLocation)(nil)).Elem()},
+					{Name: "end", Description: "End of range to search possible breakpoint locations in (excluding). When not specified, end of scripts is used as end of range.", Type: "Debugger.Location", Optional: true, GoType: reflect.TypeOf((**DebuggerLocation)(nil)).Elem()},
+					{Name: "restrictToFunction", Description: "Only consider locations which are in the same (non-nested) function as start.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "locations", Description: "List of the possible breakpoint locations.", Type: "array", Items: "Debugger.BreakLocation", GoType: reflect.TypeOf((*[]*DebuggerBreakLocation)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getScriptSource",
+				Description: "Returns source for the script with given id.",
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Id of the script to get source for.", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "scriptSource", Description: "Script source (empty in case of Wasm bytecode).", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "bytecode", Description: "Wasm bytecode. (Encoded as a base64 string when passed over JSON)", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "disassembleWasmModule",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Id of the script to disassemble", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "streamId", Description: "For large modules, return a stream from which additional chunks of disassembly can be read successively.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "totalNumberOfLines", Description: "The total number of lines in the disassembly text.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "functionBodyOffsets", Description: "The offsets of all function bodies, in the format [start1, end1, start2, end2, ...] where all ends are exclusive.", Type: "array", Items: "integer", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+					{Name: "chunk", Description: "The first chunk of disassembly.", Type: "Debugger.WasmDisassemblyChunk", GoType: reflect.TypeOf((**DebuggerWasmDisassemblyChunk)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "nextWasmDisassemblyChunk",
+				Description:  "Disassemble the next chunk of lines for the module corresponding to the stream. If disassembly is complete, this API will invalidate the streamId and return an empty chunk. Any subsequent calls for the now invalid stream will return errors.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "streamId", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "chunk", Description: "The next chunk of disassembly.", Type: "Debugger.WasmDisassemblyChunk", GoType: reflect.TypeOf((**DebuggerWasmDisassemblyChunk)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getWasmBytecode",
+				Description: "This command is deprecated. Use getScriptSource instead.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Id of the Wasm script to get source for.", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "bytecode", Description: "Script source. (Encoded as a base64 string when passed over JSON)", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getStackTrace",
+				Description:  "Returns stack trace with given `stackTraceId`.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "stackTraceId", Description: "", Type: "Runtime.StackTraceId", GoType: reflect.TypeOf((**RuntimeStackTraceId)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "stackTrace", Description: "", Type: "Runtime.StackTrace", GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "pause",
+				Description: "Stops on the next JavaScript statement.",
+			},
+			{
+				Name:         "pauseOnAsyncCall",
+				Description:  "",
+				Experimental: true,
+				Deprecated:   true,
+				Params: []*ParamInfo{
+					{Name: "parentStackTraceId", Description: "Debugger will pause when async call with given stack trace is started.", Type: "Runtime.StackTraceId", GoType: reflect.TypeOf((**RuntimeStackTraceId)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeBreakpoint",
+				Description: "Removes JavaScript breakpoint.",
+				Params: []*ParamInfo{
+					{Name: "breakpointId", Description: "", Type: "Debugger.BreakpointId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "restartFrame",
+				Description: "Restarts particular call frame from the beginning. The old, deprecated behavior of `restartFrame` is to stay paused and allow further CDP commands after a restart was scheduled. This can cause problems with restarting, so we now continue execution immediatly after it has been scheduled until we reach the beginning of the restarted frame.  To stay back-wards compatible, `restartFrame` now expects a `mode` parameter to be present. If the `mode` parameter is missing, `restartFrame` errors out.  The various return values are deprecated and `callFrames` is always empty. Use the call frames from the `Debugger#paused` events instead, that fires once V8 pauses at the beginning of the restarted function.",
+				Params: []*ParamInfo{
+					{Name: "callFrameId", Description: "Call frame identifier to evaluate on.", Type: "Debugger.CallFrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "mode", Description: "The `mode` parameter must be present and set to 'StepInto', otherwise `restartFrame` will error out.", Type: "string", Optional: true, GoType: reflect.TypeOf((*DebuggerRestartFrameMode)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "callFrames", Description: "New stack trace.", Type: "array", Items: "Debugger.CallFrame", GoType: reflect.TypeOf((*[]*DebuggerCallFrame)(nil)).Elem()},
+					{Name: "asyncStackTrace", Description: "Async stack trace, if any.", Type: "Runtime.StackTrace", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+					{Name: "asyncStackTraceId", Description: "Async stack trace, if any.", Type: "Runtime.StackTraceId", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTraceId)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "resume",
+				Description: "Resumes JavaScript execution.",
+				Params: []*ParamInfo{
+					{Name: "terminateOnResume", Description: "Set to true to terminate execution upon resuming execution. In contrast to Runtime.terminateExecution, this will allows to execute further JavaScript (i.e. via evaluation) until execution of the paused code is actually resumed, at which point termination is triggered. If execution is currently not paused, this parameter has no effect.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "searchInContent",
+				Description: "Searches for given string in script content.",
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Id of the script to search in.", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "query", Description: "String to search for.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "caseSensitive", Description: "If true, search is case sensitive.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isRegex", Description: "If true, treats string parameter as regex.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "List of search matches.", Type: "array", Items: "Debugger.SearchMatch", GoType: reflect.TypeOf((*[]*DebuggerSearchMatch)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setAsyncCallStackDepth",
+				Description: "Enables or disables async call stacks tracking.",
+				Params: []*ParamInfo{
+					{Name: "maxDepth", Description: "Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async call stacks (default).", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setBlackboxExecutionContexts",
+				Description:  "Replace previous blackbox execution contexts with passed ones. Forces backend to skip stepping/pausing in scripts in these execution contexts. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "uniqueIds", Description: "Array of execution context unique ids for the debugger to ignore.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setBlackboxPatterns",
+				Description:  "Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in scripts with url matching one of the patterns. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "patterns", Description: "Array of regexps that will be used to check script url for blackbox state.", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "skipAnonymous", Description: "If true, also ignore scripts with no source url.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setBlackboxedRanges",
+				Description:  "Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted scripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful. Positions array contains positions where blackbox state is changed. First interval isn't blackboxed. Array should be sorted.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Id of the script.", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "positions", Description: "", Type: "array", Items: "Debugger.ScriptPosition", GoType: reflect.TypeOf((*[]*DebuggerScriptPosition)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setBreakpoint",
+				Description: "Sets JavaScript breakpoint at a given location.",
+				Params: []*ParamInfo{
+					{Name: "location", Description: "Location to set breakpoint in.", Type: "Debugger.Location", GoType: reflect.TypeOf((**DebuggerLocation)(nil)).Elem()},
+					{Name: "condition", Description: "Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "breakpointId", Description: "Id of the created breakpoint for further reference.", Type: "Debugger.BreakpointId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "actualLocation", Description: "Location this breakpoint resolved into.", Type: "Debugger.Location", GoType: reflect.TypeOf((**DebuggerLocation)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setInstrumentationBreakpoint",
+				Description: "Sets instrumentation breakpoint.",
+				Params: []*ParamInfo{
+					{Name: "instrumentation", Description: "Instrumentation name.", Type: "string", GoType: reflect.TypeOf((*DebuggerSetInstrumentationBreakpointInstrumentation)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "breakpointId", Description: "Id of the created breakpoint for further reference.", Type: "Debugger.BreakpointId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setBreakpointByUrl",
+				Description: "Sets JavaScript breakpoint at given location specified either by URL or URL regex. Once this command is issued, all existing parsed scripts will have breakpoints resolved and returned in `locations` property. Further matching script parsing will result in subsequent `breakpointResolved` events issued. This logical breakpoint will survive page reloads.",
+				Params: []*ParamInfo{
+					{Name: "lineNumber", Description: "Line number to set breakpoint at.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "url", Description: "URL of the resources to set breakpoint on.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "urlRegex", Description: "Regex pattern for the URLs of the resources to set breakpoints on. Either `url` or `urlRegex` must be specified.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "scriptHash", Description: "Script hash of the resources to set breakpoint on.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "columnNumber", Description: "Offset in the line to set breakpoint at.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "condition", Description: "Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "breakpointId", Description: "Id of the created breakpoint for further reference.", Type: "Debugger.BreakpointId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "locations", Description: "List of the locations this breakpoint resolved into upon addition.", Type: "array", Items: "Debugger.Location", GoType: reflect.TypeOf((*[]*DebuggerLocation)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setBreakpointOnFunctionCall",
+				Description:  "Sets JavaScript breakpoint before each call to the given function. If another function was created from the same source as a given one, calling it will also trigger the breakpoint.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "Function object id.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "condition", Description: "Expression to use as a breakpoint condition. When specified, debugger will stop on the breakpoint if this expression evaluates to true.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "breakpointId", Description: "Id of the created breakpoint for further reference.", Type: "Debugger.BreakpointId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setBreakpointsActive",
+				Description: "Activates / deactivates all breakpoints on the page.",
+				Params: []*ParamInfo{
+					{Name: "active", Description: "New value for breakpoints active state.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setPauseOnExceptions",
+				Description: "Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions, or caught exceptions, no exceptions. Initial pause on exceptions state is `none`.",
+				Params: []*ParamInfo{
+					{Name: "state", Description: "Pause on exceptions mode.", Type: "string", GoType: reflect.TypeOf((*DebuggerSetPauseOnExceptionsState)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setReturnValue",
+				Description:  "Changes return value in top frame. Available only at return break position.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "newValue", Description: "New return value.", Type: "Runtime.CallArgument", GoType: reflect.TypeOf((**RuntimeCallArgument)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setScriptSource",
+				Description: "Edits JavaScript source live.  In general, functions that are currently on the stack can not be edited with a single exception: If the edited function is the top-most stack frame and that is the only activation of that function on the stack. In this case the live edit will be successful and a `Debugger.restartFrame` for the top-most function is automatically triggered.",
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Id of the script to edit.", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "scriptSource", Description: "New content of the script.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "dryRun", Description: "If true the change will not actually be applied. Dry run may be used to get result description without actually modifying the code.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "allowTopFrameEditing", Description: "If true, then `scriptSource` is allowed to change the function on top of the stack as long as the top-most stack frame is the only activation of that function.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "callFrames", Description: "New stack trace in case editing has happened while VM was stopped.", Type: "array", Items: "Debugger.CallFrame", Optional: true, GoType: reflect.TypeOf((*[]*DebuggerCallFrame)(nil)).Elem()},
+					{Name: "stackChanged", Description: "Whether current call stack  was modified after applying the changes.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "asyncStackTrace", Description: "Async stack trace, if any.", Type: "Runtime.StackTrace", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+					{Name: "asyncStackTraceId", Description: "Async stack trace, if any.", Type: "Runtime.StackTraceId", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTraceId)(nil)).Elem()},
+					{Name: "status", Description: "Whether the operation was successful or not. Only `Ok` denotes a successful live edit while the other enum variants denote why the live edit failed.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "Exception details if any. Only present when `status` is `CompileError`.", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setSkipAllPauses",
+				Description: "Makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).",
+				Params: []*ParamInfo{
+					{Name: "skip", Description: "New value for skip pauses state.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setVariableValue",
+				Description: "Changes value of variable in a callframe. Object-based scopes are not supported and must be mutated manually.",
+				Params: []*ParamInfo{
+					{Name: "scopeNumber", Description: "0-based number of scope as was listed in scope chain. Only 'local', 'closure' and 'catch' scope types are allowed. Other scopes could be manipulated manually.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "variableName", Description: "Variable name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "newValue", Description: "New variable value.", Type: "Runtime.CallArgument", GoType: reflect.TypeOf((**RuntimeCallArgument)(nil)).Elem()},
+					{Name: "callFrameId", Description: "Id of callframe that holds variable.", Type: "Debugger.CallFrameId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stepInto",
+				Description: "Steps into the function call.",
+				Params: []*ParamInfo{
+					{Name: "breakOnAsyncCall", Description: "Debugger will pause on the execution of the first async task which was scheduled before next pause.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "skipList", Description: "The skipList specifies location ranges that should be skipped on step into.", Type: "array", Items: "Debugger.LocationRange", Optional: true, GoType: reflect.TypeOf((*[]*DebuggerLocationRange)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stepOut",
+				Description: "Steps out of the function call.",
+			},
+			{
+				Name:        "stepOver",
+				Description: "Steps over the statement.",
+				Params: []*ParamInfo{
+					{Name: "skipList", Description: "The skipList specifies location ranges that should be skipped on step over.", Type: "array", Items: "Debugger.LocationRange", Optional: true, GoType: reflect.TypeOf((*[]*DebuggerLocationRange)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "breakpointResolved",
+				Description: "Fired when breakpoint is resolved to an actual script and location. Deprecated in favor of `resolvedBreakpoints` in the `scriptParsed` event.",
+				Deprecated:  true,
+				Params: []*ParamInfo{
+					{Name: "breakpointId", Description: "Breakpoint unique identifier.", Type: "Debugger.BreakpointId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "location", Description: "Actual breakpoint location.", Type: "Debugger.Location", GoType: reflect.TypeOf((**DebuggerLocation)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DebuggerBreakpointResolvedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "paused",
+				Description: "Fired when the virtual machine stopped on breakpoint or exception or any other stop criteria.",
+				Params: []*ParamInfo{
+					{Name: "callFrames", Description: "Call stack the virtual machine stopped on.", Type: "array", Items: "Debugger.CallFrame", GoType: reflect.TypeOf((*[]*DebuggerCallFrame)(nil)).Elem()},
+					{Name: "reason", Description: "Pause reason.", Type: "string", GoType: reflect.TypeOf((*DebuggerPausedReason)(nil)).Elem()},
+					{Name: "data", Description: "Object containing break-specific auxiliary properties.", Type: "object", Optional: true, GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "hitBreakpoints", Description: "Hit breakpoints IDs", Type: "array", Items: "string", Optional: true, GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+					{Name: "asyncStackTrace", Description: "Async stack trace, if any.", Type: "Runtime.StackTrace", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+					{Name: "asyncStackTraceId", Description: "Async stack trace, if any.", Type: "Runtime.StackTraceId", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTraceId)(nil)).Elem()},
+					{Name: "asyncCallStackTraceId", Description: "Never present, will be removed.", Type: "Runtime.StackTraceId", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTraceId)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DebuggerPausedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "resumed",
+				Description: "Fired when the virtual machine resumed execution.",
+				Type:        reflect.TypeOf((*DebuggerResumedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "scriptFailedToParse",
+				Description: "Fired when virtual machine fails to parse the script.",
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Identifier of the script parsed.", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "URL or name of the script parsed (if any).", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "startLine", Description: "Line offset of the script within the resource with given URL (for script tags).", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "startColumn", Description: "Column offset of the script within the resource with given URL.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "endLine", Description: "Last line of the script.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "endColumn", Description: "Length of the last line of the script.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "executionContextId", Description: "Specifies script creation context.", Type: "Runtime.ExecutionContextId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "hash", Description: "Content hash of the script, SHA-256.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "buildId", Description: "For Wasm modules, the content of the `build_id` custom section. For JavaScript the `debugId` magic comment.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "executionContextAuxData", Description: "Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}", Type: "object", Optional: true, GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "sourceMapURL", Description: "URL of source map associated with script (if any).", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "hasSourceURL", Description: "True, if this script has sourceURL.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isModule", Description: "True, if this script is ES6 module.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "length", Description: "This script length.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "stackTrace", Description: "JavaScript top stack frame of where the script parsed event was triggered if available.", Type: "Runtime.StackTrace", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+					{Name: "codeOffset", Description: "If the scriptLanguage is WebAssembly, the code section offset in the module.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "scriptLanguage", Description: "The language of the script. enum values: JavaScript, WebAssembly", Type: "Debugger.ScriptLanguage", Optional: true, GoType: reflect.TypeOf((*DebuggerScriptLanguage)(nil)).Elem()},
+					{Name: "embedderName", Description: "The name the embedder supplied for this script.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DebuggerScriptFailedToParseEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "scriptParsed",
+				Description: "Fired when virtual machine parses script. This event is also fired for all known and uncollected scripts upon enabling debugger.",
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Identifier of the script parsed.", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "url", Description: "URL or name of the script parsed (if any).", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "startLine", Description: "Line offset of the script within the resource with given URL (for script tags).", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "startColumn", Description: "Column offset of the script within the resource with given URL.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "endLine", Description: "Last line of the script.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "endColumn", Description: "Length of the last line of the script.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "executionContextId", Description: "Specifies script creation context.", Type: "Runtime.ExecutionContextId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "hash", Description: "Content hash of the script, SHA-256.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "buildId", Description: "For Wasm modules, the content of the `build_id` custom section. For JavaScript the `debugId` magic comment.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "executionContextAuxData", Description: "Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string}", Type: "object", Optional: true, GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "isLiveEdit", Description: "True, if this script is generated as a result of the live edit operation.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "sourceMapURL", Description: "URL of source map associated with script (if any).", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "hasSourceURL", Description: "True, if this script has sourceURL.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "isModule", Description: "True, if this script is ES6 module.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "length", Description: "This script length.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "stackTrace", Description: "JavaScript top stack frame of where the script parsed event was triggered if available.", Type: "Runtime.StackTrace", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+					{Name: "codeOffset", Description: "If the scriptLanguage is WebAssembly, the code section offset in the module.", Type: "integer", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "scriptLanguage", Description: "The language of the script. enum values: JavaScript, WebAssembly", Type: "Debugger.ScriptLanguage", Optional: true, GoType: reflect.TypeOf((*DebuggerScriptLanguage)(nil)).Elem()},
+					{Name: "debugSymbols", Description: "If the scriptLanguage is WebAssembly, the source of debug symbols for the module.", Type: "array", Items: "Debugger.DebugSymbols", Optional: true, GoType: reflect.TypeOf((*[]*DebuggerDebugSymbols)(nil)).Elem()},
+					{Name: "embedderName", Description: "The name the embedder supplied for this script.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "resolvedBreakpoints", Description: "The list of set breakpoints in this script if calls to `setBreakpointByUrl` matches this script's URL or hash. Clients that use this list can ignore the `breakpointResolved` event. They are equivalent.", Type: "array", Items: "Debugger.ResolvedBreakpoint", Optional: true, GoType: reflect.TypeOf((*[]*DebuggerResolvedBreakpoint)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*DebuggerScriptParsedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "HeapProfiler",
+		Description:  "",
+		Experimental: true,
+		Dependencies: []string{"Runtime"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "addInspectedHeapObject",
+				Description: "Enables console to refer to the node with given id via $x (see Command Line API for more details $x functions).",
+				Params: []*ParamInfo{
+					{Name: "heapObjectId", Description: "Heap snapshot object id to be accessible by means of $x command line API.", Type: "HeapProfiler.HeapSnapshotObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "collectGarbage",
+				Description: "",
+			},
+			{
+				Name:        "disable",
+				Description: "",
+			},
+			{
+				Name:        "enable",
+				Description: "",
+			},
+			{
+				Name:        "getHeapObjectId",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "Identifier of the object to get heap object id for.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "heapSnapshotObjectId", Description: "Id of the heap snapshot object corresponding to the passed remote object id.", Type: "HeapProfiler.HeapSnapshotObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getObjectByHeapObjectId",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "", Type: "HeapProfiler.HeapSnapshotObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectGroup", Description: "Symbolic group name that can be used to release multiple objects.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Evaluation result.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getSamplingProfile",
+				Description: "",
+				Returns: []*ParamInfo{
+					{Name: "profile", Description: "Return the sampling profile being collected.", Type: "HeapProfiler.SamplingHeapProfile", GoType: reflect.TypeOf((**HeapProfilerSamplingHeapProfile)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "startSampling",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "samplingInterval", Description: "Average sample interval in bytes. Poisson distribution is used for the intervals. The default value is 32768 bytes.", Type: "number", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "includeObjectsCollectedByMajorGC", Description: "By default, the sampling heap profiler reports only objects which are still alive when the profile is returned via getSamplingProfile or stopSampling, which is useful for determining what functions contribute the most to steady-state memory usage. This flag instructs the sampling heap profiler to also include information about objects discarded by major GC, which will show which functions cause large temporary memory usage or long GC pauses.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "includeObjectsCollectedByMinorGC", Description: "By default, the sampling heap profiler reports only objects which are still alive when the profile is returned via getSamplingProfile or stopSampling, which is useful for determining what functions contribute the most to steady-state memory usage. This flag instructs the sampling heap profiler to also include information about objects discarded by minor GC, which is useful when tuning a latency-sensitive application for minimal GC activity.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "startTrackingHeapObjects",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "trackAllocations", Description: "", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopSampling",
+				Description: "",
+				Returns: []*ParamInfo{
+					{Name: "profile", Description: "Recorded sampling heap profile.", Type: "HeapProfiler.SamplingHeapProfile", GoType: reflect.TypeOf((**HeapProfilerSamplingHeapProfile)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopTrackingHeapObjects",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "reportProgress", Description: "If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken when the tracking is stopped.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "treatGlobalObjectsAsRoots", Description: "Deprecated in favor of `exposeInternals`.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "captureNumericValue", Description: "If true, numerical values are included in the snapshot", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "exposeInternals", Description: "If true, exposes internals of the snapshot.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "takeHeapSnapshot",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "reportProgress", Description: "If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "treatGlobalObjectsAsRoots", Description: "If true, a raw snapshot without artificial roots will be generated. Deprecated in favor of `exposeInternals`.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "captureNumericValue", Description: "If true, numerical values are included in the snapshot", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "exposeInternals", Description: "If true, exposes internals of the snapshot.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "addHeapSnapshotChunk",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "chunk", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*HeapProfilerAddHeapSnapshotChunkEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "heapStatsUpdate",
+				Description: "If heap objects tracking has been started then backend may send update for one or more fragments",
+				Params: []*ParamInfo{
+					{Name: "statsUpdate", Description: "An array of triplets. Each triplet describes a fragment. The first integer is the fragment index, the second integer is a total count of objects for the fragment, the third integer is a total size of the objects for the fragment.", Type: "array", Items: "integer", GoType: reflect.TypeOf((*[]int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*HeapProfilerHeapStatsUpdateEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "lastSeenObjectId",
+				Description: "If heap objects tracking has been started then backend regularly sends a current value for last seen object id and corresponding timestamp. If the were changes in the heap since last event then one or more heapStatsUpdate events will be sent before a new lastSeenObjectId event.",
+				Params: []*ParamInfo{
+					{Name: "lastSeenObjectId", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "timestamp", Description: "", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*HeapProfilerLastSeenObjectIdEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "reportHeapSnapshotProgress",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "done", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "total", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "finished", Description: "", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*HeapProfilerReportHeapSnapshotProgressEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "resetProfiles",
+				Description: "",
+				Type:        reflect.TypeOf((*HeapProfilerResetProfilesEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:         "Profiler",
+		Description:  "",
+		Dependencies: []string{"Runtime", "Debugger"},
+		Commands: []*CommandInfo{
+			{
+				Name:        "disable",
+				Description: "",
+			},
+			{
+				Name:        "enable",
+				Description: "",
+			},
+			{
+				Name:        "getBestEffortCoverage",
+				Description: "Collect coverage data for the current isolate. The coverage data may be incomplete due to garbage collection.",
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Coverage data for the current isolate.", Type: "array", Items: "Profiler.ScriptCoverage", GoType: reflect.TypeOf((*[]*ProfilerScriptCoverage)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setSamplingInterval",
+				Description: "Changes CPU profiler sampling interval. Must be called before CPU profiles recording started.",
+				Params: []*ParamInfo{
+					{Name: "interval", Description: "New sampling interval in microseconds.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "start",
+				Description: "",
+			},
+			{
+				Name:        "startPreciseCoverage",
+				Description: "Enable precise code coverage. Coverage data for JavaScript executed before enabling precise code coverage may be incomplete. Enabling prevents running optimized code and resets execution counters.",
+				Params: []*ParamInfo{
+					{Name: "callCount", Description: "Collect accurate call counts beyond simple 'covered' or 'not covered'.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "detailed", Description: "Collect block-based coverage.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "allowTriggeredUpdates", Description: "Allow the backend to send updates on its own initiative", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "timestamp", Description: "Monotonically increasing time (in seconds) when the coverage update was taken in the backend.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stop",
+				Description: "",
+				Returns: []*ParamInfo{
+					{Name: "profile", Description: "Recorded profile.", Type: "Profiler.Profile", GoType: reflect.TypeOf((**ProfilerProfile)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "stopPreciseCoverage",
+				Description: "Disable precise code coverage. Disabling releases unnecessary execution count records and allows executing optimized code.",
+			},
+			{
+				Name:        "takePreciseCoverage",
+				Description: "Collect coverage data for the current isolate, and resets execution counters. Precise code coverage needs to have started.",
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Coverage data for the current isolate.", Type: "array", Items: "Profiler.ScriptCoverage", GoType: reflect.TypeOf((*[]*ProfilerScriptCoverage)(nil)).Elem()},
+					{Name: "timestamp", Description: "Monotonically increasing time (in seconds) when the coverage update was taken in the backend.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:        "consoleProfileFinished",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "location", Description: "Location of console.profileEnd().", Type: "Debugger.Location", GoType: reflect.TypeOf((**DebuggerLocation)(nil)).Elem()},
+					{Name: "profile", Description: "", Type: "Profiler.Profile", GoType: reflect.TypeOf((**ProfilerProfile)(nil)).Elem()},
+					{Name: "title", Description: "Profile title passed as an argument to console.profile().", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*ProfilerConsoleProfileFinishedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "consoleProfileStarted",
+				Description: "Sent when new profile recording is started using console.profile() call.",
+				Params: []*ParamInfo{
+					{Name: "id", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "location", Description: "Location of console.profile().", Type: "Debugger.Location", GoType: reflect.TypeOf((**DebuggerLocation)(nil)).Elem()},
+					{Name: "title", Description: "Profile title passed as an argument to console.profile().", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*ProfilerConsoleProfileStartedEvent)(nil)).Elem(),
+			},
+			{
+				Name:         "preciseCoverageDeltaUpdate",
+				Description:  "Reports coverage delta since the last poll (either from an event like this, or from `takePreciseCoverage` for the current isolate. May only be sent if precise code coverage has been started. This event can be trigged by the embedder to, for example, trigger collection of coverage data immediately at a certain point in time.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "timestamp", Description: "Monotonically increasing time (in seconds) when the coverage update was taken in the backend.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "occasion", Description: "Identifier for distinguishing coverage events.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "result", Description: "Coverage data for the current isolate.", Type: "array", Items: "Profiler.ScriptCoverage", GoType: reflect.TypeOf((*[]*ProfilerScriptCoverage)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*ProfilerPreciseCoverageDeltaUpdateEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:        "Runtime",
+		Description: "Runtime domain exposes JavaScript runtime by means of remote evaluation and mirror objects. Evaluation results are returned as mirror object that expose object type, string representation and unique identifier that can be used for further object reference. Original objects are maintained in memory unless they are either explicitly released or are released along with the other objects in their object group.",
+		Commands: []*CommandInfo{
+			{
+				Name:        "awaitPromise",
+				Description: "Add handler to promise with given promise object id.",
+				Params: []*ParamInfo{
+					{Name: "promiseObjectId", Description: "Identifier of the promise.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "returnByValue", Description: "Whether the result is expected to be a JSON object that should be sent by value.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "generatePreview", Description: "Whether preview should be generated for the result.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Promise result. Will contain rejected value if promise was rejected.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "Exception details if stack strace is available.", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "callFunctionOn",
+				Description: "Calls function with given declaration on the given object. Object group of the result is inherited from the target object.",
+				Params: []*ParamInfo{
+					{Name: "functionDeclaration", Description: "Declaration of the function to call.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectId", Description: "Identifier of the object to call function on. Either objectId or executionContextId should be specified.", Type: "Runtime.RemoteObjectId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "arguments", Description: "Call arguments. All call arguments must belong to the same JavaScript world as the target object.", Type: "array", Items: "Runtime.CallArgument", Optional: true, GoType: reflect.TypeOf((*[]*RuntimeCallArgument)(nil)).Elem()},
+					{Name: "silent", Description: "In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "returnByValue", Description: "Whether the result is expected to be a JSON object which should be sent by value. Can be overriden by `serializationOptions`.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "generatePreview", Description: "Whether preview should be generated for the result.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "userGesture", Description: "Whether execution should be treated as initiated by user in the UI.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "awaitPromise", Description: "Whether execution should `await` for resulting value and return once awaited promise is resolved.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "executionContextId", Description: "Specifies execution context which global object will be used to call function on. Either executionContextId or objectId should be specified.", Type: "Runtime.ExecutionContextId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectGroup", Description: "Symbolic group name that can be used to release multiple objects. If objectGroup is not specified and objectId is, objectGroup will be inherited from object.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "throwOnSideEffect", Description: "Whether to throw an exception if side effect cannot be ruled out during evaluation.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "uniqueContextId", Description: "An alternative way to specify the execution context to call function on. Compared to contextId that may be reused across processes, this is guaranteed to be system-unique, so it can be used to prevent accidental function call in context different than intended (e.g. as a result of navigation across process boundaries). This is mutually exclusive with `executionContextId`.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "serializationOptions", Description: "Specifies the result serialization. If provided, overrides `generatePreview` and `returnByValue`.", Type: "Runtime.SerializationOptions", Optional: true, GoType: reflect.TypeOf((**RuntimeSerializationOptions)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Call result.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "Exception details.", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "compileScript",
+				Description: "Compiles expression.",
+				Params: []*ParamInfo{
+					{Name: "expression", Description: "Expression to compile.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "sourceURL", Description: "Source url to be set for the script.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "persistScript", Description: "Specifies whether the compiled script should be persisted.", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "executionContextId", Description: "Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page.", Type: "Runtime.ExecutionContextId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "scriptId", Description: "Id of the script.", Type: "Runtime.ScriptId", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "Exception details.", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "disable",
+				Description: "Disables reporting of execution contexts creation.",
+			},
+			{
+				Name:        "discardConsoleEntries",
+				Description: "Discards collected exceptions and console API calls.",
+			},
+			{
+				Name:        "enable",
+				Description: "Enables reporting of execution contexts creation by means of `executionContextCreated` event. When the reporting gets enabled the event will be sent immediately for each existing execution context.",
+			},
+			{
+				Name:        "evaluate",
+				Description: "Evaluates expression on global object.",
+				Params: []*ParamInfo{
+					{Name: "expression", Description: "Expression to evaluate.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectGroup", Description: "Symbolic group name that can be used to release multiple objects.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "includeCommandLineAPI", Description: "Determines whether Command Line API should be available during the evaluation.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "silent", Description: "In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "contextId", Description: "Specifies in which execution context to perform evaluation. If the parameter is omitted the evaluation will be performed in the context of the inspected page. This is mutually exclusive with `uniqueContextId`, which offers an alternative way to identify the execution context that is more reliable in a multi-process environment.", Type: "Runtime.ExecutionContextId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "returnByValue", Description: "Whether the result is expected to be a JSON object that should be sent by value.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "generatePreview", Description: "Whether preview should be generated for the result.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "userGesture", Description: "Whether execution should be treated as initiated by user in the UI.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "awaitPromise", Description: "Whether execution should `await` for resulting value and return once awaited promise is resolved.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "throwOnSideEffect", Description: "Whether to throw an exception if side effect cannot be ruled out during evaluation. This implies `disableBreaks` below.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "timeout", Description: "Terminate execution after timing out (number of milliseconds).", Type: "Runtime.TimeDelta", Optional: true, GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "disableBreaks", Description: "Disable breakpoints during execution.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "replMode", Description: "Setting this flag to true enables `let` re-declaration and top-level `await`. Note that `let` variables can only be re-declared if they originate from `replMode` themselves.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "allowUnsafeEvalBlockedByCSP", Description: "The Content Security Policy (CSP) for the target might block 'unsafe-eval' which includes eval(), Function(), setTimeout() and setInterval() when called with non-callable arguments. This flag bypasses CSP for this evaluation and allows unsafe-eval. Defaults to true.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "uniqueContextId", Description: "An alternative way to specify the execution context to evaluate in. Compared to contextId that may be reused across processes, this is guaranteed to be system-unique, so it can be used to prevent accidental evaluation of the expression in context different than intended (e.g. as a result of navigation across process boundaries). This is mutually exclusive with `contextId`.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "serializationOptions", Description: "Specifies the result serialization. If provided, overrides `generatePreview` and `returnByValue`.", Type: "Runtime.SerializationOptions", Optional: true, GoType: reflect.TypeOf((**RuntimeSerializationOptions)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Evaluation result.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "Exception details.", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getIsolateId",
+				Description:  "Returns the isolate id.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "id", Description: "The isolate id.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getHeapUsage",
+				Description:  "Returns the JavaScript heap usage. It is the total usage of the corresponding isolate not scoped to a particular Runtime.",
+				Experimental: true,
+				Returns: []*ParamInfo{
+					{Name: "usedSize", Description: "Used JavaScript heap size in bytes.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "totalSize", Description: "Allocated JavaScript heap size in bytes.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "embedderHeapUsedSize", Description: "Used size in bytes in the embedder's garbage-collected heap.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "backingStorageSize", Description: "Size in bytes of backing storage for array buffers and external strings.", Type: "number", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "getProperties",
+				Description: "Returns properties of a given object. Object group of the result is inherited from the target object.",
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "Identifier of the object to return properties for.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "ownProperties", Description: "If true, returns properties belonging only to the element itself, not to its prototype chain.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "accessorPropertiesOnly", Description: "If true, returns accessor properties (with getter/setter) only; internal properties are not returned either.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "generatePreview", Description: "Whether preview should be generated for the results.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "nonIndexedPropertiesOnly", Description: "If true, returns non-indexed properties only.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Object properties.", Type: "array", Items: "Runtime.PropertyDescriptor", GoType: reflect.TypeOf((*[]*RuntimePropertyDescriptor)(nil)).Elem()},
+					{Name: "internalProperties", Description: "Internal object properties (only of the element itself).", Type: "array", Items: "Runtime.InternalPropertyDescriptor", Optional: true, GoType: reflect.TypeOf((*[]*RuntimeInternalPropertyDescriptor)(nil)).Elem()},
+					{Name: "privateProperties", Description: "Object private properties.", Type: "array", Items: "Runtime.PrivatePropertyDescriptor", Optional: true, GoType: reflect.TypeOf((*[]*RuntimePrivatePropertyDescriptor)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "Exception details.", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "globalLexicalScopeNames",
+				Description: "Returns all let, const and class variables from global scope.",
+				Params: []*ParamInfo{
+					{Name: "executionContextId", Description: "Specifies in which execution context to lookup global scope variables.", Type: "Runtime.ExecutionContextId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "names", Description: "", Type: "array", Items: "string", GoType: reflect.TypeOf((*[]string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "queryObjects",
+				Description: "",
+				Params: []*ParamInfo{
+					{Name: "prototypeObjectId", Description: "Identifier of the prototype to return objects for.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "objectGroup", Description: "Symbolic group name that can be used to release the results.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "objects", Description: "Array with objects.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "releaseObject",
+				Description: "Releases remote object with given id.",
+				Params: []*ParamInfo{
+					{Name: "objectId", Description: "Identifier of the object to release.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "releaseObjectGroup",
+				Description: "Releases all remote objects that belong to a given group.",
+				Params: []*ParamInfo{
+					{Name: "objectGroup", Description: "Symbolic object group name.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "runIfWaitingForDebugger",
+				Description: "Tells inspected instance to run if it was waiting for debugger to attach.",
+			},
+			{
+				Name:        "runScript",
+				Description: "Runs script with given id in a given context.",
+				Params: []*ParamInfo{
+					{Name: "scriptId", Description: "Id of the script to run.", Type: "Runtime.ScriptId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "executionContextId", Description: "Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page.", Type: "Runtime.ExecutionContextId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "objectGroup", Description: "Symbolic group name that can be used to release multiple objects.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "silent", Description: "In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "includeCommandLineAPI", Description: "Determines whether Command Line API should be available during the evaluation.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "returnByValue", Description: "Whether the result is expected to be a JSON object which should be sent by value.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "generatePreview", Description: "Whether preview should be generated for the result.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+					{Name: "awaitPromise", Description: "Whether execution should `await` for resulting value and return once awaited promise is resolved.", Type: "boolean", Optional: true, GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "result", Description: "Run result.", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "Exception details.", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "setAsyncCallStackDepth",
+				Description: "Enables or disables async call stacks tracking.",
+				Params: []*ParamInfo{
+					{Name: "maxDepth", Description: "Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async call stacks (default).", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setCustomObjectFormatterEnabled",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "enabled", Description: "", Type: "boolean", GoType: reflect.TypeOf((*bool)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "setMaxCallStackSizeToCapture",
+				Description:  "",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "size", Description: "", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "terminateExecution",
+				Description:  "Terminate current or next JavaScript execution. Will cancel the termination when the outer-most script execution ends.",
+				Experimental: true,
+			},
+			{
+				Name:        "addBinding",
+				Description: "If executionContextId is empty, adds binding with the given name on the global objects of all inspected contexts, including those created later, bindings survive reloads. Binding function takes exactly one argument, this argument should be string, in case of any other input, function throws an exception. Each binding function call produces Runtime.bindingCalled notification.",
+				Params: []*ParamInfo{
+					{Name: "name", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "executionContextId", Description: "If specified, the binding would only be exposed to the specified execution context. If omitted and `executionContextName` is not set, the binding is exposed to all execution contexts of the target. This parameter is mutually exclusive with `executionContextName`. Deprecated in favor of `executionContextName` due to an unclear use case and bugs in implementation (crbug.com/1169639). `executionContextId` will be removed in the future.", Type: "Runtime.ExecutionContextId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "executionContextName", Description: "If specified, the binding is exposed to the executionContext with matching name, even for contexts created after the binding is added. See also `ExecutionContext.name` and `worldName` parameter to `Page.addScriptToEvaluateOnNewDocument`. This parameter is mutually exclusive with `executionContextId`.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:        "removeBinding",
+				Description: "This method does not remove binding function from global object but unsubscribes current runtime agent from Runtime.bindingCalled notifications.",
+				Params: []*ParamInfo{
+					{Name: "name", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+			},
+			{
+				Name:         "getExceptionDetails",
+				Description:  "This method tries to lookup and populate exception details for a JavaScript Error object. Note that the stackTrace portion of the resulting exceptionDetails will only be populated if the Runtime domain was enabled at the time when the Error was thrown.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "errorObjectId", Description: "The error object for which to resolve the exception details.", Type: "Runtime.RemoteObjectId", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Returns: []*ParamInfo{
+					{Name: "exceptionDetails", Description: "", Type: "Runtime.ExceptionDetails", Optional: true, GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{
+			{
+				Name:         "bindingCalled",
+				Description:  "Notification is issued every time when binding is called.",
+				Experimental: true,
+				Params: []*ParamInfo{
+					{Name: "name", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "payload", Description: "", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "executionContextId", Description: "Identifier of the context where the call was made.", Type: "Runtime.ExecutionContextId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*RuntimeBindingCalledEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "consoleAPICalled",
+				Description: "Issued when console API was called.",
+				Params: []*ParamInfo{
+					{Name: "type", Description: "Type of the call.", Type: "string", GoType: reflect.TypeOf((*RuntimeConsoleAPICalledType)(nil)).Elem()},
+					{Name: "args", Description: "Call arguments.", Type: "array", Items: "Runtime.RemoteObject", GoType: reflect.TypeOf((*[]*RuntimeRemoteObject)(nil)).Elem()},
+					{Name: "executionContextId", Description: "Identifier of the context where the call was made.", Type: "Runtime.ExecutionContextId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "timestamp", Description: "Call timestamp.", Type: "Runtime.Timestamp", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "stackTrace", Description: "Stack trace captured when the call was made. The async stack chain is automatically reported for the following call types: `assert`, `error`, `trace`, `warning`. For other types the async call chain can be retrieved using `Debugger.getStackTrace` and `stackTrace.parentId` field.", Type: "Runtime.StackTrace", Optional: true, GoType: reflect.TypeOf((**RuntimeStackTrace)(nil)).Elem()},
+					{Name: "context", Description: "Console context descriptor for calls on non-default console context (not console.*): 'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call on named context.", Type: "string", Optional: true, GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*RuntimeConsoleAPICalledEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "exceptionRevoked",
+				Description: "Issued when unhandled exception was revoked.",
+				Params: []*ParamInfo{
+					{Name: "reason", Description: "Reason describing why exception was revoked.", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+					{Name: "exceptionId", Description: "The id of revoked exception, as reported in `exceptionThrown`.", Type: "integer", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*RuntimeExceptionRevokedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "exceptionThrown",
+				Description: "Issued when exception was thrown and unhandled.",
+				Params: []*ParamInfo{
+					{Name: "timestamp", Description: "Timestamp of the exception.", Type: "Runtime.Timestamp", GoType: reflect.TypeOf((*float64)(nil)).Elem()},
+					{Name: "exceptionDetails", Description: "", Type: "Runtime.ExceptionDetails", GoType: reflect.TypeOf((**RuntimeExceptionDetails)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*RuntimeExceptionThrownEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "executionContextCreated",
+				Description: "Issued when new execution context is created.",
+				Params: []*ParamInfo{
+					{Name: "context", Description: "A newly created execution context.", Type: "Runtime.ExecutionContextDescription", GoType: reflect.TypeOf((**RuntimeExecutionContextDescription)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*RuntimeExecutionContextCreatedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "executionContextDestroyed",
+				Description: "Issued when execution context is destroyed.",
+				Params: []*ParamInfo{
+					{Name: "executionContextId", Description: "Id of the destroyed context", Type: "Runtime.ExecutionContextId", GoType: reflect.TypeOf((*int)(nil)).Elem()},
+					{Name: "executionContextUniqueId", Description: "Unique Id of the destroyed context", Type: "string", GoType: reflect.TypeOf((*string)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*RuntimeExecutionContextDestroyedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "executionContextsCleared",
+				Description: "Issued when all executionContexts were cleared in browser",
+				Type:        reflect.TypeOf((*RuntimeExecutionContextsClearedEvent)(nil)).Elem(),
+			},
+			{
+				Name:        "inspectRequested",
+				Description: "Issued when object should be inspected (for example, as a result of inspect() command line API call).",
+				Params: []*ParamInfo{
+					{Name: "object", Description: "", Type: "Runtime.RemoteObject", GoType: reflect.TypeOf((**RuntimeRemoteObject)(nil)).Elem()},
+					{Name: "hints", Description: "", Type: "object", GoType: reflect.TypeOf((*map[string]interface{})(nil)).Elem()},
+					{Name: "executionContextId", Description: "Identifier of the context where the call was made.", Type: "Runtime.ExecutionContextId", Optional: true, GoType: reflect.TypeOf((*int)(nil)).Elem()},
+				},
+				Type: reflect.TypeOf((*RuntimeInspectRequestedEvent)(nil)).Elem(),
+			},
+		},
+	},
+	{
+		Name:        "Schema",
+		Description: "This domain is deprecated.",
+		Deprecated:  true,
+		Commands: []*CommandInfo{
+			{
+				Name:        "getDomains",
+				Description: "Returns supported domains.",
+				Returns: []*ParamInfo{
+					{Name: "domains", Description: "List of supported domains.", Type: "array", Items: "Schema.Domain", GoType: reflect.TypeOf((*[]*SchemaDomain)(nil)).Elem()},
+				},
+			},
+		},
+		Events: []*EventInfo{},
+	},
+}
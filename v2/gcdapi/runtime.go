@@ -9,53 +9,449 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
-// Represents the value serialiazed by the WebDriver BiDi specification https://w3c.github.io/webdriver-bidi.
-type RuntimeWebDriverValue struct {
-	Type     string      `json:"type"`               //
-	Value    interface{} `json:"value,omitempty"`    //
-	ObjectId string      `json:"objectId,omitempty"` //
+// No Description.
+type RuntimeSerializationOptionsSerialization string
+
+const (
+	RuntimeSerializationOptionsSerializationDeep   RuntimeSerializationOptionsSerialization = "deep"
+	RuntimeSerializationOptionsSerializationJson   RuntimeSerializationOptionsSerialization = "json"
+	RuntimeSerializationOptionsSerializationIdOnly RuntimeSerializationOptionsSerialization = "idOnly"
+)
+
+// Valid returns true if the value is one of the RuntimeSerializationOptionsSerialization enum values.
+func (e RuntimeSerializationOptionsSerialization) Valid() bool {
+	switch e {
+	case RuntimeSerializationOptionsSerializationDeep,
+		RuntimeSerializationOptionsSerializationJson,
+		RuntimeSerializationOptionsSerializationIdOnly:
+		return true
+	}
+	return false
+}
+
+// No Description.
+type RuntimeDeepSerializedValueType string
+
+const (
+	RuntimeDeepSerializedValueTypeUndefined   RuntimeDeepSerializedValueType = "undefined"
+	RuntimeDeepSerializedValueTypeNull        RuntimeDeepSerializedValueType = "null"
+	RuntimeDeepSerializedValueTypeString      RuntimeDeepSerializedValueType = "string"
+	RuntimeDeepSerializedValueTypeNumber      RuntimeDeepSerializedValueType = "number"
+	RuntimeDeepSerializedValueTypeBoolean     RuntimeDeepSerializedValueType = "boolean"
+	RuntimeDeepSerializedValueTypeBigint      RuntimeDeepSerializedValueType = "bigint"
+	RuntimeDeepSerializedValueTypeRegexp      RuntimeDeepSerializedValueType = "regexp"
+	RuntimeDeepSerializedValueTypeDate        RuntimeDeepSerializedValueType = "date"
+	RuntimeDeepSerializedValueTypeSymbol      RuntimeDeepSerializedValueType = "symbol"
+	RuntimeDeepSerializedValueTypeArray       RuntimeDeepSerializedValueType = "array"
+	RuntimeDeepSerializedValueTypeObject      RuntimeDeepSerializedValueType = "object"
+	RuntimeDeepSerializedValueTypeFunction    RuntimeDeepSerializedValueType = "function"
+	RuntimeDeepSerializedValueTypeMap         RuntimeDeepSerializedValueType = "map"
+	RuntimeDeepSerializedValueTypeSet         RuntimeDeepSerializedValueType = "set"
+	RuntimeDeepSerializedValueTypeWeakmap     RuntimeDeepSerializedValueType = "weakmap"
+	RuntimeDeepSerializedValueTypeWeakset     RuntimeDeepSerializedValueType = "weakset"
+	RuntimeDeepSerializedValueTypeError       RuntimeDeepSerializedValueType = "error"
+	RuntimeDeepSerializedValueTypeProxy       RuntimeDeepSerializedValueType = "proxy"
+	RuntimeDeepSerializedValueTypePromise     RuntimeDeepSerializedValueType = "promise"
+	RuntimeDeepSerializedValueTypeTypedarray  RuntimeDeepSerializedValueType = "typedarray"
+	RuntimeDeepSerializedValueTypeArraybuffer RuntimeDeepSerializedValueType = "arraybuffer"
+	RuntimeDeepSerializedValueTypeNode        RuntimeDeepSerializedValueType = "node"
+	RuntimeDeepSerializedValueTypeWindow      RuntimeDeepSerializedValueType = "window"
+	RuntimeDeepSerializedValueTypeGenerator   RuntimeDeepSerializedValueType = "generator"
+)
+
+// Valid returns true if the value is one of the RuntimeDeepSerializedValueType enum values.
+func (e RuntimeDeepSerializedValueType) Valid() bool {
+	switch e {
+	case RuntimeDeepSerializedValueTypeUndefined,
+		RuntimeDeepSerializedValueTypeNull,
+		RuntimeDeepSerializedValueTypeString,
+		RuntimeDeepSerializedValueTypeNumber,
+		RuntimeDeepSerializedValueTypeBoolean,
+		RuntimeDeepSerializedValueTypeBigint,
+		RuntimeDeepSerializedValueTypeRegexp,
+		RuntimeDeepSerializedValueTypeDate,
+		RuntimeDeepSerializedValueTypeSymbol,
+		RuntimeDeepSerializedValueTypeArray,
+		RuntimeDeepSerializedValueTypeObject,
+		RuntimeDeepSerializedValueTypeFunction,
+		RuntimeDeepSerializedValueTypeMap,
+		RuntimeDeepSerializedValueTypeSet,
+		RuntimeDeepSerializedValueTypeWeakmap,
+		RuntimeDeepSerializedValueTypeWeakset,
+		RuntimeDeepSerializedValueTypeError,
+		RuntimeDeepSerializedValueTypeProxy,
+		RuntimeDeepSerializedValueTypePromise,
+		RuntimeDeepSerializedValueTypeTypedarray,
+		RuntimeDeepSerializedValueTypeArraybuffer,
+		RuntimeDeepSerializedValueTypeNode,
+		RuntimeDeepSerializedValueTypeWindow,
+		RuntimeDeepSerializedValueTypeGenerator:
+		return true
+	}
+	return false
+}
+
+// Object type.
+type RuntimeRemoteObjectType string
+
+const (
+	RuntimeRemoteObjectTypeObject    RuntimeRemoteObjectType = "object"
+	RuntimeRemoteObjectTypeFunction  RuntimeRemoteObjectType = "function"
+	RuntimeRemoteObjectTypeUndefined RuntimeRemoteObjectType = "undefined"
+	RuntimeRemoteObjectTypeString    RuntimeRemoteObjectType = "string"
+	RuntimeRemoteObjectTypeNumber    RuntimeRemoteObjectType = "number"
+	RuntimeRemoteObjectTypeBoolean   RuntimeRemoteObjectType = "boolean"
+	RuntimeRemoteObjectTypeSymbol    RuntimeRemoteObjectType = "symbol"
+	RuntimeRemoteObjectTypeBigint    RuntimeRemoteObjectType = "bigint"
+)
+
+// Valid returns true if the value is one of the RuntimeRemoteObjectType enum values.
+func (e RuntimeRemoteObjectType) Valid() bool {
+	switch e {
+	case RuntimeRemoteObjectTypeObject,
+		RuntimeRemoteObjectTypeFunction,
+		RuntimeRemoteObjectTypeUndefined,
+		RuntimeRemoteObjectTypeString,
+		RuntimeRemoteObjectTypeNumber,
+		RuntimeRemoteObjectTypeBoolean,
+		RuntimeRemoteObjectTypeSymbol,
+		RuntimeRemoteObjectTypeBigint:
+		return true
+	}
+	return false
+}
+
+// Object subtype hint. Specified for `object` type values only. NOTE: If you change anything here, make sure to also update `subtype` in `ObjectPreview` and `PropertyPreview` below.
+type RuntimeRemoteObjectSubtype string
+
+const (
+	RuntimeRemoteObjectSubtypeArray             RuntimeRemoteObjectSubtype = "array"
+	RuntimeRemoteObjectSubtypeNull              RuntimeRemoteObjectSubtype = "null"
+	RuntimeRemoteObjectSubtypeNode              RuntimeRemoteObjectSubtype = "node"
+	RuntimeRemoteObjectSubtypeRegexp            RuntimeRemoteObjectSubtype = "regexp"
+	RuntimeRemoteObjectSubtypeDate              RuntimeRemoteObjectSubtype = "date"
+	RuntimeRemoteObjectSubtypeMap               RuntimeRemoteObjectSubtype = "map"
+	RuntimeRemoteObjectSubtypeSet               RuntimeRemoteObjectSubtype = "set"
+	RuntimeRemoteObjectSubtypeWeakmap           RuntimeRemoteObjectSubtype = "weakmap"
+	RuntimeRemoteObjectSubtypeWeakset           RuntimeRemoteObjectSubtype = "weakset"
+	RuntimeRemoteObjectSubtypeIterator          RuntimeRemoteObjectSubtype = "iterator"
+	RuntimeRemoteObjectSubtypeGenerator         RuntimeRemoteObjectSubtype = "generator"
+	RuntimeRemoteObjectSubtypeError             RuntimeRemoteObjectSubtype = "error"
+	RuntimeRemoteObjectSubtypeProxy             RuntimeRemoteObjectSubtype = "proxy"
+	RuntimeRemoteObjectSubtypePromise           RuntimeRemoteObjectSubtype = "promise"
+	RuntimeRemoteObjectSubtypeTypedarray        RuntimeRemoteObjectSubtype = "typedarray"
+	RuntimeRemoteObjectSubtypeArraybuffer       RuntimeRemoteObjectSubtype = "arraybuffer"
+	RuntimeRemoteObjectSubtypeDataview          RuntimeRemoteObjectSubtype = "dataview"
+	RuntimeRemoteObjectSubtypeWebassemblymemory RuntimeRemoteObjectSubtype = "webassemblymemory"
+	RuntimeRemoteObjectSubtypeWasmvalue         RuntimeRemoteObjectSubtype = "wasmvalue"
+)
+
+// Valid returns true if the value is one of the RuntimeRemoteObjectSubtype enum values.
+func (e RuntimeRemoteObjectSubtype) Valid() bool {
+	switch e {
+	case RuntimeRemoteObjectSubtypeArray,
+		RuntimeRemoteObjectSubtypeNull,
+		RuntimeRemoteObjectSubtypeNode,
+		RuntimeRemoteObjectSubtypeRegexp,
+		RuntimeRemoteObjectSubtypeDate,
+		RuntimeRemoteObjectSubtypeMap,
+		RuntimeRemoteObjectSubtypeSet,
+		RuntimeRemoteObjectSubtypeWeakmap,
+		RuntimeRemoteObjectSubtypeWeakset,
+		RuntimeRemoteObjectSubtypeIterator,
+		RuntimeRemoteObjectSubtypeGenerator,
+		RuntimeRemoteObjectSubtypeError,
+		RuntimeRemoteObjectSubtypeProxy,
+		RuntimeRemoteObjectSubtypePromise,
+		RuntimeRemoteObjectSubtypeTypedarray,
+		RuntimeRemoteObjectSubtypeArraybuffer,
+		RuntimeRemoteObjectSubtypeDataview,
+		RuntimeRemoteObjectSubtypeWebassemblymemory,
+		RuntimeRemoteObjectSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Object type.
+type RuntimeObjectPreviewType string
+
+const (
+	RuntimeObjectPreviewTypeObject    RuntimeObjectPreviewType = "object"
+	RuntimeObjectPreviewTypeFunction  RuntimeObjectPreviewType = "function"
+	RuntimeObjectPreviewTypeUndefined RuntimeObjectPreviewType = "undefined"
+	RuntimeObjectPreviewTypeString    RuntimeObjectPreviewType = "string"
+	RuntimeObjectPreviewTypeNumber    RuntimeObjectPreviewType = "number"
+	RuntimeObjectPreviewTypeBoolean   RuntimeObjectPreviewType = "boolean"
+	RuntimeObjectPreviewTypeSymbol    RuntimeObjectPreviewType = "symbol"
+	RuntimeObjectPreviewTypeBigint    RuntimeObjectPreviewType = "bigint"
+)
+
+// Valid returns true if the value is one of the RuntimeObjectPreviewType enum values.
+func (e RuntimeObjectPreviewType) Valid() bool {
+	switch e {
+	case RuntimeObjectPreviewTypeObject,
+		RuntimeObjectPreviewTypeFunction,
+		RuntimeObjectPreviewTypeUndefined,
+		RuntimeObjectPreviewTypeString,
+		RuntimeObjectPreviewTypeNumber,
+		RuntimeObjectPreviewTypeBoolean,
+		RuntimeObjectPreviewTypeSymbol,
+		RuntimeObjectPreviewTypeBigint:
+		return true
+	}
+	return false
+}
+
+// Object subtype hint. Specified for `object` type values only.
+type RuntimeObjectPreviewSubtype string
+
+const (
+	RuntimeObjectPreviewSubtypeArray             RuntimeObjectPreviewSubtype = "array"
+	RuntimeObjectPreviewSubtypeNull              RuntimeObjectPreviewSubtype = "null"
+	RuntimeObjectPreviewSubtypeNode              RuntimeObjectPreviewSubtype = "node"
+	RuntimeObjectPreviewSubtypeRegexp            RuntimeObjectPreviewSubtype = "regexp"
+	RuntimeObjectPreviewSubtypeDate              RuntimeObjectPreviewSubtype = "date"
+	RuntimeObjectPreviewSubtypeMap               RuntimeObjectPreviewSubtype = "map"
+	RuntimeObjectPreviewSubtypeSet               RuntimeObjectPreviewSubtype = "set"
+	RuntimeObjectPreviewSubtypeWeakmap           RuntimeObjectPreviewSubtype = "weakmap"
+	RuntimeObjectPreviewSubtypeWeakset           RuntimeObjectPreviewSubtype = "weakset"
+	RuntimeObjectPreviewSubtypeIterator          RuntimeObjectPreviewSubtype = "iterator"
+	RuntimeObjectPreviewSubtypeGenerator         RuntimeObjectPreviewSubtype = "generator"
+	RuntimeObjectPreviewSubtypeError             RuntimeObjectPreviewSubtype = "error"
+	RuntimeObjectPreviewSubtypeProxy             RuntimeObjectPreviewSubtype = "proxy"
+	RuntimeObjectPreviewSubtypePromise           RuntimeObjectPreviewSubtype = "promise"
+	RuntimeObjectPreviewSubtypeTypedarray        RuntimeObjectPreviewSubtype = "typedarray"
+	RuntimeObjectPreviewSubtypeArraybuffer       RuntimeObjectPreviewSubtype = "arraybuffer"
+	RuntimeObjectPreviewSubtypeDataview          RuntimeObjectPreviewSubtype = "dataview"
+	RuntimeObjectPreviewSubtypeWebassemblymemory RuntimeObjectPreviewSubtype = "webassemblymemory"
+	RuntimeObjectPreviewSubtypeWasmvalue         RuntimeObjectPreviewSubtype = "wasmvalue"
+)
+
+// Valid returns true if the value is one of the RuntimeObjectPreviewSubtype enum values.
+func (e RuntimeObjectPreviewSubtype) Valid() bool {
+	switch e {
+	case RuntimeObjectPreviewSubtypeArray,
+		RuntimeObjectPreviewSubtypeNull,
+		RuntimeObjectPreviewSubtypeNode,
+		RuntimeObjectPreviewSubtypeRegexp,
+		RuntimeObjectPreviewSubtypeDate,
+		RuntimeObjectPreviewSubtypeMap,
+		RuntimeObjectPreviewSubtypeSet,
+		RuntimeObjectPreviewSubtypeWeakmap,
+		RuntimeObjectPreviewSubtypeWeakset,
+		RuntimeObjectPreviewSubtypeIterator,
+		RuntimeObjectPreviewSubtypeGenerator,
+		RuntimeObjectPreviewSubtypeError,
+		RuntimeObjectPreviewSubtypeProxy,
+		RuntimeObjectPreviewSubtypePromise,
+		RuntimeObjectPreviewSubtypeTypedarray,
+		RuntimeObjectPreviewSubtypeArraybuffer,
+		RuntimeObjectPreviewSubtypeDataview,
+		RuntimeObjectPreviewSubtypeWebassemblymemory,
+		RuntimeObjectPreviewSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Object type. Accessor means that the property itself is an accessor property.
+type RuntimePropertyPreviewType string
+
+const (
+	RuntimePropertyPreviewTypeObject    RuntimePropertyPreviewType = "object"
+	RuntimePropertyPreviewTypeFunction  RuntimePropertyPreviewType = "function"
+	RuntimePropertyPreviewTypeUndefined RuntimePropertyPreviewType = "undefined"
+	RuntimePropertyPreviewTypeString    RuntimePropertyPreviewType = "string"
+	RuntimePropertyPreviewTypeNumber    RuntimePropertyPreviewType = "number"
+	RuntimePropertyPreviewTypeBoolean   RuntimePropertyPreviewType = "boolean"
+	RuntimePropertyPreviewTypeSymbol    RuntimePropertyPreviewType = "symbol"
+	RuntimePropertyPreviewTypeAccessor  RuntimePropertyPreviewType = "accessor"
+	RuntimePropertyPreviewTypeBigint    RuntimePropertyPreviewType = "bigint"
+)
+
+// Valid returns true if the value is one of the RuntimePropertyPreviewType enum values.
+func (e RuntimePropertyPreviewType) Valid() bool {
+	switch e {
+	case RuntimePropertyPreviewTypeObject,
+		RuntimePropertyPreviewTypeFunction,
+		RuntimePropertyPreviewTypeUndefined,
+		RuntimePropertyPreviewTypeString,
+		RuntimePropertyPreviewTypeNumber,
+		RuntimePropertyPreviewTypeBoolean,
+		RuntimePropertyPreviewTypeSymbol,
+		RuntimePropertyPreviewTypeAccessor,
+		RuntimePropertyPreviewTypeBigint:
+		return true
+	}
+	return false
+}
+
+// Object subtype hint. Specified for `object` type values only.
+type RuntimePropertyPreviewSubtype string
+
+const (
+	RuntimePropertyPreviewSubtypeArray             RuntimePropertyPreviewSubtype = "array"
+	RuntimePropertyPreviewSubtypeNull              RuntimePropertyPreviewSubtype = "null"
+	RuntimePropertyPreviewSubtypeNode              RuntimePropertyPreviewSubtype = "node"
+	RuntimePropertyPreviewSubtypeRegexp            RuntimePropertyPreviewSubtype = "regexp"
+	RuntimePropertyPreviewSubtypeDate              RuntimePropertyPreviewSubtype = "date"
+	RuntimePropertyPreviewSubtypeMap               RuntimePropertyPreviewSubtype = "map"
+	RuntimePropertyPreviewSubtypeSet               RuntimePropertyPreviewSubtype = "set"
+	RuntimePropertyPreviewSubtypeWeakmap           RuntimePropertyPreviewSubtype = "weakmap"
+	RuntimePropertyPreviewSubtypeWeakset           RuntimePropertyPreviewSubtype = "weakset"
+	RuntimePropertyPreviewSubtypeIterator          RuntimePropertyPreviewSubtype = "iterator"
+	RuntimePropertyPreviewSubtypeGenerator         RuntimePropertyPreviewSubtype = "generator"
+	RuntimePropertyPreviewSubtypeError             RuntimePropertyPreviewSubtype = "error"
+	RuntimePropertyPreviewSubtypeProxy             RuntimePropertyPreviewSubtype = "proxy"
+	RuntimePropertyPreviewSubtypePromise           RuntimePropertyPreviewSubtype = "promise"
+	RuntimePropertyPreviewSubtypeTypedarray        RuntimePropertyPreviewSubtype = "typedarray"
+	RuntimePropertyPreviewSubtypeArraybuffer       RuntimePropertyPreviewSubtype = "arraybuffer"
+	RuntimePropertyPreviewSubtypeDataview          RuntimePropertyPreviewSubtype = "dataview"
+	RuntimePropertyPreviewSubtypeWebassemblymemory RuntimePropertyPreviewSubtype = "webassemblymemory"
+	RuntimePropertyPreviewSubtypeWasmvalue         RuntimePropertyPreviewSubtype = "wasmvalue"
+)
+
+// Valid returns true if the value is one of the RuntimePropertyPreviewSubtype enum values.
+func (e RuntimePropertyPreviewSubtype) Valid() bool {
+	switch e {
+	case RuntimePropertyPreviewSubtypeArray,
+		RuntimePropertyPreviewSubtypeNull,
+		RuntimePropertyPreviewSubtypeNode,
+		RuntimePropertyPreviewSubtypeRegexp,
+		RuntimePropertyPreviewSubtypeDate,
+		RuntimePropertyPreviewSubtypeMap,
+		RuntimePropertyPreviewSubtypeSet,
+		RuntimePropertyPreviewSubtypeWeakmap,
+		RuntimePropertyPreviewSubtypeWeakset,
+		RuntimePropertyPreviewSubtypeIterator,
+		RuntimePropertyPreviewSubtypeGenerator,
+		RuntimePropertyPreviewSubtypeError,
+		RuntimePropertyPreviewSubtypeProxy,
+		RuntimePropertyPreviewSubtypePromise,
+		RuntimePropertyPreviewSubtypeTypedarray,
+		RuntimePropertyPreviewSubtypeArraybuffer,
+		RuntimePropertyPreviewSubtypeDataview,
+		RuntimePropertyPreviewSubtypeWebassemblymemory,
+		RuntimePropertyPreviewSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Type of the call.
+type RuntimeConsoleAPICalledType string
+
+const (
+	RuntimeConsoleAPICalledTypeLog                 RuntimeConsoleAPICalledType = "log"
+	RuntimeConsoleAPICalledTypeDebug               RuntimeConsoleAPICalledType = "debug"
+	RuntimeConsoleAPICalledTypeInfo                RuntimeConsoleAPICalledType = "info"
+	RuntimeConsoleAPICalledTypeError               RuntimeConsoleAPICalledType = "error"
+	RuntimeConsoleAPICalledTypeWarning             RuntimeConsoleAPICalledType = "warning"
+	RuntimeConsoleAPICalledTypeDir                 RuntimeConsoleAPICalledType = "dir"
+	RuntimeConsoleAPICalledTypeDirxml              RuntimeConsoleAPICalledType = "dirxml"
+	RuntimeConsoleAPICalledTypeTable               RuntimeConsoleAPICalledType = "table"
+	RuntimeConsoleAPICalledTypeTrace               RuntimeConsoleAPICalledType = "trace"
+	RuntimeConsoleAPICalledTypeClear               RuntimeConsoleAPICalledType = "clear"
+	RuntimeConsoleAPICalledTypeStartGroup          RuntimeConsoleAPICalledType = "startGroup"
+	RuntimeConsoleAPICalledTypeStartGroupCollapsed RuntimeConsoleAPICalledType = "startGroupCollapsed"
+	RuntimeConsoleAPICalledTypeEndGroup            RuntimeConsoleAPICalledType = "endGroup"
+	RuntimeConsoleAPICalledTypeAssert              RuntimeConsoleAPICalledType = "assert"
+	RuntimeConsoleAPICalledTypeProfile             RuntimeConsoleAPICalledType = "profile"
+	RuntimeConsoleAPICalledTypeProfileEnd          RuntimeConsoleAPICalledType = "profileEnd"
+	RuntimeConsoleAPICalledTypeCount               RuntimeConsoleAPICalledType = "count"
+	RuntimeConsoleAPICalledTypeTimeEnd             RuntimeConsoleAPICalledType = "timeEnd"
+)
+
+// Valid returns true if the value is one of the RuntimeConsoleAPICalledType enum values.
+func (e RuntimeConsoleAPICalledType) Valid() bool {
+	switch e {
+	case RuntimeConsoleAPICalledTypeLog,
+		RuntimeConsoleAPICalledTypeDebug,
+		RuntimeConsoleAPICalledTypeInfo,
+		RuntimeConsoleAPICalledTypeError,
+		RuntimeConsoleAPICalledTypeWarning,
+		RuntimeConsoleAPICalledTypeDir,
+		RuntimeConsoleAPICalledTypeDirxml,
+		RuntimeConsoleAPICalledTypeTable,
+		RuntimeConsoleAPICalledTypeTrace,
+		RuntimeConsoleAPICalledTypeClear,
+		RuntimeConsoleAPICalledTypeStartGroup,
+		RuntimeConsoleAPICalledTypeStartGroupCollapsed,
+		RuntimeConsoleAPICalledTypeEndGroup,
+		RuntimeConsoleAPICalledTypeAssert,
+		RuntimeConsoleAPICalledTypeProfile,
+		RuntimeConsoleAPICalledTypeProfileEnd,
+		RuntimeConsoleAPICalledTypeCount,
+		RuntimeConsoleAPICalledTypeTimeEnd:
+		return true
+	}
+	return false
+}
+
+// Represents options for serialization. Overrides `generatePreview` and `returnByValue`.
+type RuntimeSerializationOptions struct {
+	Serialization        RuntimeSerializationOptionsSerialization `json:"serialization"`                  //
+	MaxDepth             int                                      `json:"maxDepth,omitempty"`             // Deep serialization depth. Default is full depth. Respected only in `deep` serialization mode.
+	AdditionalParameters map[string]interface{}                   `json:"additionalParameters,omitempty"` // Embedder-specific parameters. For example if connected to V8 in Chrome these control DOM serialization via `maxNodeDepth: integer` and `includeShadowTree: "none" | "open" | "all"`. Values can be only of type string or integer.
+}
+
+// Represents deep serialized value.
+type RuntimeDeepSerializedValue struct {
+	Type                     RuntimeDeepSerializedValueType `json:"type"`                               //
+	Value                    interface{}                    `json:"value,omitempty"`                    //
+	ObjectId                 string                         `json:"objectId,omitempty"`                 //
+	WeakLocalObjectReference int                            `json:"weakLocalObjectReference,omitempty"` // Set if value reference met more then once during serialization. In such case, value is provided only to one of the serialized values. Unique per value in the scope of one CDP call.
 }
 
 // Mirror object referencing original JavaScript object.
 type RuntimeRemoteObject struct {
-	Type                string                 `json:"type"`                          // Object type.
-	Subtype             string                 `json:"subtype,omitempty"`             // Object subtype hint. Specified for `object` type values only. NOTE: If you change anything here, make sure to also update `subtype` in `ObjectPreview` and `PropertyPreview` below.
-	ClassName           string                 `json:"className,omitempty"`           // Object class (constructor) name. Specified for `object` type values only.
-	Value               interface{}            `json:"value,omitempty"`               // Remote object value in case of primitive values or JSON values (if it was requested).
-	UnserializableValue string                 `json:"unserializableValue,omitempty"` // Primitive value which can not be JSON-stringified does not have `value`, but gets this property.
-	Description         string                 `json:"description,omitempty"`         // String representation of the object.
-	WebDriverValue      *RuntimeWebDriverValue `json:"webDriverValue,omitempty"`      // WebDriver BiDi representation of the value.
-	ObjectId            string                 `json:"objectId,omitempty"`            // Unique object identifier (for non-primitive values).
-	Preview             *RuntimeObjectPreview  `json:"preview,omitempty"`             // Preview containing abbreviated property values. Specified for `object` type values only.
-	CustomPreview       *RuntimeCustomPreview  `json:"customPreview,omitempty"`       //
+	Type                RuntimeRemoteObjectType     `json:"type"`                          // Object type.
+	Subtype             RuntimeRemoteObjectSubtype  `json:"subtype,omitempty"`             // Object subtype hint. Specified for `object` type values only. NOTE: If you change anything here, make sure to also update `subtype` in `ObjectPreview` and `PropertyPreview` below.
+	ClassName           string                      `json:"className,omitempty"`           // Object class (constructor) name. Specified for `object` type values only.
+	Value               interface{}                 `json:"value,omitempty"`               // Remote object value in case of primitive values or JSON values (if it was requested).
+	UnserializableValue string                      `json:"unserializableValue,omitempty"` // Primitive value which can not be JSON-stringified does not have `value`, but gets this property.
+	Description         string                      `json:"description,omitempty"`         // String representation of the object.
+	DeepSerializedValue *RuntimeDeepSerializedValue `json:"deepSerializedValue,omitempty"` // Deep serialized value.
+	ObjectId            string                      `json:"objectId,omitempty"`            // Unique object identifier (for non-primitive values).
+	Preview             *RuntimeObjectPreview       `json:"preview,omitempty"`             // Preview containing abbreviated property values. Specified for `object` type values only.
+	CustomPreview       *RuntimeCustomPreview       `json:"customPreview,omitempty"`       //
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type RuntimeCustomPreview struct {
 	Header       string `json:"header"`                 // The JSON-stringified result of formatter.header(object, config) call. It contains json ML array that represents RemoteObject.
 	BodyGetterId string `json:"bodyGetterId,omitempty"` // If formatter returns true as a result of formatter.hasBody call then bodyGetterId will contain RemoteObjectId for the function that returns result of formatter.body(object, config) call. The result value is json ML array.
 }
 
 // Object containing abbreviated remote object value.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type RuntimeObjectPreview struct {
-	Type        string                    `json:"type"`                  // Object type.
-	Subtype     string                    `json:"subtype,omitempty"`     // Object subtype hint. Specified for `object` type values only.
-	Description string                    `json:"description,omitempty"` // String representation of the object.
-	Overflow    bool                      `json:"overflow"`              // True iff some of the properties or entries of the original object did not fit.
-	Properties  []*RuntimePropertyPreview `json:"properties"`            // List of the properties.
-	Entries     []*RuntimeEntryPreview    `json:"entries,omitempty"`     // List of the entries. Specified for `map` and `set` subtype values only.
+	Type        RuntimeObjectPreviewType    `json:"type"`                  // Object type.
+	Subtype     RuntimeObjectPreviewSubtype `json:"subtype,omitempty"`     // Object subtype hint. Specified for `object` type values only.
+	Description string                      `json:"description,omitempty"` // String representation of the object.
+	Overflow    bool                        `json:"overflow"`              // True iff some of the properties or entries of the original object did not fit.
+	Properties  []*RuntimePropertyPreview   `json:"properties"`            // List of the properties.
+	Entries     []*RuntimeEntryPreview      `json:"entries,omitempty"`     // List of the entries. Specified for `map` and `set` subtype values only.
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type RuntimePropertyPreview struct {
-	Name         string                `json:"name"`                   // Property name.
-	Type         string                `json:"type"`                   // Object type. Accessor means that the property itself is an accessor property.
-	Value        string                `json:"value,omitempty"`        // User-friendly property value string.
-	ValuePreview *RuntimeObjectPreview `json:"valuePreview,omitempty"` // Nested value preview.
-	Subtype      string                `json:"subtype,omitempty"`      // Object subtype hint. Specified for `object` type values only.
+	Name         string                        `json:"name"`                   // Property name.
+	Type         RuntimePropertyPreviewType    `json:"type"`                   // Object type. Accessor means that the property itself is an accessor property.
+	Value        string                        `json:"value,omitempty"`        // User-friendly property value string.
+	ValuePreview *RuntimeObjectPreview         `json:"valuePreview,omitempty"` // Nested value preview.
+	Subtype      RuntimePropertyPreviewSubtype `json:"subtype,omitempty"`      // Object subtype hint. Specified for `object` type values only.
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type RuntimeEntryPreview struct {
 	Key   *RuntimeObjectPreview `json:"key,omitempty"` // Preview of the key. Specified for map-like collection entries.
 	Value *RuntimeObjectPreview `json:"value"`         // Preview of the value.
@@ -82,6 +478,8 @@ type RuntimeInternalPropertyDescriptor struct {
 }
 
 // Object private field descriptor.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type RuntimePrivatePropertyDescriptor struct {
 	Name  string               `json:"name"`            // Private property name.
 	Value *RuntimeRemoteObject `json:"value,omitempty"` // The value associated with the private property.
@@ -137,12 +535,40 @@ type RuntimeStackTrace struct {
 }
 
 // If `debuggerId` is set stack trace comes from another debugger and can be resolved there. This allows to track cross-debugger calls. See `Runtime.StackTrace` and `Debugger.paused` for usages.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type RuntimeStackTraceId struct {
 	Id         string `json:"id"`                   //
 	DebuggerId string `json:"debuggerId,omitempty"` //
 }
 
+// Event method names for the Runtime domain
+const (
+	EventRuntimeBindingCalled             = "Runtime.bindingCalled"
+	EventRuntimeConsoleAPICalled          = "Runtime.consoleAPICalled"
+	EventRuntimeExceptionRevoked          = "Runtime.exceptionRevoked"
+	EventRuntimeExceptionThrown           = "Runtime.exceptionThrown"
+	EventRuntimeExecutionContextCreated   = "Runtime.executionContextCreated"
+	EventRuntimeExecutionContextDestroyed = "Runtime.executionContextDestroyed"
+	EventRuntimeExecutionContextsCleared  = "Runtime.executionContextsCleared"
+	EventRuntimeInspectRequested          = "Runtime.inspectRequested"
+)
+
+// RuntimeEvents lists all event method names for the Runtime domain
+var RuntimeEvents = []string{
+	EventRuntimeBindingCalled,
+	EventRuntimeConsoleAPICalled,
+	EventRuntimeExceptionRevoked,
+	EventRuntimeExceptionThrown,
+	EventRuntimeExecutionContextCreated,
+	EventRuntimeExecutionContextDestroyed,
+	EventRuntimeExecutionContextsCleared,
+	EventRuntimeInspectRequested,
+}
+
 // Notification is issued every time when binding is called.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type RuntimeBindingCalledEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -156,12 +582,12 @@ type RuntimeBindingCalledEvent struct {
 type RuntimeConsoleAPICalledEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		Type               string                 `json:"type"`                 // Type of the call.
-		Args               []*RuntimeRemoteObject `json:"args"`                 // Call arguments.
-		ExecutionContextId int                    `json:"executionContextId"`   // Identifier of the context where the call was made.
-		Timestamp          float64                `json:"timestamp"`            // Call timestamp.
-		StackTrace         *RuntimeStackTrace     `json:"stackTrace,omitempty"` // Stack trace captured when the call was made. The async stack chain is automatically reported for the following call types: `assert`, `error`, `trace`, `warning`. For other types the async call chain can be retrieved using `Debugger.getStackTrace` and `stackTrace.parentId` field.
-		Context            string                 `json:"context,omitempty"`    // Console context descriptor for calls on non-default console context (not console.*): 'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call on named context.
+		Type               RuntimeConsoleAPICalledType `json:"type"`                 // Type of the call.
+		Args               []*RuntimeRemoteObject      `json:"args"`                 // Call arguments.
+		ExecutionContextId int                         `json:"executionContextId"`   // Identifier of the context where the call was made.
+		Timestamp          float64                     `json:"timestamp"`            // Call timestamp.
+		StackTrace         *RuntimeStackTrace          `json:"stackTrace,omitempty"` // Stack trace captured when the call was made. The async stack chain is automatically reported for the following call types: `assert`, `error`, `trace`, `warning`. For other types the async call chain can be retrieved using `Debugger.getStackTrace` and `stackTrace.parentId` field.
+		Context            string                      `json:"context,omitempty"`    // Console context descriptor for calls on non-default console context (not console.*): 'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call on named context.
 	} `json:"Params,omitempty"`
 }
 
@@ -195,11 +621,18 @@ type RuntimeExecutionContextCreatedEvent struct {
 type RuntimeExecutionContextDestroyedEvent struct {
 	Method string `json:"method"`
 	Params struct {
+
+		// Deprecated: this field is deprecated in the protocol.
 		ExecutionContextId       int    `json:"executionContextId"`       // Id of the destroyed context
 		ExecutionContextUniqueId string `json:"executionContextUniqueId"` // Unique Id of the destroyed context
 	} `json:"Params,omitempty"`
 }
 
+// Issued when all executionContexts were cleared in browser
+type RuntimeExecutionContextsClearedEvent struct {
+	Method string `json:"method"`
+}
+
 // Issued when object should be inspected (for example, as a result of inspect() command line API call).
 type RuntimeInspectRequestedEvent struct {
 	Method string `json:"method"`
@@ -219,6 +652,112 @@ func NewRuntime(target gcdmessage.ChromeTargeter) *Runtime {
 	return c
 }
 
+// OnBindingCalled subscribes to Runtime.bindingCalled events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Runtime) OnBindingCalled(callback func(*RuntimeBindingCalledEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventRuntimeBindingCalled, func(payload []byte) error {
+		event := &RuntimeBindingCalledEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnConsoleAPICalled subscribes to Runtime.consoleAPICalled events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Runtime) OnConsoleAPICalled(callback func(*RuntimeConsoleAPICalledEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventRuntimeConsoleAPICalled, func(payload []byte) error {
+		event := &RuntimeConsoleAPICalledEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnExceptionRevoked subscribes to Runtime.exceptionRevoked events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Runtime) OnExceptionRevoked(callback func(*RuntimeExceptionRevokedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventRuntimeExceptionRevoked, func(payload []byte) error {
+		event := &RuntimeExceptionRevokedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnExceptionThrown subscribes to Runtime.exceptionThrown events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Runtime) OnExceptionThrown(callback func(*RuntimeExceptionThrownEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventRuntimeExceptionThrown, func(payload []byte) error {
+		event := &RuntimeExceptionThrownEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnExecutionContextCreated subscribes to Runtime.executionContextCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Runtime) OnExecutionContextCreated(callback func(*RuntimeExecutionContextCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventRuntimeExecutionContextCreated, func(payload []byte) error {
+		event := &RuntimeExecutionContextCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnExecutionContextDestroyed subscribes to Runtime.executionContextDestroyed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Runtime) OnExecutionContextDestroyed(callback func(*RuntimeExecutionContextDestroyedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventRuntimeExecutionContextDestroyed, func(payload []byte) error {
+		event := &RuntimeExecutionContextDestroyedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnExecutionContextsCleared subscribes to Runtime.executionContextsCleared events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Runtime) OnExecutionContextsCleared(callback func(*RuntimeExecutionContextsClearedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventRuntimeExecutionContextsCleared, func(payload []byte) error {
+		event := &RuntimeExecutionContextsClearedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnInspectRequested subscribes to Runtime.inspectRequested events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Runtime) OnInspectRequested(callback func(*RuntimeInspectRequestedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventRuntimeInspectRequested, func(payload []byte) error {
+		event := &RuntimeInspectRequestedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 type RuntimeAwaitPromiseParams struct {
 	// Identifier of the promise.
 	PromiseObjectId string `json:"promiseObjectId"`
@@ -281,9 +820,11 @@ type RuntimeCallFunctionOnParams struct {
 	Arguments []*RuntimeCallArgument `json:"arguments,omitempty"`
 	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
 	Silent bool `json:"silent,omitempty"`
-	// Whether the result is expected to be a JSON object which should be sent by value.
+	// Whether the result is expected to be a JSON object which should be sent by value. Can be overriden by `serializationOptions`.
 	ReturnByValue bool `json:"returnByValue,omitempty"`
 	// Whether preview should be generated for the result.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	GeneratePreview bool `json:"generatePreview,omitempty"`
 	// Whether execution should be treated as initiated by user in the UI.
 	UserGesture bool `json:"userGesture,omitempty"`
@@ -294,11 +835,17 @@ type RuntimeCallFunctionOnParams struct {
 	// Symbolic group name that can be used to release multiple objects. If objectGroup is not specified and objectId is, objectGroup will be inherited from object.
 	ObjectGroup string `json:"objectGroup,omitempty"`
 	// Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
 	// An alternative way to specify the execution context to call function on. Compared to contextId that may be reused across processes, this is guaranteed to be system-unique, so it can be used to prevent accidental function call in context different than intended (e.g. as a result of navigation across process boundaries). This is mutually exclusive with `executionContextId`.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	UniqueContextId string `json:"uniqueContextId,omitempty"`
-	// Whether the result should contain `webDriverValue`, serialized according to https://w3c.github.io/webdriver-bidi. This is mutually exclusive with `returnByValue`, but resulting `objectId` is still provided.
-	GenerateWebDriverValue bool `json:"generateWebDriverValue,omitempty"`
+	// Specifies the result serialization. If provided, overrides `generatePreview` and `returnByValue`.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	SerializationOptions *RuntimeSerializationOptions `json:"serializationOptions,omitempty"`
 }
 
 // CallFunctionOnWithParams - Calls function with given declaration on the given object. Object group of the result is inherited from the target object.
@@ -337,7 +884,7 @@ func (c *Runtime) CallFunctionOnWithParams(ctx context.Context, v *RuntimeCallFu
 // objectId - Identifier of the object to call function on. Either objectId or executionContextId should be specified.
 // arguments - Call arguments. All call arguments must belong to the same JavaScript world as the target object.
 // silent - In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides `setPauseOnException` state.
-// returnByValue - Whether the result is expected to be a JSON object which should be sent by value.
+// returnByValue - Whether the result is expected to be a JSON object which should be sent by value. Can be overriden by `serializationOptions`.
 // generatePreview - Whether preview should be generated for the result.
 // userGesture - Whether execution should be treated as initiated by user in the UI.
 // awaitPromise - Whether execution should `await` for resulting value and return once awaited promise is resolved.
@@ -345,9 +892,9 @@ func (c *Runtime) CallFunctionOnWithParams(ctx context.Context, v *RuntimeCallFu
 // objectGroup - Symbolic group name that can be used to release multiple objects. If objectGroup is not specified and objectId is, objectGroup will be inherited from object.
 // throwOnSideEffect - Whether to throw an exception if side effect cannot be ruled out during evaluation.
 // uniqueContextId - An alternative way to specify the execution context to call function on. Compared to contextId that may be reused across processes, this is guaranteed to be system-unique, so it can be used to prevent accidental function call in context different than intended (e.g. as a result of navigation across process boundaries). This is mutually exclusive with `executionContextId`.
-// generateWebDriverValue - Whether the result should contain `webDriverValue`, serialized according to https://w3c.github.io/webdriver-bidi. This is mutually exclusive with `returnByValue`, but resulting `objectId` is still provided.
+// serializationOptions - Specifies the result serialization. If provided, overrides `generatePreview` and `returnByValue`.
 // Returns -  result - Call result. exceptionDetails - Exception details.
-func (c *Runtime) CallFunctionOn(ctx context.Context, functionDeclaration string, objectId string, arguments []*RuntimeCallArgument, silent bool, returnByValue bool, generatePreview bool, userGesture bool, awaitPromise bool, executionContextId int, objectGroup string, throwOnSideEffect bool, uniqueContextId string, generateWebDriverValue bool) (*RuntimeRemoteObject, *RuntimeExceptionDetails, error) {
+func (c *Runtime) CallFunctionOn(ctx context.Context, functionDeclaration string, objectId string, arguments []*RuntimeCallArgument, silent bool, returnByValue bool, generatePreview bool, userGesture bool, awaitPromise bool, executionContextId int, objectGroup string, throwOnSideEffect bool, uniqueContextId string, serializationOptions *RuntimeSerializationOptions) (*RuntimeRemoteObject, *RuntimeExceptionDetails, error) {
 	var v RuntimeCallFunctionOnParams
 	v.FunctionDeclaration = functionDeclaration
 	v.ObjectId = objectId
@@ -361,7 +908,7 @@ func (c *Runtime) CallFunctionOn(ctx context.Context, functionDeclaration string
 	v.ObjectGroup = objectGroup
 	v.ThrowOnSideEffect = throwOnSideEffect
 	v.UniqueContextId = uniqueContextId
-	v.GenerateWebDriverValue = generateWebDriverValue
+	v.SerializationOptions = serializationOptions
 	return c.CallFunctionOnWithParams(ctx, &v)
 }
 
@@ -451,25 +998,41 @@ type RuntimeEvaluateParams struct {
 	// Whether the result is expected to be a JSON object that should be sent by value.
 	ReturnByValue bool `json:"returnByValue,omitempty"`
 	// Whether preview should be generated for the result.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	GeneratePreview bool `json:"generatePreview,omitempty"`
 	// Whether execution should be treated as initiated by user in the UI.
 	UserGesture bool `json:"userGesture,omitempty"`
 	// Whether execution should `await` for resulting value and return once awaited promise is resolved.
 	AwaitPromise bool `json:"awaitPromise,omitempty"`
 	// Whether to throw an exception if side effect cannot be ruled out during evaluation. This implies `disableBreaks` below.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
 	// Terminate execution after timing out (number of milliseconds).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Timeout float64 `json:"timeout,omitempty"`
 	// Disable breakpoints during execution.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	DisableBreaks bool `json:"disableBreaks,omitempty"`
 	// Setting this flag to true enables `let` re-declaration and top-level `await`. Note that `let` variables can only be re-declared if they originate from `replMode` themselves.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ReplMode bool `json:"replMode,omitempty"`
 	// The Content Security Policy (CSP) for the target might block 'unsafe-eval' which includes eval(), Function(), setTimeout() and setInterval() when called with non-callable arguments. This flag bypasses CSP for this evaluation and allows unsafe-eval. Defaults to true.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	AllowUnsafeEvalBlockedByCSP bool `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
 	// An alternative way to specify the execution context to evaluate in. Compared to contextId that may be reused across processes, this is guaranteed to be system-unique, so it can be used to prevent accidental evaluation of the expression in context different than intended (e.g. as a result of navigation across process boundaries). This is mutually exclusive with `contextId`.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	UniqueContextId string `json:"uniqueContextId,omitempty"`
-	// Whether the result should be serialized according to https://w3c.github.io/webdriver-bidi.
-	GenerateWebDriverValue bool `json:"generateWebDriverValue,omitempty"`
+	// Specifies the result serialization. If provided, overrides `generatePreview` and `returnByValue`.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	SerializationOptions *RuntimeSerializationOptions `json:"serializationOptions,omitempty"`
 }
 
 // EvaluateWithParams - Evaluates expression on global object.
@@ -519,9 +1082,9 @@ func (c *Runtime) EvaluateWithParams(ctx context.Context, v *RuntimeEvaluatePara
 // replMode - Setting this flag to true enables `let` re-declaration and top-level `await`. Note that `let` variables can only be re-declared if they originate from `replMode` themselves.
 // allowUnsafeEvalBlockedByCSP - The Content Security Policy (CSP) for the target might block 'unsafe-eval' which includes eval(), Function(), setTimeout() and setInterval() when called with non-callable arguments. This flag bypasses CSP for this evaluation and allows unsafe-eval. Defaults to true.
 // uniqueContextId - An alternative way to specify the execution context to evaluate in. Compared to contextId that may be reused across processes, this is guaranteed to be system-unique, so it can be used to prevent accidental evaluation of the expression in context different than intended (e.g. as a result of navigation across process boundaries). This is mutually exclusive with `contextId`.
-// generateWebDriverValue - Whether the result should be serialized according to https://w3c.github.io/webdriver-bidi.
+// serializationOptions - Specifies the result serialization. If provided, overrides `generatePreview` and `returnByValue`.
 // Returns -  result - Evaluation result. exceptionDetails - Exception details.
-func (c *Runtime) Evaluate(ctx context.Context, expression string, objectGroup string, includeCommandLineAPI bool, silent bool, contextId int, returnByValue bool, generatePreview bool, userGesture bool, awaitPromise bool, throwOnSideEffect bool, timeout float64, disableBreaks bool, replMode bool, allowUnsafeEvalBlockedByCSP bool, uniqueContextId string, generateWebDriverValue bool) (*RuntimeRemoteObject, *RuntimeExceptionDetails, error) {
+func (c *Runtime) Evaluate(ctx context.Context, expression string, objectGroup string, includeCommandLineAPI bool, silent bool, contextId int, returnByValue bool, generatePreview bool, userGesture bool, awaitPromise bool, throwOnSideEffect bool, timeout float64, disableBreaks bool, replMode bool, allowUnsafeEvalBlockedByCSP bool, uniqueContextId string, serializationOptions *RuntimeSerializationOptions) (*RuntimeRemoteObject, *RuntimeExceptionDetails, error) {
 	var v RuntimeEvaluateParams
 	v.Expression = expression
 	v.ObjectGroup = objectGroup
@@ -538,12 +1101,14 @@ func (c *Runtime) Evaluate(ctx context.Context, expression string, objectGroup s
 	v.ReplMode = replMode
 	v.AllowUnsafeEvalBlockedByCSP = allowUnsafeEvalBlockedByCSP
 	v.UniqueContextId = uniqueContextId
-	v.GenerateWebDriverValue = generateWebDriverValue
+	v.SerializationOptions = serializationOptions
 	return c.EvaluateWithParams(ctx, &v)
 }
 
 // GetIsolateId - Returns the isolate id.
 // Returns -  id - The isolate id.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Runtime) GetIsolateId(ctx context.Context) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Runtime.getIsolateId"})
 	if err != nil {
@@ -573,34 +1138,38 @@ func (c *Runtime) GetIsolateId(ctx context.Context) (string, error) {
 }
 
 // GetHeapUsage - Returns the JavaScript heap usage. It is the total usage of the corresponding isolate not scoped to a particular Runtime.
-// Returns -  usedSize - Used heap size in bytes. totalSize - Allocated heap size in bytes.
-func (c *Runtime) GetHeapUsage(ctx context.Context) (float64, float64, error) {
+// Returns -  usedSize - Used JavaScript heap size in bytes. totalSize - Allocated JavaScript heap size in bytes. embedderHeapUsedSize - Used size in bytes in the embedder's garbage-collected heap. backingStorageSize - Size in bytes of backing storage for array buffers and external strings.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Runtime) GetHeapUsage(ctx context.Context) (float64, float64, float64, float64, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Runtime.getHeapUsage"})
 	if err != nil {
-		return 0, 0, err
+		return 0, 0, 0, 0, err
 	}
 
 	var chromeData struct {
 		gcdmessage.ChromeErrorResponse
 		Result struct {
-			UsedSize  float64
-			TotalSize float64
+			UsedSize             float64
+			TotalSize            float64
+			EmbedderHeapUsedSize float64
+			BackingStorageSize   float64
 		}
 	}
 
 	if resp == nil {
-		return 0, 0, &gcdmessage.ChromeEmptyResponseErr{}
+		return 0, 0, 0, 0, &gcdmessage.ChromeEmptyResponseErr{}
 	}
 
 	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return 0, 0, err
+		return 0, 0, 0, 0, err
 	}
 
 	if chromeData.Error != nil {
-		return 0, 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+		return 0, 0, 0, 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
 	}
 
-	return chromeData.Result.UsedSize, chromeData.Result.TotalSize, nil
+	return chromeData.Result.UsedSize, chromeData.Result.TotalSize, chromeData.Result.EmbedderHeapUsedSize, chromeData.Result.BackingStorageSize, nil
 }
 
 type RuntimeGetPropertiesParams struct {
@@ -609,10 +1178,16 @@ type RuntimeGetPropertiesParams struct {
 	// If true, returns properties belonging only to the element itself, not to its prototype chain.
 	OwnProperties bool `json:"ownProperties,omitempty"`
 	// If true, returns accessor properties (with getter/setter) only; internal properties are not returned either.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	AccessorPropertiesOnly bool `json:"accessorPropertiesOnly,omitempty"`
 	// Whether preview should be generated for the results.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	GeneratePreview bool `json:"generatePreview,omitempty"`
 	// If true, returns non-indexed properties only.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	NonIndexedPropertiesOnly bool `json:"nonIndexedPropertiesOnly,omitempty"`
 }
 
@@ -896,12 +1471,16 @@ type RuntimeSetCustomObjectFormatterEnabledParams struct {
 }
 
 // SetCustomObjectFormatterEnabledWithParams -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Runtime) SetCustomObjectFormatterEnabledWithParams(ctx context.Context, v *RuntimeSetCustomObjectFormatterEnabledParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Runtime.setCustomObjectFormatterEnabled", Params: v})
 }
 
 // SetCustomObjectFormatterEnabled -
 // enabled -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Runtime) SetCustomObjectFormatterEnabled(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
 	var v RuntimeSetCustomObjectFormatterEnabledParams
 	v.Enabled = enabled
@@ -914,12 +1493,16 @@ type RuntimeSetMaxCallStackSizeToCaptureParams struct {
 }
 
 // SetMaxCallStackSizeToCaptureWithParams -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Runtime) SetMaxCallStackSizeToCaptureWithParams(ctx context.Context, v *RuntimeSetMaxCallStackSizeToCaptureParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Runtime.setMaxCallStackSizeToCapture", Params: v})
 }
 
 // SetMaxCallStackSizeToCapture -
 // size -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Runtime) SetMaxCallStackSizeToCapture(ctx context.Context, size int) (*gcdmessage.ChromeResponse, error) {
 	var v RuntimeSetMaxCallStackSizeToCaptureParams
 	v.Size = size
@@ -927,6 +1510,8 @@ func (c *Runtime) SetMaxCallStackSizeToCapture(ctx context.Context, size int) (*
 }
 
 // Terminate current or next JavaScript execution. Will cancel the termination when the outer-most script execution ends.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Runtime) TerminateExecution(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Runtime.terminateExecution"})
 }
@@ -935,6 +1520,10 @@ type RuntimeAddBindingParams struct {
 	//
 	Name string `json:"name"`
 	// If specified, the binding would only be exposed to the specified execution context. If omitted and `executionContextName` is not set, the binding is exposed to all execution contexts of the target. This parameter is mutually exclusive with `executionContextName`. Deprecated in favor of `executionContextName` due to an unclear use case and bugs in implementation (crbug.com/1169639). `executionContextId` will be removed in the future.
+	//
+	// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ExecutionContextId int `json:"executionContextId,omitempty"`
 	// If specified, the binding is exposed to the executionContext with matching name, even for contexts created after the binding is added. See also `ExecutionContext.name` and `worldName` parameter to `Page.addScriptToEvaluateOnNewDocument`. This parameter is mutually exclusive with `executionContextId`.
 	ExecutionContextName string `json:"executionContextName,omitempty"`
@@ -982,6 +1571,8 @@ type RuntimeGetExceptionDetailsParams struct {
 
 // GetExceptionDetailsWithParams - This method tries to lookup and populate exception details for a JavaScript Error object. Note that the stackTrace portion of the resulting exceptionDetails will only be populated if the Runtime domain was enabled at the time when the Error was thrown.
 // Returns -  exceptionDetails -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Runtime) GetExceptionDetailsWithParams(ctx context.Context, v *RuntimeGetExceptionDetailsParams) (*RuntimeExceptionDetails, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Runtime.getExceptionDetails", Params: v})
 	if err != nil {
@@ -1013,6 +1604,8 @@ func (c *Runtime) GetExceptionDetailsWithParams(ctx context.Context, v *RuntimeG
 // GetExceptionDetails - This method tries to lookup and populate exception details for a JavaScript Error object. Note that the stackTrace portion of the resulting exceptionDetails will only be populated if the Runtime domain was enabled at the time when the Error was thrown.
 // errorObjectId - The error object for which to resolve the exception details.
 // Returns -  exceptionDetails -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Runtime) GetExceptionDetails(ctx context.Context, errorObjectId string) (*RuntimeExceptionDetails, error) {
 	var v RuntimeGetExceptionDetailsParams
 	v.ErrorObjectId = errorObjectId
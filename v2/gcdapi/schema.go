@@ -15,6 +15,9 @@ type SchemaDomain struct {
 	Version string `json:"version"` // Domain version.
 }
 
+// Schema domain commands and events.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type Schema struct {
 	target gcdmessage.ChromeTargeter
 }
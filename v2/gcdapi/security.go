@@ -9,7 +9,93 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// A description of mixed content (HTTP resources on HTTPS pages), as defined by https://www.w3.org/TR/mixed-content/#categories
+type SecurityMixedContentType string
+
+const (
+	SecurityMixedContentTypeBlockable           SecurityMixedContentType = "blockable"
+	SecurityMixedContentTypeOptionallyBlockable SecurityMixedContentType = "optionally-blockable"
+	SecurityMixedContentTypeNone                SecurityMixedContentType = "none"
+)
+
+// Valid returns true if the value is one of the SecurityMixedContentType enum values.
+func (e SecurityMixedContentType) Valid() bool {
+	switch e {
+	case SecurityMixedContentTypeBlockable,
+		SecurityMixedContentTypeOptionallyBlockable,
+		SecurityMixedContentTypeNone:
+		return true
+	}
+	return false
+}
+
+// The security level of a page or resource.
+type SecuritySecurityState string
+
+const (
+	SecuritySecurityStateUnknown        SecuritySecurityState = "unknown"
+	SecuritySecurityStateNeutral        SecuritySecurityState = "neutral"
+	SecuritySecurityStateInsecure       SecuritySecurityState = "insecure"
+	SecuritySecurityStateSecure         SecuritySecurityState = "secure"
+	SecuritySecurityStateInfo           SecuritySecurityState = "info"
+	SecuritySecurityStateInsecureBroken SecuritySecurityState = "insecure-broken"
+)
+
+// Valid returns true if the value is one of the SecuritySecurityState enum values.
+func (e SecuritySecurityState) Valid() bool {
+	switch e {
+	case SecuritySecurityStateUnknown,
+		SecuritySecurityStateNeutral,
+		SecuritySecurityStateInsecure,
+		SecuritySecurityStateSecure,
+		SecuritySecurityStateInfo,
+		SecuritySecurityStateInsecureBroken:
+		return true
+	}
+	return false
+}
+
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type SecuritySafetyTipStatus string
+
+const (
+	SecuritySafetyTipStatusBadReputation SecuritySafetyTipStatus = "badReputation"
+	SecuritySafetyTipStatusLookalike     SecuritySafetyTipStatus = "lookalike"
+)
+
+// Valid returns true if the value is one of the SecuritySafetyTipStatus enum values.
+func (e SecuritySafetyTipStatus) Valid() bool {
+	switch e {
+	case SecuritySafetyTipStatusBadReputation,
+		SecuritySafetyTipStatusLookalike:
+		return true
+	}
+	return false
+}
+
+// The action to take when a certificate error occurs. continue will continue processing the request and cancel will cancel the request.
+type SecurityCertificateErrorAction string
+
+const (
+	SecurityCertificateErrorActionContinue SecurityCertificateErrorAction = "continue"
+	SecurityCertificateErrorActionCancel   SecurityCertificateErrorAction = "cancel"
+)
+
+// Valid returns true if the value is one of the SecurityCertificateErrorAction enum values.
+func (e SecurityCertificateErrorAction) Valid() bool {
+	switch e {
+	case SecurityCertificateErrorActionContinue,
+		SecurityCertificateErrorActionCancel:
+		return true
+	}
+	return false
+}
+
 // Details about the security state of the page certificate.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type SecurityCertificateSecurityState struct {
 	Protocol                    string   `json:"protocol"`                          // Protocol name (e.g. "TLS 1.2" or "QUIC").
 	KeyExchange                 string   `json:"keyExchange"`                       // Key Exchange used by the connection, or the empty string if not applicable.
@@ -22,7 +108,7 @@ type SecurityCertificateSecurityState struct {
 	ValidFrom                   float64  `json:"validFrom"`                         // Certificate valid from date.
 	ValidTo                     float64  `json:"validTo"`                           // Certificate valid to (expiration) date
 	CertificateNetworkError     string   `json:"certificateNetworkError,omitempty"` // The highest priority network error code, if the certificate has an error.
-	CertificateHasWeakSignature bool     `json:"certificateHasWeakSignature"`       // True if the certificate uses a weak signature aglorithm.
+	CertificateHasWeakSignature bool     `json:"certificateHasWeakSignature"`       // True if the certificate uses a weak signature algorithm.
 	CertificateHasSha1Signature bool     `json:"certificateHasSha1Signature"`       // True if the certificate has a SHA1 signature in the chain.
 	ModernSSL                   bool     `json:"modernSSL"`                         // True if modern SSL
 	ObsoleteSslProtocol         bool     `json:"obsoleteSslProtocol"`               // True if the connection is using an obsolete SSL protocol.
@@ -32,14 +118,18 @@ type SecurityCertificateSecurityState struct {
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type SecuritySafetyTipInfo struct {
-	SafetyTipStatus string `json:"safetyTipStatus"`   // Describes whether the page triggers any safety tips or reputation warnings. Default is unknown. enum values: badReputation, lookalike
-	SafeUrl         string `json:"safeUrl,omitempty"` // The URL the safety tip suggested ("Did you mean?"). Only filled in for lookalike matches.
+	SafetyTipStatus SecuritySafetyTipStatus `json:"safetyTipStatus"`   // Describes whether the page triggers any safety tips or reputation warnings. Default is unknown. enum values: badReputation, lookalike
+	SafeUrl         string                  `json:"safeUrl,omitempty"` // The URL the safety tip suggested ("Did you mean?"). Only filled in for lookalike matches.
 }
 
 // Security state information about the page.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type SecurityVisibleSecurityState struct {
-	SecurityState            string                            `json:"securityState"`                      // The security level of the page. enum values: unknown, neutral, insecure, secure, info, insecure-broken
+	SecurityState            SecuritySecurityState             `json:"securityState"`                      // The security level of the page. enum values: unknown, neutral, insecure, secure, info, insecure-broken
 	CertificateSecurityState *SecurityCertificateSecurityState `json:"certificateSecurityState,omitempty"` // Security state details about the page certificate.
 	SafetyTipInfo            *SecuritySafetyTipInfo            `json:"safetyTipInfo,omitempty"`            // The type of Safety Tip triggered on the page. Note that this field will be set even if the Safety Tip UI was not actually shown.
 	SecurityStateIssueIds    []string                          `json:"securityStateIssueIds"`              // Array of security state issues ids.
@@ -47,27 +137,45 @@ type SecurityVisibleSecurityState struct {
 
 // An explanation of an factor contributing to the security state.
 type SecuritySecurityStateExplanation struct {
-	SecurityState    string   `json:"securityState"`             // Security state representing the severity of the factor being explained. enum values: unknown, neutral, insecure, secure, info, insecure-broken
-	Title            string   `json:"title"`                     // Title describing the type of factor.
-	Summary          string   `json:"summary"`                   // Short phrase describing the type of factor.
-	Description      string   `json:"description"`               // Full text explanation of the factor.
-	MixedContentType string   `json:"mixedContentType"`          // The type of mixed content described by the explanation. enum values: blockable, optionally-blockable, none
-	Certificate      []string `json:"certificate"`               // Page certificate.
-	Recommendations  []string `json:"recommendations,omitempty"` // Recommendations to fix any issues.
+	SecurityState    SecuritySecurityState    `json:"securityState"`             // Security state representing the severity of the factor being explained. enum values: unknown, neutral, insecure, secure, info, insecure-broken
+	Title            string                   `json:"title"`                     // Title describing the type of factor.
+	Summary          string                   `json:"summary"`                   // Short phrase describing the type of factor.
+	Description      string                   `json:"description"`               // Full text explanation of the factor.
+	MixedContentType SecurityMixedContentType `json:"mixedContentType"`          // The type of mixed content described by the explanation. enum values: blockable, optionally-blockable, none
+	Certificate      []string                 `json:"certificate"`               // Page certificate.
+	Recommendations  []string                 `json:"recommendations,omitempty"` // Recommendations to fix any issues.
 }
 
 // Information about insecure content on the page.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type SecurityInsecureContentStatus struct {
-	RanMixedContent                bool   `json:"ranMixedContent"`                // Always false.
-	DisplayedMixedContent          bool   `json:"displayedMixedContent"`          // Always false.
-	ContainedMixedForm             bool   `json:"containedMixedForm"`             // Always false.
-	RanContentWithCertErrors       bool   `json:"ranContentWithCertErrors"`       // Always false.
-	DisplayedContentWithCertErrors bool   `json:"displayedContentWithCertErrors"` // Always false.
-	RanInsecureContentStyle        string `json:"ranInsecureContentStyle"`        // Always set to unknown. enum values: unknown, neutral, insecure, secure, info, insecure-broken
-	DisplayedInsecureContentStyle  string `json:"displayedInsecureContentStyle"`  // Always set to unknown. enum values: unknown, neutral, insecure, secure, info, insecure-broken
+	RanMixedContent                bool                  `json:"ranMixedContent"`                // Always false.
+	DisplayedMixedContent          bool                  `json:"displayedMixedContent"`          // Always false.
+	ContainedMixedForm             bool                  `json:"containedMixedForm"`             // Always false.
+	RanContentWithCertErrors       bool                  `json:"ranContentWithCertErrors"`       // Always false.
+	DisplayedContentWithCertErrors bool                  `json:"displayedContentWithCertErrors"` // Always false.
+	RanInsecureContentStyle        SecuritySecurityState `json:"ranInsecureContentStyle"`        // Always set to unknown. enum values: unknown, neutral, insecure, secure, info, insecure-broken
+	DisplayedInsecureContentStyle  SecuritySecurityState `json:"displayedInsecureContentStyle"`  // Always set to unknown. enum values: unknown, neutral, insecure, secure, info, insecure-broken
+}
+
+// Event method names for the Security domain
+const (
+	EventSecurityCertificateError            = "Security.certificateError"
+	EventSecurityVisibleSecurityStateChanged = "Security.visibleSecurityStateChanged"
+	EventSecuritySecurityStateChanged        = "Security.securityStateChanged"
+)
+
+// SecurityEvents lists all event method names for the Security domain
+var SecurityEvents = []string{
+	EventSecurityCertificateError,
+	EventSecurityVisibleSecurityStateChanged,
+	EventSecuritySecurityStateChanged,
 }
 
 // There is a certificate error. If overriding certificate errors is enabled, then it should be handled with the `handleCertificateError` command. Note: this event does not fire if the certificate error has been allowed internally. Only one client per target should override certificate errors at the same time.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type SecurityCertificateErrorEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -78,6 +186,8 @@ type SecurityCertificateErrorEvent struct {
 }
 
 // The security state of the page changed.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type SecurityVisibleSecurityStateChangedEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -86,14 +196,20 @@ type SecurityVisibleSecurityStateChangedEvent struct {
 }
 
 // The security state of the page changed. No longer being sent.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 type SecuritySecurityStateChangedEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		SecurityState         string                              `json:"securityState"`         // Security state. enum values: unknown, neutral, insecure, secure, info, insecure-broken
-		SchemeIsCryptographic bool                                `json:"schemeIsCryptographic"` // True if the page was loaded over cryptographic transport such as HTTPS.
-		Explanations          []*SecuritySecurityStateExplanation `json:"explanations"`          // Previously a list of explanations for the security state. Now always empty.
-		InsecureContentStatus *SecurityInsecureContentStatus      `json:"insecureContentStatus"` // Information about insecure content on the page.
-		Summary               string                              `json:"summary,omitempty"`     // Overrides user-visible description of the state. Always omitted.
+		SecurityState SecuritySecurityState `json:"securityState"` // Security state. enum values: unknown, neutral, insecure, secure, info, insecure-broken
+		// Deprecated: this field is deprecated in the protocol.
+		SchemeIsCryptographic bool `json:"schemeIsCryptographic"` // True if the page was loaded over cryptographic transport such as HTTPS.
+		// Deprecated: this field is deprecated in the protocol.
+		Explanations []*SecuritySecurityStateExplanation `json:"explanations"` // Previously a list of explanations for the security state. Now always empty.
+		// Deprecated: this field is deprecated in the protocol.
+		InsecureContentStatus *SecurityInsecureContentStatus `json:"insecureContentStatus"` // Information about insecure content on the page.
+		// Deprecated: this field is deprecated in the protocol.
+		Summary string `json:"summary,omitempty"` // Overrides user-visible description of the state. Always omitted.
 	} `json:"Params,omitempty"`
 }
 
@@ -106,6 +222,51 @@ func NewSecurity(target gcdmessage.ChromeTargeter) *Security {
 	return c
 }
 
+// OnCertificateError subscribes to Security.certificateError events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Security) OnCertificateError(callback func(*SecurityCertificateErrorEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventSecurityCertificateError, func(payload []byte) error {
+		event := &SecurityCertificateErrorEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnVisibleSecurityStateChanged subscribes to Security.visibleSecurityStateChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Security) OnVisibleSecurityStateChanged(callback func(*SecurityVisibleSecurityStateChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventSecurityVisibleSecurityStateChanged, func(payload []byte) error {
+		event := &SecurityVisibleSecurityStateChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSecurityStateChanged subscribes to Security.securityStateChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Security) OnSecurityStateChanged(callback func(*SecuritySecurityStateChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventSecuritySecurityStateChanged, func(payload []byte) error {
+		event := &SecuritySecurityStateChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 // Disables tracking security state changes.
 func (c *Security) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Security.disable"})
@@ -134,14 +295,17 @@ func (c *Security) SetIgnoreCertificateErrors(ctx context.Context, ignore bool)
 	return c.SetIgnoreCertificateErrorsWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type SecurityHandleCertificateErrorParams struct {
 	// The ID of the event.
 	EventId int `json:"eventId"`
 	// The action to take on the certificate error. enum values: continue, cancel
-	Action string `json:"action"`
+	Action SecurityCertificateErrorAction `json:"action"`
 }
 
 // HandleCertificateErrorWithParams - Handles a certificate error that fired a certificateError event.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Security) HandleCertificateErrorWithParams(ctx context.Context, v *SecurityHandleCertificateErrorParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Security.handleCertificateError", Params: v})
 }
@@ -149,25 +313,32 @@ func (c *Security) HandleCertificateErrorWithParams(ctx context.Context, v *Secu
 // HandleCertificateError - Handles a certificate error that fired a certificateError event.
 // eventId - The ID of the event.
 // action - The action to take on the certificate error. enum values: continue, cancel
-func (c *Security) HandleCertificateError(ctx context.Context, eventId int, action string) (*gcdmessage.ChromeResponse, error) {
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+func (c *Security) HandleCertificateError(ctx context.Context, eventId int, action SecurityCertificateErrorAction) (*gcdmessage.ChromeResponse, error) {
 	var v SecurityHandleCertificateErrorParams
 	v.EventId = eventId
 	v.Action = action
 	return c.HandleCertificateErrorWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type SecuritySetOverrideCertificateErrorsParams struct {
 	// If true, certificate errors will be overridden.
 	Override bool `json:"override"`
 }
 
 // SetOverrideCertificateErrorsWithParams - Enable/disable overriding certificate errors. If enabled, all certificate error events need to be handled by the DevTools client and should be answered with `handleCertificateError` commands.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Security) SetOverrideCertificateErrorsWithParams(ctx context.Context, v *SecuritySetOverrideCertificateErrorsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Security.setOverrideCertificateErrors", Params: v})
 }
 
 // SetOverrideCertificateErrors - Enable/disable overriding certificate errors. If enabled, all certificate error events need to be handled by the DevTools client and should be answered with `handleCertificateError` commands.
 // override - If true, certificate errors will be overridden.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Security) SetOverrideCertificateErrors(ctx context.Context, override bool) (*gcdmessage.ChromeResponse, error) {
 	var v SecuritySetOverrideCertificateErrorsParams
 	v.Override = override
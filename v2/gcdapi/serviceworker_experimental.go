@@ -0,0 +1,253 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains ServiceWorker functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnWorkerErrorReported subscribes to ServiceWorker.workerErrorReported events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *ServiceWorker) OnWorkerErrorReported(callback func(*ServiceWorkerWorkerErrorReportedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventServiceWorkerWorkerErrorReported, func(payload []byte) error {
+		event := &ServiceWorkerWorkerErrorReportedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWorkerRegistrationUpdated subscribes to ServiceWorker.workerRegistrationUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *ServiceWorker) OnWorkerRegistrationUpdated(callback func(*ServiceWorkerWorkerRegistrationUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventServiceWorkerWorkerRegistrationUpdated, func(payload []byte) error {
+		event := &ServiceWorkerWorkerRegistrationUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnWorkerVersionUpdated subscribes to ServiceWorker.workerVersionUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *ServiceWorker) OnWorkerVersionUpdated(callback func(*ServiceWorkerWorkerVersionUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventServiceWorkerWorkerVersionUpdated, func(payload []byte) error {
+		event := &ServiceWorkerWorkerVersionUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type ServiceWorkerDeliverPushMessageParams struct {
+	//
+	Origin string `json:"origin"`
+	//
+	RegistrationId string `json:"registrationId"`
+	//
+	Data string `json:"data"`
+}
+
+// DeliverPushMessageWithParams -
+func (c *ServiceWorker) DeliverPushMessageWithParams(ctx context.Context, v *ServiceWorkerDeliverPushMessageParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.deliverPushMessage", Params: v})
+}
+
+// DeliverPushMessage -
+// origin -
+// registrationId -
+// data -
+func (c *ServiceWorker) DeliverPushMessage(ctx context.Context, origin string, registrationId string, data string) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerDeliverPushMessageParams
+	v.Origin = origin
+	v.RegistrationId = registrationId
+	v.Data = data
+	return c.DeliverPushMessageWithParams(ctx, &v)
+}
+
+func (c *ServiceWorker) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.disable"})
+}
+
+type ServiceWorkerDispatchSyncEventParams struct {
+	//
+	Origin string `json:"origin"`
+	//
+	RegistrationId string `json:"registrationId"`
+	//
+	Tag string `json:"tag"`
+	//
+	LastChance bool `json:"lastChance"`
+}
+
+// DispatchSyncEventWithParams -
+func (c *ServiceWorker) DispatchSyncEventWithParams(ctx context.Context, v *ServiceWorkerDispatchSyncEventParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.dispatchSyncEvent", Params: v})
+}
+
+// DispatchSyncEvent -
+// origin -
+// registrationId -
+// tag -
+// lastChance -
+func (c *ServiceWorker) DispatchSyncEvent(ctx context.Context, origin string, registrationId string, tag string, lastChance bool) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerDispatchSyncEventParams
+	v.Origin = origin
+	v.RegistrationId = registrationId
+	v.Tag = tag
+	v.LastChance = lastChance
+	return c.DispatchSyncEventWithParams(ctx, &v)
+}
+
+type ServiceWorkerDispatchPeriodicSyncEventParams struct {
+	//
+	Origin string `json:"origin"`
+	//
+	RegistrationId string `json:"registrationId"`
+	//
+	Tag string `json:"tag"`
+}
+
+// DispatchPeriodicSyncEventWithParams -
+func (c *ServiceWorker) DispatchPeriodicSyncEventWithParams(ctx context.Context, v *ServiceWorkerDispatchPeriodicSyncEventParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.dispatchPeriodicSyncEvent", Params: v})
+}
+
+// DispatchPeriodicSyncEvent -
+// origin -
+// registrationId -
+// tag -
+func (c *ServiceWorker) DispatchPeriodicSyncEvent(ctx context.Context, origin string, registrationId string, tag string) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerDispatchPeriodicSyncEventParams
+	v.Origin = origin
+	v.RegistrationId = registrationId
+	v.Tag = tag
+	return c.DispatchPeriodicSyncEventWithParams(ctx, &v)
+}
+
+func (c *ServiceWorker) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.enable"})
+}
+
+type ServiceWorkerSetForceUpdateOnPageLoadParams struct {
+	//
+	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"`
+}
+
+// SetForceUpdateOnPageLoadWithParams -
+func (c *ServiceWorker) SetForceUpdateOnPageLoadWithParams(ctx context.Context, v *ServiceWorkerSetForceUpdateOnPageLoadParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.setForceUpdateOnPageLoad", Params: v})
+}
+
+// SetForceUpdateOnPageLoad -
+// forceUpdateOnPageLoad -
+func (c *ServiceWorker) SetForceUpdateOnPageLoad(ctx context.Context, forceUpdateOnPageLoad bool) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerSetForceUpdateOnPageLoadParams
+	v.ForceUpdateOnPageLoad = forceUpdateOnPageLoad
+	return c.SetForceUpdateOnPageLoadWithParams(ctx, &v)
+}
+
+type ServiceWorkerSkipWaitingParams struct {
+	//
+	ScopeURL string `json:"scopeURL"`
+}
+
+// SkipWaitingWithParams -
+func (c *ServiceWorker) SkipWaitingWithParams(ctx context.Context, v *ServiceWorkerSkipWaitingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.skipWaiting", Params: v})
+}
+
+// SkipWaiting -
+// scopeURL -
+func (c *ServiceWorker) SkipWaiting(ctx context.Context, scopeURL string) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerSkipWaitingParams
+	v.ScopeURL = scopeURL
+	return c.SkipWaitingWithParams(ctx, &v)
+}
+
+type ServiceWorkerStartWorkerParams struct {
+	//
+	ScopeURL string `json:"scopeURL"`
+}
+
+// StartWorkerWithParams -
+func (c *ServiceWorker) StartWorkerWithParams(ctx context.Context, v *ServiceWorkerStartWorkerParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.startWorker", Params: v})
+}
+
+// StartWorker -
+// scopeURL -
+func (c *ServiceWorker) StartWorker(ctx context.Context, scopeURL string) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerStartWorkerParams
+	v.ScopeURL = scopeURL
+	return c.StartWorkerWithParams(ctx, &v)
+}
+
+func (c *ServiceWorker) StopAllWorkers(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.stopAllWorkers"})
+}
+
+type ServiceWorkerStopWorkerParams struct {
+	//
+	VersionId string `json:"versionId"`
+}
+
+// StopWorkerWithParams -
+func (c *ServiceWorker) StopWorkerWithParams(ctx context.Context, v *ServiceWorkerStopWorkerParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.stopWorker", Params: v})
+}
+
+// StopWorker -
+// versionId -
+func (c *ServiceWorker) StopWorker(ctx context.Context, versionId string) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerStopWorkerParams
+	v.VersionId = versionId
+	return c.StopWorkerWithParams(ctx, &v)
+}
+
+type ServiceWorkerUnregisterParams struct {
+	//
+	ScopeURL string `json:"scopeURL"`
+}
+
+// UnregisterWithParams -
+func (c *ServiceWorker) UnregisterWithParams(ctx context.Context, v *ServiceWorkerUnregisterParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.unregister", Params: v})
+}
+
+// Unregister -
+// scopeURL -
+func (c *ServiceWorker) Unregister(ctx context.Context, scopeURL string) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerUnregisterParams
+	v.ScopeURL = scopeURL
+	return c.UnregisterWithParams(ctx, &v)
+}
+
+type ServiceWorkerUpdateRegistrationParams struct {
+	//
+	ScopeURL string `json:"scopeURL"`
+}
+
+// UpdateRegistrationWithParams -
+func (c *ServiceWorker) UpdateRegistrationWithParams(ctx context.Context, v *ServiceWorkerUpdateRegistrationParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "ServiceWorker.updateRegistration", Params: v})
+}
+
+// UpdateRegistration -
+// scopeURL -
+func (c *ServiceWorker) UpdateRegistration(ctx context.Context, scopeURL string) (*gcdmessage.ChromeResponse, error) {
+	var v ServiceWorkerUpdateRegistrationParams
+	v.ScopeURL = scopeURL
+	return c.UpdateRegistrationWithParams(ctx, &v)
+}
@@ -5,968 +5,925 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
-// Usage for a storage type.
-type StorageUsageForType struct {
-	StorageType string  `json:"storageType"` // Name of storage type. enum values: appcache, cookies, file_systems, indexeddb, local_storage, shader_cache, websql, service_workers, cache_storage, interest_groups, shared_storage, storage_buckets, all, other
-	Usage       float64 `json:"usage"`       // Storage usage (bytes).
-}
-
-// Pair of issuer origin and number of available (signed, but not used) Trust Tokens from that issuer.
-type StorageTrustTokens struct {
-	IssuerOrigin string  `json:"issuerOrigin"` //
-	Count        float64 `json:"count"`        //
-}
-
-// Ad advertising element inside an interest group.
-type StorageInterestGroupAd struct {
-	RenderUrl string `json:"renderUrl"`          //
-	Metadata  string `json:"metadata,omitempty"` //
-}
-
-// The full details of an interest group.
-type StorageInterestGroupDetails struct {
-	OwnerOrigin               string                    `json:"ownerOrigin"`                        //
-	Name                      string                    `json:"name"`                               //
-	ExpirationTime            float64                   `json:"expirationTime"`                     //
-	JoiningOrigin             string                    `json:"joiningOrigin"`                      //
-	BiddingUrl                string                    `json:"biddingUrl,omitempty"`               //
-	BiddingWasmHelperUrl      string                    `json:"biddingWasmHelperUrl,omitempty"`     //
-	UpdateUrl                 string                    `json:"updateUrl,omitempty"`                //
-	TrustedBiddingSignalsUrl  string                    `json:"trustedBiddingSignalsUrl,omitempty"` //
-	TrustedBiddingSignalsKeys []string                  `json:"trustedBiddingSignalsKeys"`          //
-	UserBiddingSignals        string                    `json:"userBiddingSignals,omitempty"`       //
-	Ads                       []*StorageInterestGroupAd `json:"ads"`                                //
-	AdComponents              []*StorageInterestGroupAd `json:"adComponents"`                       //
-}
-
-// Struct for a single key-value pair in an origin's shared storage.
-type StorageSharedStorageEntry struct {
-	Key   string `json:"key"`   //
-	Value string `json:"value"` //
-}
-
-// Details for an origin's shared storage.
-type StorageSharedStorageMetadata struct {
-	CreationTime    float64 `json:"creationTime"`    //
-	Length          int     `json:"length"`          //
-	RemainingBudget float64 `json:"remainingBudget"` //
-}
-
-// Pair of reporting metadata details for a candidate URL for `selectURL()`.
-type StorageSharedStorageReportingMetadata struct {
-	EventType    string `json:"eventType"`    //
-	ReportingUrl string `json:"reportingUrl"` //
-}
-
-// Bundles a candidate URL with its reporting metadata.
-type StorageSharedStorageUrlWithMetadata struct {
-	Url               string                                   `json:"url"`               // Spec of candidate URL.
-	ReportingMetadata []*StorageSharedStorageReportingMetadata `json:"reportingMetadata"` // Any associated reporting metadata.
-}
-
-// Bundles the parameters for shared storage access events whose presence/absence can vary according to SharedStorageAccessType.
-type StorageSharedStorageAccessParams struct {
-	ScriptSourceUrl  string                                 `json:"scriptSourceUrl,omitempty"`  // Spec of the module script URL. Present only for SharedStorageAccessType.documentAddModule.
-	OperationName    string                                 `json:"operationName,omitempty"`    // Name of the registered operation to be run. Present only for SharedStorageAccessType.documentRun and SharedStorageAccessType.documentSelectURL.
-	SerializedData   string                                 `json:"serializedData,omitempty"`   // The operation's serialized data in bytes (converted to a string). Present only for SharedStorageAccessType.documentRun and SharedStorageAccessType.documentSelectURL.
-	UrlsWithMetadata []*StorageSharedStorageUrlWithMetadata `json:"urlsWithMetadata,omitempty"` // Array of candidate URLs' specs, along with any associated metadata. Present only for SharedStorageAccessType.documentSelectURL.
-	Key              string                                 `json:"key,omitempty"`              // Key for a specific entry in an origin's shared storage. Present only for SharedStorageAccessType.documentSet, SharedStorageAccessType.documentAppend, SharedStorageAccessType.documentDelete, SharedStorageAccessType.workletSet, SharedStorageAccessType.workletAppend, SharedStorageAccessType.workletDelete, and SharedStorageAccessType.workletGet.
-	Value            string                                 `json:"value,omitempty"`            // Value for a specific entry in an origin's shared storage. Present only for SharedStorageAccessType.documentSet, SharedStorageAccessType.documentAppend, SharedStorageAccessType.workletSet, and SharedStorageAccessType.workletAppend.
-	IgnoreIfPresent  bool                                   `json:"ignoreIfPresent,omitempty"`  // Whether or not to set an entry for a key if that key is already present. Present only for SharedStorageAccessType.documentSet and SharedStorageAccessType.workletSet.
-}
-
-// No Description.
-type StorageStorageBucket struct {
-	StorageKey string `json:"storageKey"`     //
-	Name       string `json:"name,omitempty"` // If not specified, it is the default bucket of the storageKey.
-}
-
-// No Description.
-type StorageStorageBucketInfo struct {
-	Bucket     *StorageStorageBucket `json:"bucket"`     //
-	Id         string                `json:"id"`         //
-	Expiration float64               `json:"expiration"` //
-	Quota      float64               `json:"quota"`      // Storage quota (bytes).
-	Persistent bool                  `json:"persistent"` //
-	Durability string                `json:"durability"` //  enum values: relaxed, strict
-}
-
-// A cache's contents have been modified.
-type StorageCacheStorageContentUpdatedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		Origin     string `json:"origin"`     // Origin to update.
-		StorageKey string `json:"storageKey"` // Storage key to update.
-		CacheName  string `json:"cacheName"`  // Name of cache in origin.
-	} `json:"Params,omitempty"`
-}
-
-// A cache has been added/deleted.
-type StorageCacheStorageListUpdatedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		Origin     string `json:"origin"`     // Origin to update.
-		StorageKey string `json:"storageKey"` // Storage key to update.
-	} `json:"Params,omitempty"`
-}
-
-// The origin's IndexedDB object store has been modified.
-type StorageIndexedDBContentUpdatedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		Origin          string `json:"origin"`          // Origin to update.
-		StorageKey      string `json:"storageKey"`      // Storage key to update.
-		BucketId        string `json:"bucketId"`        // Storage bucket to update.
-		DatabaseName    string `json:"databaseName"`    // Database to update.
-		ObjectStoreName string `json:"objectStoreName"` // ObjectStore to update.
-	} `json:"Params,omitempty"`
-}
-
-// The origin's IndexedDB database list has been modified.
-type StorageIndexedDBListUpdatedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		Origin     string `json:"origin"`     // Origin to update.
-		StorageKey string `json:"storageKey"` // Storage key to update.
-		BucketId   string `json:"bucketId"`   // Storage bucket to update.
-	} `json:"Params,omitempty"`
-}
-
-// One of the interest groups was accessed by the associated page.
-type StorageInterestGroupAccessedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		AccessTime  float64 `json:"accessTime"`  //
-		Type        string  `json:"type"`        //  enum values: join, leave, update, loaded, bid, win
-		OwnerOrigin string  `json:"ownerOrigin"` //
-		Name        string  `json:"name"`        //
-	} `json:"Params,omitempty"`
-}
-
-// Shared storage was accessed by the associated page. The following parameters are included in all events.
-type StorageSharedStorageAccessedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		AccessTime  float64                           `json:"accessTime"`  // Time of the access.
-		Type        string                            `json:"type"`        // Enum value indicating the Shared Storage API method invoked. enum values: documentAddModule, documentSelectURL, documentRun, documentSet, documentAppend, documentDelete, documentClear, workletSet, workletAppend, workletDelete, workletClear, workletGet, workletKeys, workletEntries, workletLength, workletRemainingBudget
-		MainFrameId string                            `json:"mainFrameId"` // DevTools Frame Token for the primary frame tree's root.
-		OwnerOrigin string                            `json:"ownerOrigin"` // Serialized origin for the context that invoked the Shared Storage API.
-		Params      *StorageSharedStorageAccessParams `json:"params"`      // The sub-parameters warapped by `params` are all optional and their presence/absence depends on `type`.
-	} `json:"Params,omitempty"`
-}
-
-type StorageStorageBucketCreatedOrUpdatedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		BucketInfo *StorageStorageBucketInfo `json:"bucketInfo"` //
-	} `json:"Params,omitempty"`
-}
-
-type StorageStorageBucketDeletedEvent struct {
-	Method string `json:"method"`
-	Params struct {
-		BucketId string `json:"bucketId"` //
-	} `json:"Params,omitempty"`
-}
-
-type Storage struct {
-	target gcdmessage.ChromeTargeter
-}
-
-func NewStorage(target gcdmessage.ChromeTargeter) *Storage {
-	c := &Storage{target: target}
-	return c
-}
-
-type StorageGetStorageKeyForFrameParams struct {
-	//
-	FrameId string `json:"frameId"`
-}
-
-// GetStorageKeyForFrameWithParams - Returns a storage key given a frame id.
-// Returns -  storageKey -
-func (c *Storage) GetStorageKeyForFrameWithParams(ctx context.Context, v *StorageGetStorageKeyForFrameParams) (string, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getStorageKeyForFrame", Params: v})
-	if err != nil {
-		return "", err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			StorageKey string
-		}
-	}
+// Enum of possible storage types.
+type StorageStorageType string
+
+const (
+	StorageStorageTypeCookies        StorageStorageType = "cookies"
+	StorageStorageTypeFileSystems    StorageStorageType = "file_systems"
+	StorageStorageTypeIndexeddb      StorageStorageType = "indexeddb"
+	StorageStorageTypeLocalStorage   StorageStorageType = "local_storage"
+	StorageStorageTypeShaderCache    StorageStorageType = "shader_cache"
+	StorageStorageTypeWebsql         StorageStorageType = "websql"
+	StorageStorageTypeServiceWorkers StorageStorageType = "service_workers"
+	StorageStorageTypeCacheStorage   StorageStorageType = "cache_storage"
+	StorageStorageTypeInterestGroups StorageStorageType = "interest_groups"
+	StorageStorageTypeSharedStorage  StorageStorageType = "shared_storage"
+	StorageStorageTypeStorageBuckets StorageStorageType = "storage_buckets"
+	StorageStorageTypeAll            StorageStorageType = "all"
+	StorageStorageTypeOther          StorageStorageType = "other"
+)
 
-	if resp == nil {
-		return "", &gcdmessage.ChromeEmptyResponseErr{}
-	}
+// Valid returns true if the value is one of the StorageStorageType enum values.
+func (e StorageStorageType) Valid() bool {
+	switch e {
+	case StorageStorageTypeCookies,
+		StorageStorageTypeFileSystems,
+		StorageStorageTypeIndexeddb,
+		StorageStorageTypeLocalStorage,
+		StorageStorageTypeShaderCache,
+		StorageStorageTypeWebsql,
+		StorageStorageTypeServiceWorkers,
+		StorageStorageTypeCacheStorage,
+		StorageStorageTypeInterestGroups,
+		StorageStorageTypeSharedStorage,
+		StorageStorageTypeStorageBuckets,
+		StorageStorageTypeAll,
+		StorageStorageTypeOther:
+		return true
+	}
+	return false
+}
+
+// Enum of interest group access types.
+type StorageInterestGroupAccessType string
+
+const (
+	StorageInterestGroupAccessTypeJoin                  StorageInterestGroupAccessType = "join"
+	StorageInterestGroupAccessTypeLeave                 StorageInterestGroupAccessType = "leave"
+	StorageInterestGroupAccessTypeUpdate                StorageInterestGroupAccessType = "update"
+	StorageInterestGroupAccessTypeLoaded                StorageInterestGroupAccessType = "loaded"
+	StorageInterestGroupAccessTypeBid                   StorageInterestGroupAccessType = "bid"
+	StorageInterestGroupAccessTypeWin                   StorageInterestGroupAccessType = "win"
+	StorageInterestGroupAccessTypeAdditionalBid         StorageInterestGroupAccessType = "additionalBid"
+	StorageInterestGroupAccessTypeAdditionalBidWin      StorageInterestGroupAccessType = "additionalBidWin"
+	StorageInterestGroupAccessTypeTopLevelBid           StorageInterestGroupAccessType = "topLevelBid"
+	StorageInterestGroupAccessTypeTopLevelAdditionalBid StorageInterestGroupAccessType = "topLevelAdditionalBid"
+	StorageInterestGroupAccessTypeClear                 StorageInterestGroupAccessType = "clear"
+)
 
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return "", err
-	}
+// Valid returns true if the value is one of the StorageInterestGroupAccessType enum values.
+func (e StorageInterestGroupAccessType) Valid() bool {
+	switch e {
+	case StorageInterestGroupAccessTypeJoin,
+		StorageInterestGroupAccessTypeLeave,
+		StorageInterestGroupAccessTypeUpdate,
+		StorageInterestGroupAccessTypeLoaded,
+		StorageInterestGroupAccessTypeBid,
+		StorageInterestGroupAccessTypeWin,
+		StorageInterestGroupAccessTypeAdditionalBid,
+		StorageInterestGroupAccessTypeAdditionalBidWin,
+		StorageInterestGroupAccessTypeTopLevelBid,
+		StorageInterestGroupAccessTypeTopLevelAdditionalBid,
+		StorageInterestGroupAccessTypeClear:
+		return true
+	}
+	return false
+}
+
+// Enum of auction events.
+type StorageInterestGroupAuctionEventType string
+
+const (
+	StorageInterestGroupAuctionEventTypeStarted        StorageInterestGroupAuctionEventType = "started"
+	StorageInterestGroupAuctionEventTypeConfigResolved StorageInterestGroupAuctionEventType = "configResolved"
+)
 
-	if chromeData.Error != nil {
-		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+// Valid returns true if the value is one of the StorageInterestGroupAuctionEventType enum values.
+func (e StorageInterestGroupAuctionEventType) Valid() bool {
+	switch e {
+	case StorageInterestGroupAuctionEventTypeStarted,
+		StorageInterestGroupAuctionEventTypeConfigResolved:
+		return true
 	}
-
-	return chromeData.Result.StorageKey, nil
-}
-
-// GetStorageKeyForFrame - Returns a storage key given a frame id.
-// frameId -
-// Returns -  storageKey -
-func (c *Storage) GetStorageKeyForFrame(ctx context.Context, frameId string) (string, error) {
-	var v StorageGetStorageKeyForFrameParams
-	v.FrameId = frameId
-	return c.GetStorageKeyForFrameWithParams(ctx, &v)
-}
-
-type StorageClearDataForOriginParams struct {
-	// Security origin.
-	Origin string `json:"origin"`
-	// Comma separated list of StorageType to clear.
-	StorageTypes string `json:"storageTypes"`
-}
-
-// ClearDataForOriginWithParams - Clears storage for origin.
-func (c *Storage) ClearDataForOriginWithParams(ctx context.Context, v *StorageClearDataForOriginParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearDataForOrigin", Params: v})
+	return false
 }
 
-// ClearDataForOrigin - Clears storage for origin.
-// origin - Security origin.
-// storageTypes - Comma separated list of StorageType to clear.
-func (c *Storage) ClearDataForOrigin(ctx context.Context, origin string, storageTypes string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageClearDataForOriginParams
-	v.Origin = origin
-	v.StorageTypes = storageTypes
-	return c.ClearDataForOriginWithParams(ctx, &v)
-}
+// Enum of network fetches auctions can do.
+type StorageInterestGroupAuctionFetchType string
 
-type StorageClearDataForStorageKeyParams struct {
-	// Storage key.
-	StorageKey string `json:"storageKey"`
-	// Comma separated list of StorageType to clear.
-	StorageTypes string `json:"storageTypes"`
-}
-
-// ClearDataForStorageKeyWithParams - Clears storage for storage key.
-func (c *Storage) ClearDataForStorageKeyWithParams(ctx context.Context, v *StorageClearDataForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearDataForStorageKey", Params: v})
-}
-
-// ClearDataForStorageKey - Clears storage for storage key.
-// storageKey - Storage key.
-// storageTypes - Comma separated list of StorageType to clear.
-func (c *Storage) ClearDataForStorageKey(ctx context.Context, storageKey string, storageTypes string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageClearDataForStorageKeyParams
-	v.StorageKey = storageKey
-	v.StorageTypes = storageTypes
-	return c.ClearDataForStorageKeyWithParams(ctx, &v)
-}
-
-type StorageGetCookiesParams struct {
-	// Browser context to use when called on the browser endpoint.
-	BrowserContextId string `json:"browserContextId,omitempty"`
-}
-
-// GetCookiesWithParams - Returns all browser cookies.
-// Returns -  cookies - Array of cookie objects.
-func (c *Storage) GetCookiesWithParams(ctx context.Context, v *StorageGetCookiesParams) ([]*NetworkCookie, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getCookies", Params: v})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Cookies []*NetworkCookie
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
+const (
+	StorageInterestGroupAuctionFetchTypeBidderJs             StorageInterestGroupAuctionFetchType = "bidderJs"
+	StorageInterestGroupAuctionFetchTypeBidderWasm           StorageInterestGroupAuctionFetchType = "bidderWasm"
+	StorageInterestGroupAuctionFetchTypeSellerJs             StorageInterestGroupAuctionFetchType = "sellerJs"
+	StorageInterestGroupAuctionFetchTypeBidderTrustedSignals StorageInterestGroupAuctionFetchType = "bidderTrustedSignals"
+	StorageInterestGroupAuctionFetchTypeSellerTrustedSignals StorageInterestGroupAuctionFetchType = "sellerTrustedSignals"
+)
 
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+// Valid returns true if the value is one of the StorageInterestGroupAuctionFetchType enum values.
+func (e StorageInterestGroupAuctionFetchType) Valid() bool {
+	switch e {
+	case StorageInterestGroupAuctionFetchTypeBidderJs,
+		StorageInterestGroupAuctionFetchTypeBidderWasm,
+		StorageInterestGroupAuctionFetchTypeSellerJs,
+		StorageInterestGroupAuctionFetchTypeBidderTrustedSignals,
+		StorageInterestGroupAuctionFetchTypeSellerTrustedSignals:
+		return true
 	}
-
-	return chromeData.Result.Cookies, nil
-}
-
-// GetCookies - Returns all browser cookies.
-// browserContextId - Browser context to use when called on the browser endpoint.
-// Returns -  cookies - Array of cookie objects.
-func (c *Storage) GetCookies(ctx context.Context, browserContextId string) ([]*NetworkCookie, error) {
-	var v StorageGetCookiesParams
-	v.BrowserContextId = browserContextId
-	return c.GetCookiesWithParams(ctx, &v)
-}
-
-type StorageSetCookiesParams struct {
-	// Cookies to be set.
-	Cookies []*NetworkCookieParam `json:"cookies"`
-	// Browser context to use when called on the browser endpoint.
-	BrowserContextId string `json:"browserContextId,omitempty"`
-}
-
-// SetCookiesWithParams - Sets given cookies.
-func (c *Storage) SetCookiesWithParams(ctx context.Context, v *StorageSetCookiesParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setCookies", Params: v})
-}
-
-// SetCookies - Sets given cookies.
-// cookies - Cookies to be set.
-// browserContextId - Browser context to use when called on the browser endpoint.
-func (c *Storage) SetCookies(ctx context.Context, cookies []*NetworkCookieParam, browserContextId string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageSetCookiesParams
-	v.Cookies = cookies
-	v.BrowserContextId = browserContextId
-	return c.SetCookiesWithParams(ctx, &v)
+	return false
 }
 
-type StorageClearCookiesParams struct {
-	// Browser context to use when called on the browser endpoint.
-	BrowserContextId string `json:"browserContextId,omitempty"`
-}
+// Enum of shared storage access scopes.
+type StorageSharedStorageAccessScope string
 
-// ClearCookiesWithParams - Clears cookies.
-func (c *Storage) ClearCookiesWithParams(ctx context.Context, v *StorageClearCookiesParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearCookies", Params: v})
-}
+const (
+	StorageSharedStorageAccessScopeWindow                   StorageSharedStorageAccessScope = "window"
+	StorageSharedStorageAccessScopeSharedStorageWorklet     StorageSharedStorageAccessScope = "sharedStorageWorklet"
+	StorageSharedStorageAccessScopeProtectedAudienceWorklet StorageSharedStorageAccessScope = "protectedAudienceWorklet"
+	StorageSharedStorageAccessScopeHeader                   StorageSharedStorageAccessScope = "header"
+)
 
-// ClearCookies - Clears cookies.
-// browserContextId - Browser context to use when called on the browser endpoint.
-func (c *Storage) ClearCookies(ctx context.Context, browserContextId string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageClearCookiesParams
-	v.BrowserContextId = browserContextId
-	return c.ClearCookiesWithParams(ctx, &v)
-}
+// Valid returns true if the value is one of the StorageSharedStorageAccessScope enum values.
+func (e StorageSharedStorageAccessScope) Valid() bool {
+	switch e {
+	case StorageSharedStorageAccessScopeWindow,
+		StorageSharedStorageAccessScopeSharedStorageWorklet,
+		StorageSharedStorageAccessScopeProtectedAudienceWorklet,
+		StorageSharedStorageAccessScopeHeader:
+		return true
+	}
+	return false
+}
+
+// Enum of shared storage access methods.
+type StorageSharedStorageAccessMethod string
+
+const (
+	StorageSharedStorageAccessMethodAddModule       StorageSharedStorageAccessMethod = "addModule"
+	StorageSharedStorageAccessMethodCreateWorklet   StorageSharedStorageAccessMethod = "createWorklet"
+	StorageSharedStorageAccessMethodSelectURL       StorageSharedStorageAccessMethod = "selectURL"
+	StorageSharedStorageAccessMethodRun             StorageSharedStorageAccessMethod = "run"
+	StorageSharedStorageAccessMethodBatchUpdate     StorageSharedStorageAccessMethod = "batchUpdate"
+	StorageSharedStorageAccessMethodSet             StorageSharedStorageAccessMethod = "set"
+	StorageSharedStorageAccessMethodAppend          StorageSharedStorageAccessMethod = "append"
+	StorageSharedStorageAccessMethodDelete          StorageSharedStorageAccessMethod = "delete"
+	StorageSharedStorageAccessMethodClear           StorageSharedStorageAccessMethod = "clear"
+	StorageSharedStorageAccessMethodGet             StorageSharedStorageAccessMethod = "get"
+	StorageSharedStorageAccessMethodKeys            StorageSharedStorageAccessMethod = "keys"
+	StorageSharedStorageAccessMethodValues          StorageSharedStorageAccessMethod = "values"
+	StorageSharedStorageAccessMethodEntries         StorageSharedStorageAccessMethod = "entries"
+	StorageSharedStorageAccessMethodLength          StorageSharedStorageAccessMethod = "length"
+	StorageSharedStorageAccessMethodRemainingBudget StorageSharedStorageAccessMethod = "remainingBudget"
+)
 
-type StorageGetUsageAndQuotaParams struct {
-	// Security origin.
-	Origin string `json:"origin"`
+// Valid returns true if the value is one of the StorageSharedStorageAccessMethod enum values.
+func (e StorageSharedStorageAccessMethod) Valid() bool {
+	switch e {
+	case StorageSharedStorageAccessMethodAddModule,
+		StorageSharedStorageAccessMethodCreateWorklet,
+		StorageSharedStorageAccessMethodSelectURL,
+		StorageSharedStorageAccessMethodRun,
+		StorageSharedStorageAccessMethodBatchUpdate,
+		StorageSharedStorageAccessMethodSet,
+		StorageSharedStorageAccessMethodAppend,
+		StorageSharedStorageAccessMethodDelete,
+		StorageSharedStorageAccessMethodClear,
+		StorageSharedStorageAccessMethodGet,
+		StorageSharedStorageAccessMethodKeys,
+		StorageSharedStorageAccessMethodValues,
+		StorageSharedStorageAccessMethodEntries,
+		StorageSharedStorageAccessMethodLength,
+		StorageSharedStorageAccessMethodRemainingBudget:
+		return true
+	}
+	return false
 }
 
-// GetUsageAndQuotaWithParams - Returns usage and quota in bytes.
-// Returns -  usage - Storage usage (bytes). quota - Storage quota (bytes). overrideActive - Whether or not the origin has an active storage quota override usageBreakdown - Storage usage per type (bytes).
-func (c *Storage) GetUsageAndQuotaWithParams(ctx context.Context, v *StorageGetUsageAndQuotaParams) (float64, float64, bool, []*StorageUsageForType, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getUsageAndQuota", Params: v})
-	if err != nil {
-		return 0, 0, false, nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Usage          float64
-			Quota          float64
-			OverrideActive bool
-			UsageBreakdown []*StorageUsageForType
-		}
-	}
-
-	if resp == nil {
-		return 0, 0, false, nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
+// No Description.
+type StorageStorageBucketsDurability string
 
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return 0, 0, false, nil, err
-	}
+const (
+	StorageStorageBucketsDurabilityRelaxed StorageStorageBucketsDurability = "relaxed"
+	StorageStorageBucketsDurabilityStrict  StorageStorageBucketsDurability = "strict"
+)
 
-	if chromeData.Error != nil {
-		return 0, 0, false, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+// Valid returns true if the value is one of the StorageStorageBucketsDurability enum values.
+func (e StorageStorageBucketsDurability) Valid() bool {
+	switch e {
+	case StorageStorageBucketsDurabilityRelaxed,
+		StorageStorageBucketsDurabilityStrict:
+		return true
 	}
-
-	return chromeData.Result.Usage, chromeData.Result.Quota, chromeData.Result.OverrideActive, chromeData.Result.UsageBreakdown, nil
+	return false
 }
 
-// GetUsageAndQuota - Returns usage and quota in bytes.
-// origin - Security origin.
-// Returns -  usage - Storage usage (bytes). quota - Storage quota (bytes). overrideActive - Whether or not the origin has an active storage quota override usageBreakdown - Storage usage per type (bytes).
-func (c *Storage) GetUsageAndQuota(ctx context.Context, origin string) (float64, float64, bool, []*StorageUsageForType, error) {
-	var v StorageGetUsageAndQuotaParams
-	v.Origin = origin
-	return c.GetUsageAndQuotaWithParams(ctx, &v)
-}
-
-type StorageOverrideQuotaForOriginParams struct {
-	// Security origin.
-	Origin string `json:"origin"`
-	// The quota size (in bytes) to override the original quota with. If this is called multiple times, the overridden quota will be equal to the quotaSize provided in the final call. If this is called without specifying a quotaSize, the quota will be reset to the default value for the specified origin. If this is called multiple times with different origins, the override will be maintained for each origin until it is disabled (called without a quotaSize).
-	QuotaSize float64 `json:"quotaSize,omitempty"`
-}
-
-// OverrideQuotaForOriginWithParams - Override quota for the specified origin
-func (c *Storage) OverrideQuotaForOriginWithParams(ctx context.Context, v *StorageOverrideQuotaForOriginParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.overrideQuotaForOrigin", Params: v})
-}
-
-// OverrideQuotaForOrigin - Override quota for the specified origin
-// origin - Security origin.
-// quotaSize - The quota size (in bytes) to override the original quota with. If this is called multiple times, the overridden quota will be equal to the quotaSize provided in the final call. If this is called without specifying a quotaSize, the quota will be reset to the default value for the specified origin. If this is called multiple times with different origins, the override will be maintained for each origin until it is disabled (called without a quotaSize).
-func (c *Storage) OverrideQuotaForOrigin(ctx context.Context, origin string, quotaSize float64) (*gcdmessage.ChromeResponse, error) {
-	var v StorageOverrideQuotaForOriginParams
-	v.Origin = origin
-	v.QuotaSize = quotaSize
-	return c.OverrideQuotaForOriginWithParams(ctx, &v)
-}
-
-type StorageTrackCacheStorageForOriginParams struct {
-	// Security origin.
-	Origin string `json:"origin"`
-}
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingSourceType string
 
-// TrackCacheStorageForOriginWithParams - Registers origin to be notified when an update occurs to its cache storage list.
-func (c *Storage) TrackCacheStorageForOriginWithParams(ctx context.Context, v *StorageTrackCacheStorageForOriginParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.trackCacheStorageForOrigin", Params: v})
-}
+const (
+	StorageAttributionReportingSourceTypeNavigation StorageAttributionReportingSourceType = "navigation"
+	StorageAttributionReportingSourceTypeEvent      StorageAttributionReportingSourceType = "event"
+)
 
-// TrackCacheStorageForOrigin - Registers origin to be notified when an update occurs to its cache storage list.
-// origin - Security origin.
-func (c *Storage) TrackCacheStorageForOrigin(ctx context.Context, origin string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageTrackCacheStorageForOriginParams
-	v.Origin = origin
-	return c.TrackCacheStorageForOriginWithParams(ctx, &v)
+// Valid returns true if the value is one of the StorageAttributionReportingSourceType enum values.
+func (e StorageAttributionReportingSourceType) Valid() bool {
+	switch e {
+	case StorageAttributionReportingSourceTypeNavigation,
+		StorageAttributionReportingSourceTypeEvent:
+		return true
+	}
+	return false
 }
 
-type StorageTrackCacheStorageForStorageKeyParams struct {
-	// Storage key.
-	StorageKey string `json:"storageKey"`
-}
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingTriggerDataMatching string
 
-// TrackCacheStorageForStorageKeyWithParams - Registers storage key to be notified when an update occurs to its cache storage list.
-func (c *Storage) TrackCacheStorageForStorageKeyWithParams(ctx context.Context, v *StorageTrackCacheStorageForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.trackCacheStorageForStorageKey", Params: v})
-}
+const (
+	StorageAttributionReportingTriggerDataMatchingExact   StorageAttributionReportingTriggerDataMatching = "exact"
+	StorageAttributionReportingTriggerDataMatchingModulus StorageAttributionReportingTriggerDataMatching = "modulus"
+)
 
-// TrackCacheStorageForStorageKey - Registers storage key to be notified when an update occurs to its cache storage list.
-// storageKey - Storage key.
-func (c *Storage) TrackCacheStorageForStorageKey(ctx context.Context, storageKey string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageTrackCacheStorageForStorageKeyParams
-	v.StorageKey = storageKey
-	return c.TrackCacheStorageForStorageKeyWithParams(ctx, &v)
+// Valid returns true if the value is one of the StorageAttributionReportingTriggerDataMatching enum values.
+func (e StorageAttributionReportingTriggerDataMatching) Valid() bool {
+	switch e {
+	case StorageAttributionReportingTriggerDataMatchingExact,
+		StorageAttributionReportingTriggerDataMatchingModulus:
+		return true
+	}
+	return false
 }
 
-type StorageTrackIndexedDBForOriginParams struct {
-	// Security origin.
-	Origin string `json:"origin"`
-}
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingSourceRegistrationResult string
+
+const (
+	StorageAttributionReportingSourceRegistrationResultSuccess                                StorageAttributionReportingSourceRegistrationResult = "success"
+	StorageAttributionReportingSourceRegistrationResultInternalError                          StorageAttributionReportingSourceRegistrationResult = "internalError"
+	StorageAttributionReportingSourceRegistrationResultInsufficientSourceCapacity             StorageAttributionReportingSourceRegistrationResult = "insufficientSourceCapacity"
+	StorageAttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity  StorageAttributionReportingSourceRegistrationResult = "insufficientUniqueDestinationCapacity"
+	StorageAttributionReportingSourceRegistrationResultExcessiveReportingOrigins              StorageAttributionReportingSourceRegistrationResult = "excessiveReportingOrigins"
+	StorageAttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy              StorageAttributionReportingSourceRegistrationResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingSourceRegistrationResultSuccessNoised                          StorageAttributionReportingSourceRegistrationResult = "successNoised"
+	StorageAttributionReportingSourceRegistrationResultDestinationReportingLimitReached       StorageAttributionReportingSourceRegistrationResult = "destinationReportingLimitReached"
+	StorageAttributionReportingSourceRegistrationResultDestinationGlobalLimitReached          StorageAttributionReportingSourceRegistrationResult = "destinationGlobalLimitReached"
+	StorageAttributionReportingSourceRegistrationResultDestinationBothLimitsReached           StorageAttributionReportingSourceRegistrationResult = "destinationBothLimitsReached"
+	StorageAttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached    StorageAttributionReportingSourceRegistrationResult = "reportingOriginsPerSiteLimitReached"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity              StorageAttributionReportingSourceRegistrationResult = "exceedsMaxChannelCapacity"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity        StorageAttributionReportingSourceRegistrationResult = "exceedsMaxScopesChannelCapacity"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality      StorageAttributionReportingSourceRegistrationResult = "exceedsMaxTriggerStateCardinality"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit             StorageAttributionReportingSourceRegistrationResult = "exceedsMaxEventStatesLimit"
+	StorageAttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached StorageAttributionReportingSourceRegistrationResult = "destinationPerDayReportingLimitReached"
+)
 
-// TrackIndexedDBForOriginWithParams - Registers origin to be notified when an update occurs to its IndexedDB.
-func (c *Storage) TrackIndexedDBForOriginWithParams(ctx context.Context, v *StorageTrackIndexedDBForOriginParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.trackIndexedDBForOrigin", Params: v})
+// Valid returns true if the value is one of the StorageAttributionReportingSourceRegistrationResult enum values.
+func (e StorageAttributionReportingSourceRegistrationResult) Valid() bool {
+	switch e {
+	case StorageAttributionReportingSourceRegistrationResultSuccess,
+		StorageAttributionReportingSourceRegistrationResultInternalError,
+		StorageAttributionReportingSourceRegistrationResultInsufficientSourceCapacity,
+		StorageAttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity,
+		StorageAttributionReportingSourceRegistrationResultExcessiveReportingOrigins,
+		StorageAttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy,
+		StorageAttributionReportingSourceRegistrationResultSuccessNoised,
+		StorageAttributionReportingSourceRegistrationResultDestinationReportingLimitReached,
+		StorageAttributionReportingSourceRegistrationResultDestinationGlobalLimitReached,
+		StorageAttributionReportingSourceRegistrationResultDestinationBothLimitsReached,
+		StorageAttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached,
+		StorageAttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity,
+		StorageAttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity,
+		StorageAttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality,
+		StorageAttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit,
+		StorageAttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached:
+		return true
+	}
+	return false
 }
 
-// TrackIndexedDBForOrigin - Registers origin to be notified when an update occurs to its IndexedDB.
-// origin - Security origin.
-func (c *Storage) TrackIndexedDBForOrigin(ctx context.Context, origin string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageTrackIndexedDBForOriginParams
-	v.Origin = origin
-	return c.TrackIndexedDBForOriginWithParams(ctx, &v)
-}
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingSourceRegistrationTimeConfig string
 
-type StorageTrackIndexedDBForStorageKeyParams struct {
-	// Storage key.
-	StorageKey string `json:"storageKey"`
-}
+const (
+	StorageAttributionReportingSourceRegistrationTimeConfigInclude StorageAttributionReportingSourceRegistrationTimeConfig = "include"
+	StorageAttributionReportingSourceRegistrationTimeConfigExclude StorageAttributionReportingSourceRegistrationTimeConfig = "exclude"
+)
 
-// TrackIndexedDBForStorageKeyWithParams - Registers storage key to be notified when an update occurs to its IndexedDB.
-func (c *Storage) TrackIndexedDBForStorageKeyWithParams(ctx context.Context, v *StorageTrackIndexedDBForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.trackIndexedDBForStorageKey", Params: v})
+// Valid returns true if the value is one of the StorageAttributionReportingSourceRegistrationTimeConfig enum values.
+func (e StorageAttributionReportingSourceRegistrationTimeConfig) Valid() bool {
+	switch e {
+	case StorageAttributionReportingSourceRegistrationTimeConfigInclude,
+		StorageAttributionReportingSourceRegistrationTimeConfigExclude:
+		return true
+	}
+	return false
 }
 
-// TrackIndexedDBForStorageKey - Registers storage key to be notified when an update occurs to its IndexedDB.
-// storageKey - Storage key.
-func (c *Storage) TrackIndexedDBForStorageKey(ctx context.Context, storageKey string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageTrackIndexedDBForStorageKeyParams
-	v.StorageKey = storageKey
-	return c.TrackIndexedDBForStorageKeyWithParams(ctx, &v)
-}
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingEventLevelResult string
+
+const (
+	StorageAttributionReportingEventLevelResultSuccess                             StorageAttributionReportingEventLevelResult = "success"
+	StorageAttributionReportingEventLevelResultSuccessDroppedLowerPriority         StorageAttributionReportingEventLevelResult = "successDroppedLowerPriority"
+	StorageAttributionReportingEventLevelResultInternalError                       StorageAttributionReportingEventLevelResult = "internalError"
+	StorageAttributionReportingEventLevelResultNoCapacityForAttributionDestination StorageAttributionReportingEventLevelResult = "noCapacityForAttributionDestination"
+	StorageAttributionReportingEventLevelResultNoMatchingSources                   StorageAttributionReportingEventLevelResult = "noMatchingSources"
+	StorageAttributionReportingEventLevelResultDeduplicated                        StorageAttributionReportingEventLevelResult = "deduplicated"
+	StorageAttributionReportingEventLevelResultExcessiveAttributions               StorageAttributionReportingEventLevelResult = "excessiveAttributions"
+	StorageAttributionReportingEventLevelResultPriorityTooLow                      StorageAttributionReportingEventLevelResult = "priorityTooLow"
+	StorageAttributionReportingEventLevelResultNeverAttributedSource               StorageAttributionReportingEventLevelResult = "neverAttributedSource"
+	StorageAttributionReportingEventLevelResultExcessiveReportingOrigins           StorageAttributionReportingEventLevelResult = "excessiveReportingOrigins"
+	StorageAttributionReportingEventLevelResultNoMatchingSourceFilterData          StorageAttributionReportingEventLevelResult = "noMatchingSourceFilterData"
+	StorageAttributionReportingEventLevelResultProhibitedByBrowserPolicy           StorageAttributionReportingEventLevelResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingEventLevelResultNoMatchingConfigurations            StorageAttributionReportingEventLevelResult = "noMatchingConfigurations"
+	StorageAttributionReportingEventLevelResultExcessiveReports                    StorageAttributionReportingEventLevelResult = "excessiveReports"
+	StorageAttributionReportingEventLevelResultFalselyAttributedSource             StorageAttributionReportingEventLevelResult = "falselyAttributedSource"
+	StorageAttributionReportingEventLevelResultReportWindowPassed                  StorageAttributionReportingEventLevelResult = "reportWindowPassed"
+	StorageAttributionReportingEventLevelResultNotRegistered                       StorageAttributionReportingEventLevelResult = "notRegistered"
+	StorageAttributionReportingEventLevelResultReportWindowNotStarted              StorageAttributionReportingEventLevelResult = "reportWindowNotStarted"
+	StorageAttributionReportingEventLevelResultNoMatchingTriggerData               StorageAttributionReportingEventLevelResult = "noMatchingTriggerData"
+)
 
-type StorageUntrackCacheStorageForOriginParams struct {
-	// Security origin.
-	Origin string `json:"origin"`
+// Valid returns true if the value is one of the StorageAttributionReportingEventLevelResult enum values.
+func (e StorageAttributionReportingEventLevelResult) Valid() bool {
+	switch e {
+	case StorageAttributionReportingEventLevelResultSuccess,
+		StorageAttributionReportingEventLevelResultSuccessDroppedLowerPriority,
+		StorageAttributionReportingEventLevelResultInternalError,
+		StorageAttributionReportingEventLevelResultNoCapacityForAttributionDestination,
+		StorageAttributionReportingEventLevelResultNoMatchingSources,
+		StorageAttributionReportingEventLevelResultDeduplicated,
+		StorageAttributionReportingEventLevelResultExcessiveAttributions,
+		StorageAttributionReportingEventLevelResultPriorityTooLow,
+		StorageAttributionReportingEventLevelResultNeverAttributedSource,
+		StorageAttributionReportingEventLevelResultExcessiveReportingOrigins,
+		StorageAttributionReportingEventLevelResultNoMatchingSourceFilterData,
+		StorageAttributionReportingEventLevelResultProhibitedByBrowserPolicy,
+		StorageAttributionReportingEventLevelResultNoMatchingConfigurations,
+		StorageAttributionReportingEventLevelResultExcessiveReports,
+		StorageAttributionReportingEventLevelResultFalselyAttributedSource,
+		StorageAttributionReportingEventLevelResultReportWindowPassed,
+		StorageAttributionReportingEventLevelResultNotRegistered,
+		StorageAttributionReportingEventLevelResultReportWindowNotStarted,
+		StorageAttributionReportingEventLevelResultNoMatchingTriggerData:
+		return true
+	}
+	return false
 }
 
-// UntrackCacheStorageForOriginWithParams - Unregisters origin from receiving notifications for cache storage.
-func (c *Storage) UntrackCacheStorageForOriginWithParams(ctx context.Context, v *StorageUntrackCacheStorageForOriginParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.untrackCacheStorageForOrigin", Params: v})
-}
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingAggregatableResult string
+
+const (
+	StorageAttributionReportingAggregatableResultSuccess                             StorageAttributionReportingAggregatableResult = "success"
+	StorageAttributionReportingAggregatableResultInternalError                       StorageAttributionReportingAggregatableResult = "internalError"
+	StorageAttributionReportingAggregatableResultNoCapacityForAttributionDestination StorageAttributionReportingAggregatableResult = "noCapacityForAttributionDestination"
+	StorageAttributionReportingAggregatableResultNoMatchingSources                   StorageAttributionReportingAggregatableResult = "noMatchingSources"
+	StorageAttributionReportingAggregatableResultExcessiveAttributions               StorageAttributionReportingAggregatableResult = "excessiveAttributions"
+	StorageAttributionReportingAggregatableResultExcessiveReportingOrigins           StorageAttributionReportingAggregatableResult = "excessiveReportingOrigins"
+	StorageAttributionReportingAggregatableResultNoHistograms                        StorageAttributionReportingAggregatableResult = "noHistograms"
+	StorageAttributionReportingAggregatableResultInsufficientBudget                  StorageAttributionReportingAggregatableResult = "insufficientBudget"
+	StorageAttributionReportingAggregatableResultInsufficientNamedBudget             StorageAttributionReportingAggregatableResult = "insufficientNamedBudget"
+	StorageAttributionReportingAggregatableResultNoMatchingSourceFilterData          StorageAttributionReportingAggregatableResult = "noMatchingSourceFilterData"
+	StorageAttributionReportingAggregatableResultNotRegistered                       StorageAttributionReportingAggregatableResult = "notRegistered"
+	StorageAttributionReportingAggregatableResultProhibitedByBrowserPolicy           StorageAttributionReportingAggregatableResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingAggregatableResultDeduplicated                        StorageAttributionReportingAggregatableResult = "deduplicated"
+	StorageAttributionReportingAggregatableResultReportWindowPassed                  StorageAttributionReportingAggregatableResult = "reportWindowPassed"
+	StorageAttributionReportingAggregatableResultExcessiveReports                    StorageAttributionReportingAggregatableResult = "excessiveReports"
+)
 
-// UntrackCacheStorageForOrigin - Unregisters origin from receiving notifications for cache storage.
-// origin - Security origin.
-func (c *Storage) UntrackCacheStorageForOrigin(ctx context.Context, origin string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageUntrackCacheStorageForOriginParams
-	v.Origin = origin
-	return c.UntrackCacheStorageForOriginWithParams(ctx, &v)
+// Valid returns true if the value is one of the StorageAttributionReportingAggregatableResult enum values.
+func (e StorageAttributionReportingAggregatableResult) Valid() bool {
+	switch e {
+	case StorageAttributionReportingAggregatableResultSuccess,
+		StorageAttributionReportingAggregatableResultInternalError,
+		StorageAttributionReportingAggregatableResultNoCapacityForAttributionDestination,
+		StorageAttributionReportingAggregatableResultNoMatchingSources,
+		StorageAttributionReportingAggregatableResultExcessiveAttributions,
+		StorageAttributionReportingAggregatableResultExcessiveReportingOrigins,
+		StorageAttributionReportingAggregatableResultNoHistograms,
+		StorageAttributionReportingAggregatableResultInsufficientBudget,
+		StorageAttributionReportingAggregatableResultInsufficientNamedBudget,
+		StorageAttributionReportingAggregatableResultNoMatchingSourceFilterData,
+		StorageAttributionReportingAggregatableResultNotRegistered,
+		StorageAttributionReportingAggregatableResultProhibitedByBrowserPolicy,
+		StorageAttributionReportingAggregatableResultDeduplicated,
+		StorageAttributionReportingAggregatableResultReportWindowPassed,
+		StorageAttributionReportingAggregatableResultExcessiveReports:
+		return true
+	}
+	return false
 }
 
-type StorageUntrackCacheStorageForStorageKeyParams struct {
-	// Storage key.
-	StorageKey string `json:"storageKey"`
-}
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingReportResult string
+
+const (
+	StorageAttributionReportingReportResultSent             StorageAttributionReportingReportResult = "sent"
+	StorageAttributionReportingReportResultProhibited       StorageAttributionReportingReportResult = "prohibited"
+	StorageAttributionReportingReportResultFailedToAssemble StorageAttributionReportingReportResult = "failedToAssemble"
+	StorageAttributionReportingReportResultExpired          StorageAttributionReportingReportResult = "expired"
+)
 
-// UntrackCacheStorageForStorageKeyWithParams - Unregisters storage key from receiving notifications for cache storage.
-func (c *Storage) UntrackCacheStorageForStorageKeyWithParams(ctx context.Context, v *StorageUntrackCacheStorageForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.untrackCacheStorageForStorageKey", Params: v})
+// Valid returns true if the value is one of the StorageAttributionReportingReportResult enum values.
+func (e StorageAttributionReportingReportResult) Valid() bool {
+	switch e {
+	case StorageAttributionReportingReportResultSent,
+		StorageAttributionReportingReportResultProhibited,
+		StorageAttributionReportingReportResultFailedToAssemble,
+		StorageAttributionReportingReportResultExpired:
+		return true
+	}
+	return false
 }
 
-// UntrackCacheStorageForStorageKey - Unregisters storage key from receiving notifications for cache storage.
-// storageKey - Storage key.
-func (c *Storage) UntrackCacheStorageForStorageKey(ctx context.Context, storageKey string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageUntrackCacheStorageForStorageKeyParams
-	v.StorageKey = storageKey
-	return c.UntrackCacheStorageForStorageKeyWithParams(ctx, &v)
+// Usage for a storage type.
+type StorageUsageForType struct {
+	StorageType StorageStorageType `json:"storageType"` // Name of storage type. enum values: cookies, file_systems, indexeddb, local_storage, shader_cache, websql, service_workers, cache_storage, interest_groups, shared_storage, storage_buckets, all, other
+	Usage       float64            `json:"usage"`       // Storage usage (bytes).
 }
 
-type StorageUntrackIndexedDBForOriginParams struct {
-	// Security origin.
-	Origin string `json:"origin"`
+// Pair of issuer origin and number of available (signed, but not used) Trust Tokens from that issuer.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageTrustTokens struct {
+	IssuerOrigin string  `json:"issuerOrigin"` //
+	Count        float64 `json:"count"`        //
 }
 
-// UntrackIndexedDBForOriginWithParams - Unregisters origin from receiving notifications for IndexedDB.
-func (c *Storage) UntrackIndexedDBForOriginWithParams(ctx context.Context, v *StorageUntrackIndexedDBForOriginParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.untrackIndexedDBForOrigin", Params: v})
+// Struct for a single key-value pair in an origin's shared storage.
+type StorageSharedStorageEntry struct {
+	Key   string `json:"key"`   //
+	Value string `json:"value"` //
 }
 
-// UntrackIndexedDBForOrigin - Unregisters origin from receiving notifications for IndexedDB.
-// origin - Security origin.
-func (c *Storage) UntrackIndexedDBForOrigin(ctx context.Context, origin string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageUntrackIndexedDBForOriginParams
-	v.Origin = origin
-	return c.UntrackIndexedDBForOriginWithParams(ctx, &v)
+// Details for an origin's shared storage.
+type StorageSharedStorageMetadata struct {
+	CreationTime    float64 `json:"creationTime"`    // Time when the origin's shared storage was last created.
+	Length          int     `json:"length"`          // Number of key-value pairs stored in origin's shared storage.
+	RemainingBudget float64 `json:"remainingBudget"` // Current amount of bits of entropy remaining in the navigation budget.
+	BytesUsed       int     `json:"bytesUsed"`       // Total number of bytes stored as key-value pairs in origin's shared storage.
 }
 
-type StorageUntrackIndexedDBForStorageKeyParams struct {
-	// Storage key.
-	StorageKey string `json:"storageKey"`
+// Represents a dictionary object passed in as privateAggregationConfig to run or selectURL.
+type StorageSharedStoragePrivateAggregationConfig struct {
+	AggregationCoordinatorOrigin string `json:"aggregationCoordinatorOrigin,omitempty"` // The chosen aggregation service deployment.
+	ContextId                    string `json:"contextId,omitempty"`                    // The context ID provided.
+	FilteringIdMaxBytes          int    `json:"filteringIdMaxBytes"`                    // Configures the maximum size allowed for filtering IDs.
+	MaxContributions             int    `json:"maxContributions,omitempty"`             // The limit on the number of contributions in the final report.
 }
 
-// UntrackIndexedDBForStorageKeyWithParams - Unregisters storage key from receiving notifications for IndexedDB.
-func (c *Storage) UntrackIndexedDBForStorageKeyWithParams(ctx context.Context, v *StorageUntrackIndexedDBForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.untrackIndexedDBForStorageKey", Params: v})
+// Pair of reporting metadata details for a candidate URL for `selectURL()`.
+type StorageSharedStorageReportingMetadata struct {
+	EventType    string `json:"eventType"`    //
+	ReportingUrl string `json:"reportingUrl"` //
 }
 
-// UntrackIndexedDBForStorageKey - Unregisters storage key from receiving notifications for IndexedDB.
-// storageKey - Storage key.
-func (c *Storage) UntrackIndexedDBForStorageKey(ctx context.Context, storageKey string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageUntrackIndexedDBForStorageKeyParams
-	v.StorageKey = storageKey
-	return c.UntrackIndexedDBForStorageKeyWithParams(ctx, &v)
+// Bundles a candidate URL with its reporting metadata.
+type StorageSharedStorageUrlWithMetadata struct {
+	Url               string                                   `json:"url"`               // Spec of candidate URL.
+	ReportingMetadata []*StorageSharedStorageReportingMetadata `json:"reportingMetadata"` // Any associated reporting metadata.
 }
 
-// GetTrustTokens - Returns the number of stored Trust Tokens per issuer for the current browsing context.
-// Returns -  tokens -
-func (c *Storage) GetTrustTokens(ctx context.Context) ([]*StorageTrustTokens, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getTrustTokens"})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Tokens []*StorageTrustTokens
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Tokens, nil
+// Bundles the parameters for shared storage access events whose presence/absence can vary according to SharedStorageAccessType.
+type StorageSharedStorageAccessParams struct {
+	ScriptSourceUrl          string                                        `json:"scriptSourceUrl,omitempty"`          // Spec of the module script URL. Present only for SharedStorageAccessMethods: addModule and createWorklet.
+	DataOrigin               string                                        `json:"dataOrigin,omitempty"`               // String denoting "context-origin", "script-origin", or a custom origin to be used as the worklet's data origin. Present only for SharedStorageAccessMethod: createWorklet.
+	OperationName            string                                        `json:"operationName,omitempty"`            // Name of the registered operation to be run. Present only for SharedStorageAccessMethods: run and selectURL.
+	OperationId              string                                        `json:"operationId,omitempty"`              // ID of the operation call. Present only for SharedStorageAccessMethods: run and selectURL.
+	KeepAlive                bool                                          `json:"keepAlive,omitempty"`                // Whether or not to keep the worket alive for future run or selectURL calls. Present only for SharedStorageAccessMethods: run and selectURL.
+	PrivateAggregationConfig *StorageSharedStoragePrivateAggregationConfig `json:"privateAggregationConfig,omitempty"` // Configures the private aggregation options. Present only for SharedStorageAccessMethods: run and selectURL.
+	SerializedData           string                                        `json:"serializedData,omitempty"`           // The operation's serialized data in bytes (converted to a string). Present only for SharedStorageAccessMethods: run and selectURL. TODO(crbug.com/401011862): Consider updating this parameter to binary.
+	UrlsWithMetadata         []*StorageSharedStorageUrlWithMetadata        `json:"urlsWithMetadata,omitempty"`         // Array of candidate URLs' specs, along with any associated metadata. Present only for SharedStorageAccessMethod: selectURL.
+	UrnUuid                  string                                        `json:"urnUuid,omitempty"`                  // Spec of the URN:UUID generated for a selectURL call. Present only for SharedStorageAccessMethod: selectURL.
+	Key                      string                                        `json:"key,omitempty"`                      // Key for a specific entry in an origin's shared storage. Present only for SharedStorageAccessMethods: set, append, delete, and get.
+	Value                    string                                        `json:"value,omitempty"`                    // Value for a specific entry in an origin's shared storage. Present only for SharedStorageAccessMethods: set and append.
+	IgnoreIfPresent          bool                                          `json:"ignoreIfPresent,omitempty"`          // Whether or not to set an entry for a key if that key is already present. Present only for SharedStorageAccessMethod: set.
+	WorkletOrdinal           int                                           `json:"workletOrdinal,omitempty"`           // A number denoting the (0-based) order of the worklet's creation relative to all other shared storage worklets created by documents using the current storage partition. Present only for SharedStorageAccessMethods: addModule, createWorklet.
+	WorkletTargetId          string                                        `json:"workletTargetId,omitempty"`          // Hex representation of the DevTools token used as the TargetID for the associated shared storage worklet. Present only for SharedStorageAccessMethods: addModule, createWorklet, run, selectURL, and any other SharedStorageAccessMethod when the SharedStorageAccessScope is sharedStorageWorklet.
+	WithLock                 string                                        `json:"withLock,omitempty"`                 // Name of the lock to be acquired, if present. Optionally present only for SharedStorageAccessMethods: batchUpdate, set, append, delete, and clear.
+	BatchUpdateId            string                                        `json:"batchUpdateId,omitempty"`            // If the method has been called as part of a batchUpdate, then this number identifies the batch to which it belongs. Optionally present only for SharedStorageAccessMethods: batchUpdate (required), set, append, delete, and clear.
+	BatchSize                int                                           `json:"batchSize,omitempty"`                // Number of modifier methods sent in batch. Present only for SharedStorageAccessMethod: batchUpdate.
 }
 
-type StorageClearTrustTokensParams struct {
-	//
-	IssuerOrigin string `json:"issuerOrigin"`
+// No Description.
+type StorageStorageBucket struct {
+	StorageKey string `json:"storageKey"`     //
+	Name       string `json:"name,omitempty"` // If not specified, it is the default bucket of the storageKey.
 }
 
-// ClearTrustTokensWithParams - Removes all Trust Tokens issued by the provided issuerOrigin. Leaves other stored data, including the issuer's Redemption Records, intact.
-// Returns -  didDeleteTokens - True if any tokens were deleted, false otherwise.
-func (c *Storage) ClearTrustTokensWithParams(ctx context.Context, v *StorageClearTrustTokensParams) (bool, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearTrustTokens", Params: v})
-	if err != nil {
-		return false, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			DidDeleteTokens bool
-		}
-	}
-
-	if resp == nil {
-		return false, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return false, err
-	}
-
-	if chromeData.Error != nil {
-		return false, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.DidDeleteTokens, nil
+// No Description.
+type StorageStorageBucketInfo struct {
+	Bucket     *StorageStorageBucket           `json:"bucket"`     //
+	Id         string                          `json:"id"`         //
+	Expiration float64                         `json:"expiration"` //
+	Quota      float64                         `json:"quota"`      // Storage quota (bytes).
+	Persistent bool                            `json:"persistent"` //
+	Durability StorageStorageBucketsDurability `json:"durability"` //  enum values: relaxed, strict
 }
 
-// ClearTrustTokens - Removes all Trust Tokens issued by the provided issuerOrigin. Leaves other stored data, including the issuer's Redemption Records, intact.
-// issuerOrigin -
-// Returns -  didDeleteTokens - True if any tokens were deleted, false otherwise.
-func (c *Storage) ClearTrustTokens(ctx context.Context, issuerOrigin string) (bool, error) {
-	var v StorageClearTrustTokensParams
-	v.IssuerOrigin = issuerOrigin
-	return c.ClearTrustTokensWithParams(ctx, &v)
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingFilterDataEntry struct {
+	Key    string   `json:"key"`    //
+	Values []string `json:"values"` //
 }
 
-type StorageGetInterestGroupDetailsParams struct {
-	//
-	OwnerOrigin string `json:"ownerOrigin"`
-	//
-	Name string `json:"name"`
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingFilterConfig struct {
+	FilterValues   []*StorageAttributionReportingFilterDataEntry `json:"filterValues"`             //
+	LookbackWindow int                                           `json:"lookbackWindow,omitempty"` // duration in seconds
 }
 
-// GetInterestGroupDetailsWithParams - Gets details for a named interest group.
-// Returns -  details -
-func (c *Storage) GetInterestGroupDetailsWithParams(ctx context.Context, v *StorageGetInterestGroupDetailsParams) (*StorageInterestGroupDetails, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getInterestGroupDetails", Params: v})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Details *StorageInterestGroupDetails
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Details, nil
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingFilterPair struct {
+	Filters    []*StorageAttributionReportingFilterConfig `json:"filters"`    //
+	NotFilters []*StorageAttributionReportingFilterConfig `json:"notFilters"` //
 }
 
-// GetInterestGroupDetails - Gets details for a named interest group.
-// ownerOrigin -
-// name -
-// Returns -  details -
-func (c *Storage) GetInterestGroupDetails(ctx context.Context, ownerOrigin string, name string) (*StorageInterestGroupDetails, error) {
-	var v StorageGetInterestGroupDetailsParams
-	v.OwnerOrigin = ownerOrigin
-	v.Name = name
-	return c.GetInterestGroupDetailsWithParams(ctx, &v)
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingAggregationKeysEntry struct {
+	Key   string `json:"key"`   //
+	Value string `json:"value"` //
 }
 
-type StorageSetInterestGroupTrackingParams struct {
-	//
-	Enable bool `json:"enable"`
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingEventReportWindows struct {
+	Start int   `json:"start"` // duration in seconds
+	Ends  []int `json:"ends"`  // duration in seconds
 }
 
-// SetInterestGroupTrackingWithParams - Enables/Disables issuing of interestGroupAccessed events.
-func (c *Storage) SetInterestGroupTrackingWithParams(ctx context.Context, v *StorageSetInterestGroupTrackingParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setInterestGroupTracking", Params: v})
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingAggregatableDebugReportingData struct {
+	KeyPiece string   `json:"keyPiece"` //
+	Value    float64  `json:"value"`    // number instead of integer because not all uint32 can be represented by int
+	Types    []string `json:"types"`    //
 }
 
-// SetInterestGroupTracking - Enables/Disables issuing of interestGroupAccessed events.
-// enable -
-func (c *Storage) SetInterestGroupTracking(ctx context.Context, enable bool) (*gcdmessage.ChromeResponse, error) {
-	var v StorageSetInterestGroupTrackingParams
-	v.Enable = enable
-	return c.SetInterestGroupTrackingWithParams(ctx, &v)
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingAggregatableDebugReportingConfig struct {
+	Budget                       float64                                                      `json:"budget,omitempty"`                       // number instead of integer because not all uint32 can be represented by int, only present for source registrations
+	KeyPiece                     string                                                       `json:"keyPiece"`                               //
+	DebugData                    []*StorageAttributionReportingAggregatableDebugReportingData `json:"debugData"`                              //
+	AggregationCoordinatorOrigin string                                                       `json:"aggregationCoordinatorOrigin,omitempty"` //
 }
 
-type StorageGetSharedStorageMetadataParams struct {
-	//
-	OwnerOrigin string `json:"ownerOrigin"`
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionScopesData struct {
+	Values         []string `json:"values"`         //
+	Limit          float64  `json:"limit"`          // number instead of integer because not all uint32 can be represented by int
+	MaxEventStates float64  `json:"maxEventStates"` //
 }
 
-// GetSharedStorageMetadataWithParams - Gets metadata for an origin's shared storage.
-// Returns -  metadata -
-func (c *Storage) GetSharedStorageMetadataWithParams(ctx context.Context, v *StorageGetSharedStorageMetadataParams) (*StorageSharedStorageMetadata, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getSharedStorageMetadata", Params: v})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Metadata *StorageSharedStorageMetadata
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Metadata, nil
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingNamedBudgetDef struct {
+	Name   string `json:"name"`   //
+	Budget int    `json:"budget"` //
 }
 
-// GetSharedStorageMetadata - Gets metadata for an origin's shared storage.
-// ownerOrigin -
-// Returns -  metadata -
-func (c *Storage) GetSharedStorageMetadata(ctx context.Context, ownerOrigin string) (*StorageSharedStorageMetadata, error) {
-	var v StorageGetSharedStorageMetadataParams
-	v.OwnerOrigin = ownerOrigin
-	return c.GetSharedStorageMetadataWithParams(ctx, &v)
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingSourceRegistration struct {
+	Time                             float64                                                      `json:"time"`                             //
+	Expiry                           int                                                          `json:"expiry"`                           // duration in seconds
+	TriggerData                      []float64                                                    `json:"triggerData"`                      // number instead of integer because not all uint32 can be represented by int
+	EventReportWindows               *StorageAttributionReportingEventReportWindows               `json:"eventReportWindows"`               //
+	AggregatableReportWindow         int                                                          `json:"aggregatableReportWindow"`         // duration in seconds
+	Type                             StorageAttributionReportingSourceType                        `json:"type"`                             //  enum values: navigation, event
+	SourceOrigin                     string                                                       `json:"sourceOrigin"`                     //
+	ReportingOrigin                  string                                                       `json:"reportingOrigin"`                  //
+	DestinationSites                 []string                                                     `json:"destinationSites"`                 //
+	EventId                          string                                                       `json:"eventId"`                          //
+	Priority                         string                                                       `json:"priority"`                         //
+	FilterData                       []*StorageAttributionReportingFilterDataEntry                `json:"filterData"`                       //
+	AggregationKeys                  []*StorageAttributionReportingAggregationKeysEntry           `json:"aggregationKeys"`                  //
+	DebugKey                         string                                                       `json:"debugKey,omitempty"`               //
+	TriggerDataMatching              StorageAttributionReportingTriggerDataMatching               `json:"triggerDataMatching"`              //  enum values: exact, modulus
+	DestinationLimitPriority         string                                                       `json:"destinationLimitPriority"`         //
+	AggregatableDebugReportingConfig *StorageAttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"` //
+	ScopesData                       *StorageAttributionScopesData                                `json:"scopesData,omitempty"`             //
+	MaxEventLevelReports             int                                                          `json:"maxEventLevelReports"`             //
+	NamedBudgets                     []*StorageAttributionReportingNamedBudgetDef                 `json:"namedBudgets"`                     //
+	DebugReporting                   bool                                                         `json:"debugReporting"`                   //
+	EventLevelEpsilon                float64                                                      `json:"eventLevelEpsilon"`                //
 }
 
-type StorageGetSharedStorageEntriesParams struct {
-	//
-	OwnerOrigin string `json:"ownerOrigin"`
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingAggregatableValueDictEntry struct {
+	Key         string  `json:"key"`         //
+	Value       float64 `json:"value"`       // number instead of integer because not all uint32 can be represented by int
+	FilteringId string  `json:"filteringId"` //
 }
 
-// GetSharedStorageEntriesWithParams - Gets the entries in an given origin's shared storage.
-// Returns -  entries -
-func (c *Storage) GetSharedStorageEntriesWithParams(ctx context.Context, v *StorageGetSharedStorageEntriesParams) ([]*StorageSharedStorageEntry, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getSharedStorageEntries", Params: v})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Entries []*StorageSharedStorageEntry
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Entries, nil
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingAggregatableValueEntry struct {
+	Values  []*StorageAttributionReportingAggregatableValueDictEntry `json:"values"`  //
+	Filters *StorageAttributionReportingFilterPair                   `json:"filters"` //
 }
 
-// GetSharedStorageEntries - Gets the entries in an given origin's shared storage.
-// ownerOrigin -
-// Returns -  entries -
-func (c *Storage) GetSharedStorageEntries(ctx context.Context, ownerOrigin string) ([]*StorageSharedStorageEntry, error) {
-	var v StorageGetSharedStorageEntriesParams
-	v.OwnerOrigin = ownerOrigin
-	return c.GetSharedStorageEntriesWithParams(ctx, &v)
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingEventTriggerData struct {
+	Data     string                                 `json:"data"`               //
+	Priority string                                 `json:"priority"`           //
+	DedupKey string                                 `json:"dedupKey,omitempty"` //
+	Filters  *StorageAttributionReportingFilterPair `json:"filters"`            //
 }
 
-type StorageSetSharedStorageEntryParams struct {
-	//
-	OwnerOrigin string `json:"ownerOrigin"`
-	//
-	Key string `json:"key"`
-	//
-	Value string `json:"value"`
-	// If `ignoreIfPresent` is included and true, then only sets the entry if `key` doesn't already exist.
-	IgnoreIfPresent bool `json:"ignoreIfPresent,omitempty"`
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingAggregatableTriggerData struct {
+	KeyPiece   string                                 `json:"keyPiece"`   //
+	SourceKeys []string                               `json:"sourceKeys"` //
+	Filters    *StorageAttributionReportingFilterPair `json:"filters"`    //
 }
 
-// SetSharedStorageEntryWithParams - Sets entry with `key` and `value` for a given origin's shared storage.
-func (c *Storage) SetSharedStorageEntryWithParams(ctx context.Context, v *StorageSetSharedStorageEntryParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setSharedStorageEntry", Params: v})
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingAggregatableDedupKey struct {
+	DedupKey string                                 `json:"dedupKey,omitempty"` //
+	Filters  *StorageAttributionReportingFilterPair `json:"filters"`            //
 }
 
-// SetSharedStorageEntry - Sets entry with `key` and `value` for a given origin's shared storage.
-// ownerOrigin -
-// key -
-// value -
-// ignoreIfPresent - If `ignoreIfPresent` is included and true, then only sets the entry if `key` doesn't already exist.
-func (c *Storage) SetSharedStorageEntry(ctx context.Context, ownerOrigin string, key string, value string, ignoreIfPresent bool) (*gcdmessage.ChromeResponse, error) {
-	var v StorageSetSharedStorageEntryParams
-	v.OwnerOrigin = ownerOrigin
-	v.Key = key
-	v.Value = value
-	v.IgnoreIfPresent = ignoreIfPresent
-	return c.SetSharedStorageEntryWithParams(ctx, &v)
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingNamedBudgetCandidate struct {
+	Name    string                                 `json:"name,omitempty"` //
+	Filters *StorageAttributionReportingFilterPair `json:"filters"`        //
 }
 
-type StorageDeleteSharedStorageEntryParams struct {
-	//
-	OwnerOrigin string `json:"ownerOrigin"`
-	//
-	Key string `json:"key"`
-}
+// No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingTriggerRegistration struct {
+	Filters                          *StorageAttributionReportingFilterPair                       `json:"filters"`                                //
+	DebugKey                         string                                                       `json:"debugKey,omitempty"`                     //
+	AggregatableDedupKeys            []*StorageAttributionReportingAggregatableDedupKey           `json:"aggregatableDedupKeys"`                  //
+	EventTriggerData                 []*StorageAttributionReportingEventTriggerData               `json:"eventTriggerData"`                       //
+	AggregatableTriggerData          []*StorageAttributionReportingAggregatableTriggerData        `json:"aggregatableTriggerData"`                //
+	AggregatableValues               []*StorageAttributionReportingAggregatableValueEntry         `json:"aggregatableValues"`                     //
+	AggregatableFilteringIdMaxBytes  int                                                          `json:"aggregatableFilteringIdMaxBytes"`        //
+	DebugReporting                   bool                                                         `json:"debugReporting"`                         //
+	AggregationCoordinatorOrigin     string                                                       `json:"aggregationCoordinatorOrigin,omitempty"` //
+	SourceRegistrationTimeConfig     StorageAttributionReportingSourceRegistrationTimeConfig      `json:"sourceRegistrationTimeConfig"`           //  enum values: include, exclude
+	TriggerContextId                 string                                                       `json:"triggerContextId,omitempty"`             //
+	AggregatableDebugReportingConfig *StorageAttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`       //
+	Scopes                           []string                                                     `json:"scopes"`                                 //
+	NamedBudgets                     []*StorageAttributionReportingNamedBudgetCandidate           `json:"namedBudgets"`                           //
+}
+
+// A single Related Website Set object.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageRelatedWebsiteSet struct {
+	PrimarySites    []string `json:"primarySites"`    // The primary site of this set, along with the ccTLDs if there is any.
+	AssociatedSites []string `json:"associatedSites"` // The associated sites of this set, along with the ccTLDs if there is any.
+	ServiceSites    []string `json:"serviceSites"`    // The service sites of this set, along with the ccTLDs if there is any.
+}
+
+// Event method names for the Storage domain
+const (
+	EventStorageCacheStorageContentUpdated                     = "Storage.cacheStorageContentUpdated"
+	EventStorageCacheStorageListUpdated                        = "Storage.cacheStorageListUpdated"
+	EventStorageIndexedDBContentUpdated                        = "Storage.indexedDBContentUpdated"
+	EventStorageIndexedDBListUpdated                           = "Storage.indexedDBListUpdated"
+	EventStorageInterestGroupAccessed                          = "Storage.interestGroupAccessed"
+	EventStorageInterestGroupAuctionEventOccurred              = "Storage.interestGroupAuctionEventOccurred"
+	EventStorageInterestGroupAuctionNetworkRequestCreated      = "Storage.interestGroupAuctionNetworkRequestCreated"
+	EventStorageSharedStorageAccessed                          = "Storage.sharedStorageAccessed"
+	EventStorageSharedStorageWorkletOperationExecutionFinished = "Storage.sharedStorageWorkletOperationExecutionFinished"
+	EventStorageStorageBucketCreatedOrUpdated                  = "Storage.storageBucketCreatedOrUpdated"
+	EventStorageStorageBucketDeleted                           = "Storage.storageBucketDeleted"
+	EventStorageAttributionReportingSourceRegistered           = "Storage.attributionReportingSourceRegistered"
+	EventStorageAttributionReportingTriggerRegistered          = "Storage.attributionReportingTriggerRegistered"
+	EventStorageAttributionReportingReportSent                 = "Storage.attributionReportingReportSent"
+	EventStorageAttributionReportingVerboseDebugReportSent     = "Storage.attributionReportingVerboseDebugReportSent"
+)
 
-// DeleteSharedStorageEntryWithParams - Deletes entry for `key` (if it exists) for a given origin's shared storage.
-func (c *Storage) DeleteSharedStorageEntryWithParams(ctx context.Context, v *StorageDeleteSharedStorageEntryParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.deleteSharedStorageEntry", Params: v})
+// StorageEvents lists all event method names for the Storage domain
+var StorageEvents = []string{
+	EventStorageCacheStorageContentUpdated,
+	EventStorageCacheStorageListUpdated,
+	EventStorageIndexedDBContentUpdated,
+	EventStorageIndexedDBListUpdated,
+	EventStorageInterestGroupAccessed,
+	EventStorageInterestGroupAuctionEventOccurred,
+	EventStorageInterestGroupAuctionNetworkRequestCreated,
+	EventStorageSharedStorageAccessed,
+	EventStorageSharedStorageWorkletOperationExecutionFinished,
+	EventStorageStorageBucketCreatedOrUpdated,
+	EventStorageStorageBucketDeleted,
+	EventStorageAttributionReportingSourceRegistered,
+	EventStorageAttributionReportingTriggerRegistered,
+	EventStorageAttributionReportingReportSent,
+	EventStorageAttributionReportingVerboseDebugReportSent,
 }
 
-// DeleteSharedStorageEntry - Deletes entry for `key` (if it exists) for a given origin's shared storage.
-// ownerOrigin -
-// key -
-func (c *Storage) DeleteSharedStorageEntry(ctx context.Context, ownerOrigin string, key string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageDeleteSharedStorageEntryParams
-	v.OwnerOrigin = ownerOrigin
-	v.Key = key
-	return c.DeleteSharedStorageEntryWithParams(ctx, &v)
+// A cache's contents have been modified.
+type StorageCacheStorageContentUpdatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Origin     string `json:"origin"`     // Origin to update.
+		StorageKey string `json:"storageKey"` // Storage key to update.
+		BucketId   string `json:"bucketId"`   // Storage bucket to update.
+		CacheName  string `json:"cacheName"`  // Name of cache in origin.
+	} `json:"Params,omitempty"`
 }
 
-type StorageClearSharedStorageEntriesParams struct {
-	//
-	OwnerOrigin string `json:"ownerOrigin"`
+// A cache has been added/deleted.
+type StorageCacheStorageListUpdatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Origin     string `json:"origin"`     // Origin to update.
+		StorageKey string `json:"storageKey"` // Storage key to update.
+		BucketId   string `json:"bucketId"`   // Storage bucket to update.
+	} `json:"Params,omitempty"`
 }
 
-// ClearSharedStorageEntriesWithParams - Clears all entries for a given origin's shared storage.
-func (c *Storage) ClearSharedStorageEntriesWithParams(ctx context.Context, v *StorageClearSharedStorageEntriesParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearSharedStorageEntries", Params: v})
+// The origin's IndexedDB object store has been modified.
+type StorageIndexedDBContentUpdatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Origin          string `json:"origin"`          // Origin to update.
+		StorageKey      string `json:"storageKey"`      // Storage key to update.
+		BucketId        string `json:"bucketId"`        // Storage bucket to update.
+		DatabaseName    string `json:"databaseName"`    // Database to update.
+		ObjectStoreName string `json:"objectStoreName"` // ObjectStore to update.
+	} `json:"Params,omitempty"`
 }
 
-// ClearSharedStorageEntries - Clears all entries for a given origin's shared storage.
-// ownerOrigin -
-func (c *Storage) ClearSharedStorageEntries(ctx context.Context, ownerOrigin string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageClearSharedStorageEntriesParams
-	v.OwnerOrigin = ownerOrigin
-	return c.ClearSharedStorageEntriesWithParams(ctx, &v)
+// The origin's IndexedDB database list has been modified.
+type StorageIndexedDBListUpdatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Origin     string `json:"origin"`     // Origin to update.
+		StorageKey string `json:"storageKey"` // Storage key to update.
+		BucketId   string `json:"bucketId"`   // Storage bucket to update.
+	} `json:"Params,omitempty"`
 }
 
-type StorageResetSharedStorageBudgetParams struct {
-	//
-	OwnerOrigin string `json:"ownerOrigin"`
+// One of the interest groups was accessed. Note that these events are global to all targets sharing an interest group store.
+type StorageInterestGroupAccessedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		AccessTime            float64                        `json:"accessTime"`                      //
+		Type                  StorageInterestGroupAccessType `json:"type"`                            //  enum values: join, leave, update, loaded, bid, win, additionalBid, additionalBidWin, topLevelBid, topLevelAdditionalBid, clear
+		OwnerOrigin           string                         `json:"ownerOrigin"`                     //
+		Name                  string                         `json:"name"`                            //
+		ComponentSellerOrigin string                         `json:"componentSellerOrigin,omitempty"` // For topLevelBid/topLevelAdditionalBid, and when appropriate, win and additionalBidWin
+		Bid                   float64                        `json:"bid,omitempty"`                   // For bid or somethingBid event, if done locally and not on a server.
+		BidCurrency           string                         `json:"bidCurrency,omitempty"`           //
+		UniqueAuctionId       string                         `json:"uniqueAuctionId,omitempty"`       // For non-global events --- links to interestGroupAuctionEvent
+	} `json:"Params,omitempty"`
 }
 
-// ResetSharedStorageBudgetWithParams - Resets the budget for `ownerOrigin` by clearing all budget withdrawals.
-func (c *Storage) ResetSharedStorageBudgetWithParams(ctx context.Context, v *StorageResetSharedStorageBudgetParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.resetSharedStorageBudget", Params: v})
+// An auction involving interest groups is taking place. These events are target-specific.
+type StorageInterestGroupAuctionEventOccurredEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		EventTime       float64                              `json:"eventTime"`                 //
+		Type            StorageInterestGroupAuctionEventType `json:"type"`                      //  enum values: started, configResolved
+		UniqueAuctionId string                               `json:"uniqueAuctionId"`           //
+		ParentAuctionId string                               `json:"parentAuctionId,omitempty"` // Set for child auctions.
+		AuctionConfig   map[string]interface{}               `json:"auctionConfig,omitempty"`   // Set for started and configResolved
+	} `json:"Params,omitempty"`
 }
 
-// ResetSharedStorageBudget - Resets the budget for `ownerOrigin` by clearing all budget withdrawals.
-// ownerOrigin -
-func (c *Storage) ResetSharedStorageBudget(ctx context.Context, ownerOrigin string) (*gcdmessage.ChromeResponse, error) {
-	var v StorageResetSharedStorageBudgetParams
-	v.OwnerOrigin = ownerOrigin
-	return c.ResetSharedStorageBudgetWithParams(ctx, &v)
+// Specifies which auctions a particular network fetch may be related to, and in what role. Note that it is not ordered with respect to Network.requestWillBeSent (but will happen before loadingFinished loadingFailed).
+type StorageInterestGroupAuctionNetworkRequestCreatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Type      StorageInterestGroupAuctionFetchType `json:"type"`      //  enum values: bidderJs, bidderWasm, sellerJs, bidderTrustedSignals, sellerTrustedSignals
+		RequestId string                               `json:"requestId"` //
+		Auctions  []string                             `json:"auctions"`  // This is the set of the auctions using the worklet that issued this request.  In the case of trusted signals, it's possible that only some of them actually care about the keys being queried.
+	} `json:"Params,omitempty"`
 }
 
-type StorageSetSharedStorageTrackingParams struct {
-	//
-	Enable bool `json:"enable"`
+// Shared storage was accessed by the associated page. The following parameters are included in all events.
+type StorageSharedStorageAccessedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		AccessTime  float64                           `json:"accessTime"`  // Time of the access.
+		Scope       StorageSharedStorageAccessScope   `json:"scope"`       // Enum value indicating the access scope. enum values: window, sharedStorageWorklet, protectedAudienceWorklet, header
+		Method      StorageSharedStorageAccessMethod  `json:"method"`      // Enum value indicating the Shared Storage API method invoked. enum values: addModule, createWorklet, selectURL, run, batchUpdate, set, append, delete, clear, get, keys, values, entries, length, remainingBudget
+		MainFrameId string                            `json:"mainFrameId"` // DevTools Frame Token for the primary frame tree's root.
+		OwnerOrigin string                            `json:"ownerOrigin"` // Serialization of the origin owning the Shared Storage data.
+		OwnerSite   string                            `json:"ownerSite"`   // Serialization of the site owning the Shared Storage data.
+		Params      *StorageSharedStorageAccessParams `json:"params"`      // The sub-parameters wrapped by `params` are all optional and their presence/absence depends on `type`.
+	} `json:"Params,omitempty"`
 }
 
-// SetSharedStorageTrackingWithParams - Enables/disables issuing of sharedStorageAccessed events.
-func (c *Storage) SetSharedStorageTrackingWithParams(ctx context.Context, v *StorageSetSharedStorageTrackingParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setSharedStorageTracking", Params: v})
+// A shared storage run or selectURL operation finished its execution. The following parameters are included in all events.
+type StorageSharedStorageWorkletOperationExecutionFinishedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		FinishedTime    float64                          `json:"finishedTime"`    // Time that the operation finished.
+		ExecutionTime   int                              `json:"executionTime"`   // Time, in microseconds, from start of shared storage JS API call until end of operation execution in the worklet.
+		Method          StorageSharedStorageAccessMethod `json:"method"`          // Enum value indicating the Shared Storage API method invoked. enum values: addModule, createWorklet, selectURL, run, batchUpdate, set, append, delete, clear, get, keys, values, entries, length, remainingBudget
+		OperationId     string                           `json:"operationId"`     // ID of the operation call.
+		WorkletTargetId string                           `json:"workletTargetId"` // Hex representation of the DevTools token used as the TargetID for the associated shared storage worklet.
+		MainFrameId     string                           `json:"mainFrameId"`     // DevTools Frame Token for the primary frame tree's root.
+		OwnerOrigin     string                           `json:"ownerOrigin"`     // Serialization of the origin owning the Shared Storage data.
+	} `json:"Params,omitempty"`
 }
 
-// SetSharedStorageTracking - Enables/disables issuing of sharedStorageAccessed events.
-// enable -
-func (c *Storage) SetSharedStorageTracking(ctx context.Context, enable bool) (*gcdmessage.ChromeResponse, error) {
-	var v StorageSetSharedStorageTrackingParams
-	v.Enable = enable
-	return c.SetSharedStorageTrackingWithParams(ctx, &v)
+type StorageStorageBucketCreatedOrUpdatedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		BucketInfo *StorageStorageBucketInfo `json:"bucketInfo"` //
+	} `json:"Params,omitempty"`
 }
 
-type StorageSetStorageBucketTrackingParams struct {
-	//
-	StorageKey string `json:"storageKey"`
-	//
-	Enable bool `json:"enable"`
+type StorageStorageBucketDeletedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		BucketId string `json:"bucketId"` //
+	} `json:"Params,omitempty"`
 }
 
-// SetStorageBucketTrackingWithParams - Set tracking for a storage key's buckets.
-func (c *Storage) SetStorageBucketTrackingWithParams(ctx context.Context, v *StorageSetStorageBucketTrackingParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setStorageBucketTracking", Params: v})
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingSourceRegisteredEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Registration *StorageAttributionReportingSourceRegistration      `json:"registration"` //
+		Result       StorageAttributionReportingSourceRegistrationResult `json:"result"`       //  enum values: success, internalError, insufficientSourceCapacity, insufficientUniqueDestinationCapacity, excessiveReportingOrigins, prohibitedByBrowserPolicy, successNoised, destinationReportingLimitReached, destinationGlobalLimitReached, destinationBothLimitsReached, reportingOriginsPerSiteLimitReached, exceedsMaxChannelCapacity, exceedsMaxScopesChannelCapacity, exceedsMaxTriggerStateCardinality, exceedsMaxEventStatesLimit, destinationPerDayReportingLimitReached
+	} `json:"Params,omitempty"`
 }
 
-// SetStorageBucketTracking - Set tracking for a storage key's buckets.
-// storageKey -
-// enable -
-func (c *Storage) SetStorageBucketTracking(ctx context.Context, storageKey string, enable bool) (*gcdmessage.ChromeResponse, error) {
-	var v StorageSetStorageBucketTrackingParams
-	v.StorageKey = storageKey
-	v.Enable = enable
-	return c.SetStorageBucketTrackingWithParams(ctx, &v)
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingTriggerRegisteredEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Registration *StorageAttributionReportingTriggerRegistration `json:"registration"` //
+		EventLevel   StorageAttributionReportingEventLevelResult     `json:"eventLevel"`   //  enum values: success, successDroppedLowerPriority, internalError, noCapacityForAttributionDestination, noMatchingSources, deduplicated, excessiveAttributions, priorityTooLow, neverAttributedSource, excessiveReportingOrigins, noMatchingSourceFilterData, prohibitedByBrowserPolicy, noMatchingConfigurations, excessiveReports, falselyAttributedSource, reportWindowPassed, notRegistered, reportWindowNotStarted, noMatchingTriggerData
+		Aggregatable StorageAttributionReportingAggregatableResult   `json:"aggregatable"` //  enum values: success, internalError, noCapacityForAttributionDestination, noMatchingSources, excessiveAttributions, excessiveReportingOrigins, noHistograms, insufficientBudget, insufficientNamedBudget, noMatchingSourceFilterData, notRegistered, prohibitedByBrowserPolicy, deduplicated, reportWindowPassed, excessiveReports
+	} `json:"Params,omitempty"`
 }
 
-type StorageDeleteStorageBucketParams struct {
-	//
-	Bucket *StorageStorageBucket `json:"bucket"`
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingReportSentEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Url            string                                  `json:"url"`                      //
+		Body           map[string]interface{}                  `json:"body"`                     //
+		Result         StorageAttributionReportingReportResult `json:"result"`                   //  enum values: sent, prohibited, failedToAssemble, expired
+		NetError       int                                     `json:"netError,omitempty"`       // If result is `sent`, populated with net/HTTP status.
+		NetErrorName   string                                  `json:"netErrorName,omitempty"`   //
+		HttpStatusCode int                                     `json:"httpStatusCode,omitempty"` //
+	} `json:"Params,omitempty"`
 }
 
-// DeleteStorageBucketWithParams - Deletes the Storage Bucket with the given storage key and bucket name.
-func (c *Storage) DeleteStorageBucketWithParams(ctx context.Context, v *StorageDeleteStorageBucketParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.deleteStorageBucket", Params: v})
+// Experimental: this may change or be removed in a future Chrome release.
+type StorageAttributionReportingVerboseDebugReportSentEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Url            string                   `json:"url"`                      //
+		Body           []map[string]interface{} `json:"body,omitempty"`           //
+		NetError       int                      `json:"netError,omitempty"`       //
+		NetErrorName   string                   `json:"netErrorName,omitempty"`   //
+		HttpStatusCode int                      `json:"httpStatusCode,omitempty"` //
+	} `json:"Params,omitempty"`
 }
 
-// DeleteStorageBucket - Deletes the Storage Bucket with the given storage key and bucket name.
-// bucket -
-func (c *Storage) DeleteStorageBucket(ctx context.Context, bucket *StorageStorageBucket) (*gcdmessage.ChromeResponse, error) {
-	var v StorageDeleteStorageBucketParams
-	v.Bucket = bucket
-	return c.DeleteStorageBucketWithParams(ctx, &v)
+// Storage domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type Storage struct {
+	target gcdmessage.ChromeTargeter
 }
 
-// RunBounceTrackingMitigations - Deletes state for sites identified as potential bounce trackers, immediately.
-// Returns -  deletedSites -
-func (c *Storage) RunBounceTrackingMitigations(ctx context.Context) ([]string, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.runBounceTrackingMitigations"})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			DeletedSites []string
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.DeletedSites, nil
+func NewStorage(target gcdmessage.ChromeTargeter) *Storage {
+	c := &Storage{target: target}
+	return c
 }
@@ -0,0 +1,1268 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains Storage functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnCacheStorageContentUpdated subscribes to Storage.cacheStorageContentUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnCacheStorageContentUpdated(callback func(*StorageCacheStorageContentUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageCacheStorageContentUpdated, func(payload []byte) error {
+		event := &StorageCacheStorageContentUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnCacheStorageListUpdated subscribes to Storage.cacheStorageListUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnCacheStorageListUpdated(callback func(*StorageCacheStorageListUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageCacheStorageListUpdated, func(payload []byte) error {
+		event := &StorageCacheStorageListUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnIndexedDBContentUpdated subscribes to Storage.indexedDBContentUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnIndexedDBContentUpdated(callback func(*StorageIndexedDBContentUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageIndexedDBContentUpdated, func(payload []byte) error {
+		event := &StorageIndexedDBContentUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnIndexedDBListUpdated subscribes to Storage.indexedDBListUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnIndexedDBListUpdated(callback func(*StorageIndexedDBListUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageIndexedDBListUpdated, func(payload []byte) error {
+		event := &StorageIndexedDBListUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnInterestGroupAccessed subscribes to Storage.interestGroupAccessed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnInterestGroupAccessed(callback func(*StorageInterestGroupAccessedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageInterestGroupAccessed, func(payload []byte) error {
+		event := &StorageInterestGroupAccessedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnInterestGroupAuctionEventOccurred subscribes to Storage.interestGroupAuctionEventOccurred events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnInterestGroupAuctionEventOccurred(callback func(*StorageInterestGroupAuctionEventOccurredEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageInterestGroupAuctionEventOccurred, func(payload []byte) error {
+		event := &StorageInterestGroupAuctionEventOccurredEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnInterestGroupAuctionNetworkRequestCreated subscribes to Storage.interestGroupAuctionNetworkRequestCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnInterestGroupAuctionNetworkRequestCreated(callback func(*StorageInterestGroupAuctionNetworkRequestCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageInterestGroupAuctionNetworkRequestCreated, func(payload []byte) error {
+		event := &StorageInterestGroupAuctionNetworkRequestCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSharedStorageAccessed subscribes to Storage.sharedStorageAccessed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnSharedStorageAccessed(callback func(*StorageSharedStorageAccessedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageSharedStorageAccessed, func(payload []byte) error {
+		event := &StorageSharedStorageAccessedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnSharedStorageWorkletOperationExecutionFinished subscribes to Storage.sharedStorageWorkletOperationExecutionFinished events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnSharedStorageWorkletOperationExecutionFinished(callback func(*StorageSharedStorageWorkletOperationExecutionFinishedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageSharedStorageWorkletOperationExecutionFinished, func(payload []byte) error {
+		event := &StorageSharedStorageWorkletOperationExecutionFinishedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnStorageBucketCreatedOrUpdated subscribes to Storage.storageBucketCreatedOrUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnStorageBucketCreatedOrUpdated(callback func(*StorageStorageBucketCreatedOrUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageStorageBucketCreatedOrUpdated, func(payload []byte) error {
+		event := &StorageStorageBucketCreatedOrUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnStorageBucketDeleted subscribes to Storage.storageBucketDeleted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Storage) OnStorageBucketDeleted(callback func(*StorageStorageBucketDeletedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageStorageBucketDeleted, func(payload []byte) error {
+		event := &StorageStorageBucketDeletedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAttributionReportingSourceRegistered subscribes to Storage.attributionReportingSourceRegistered events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) OnAttributionReportingSourceRegistered(callback func(*StorageAttributionReportingSourceRegisteredEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageAttributionReportingSourceRegistered, func(payload []byte) error {
+		event := &StorageAttributionReportingSourceRegisteredEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAttributionReportingTriggerRegistered subscribes to Storage.attributionReportingTriggerRegistered events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) OnAttributionReportingTriggerRegistered(callback func(*StorageAttributionReportingTriggerRegisteredEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageAttributionReportingTriggerRegistered, func(payload []byte) error {
+		event := &StorageAttributionReportingTriggerRegisteredEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAttributionReportingReportSent subscribes to Storage.attributionReportingReportSent events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) OnAttributionReportingReportSent(callback func(*StorageAttributionReportingReportSentEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageAttributionReportingReportSent, func(payload []byte) error {
+		event := &StorageAttributionReportingReportSentEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAttributionReportingVerboseDebugReportSent subscribes to Storage.attributionReportingVerboseDebugReportSent events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) OnAttributionReportingVerboseDebugReportSent(callback func(*StorageAttributionReportingVerboseDebugReportSentEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventStorageAttributionReportingVerboseDebugReportSent, func(payload []byte) error {
+		event := &StorageAttributionReportingVerboseDebugReportSentEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type StorageGetStorageKeyForFrameParams struct {
+	//
+	FrameId string `json:"frameId"`
+}
+
+// GetStorageKeyForFrameWithParams - Returns a storage key given a frame id.
+// Returns -  storageKey -
+func (c *Storage) GetStorageKeyForFrameWithParams(ctx context.Context, v *StorageGetStorageKeyForFrameParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getStorageKeyForFrame", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			StorageKey string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.StorageKey, nil
+}
+
+// GetStorageKeyForFrame - Returns a storage key given a frame id.
+// frameId -
+// Returns -  storageKey -
+func (c *Storage) GetStorageKeyForFrame(ctx context.Context, frameId string) (string, error) {
+	var v StorageGetStorageKeyForFrameParams
+	v.FrameId = frameId
+	return c.GetStorageKeyForFrameWithParams(ctx, &v)
+}
+
+type StorageClearDataForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+	// Comma separated list of StorageType to clear.
+	StorageTypes string `json:"storageTypes"`
+}
+
+// ClearDataForOriginWithParams - Clears storage for origin.
+func (c *Storage) ClearDataForOriginWithParams(ctx context.Context, v *StorageClearDataForOriginParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearDataForOrigin", Params: v})
+}
+
+// ClearDataForOrigin - Clears storage for origin.
+// origin - Security origin.
+// storageTypes - Comma separated list of StorageType to clear.
+func (c *Storage) ClearDataForOrigin(ctx context.Context, origin string, storageTypes string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageClearDataForOriginParams
+	v.Origin = origin
+	v.StorageTypes = storageTypes
+	return c.ClearDataForOriginWithParams(ctx, &v)
+}
+
+type StorageClearDataForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+	// Comma separated list of StorageType to clear.
+	StorageTypes string `json:"storageTypes"`
+}
+
+// ClearDataForStorageKeyWithParams - Clears storage for storage key.
+func (c *Storage) ClearDataForStorageKeyWithParams(ctx context.Context, v *StorageClearDataForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearDataForStorageKey", Params: v})
+}
+
+// ClearDataForStorageKey - Clears storage for storage key.
+// storageKey - Storage key.
+// storageTypes - Comma separated list of StorageType to clear.
+func (c *Storage) ClearDataForStorageKey(ctx context.Context, storageKey string, storageTypes string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageClearDataForStorageKeyParams
+	v.StorageKey = storageKey
+	v.StorageTypes = storageTypes
+	return c.ClearDataForStorageKeyWithParams(ctx, &v)
+}
+
+type StorageGetCookiesParams struct {
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextId string `json:"browserContextId,omitempty"`
+}
+
+// GetCookiesWithParams - Returns all browser cookies.
+// Returns -  cookies - Array of cookie objects.
+func (c *Storage) GetCookiesWithParams(ctx context.Context, v *StorageGetCookiesParams) ([]*NetworkCookie, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getCookies", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Cookies []*NetworkCookie
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Cookies, nil
+}
+
+// GetCookies - Returns all browser cookies.
+// browserContextId - Browser context to use when called on the browser endpoint.
+// Returns -  cookies - Array of cookie objects.
+func (c *Storage) GetCookies(ctx context.Context, browserContextId string) ([]*NetworkCookie, error) {
+	var v StorageGetCookiesParams
+	v.BrowserContextId = browserContextId
+	return c.GetCookiesWithParams(ctx, &v)
+}
+
+type StorageSetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []*NetworkCookieParam `json:"cookies"`
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextId string `json:"browserContextId,omitempty"`
+}
+
+// SetCookiesWithParams - Sets given cookies.
+func (c *Storage) SetCookiesWithParams(ctx context.Context, v *StorageSetCookiesParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setCookies", Params: v})
+}
+
+// SetCookies - Sets given cookies.
+// cookies - Cookies to be set.
+// browserContextId - Browser context to use when called on the browser endpoint.
+func (c *Storage) SetCookies(ctx context.Context, cookies []*NetworkCookieParam, browserContextId string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetCookiesParams
+	v.Cookies = cookies
+	v.BrowserContextId = browserContextId
+	return c.SetCookiesWithParams(ctx, &v)
+}
+
+type StorageClearCookiesParams struct {
+	// Browser context to use when called on the browser endpoint.
+	BrowserContextId string `json:"browserContextId,omitempty"`
+}
+
+// ClearCookiesWithParams - Clears cookies.
+func (c *Storage) ClearCookiesWithParams(ctx context.Context, v *StorageClearCookiesParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearCookies", Params: v})
+}
+
+// ClearCookies - Clears cookies.
+// browserContextId - Browser context to use when called on the browser endpoint.
+func (c *Storage) ClearCookies(ctx context.Context, browserContextId string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageClearCookiesParams
+	v.BrowserContextId = browserContextId
+	return c.ClearCookiesWithParams(ctx, &v)
+}
+
+type StorageGetUsageAndQuotaParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// GetUsageAndQuotaWithParams - Returns usage and quota in bytes.
+// Returns -  usage - Storage usage (bytes). quota - Storage quota (bytes). overrideActive - Whether or not the origin has an active storage quota override usageBreakdown - Storage usage per type (bytes).
+func (c *Storage) GetUsageAndQuotaWithParams(ctx context.Context, v *StorageGetUsageAndQuotaParams) (float64, float64, bool, []*StorageUsageForType, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getUsageAndQuota", Params: v})
+	if err != nil {
+		return 0, 0, false, nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Usage          float64
+			Quota          float64
+			OverrideActive bool
+			UsageBreakdown []*StorageUsageForType
+		}
+	}
+
+	if resp == nil {
+		return 0, 0, false, nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return 0, 0, false, nil, err
+	}
+
+	if chromeData.Error != nil {
+		return 0, 0, false, nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Usage, chromeData.Result.Quota, chromeData.Result.OverrideActive, chromeData.Result.UsageBreakdown, nil
+}
+
+// GetUsageAndQuota - Returns usage and quota in bytes.
+// origin - Security origin.
+// Returns -  usage - Storage usage (bytes). quota - Storage quota (bytes). overrideActive - Whether or not the origin has an active storage quota override usageBreakdown - Storage usage per type (bytes).
+func (c *Storage) GetUsageAndQuota(ctx context.Context, origin string) (float64, float64, bool, []*StorageUsageForType, error) {
+	var v StorageGetUsageAndQuotaParams
+	v.Origin = origin
+	return c.GetUsageAndQuotaWithParams(ctx, &v)
+}
+
+type StorageOverrideQuotaForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+	// The quota size (in bytes) to override the original quota with. If this is called multiple times, the overridden quota will be equal to the quotaSize provided in the final call. If this is called without specifying a quotaSize, the quota will be reset to the default value for the specified origin. If this is called multiple times with different origins, the override will be maintained for each origin until it is disabled (called without a quotaSize).
+	QuotaSize float64 `json:"quotaSize,omitempty"`
+}
+
+// OverrideQuotaForOriginWithParams - Override quota for the specified origin
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) OverrideQuotaForOriginWithParams(ctx context.Context, v *StorageOverrideQuotaForOriginParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.overrideQuotaForOrigin", Params: v})
+}
+
+// OverrideQuotaForOrigin - Override quota for the specified origin
+// origin - Security origin.
+// quotaSize - The quota size (in bytes) to override the original quota with. If this is called multiple times, the overridden quota will be equal to the quotaSize provided in the final call. If this is called without specifying a quotaSize, the quota will be reset to the default value for the specified origin. If this is called multiple times with different origins, the override will be maintained for each origin until it is disabled (called without a quotaSize).
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) OverrideQuotaForOrigin(ctx context.Context, origin string, quotaSize float64) (*gcdmessage.ChromeResponse, error) {
+	var v StorageOverrideQuotaForOriginParams
+	v.Origin = origin
+	v.QuotaSize = quotaSize
+	return c.OverrideQuotaForOriginWithParams(ctx, &v)
+}
+
+type StorageTrackCacheStorageForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// TrackCacheStorageForOriginWithParams - Registers origin to be notified when an update occurs to its cache storage list.
+func (c *Storage) TrackCacheStorageForOriginWithParams(ctx context.Context, v *StorageTrackCacheStorageForOriginParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.trackCacheStorageForOrigin", Params: v})
+}
+
+// TrackCacheStorageForOrigin - Registers origin to be notified when an update occurs to its cache storage list.
+// origin - Security origin.
+func (c *Storage) TrackCacheStorageForOrigin(ctx context.Context, origin string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageTrackCacheStorageForOriginParams
+	v.Origin = origin
+	return c.TrackCacheStorageForOriginWithParams(ctx, &v)
+}
+
+type StorageTrackCacheStorageForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// TrackCacheStorageForStorageKeyWithParams - Registers storage key to be notified when an update occurs to its cache storage list.
+func (c *Storage) TrackCacheStorageForStorageKeyWithParams(ctx context.Context, v *StorageTrackCacheStorageForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.trackCacheStorageForStorageKey", Params: v})
+}
+
+// TrackCacheStorageForStorageKey - Registers storage key to be notified when an update occurs to its cache storage list.
+// storageKey - Storage key.
+func (c *Storage) TrackCacheStorageForStorageKey(ctx context.Context, storageKey string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageTrackCacheStorageForStorageKeyParams
+	v.StorageKey = storageKey
+	return c.TrackCacheStorageForStorageKeyWithParams(ctx, &v)
+}
+
+type StorageTrackIndexedDBForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// TrackIndexedDBForOriginWithParams - Registers origin to be notified when an update occurs to its IndexedDB.
+func (c *Storage) TrackIndexedDBForOriginWithParams(ctx context.Context, v *StorageTrackIndexedDBForOriginParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.trackIndexedDBForOrigin", Params: v})
+}
+
+// TrackIndexedDBForOrigin - Registers origin to be notified when an update occurs to its IndexedDB.
+// origin - Security origin.
+func (c *Storage) TrackIndexedDBForOrigin(ctx context.Context, origin string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageTrackIndexedDBForOriginParams
+	v.Origin = origin
+	return c.TrackIndexedDBForOriginWithParams(ctx, &v)
+}
+
+type StorageTrackIndexedDBForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// TrackIndexedDBForStorageKeyWithParams - Registers storage key to be notified when an update occurs to its IndexedDB.
+func (c *Storage) TrackIndexedDBForStorageKeyWithParams(ctx context.Context, v *StorageTrackIndexedDBForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.trackIndexedDBForStorageKey", Params: v})
+}
+
+// TrackIndexedDBForStorageKey - Registers storage key to be notified when an update occurs to its IndexedDB.
+// storageKey - Storage key.
+func (c *Storage) TrackIndexedDBForStorageKey(ctx context.Context, storageKey string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageTrackIndexedDBForStorageKeyParams
+	v.StorageKey = storageKey
+	return c.TrackIndexedDBForStorageKeyWithParams(ctx, &v)
+}
+
+type StorageUntrackCacheStorageForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// UntrackCacheStorageForOriginWithParams - Unregisters origin from receiving notifications for cache storage.
+func (c *Storage) UntrackCacheStorageForOriginWithParams(ctx context.Context, v *StorageUntrackCacheStorageForOriginParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.untrackCacheStorageForOrigin", Params: v})
+}
+
+// UntrackCacheStorageForOrigin - Unregisters origin from receiving notifications for cache storage.
+// origin - Security origin.
+func (c *Storage) UntrackCacheStorageForOrigin(ctx context.Context, origin string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageUntrackCacheStorageForOriginParams
+	v.Origin = origin
+	return c.UntrackCacheStorageForOriginWithParams(ctx, &v)
+}
+
+type StorageUntrackCacheStorageForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// UntrackCacheStorageForStorageKeyWithParams - Unregisters storage key from receiving notifications for cache storage.
+func (c *Storage) UntrackCacheStorageForStorageKeyWithParams(ctx context.Context, v *StorageUntrackCacheStorageForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.untrackCacheStorageForStorageKey", Params: v})
+}
+
+// UntrackCacheStorageForStorageKey - Unregisters storage key from receiving notifications for cache storage.
+// storageKey - Storage key.
+func (c *Storage) UntrackCacheStorageForStorageKey(ctx context.Context, storageKey string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageUntrackCacheStorageForStorageKeyParams
+	v.StorageKey = storageKey
+	return c.UntrackCacheStorageForStorageKeyWithParams(ctx, &v)
+}
+
+type StorageUntrackIndexedDBForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+}
+
+// UntrackIndexedDBForOriginWithParams - Unregisters origin from receiving notifications for IndexedDB.
+func (c *Storage) UntrackIndexedDBForOriginWithParams(ctx context.Context, v *StorageUntrackIndexedDBForOriginParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.untrackIndexedDBForOrigin", Params: v})
+}
+
+// UntrackIndexedDBForOrigin - Unregisters origin from receiving notifications for IndexedDB.
+// origin - Security origin.
+func (c *Storage) UntrackIndexedDBForOrigin(ctx context.Context, origin string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageUntrackIndexedDBForOriginParams
+	v.Origin = origin
+	return c.UntrackIndexedDBForOriginWithParams(ctx, &v)
+}
+
+type StorageUntrackIndexedDBForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+// UntrackIndexedDBForStorageKeyWithParams - Unregisters storage key from receiving notifications for IndexedDB.
+func (c *Storage) UntrackIndexedDBForStorageKeyWithParams(ctx context.Context, v *StorageUntrackIndexedDBForStorageKeyParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.untrackIndexedDBForStorageKey", Params: v})
+}
+
+// UntrackIndexedDBForStorageKey - Unregisters storage key from receiving notifications for IndexedDB.
+// storageKey - Storage key.
+func (c *Storage) UntrackIndexedDBForStorageKey(ctx context.Context, storageKey string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageUntrackIndexedDBForStorageKeyParams
+	v.StorageKey = storageKey
+	return c.UntrackIndexedDBForStorageKeyWithParams(ctx, &v)
+}
+
+// GetTrustTokens - Returns the number of stored Trust Tokens per issuer for the current browsing context.
+// Returns -  tokens -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetTrustTokens(ctx context.Context) ([]*StorageTrustTokens, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getTrustTokens"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Tokens []*StorageTrustTokens
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Tokens, nil
+}
+
+type StorageClearTrustTokensParams struct {
+	//
+	IssuerOrigin string `json:"issuerOrigin"`
+}
+
+// ClearTrustTokensWithParams - Removes all Trust Tokens issued by the provided issuerOrigin. Leaves other stored data, including the issuer's Redemption Records, intact.
+// Returns -  didDeleteTokens - True if any tokens were deleted, false otherwise.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) ClearTrustTokensWithParams(ctx context.Context, v *StorageClearTrustTokensParams) (bool, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearTrustTokens", Params: v})
+	if err != nil {
+		return false, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			DidDeleteTokens bool
+		}
+	}
+
+	if resp == nil {
+		return false, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return false, err
+	}
+
+	if chromeData.Error != nil {
+		return false, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.DidDeleteTokens, nil
+}
+
+// ClearTrustTokens - Removes all Trust Tokens issued by the provided issuerOrigin. Leaves other stored data, including the issuer's Redemption Records, intact.
+// issuerOrigin -
+// Returns -  didDeleteTokens - True if any tokens were deleted, false otherwise.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) ClearTrustTokens(ctx context.Context, issuerOrigin string) (bool, error) {
+	var v StorageClearTrustTokensParams
+	v.IssuerOrigin = issuerOrigin
+	return c.ClearTrustTokensWithParams(ctx, &v)
+}
+
+type StorageGetInterestGroupDetailsParams struct {
+	//
+	OwnerOrigin string `json:"ownerOrigin"`
+	//
+	Name string `json:"name"`
+}
+
+// GetInterestGroupDetailsWithParams - Gets details for a named interest group.
+// Returns -  details - This largely corresponds to: https://wicg.github.io/turtledove/#dictdef-generatebidinterestgroup but has absolute expirationTime instead of relative lifetimeMs and also adds joiningOrigin.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetInterestGroupDetailsWithParams(ctx context.Context, v *StorageGetInterestGroupDetailsParams) (map[string]interface{}, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getInterestGroupDetails", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Details map[string]interface{}
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Details, nil
+}
+
+// GetInterestGroupDetails - Gets details for a named interest group.
+// ownerOrigin -
+// name -
+// Returns -  details - This largely corresponds to: https://wicg.github.io/turtledove/#dictdef-generatebidinterestgroup but has absolute expirationTime instead of relative lifetimeMs and also adds joiningOrigin.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetInterestGroupDetails(ctx context.Context, ownerOrigin string, name string) (map[string]interface{}, error) {
+	var v StorageGetInterestGroupDetailsParams
+	v.OwnerOrigin = ownerOrigin
+	v.Name = name
+	return c.GetInterestGroupDetailsWithParams(ctx, &v)
+}
+
+type StorageSetInterestGroupTrackingParams struct {
+	//
+	Enable bool `json:"enable"`
+}
+
+// SetInterestGroupTrackingWithParams - Enables/Disables issuing of interestGroupAccessed events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetInterestGroupTrackingWithParams(ctx context.Context, v *StorageSetInterestGroupTrackingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setInterestGroupTracking", Params: v})
+}
+
+// SetInterestGroupTracking - Enables/Disables issuing of interestGroupAccessed events.
+// enable -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetInterestGroupTracking(ctx context.Context, enable bool) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetInterestGroupTrackingParams
+	v.Enable = enable
+	return c.SetInterestGroupTrackingWithParams(ctx, &v)
+}
+
+type StorageSetInterestGroupAuctionTrackingParams struct {
+	//
+	Enable bool `json:"enable"`
+}
+
+// SetInterestGroupAuctionTrackingWithParams - Enables/Disables issuing of interestGroupAuctionEventOccurred and interestGroupAuctionNetworkRequestCreated.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetInterestGroupAuctionTrackingWithParams(ctx context.Context, v *StorageSetInterestGroupAuctionTrackingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setInterestGroupAuctionTracking", Params: v})
+}
+
+// SetInterestGroupAuctionTracking - Enables/Disables issuing of interestGroupAuctionEventOccurred and interestGroupAuctionNetworkRequestCreated.
+// enable -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetInterestGroupAuctionTracking(ctx context.Context, enable bool) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetInterestGroupAuctionTrackingParams
+	v.Enable = enable
+	return c.SetInterestGroupAuctionTrackingWithParams(ctx, &v)
+}
+
+type StorageGetSharedStorageMetadataParams struct {
+	//
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// GetSharedStorageMetadataWithParams - Gets metadata for an origin's shared storage.
+// Returns -  metadata -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetSharedStorageMetadataWithParams(ctx context.Context, v *StorageGetSharedStorageMetadataParams) (*StorageSharedStorageMetadata, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getSharedStorageMetadata", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Metadata *StorageSharedStorageMetadata
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Metadata, nil
+}
+
+// GetSharedStorageMetadata - Gets metadata for an origin's shared storage.
+// ownerOrigin -
+// Returns -  metadata -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetSharedStorageMetadata(ctx context.Context, ownerOrigin string) (*StorageSharedStorageMetadata, error) {
+	var v StorageGetSharedStorageMetadataParams
+	v.OwnerOrigin = ownerOrigin
+	return c.GetSharedStorageMetadataWithParams(ctx, &v)
+}
+
+type StorageGetSharedStorageEntriesParams struct {
+	//
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// GetSharedStorageEntriesWithParams - Gets the entries in an given origin's shared storage.
+// Returns -  entries -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetSharedStorageEntriesWithParams(ctx context.Context, v *StorageGetSharedStorageEntriesParams) ([]*StorageSharedStorageEntry, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getSharedStorageEntries", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Entries []*StorageSharedStorageEntry
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Entries, nil
+}
+
+// GetSharedStorageEntries - Gets the entries in an given origin's shared storage.
+// ownerOrigin -
+// Returns -  entries -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetSharedStorageEntries(ctx context.Context, ownerOrigin string) ([]*StorageSharedStorageEntry, error) {
+	var v StorageGetSharedStorageEntriesParams
+	v.OwnerOrigin = ownerOrigin
+	return c.GetSharedStorageEntriesWithParams(ctx, &v)
+}
+
+type StorageSetSharedStorageEntryParams struct {
+	//
+	OwnerOrigin string `json:"ownerOrigin"`
+	//
+	Key string `json:"key"`
+	//
+	Value string `json:"value"`
+	// If `ignoreIfPresent` is included and true, then only sets the entry if `key` doesn't already exist.
+	IgnoreIfPresent bool `json:"ignoreIfPresent,omitempty"`
+}
+
+// SetSharedStorageEntryWithParams - Sets entry with `key` and `value` for a given origin's shared storage.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetSharedStorageEntryWithParams(ctx context.Context, v *StorageSetSharedStorageEntryParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setSharedStorageEntry", Params: v})
+}
+
+// SetSharedStorageEntry - Sets entry with `key` and `value` for a given origin's shared storage.
+// ownerOrigin -
+// key -
+// value -
+// ignoreIfPresent - If `ignoreIfPresent` is included and true, then only sets the entry if `key` doesn't already exist.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetSharedStorageEntry(ctx context.Context, ownerOrigin string, key string, value string, ignoreIfPresent bool) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetSharedStorageEntryParams
+	v.OwnerOrigin = ownerOrigin
+	v.Key = key
+	v.Value = value
+	v.IgnoreIfPresent = ignoreIfPresent
+	return c.SetSharedStorageEntryWithParams(ctx, &v)
+}
+
+type StorageDeleteSharedStorageEntryParams struct {
+	//
+	OwnerOrigin string `json:"ownerOrigin"`
+	//
+	Key string `json:"key"`
+}
+
+// DeleteSharedStorageEntryWithParams - Deletes entry for `key` (if it exists) for a given origin's shared storage.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) DeleteSharedStorageEntryWithParams(ctx context.Context, v *StorageDeleteSharedStorageEntryParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.deleteSharedStorageEntry", Params: v})
+}
+
+// DeleteSharedStorageEntry - Deletes entry for `key` (if it exists) for a given origin's shared storage.
+// ownerOrigin -
+// key -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) DeleteSharedStorageEntry(ctx context.Context, ownerOrigin string, key string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageDeleteSharedStorageEntryParams
+	v.OwnerOrigin = ownerOrigin
+	v.Key = key
+	return c.DeleteSharedStorageEntryWithParams(ctx, &v)
+}
+
+type StorageClearSharedStorageEntriesParams struct {
+	//
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// ClearSharedStorageEntriesWithParams - Clears all entries for a given origin's shared storage.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) ClearSharedStorageEntriesWithParams(ctx context.Context, v *StorageClearSharedStorageEntriesParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.clearSharedStorageEntries", Params: v})
+}
+
+// ClearSharedStorageEntries - Clears all entries for a given origin's shared storage.
+// ownerOrigin -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) ClearSharedStorageEntries(ctx context.Context, ownerOrigin string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageClearSharedStorageEntriesParams
+	v.OwnerOrigin = ownerOrigin
+	return c.ClearSharedStorageEntriesWithParams(ctx, &v)
+}
+
+type StorageResetSharedStorageBudgetParams struct {
+	//
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// ResetSharedStorageBudgetWithParams - Resets the budget for `ownerOrigin` by clearing all budget withdrawals.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) ResetSharedStorageBudgetWithParams(ctx context.Context, v *StorageResetSharedStorageBudgetParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.resetSharedStorageBudget", Params: v})
+}
+
+// ResetSharedStorageBudget - Resets the budget for `ownerOrigin` by clearing all budget withdrawals.
+// ownerOrigin -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) ResetSharedStorageBudget(ctx context.Context, ownerOrigin string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageResetSharedStorageBudgetParams
+	v.OwnerOrigin = ownerOrigin
+	return c.ResetSharedStorageBudgetWithParams(ctx, &v)
+}
+
+type StorageSetSharedStorageTrackingParams struct {
+	//
+	Enable bool `json:"enable"`
+}
+
+// SetSharedStorageTrackingWithParams - Enables/disables issuing of sharedStorageAccessed events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetSharedStorageTrackingWithParams(ctx context.Context, v *StorageSetSharedStorageTrackingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setSharedStorageTracking", Params: v})
+}
+
+// SetSharedStorageTracking - Enables/disables issuing of sharedStorageAccessed events.
+// enable -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetSharedStorageTracking(ctx context.Context, enable bool) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetSharedStorageTrackingParams
+	v.Enable = enable
+	return c.SetSharedStorageTrackingWithParams(ctx, &v)
+}
+
+type StorageSetStorageBucketTrackingParams struct {
+	//
+	StorageKey string `json:"storageKey"`
+	//
+	Enable bool `json:"enable"`
+}
+
+// SetStorageBucketTrackingWithParams - Set tracking for a storage key's buckets.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetStorageBucketTrackingWithParams(ctx context.Context, v *StorageSetStorageBucketTrackingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setStorageBucketTracking", Params: v})
+}
+
+// SetStorageBucketTracking - Set tracking for a storage key's buckets.
+// storageKey -
+// enable -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetStorageBucketTracking(ctx context.Context, storageKey string, enable bool) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetStorageBucketTrackingParams
+	v.StorageKey = storageKey
+	v.Enable = enable
+	return c.SetStorageBucketTrackingWithParams(ctx, &v)
+}
+
+type StorageDeleteStorageBucketParams struct {
+	//
+	Bucket *StorageStorageBucket `json:"bucket"`
+}
+
+// DeleteStorageBucketWithParams - Deletes the Storage Bucket with the given storage key and bucket name.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) DeleteStorageBucketWithParams(ctx context.Context, v *StorageDeleteStorageBucketParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.deleteStorageBucket", Params: v})
+}
+
+// DeleteStorageBucket - Deletes the Storage Bucket with the given storage key and bucket name.
+// bucket -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) DeleteStorageBucket(ctx context.Context, bucket *StorageStorageBucket) (*gcdmessage.ChromeResponse, error) {
+	var v StorageDeleteStorageBucketParams
+	v.Bucket = bucket
+	return c.DeleteStorageBucketWithParams(ctx, &v)
+}
+
+// RunBounceTrackingMitigations - Deletes state for sites identified as potential bounce trackers, immediately.
+// Returns -  deletedSites -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) RunBounceTrackingMitigations(ctx context.Context) ([]string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.runBounceTrackingMitigations"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			DeletedSites []string
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.DeletedSites, nil
+}
+
+type StorageSetAttributionReportingLocalTestingModeParams struct {
+	// If enabled, noise is suppressed and reports are sent immediately.
+	Enabled bool `json:"enabled"`
+}
+
+// SetAttributionReportingLocalTestingModeWithParams - https://wicg.github.io/attribution-reporting-api/
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetAttributionReportingLocalTestingModeWithParams(ctx context.Context, v *StorageSetAttributionReportingLocalTestingModeParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setAttributionReportingLocalTestingMode", Params: v})
+}
+
+// SetAttributionReportingLocalTestingMode - https://wicg.github.io/attribution-reporting-api/
+// enabled - If enabled, noise is suppressed and reports are sent immediately.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetAttributionReportingLocalTestingMode(ctx context.Context, enabled bool) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetAttributionReportingLocalTestingModeParams
+	v.Enabled = enabled
+	return c.SetAttributionReportingLocalTestingModeWithParams(ctx, &v)
+}
+
+type StorageSetAttributionReportingTrackingParams struct {
+	//
+	Enable bool `json:"enable"`
+}
+
+// SetAttributionReportingTrackingWithParams - Enables/disables issuing of Attribution Reporting events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetAttributionReportingTrackingWithParams(ctx context.Context, v *StorageSetAttributionReportingTrackingParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setAttributionReportingTracking", Params: v})
+}
+
+// SetAttributionReportingTracking - Enables/disables issuing of Attribution Reporting events.
+// enable -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SetAttributionReportingTracking(ctx context.Context, enable bool) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetAttributionReportingTrackingParams
+	v.Enable = enable
+	return c.SetAttributionReportingTrackingWithParams(ctx, &v)
+}
+
+// SendPendingAttributionReports - Sends all pending Attribution Reports immediately, regardless of their scheduled report time.
+// Returns -  numSent - The number of reports that were sent.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) SendPendingAttributionReports(ctx context.Context) (int, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.sendPendingAttributionReports"})
+	if err != nil {
+		return 0, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			NumSent int
+		}
+	}
+
+	if resp == nil {
+		return 0, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return 0, err
+	}
+
+	if chromeData.Error != nil {
+		return 0, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.NumSent, nil
+}
+
+// GetRelatedWebsiteSets - Returns the effective Related Website Sets in use by this profile for the browser session. The effective Related Website Sets will not change during a browser session.
+// Returns -  sets -
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetRelatedWebsiteSets(ctx context.Context) ([]*StorageRelatedWebsiteSet, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getRelatedWebsiteSets"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Sets []*StorageRelatedWebsiteSet
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Sets, nil
+}
+
+type StorageGetAffectedUrlsForThirdPartyCookieMetadataParams struct {
+	// The URL of the page currently being visited.
+	FirstPartyUrl string `json:"firstPartyUrl"`
+	// The list of embedded resource URLs from the page.
+	ThirdPartyUrls []string `json:"thirdPartyUrls"`
+}
+
+// GetAffectedUrlsForThirdPartyCookieMetadataWithParams - Returns the list of URLs from a page and its embedded resources that match existing grace period URL pattern rules. https://developers.google.com/privacy-sandbox/cookies/temporary-exceptions/grace-period
+// Returns -  matchedUrls - Array of matching URLs. If there is a primary pattern match for the first- party URL, only the first-party URL is returned in the array.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetAffectedUrlsForThirdPartyCookieMetadataWithParams(ctx context.Context, v *StorageGetAffectedUrlsForThirdPartyCookieMetadataParams) ([]string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.getAffectedUrlsForThirdPartyCookieMetadata", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			MatchedUrls []string
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.MatchedUrls, nil
+}
+
+// GetAffectedUrlsForThirdPartyCookieMetadata - Returns the list of URLs from a page and its embedded resources that match existing grace period URL pattern rules. https://developers.google.com/privacy-sandbox/cookies/temporary-exceptions/grace-period
+// firstPartyUrl - The URL of the page currently being visited.
+// thirdPartyUrls - The list of embedded resource URLs from the page.
+// Returns -  matchedUrls - Array of matching URLs. If there is a primary pattern match for the first- party URL, only the first-party URL is returned in the array.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Storage) GetAffectedUrlsForThirdPartyCookieMetadata(ctx context.Context, firstPartyUrl string, thirdPartyUrls []string) ([]string, error) {
+	var v StorageGetAffectedUrlsForThirdPartyCookieMetadataParams
+	v.FirstPartyUrl = firstPartyUrl
+	v.ThirdPartyUrls = thirdPartyUrls
+	return c.GetAffectedUrlsForThirdPartyCookieMetadataWithParams(ctx, &v)
+}
+
+type StorageSetProtectedAudienceKAnonymityParams struct {
+	//
+	Owner string `json:"owner"`
+	//
+	Name string `json:"name"`
+	//
+	Hashes []string `json:"hashes"`
+}
+
+// SetProtectedAudienceKAnonymityWithParams -
+func (c *Storage) SetProtectedAudienceKAnonymityWithParams(ctx context.Context, v *StorageSetProtectedAudienceKAnonymityParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Storage.setProtectedAudienceKAnonymity", Params: v})
+}
+
+// SetProtectedAudienceKAnonymity -
+// owner -
+// name -
+// hashes -
+func (c *Storage) SetProtectedAudienceKAnonymity(ctx context.Context, owner string, name string, hashes []string) (*gcdmessage.ChromeResponse, error) {
+	var v StorageSetProtectedAudienceKAnonymityParams
+	v.Owner = owner
+	v.Name = name
+	v.Hashes = hashes
+	return c.SetProtectedAudienceKAnonymityWithParams(ctx, &v)
+}
@@ -5,10 +5,49 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// YUV subsampling type of the pixels of a given image.
+type SystemInfoSubsamplingFormat string
+
+const (
+	SystemInfoSubsamplingFormatYuv420 SystemInfoSubsamplingFormat = "yuv420"
+	SystemInfoSubsamplingFormatYuv422 SystemInfoSubsamplingFormat = "yuv422"
+	SystemInfoSubsamplingFormatYuv444 SystemInfoSubsamplingFormat = "yuv444"
+)
+
+// Valid returns true if the value is one of the SystemInfoSubsamplingFormat enum values.
+func (e SystemInfoSubsamplingFormat) Valid() bool {
+	switch e {
+	case SystemInfoSubsamplingFormatYuv420,
+		SystemInfoSubsamplingFormatYuv422,
+		SystemInfoSubsamplingFormatYuv444:
+		return true
+	}
+	return false
+}
+
+// Image format of a given image.
+type SystemInfoImageType string
+
+const (
+	SystemInfoImageTypeJpeg    SystemInfoImageType = "jpeg"
+	SystemInfoImageTypeWebp    SystemInfoImageType = "webp"
+	SystemInfoImageTypeUnknown SystemInfoImageType = "unknown"
+)
+
+// Valid returns true if the value is one of the SystemInfoImageType enum values.
+func (e SystemInfoImageType) Valid() bool {
+	switch e {
+	case SystemInfoImageTypeJpeg,
+		SystemInfoImageTypeWebp,
+		SystemInfoImageTypeUnknown:
+		return true
+	}
+	return false
+}
+
 // Describes a single graphics processor (GPU).
 type SystemInfoGPUDevice struct {
 	VendorId      float64 `json:"vendorId"`           // PCI ID of the GPU vendor, if available; 0 otherwise.
@@ -44,10 +83,10 @@ type SystemInfoVideoEncodeAcceleratorCapability struct {
 
 // Describes a supported image decoding profile with its associated minimum and maximum resolutions and subsampling.
 type SystemInfoImageDecodeAcceleratorCapability struct {
-	ImageType     string          `json:"imageType"`     // Image coded, e.g. Jpeg. enum values: jpeg, webp, unknown
-	MaxDimensions *SystemInfoSize `json:"maxDimensions"` // Maximum supported dimensions of the image in pixels.
-	MinDimensions *SystemInfoSize `json:"minDimensions"` // Minimum supported dimensions of the image in pixels.
-	Subsamplings  []string        `json:"subsamplings"`  // Optional array of supported subsampling formats, e.g. 4:2:0, if known. enum values: yuv420, yuv422, yuv444
+	ImageType     SystemInfoImageType           `json:"imageType"`     // Image coded, e.g. Jpeg. enum values: jpeg, webp, unknown
+	MaxDimensions *SystemInfoSize               `json:"maxDimensions"` // Maximum supported dimensions of the image in pixels.
+	MinDimensions *SystemInfoSize               `json:"minDimensions"` // Minimum supported dimensions of the image in pixels.
+	Subsamplings  []SystemInfoSubsamplingFormat `json:"subsamplings"`  // Optional array of supported subsampling formats, e.g. 4:2:0, if known. enum values: yuv420, yuv422, yuv444
 }
 
 // Provides information about the GPU(s) on the system.
@@ -68,6 +107,9 @@ type SystemInfoProcessInfo struct {
 	CpuTime float64 `json:"cpuTime"` // Specifies cumulative CPU usage in seconds across all threads of the process since the process start.
 }
 
+// SystemInfo domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type SystemInfo struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -76,110 +118,3 @@ func NewSystemInfo(target gcdmessage.ChromeTargeter) *SystemInfo {
 	c := &SystemInfo{target: target}
 	return c
 }
-
-// GetInfo - Returns information about the system.
-// Returns -  gpu - Information about the GPUs on the system. modelName - A platform-dependent description of the model of the machine. On Mac OS, this is, for example, 'MacBookPro'. Will be the empty string if not supported. modelVersion - A platform-dependent description of the version of the machine. On Mac OS, this is, for example, '10.1'. Will be the empty string if not supported. commandLine - The command line string used to launch the browser. Will be the empty string if not supported.
-func (c *SystemInfo) GetInfo(ctx context.Context) (*SystemInfoGPUInfo, string, string, string, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "SystemInfo.getInfo"})
-	if err != nil {
-		return nil, "", "", "", err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			Gpu          *SystemInfoGPUInfo
-			ModelName    string
-			ModelVersion string
-			CommandLine  string
-		}
-	}
-
-	if resp == nil {
-		return nil, "", "", "", &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, "", "", "", err
-	}
-
-	if chromeData.Error != nil {
-		return nil, "", "", "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.Gpu, chromeData.Result.ModelName, chromeData.Result.ModelVersion, chromeData.Result.CommandLine, nil
-}
-
-type SystemInfoGetFeatureStateParams struct {
-	//
-	FeatureState string `json:"featureState"`
-}
-
-// GetFeatureStateWithParams - Returns information about the feature state.
-// Returns -  featureEnabled -
-func (c *SystemInfo) GetFeatureStateWithParams(ctx context.Context, v *SystemInfoGetFeatureStateParams) (bool, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "SystemInfo.getFeatureState", Params: v})
-	if err != nil {
-		return false, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			FeatureEnabled bool
-		}
-	}
-
-	if resp == nil {
-		return false, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return false, err
-	}
-
-	if chromeData.Error != nil {
-		return false, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.FeatureEnabled, nil
-}
-
-// GetFeatureState - Returns information about the feature state.
-// featureState -
-// Returns -  featureEnabled -
-func (c *SystemInfo) GetFeatureState(ctx context.Context, featureState string) (bool, error) {
-	var v SystemInfoGetFeatureStateParams
-	v.FeatureState = featureState
-	return c.GetFeatureStateWithParams(ctx, &v)
-}
-
-// GetProcessInfo - Returns information about all running processes.
-// Returns -  processInfo - An array of process info blocks.
-func (c *SystemInfo) GetProcessInfo(ctx context.Context) ([]*SystemInfoProcessInfo, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "SystemInfo.getProcessInfo"})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			ProcessInfo []*SystemInfoProcessInfo
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.ProcessInfo, nil
-}
@@ -0,0 +1,119 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains SystemInfo functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// GetInfo - Returns information about the system.
+// Returns -  gpu - Information about the GPUs on the system. modelName - A platform-dependent description of the model of the machine. On Mac OS, this is, for example, 'MacBookPro'. Will be the empty string if not supported. modelVersion - A platform-dependent description of the version of the machine. On Mac OS, this is, for example, '10.1'. Will be the empty string if not supported. commandLine - The command line string used to launch the browser. Will be the empty string if not supported.
+func (c *SystemInfo) GetInfo(ctx context.Context) (*SystemInfoGPUInfo, string, string, string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "SystemInfo.getInfo"})
+	if err != nil {
+		return nil, "", "", "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Gpu          *SystemInfoGPUInfo
+			ModelName    string
+			ModelVersion string
+			CommandLine  string
+		}
+	}
+
+	if resp == nil {
+		return nil, "", "", "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, "", "", "", err
+	}
+
+	if chromeData.Error != nil {
+		return nil, "", "", "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Gpu, chromeData.Result.ModelName, chromeData.Result.ModelVersion, chromeData.Result.CommandLine, nil
+}
+
+type SystemInfoGetFeatureStateParams struct {
+	//
+	FeatureState string `json:"featureState"`
+}
+
+// GetFeatureStateWithParams - Returns information about the feature state.
+// Returns -  featureEnabled -
+func (c *SystemInfo) GetFeatureStateWithParams(ctx context.Context, v *SystemInfoGetFeatureStateParams) (bool, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "SystemInfo.getFeatureState", Params: v})
+	if err != nil {
+		return false, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			FeatureEnabled bool
+		}
+	}
+
+	if resp == nil {
+		return false, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return false, err
+	}
+
+	if chromeData.Error != nil {
+		return false, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.FeatureEnabled, nil
+}
+
+// GetFeatureState - Returns information about the feature state.
+// featureState -
+// Returns -  featureEnabled -
+func (c *SystemInfo) GetFeatureState(ctx context.Context, featureState string) (bool, error) {
+	var v SystemInfoGetFeatureStateParams
+	v.FeatureState = featureState
+	return c.GetFeatureStateWithParams(ctx, &v)
+}
+
+// GetProcessInfo - Returns information about all running processes.
+// Returns -  processInfo - An array of process info blocks.
+func (c *SystemInfo) GetProcessInfo(ctx context.Context) ([]*SystemInfoProcessInfo, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "SystemInfo.getProcessInfo"})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			ProcessInfo []*SystemInfoProcessInfo
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.ProcessInfo, nil
+}
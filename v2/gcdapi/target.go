@@ -9,10 +9,34 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// The state of the target window.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type TargetWindowState string
+
+const (
+	TargetWindowStateNormal     TargetWindowState = "normal"
+	TargetWindowStateMinimized  TargetWindowState = "minimized"
+	TargetWindowStateMaximized  TargetWindowState = "maximized"
+	TargetWindowStateFullscreen TargetWindowState = "fullscreen"
+)
+
+// Valid returns true if the value is one of the TargetWindowState enum values.
+func (e TargetWindowState) Valid() bool {
+	switch e {
+	case TargetWindowStateNormal,
+		TargetWindowStateMinimized,
+		TargetWindowStateMaximized,
+		TargetWindowStateFullscreen:
+		return true
+	}
+	return false
+}
+
 // No Description.
 type TargetTargetInfo struct {
 	TargetId         string `json:"targetId"`                   //
-	Type             string `json:"type"`                       //
+	Type             string `json:"type"`                       // List of types: https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22
 	Title            string `json:"title"`                      //
 	Url              string `json:"url"`                        //
 	Attached         bool   `json:"attached"`                   // Whether the target has an attached client.
@@ -20,22 +44,50 @@ type TargetTargetInfo struct {
 	CanAccessOpener  bool   `json:"canAccessOpener"`            // Whether the target has access to the originating window.
 	OpenerFrameId    string `json:"openerFrameId,omitempty"`    // Frame id of originating window (is only set if target has an opener).
 	BrowserContextId string `json:"browserContextId,omitempty"` //
-	Subtype          string `json:"subtype,omitempty"`          // Provides additional details for specific target types. For example, for the type of "page", this may be set to "portal" or "prerender".
+	Subtype          string `json:"subtype,omitempty"`          // Provides additional details for specific target types. For example, for the type of "page", this may be set to "prerender".
 }
 
 // A filter used by target query/discovery/auto-attach operations.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type TargetFilterEntry struct {
-	Exclude bool   `json:"exclude,omitempty"` // If set, causes exclusion of mathcing targets from the list.
+	Exclude bool   `json:"exclude,omitempty"` // If set, causes exclusion of matching targets from the list.
 	Type    string `json:"type,omitempty"`    // If not present, matches any type.
 }
 
 // No Description.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type TargetRemoteLocation struct {
 	Host string `json:"host"` //
 	Port int    `json:"port"` //
 }
 
+// Event method names for the Target domain
+const (
+	EventTargetAttachedToTarget          = "Target.attachedToTarget"
+	EventTargetDetachedFromTarget        = "Target.detachedFromTarget"
+	EventTargetReceivedMessageFromTarget = "Target.receivedMessageFromTarget"
+	EventTargetTargetCreated             = "Target.targetCreated"
+	EventTargetTargetDestroyed           = "Target.targetDestroyed"
+	EventTargetTargetCrashed             = "Target.targetCrashed"
+	EventTargetTargetInfoChanged         = "Target.targetInfoChanged"
+)
+
+// TargetEvents lists all event method names for the Target domain
+var TargetEvents = []string{
+	EventTargetAttachedToTarget,
+	EventTargetDetachedFromTarget,
+	EventTargetReceivedMessageFromTarget,
+	EventTargetTargetCreated,
+	EventTargetTargetDestroyed,
+	EventTargetTargetCrashed,
+	EventTargetTargetInfoChanged,
+}
+
 // Issued when attached to target because of auto-attach or `attachToTarget` command.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type TargetAttachedToTargetEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -46,11 +98,14 @@ type TargetAttachedToTargetEvent struct {
 }
 
 // Issued when detached from target for any reason (including `detachFromTarget` command). Can be issued multiple times per target if multiple sessions have been attached to it.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type TargetDetachedFromTargetEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		SessionId string `json:"sessionId"`          // Detached session identifier.
-		TargetId  string `json:"targetId,omitempty"` // Deprecated.
+		SessionId string `json:"sessionId"` // Detached session identifier.
+		// Deprecated: this field is deprecated in the protocol.
+		TargetId string `json:"targetId,omitempty"` // Deprecated.
 	} `json:"Params,omitempty"`
 }
 
@@ -58,9 +113,10 @@ type TargetDetachedFromTargetEvent struct {
 type TargetReceivedMessageFromTargetEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		SessionId string `json:"sessionId"`          // Identifier of a session which sends a message.
-		Message   string `json:"message"`            //
-		TargetId  string `json:"targetId,omitempty"` // Deprecated.
+		SessionId string `json:"sessionId"` // Identifier of a session which sends a message.
+		Message   string `json:"message"`   //
+		// Deprecated: this field is deprecated in the protocol.
+		TargetId string `json:"targetId,omitempty"` // Deprecated.
 	} `json:"Params,omitempty"`
 }
 
@@ -107,6 +163,101 @@ func NewTarget(target gcdmessage.ChromeTargeter) *Target {
 	return c
 }
 
+// OnAttachedToTarget subscribes to Target.attachedToTarget events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Target) OnAttachedToTarget(callback func(*TargetAttachedToTargetEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTargetAttachedToTarget, func(payload []byte) error {
+		event := &TargetAttachedToTargetEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDetachedFromTarget subscribes to Target.detachedFromTarget events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Target) OnDetachedFromTarget(callback func(*TargetDetachedFromTargetEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTargetDetachedFromTarget, func(payload []byte) error {
+		event := &TargetDetachedFromTargetEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnReceivedMessageFromTarget subscribes to Target.receivedMessageFromTarget events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Target) OnReceivedMessageFromTarget(callback func(*TargetReceivedMessageFromTargetEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTargetReceivedMessageFromTarget, func(payload []byte) error {
+		event := &TargetReceivedMessageFromTargetEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTargetCreated subscribes to Target.targetCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Target) OnTargetCreated(callback func(*TargetTargetCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTargetTargetCreated, func(payload []byte) error {
+		event := &TargetTargetCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTargetDestroyed subscribes to Target.targetDestroyed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Target) OnTargetDestroyed(callback func(*TargetTargetDestroyedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTargetTargetDestroyed, func(payload []byte) error {
+		event := &TargetTargetDestroyedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTargetCrashed subscribes to Target.targetCrashed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Target) OnTargetCrashed(callback func(*TargetTargetCrashedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTargetTargetCrashed, func(payload []byte) error {
+		event := &TargetTargetCrashedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTargetInfoChanged subscribes to Target.targetInfoChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Target) OnTargetInfoChanged(callback func(*TargetTargetInfoChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTargetTargetInfoChanged, func(payload []byte) error {
+		event := &TargetTargetInfoChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 type TargetActivateTargetParams struct {
 	//
 	TargetId string `json:"targetId"`
@@ -175,6 +326,8 @@ func (c *Target) AttachToTarget(ctx context.Context, targetId string, flatten bo
 
 // AttachToBrowserTarget - Attaches to the browser target, only uses flat sessionId mode.
 // Returns -  sessionId - Id assigned to the session.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Target) AttachToBrowserTarget(ctx context.Context) (string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Target.attachToBrowserTarget"})
 	if err != nil {
@@ -252,31 +405,47 @@ type TargetExposeDevToolsProtocolParams struct {
 	TargetId string `json:"targetId"`
 	// Binding name, 'cdp' if not specified.
 	BindingName string `json:"bindingName,omitempty"`
+	// If true, inherits the current root session's permissions (default: false).
+	InheritPermissions bool `json:"inheritPermissions,omitempty"`
 }
 
-// ExposeDevToolsProtocolWithParams - Inject object to the target's main frame that provides a communication channel with browser target.  Injected object will be available as `window[bindingName]`.  The object has the follwing API: - `binding.send(json)` - a method to send messages over the remote debugging protocol - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
+// ExposeDevToolsProtocolWithParams - Inject object to the target's main frame that provides a communication channel with browser target.  Injected object will be available as `window[bindingName]`.  The object has the following API: - `binding.send(json)` - a method to send messages over the remote debugging protocol - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Target) ExposeDevToolsProtocolWithParams(ctx context.Context, v *TargetExposeDevToolsProtocolParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Target.exposeDevToolsProtocol", Params: v})
 }
 
-// ExposeDevToolsProtocol - Inject object to the target's main frame that provides a communication channel with browser target.  Injected object will be available as `window[bindingName]`.  The object has the follwing API: - `binding.send(json)` - a method to send messages over the remote debugging protocol - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
+// ExposeDevToolsProtocol - Inject object to the target's main frame that provides a communication channel with browser target.  Injected object will be available as `window[bindingName]`.  The object has the following API: - `binding.send(json)` - a method to send messages over the remote debugging protocol - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
 // targetId -
 // bindingName - Binding name, 'cdp' if not specified.
-func (c *Target) ExposeDevToolsProtocol(ctx context.Context, targetId string, bindingName string) (*gcdmessage.ChromeResponse, error) {
+// inheritPermissions - If true, inherits the current root session's permissions (default: false).
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Target) ExposeDevToolsProtocol(ctx context.Context, targetId string, bindingName string, inheritPermissions bool) (*gcdmessage.ChromeResponse, error) {
 	var v TargetExposeDevToolsProtocolParams
 	v.TargetId = targetId
 	v.BindingName = bindingName
+	v.InheritPermissions = inheritPermissions
 	return c.ExposeDevToolsProtocolWithParams(ctx, &v)
 }
 
 type TargetCreateBrowserContextParams struct {
 	// If specified, disposes this context when debugging session disconnects.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	DisposeOnDetach bool `json:"disposeOnDetach,omitempty"`
 	// Proxy server, similar to the one passed to --proxy-server
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ProxyServer string `json:"proxyServer,omitempty"`
 	// Proxy bypass list, similar to the one passed to --proxy-bypass-list
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ProxyBypassList string `json:"proxyBypassList,omitempty"`
 	// An optional list of origins to grant unlimited cross-origin access to. Parts of the URL other than those constituting origin are ignored.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess,omitempty"`
 }
 
@@ -358,20 +527,40 @@ func (c *Target) GetBrowserContexts(ctx context.Context) ([]string, error) {
 type TargetCreateTargetParams struct {
 	// The initial URL the page will be navigated to. An empty string indicates about:blank.
 	Url string `json:"url"`
-	// Frame width in DIP (headless chrome only).
+	// Frame left origin in DIP (requires newWindow to be true or headless shell).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	Left int `json:"left,omitempty"`
+	// Frame top origin in DIP (requires newWindow to be true or headless shell).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	Top int `json:"top,omitempty"`
+	// Frame width in DIP (requires newWindow to be true or headless shell).
 	Width int `json:"width,omitempty"`
-	// Frame height in DIP (headless chrome only).
+	// Frame height in DIP (requires newWindow to be true or headless shell).
 	Height int `json:"height,omitempty"`
+	// Frame window state (requires newWindow to be true or headless shell). Default is normal. enum values: normal, minimized, maximized, fullscreen
+	WindowState TargetWindowState `json:"windowState,omitempty"`
 	// The browser context to create the page in.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	BrowserContextId string `json:"browserContextId,omitempty"`
-	// Whether BeginFrames for this target will be controlled via DevTools (headless chrome only, not supported on MacOS yet, false by default).
+	// Whether BeginFrames for this target will be controlled via DevTools (headless shell only, not supported on MacOS yet, false by default).
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	EnableBeginFrameControl bool `json:"enableBeginFrameControl,omitempty"`
-	// Whether to create a new Window or Tab (chrome-only, false by default).
+	// Whether to create a new Window or Tab (false by default, not supported by headless shell).
 	NewWindow bool `json:"newWindow,omitempty"`
-	// Whether to create the target in background or foreground (chrome-only, false by default).
+	// Whether to create the target in background or foreground (false by default, not supported by headless shell).
 	Background bool `json:"background,omitempty"`
 	// Whether to create the target of type "tab".
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	ForTab bool `json:"forTab,omitempty"`
+	// Whether to create a hidden target. The hidden target is observable via protocol, but not present in the tab UI strip. Cannot be created with `forTab: true`, `newWindow: true` or `background: false`. The life-time of the tab is limited to the life-time of the session.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	Hidden bool `json:"hidden,omitempty"`
 }
 
 // CreateTargetWithParams - Creates a new page.
@@ -406,24 +595,32 @@ func (c *Target) CreateTargetWithParams(ctx context.Context, v *TargetCreateTarg
 
 // CreateTarget - Creates a new page.
 // url - The initial URL the page will be navigated to. An empty string indicates about:blank.
-// width - Frame width in DIP (headless chrome only).
-// height - Frame height in DIP (headless chrome only).
+// left - Frame left origin in DIP (requires newWindow to be true or headless shell).
+// top - Frame top origin in DIP (requires newWindow to be true or headless shell).
+// width - Frame width in DIP (requires newWindow to be true or headless shell).
+// height - Frame height in DIP (requires newWindow to be true or headless shell).
+// windowState - Frame window state (requires newWindow to be true or headless shell). Default is normal. enum values: normal, minimized, maximized, fullscreen
 // browserContextId - The browser context to create the page in.
-// enableBeginFrameControl - Whether BeginFrames for this target will be controlled via DevTools (headless chrome only, not supported on MacOS yet, false by default).
-// newWindow - Whether to create a new Window or Tab (chrome-only, false by default).
-// background - Whether to create the target in background or foreground (chrome-only, false by default).
+// enableBeginFrameControl - Whether BeginFrames for this target will be controlled via DevTools (headless shell only, not supported on MacOS yet, false by default).
+// newWindow - Whether to create a new Window or Tab (false by default, not supported by headless shell).
+// background - Whether to create the target in background or foreground (false by default, not supported by headless shell).
 // forTab - Whether to create the target of type "tab".
+// hidden - Whether to create a hidden target. The hidden target is observable via protocol, but not present in the tab UI strip. Cannot be created with `forTab: true`, `newWindow: true` or `background: false`. The life-time of the tab is limited to the life-time of the session.
 // Returns -  targetId - The id of the page opened.
-func (c *Target) CreateTarget(ctx context.Context, url string, width int, height int, browserContextId string, enableBeginFrameControl bool, newWindow bool, background bool, forTab bool) (string, error) {
+func (c *Target) CreateTarget(ctx context.Context, url string, left int, top int, width int, height int, windowState TargetWindowState, browserContextId string, enableBeginFrameControl bool, newWindow bool, background bool, forTab bool, hidden bool) (string, error) {
 	var v TargetCreateTargetParams
 	v.Url = url
+	v.Left = left
+	v.Top = top
 	v.Width = width
 	v.Height = height
+	v.WindowState = windowState
 	v.BrowserContextId = browserContextId
 	v.EnableBeginFrameControl = enableBeginFrameControl
 	v.NewWindow = newWindow
 	v.Background = background
 	v.ForTab = forTab
+	v.Hidden = hidden
 	return c.CreateTargetWithParams(ctx, &v)
 }
 
@@ -431,6 +628,8 @@ type TargetDetachFromTargetParams struct {
 	// Session to detach.
 	SessionId string `json:"sessionId,omitempty"`
 	// Deprecated.
+	//
+	// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 	TargetId string `json:"targetId,omitempty"`
 }
 
@@ -474,6 +673,8 @@ type TargetGetTargetInfoParams struct {
 
 // GetTargetInfoWithParams - Returns information about a target.
 // Returns -  targetInfo -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Target) GetTargetInfoWithParams(ctx context.Context, v *TargetGetTargetInfoParams) (*TargetTargetInfo, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Target.getTargetInfo", Params: v})
 	if err != nil {
@@ -505,6 +706,8 @@ func (c *Target) GetTargetInfoWithParams(ctx context.Context, v *TargetGetTarget
 // GetTargetInfo - Returns information about a target.
 // targetId -
 // Returns -  targetInfo -
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Target) GetTargetInfo(ctx context.Context, targetId string) (*TargetTargetInfo, error) {
 	var v TargetGetTargetInfoParams
 	v.TargetId = targetId
@@ -513,6 +716,8 @@ func (c *Target) GetTargetInfo(ctx context.Context, targetId string) (*TargetTar
 
 type TargetGetTargetsParams struct {
 	// Only targets matching filter will be reported. If filter is not specified and target discovery is currently enabled, a filter used for target discovery is used for consistency.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Filter []*TargetFilterEntry `json:"filter,omitempty"`
 }
 
@@ -555,16 +760,21 @@ func (c *Target) GetTargets(ctx context.Context, filter []*TargetFilterEntry) ([
 	return c.GetTargetsWithParams(ctx, &v)
 }
 
+// Deprecated: this command is deprecated in the protocol.
 type TargetSendMessageToTargetParams struct {
 	//
 	Message string `json:"message"`
 	// Identifier of the session.
 	SessionId string `json:"sessionId,omitempty"`
 	// Deprecated.
+	//
+	// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 	TargetId string `json:"targetId,omitempty"`
 }
 
 // SendMessageToTargetWithParams - Sends protocol message over session with given id. Consider using flat mode instead; see commands attachToTarget, setAutoAttach, and crbug.com/991325.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Target) SendMessageToTargetWithParams(ctx context.Context, v *TargetSendMessageToTargetParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Target.sendMessageToTarget", Params: v})
 }
@@ -573,6 +783,8 @@ func (c *Target) SendMessageToTargetWithParams(ctx context.Context, v *TargetSen
 // message -
 // sessionId - Identifier of the session.
 // targetId - Deprecated.
+//
+// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
 func (c *Target) SendMessageToTarget(ctx context.Context, message string, sessionId string, targetId string) (*gcdmessage.ChromeResponse, error) {
 	var v TargetSendMessageToTargetParams
 	v.Message = message
@@ -587,17 +799,21 @@ type TargetSetAutoAttachParams struct {
 	// Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.
 	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
 	// Enables "flat" access to the session via specifying sessionId attribute in the commands. We plan to make this the default, deprecate non-flattened mode, and eventually retire it. See crbug.com/991325.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Flatten bool `json:"flatten,omitempty"`
 	// Only targets matching filter will be attached.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Filter []*TargetFilterEntry `json:"filter,omitempty"`
 }
 
-// SetAutoAttachWithParams - Controls whether to automatically attach to new targets which are considered to be related to this one. When turned on, attaches to all existing related targets as well. When turned off, automatically detaches from all currently attached targets. This also clears all targets added by `autoAttachRelated` from the list of targets to watch for creation of related targets.
+// SetAutoAttachWithParams - Controls whether to automatically attach to new targets which are considered to be directly related to this one (for example, iframes or workers). When turned on, attaches to all existing related targets as well. When turned off, automatically detaches from all currently attached targets. This also clears all targets added by `autoAttachRelated` from the list of targets to watch for creation of related targets. You might want to call this recursively for auto-attached targets to attach to all available targets.
 func (c *Target) SetAutoAttachWithParams(ctx context.Context, v *TargetSetAutoAttachParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Target.setAutoAttach", Params: v})
 }
 
-// SetAutoAttach - Controls whether to automatically attach to new targets which are considered to be related to this one. When turned on, attaches to all existing related targets as well. When turned off, automatically detaches from all currently attached targets. This also clears all targets added by `autoAttachRelated` from the list of targets to watch for creation of related targets.
+// SetAutoAttach - Controls whether to automatically attach to new targets which are considered to be directly related to this one (for example, iframes or workers). When turned on, attaches to all existing related targets as well. When turned off, automatically detaches from all currently attached targets. This also clears all targets added by `autoAttachRelated` from the list of targets to watch for creation of related targets. You might want to call this recursively for auto-attached targets to attach to all available targets.
 // autoAttach - Whether to auto-attach to related targets.
 // waitForDebuggerOnStart - Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.
 // flatten - Enables "flat" access to the session via specifying sessionId attribute in the commands. We plan to make this the default, deprecate non-flattened mode, and eventually retire it. See crbug.com/991325.
@@ -617,10 +833,14 @@ type TargetAutoAttachRelatedParams struct {
 	// Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.
 	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
 	// Only targets matching filter will be attached.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Filter []*TargetFilterEntry `json:"filter,omitempty"`
 }
 
 // AutoAttachRelatedWithParams - Adds the specified target to the list of targets that will be monitored for any related target creation (such as child frames, child workers and new versions of service worker) and reported through `attachedToTarget`. The specified target is also auto-attached. This cancels the effect of any previous `setAutoAttach` and is also cancelled by subsequent `setAutoAttach`. Only available at the Browser target.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Target) AutoAttachRelatedWithParams(ctx context.Context, v *TargetAutoAttachRelatedParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Target.autoAttachRelated", Params: v})
 }
@@ -629,6 +849,8 @@ func (c *Target) AutoAttachRelatedWithParams(ctx context.Context, v *TargetAutoA
 // targetId -
 // waitForDebuggerOnStart - Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger` to run paused targets.
 // filter - Only targets matching filter will be attached.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Target) AutoAttachRelated(ctx context.Context, targetId string, waitForDebuggerOnStart bool, filter []*TargetFilterEntry) (*gcdmessage.ChromeResponse, error) {
 	var v TargetAutoAttachRelatedParams
 	v.TargetId = targetId
@@ -641,6 +863,8 @@ type TargetSetDiscoverTargetsParams struct {
 	// Whether to discover available targets.
 	Discover bool `json:"discover"`
 	// Only targets matching filter will be attached. If `discover` is false, `filter` must be omitted or empty.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Filter []*TargetFilterEntry `json:"filter,omitempty"`
 }
 
@@ -665,14 +889,66 @@ type TargetSetRemoteLocationsParams struct {
 }
 
 // SetRemoteLocationsWithParams - Enables target discovery for the specified locations, when `setDiscoverTargets` was set to `true`.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Target) SetRemoteLocationsWithParams(ctx context.Context, v *TargetSetRemoteLocationsParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Target.setRemoteLocations", Params: v})
 }
 
 // SetRemoteLocations - Enables target discovery for the specified locations, when `setDiscoverTargets` was set to `true`.
 // locations - List of remote locations.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Target) SetRemoteLocations(ctx context.Context, locations []*TargetRemoteLocation) (*gcdmessage.ChromeResponse, error) {
 	var v TargetSetRemoteLocationsParams
 	v.Locations = locations
 	return c.SetRemoteLocationsWithParams(ctx, &v)
 }
+
+type TargetOpenDevToolsParams struct {
+	// This can be the page or tab target ID.
+	TargetId string `json:"targetId"`
+}
+
+// OpenDevToolsWithParams - Opens a DevTools window for the target.
+// Returns -  targetId - The targetId of DevTools page target.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Target) OpenDevToolsWithParams(ctx context.Context, v *TargetOpenDevToolsParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Target.openDevTools", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			TargetId string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.TargetId, nil
+}
+
+// OpenDevTools - Opens a DevTools window for the target.
+// targetId - This can be the page or tab target ID.
+// Returns -  targetId - The targetId of DevTools page target.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Target) OpenDevTools(ctx context.Context, targetId string) (string, error) {
+	var v TargetOpenDevToolsParams
+	v.TargetId = targetId
+	return c.OpenDevToolsWithParams(ctx, &v)
+}
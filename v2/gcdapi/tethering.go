@@ -5,10 +5,19 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Event method names for the Tethering domain
+const (
+	EventTetheringAccepted = "Tethering.accepted"
+)
+
+// TetheringEvents lists all event method names for the Tethering domain
+var TetheringEvents = []string{
+	EventTetheringAccepted,
+}
+
 // Informs that port was successfully bound and got a specified connection id.
 type TetheringAcceptedEvent struct {
 	Method string `json:"method"`
@@ -18,6 +27,9 @@ type TetheringAcceptedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Tethering domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type Tethering struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -26,39 +38,3 @@ func NewTethering(target gcdmessage.ChromeTargeter) *Tethering {
 	c := &Tethering{target: target}
 	return c
 }
-
-type TetheringBindParams struct {
-	// Port number to bind.
-	Port int `json:"port"`
-}
-
-// BindWithParams - Request browser port binding.
-func (c *Tethering) BindWithParams(ctx context.Context, v *TetheringBindParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Tethering.bind", Params: v})
-}
-
-// Bind - Request browser port binding.
-// port - Port number to bind.
-func (c *Tethering) Bind(ctx context.Context, port int) (*gcdmessage.ChromeResponse, error) {
-	var v TetheringBindParams
-	v.Port = port
-	return c.BindWithParams(ctx, &v)
-}
-
-type TetheringUnbindParams struct {
-	// Port number to unbind.
-	Port int `json:"port"`
-}
-
-// UnbindWithParams - Request browser port unbinding.
-func (c *Tethering) UnbindWithParams(ctx context.Context, v *TetheringUnbindParams) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Tethering.unbind", Params: v})
-}
-
-// Unbind - Request browser port unbinding.
-// port - Port number to unbind.
-func (c *Tethering) Unbind(ctx context.Context, port int) (*gcdmessage.ChromeResponse, error) {
-	var v TetheringUnbindParams
-	v.Port = port
-	return c.UnbindWithParams(ctx, &v)
-}
@@ -9,19 +9,160 @@ import (
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Controls how the trace buffer stores data. The default is `recordUntilFull`.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type TracingTraceConfigRecordMode string
+
+const (
+	TracingTraceConfigRecordModeRecordUntilFull        TracingTraceConfigRecordMode = "recordUntilFull"
+	TracingTraceConfigRecordModeRecordContinuously     TracingTraceConfigRecordMode = "recordContinuously"
+	TracingTraceConfigRecordModeRecordAsMuchAsPossible TracingTraceConfigRecordMode = "recordAsMuchAsPossible"
+	TracingTraceConfigRecordModeEchoToConsole          TracingTraceConfigRecordMode = "echoToConsole"
+)
+
+// Valid returns true if the value is one of the TracingTraceConfigRecordMode enum values.
+func (e TracingTraceConfigRecordMode) Valid() bool {
+	switch e {
+	case TracingTraceConfigRecordModeRecordUntilFull,
+		TracingTraceConfigRecordModeRecordContinuously,
+		TracingTraceConfigRecordModeRecordAsMuchAsPossible,
+		TracingTraceConfigRecordModeEchoToConsole:
+		return true
+	}
+	return false
+}
+
+// Data format of a trace. Can be either the legacy JSON format or the protocol buffer format. Note that the JSON format will be deprecated soon.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type TracingStreamFormat string
+
+const (
+	TracingStreamFormatJson  TracingStreamFormat = "json"
+	TracingStreamFormatProto TracingStreamFormat = "proto"
+)
+
+// Valid returns true if the value is one of the TracingStreamFormat enum values.
+func (e TracingStreamFormat) Valid() bool {
+	switch e {
+	case TracingStreamFormatJson,
+		TracingStreamFormatProto:
+		return true
+	}
+	return false
+}
+
+// Compression type to use for traces returned via streams.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type TracingStreamCompression string
+
+const (
+	TracingStreamCompressionNone TracingStreamCompression = "none"
+	TracingStreamCompressionGzip TracingStreamCompression = "gzip"
+)
+
+// Valid returns true if the value is one of the TracingStreamCompression enum values.
+func (e TracingStreamCompression) Valid() bool {
+	switch e {
+	case TracingStreamCompressionNone,
+		TracingStreamCompressionGzip:
+		return true
+	}
+	return false
+}
+
+// Details exposed when memory request explicitly declared. Keep consistent with memory_dump_request_args.h and memory_instrumentation.mojom
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type TracingMemoryDumpLevelOfDetail string
+
+const (
+	TracingMemoryDumpLevelOfDetailBackground TracingMemoryDumpLevelOfDetail = "background"
+	TracingMemoryDumpLevelOfDetailLight      TracingMemoryDumpLevelOfDetail = "light"
+	TracingMemoryDumpLevelOfDetailDetailed   TracingMemoryDumpLevelOfDetail = "detailed"
+)
+
+// Valid returns true if the value is one of the TracingMemoryDumpLevelOfDetail enum values.
+func (e TracingMemoryDumpLevelOfDetail) Valid() bool {
+	switch e {
+	case TracingMemoryDumpLevelOfDetailBackground,
+		TracingMemoryDumpLevelOfDetailLight,
+		TracingMemoryDumpLevelOfDetailDetailed:
+		return true
+	}
+	return false
+}
+
+// Backend type to use for tracing. `chrome` uses the Chrome-integrated tracing service and is supported on all platforms. `system` is only supported on Chrome OS and uses the Perfetto system tracing service. `auto` chooses `system` when the perfettoConfig provided to Tracing.start specifies at least one non-Chrome data source; otherwise uses `chrome`.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type TracingTracingBackend string
+
+const (
+	TracingTracingBackendAuto   TracingTracingBackend = "auto"
+	TracingTracingBackendChrome TracingTracingBackend = "chrome"
+	TracingTracingBackendSystem TracingTracingBackend = "system"
+)
+
+// Valid returns true if the value is one of the TracingTracingBackend enum values.
+func (e TracingTracingBackend) Valid() bool {
+	switch e {
+	case TracingTracingBackendAuto,
+		TracingTracingBackendChrome,
+		TracingTracingBackendSystem:
+		return true
+	}
+	return false
+}
+
+// Whether to report trace events as series of dataCollected events or to save trace to a stream (defaults to `ReportEvents`).
+type TracingStartTransferMode string
+
+const (
+	TracingStartTransferModeReportEvents   TracingStartTransferMode = "ReportEvents"
+	TracingStartTransferModeReturnAsStream TracingStartTransferMode = "ReturnAsStream"
+)
+
+// Valid returns true if the value is one of the TracingStartTransferMode enum values.
+func (e TracingStartTransferMode) Valid() bool {
+	switch e {
+	case TracingStartTransferModeReportEvents,
+		TracingStartTransferModeReturnAsStream:
+		return true
+	}
+	return false
+}
+
 // No Description.
 type TracingTraceConfig struct {
-	RecordMode           string                 `json:"recordMode,omitempty"`           // Controls how the trace buffer stores data.
-	TraceBufferSizeInKb  float64                `json:"traceBufferSizeInKb,omitempty"`  // Size of the trace buffer in kilobytes. If not specified or zero is passed, a default value of 200 MB would be used.
-	EnableSampling       bool                   `json:"enableSampling,omitempty"`       // Turns on JavaScript stack sampling.
-	EnableSystrace       bool                   `json:"enableSystrace,omitempty"`       // Turns on system tracing.
-	EnableArgumentFilter bool                   `json:"enableArgumentFilter,omitempty"` // Turns on argument filter.
-	IncludedCategories   []string               `json:"includedCategories,omitempty"`   // Included category filters.
-	ExcludedCategories   []string               `json:"excludedCategories,omitempty"`   // Excluded category filters.
-	SyntheticDelays      []string               `json:"syntheticDelays,omitempty"`      // Configuration to synthesize the delays in tracing.
-	MemoryDumpConfig     map[string]interface{} `json:"memoryDumpConfig,omitempty"`     // Configuration for memory dump triggers. Used only when "memory-infra" category is enabled.
+	RecordMode           TracingTraceConfigRecordMode `json:"recordMode,omitempty"`           // Controls how the trace buffer stores data. The default is `recordUntilFull`.
+	TraceBufferSizeInKb  float64                      `json:"traceBufferSizeInKb,omitempty"`  // Size of the trace buffer in kilobytes. If not specified or zero is passed, a default value of 200 MB would be used.
+	EnableSampling       bool                         `json:"enableSampling,omitempty"`       // Turns on JavaScript stack sampling.
+	EnableSystrace       bool                         `json:"enableSystrace,omitempty"`       // Turns on system tracing.
+	EnableArgumentFilter bool                         `json:"enableArgumentFilter,omitempty"` // Turns on argument filter.
+	IncludedCategories   []string                     `json:"includedCategories,omitempty"`   // Included category filters.
+	ExcludedCategories   []string                     `json:"excludedCategories,omitempty"`   // Excluded category filters.
+	SyntheticDelays      []string                     `json:"syntheticDelays,omitempty"`      // Configuration to synthesize the delays in tracing.
+	MemoryDumpConfig     map[string]interface{}       `json:"memoryDumpConfig,omitempty"`     // Configuration for memory dump triggers. Used only when "memory-infra" category is enabled.
+}
+
+// Event method names for the Tracing domain
+const (
+	EventTracingBufferUsage     = "Tracing.bufferUsage"
+	EventTracingDataCollected   = "Tracing.dataCollected"
+	EventTracingTracingComplete = "Tracing.tracingComplete"
+)
+
+// TracingEvents lists all event method names for the Tracing domain
+var TracingEvents = []string{
+	EventTracingBufferUsage,
+	EventTracingDataCollected,
+	EventTracingTracingComplete,
 }
 
+// Experimental: this may change or be removed in a future Chrome release.
 type TracingBufferUsageEvent struct {
 	Method string `json:"method"`
 	Params struct {
@@ -31,14 +172,24 @@ type TracingBufferUsageEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// Contains a bucket of collected trace events. When tracing is stopped collected events will be sent as a sequence of dataCollected events followed by tracingComplete event.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+type TracingDataCollectedEvent struct {
+	Method string `json:"method"`
+	Params struct {
+		Value []map[string]interface{} `json:"value"` //
+	} `json:"Params,omitempty"`
+}
+
 // Signals that tracing is stopped and there is no trace buffers pending flush, all data were delivered via dataCollected events.
 type TracingTracingCompleteEvent struct {
 	Method string `json:"method"`
 	Params struct {
-		DataLossOccurred  bool   `json:"dataLossOccurred"`            // Indicates whether some trace data is known to have been lost, e.g. because the trace ring buffer wrapped around.
-		Stream            string `json:"stream,omitempty"`            // A handle of the stream that holds resulting trace data.
-		TraceFormat       string `json:"traceFormat,omitempty"`       // Trace data format of returned stream. enum values: json, proto
-		StreamCompression string `json:"streamCompression,omitempty"` // Compression format of returned stream. enum values: none, gzip
+		DataLossOccurred  bool                     `json:"dataLossOccurred"`            // Indicates whether some trace data is known to have been lost, e.g. because the trace ring buffer wrapped around.
+		Stream            string                   `json:"stream,omitempty"`            // A handle of the stream that holds resulting trace data.
+		TraceFormat       TracingStreamFormat      `json:"traceFormat,omitempty"`       // Trace data format of returned stream. enum values: json, proto
+		StreamCompression TracingStreamCompression `json:"streamCompression,omitempty"` // Compression format of returned stream. enum values: none, gzip
 	} `json:"Params,omitempty"`
 }
 
@@ -51,6 +202,49 @@ func NewTracing(target gcdmessage.ChromeTargeter) *Tracing {
 	return c
 }
 
+// OnBufferUsage subscribes to Tracing.bufferUsage events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Tracing) OnBufferUsage(callback func(*TracingBufferUsageEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTracingBufferUsage, func(payload []byte) error {
+		event := &TracingBufferUsageEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnDataCollected subscribes to Tracing.dataCollected events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Tracing) OnDataCollected(callback func(*TracingDataCollectedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTracingDataCollected, func(payload []byte) error {
+		event := &TracingDataCollectedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnTracingComplete subscribes to Tracing.tracingComplete events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *Tracing) OnTracingComplete(callback func(*TracingTracingCompleteEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventTracingTracingComplete, func(payload []byte) error {
+		event := &TracingTracingCompleteEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
 // Stop trace events collection.
 func (c *Tracing) End(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Tracing.end"})
@@ -58,6 +252,8 @@ func (c *Tracing) End(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
 
 // GetCategories - Gets supported tracing categories.
 // Returns -  categories - A list of supported tracing categories.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Tracing) GetCategories(ctx context.Context) ([]string, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Tracing.getCategories"})
 	if err != nil {
@@ -92,12 +288,16 @@ type TracingRecordClockSyncMarkerParams struct {
 }
 
 // RecordClockSyncMarkerWithParams - Record a clock sync marker in the trace.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Tracing) RecordClockSyncMarkerWithParams(ctx context.Context, v *TracingRecordClockSyncMarkerParams) (*gcdmessage.ChromeResponse, error) {
 	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Tracing.recordClockSyncMarker", Params: v})
 }
 
 // RecordClockSyncMarker - Record a clock sync marker in the trace.
 // syncId - The ID of this clock sync marker
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Tracing) RecordClockSyncMarker(ctx context.Context, syncId string) (*gcdmessage.ChromeResponse, error) {
 	var v TracingRecordClockSyncMarkerParams
 	v.SyncId = syncId
@@ -108,11 +308,13 @@ type TracingRequestMemoryDumpParams struct {
 	// Enables more deterministic results by forcing garbage collection
 	Deterministic bool `json:"deterministic,omitempty"`
 	// Specifies level of details in memory dump. Defaults to "detailed". enum values: background, light, detailed
-	LevelOfDetail string `json:"levelOfDetail,omitempty"`
+	LevelOfDetail TracingMemoryDumpLevelOfDetail `json:"levelOfDetail,omitempty"`
 }
 
 // RequestMemoryDumpWithParams - Request a global memory dump.
 // Returns -  dumpGuid - GUID of the resulting global memory dump. success - True iff the global memory dump succeeded.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 func (c *Tracing) RequestMemoryDumpWithParams(ctx context.Context, v *TracingRequestMemoryDumpParams) (string, bool, error) {
 	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "Tracing.requestMemoryDump", Params: v})
 	if err != nil {
@@ -146,7 +348,9 @@ func (c *Tracing) RequestMemoryDumpWithParams(ctx context.Context, v *TracingReq
 // deterministic - Enables more deterministic results by forcing garbage collection
 // levelOfDetail - Specifies level of details in memory dump. Defaults to "detailed". enum values: background, light, detailed
 // Returns -  dumpGuid - GUID of the resulting global memory dump. success - True iff the global memory dump succeeded.
-func (c *Tracing) RequestMemoryDump(ctx context.Context, deterministic bool, levelOfDetail string) (string, bool, error) {
+//
+// Experimental: this may change or be removed in a future Chrome release.
+func (c *Tracing) RequestMemoryDump(ctx context.Context, deterministic bool, levelOfDetail TracingMemoryDumpLevelOfDetail) (string, bool, error) {
 	var v TracingRequestMemoryDumpParams
 	v.Deterministic = deterministic
 	v.LevelOfDetail = levelOfDetail
@@ -155,23 +359,39 @@ func (c *Tracing) RequestMemoryDump(ctx context.Context, deterministic bool, lev
 
 type TracingStartParams struct {
 	// Category/tag filter
+	//
+	// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Categories string `json:"categories,omitempty"`
 	// Tracing options
+	//
+	// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	Options string `json:"options,omitempty"`
 	// If set, the agent will issue bufferUsage events at this interval, specified in milliseconds
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	BufferUsageReportingInterval float64 `json:"bufferUsageReportingInterval,omitempty"`
 	// Whether to report trace events as series of dataCollected events or to save trace to a stream (defaults to `ReportEvents`).
-	TransferMode string `json:"transferMode,omitempty"`
+	TransferMode TracingStartTransferMode `json:"transferMode,omitempty"`
 	// Trace data format to use. This only applies when using `ReturnAsStream` transfer mode (defaults to `json`). enum values: json, proto
-	StreamFormat string `json:"streamFormat,omitempty"`
+	StreamFormat TracingStreamFormat `json:"streamFormat,omitempty"`
 	// Compression format to use. This only applies when using `ReturnAsStream` transfer mode (defaults to `none`) enum values: none, gzip
-	StreamCompression string `json:"streamCompression,omitempty"`
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	StreamCompression TracingStreamCompression `json:"streamCompression,omitempty"`
 	//
 	TraceConfig *TracingTraceConfig `json:"traceConfig,omitempty"`
 	// Base64-encoded serialized perfetto.protos.TraceConfig protobuf message When specified, the parameters `categories`, `options`, `traceConfig` are ignored. (Encoded as a base64 string when passed over JSON)
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
 	PerfettoConfig string `json:"perfettoConfig,omitempty"`
 	// Backend type (defaults to `auto`) enum values: auto, chrome, system
-	TracingBackend string `json:"tracingBackend,omitempty"`
+	//
+	// Experimental: this may change or be removed in a future Chrome release.
+	TracingBackend TracingTracingBackend `json:"tracingBackend,omitempty"`
 }
 
 // StartWithParams - Start trace events collection.
@@ -189,7 +409,7 @@ func (c *Tracing) StartWithParams(ctx context.Context, v *TracingStartParams) (*
 // traceConfig -
 // perfettoConfig - Base64-encoded serialized perfetto.protos.TraceConfig protobuf message When specified, the parameters `categories`, `options`, `traceConfig` are ignored. (Encoded as a base64 string when passed over JSON)
 // tracingBackend - Backend type (defaults to `auto`) enum values: auto, chrome, system
-func (c *Tracing) Start(ctx context.Context, categories string, options string, bufferUsageReportingInterval float64, transferMode string, streamFormat string, streamCompression string, traceConfig *TracingTraceConfig, perfettoConfig string, tracingBackend string) (*gcdmessage.ChromeResponse, error) {
+func (c *Tracing) Start(ctx context.Context, categories string, options string, bufferUsageReportingInterval float64, transferMode TracingStartTransferMode, streamFormat TracingStreamFormat, streamCompression TracingStreamCompression, traceConfig *TracingTraceConfig, perfettoConfig string, tracingBackend TracingTracingBackend) (*gcdmessage.ChromeResponse, error) {
 	var v TracingStartParams
 	v.Categories = categories
 	v.Options = options
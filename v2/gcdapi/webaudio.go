@@ -5,10 +5,105 @@
 package gcdapi
 
 import (
-	"context"
 	"github.com/wirepair/gcd/v2/gcdmessage"
 )
 
+// Enum of BaseAudioContext types
+type WebAudioContextType string
+
+const (
+	WebAudioContextTypeRealtime WebAudioContextType = "realtime"
+	WebAudioContextTypeOffline  WebAudioContextType = "offline"
+)
+
+// Valid returns true if the value is one of the WebAudioContextType enum values.
+func (e WebAudioContextType) Valid() bool {
+	switch e {
+	case WebAudioContextTypeRealtime,
+		WebAudioContextTypeOffline:
+		return true
+	}
+	return false
+}
+
+// Enum of AudioContextState from the spec
+type WebAudioContextState string
+
+const (
+	WebAudioContextStateSuspended   WebAudioContextState = "suspended"
+	WebAudioContextStateRunning     WebAudioContextState = "running"
+	WebAudioContextStateClosed      WebAudioContextState = "closed"
+	WebAudioContextStateInterrupted WebAudioContextState = "interrupted"
+)
+
+// Valid returns true if the value is one of the WebAudioContextState enum values.
+func (e WebAudioContextState) Valid() bool {
+	switch e {
+	case WebAudioContextStateSuspended,
+		WebAudioContextStateRunning,
+		WebAudioContextStateClosed,
+		WebAudioContextStateInterrupted:
+		return true
+	}
+	return false
+}
+
+// Enum of AudioNode::ChannelCountMode from the spec
+type WebAudioChannelCountMode string
+
+const (
+	WebAudioChannelCountModeClampedMax WebAudioChannelCountMode = "clamped-max"
+	WebAudioChannelCountModeExplicit   WebAudioChannelCountMode = "explicit"
+	WebAudioChannelCountModeMax        WebAudioChannelCountMode = "max"
+)
+
+// Valid returns true if the value is one of the WebAudioChannelCountMode enum values.
+func (e WebAudioChannelCountMode) Valid() bool {
+	switch e {
+	case WebAudioChannelCountModeClampedMax,
+		WebAudioChannelCountModeExplicit,
+		WebAudioChannelCountModeMax:
+		return true
+	}
+	return false
+}
+
+// Enum of AudioNode::ChannelInterpretation from the spec
+type WebAudioChannelInterpretation string
+
+const (
+	WebAudioChannelInterpretationDiscrete WebAudioChannelInterpretation = "discrete"
+	WebAudioChannelInterpretationSpeakers WebAudioChannelInterpretation = "speakers"
+)
+
+// Valid returns true if the value is one of the WebAudioChannelInterpretation enum values.
+func (e WebAudioChannelInterpretation) Valid() bool {
+	switch e {
+	case WebAudioChannelInterpretationDiscrete,
+		WebAudioChannelInterpretationSpeakers:
+		return true
+	}
+	return false
+}
+
+// Enum of AudioParam::AutomationRate from the spec
+type WebAudioAutomationRate string
+
+const (
+	WebAudioAutomationRateARate WebAudioAutomationRate = "a-rate"
+	WebAudioAutomationRateKRate WebAudioAutomationRate = "k-rate"
+)
+
+// Valid returns true if the value is one of the WebAudioAutomationRate enum values.
+func (e WebAudioAutomationRate) Valid() bool {
+	switch e {
+	case WebAudioAutomationRateARate,
+		WebAudioAutomationRateKRate:
+		return true
+	}
+	return false
+}
+
 // Fields in AudioContext that change in real-time.
 type WebAudioContextRealtimeData struct {
 	CurrentTime              float64 `json:"currentTime"`              // The current context time in second in BaseAudioContext.
@@ -20,8 +115,8 @@ type WebAudioContextRealtimeData struct {
 // Protocol object for BaseAudioContext
 type WebAudioBaseAudioContext struct {
 	ContextId             string                       `json:"contextId"`              //
-	ContextType           string                       `json:"contextType"`            //  enum values: realtime, offline
-	ContextState          string                       `json:"contextState"`           //  enum values: suspended, running, closed
+	ContextType           WebAudioContextType          `json:"contextType"`            //  enum values: realtime, offline
+	ContextState          WebAudioContextState         `json:"contextState"`           //  enum values: suspended, running, closed, interrupted
 	RealtimeData          *WebAudioContextRealtimeData `json:"realtimeData,omitempty"` //
 	CallbackBufferSize    float64                      `json:"callbackBufferSize"`     // Platform-dependent callback buffer size.
 	MaxOutputChannelCount float64                      `json:"maxOutputChannelCount"`  // Number of output channels supported by audio hardware in use.
@@ -36,26 +131,60 @@ type WebAudioAudioListener struct {
 
 // Protocol object for AudioNode
 type WebAudioAudioNode struct {
-	NodeId                string  `json:"nodeId"`                //
-	ContextId             string  `json:"contextId"`             //
-	NodeType              string  `json:"nodeType"`              //
-	NumberOfInputs        float64 `json:"numberOfInputs"`        //
-	NumberOfOutputs       float64 `json:"numberOfOutputs"`       //
-	ChannelCount          float64 `json:"channelCount"`          //
-	ChannelCountMode      string  `json:"channelCountMode"`      //  enum values: clamped-max, explicit, max
-	ChannelInterpretation string  `json:"channelInterpretation"` //  enum values: discrete, speakers
+	NodeId                string                        `json:"nodeId"`                //
+	ContextId             string                        `json:"contextId"`             //
+	NodeType              string                        `json:"nodeType"`              //
+	NumberOfInputs        float64                       `json:"numberOfInputs"`        //
+	NumberOfOutputs       float64                       `json:"numberOfOutputs"`       //
+	ChannelCount          float64                       `json:"channelCount"`          //
+	ChannelCountMode      WebAudioChannelCountMode      `json:"channelCountMode"`      //  enum values: clamped-max, explicit, max
+	ChannelInterpretation WebAudioChannelInterpretation `json:"channelInterpretation"` //  enum values: discrete, speakers
 }
 
 // Protocol object for AudioParam
 type WebAudioAudioParam struct {
-	ParamId      string  `json:"paramId"`      //
-	NodeId       string  `json:"nodeId"`       //
-	ContextId    string  `json:"contextId"`    //
-	ParamType    string  `json:"paramType"`    //
-	Rate         string  `json:"rate"`         //  enum values: a-rate, k-rate
-	DefaultValue float64 `json:"defaultValue"` //
-	MinValue     float64 `json:"minValue"`     //
-	MaxValue     float64 `json:"maxValue"`     //
+	ParamId      string                 `json:"paramId"`      //
+	NodeId       string                 `json:"nodeId"`       //
+	ContextId    string                 `json:"contextId"`    //
+	ParamType    string                 `json:"paramType"`    //
+	Rate         WebAudioAutomationRate `json:"rate"`         //  enum values: a-rate, k-rate
+	DefaultValue float64                `json:"defaultValue"` //
+	MinValue     float64                `json:"minValue"`     //
+	MaxValue     float64                `json:"maxValue"`     //
+}
+
+// Event method names for the WebAudio domain
+const (
+	EventWebAudioContextCreated               = "WebAudio.contextCreated"
+	EventWebAudioContextWillBeDestroyed       = "WebAudio.contextWillBeDestroyed"
+	EventWebAudioContextChanged               = "WebAudio.contextChanged"
+	EventWebAudioAudioListenerCreated         = "WebAudio.audioListenerCreated"
+	EventWebAudioAudioListenerWillBeDestroyed = "WebAudio.audioListenerWillBeDestroyed"
+	EventWebAudioAudioNodeCreated             = "WebAudio.audioNodeCreated"
+	EventWebAudioAudioNodeWillBeDestroyed     = "WebAudio.audioNodeWillBeDestroyed"
+	EventWebAudioAudioParamCreated            = "WebAudio.audioParamCreated"
+	EventWebAudioAudioParamWillBeDestroyed    = "WebAudio.audioParamWillBeDestroyed"
+	EventWebAudioNodesConnected               = "WebAudio.nodesConnected"
+	EventWebAudioNodesDisconnected            = "WebAudio.nodesDisconnected"
+	EventWebAudioNodeParamConnected           = "WebAudio.nodeParamConnected"
+	EventWebAudioNodeParamDisconnected        = "WebAudio.nodeParamDisconnected"
+)
+
+// WebAudioEvents lists all event method names for the WebAudio domain
+var WebAudioEvents = []string{
+	EventWebAudioContextCreated,
+	EventWebAudioContextWillBeDestroyed,
+	EventWebAudioContextChanged,
+	EventWebAudioAudioListenerCreated,
+	EventWebAudioAudioListenerWillBeDestroyed,
+	EventWebAudioAudioNodeCreated,
+	EventWebAudioAudioNodeWillBeDestroyed,
+	EventWebAudioAudioParamCreated,
+	EventWebAudioAudioParamWillBeDestroyed,
+	EventWebAudioNodesConnected,
+	EventWebAudioNodesDisconnected,
+	EventWebAudioNodeParamConnected,
+	EventWebAudioNodeParamDisconnected,
 }
 
 // Notifies that a new BaseAudioContext has been created.
@@ -180,6 +309,9 @@ type WebAudioNodeParamDisconnectedEvent struct {
 	} `json:"Params,omitempty"`
 }
 
+// WebAudio domain commands and events.
+//
+// Experimental: this may change or be removed in a future Chrome release.
 type WebAudio struct {
 	target gcdmessage.ChromeTargeter
 }
@@ -188,57 +320,3 @@ func NewWebAudio(target gcdmessage.ChromeTargeter) *WebAudio {
 	c := &WebAudio{target: target}
 	return c
 }
-
-// Enables the WebAudio domain and starts sending context lifetime events.
-func (c *WebAudio) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAudio.enable"})
-}
-
-// Disables the WebAudio domain.
-func (c *WebAudio) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
-	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAudio.disable"})
-}
-
-type WebAudioGetRealtimeDataParams struct {
-	//
-	ContextId string `json:"contextId"`
-}
-
-// GetRealtimeDataWithParams - Fetch the realtime data from the registered contexts.
-// Returns -  realtimeData -
-func (c *WebAudio) GetRealtimeDataWithParams(ctx context.Context, v *WebAudioGetRealtimeDataParams) (*WebAudioContextRealtimeData, error) {
-	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAudio.getRealtimeData", Params: v})
-	if err != nil {
-		return nil, err
-	}
-
-	var chromeData struct {
-		gcdmessage.ChromeErrorResponse
-		Result struct {
-			RealtimeData *WebAudioContextRealtimeData
-		}
-	}
-
-	if resp == nil {
-		return nil, &gcdmessage.ChromeEmptyResponseErr{}
-	}
-
-	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
-		return nil, err
-	}
-
-	if chromeData.Error != nil {
-		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
-	}
-
-	return chromeData.Result.RealtimeData, nil
-}
-
-// GetRealtimeData - Fetch the realtime data from the registered contexts.
-// contextId -
-// Returns -  realtimeData -
-func (c *WebAudio) GetRealtimeData(ctx context.Context, contextId string) (*WebAudioContextRealtimeData, error) {
-	var v WebAudioGetRealtimeDataParams
-	v.ContextId = contextId
-	return c.GetRealtimeDataWithParams(ctx, &v)
-}
@@ -0,0 +1,235 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains WebAudio functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnContextCreated subscribes to WebAudio.contextCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnContextCreated(callback func(*WebAudioContextCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioContextCreated, func(payload []byte) error {
+		event := &WebAudioContextCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnContextWillBeDestroyed subscribes to WebAudio.contextWillBeDestroyed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnContextWillBeDestroyed(callback func(*WebAudioContextWillBeDestroyedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioContextWillBeDestroyed, func(payload []byte) error {
+		event := &WebAudioContextWillBeDestroyedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnContextChanged subscribes to WebAudio.contextChanged events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnContextChanged(callback func(*WebAudioContextChangedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioContextChanged, func(payload []byte) error {
+		event := &WebAudioContextChangedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAudioListenerCreated subscribes to WebAudio.audioListenerCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnAudioListenerCreated(callback func(*WebAudioAudioListenerCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioAudioListenerCreated, func(payload []byte) error {
+		event := &WebAudioAudioListenerCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAudioListenerWillBeDestroyed subscribes to WebAudio.audioListenerWillBeDestroyed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnAudioListenerWillBeDestroyed(callback func(*WebAudioAudioListenerWillBeDestroyedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioAudioListenerWillBeDestroyed, func(payload []byte) error {
+		event := &WebAudioAudioListenerWillBeDestroyedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAudioNodeCreated subscribes to WebAudio.audioNodeCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnAudioNodeCreated(callback func(*WebAudioAudioNodeCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioAudioNodeCreated, func(payload []byte) error {
+		event := &WebAudioAudioNodeCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAudioNodeWillBeDestroyed subscribes to WebAudio.audioNodeWillBeDestroyed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnAudioNodeWillBeDestroyed(callback func(*WebAudioAudioNodeWillBeDestroyedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioAudioNodeWillBeDestroyed, func(payload []byte) error {
+		event := &WebAudioAudioNodeWillBeDestroyedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAudioParamCreated subscribes to WebAudio.audioParamCreated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnAudioParamCreated(callback func(*WebAudioAudioParamCreatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioAudioParamCreated, func(payload []byte) error {
+		event := &WebAudioAudioParamCreatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnAudioParamWillBeDestroyed subscribes to WebAudio.audioParamWillBeDestroyed events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnAudioParamWillBeDestroyed(callback func(*WebAudioAudioParamWillBeDestroyedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioAudioParamWillBeDestroyed, func(payload []byte) error {
+		event := &WebAudioAudioParamWillBeDestroyedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnNodesConnected subscribes to WebAudio.nodesConnected events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnNodesConnected(callback func(*WebAudioNodesConnectedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioNodesConnected, func(payload []byte) error {
+		event := &WebAudioNodesConnectedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnNodesDisconnected subscribes to WebAudio.nodesDisconnected events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnNodesDisconnected(callback func(*WebAudioNodesDisconnectedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioNodesDisconnected, func(payload []byte) error {
+		event := &WebAudioNodesDisconnectedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnNodeParamConnected subscribes to WebAudio.nodeParamConnected events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnNodeParamConnected(callback func(*WebAudioNodeParamConnectedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioNodeParamConnected, func(payload []byte) error {
+		event := &WebAudioNodeParamConnectedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnNodeParamDisconnected subscribes to WebAudio.nodeParamDisconnected events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAudio) OnNodeParamDisconnected(callback func(*WebAudioNodeParamDisconnectedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAudioNodeParamDisconnected, func(payload []byte) error {
+		event := &WebAudioNodeParamDisconnectedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// Enables the WebAudio domain and starts sending context lifetime events.
+func (c *WebAudio) Enable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAudio.enable"})
+}
+
+// Disables the WebAudio domain.
+func (c *WebAudio) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAudio.disable"})
+}
+
+type WebAudioGetRealtimeDataParams struct {
+	//
+	ContextId string `json:"contextId"`
+}
+
+// GetRealtimeDataWithParams - Fetch the realtime data from the registered contexts.
+// Returns -  realtimeData -
+func (c *WebAudio) GetRealtimeDataWithParams(ctx context.Context, v *WebAudioGetRealtimeDataParams) (*WebAudioContextRealtimeData, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAudio.getRealtimeData", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			RealtimeData *WebAudioContextRealtimeData
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.RealtimeData, nil
+}
+
+// GetRealtimeData - Fetch the realtime data from the registered contexts.
+// contextId -
+// Returns -  realtimeData -
+func (c *WebAudio) GetRealtimeData(ctx context.Context, contextId string) (*WebAudioContextRealtimeData, error) {
+	var v WebAudioGetRealtimeDataParams
+	v.ContextId = contextId
+	return c.GetRealtimeDataWithParams(ctx, &v)
+}
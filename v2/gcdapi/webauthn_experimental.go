@@ -0,0 +1,407 @@
+//go:build !gcd_stable
+
+// AUTO-GENERATED Chrome Remote Debugger Protocol API Client
+// This file contains WebAuthn functionality.
+// API Version: 1.3
+
+package gcdapi
+
+import (
+	"context"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// OnCredentialAdded subscribes to WebAuthn.credentialAdded events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAuthn) OnCredentialAdded(callback func(*WebAuthnCredentialAddedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAuthnCredentialAdded, func(payload []byte) error {
+		event := &WebAuthnCredentialAddedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnCredentialDeleted subscribes to WebAuthn.credentialDeleted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAuthn) OnCredentialDeleted(callback func(*WebAuthnCredentialDeletedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAuthnCredentialDeleted, func(payload []byte) error {
+		event := &WebAuthnCredentialDeletedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnCredentialUpdated subscribes to WebAuthn.credentialUpdated events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAuthn) OnCredentialUpdated(callback func(*WebAuthnCredentialUpdatedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAuthnCredentialUpdated, func(payload []byte) error {
+		event := &WebAuthnCredentialUpdatedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+// OnCredentialAsserted subscribes to WebAuthn.credentialAsserted events, the callback is given the decoded event.
+// Returns a function to unsubscribe.
+func (c *WebAuthn) OnCredentialAsserted(callback func(*WebAuthnCredentialAssertedEvent)) func() {
+	return gcdmessage.SubscribeEvent(c.target, EventWebAuthnCredentialAsserted, func(payload []byte) error {
+		event := &WebAuthnCredentialAssertedEvent{}
+		if err := jsonUnmarshal(payload, event); err != nil {
+			return err
+		}
+		callback(event)
+		return nil
+	})
+}
+
+type WebAuthnEnableParams struct {
+	// Whether to enable the WebAuthn user interface. Enabling the UI is recommended for debugging and demo purposes, as it is closer to the real experience. Disabling the UI is recommended for automated testing. Supported at the embedder's discretion if UI is available. Defaults to false.
+	EnableUI bool `json:"enableUI,omitempty"`
+}
+
+// EnableWithParams - Enable the WebAuthn domain and start intercepting credential storage and retrieval with a virtual authenticator.
+func (c *WebAuthn) EnableWithParams(ctx context.Context, v *WebAuthnEnableParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.enable", Params: v})
+}
+
+// Enable - Enable the WebAuthn domain and start intercepting credential storage and retrieval with a virtual authenticator.
+// enableUI - Whether to enable the WebAuthn user interface. Enabling the UI is recommended for debugging and demo purposes, as it is closer to the real experience. Disabling the UI is recommended for automated testing. Supported at the embedder's discretion if UI is available. Defaults to false.
+func (c *WebAuthn) Enable(ctx context.Context, enableUI bool) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnEnableParams
+	v.EnableUI = enableUI
+	return c.EnableWithParams(ctx, &v)
+}
+
+// Disable the WebAuthn domain.
+func (c *WebAuthn) Disable(ctx context.Context) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.disable"})
+}
+
+type WebAuthnAddVirtualAuthenticatorParams struct {
+	//
+	Options *WebAuthnVirtualAuthenticatorOptions `json:"options"`
+}
+
+// AddVirtualAuthenticatorWithParams - Creates and adds a virtual authenticator.
+// Returns -  authenticatorId -
+func (c *WebAuthn) AddVirtualAuthenticatorWithParams(ctx context.Context, v *WebAuthnAddVirtualAuthenticatorParams) (string, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.addVirtualAuthenticator", Params: v})
+	if err != nil {
+		return "", err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			AuthenticatorId string
+		}
+	}
+
+	if resp == nil {
+		return "", &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return "", err
+	}
+
+	if chromeData.Error != nil {
+		return "", &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.AuthenticatorId, nil
+}
+
+// AddVirtualAuthenticator - Creates and adds a virtual authenticator.
+// options -
+// Returns -  authenticatorId -
+func (c *WebAuthn) AddVirtualAuthenticator(ctx context.Context, options *WebAuthnVirtualAuthenticatorOptions) (string, error) {
+	var v WebAuthnAddVirtualAuthenticatorParams
+	v.Options = options
+	return c.AddVirtualAuthenticatorWithParams(ctx, &v)
+}
+
+type WebAuthnSetResponseOverrideBitsParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+	// If isBogusSignature is set, overrides the signature in the authenticator response to be zero. Defaults to false.
+	IsBogusSignature bool `json:"isBogusSignature,omitempty"`
+	// If isBadUV is set, overrides the UV bit in the flags in the authenticator response to be zero. Defaults to false.
+	IsBadUV bool `json:"isBadUV,omitempty"`
+	// If isBadUP is set, overrides the UP bit in the flags in the authenticator response to be zero. Defaults to false.
+	IsBadUP bool `json:"isBadUP,omitempty"`
+}
+
+// SetResponseOverrideBitsWithParams - Resets parameters isBogusSignature, isBadUV, isBadUP to false if they are not present.
+func (c *WebAuthn) SetResponseOverrideBitsWithParams(ctx context.Context, v *WebAuthnSetResponseOverrideBitsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.setResponseOverrideBits", Params: v})
+}
+
+// SetResponseOverrideBits - Resets parameters isBogusSignature, isBadUV, isBadUP to false if they are not present.
+// authenticatorId -
+// isBogusSignature - If isBogusSignature is set, overrides the signature in the authenticator response to be zero. Defaults to false.
+// isBadUV - If isBadUV is set, overrides the UV bit in the flags in the authenticator response to be zero. Defaults to false.
+// isBadUP - If isBadUP is set, overrides the UP bit in the flags in the authenticator response to be zero. Defaults to false.
+func (c *WebAuthn) SetResponseOverrideBits(ctx context.Context, authenticatorId string, isBogusSignature bool, isBadUV bool, isBadUP bool) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnSetResponseOverrideBitsParams
+	v.AuthenticatorId = authenticatorId
+	v.IsBogusSignature = isBogusSignature
+	v.IsBadUV = isBadUV
+	v.IsBadUP = isBadUP
+	return c.SetResponseOverrideBitsWithParams(ctx, &v)
+}
+
+type WebAuthnRemoveVirtualAuthenticatorParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+}
+
+// RemoveVirtualAuthenticatorWithParams - Removes the given authenticator.
+func (c *WebAuthn) RemoveVirtualAuthenticatorWithParams(ctx context.Context, v *WebAuthnRemoveVirtualAuthenticatorParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.removeVirtualAuthenticator", Params: v})
+}
+
+// RemoveVirtualAuthenticator - Removes the given authenticator.
+// authenticatorId -
+func (c *WebAuthn) RemoveVirtualAuthenticator(ctx context.Context, authenticatorId string) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnRemoveVirtualAuthenticatorParams
+	v.AuthenticatorId = authenticatorId
+	return c.RemoveVirtualAuthenticatorWithParams(ctx, &v)
+}
+
+type WebAuthnAddCredentialParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+	//
+	Credential *WebAuthnCredential `json:"credential"`
+}
+
+// AddCredentialWithParams - Adds the credential to the specified authenticator.
+func (c *WebAuthn) AddCredentialWithParams(ctx context.Context, v *WebAuthnAddCredentialParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.addCredential", Params: v})
+}
+
+// AddCredential - Adds the credential to the specified authenticator.
+// authenticatorId -
+// credential -
+func (c *WebAuthn) AddCredential(ctx context.Context, authenticatorId string, credential *WebAuthnCredential) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnAddCredentialParams
+	v.AuthenticatorId = authenticatorId
+	v.Credential = credential
+	return c.AddCredentialWithParams(ctx, &v)
+}
+
+type WebAuthnGetCredentialParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+	//
+	CredentialId string `json:"credentialId"`
+}
+
+// GetCredentialWithParams - Returns a single credential stored in the given virtual authenticator that matches the credential ID.
+// Returns -  credential -
+func (c *WebAuthn) GetCredentialWithParams(ctx context.Context, v *WebAuthnGetCredentialParams) (*WebAuthnCredential, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.getCredential", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Credential *WebAuthnCredential
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Credential, nil
+}
+
+// GetCredential - Returns a single credential stored in the given virtual authenticator that matches the credential ID.
+// authenticatorId -
+// credentialId -
+// Returns -  credential -
+func (c *WebAuthn) GetCredential(ctx context.Context, authenticatorId string, credentialId string) (*WebAuthnCredential, error) {
+	var v WebAuthnGetCredentialParams
+	v.AuthenticatorId = authenticatorId
+	v.CredentialId = credentialId
+	return c.GetCredentialWithParams(ctx, &v)
+}
+
+type WebAuthnGetCredentialsParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+}
+
+// GetCredentialsWithParams - Returns all the credentials stored in the given virtual authenticator.
+// Returns -  credentials -
+func (c *WebAuthn) GetCredentialsWithParams(ctx context.Context, v *WebAuthnGetCredentialsParams) ([]*WebAuthnCredential, error) {
+	resp, err := c.target.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.getCredentials", Params: v})
+	if err != nil {
+		return nil, err
+	}
+
+	var chromeData struct {
+		gcdmessage.ChromeErrorResponse
+		Result struct {
+			Credentials []*WebAuthnCredential
+		}
+	}
+
+	if resp == nil {
+		return nil, &gcdmessage.ChromeEmptyResponseErr{}
+	}
+
+	if err := jsonUnmarshal(resp.Data, &chromeData); err != nil {
+		return nil, err
+	}
+
+	if chromeData.Error != nil {
+		return nil, &gcdmessage.ChromeRequestErr{Resp: &chromeData.ChromeErrorResponse}
+	}
+
+	return chromeData.Result.Credentials, nil
+}
+
+// GetCredentials - Returns all the credentials stored in the given virtual authenticator.
+// authenticatorId -
+// Returns -  credentials -
+func (c *WebAuthn) GetCredentials(ctx context.Context, authenticatorId string) ([]*WebAuthnCredential, error) {
+	var v WebAuthnGetCredentialsParams
+	v.AuthenticatorId = authenticatorId
+	return c.GetCredentialsWithParams(ctx, &v)
+}
+
+type WebAuthnRemoveCredentialParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+	//
+	CredentialId string `json:"credentialId"`
+}
+
+// RemoveCredentialWithParams - Removes a credential from the authenticator.
+func (c *WebAuthn) RemoveCredentialWithParams(ctx context.Context, v *WebAuthnRemoveCredentialParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.removeCredential", Params: v})
+}
+
+// RemoveCredential - Removes a credential from the authenticator.
+// authenticatorId -
+// credentialId -
+func (c *WebAuthn) RemoveCredential(ctx context.Context, authenticatorId string, credentialId string) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnRemoveCredentialParams
+	v.AuthenticatorId = authenticatorId
+	v.CredentialId = credentialId
+	return c.RemoveCredentialWithParams(ctx, &v)
+}
+
+type WebAuthnClearCredentialsParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+}
+
+// ClearCredentialsWithParams - Clears all the credentials from the specified device.
+func (c *WebAuthn) ClearCredentialsWithParams(ctx context.Context, v *WebAuthnClearCredentialsParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.clearCredentials", Params: v})
+}
+
+// ClearCredentials - Clears all the credentials from the specified device.
+// authenticatorId -
+func (c *WebAuthn) ClearCredentials(ctx context.Context, authenticatorId string) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnClearCredentialsParams
+	v.AuthenticatorId = authenticatorId
+	return c.ClearCredentialsWithParams(ctx, &v)
+}
+
+type WebAuthnSetUserVerifiedParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+	//
+	IsUserVerified bool `json:"isUserVerified"`
+}
+
+// SetUserVerifiedWithParams - Sets whether User Verification succeeds or fails for an authenticator. The default is true.
+func (c *WebAuthn) SetUserVerifiedWithParams(ctx context.Context, v *WebAuthnSetUserVerifiedParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.setUserVerified", Params: v})
+}
+
+// SetUserVerified - Sets whether User Verification succeeds or fails for an authenticator. The default is true.
+// authenticatorId -
+// isUserVerified -
+func (c *WebAuthn) SetUserVerified(ctx context.Context, authenticatorId string, isUserVerified bool) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnSetUserVerifiedParams
+	v.AuthenticatorId = authenticatorId
+	v.IsUserVerified = isUserVerified
+	return c.SetUserVerifiedWithParams(ctx, &v)
+}
+
+type WebAuthnSetAutomaticPresenceSimulationParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+	//
+	Enabled bool `json:"enabled"`
+}
+
+// SetAutomaticPresenceSimulationWithParams - Sets whether tests of user presence will succeed immediately (if true) or fail to resolve (if false) for an authenticator. The default is true.
+func (c *WebAuthn) SetAutomaticPresenceSimulationWithParams(ctx context.Context, v *WebAuthnSetAutomaticPresenceSimulationParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.setAutomaticPresenceSimulation", Params: v})
+}
+
+// SetAutomaticPresenceSimulation - Sets whether tests of user presence will succeed immediately (if true) or fail to resolve (if false) for an authenticator. The default is true.
+// authenticatorId -
+// enabled -
+func (c *WebAuthn) SetAutomaticPresenceSimulation(ctx context.Context, authenticatorId string, enabled bool) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnSetAutomaticPresenceSimulationParams
+	v.AuthenticatorId = authenticatorId
+	v.Enabled = enabled
+	return c.SetAutomaticPresenceSimulationWithParams(ctx, &v)
+}
+
+type WebAuthnSetCredentialPropertiesParams struct {
+	//
+	AuthenticatorId string `json:"authenticatorId"`
+	//
+	CredentialId string `json:"credentialId"`
+	//
+	BackupEligibility bool `json:"backupEligibility,omitempty"`
+	//
+	BackupState bool `json:"backupState,omitempty"`
+}
+
+// SetCredentialPropertiesWithParams - Allows setting credential properties. https://w3c.github.io/webauthn/#sctn-automation-set-credential-properties
+func (c *WebAuthn) SetCredentialPropertiesWithParams(ctx context.Context, v *WebAuthnSetCredentialPropertiesParams) (*gcdmessage.ChromeResponse, error) {
+	return c.target.SendDefaultRequest(ctx, &gcdmessage.ParamRequest{Id: c.target.GetId(), Method: "WebAuthn.setCredentialProperties", Params: v})
+}
+
+// SetCredentialProperties - Allows setting credential properties. https://w3c.github.io/webauthn/#sctn-automation-set-credential-properties
+// authenticatorId -
+// credentialId -
+// backupEligibility -
+// backupState -
+func (c *WebAuthn) SetCredentialProperties(ctx context.Context, authenticatorId string, credentialId string, backupEligibility bool, backupState bool) (*gcdmessage.ChromeResponse, error) {
+	var v WebAuthnSetCredentialPropertiesParams
+	v.AuthenticatorId = authenticatorId
+	v.CredentialId = credentialId
+	v.BackupEligibility = backupEligibility
+	v.BackupState = backupState
+	return c.SetCredentialPropertiesWithParams(ctx, &v)
+}
@@ -35,12 +35,16 @@ type Command struct {
 	ParamCalls         bool
 	ReturnCalls        bool
 	ParamReturnCalls   bool
+	Experimental       bool // may change or be removed in a future protocol version
+	Deprecated         bool // deprecated in the protocol, generates a Deprecated: godoc marker
 }
 
 func NewCommand(protoCommand *ProtoCommand) *Command {
 	c := &Command{}
 	c.Name = protoCommand.Name
 	c.Description = protoCommand.Description
+	c.Experimental = protoCommand.Experimental
+	c.Deprecated = protoCommand.Deprecated
 	if protoCommand.Parameters != nil && len(protoCommand.Parameters) > 0 {
 		c.HasParams = true
 	}
@@ -40,19 +40,35 @@ type Domain struct {
 	Domain   string
 	Imports  []string
 	Hidden   bool
-	SubTypes []*Type
-	Types    []*Type
-	Events   []*Event
-	Commands []*Command
+	// Description and Dependencies are only used by the registry template
+	Description  string
+	Dependencies []string
+	// Experimental domains have their commands written to a separate file which is
+	// excluded when building with the gcd_stable tag.
+	Experimental bool
+	Deprecated   bool
+	BuildTag     string // build constraint for the file being written
+	WriteTypes   bool   // write the types, enums and events of the domain
+	WriteApi     bool   // write the commands and event subscription helpers of the domain
+	Enums        []*Enum
+	SubTypes     []*Type
+	Types        []*Type
+	Events       []*Event
+	Commands     []*Command
 	// basicTypes holds a map of type.RefName and type.Underlying type so we can replace $ref
 	// with the underlying type (provided it's not another object or array)
 
 	//typeMap map[string]*BaseType
 }
 
-func NewDomain(major, minor, domain string) *Domain {
-	d := &Domain{Major: major, Minor: minor, Domain: domain}
+func NewDomain(major, minor string, protoDomain *ProtoDomain) *Domain {
+	d := &Domain{Major: major, Minor: minor, Domain: protoDomain.Domain}
+	d.Experimental = protoDomain.Experimental
+	d.Deprecated = protoDomain.Deprecated
+	d.Description = protoDomain.Description
+	d.Dependencies = protoDomain.Dependencies
 	d.Types = make([]*Type, 0)
+	d.Enums = make([]*Enum, 0)
 	d.SubTypes = make([]*Type, 0)
 	d.Events = make([]*Event, 0)
 	d.Commands = make([]*Command, 0)
@@ -65,6 +81,14 @@ func (d *Domain) PopulateTypes(types []*ProtoType) {
 	// do first pass to get all underlying type information
 	for _, protoType := range types {
 		fmt.Printf("Populating type: %s\n", protoType.Id)
+		if len(protoType.Enum) > 0 {
+			enum := NewEnum(d.Domain+protoType.Id, protoType.Description, protoType.Enum)
+			enum.Experimental = protoType.Experimental
+			enum.Deprecated = protoType.Deprecated
+			d.Enums = append(d.Enums, enum)
+			continue
+		}
+
 		newType := NewType(protoType)
 		// ignore empty property types as we turn those into Refs
 		if len(protoType.Properties) > 0 {
@@ -84,14 +108,13 @@ func (d *Domain) PopulateEvents(events []*ProtoEvent) {
 	for _, protoEvent := range events {
 		newEvent := NewEvent(protoEvent)
 
+		// events without parameters still get a type and method constant
 		if newEvent.HasParams {
-			d.handleEvents(newEvent, protoEvent.Parameters)
-			d.Events = append(d.Events, newEvent)
+			d.handleEvents(newEvent, protoEvent.Parameters, d.Domain+strings.Title(newEvent.Name))
 		} else {
 			fmt.Printf("event: %s has no params\n", newEvent.Name)
-			fmt.Printf("protoEvent: %#v\n", protoEvent)
 		}
-		// don't add structs that don't have parameters.
+		d.Events = append(d.Events, newEvent)
 	}
 }
 
@@ -100,7 +123,7 @@ func (d *Domain) PopulateCommands(commands []*ProtoCommand) {
 		newCmd := NewCommand(protoCommand)
 
 		if newCmd.HasParams {
-			d.handleParameters(newCmd, protoCommand.Parameters)
+			d.handleParameters(newCmd, protoCommand.Parameters, d.Domain+strings.Title(newCmd.Name))
 		}
 
 		if newCmd.HasReturn {
@@ -110,12 +133,20 @@ func (d *Domain) PopulateCommands(commands []*ProtoCommand) {
 	}
 }
 
-func (d *Domain) handleEvents(newEvent *Event, protoParameters []*ProtoProperty) {
+func (d *Domain) handleEvents(newEvent *Event, protoParameters []*ProtoProperty, enumPrefix string) {
 	for _, protoParam := range protoParameters {
 		newParam := NewTypeProperties(protoParam)
 		if isBaseType(newParam) {
 			goType := getGoType(newParam)
 			d.createBase(newParam, goType)
+			d.createInlineEnum(newParam, protoParam, enumPrefix)
+			newEvent.Parameters = append(newEvent.Parameters, newParam)
+			continue
+		}
+
+		// arrays of objects without any properties (Tracing.dataCollected)
+		if newParam.IsArray() && protoParam.Items.Type == "object" && len(protoParam.Items.Properties) == 0 {
+			d.createBase(newParam, "map[string]interface{}")
 			newEvent.Parameters = append(newEvent.Parameters, newParam)
 			continue
 		}
@@ -126,12 +157,13 @@ func (d *Domain) handleEvents(newEvent *Event, protoParameters []*ProtoProperty)
 	}
 }
 
-func (d *Domain) handleParameters(newCmd *Command, protoParameters []*ProtoProperty) {
+func (d *Domain) handleParameters(newCmd *Command, protoParameters []*ProtoProperty, enumPrefix string) {
 	for _, protoParam := range protoParameters {
 		newParam := NewTypeProperties(protoParam)
 		if isBaseType(newParam) {
 			goType := getGoType(newParam)
 			d.createBase(newParam, goType)
+			d.createInlineEnum(newParam, protoParam, enumPrefix)
 			newCmd.Parameters = append(newCmd.Parameters, newParam)
 			continue
 		}
@@ -168,6 +200,7 @@ func (d *Domain) handleType(newType *Type, typeProperties []*ProtoProperty) {
 		if isBaseType(protoProp) {
 			goType := getGoType(newProp)
 			d.createBase(newProp, goType)
+			d.createInlineEnum(newProp, protoProp, d.Domain+strings.Title(newType.Name))
 			newType.Properties = append(newType.Properties, newProp)
 			continue
 		}
@@ -198,6 +231,20 @@ func (d *Domain) createBase(prop PropSetter, goType string) {
 	return
 }
 
+// Properties and parameters which define their enum values inline get a named type
+// of prefix + property name, such as InputDispatchKeyEventType.
+func (d *Domain) createInlineEnum(prop *TypeProperties, protoProp *ProtoProperty, prefix string) {
+	if len(protoProp.Enum) == 0 || prop.IsTypeArray {
+		return
+	}
+
+	enum := NewEnum(prefix+strings.Title(protoProp.Name), protoProp.Description, protoProp.Enum)
+	enum.Experimental = protoProp.Experimental
+	enum.Deprecated = protoProp.Deprecated
+	d.Enums = append(d.Enums, enum)
+	prop.SetGoType(enum.Name)
+}
+
 // Creates a new SubType *Type object. This is for nested structs that are better
 // defined outside of the original Type. It will call handleType to iterate over
 // the nested properties to create it in much of the same way as a normal type
@@ -287,7 +334,25 @@ func (d *Domain) ResolveImports() {
 	}
 }
 
+// WriteDomain writes the domain to <domain>.go, experimental domains have their commands
+// written to <domain>_experimental.go so they can be excluded with the gcd_stable build tag.
 func (d *Domain) WriteDomain() {
+	baseName := outputDir + string(os.PathSeparator) + strings.ToLower(d.Domain)
+
+	if !d.Experimental {
+		d.BuildTag, d.WriteTypes, d.WriteApi = "", true, true
+		d.writeFile(baseName + ".go")
+		return
+	}
+
+	d.BuildTag, d.WriteTypes, d.WriteApi = "", true, false
+	d.writeFile(baseName + ".go")
+
+	d.BuildTag, d.WriteTypes, d.WriteApi = "!gcd_stable", false, true
+	d.writeFile(baseName + "_experimental.go")
+}
+
+func (d *Domain) writeFile(domainFile string) {
 	if debug {
 		wr := os.Stdout
 
@@ -298,7 +363,6 @@ func (d *Domain) WriteDomain() {
 		return
 	}
 
-	domainFile := outputDir + string(os.PathSeparator) + strings.ToLower(d.Domain) + ".go"
 	wr, err := os.Create(domainFile)
 	if err != nil {
 		log.Fatalf("error creating output file: %s\n", err)
@@ -309,20 +373,21 @@ func (d *Domain) WriteDomain() {
 		log.Fatalf("Error writing to template: %s\n", err)
 	}
 
-	// clean up file
-	cmd := exec.Command("gofmt", "-w", domainFile)
-	var out bytes.Buffer
-	var outErr bytes.Buffer
-	cmd.Stdout = &out
-	cmd.Stderr = &outErr
-	err = cmd.Start()
-	if err != nil {
-		log.Fatalf("error running gofmt! %s\n", err)
-	}
+	wr.Close()
 
-	err = cmd.Wait()
-	if err != nil {
-		fmt.Printf("error in domain: %s\n%s", d.Domain, outErr.String())
+	if err := gofmtFile(domainFile); err != nil {
+		fmt.Printf("error in domain: %s\n", d.Domain)
 		log.Fatalf("error waiting for gofmt to complete: %s\n", err)
 	}
 }
+
+// gofmtFile runs gofmt -w over a generated file, returning gofmt's stderr on failure.
+func gofmtFile(file string) error {
+	cmd := exec.Command("gofmt", "-w", file)
+	var outErr bytes.Buffer
+	cmd.Stderr = &outErr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s\n%s", err, outErr.String())
+	}
+	return nil
+}
@@ -33,6 +33,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 )
 
 const (
@@ -104,8 +105,18 @@ func getRevisionData(channel string) *RevisionInfo {
 }
 
 func download(browserFile, jsFile string) {
-	browserData := fixBrokenBool(getRemoteFile(browserFile))
-	jsData := fixBrokenBool(getRemoteFile(jsFile))
+	mergeProtocol(getRemoteFile(browserFile), getRemoteFile(jsFile))
+}
+
+// readLocal merges browser_protocol.json and js_protocol.json from a local checkout of
+// devtools-protocol, or the json directory of its npm package, such as when offline.
+func readLocal(dir string) {
+	mergeProtocol(getLocalFile(filepath.Join(dir, "browser_protocol.json")), getLocalFile(filepath.Join(dir, "js_protocol.json")))
+}
+
+func mergeProtocol(browserData, jsData []byte) {
+	browserData = fixBrokenBool(browserData)
+	jsData = fixBrokenBool(jsData)
 
 	api := &ProtoDebuggerApi{}
 	copyApi := &ProtoDebuggerApi{}
@@ -147,6 +158,15 @@ func getRemoteFile(fileName string) []byte {
 	return data
 }
 
+func getLocalFile(fileName string) []byte {
+	log.Printf("reading: %s\n", fileName)
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		log.Fatalf("error reading %s: %s\n", fileName, err)
+	}
+	return data
+}
+
 // because google's git viewer is broken, downloading ?format=json, we have to
 // base64 decode the text response.
 func decodeProtocol(data []byte) []byte {
@@ -167,8 +187,27 @@ func writeFile(protocolData []byte) {
 	if err != nil {
 		log.Fatalf("error opening file %s for writing: %s\n", file, err)
 	}
-	protocolData = bytes.Replace(protocolData, []byte("\\n"), []byte(" "), -1) // remove newlines
-	f.Write(protocolData)
+	f.Write(removeNewlines(protocolData))
 	f.Sync()
 	f.Close()
 }
+
+// removeNewlines replaces the \n escapes in the json strings with spaces so descriptions
+// fit on a single comment line. An escaped backslash followed by n is left as is.
+func removeNewlines(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		if data[i] != '\\' || i+1 == len(data) {
+			out = append(out, data[i])
+			continue
+		}
+
+		if data[i+1] == 'n' {
+			out = append(out, ' ')
+		} else {
+			out = append(out, data[i], data[i+1])
+		}
+		i++
+	}
+	return out
+}
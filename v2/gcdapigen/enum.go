@@ -0,0 +1,75 @@
+/*
+The MIT License (MIT)
+
+Copyright (c) 2016 isaac dawson
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Stores the go type names of all generated enums so they are not turned into pointers
+var enumTypes = make(map[string]bool)
+
+// A named string type generated for protocol enums, either a type with
+// enum values or a property/parameter which defines its enum values inline.
+type Enum struct {
+	Name         string // the go type name "NetworkResourceType"
+	Description  string // the description/comments for the type
+	Experimental bool   // may change or be removed in a future protocol version
+	Deprecated   bool   // deprecated in the protocol, generates a Deprecated: godoc marker
+	Values       []*EnumValue
+}
+
+// An enum value and the name of the constant it is generated as
+type EnumValue struct {
+	Name  string // the constant name "NetworkResourceTypeDocument"
+	Value string // the protocol value "Document"
+}
+
+func NewEnum(name, description string, values []string) *Enum {
+	e := &Enum{Name: name, Description: description}
+	if e.Description == "" {
+		e.Description = "No Description."
+	}
+	e.Values = make([]*EnumValue, 0, len(values))
+	for _, value := range values {
+		e.Values = append(e.Values, &EnumValue{Name: name + enumConstName(value), Value: value})
+	}
+	enumTypes[name] = true
+	return e
+}
+
+// turns values like "cache-storage" or "RenderFrameHostReused_CrossSite" into
+// CacheStorage and RenderFrameHostReusedCrossSite.
+func enumConstName(value string) string {
+	parts := strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, part := range parts {
+		parts[i] = strings.Title(part)
+	}
+	return strings.Join(parts, "")
+}
@@ -25,17 +25,21 @@ THE SOFTWARE.
 package main
 
 type Event struct {
-	protoEvent  *ProtoEvent
-	Name        string
-	Description string
-	Parameters  []*TypeProperties
-	HasParams   bool
+	protoEvent   *ProtoEvent
+	Name         string
+	Description  string
+	Parameters   []*TypeProperties
+	HasParams    bool
+	Experimental bool // may change or be removed in a future protocol version
+	Deprecated   bool // deprecated in the protocol, generates a Deprecated: godoc marker
 }
 
 func NewEvent(protoEvent *ProtoEvent) *Event {
 	e := &Event{}
 	e.Name = protoEvent.Name
 	e.Description = protoEvent.Description
+	e.Experimental = protoEvent.Experimental
+	e.Deprecated = protoEvent.Deprecated
 	if protoEvent.Parameters != nil && len(protoEvent.Parameters) > 0 {
 		e.HasParams = true
 	}
@@ -38,8 +38,8 @@ import (
 type GlobalReference struct {
 	LocalRefName    string // the local reference name "StackTrace"
 	ExternalGoName  string // the gotype name "ConsoleStackTrace"
-	IsBaseType      bool   // is this a base type (int, string, float64 etc)
-	BaseType        string // what is it
+	IsBaseType      bool   // is this a base type (int, string, float64 etc) or an enum type
+	BaseType        string // what is it, for enums this is the generated enum type name
 	EnumDescription string // is it an enum, if so keep metadata of enum values
 	IsArrayRef      bool   // is this a reference to another type that is of an array type
 	RefToRefName    string // reference to another reference
@@ -53,6 +53,7 @@ const (
 	outputDir    = "output"
 	prefix       = "chrome_"
 	templateFile = "api_template.txt"
+	registryFile = "registry_template.txt"
 	versionData  = `package gcdapi
 
 import "github.com/goccy/go-json"
@@ -74,6 +75,7 @@ const DEVTOOLS_MINOR_VERSION = "%s"`
 var file string
 var update bool
 var rev string
+var dir string
 var templates *template.Template // for code generation
 var funcMap template.FuncMap     // helper funcs
 var revisionInfo *RevisionInfo
@@ -81,23 +83,25 @@ var revisionInfo *RevisionInfo
 func init() {
 	flag.BoolVar(&update, "update", false, "download and merge js_protocol.json and browser_protocol.json into protocol.json")
 	flag.StringVar(&rev, "rev", "master", "with -update, the revision (branch/tag/commit) from which to download protocol files")
+	flag.StringVar(&dir, "dir", "", "with -update, read browser_protocol.json and js_protocol.json from this directory instead of downloading them")
 	flag.StringVar(&file, "file", "protocol.json", "open remote debugger protocol file, if -update the filename to write to.")
 	funcMap := template.FuncMap{
-		"Title":    strings.Title,
-		"ToLower":  strings.ToLower,
-		"Reserved": modifyReserved,
-		"NullType": nullType,
+		"Title":         strings.Title,
+		"ToLower":       strings.ToLower,
+		"Reserved":      modifyReserved,
+		"NullType":      nullType,
+		"ProtocolType":  protocolType,
+		"ProtocolItems": protocolItems,
 	}
-	templates = template.Must(template.New(templateFile).Funcs(funcMap).ParseFiles(templateFile))
+	templates = template.Must(template.New(templateFile).Funcs(funcMap).ParseFiles(templateFile, registryFile))
 }
 
 func main() {
-	var domains []*Domain
-	globalRefs = make(map[string]*GlobalReference)
-
 	flag.Parse()
 
-	if update {
+	if update && dir != "" {
+		readLocal(dir)
+	} else if update {
 		revisionInfo = getApiRevision(rev)
 	}
 
@@ -107,6 +111,17 @@ func main() {
 	}
 
 	protocolApi := unmarshalProtocol(protocolData)
+	domains := generateDomains(protocolApi)
+
+	writeVersionFile(revisionInfo, protocolApi.Version)
+	writeRegistryFile(domains)
+}
+
+// generateDomains writes the types, events and commands of every domain to the output directory.
+func generateDomains(protocolApi *ProtoDebuggerApi) []*Domain {
+	var domains []*Domain
+	globalRefs = make(map[string]*GlobalReference)
+
 	major := protocolApi.Version.Major
 	minor := protocolApi.Version.Minor
 
@@ -116,7 +131,7 @@ func main() {
 	}
 
 	for _, protoDomain := range protocolApi.Domains {
-		domain := NewDomain(major, minor, protoDomain.Domain)
+		domain := NewDomain(major, minor, protoDomain)
 		fmt.Printf("Creating api for domain: %s\n", protoDomain.Domain)
 
 		// Do types first
@@ -137,8 +152,26 @@ func main() {
 		domain.ResolveImports()
 		domain.WriteDomain()
 	}
+	return domains
+}
 
-	writeVersionFile(revisionInfo, protocolApi.Version)
+// writeRegistryFile writes registry.go, runtime metadata of every domain, command and event.
+func writeRegistryFile(domains []*Domain) {
+	registryOutput := outputDir + string(os.PathSeparator) + "registry.go"
+	wr, err := os.Create(registryOutput)
+	if err != nil {
+		log.Fatalf("error creating registry output file: %s\n", err)
+	}
+
+	err = templates.ExecuteTemplate(wr, registryFile, domains)
+	wr.Close()
+	if err != nil {
+		log.Fatalf("Error writing to registry template: %s\n", err)
+	}
+
+	if err := gofmtFile(registryOutput); err != nil {
+		log.Fatalf("error waiting for gofmt to complete: %s\n", err)
+	}
 }
 
 func writeVersionFile(revision *RevisionInfo, apiVersion *ProtoApiVersion) {
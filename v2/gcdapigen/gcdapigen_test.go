@@ -0,0 +1,91 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testProtocol = `{"version":{"major":"1","minor":"3"},"domains":[
+{"domain":"Stable","description":"A stable domain.","types":[
+	{"id":"Thing","type":"object","properties":[
+		{"name":"name","type":"string"},
+		{"name":"oldName","type":"string","optional":true,"deprecated":true}]}],
+"commands":[
+	{"name":"enable"},
+	{"name":"getThing","parameters":[{"name":"id","type":"string"}],"returns":[{"name":"thing","$ref":"Thing"}]},
+	{"name":"oldCommand","deprecated":true,"parameters":[{"name":"id","type":"string"}]}],
+"events":[{"name":"thingAdded","parameters":[{"name":"thing","$ref":"Thing"}]}]},
+{"domain":"Lab","description":"An experimental domain.","experimental":true,"types":[
+	{"id":"Mode","type":"string","enum":["on","off"]}],
+"commands":[
+	{"name":"setMode","parameters":[{"name":"mode","$ref":"Mode"},{"name":"legacy","type":"boolean","optional":true,"deprecated":true}]},
+	{"name":"oldCommand","deprecated":true,"parameters":[{"name":"id","type":"string"}]}],
+"events":[{"name":"modeChanged","parameters":[{"name":"mode","$ref":"Mode"}]}]}]}`
+
+func TestGenerateBuildTags(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error getting working directory: %s\n", err)
+	}
+
+	dir := t.TempDir()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("error changing to temp directory: %s\n", err)
+	}
+	defer os.Chdir(wd)
+
+	createOutputDirectory()
+	generateDomains(unmarshalProtocol([]byte(testProtocol)))
+
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(dir, outputDir, name))
+		if err != nil {
+			t.Fatalf("error reading generated %s: %s\n", name, err)
+		}
+		return string(data)
+	}
+
+	stable := read("stable.go")
+	if strings.Contains(stable, "//go:build") {
+		t.Fatalf("stable.go should not have a build tag:\n%s\n", stable)
+	}
+	if !strings.Contains(stable, "func (c *Stable) GetThing(") {
+		t.Fatalf("stable.go should have the commands of a stable domain:\n%s\n", stable)
+	}
+	if !strings.Contains(stable, "// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.\nfunc (c *Stable) OldCommand(") {
+		t.Fatalf("expected a Deprecated comment on Stable.OldCommand:\n%s\n", stable)
+	}
+	if !strings.Contains(stable, "// Deprecated: this field is deprecated in the protocol.\n\tOldName string") {
+		t.Fatalf("expected a Deprecated comment on StableThing.OldName:\n%s\n", stable)
+	}
+	if _, err := os.Stat(filepath.Join(dir, outputDir, "stable_experimental.go")); !os.IsNotExist(err) {
+		t.Fatalf("a stable domain should not have an _experimental.go file: %v\n", err)
+	}
+
+	lab := read("lab.go")
+	if strings.Contains(lab, "//go:build") {
+		t.Fatalf("lab.go holds the types and events and should not have a build tag:\n%s\n", lab)
+	}
+	if !strings.Contains(lab, "LabModeChangedEvent") || !strings.Contains(lab, "type LabMode string") {
+		t.Fatalf("lab.go should have the types and events of the experimental domain:\n%s\n", lab)
+	}
+	if strings.Contains(lab, "func (c *Lab)") {
+		t.Fatalf("lab.go should not have the commands of the experimental domain:\n%s\n", lab)
+	}
+
+	labExperimental := read("lab_experimental.go")
+	if !strings.HasPrefix(labExperimental, "//go:build !gcd_stable\n") {
+		t.Fatalf("lab_experimental.go should start with the gcd_stable build tag:\n%s\n", labExperimental)
+	}
+	if !strings.Contains(labExperimental, "func (c *Lab) SetMode(") {
+		t.Fatalf("lab_experimental.go should have the commands of the experimental domain:\n%s\n", labExperimental)
+	}
+	if !strings.Contains(labExperimental, "// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.\nfunc (c *Lab) OldCommand(") {
+		t.Fatalf("expected a Deprecated comment on Lab.OldCommand:\n%s\n", labExperimental)
+	}
+	if !strings.Contains(labExperimental, "// Deprecated: this is deprecated in the protocol and may be removed in a future Chrome release.\n\tLegacy bool") {
+		t.Fatalf("expected a Deprecated comment on LabSetModeParams.Legacy:\n%s\n", labExperimental)
+	}
+}
@@ -38,26 +38,31 @@ type ProtoApiVersion struct {
 
 // The Domain (contains all objects, their type/commands/events)
 type ProtoDomain struct {
-	Domain      string          `json:"domain"`
-	Description string          `json:"description,omitempty"`
-	Types       []*ProtoType    `json:"types,omitempty"`
-	Commands    []*ProtoCommand `json:"commands,omitempty"`
-	Events      []*ProtoEvent   `json:"events,omitempty"`
-	Hidden      bool            `json:"hidden,omitempty"`
-	Items       *ProtoItem      `json:"items,omitempty"`
+	Domain       string          `json:"domain"`
+	Description  string          `json:"description,omitempty"`
+	Types        []*ProtoType    `json:"types,omitempty"`
+	Commands     []*ProtoCommand `json:"commands,omitempty"`
+	Events       []*ProtoEvent   `json:"events,omitempty"`
+	Hidden       bool            `json:"hidden,omitempty"`
+	Items        *ProtoItem      `json:"items,omitempty"`
+	Dependencies []string        `json:"dependencies,omitempty"`
+	Experimental bool            `json:"experimental,omitempty"`
+	Deprecated   bool            `json:"deprecated,omitempty"`
 }
 
 // A Type which represents objects specific to the API method
 type ProtoType struct {
-	Id          string           `json:"id"`
-	Type        string           `json:"type"`
-	Description string           `json:"description,omitempty"`
-	Enum        []string         `json:"enum,omitempty"`
-	Properties  []*ProtoProperty `json:"properties,omitempty"`
-	Hidden      bool             `json:"hidden,omitempty"`
-	Items       *ProtoItem       `json:"items,omitempty"`
-	MinItems    int64            `json:"minItems,omitempty"`
-	MaxItems    int64            `json:"maxItems,omitempty"`
+	Id           string           `json:"id"`
+	Type         string           `json:"type"`
+	Description  string           `json:"description,omitempty"`
+	Enum         []string         `json:"enum,omitempty"`
+	Properties   []*ProtoProperty `json:"properties,omitempty"`
+	Hidden       bool             `json:"hidden,omitempty"`
+	Items        *ProtoItem       `json:"items,omitempty"`
+	MinItems     int64            `json:"minItems,omitempty"`
+	MaxItems     int64            `json:"maxItems,omitempty"`
+	Experimental bool             `json:"experimental,omitempty"`
+	Deprecated   bool             `json:"deprecated,omitempty"`
 }
 
 func (p *ProtoType) IsNonPropertiesObject() bool {
@@ -87,15 +92,17 @@ func (p *ProtoType) IsArray() bool {
 
 // A property & Parameter type used by both commands & types
 type ProtoProperty struct {
-	Name        string           `json:"name"`
-	Type        string           `json:"type,omitempty"`
-	Description string           `json:"description,omitempty"`
-	Ref         string           `json:"$ref,omitempty"`
-	Optional    bool             `json:"optional,omitempty"`
-	Hidden      bool             `json:"hidden,omitempty"`
-	Enum        []string         `json:"enum,omitempty"`
-	Items       *ProtoItem       `json:"items,omitempty"`
-	Properties  []*ProtoProperty `json:"properties,omitempty"`
+	Name         string           `json:"name"`
+	Type         string           `json:"type,omitempty"`
+	Description  string           `json:"description,omitempty"`
+	Ref          string           `json:"$ref,omitempty"`
+	Optional     bool             `json:"optional,omitempty"`
+	Hidden       bool             `json:"hidden,omitempty"`
+	Enum         []string         `json:"enum,omitempty"`
+	Items        *ProtoItem       `json:"items,omitempty"`
+	Properties   []*ProtoProperty `json:"properties,omitempty"`
+	Experimental bool             `json:"experimental,omitempty"`
+	Deprecated   bool             `json:"deprecated,omitempty"`
 }
 
 func (p *ProtoProperty) IsNonPropertiesObject() bool {
@@ -134,38 +141,45 @@ type ProtoItem struct {
 
 // The API Command call.
 type ProtoCommand struct {
-	Name        string                 `json:"name"`
-	Type        string                 `json:"type,omitempty"`
-	Description string                 `json:"description,omitempty"`
-	Handlers    []string               `json:"handlers,omitempty"`
-	Parameters  []*ProtoProperty       `json:"parameters,omitempty"`
-	Returns     []*ProtoCommandReturns `json:"returns,omitempty"`
-	Hidden      bool                   `json:"hidden,omitempty"`
-	Async       bool                   `json:"async,omitempty"`
-	Redirect    string                 `json:"redirect,omitempty"`
+	Name         string                 `json:"name"`
+	Type         string                 `json:"type,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Handlers     []string               `json:"handlers,omitempty"`
+	Parameters   []*ProtoProperty       `json:"parameters,omitempty"`
+	Returns      []*ProtoCommandReturns `json:"returns,omitempty"`
+	Hidden       bool                   `json:"hidden,omitempty"`
+	Async        bool                   `json:"async,omitempty"`
+	Redirect     string                 `json:"redirect,omitempty"`
+	Experimental bool                   `json:"experimental,omitempty"`
+	Deprecated   bool                   `json:"deprecated,omitempty"`
 }
 
 // The return parameters for an API call
 type ProtoCommandReturns struct {
-	Name        string     `json:"name"`
-	Type        string     `json:"type,omitempty"`
-	Ref         string     `json:"$ref,omitempty"`
-	Items       *ProtoItem `json:"items,omitempty"`
-	Description string     `json:"description,omitempty"`
+	Name         string     `json:"name"`
+	Type         string     `json:"type,omitempty"`
+	Ref          string     `json:"$ref,omitempty"`
+	Items        *ProtoItem `json:"items,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	Optional     bool       `json:"optional,omitempty"`
+	Experimental bool       `json:"experimental,omitempty"`
+	Deprecated   bool       `json:"deprecated,omitempty"`
 }
 
 // An event, asynchronous events that can come in once
 // enabled.
 type ProtoEvent struct {
-	Name        string           `json:"name"`
-	Type        string           `json:"type,omitempty"`
-	Description string           `json:"description,omitempty"`
-	Ref         string           `json:"$ref,omitempty"`
-	Optional    bool             `json:"optional,omitempty"`
-	Hidden      bool             `json:"hidden,omitempty"`
-	Enum        []string         `json:"enum,omitempty"`
-	Items       *ProtoItem       `json:"items,omitempty"`
-	Parameters  []*ProtoProperty `json:"parameters,omitempty"`
+	Name         string           `json:"name"`
+	Type         string           `json:"type,omitempty"`
+	Description  string           `json:"description,omitempty"`
+	Ref          string           `json:"$ref,omitempty"`
+	Optional     bool             `json:"optional,omitempty"`
+	Hidden       bool             `json:"hidden,omitempty"`
+	Enum         []string         `json:"enum,omitempty"`
+	Items        *ProtoItem       `json:"items,omitempty"`
+	Parameters   []*ProtoProperty `json:"parameters,omitempty"`
+	Experimental bool             `json:"experimental,omitempty"`
+	Deprecated   bool             `json:"deprecated,omitempty"`
 }
 
 func (p *ProtoEvent) IsNonPropertiesObject() bool {
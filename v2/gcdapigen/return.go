@@ -45,6 +45,7 @@ func NewReturn(protoReturn *ProtoCommandReturns) *Return {
 	r.Description = protoReturn.Description
 	r.UnderlyingType = protoReturn.Type
 	r.Ref = protoReturn.Ref
+	r.Optional = protoReturn.Optional
 
 	if protoReturn.Ref != "" {
 		r.IsRef = true
@@ -47,6 +47,10 @@ func nullType(input string) string {
 		return "nil"
 	}
 	//fmt.Printf("INPUT: %s\n", input)
+	if enumTypes[input] {
+		return "\"\""
+	}
+
 	switch input {
 	case "int":
 		return "0"
@@ -61,3 +65,42 @@ func nullType(input string) string {
 	}
 	return "nil"
 }
+
+// protocolTyped is implemented by parameters, properties and returns so the
+// registry template can output their protocol type names.
+type protocolTyped interface {
+	GetRef() string
+	GetUnderlyingType() string
+	GetArrayType() string
+}
+
+// qualifyRef prefixes local references with their domain, FrameId becomes Page.FrameId
+func qualifyRef(domain, ref string) string {
+	if strings.Contains(ref, ".") {
+		return ref
+	}
+	return domain + "." + ref
+}
+
+// protocolType returns the protocol type as defined in protocol.json, either a basic type
+// such as "string" and "array" or a domain qualified reference such as "Page.FrameId".
+func protocolType(domain string, p protocolTyped) string {
+	if p.GetUnderlyingType() == "array" {
+		return "array"
+	}
+	if p.GetRef() != "" {
+		return qualifyRef(domain, p.GetRef())
+	}
+	return p.GetUnderlyingType()
+}
+
+// protocolItems returns the protocol type of array elements, or an empty string if not an array.
+func protocolItems(domain string, p protocolTyped) string {
+	if p.GetUnderlyingType() != "array" {
+		return ""
+	}
+	if p.GetRef() != "" {
+		return qualifyRef(domain, p.GetRef())
+	}
+	return p.GetArrayType()
+}
@@ -36,6 +36,8 @@ type Type struct {
 	UnderlyingType string // the type defined in protocol.json
 	EnumVals       string // if it's an enum string list out the possible values as a comment
 	IsSubType      bool   // is this a sub type? (Should be prefixed with Sub in template)
+	Experimental   bool   // may change or be removed in a future protocol version
+	Deprecated     bool   // deprecated in the protocol, generates a Deprecated: godoc marker
 	Properties     []*TypeProperties
 }
 
@@ -48,6 +50,8 @@ func NewType(protoType *ProtoType) *Type {
 		t.Description = "No Description."
 	}
 	t.UnderlyingType = protoType.Type
+	t.Experimental = protoType.Experimental
+	t.Deprecated = protoType.Deprecated
 	t.Properties = make([]*TypeProperties, 0)
 	return t
 }
@@ -37,6 +37,8 @@ type TypeProperties struct {
 	IsRef          bool // is a reference to another type
 	IsPointer      bool // should we output as pointer (for API types, not basic types)
 	IsTypeArray    bool // for templates to spit out []
+	Experimental   bool // may change or be removed in a future protocol version
+	Deprecated     bool // deprecated in the protocol, generates a Deprecated: godoc marker
 }
 
 func NewTypeProperties(props *ProtoProperty) *TypeProperties {
@@ -47,6 +49,8 @@ func NewTypeProperties(props *ProtoProperty) *TypeProperties {
 	tp.Optional = props.Optional
 	tp.Ref = props.Ref
 	tp.UnderlyingType = props.Type
+	tp.Experimental = props.Experimental
+	tp.Deprecated = props.Deprecated
 	if tp.IsArray() {
 		tp.IsTypeArray = true
 		if arrayRef := tp.ArrayRef(); arrayRef != "" {
@@ -69,6 +69,12 @@ func PopulateReferences(domain string, types []*ProtoType) {
 			ref.BaseType = getGoType(protoType)
 		}
 
+		// enums get their own named string type
+		if len(protoType.Enum) > 0 {
+			ref.BaseType = domain + protoType.Id
+			enumTypes[ref.BaseType] = true
+		}
+
 		ref.ExternalGoName = domain + protoType.Id
 		// an array pointing to another type
 		if protoType.IsArray() {
@@ -162,6 +168,10 @@ func isSubType(protoProp *ProtoProperty) bool {
 
 func isPointerType(props PropSetter) bool {
 	goType := props.GetGoType()
+	if enumTypes[goType] {
+		return false
+	}
+
 	switch goType {
 	case "int", "string", "bool", "float64", "map[string]interface{}", "interface{}":
 		return false
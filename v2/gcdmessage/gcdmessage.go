@@ -41,6 +41,23 @@ type ChromeTargeter interface {
 	SendDefaultRequest(ctx context.Context, paramRequest *ParamRequest) (*ChromeResponse, error)
 }
 
+// EventSubscriber is implemented by targets which deliver events to the typed gcdapi On<Event>
+// helpers, such as gcd.ChromeTarget. It is kept out of ChromeTargeter so other implementations
+// don't have to provide it.
+type EventSubscriber interface {
+	SubscribeEvent(method string, callback func(payload []byte) error) func() // returns a function to unsubscribe
+}
+
+// SubscribeEvent subscribes callback to method events if target is an EventSubscriber, otherwise
+// nothing is subscribed and the returned unsubscribe function does nothing.
+func SubscribeEvent(target ChromeTargeter, method string, callback func(payload []byte) error) func() {
+	subscriber, ok := target.(EventSubscriber)
+	if !ok {
+		return func() {}
+	}
+	return subscriber.SubscribeEvent(method, callback)
+}
+
 // An internal message object used for components and ChromeTarget to communicate back and forth
 type Message struct {
 	ReplyCh chan *Message  // json response channel
@@ -48,6 +65,7 @@ type Message struct {
 	Data    []byte         // the data for the websocket to send/recv
 	Method  string         // event name type.
 	Target  ChromeTargeter // reference to the ChromeTarget for events
+	Err     error          // set instead of Data when the request failed without a response
 }
 
 // default response object, contains the id and a result if applicable.
@@ -58,9 +76,10 @@ type ChromeResponse struct {
 
 // default no-arg request
 type ChromeRequest struct {
-	Id     int64       `json:"id"`
-	Method string      `json:"method"`
-	Params interface{} `json:"params,omitempty"`
+	Id        int64       `json:"id"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params,omitempty"`
+	SessionId string      `json:"sessionId,omitempty"` // flattened session this request is for, empty for the connection's own target
 }
 
 // default chrome error response to an invalid request.
@@ -116,7 +135,8 @@ func (cerr *ChromeCtxDoneErr) Error() string {
 
 // default request object that has parameters.
 type ParamRequest struct {
-	Id     int64       `json:"id"`
-	Method string      `json:"method"`
-	Params interface{} `json:"params,omitempty"`
+	Id        int64       `json:"id"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params,omitempty"`
+	SessionId string      `json:"sessionId,omitempty"` // flattened session this request is for, empty for the connection's own target
 }
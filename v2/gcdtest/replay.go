@@ -0,0 +1,196 @@
+package gcdtest
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/wirepair/gcd/v2/observer"
+)
+
+// ErrReplayClosed is returned reading from a closed ReplayTransport
+var ErrReplayClosed = errors.New("replay transport closed")
+
+// replayStep is a recorded response and the events which followed it
+type replayStep struct {
+	method   string
+	response []byte
+	events   [][]byte
+	used     bool
+}
+
+// replayer hands out recorded responses by method in the order they were recorded
+type replayer struct {
+	lock    sync.Mutex
+	initial [][]byte // events recorded before any response
+	steps   []*replayStep
+}
+
+func newReplayer(records []*observer.Record) *replayer {
+	r := &replayer{}
+	var last *replayStep
+	for _, record := range records {
+		switch record.Type {
+		case observer.RecordResponse:
+			if len(record.Data) == 0 {
+				// the caller gave up (timeout, canceled), chrome's reply was never seen
+				continue
+			}
+			last = &replayStep{method: record.Method, response: record.Data}
+			r.steps = append(r.steps, last)
+		case observer.RecordEvent:
+			if last == nil {
+				r.initial = append(r.initial, record.Data)
+				continue
+			}
+			last.events = append(last.events, record.Data)
+		}
+	}
+	return r
+}
+
+// next returns the first unused recorded step for method
+func (r *replayer) next(method string) *replayStep {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, step := range r.steps {
+		if !step.used && step.method == method {
+			step.used = true
+			return step
+		}
+	}
+	return nil
+}
+
+// takeInitial returns the events recorded before the first response, only once
+func (r *replayer) takeInitial() [][]byte {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	events := r.initial
+	r.initial = nil
+	return events
+}
+
+// Replay serves a recorded transcript (see observer.RecordingObserver) for requests without
+// a handler. Each request is answered with the next unused response recorded for its method,
+// then the events recorded after that response are sent. Requests with no recorded response
+// get an error reply.
+func (s *Server) Replay(records []*observer.Record) {
+	r := newReplayer(records)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.fallback = func(req *Request) (interface{}, error) {
+		step := r.next(req.Method)
+		if step == nil {
+			return nil, &Error{Code: -32601, Message: "no recorded response for " + req.Method}
+		}
+
+		var recorded struct {
+			Result json.RawMessage `json:"result"`
+			Error  *Error          `json:"error"`
+		}
+		if err := json.Unmarshal(step.response, &recorded); err != nil {
+			return nil, err
+		}
+
+		events := append(r.takeInitial(), step.events...)
+		req.After(func() {
+			for _, event := range events {
+				s.emitRaw(req.TargetId, event)
+			}
+		})
+
+		if recorded.Error != nil {
+			return nil, recorded.Error
+		}
+		return recorded.Result, nil
+	}
+}
+
+// ReplayTransport replays a recorded transcript without any server, it satisfies gcd.Transport
+// so it can be used with gcd.WithTransportDialer or Gcd.OpenTarget. Requests are answered like
+// Server.Replay.
+type ReplayTransport struct {
+	replayer  *replayer
+	messages  chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewReplayTransport(records []*observer.Record) *ReplayTransport {
+	return &ReplayTransport{
+		replayer: newReplayer(records),
+		messages: make(chan []byte, 1024),
+		done:     make(chan struct{}),
+	}
+}
+
+// Send replays the recorded response to msg and the events that followed it
+func (t *ReplayTransport) Send(msg []byte) error {
+	req := &Request{}
+	if err := json.Unmarshal(msg, req); err != nil {
+		return err
+	}
+
+	var response []byte
+	var events [][]byte
+	var err error
+
+	step := t.replayer.next(req.Method)
+	if step == nil {
+		response, err = json.Marshal(map[string]interface{}{"id": req.Id, "sessionId": req.SessionId, "error": &Error{Code: -32601, Message: "no recorded response for " + req.Method}})
+	} else {
+		response, err = rewriteResponse(step.response, req)
+		events = step.events
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, queued := range append(append(t.replayer.takeInitial(), response), events...) {
+		select {
+		case t.messages <- queued:
+		case <-t.done:
+			return ErrReplayClosed
+		}
+	}
+	return nil
+}
+
+// Read the next replayed message
+func (t *ReplayTransport) Read() ([]byte, error) {
+	select {
+	case msg := <-t.messages:
+		return msg, nil
+	case <-t.done:
+		return nil, io.EOF
+	}
+}
+
+// Close the transport
+func (t *ReplayTransport) Close() error {
+	t.closeOnce.Do(func() { close(t.done) })
+	return nil
+}
+
+// rewriteResponse sets the recorded response's id and sessionId to the request's
+func rewriteResponse(recorded []byte, req *Request) ([]byte, error) {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(recorded, &fields); err != nil {
+		return nil, err
+	}
+
+	id, _ := json.Marshal(req.Id)
+	fields["id"] = id
+	delete(fields, "sessionId")
+	if req.SessionId != "" {
+		sessionId, _ := json.Marshal(req.SessionId)
+		fields["sessionId"] = sessionId
+	}
+	return json.Marshal(fields)
+}
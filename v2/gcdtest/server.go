@@ -0,0 +1,504 @@
+/*
+The MIT License (MIT)
+
+Copyright (c) 2020 isaac dawson
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+// Package gcdtest provides an in process fake of the chrome remote debugger service so code
+// built on gcd can be unit tested without a browser. The Server serves the /json endpoints
+// and WebSocket connections for its targets, replies to requests with scripted handlers and
+// can push events:
+//
+//	srv := gcdtest.NewServer()
+//	defer srv.Close()
+//	srv.OnMethod("Page.navigate", func(req *gcdtest.Request) (interface{}, error) {
+//		srv.Emit("Page.loadEventFired", map[string]interface{}{"timestamp": 1})
+//		return map[string]interface{}{"frameId": "frame1"}, nil
+//	})
+//	debugger := gcd.NewChromeDebugger()
+//	debugger.ConnectToInstance(srv.Host(), srv.Port())
+package gcdtest
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+)
+
+// Target as listed by /json
+type Target struct {
+	Description          string `json:"description"`
+	DevtoolsFrontendUrl  string `json:"devtoolsFrontendUrl"`
+	Id                   string `json:"id"`
+	Title                string `json:"title"`
+	Type                 string `json:"type"`
+	Url                  string `json:"url"`
+	WebSocketDebuggerUrl string `json:"webSocketDebuggerUrl"`
+}
+
+// Request received from a client
+type Request struct {
+	Id        int64           `json:"id"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	SessionId string          `json:"sessionId,omitempty"`
+	TargetId  string          `json:"-"` // the target whose connection the request was sent on
+
+	after []func()
+}
+
+// After runs fn once the reply to the request has been sent, such as emitting
+// events which chrome sends after responding.
+func (r *Request) After(fn func()) {
+	r.after = append(r.after, fn)
+}
+
+// Decode the request's params into v
+func (r *Request) Decode(v interface{}) error {
+	if len(r.Params) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r.Params, v)
+}
+
+// Error may be returned from a Handler to reply with a protocol error, any other
+// error is replied with the generic server error code.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// Handler returns the result for a request, which is marshaled as the response's result,
+// or an error to reply with.
+type Handler func(req *Request) (interface{}, error)
+
+// Server is a fake remote debugger service, see NewServer.
+type Server struct {
+	// Version returned from /json/version, the webSocketDebuggerUrl is filled in.
+	Version map[string]string
+	// Protocol returned from /json/protocol, by default only the version with no domains.
+	Protocol interface{}
+	// Authorize, if set, is called for every http request including WebSocket upgrades,
+	// unauthorized requests get a 401 as from an authenticating proxy.
+	Authorize func(r *http.Request) bool
+
+	httpServer *httptest.Server
+	lock       sync.RWMutex
+	handlers   map[string]Handler
+	fallback   Handler // for requests without a handler
+	targets    []*Target
+	conns      map[string][]*wsConn // target id to its connections
+	requests   []*Request
+	nextId     int
+	browserId  string
+}
+
+// NewServer starts a fake debugger service with a browser target and a single page target.
+func NewServer() *Server {
+	return newServer(false)
+}
+
+// NewTLSServer starts a fake debugger service served over https, as if chrome was behind a TLS
+// terminating proxy the targets' urls are still ws://. Connect with the Client or Certificate.
+func NewTLSServer() *Server {
+	return newServer(true)
+}
+
+func newServer(useTLS bool) *Server {
+	s := &Server{
+		handlers:  make(map[string]Handler),
+		conns:     make(map[string][]*wsConn),
+		browserId: "browser",
+		Version: map[string]string{
+			"Browser":          "HeadlessChrome/0.0.0.0",
+			"Protocol-Version": "1.3",
+			"User-Agent":       "gcdtest",
+			"V8-Version":       "0.0.0",
+			"WebKit-Version":   "0.0",
+		},
+		Protocol: map[string]interface{}{
+			"version": map[string]string{"major": "1", "minor": "3"},
+			"domains": []interface{}{},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json", s.serveList)
+	mux.HandleFunc("/json/list", s.serveList)
+	mux.HandleFunc("/json/new", s.serveNew)
+	mux.HandleFunc("/json/close/", s.serveClose)
+	mux.HandleFunc("/json/activate/", s.serveActivate)
+	mux.HandleFunc("/json/version", s.serveVersion)
+	mux.HandleFunc("/json/protocol", s.serveProtocol)
+	mux.HandleFunc("/devtools/", s.serveWebSocket)
+
+	s.httpServer = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.Authorize != nil && !s.Authorize(r) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+
+	if useTLS {
+		s.httpServer.StartTLS()
+	} else {
+		s.httpServer.Start()
+	}
+
+	s.AddTarget("about:blank")
+	return s
+}
+
+// Close all connections and shut down the server
+func (s *Server) Close() {
+	s.lock.Lock()
+	for _, conns := range s.conns {
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}
+	s.conns = make(map[string][]*wsConn)
+	s.lock.Unlock()
+	s.httpServer.Close()
+}
+
+// URL of the server, such as http://127.0.0.1:41234
+func (s *Server) URL() string {
+	return s.httpServer.URL
+}
+
+// Client returns an http client which trusts the server's certificate, for NewTLSServer
+func (s *Server) Client() *http.Client {
+	return s.httpServer.Client()
+}
+
+// Certificate the server uses, for NewTLSServer
+func (s *Server) Certificate() *x509.Certificate {
+	return s.httpServer.Certificate()
+}
+
+// Host the server is listening on, for gcd's ConnectToInstance
+func (s *Server) Host() string {
+	host, _, _ := net.SplitHostPort(s.httpServer.Listener.Addr().String())
+	return host
+}
+
+// Port the server is listening on, for gcd's ConnectToInstance
+func (s *Server) Port() string {
+	_, port, _ := net.SplitHostPort(s.httpServer.Listener.Addr().String())
+	return port
+}
+
+// OnMethod sets the handler for requests of method, such as "Page.navigate". Requests
+// without a handler are replied to with an empty result.
+func (s *Server) OnMethod(method string, handler Handler) {
+	s.lock.Lock()
+	s.handlers[method] = handler
+	s.lock.Unlock()
+}
+
+// AddTarget adds a page target, as if it was opened with /json/new
+func (s *Server) AddTarget(url string) *Target {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.nextId++
+	id := fmt.Sprintf("TARGET%d", s.nextId)
+	target := &Target{
+		Id:                   id,
+		Title:                url,
+		Type:                 "page",
+		Url:                  url,
+		WebSocketDebuggerUrl: s.wsURL("page", id),
+	}
+	s.targets = append(s.targets, target)
+	return target
+}
+
+// Targets currently listed by /json
+func (s *Server) Targets() []*Target {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return append([]*Target(nil), s.targets...)
+}
+
+// Requests returns every request received so far, in order
+func (s *Server) Requests() []*Request {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return append([]*Request(nil), s.requests...)
+}
+
+// Disconnect drops the connections to the target with targetId, or every connection if empty,
+// as if the network or a proxy between chrome and the client failed. The target is kept.
+func (s *Server) Disconnect(targetId string) {
+	s.lock.RLock()
+	var conns []*wsConn
+	for id, targetConns := range s.conns {
+		if targetId == "" || id == targetId {
+			conns = append(conns, targetConns...)
+		}
+	}
+	s.lock.RUnlock()
+
+	for _, conn := range conns {
+		conn.Close()
+	}
+}
+
+// Emit sends an event to every connected client
+func (s *Server) Emit(method string, params interface{}) error {
+	return s.emit("", method, params)
+}
+
+// EmitTo sends an event to the clients connected to the target with targetId
+func (s *Server) EmitTo(targetId, method string, params interface{}) error {
+	return s.emit(targetId, method, params)
+}
+
+func (s *Server) emit(targetId, method string, params interface{}) error {
+	msg, err := json.Marshal(map[string]interface{}{"method": method, "params": params})
+	if err != nil {
+		return err
+	}
+	return s.emitRaw(targetId, msg)
+}
+
+// emitRaw sends an already encoded message
+func (s *Server) emitRaw(targetId string, msg []byte) error {
+	s.lock.RLock()
+	var conns []*wsConn
+	for id, targetConns := range s.conns {
+		if targetId == "" || id == targetId {
+			conns = append(conns, targetConns...)
+		}
+	}
+	s.lock.RUnlock()
+
+	for _, conn := range conns {
+		if err := conn.WriteMessage(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) wsURL(kind, id string) string {
+	return fmt.Sprintf("ws://%s/devtools/%s/%s", s.httpServer.Listener.Addr().String(), kind, id)
+}
+
+func (s *Server) serveList(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.Targets())
+}
+
+func (s *Server) serveNew(w http.ResponseWriter, r *http.Request) {
+	// like chrome the query is the escaped url
+	pageUrl, err := url.QueryUnescape(r.URL.RawQuery)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if pageUrl == "" {
+		pageUrl = "about:blank"
+	}
+	writeJSON(w, s.AddTarget(pageUrl))
+}
+
+func (s *Server) serveClose(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/json/close/")
+	if !s.removeTarget(id) {
+		http.Error(w, "No such target id: "+id, http.StatusNotFound)
+		return
+	}
+	fmt.Fprint(w, "Target is closing")
+}
+
+func (s *Server) serveActivate(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/json/activate/")
+	if s.lookupTarget(id) == nil {
+		http.Error(w, "No such target id: "+id, http.StatusNotFound)
+		return
+	}
+	fmt.Fprint(w, "Target activated")
+}
+
+func (s *Server) serveVersion(w http.ResponseWriter, r *http.Request) {
+	version := make(map[string]string)
+	s.lock.RLock()
+	for k, v := range s.Version {
+		version[k] = v
+	}
+	s.lock.RUnlock()
+	version["webSocketDebuggerUrl"] = s.wsURL("browser", s.browserId)
+	writeJSON(w, version)
+}
+
+func (s *Server) serveProtocol(w http.ResponseWriter, r *http.Request) {
+	s.lock.RLock()
+	protocol := s.Protocol
+	s.lock.RUnlock()
+	writeJSON(w, protocol)
+}
+
+// serveWebSocket accepts connections to /devtools/page/<id> and /devtools/browser/<id>
+func (s *Server) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/devtools/"), "/")
+	if len(parts) != 2 {
+		http.NotFound(w, r)
+		return
+	}
+
+	id := parts[1]
+	if parts[0] == "browser" && id != s.browserId || parts[0] != "browser" && s.lookupTarget(id) == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	conn, err := upgrade(w, r)
+	if err != nil {
+		return
+	}
+
+	s.lock.Lock()
+	s.conns[id] = append(s.conns[id], conn)
+	s.lock.Unlock()
+
+	defer s.removeConn(id, conn)
+	for {
+		msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		req := &Request{}
+		if err := json.Unmarshal(msg, req); err != nil {
+			continue
+		}
+		req.TargetId = id
+		s.reply(conn, req)
+	}
+}
+
+// reply to the request with its handler's result or error
+func (s *Server) reply(conn *wsConn, req *Request) {
+	s.lock.Lock()
+	s.requests = append(s.requests, req)
+	handler, ok := s.handlers[req.Method]
+	if !ok {
+		handler = s.fallback
+	}
+	s.lock.Unlock()
+
+	response := map[string]interface{}{"id": req.Id}
+	if req.SessionId != "" {
+		response["sessionId"] = req.SessionId
+	}
+
+	var result interface{}
+	var err error
+	if handler != nil {
+		result, err = handler(req)
+	}
+
+	switch e := err.(type) {
+	case nil:
+		if result == nil {
+			result = struct{}{}
+		}
+		response["result"] = result
+	case *Error:
+		response["error"] = e
+	default:
+		response["error"] = &Error{Code: -32000, Message: err.Error()}
+	}
+
+	msg, err := json.Marshal(response)
+	if err != nil {
+		msg, _ = json.Marshal(map[string]interface{}{"id": req.Id, "error": &Error{Code: -32603, Message: err.Error()}})
+	}
+	conn.WriteMessage(msg)
+
+	for _, fn := range req.after {
+		fn()
+	}
+}
+
+func (s *Server) lookupTarget(id string) *Target {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for _, target := range s.targets {
+		if target.Id == id {
+			return target
+		}
+	}
+	return nil
+}
+
+// removeTarget and close its connections
+func (s *Server) removeTarget(id string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i, target := range s.targets {
+		if target.Id != id {
+			continue
+		}
+		s.targets = append(s.targets[:i], s.targets[i+1:]...)
+		for _, conn := range s.conns[id] {
+			conn.Close()
+		}
+		delete(s.conns, id)
+		return true
+	}
+	return false
+}
+
+func (s *Server) removeConn(id string, conn *wsConn) {
+	conn.Close()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	conns := s.conns[id]
+	for i, c := range conns {
+		if c == conn {
+			s.conns[id] = append(conns[:i], conns[i+1:]...)
+			break
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	json.NewEncoder(w).Encode(v)
+}
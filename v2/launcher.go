@@ -0,0 +1,202 @@
+package gcd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HeadlessMode selects how chrome is started without a window
+type HeadlessMode int
+
+const (
+	Headful     HeadlessMode = iota // show a window
+	HeadlessNew                     // --headless=new, the full browser without a window
+	HeadlessOld                     // --headless=old, the separate headless shell, not available in newer releases
+)
+
+// AutomationDefaults are flags commonly used when automating chrome. They turn off background
+// networking, default apps, sync, translate, component updates and prompts which make runs slower
+// and less predictable.
+var AutomationDefaults = []string{
+	"--disable-background-networking",
+	"--disable-background-timer-throttling",
+	"--disable-backgrounding-occluded-windows",
+	"--disable-breakpad",
+	"--disable-client-side-phishing-detection",
+	"--disable-component-extensions-with-background-pages",
+	"--disable-component-update",
+	"--disable-default-apps",
+	"--disable-dev-shm-usage",
+	"--disable-extensions",
+	"--disable-features=Translate,MediaRouter,OptimizationHints",
+	"--disable-hang-monitor",
+	"--disable-ipc-flooding-protection",
+	"--disable-popup-blocking",
+	"--disable-prompt-on-repost",
+	"--disable-renderer-backgrounding",
+	"--disable-sync",
+	"--enable-automation",
+	"--force-color-profile=srgb",
+	"--metrics-recording-only",
+	"--mute-audio",
+	"--no-default-browser-check",
+	"--no-first-run",
+	"--password-store=basic",
+	"--use-mock-keychain",
+}
+
+// flags whose comma separated values are merged rather than replaced when repeated
+var mergedFlags = map[string]struct{}{
+	"--enable-features":           {},
+	"--disable-features":          {},
+	"--load-extension":            {},
+	"--disable-extensions-except": {},
+}
+
+// Launcher builds the flags chrome is started with, see WithLauncher. Defaults come first,
+// then the typed options and then Flags, repeated flags are de-duplicated with the last
+// value winning.
+type Launcher struct {
+	Headless         HeadlessMode
+	WindowWidth      int      // --window-size, if both width and height are set
+	WindowHeight     int      // see WindowWidth
+	ProxyServer      string   // --proxy-server, such as socks5://localhost:1080
+	UserAgent        string   // --user-agent
+	DisableGPU       bool     // --disable-gpu
+	NoSandbox        bool     // --no-sandbox, needed when running as root such as in a container
+	IgnoreCertErrors bool     // --ignore-certificate-errors
+	Extensions       []string // unpacked extension directories to load, --disable-extensions is dropped
+	Lang             string   // --lang, such as en-US
+	Defaults         []string // a preset such as AutomationDefaults
+	Remove           []string // flags to leave out by name, such as --disable-sync from the defaults
+	Flags            []string // any other flags
+}
+
+// NewAutomationLauncher returns a Launcher for headless automation with the AutomationDefaults
+func NewAutomationLauncher() *Launcher {
+	return &Launcher{Headless: HeadlessNew, Defaults: AutomationDefaults}
+}
+
+// WithLauncher adds the flags built by the launcher to the chrome process
+func WithLauncher(launcher *Launcher) func(*Gcd) {
+	return func(g *Gcd) {
+		g.flags = append(g.flags, launcher.Args()...)
+	}
+}
+
+// Args returns the de-duplicated flags without the removed ones
+func (l *Launcher) Args() []string {
+	flags := make([]string, 0, len(l.Defaults)+len(l.Flags)+10)
+	flags = append(flags, l.Defaults...)
+
+	switch l.Headless {
+	case HeadlessNew:
+		flags = append(flags, "--headless=new")
+	case HeadlessOld:
+		flags = append(flags, "--headless=old")
+	}
+
+	if l.WindowWidth > 0 && l.WindowHeight > 0 {
+		flags = append(flags, fmt.Sprintf("--window-size=%d,%d", l.WindowWidth, l.WindowHeight))
+	}
+
+	if l.ProxyServer != "" {
+		flags = append(flags, "--proxy-server="+l.ProxyServer)
+	}
+
+	if l.UserAgent != "" {
+		flags = append(flags, "--user-agent="+l.UserAgent)
+	}
+
+	if l.DisableGPU {
+		flags = append(flags, "--disable-gpu")
+	}
+
+	if l.NoSandbox {
+		flags = append(flags, "--no-sandbox")
+	}
+
+	if l.IgnoreCertErrors {
+		flags = append(flags, "--ignore-certificate-errors")
+	}
+
+	remove := make(map[string]struct{}, len(l.Remove)+1)
+	for _, name := range l.Remove {
+		remove[flagName(name)] = struct{}{}
+	}
+
+	if len(l.Extensions) > 0 {
+		extensions := strings.Join(l.Extensions, ",")
+		flags = append(flags, "--load-extension="+extensions, "--disable-extensions-except="+extensions)
+		remove["--disable-extensions"] = struct{}{}
+	}
+
+	if l.Lang != "" {
+		flags = append(flags, "--lang="+l.Lang)
+	}
+
+	flags = append(flags, l.Flags...)
+
+	kept := flags[:0]
+	for _, flag := range flags {
+		if _, ok := remove[flagName(flag)]; !ok {
+			kept = append(kept, flag)
+		}
+	}
+	return dedupeFlags(kept)
+}
+
+// flagName returns the name of a flag without its value, with the leading -- added if missing
+func flagName(flag string) string {
+	name, _, _ := strings.Cut(flag, "=")
+	if !strings.HasPrefix(name, "-") {
+		name = "--" + name
+	}
+	return name
+}
+
+// dedupeFlags keeps the last value of each flag where it first appeared. The values of
+// mergedFlags are combined, as chrome only reads the last one. Arguments which aren't
+// flags, such as a url to open, are left as they are.
+func dedupeFlags(flags []string) []string {
+	deduped := make([]string, 0, len(flags))
+	index := make(map[string]int, len(flags))
+
+	for _, flag := range flags {
+		if !strings.HasPrefix(flag, "-") {
+			deduped = append(deduped, flag)
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(flag, "=")
+		i, seen := index[name]
+		if !seen {
+			index[name] = len(deduped)
+			deduped = append(deduped, flag)
+			continue
+		}
+
+		if _, merge := mergedFlags[name]; merge && hasValue {
+			deduped[i] = mergeFlagValues(deduped[i], value)
+			continue
+		}
+		deduped[i] = flag
+	}
+	return deduped
+}
+
+// mergeFlagValues appends the comma separated values which flag doesn't already have
+func mergeFlagValues(flag, values string) string {
+	name, existing, _ := strings.Cut(flag, "=")
+	merged := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	for _, value := range strings.Split(existing+","+values, ",") {
+		if _, ok := seen[value]; ok || value == "" {
+			continue
+		}
+		seen[value] = struct{}{}
+		merged = append(merged, value)
+	}
+	return name + "=" + strings.Join(merged, ",")
+}
@@ -0,0 +1,180 @@
+package gcd
+
+import (
+	"errors"
+
+	"github.com/wirepair/gcd/v2/gcdapi"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// ErrTargetCrashed is returned from calls in flight when the target's renderer crashes
+var ErrTargetCrashed = errors.New("target crashed")
+
+// ErrTargetDetached is returned from calls in flight when the debugger is detached from the target,
+// such as when another client attaches or the target is closed. Reason is chrome's reason from
+// Inspector.detached, such as target_closed, or "target closed" from CloseTab. Chrome doesn't say
+// why a flattened session was detached, its Reason names the session and target instead.
+type ErrTargetDetached struct {
+	Reason string
+}
+
+func (e *ErrTargetDetached) Error() string {
+	return "target detached: " + e.Reason
+}
+
+// As lets errors.As match a *gcdmessage.ChromeDoneErr, which calls returned before ErrTargetDetached
+func (e *ErrTargetDetached) As(target interface{}) bool {
+	return asChromeDoneErr(target)
+}
+
+// ErrConnectionClosed is returned from calls once the target has shut down, see ChromeTarget.Err.
+// Cause is why: the connection's read error, an *ErrTargetDetached for flattened sessions or a
+// *gcdmessage.ChromeDoneErr if the target was closed by us.
+type ErrConnectionClosed struct {
+	Cause error
+}
+
+func (e *ErrConnectionClosed) Error() string {
+	if e.Cause == nil {
+		return "connection closed"
+	}
+	return "connection closed: " + e.Cause.Error()
+}
+
+func (e *ErrConnectionClosed) Unwrap() error {
+	return e.Cause
+}
+
+// As lets errors.As match a *gcdmessage.ChromeDoneErr, which calls returned before ErrConnectionClosed
+func (e *ErrConnectionClosed) As(target interface{}) bool {
+	return asChromeDoneErr(target)
+}
+
+func asChromeDoneErr(target interface{}) bool {
+	doneErr, ok := target.(**gcdmessage.ChromeDoneErr)
+	if ok {
+		*doneErr = &gcdmessage.ChromeDoneErr{}
+	}
+	return ok
+}
+
+// OnCrash calls fn on its own goroutine when the target's renderer crashes (Inspector.targetCrashed),
+// the Inspector domain must be enabled.
+func (c *ChromeTarget) OnCrash(fn func()) {
+	c.lifecycleLock.Lock()
+	c.crashHandlers = append(c.crashHandlers, fn)
+	c.lifecycleLock.Unlock()
+}
+
+// OnDetach calls fn on its own goroutine with the reason when the debugger is detached from the
+// target (Inspector.detached, which requires the Inspector domain enabled) or when a flattened
+// session is detached (Target.detachedFromTarget).
+func (c *ChromeTarget) OnDetach(fn func(reason string)) {
+	c.lifecycleLock.Lock()
+	c.detachHandlers = append(c.detachHandlers, fn)
+	c.lifecycleLock.Unlock()
+}
+
+// OnClose calls fn on its own goroutine with Err once the target has shut down. If it already
+// has, fn is called right away.
+func (c *ChromeTarget) OnClose(fn func(err error)) {
+	c.lifecycleLock.Lock()
+	if !c.isStopped() {
+		c.closeHandlers = append(c.closeHandlers, fn)
+		c.lifecycleLock.Unlock()
+		return
+	}
+	c.lifecycleLock.Unlock()
+	go fn(c.Err())
+}
+
+// Done is closed once the target has shut down, see Err for why.
+func (c *ChromeTarget) Done() <-chan struct{} {
+	return c.doneCh
+}
+
+// Err returns nil while the target is running, once Done is closed an *ErrConnectionClosed.
+func (c *ChromeTarget) Err() error {
+	c.stopLock.Lock()
+	defer c.stopLock.Unlock()
+	return c.closeErr
+}
+
+// targetCrashed fails calls in flight and notifies the OnCrash handlers
+func (c *ChromeTarget) targetCrashed() {
+	c.failPending(ErrTargetCrashed)
+
+	c.lifecycleLock.Lock()
+	handlers := append([]func(){}, c.crashHandlers...)
+	c.lifecycleLock.Unlock()
+	for _, fn := range handlers {
+		go fn()
+	}
+}
+
+// targetDetached fails calls in flight and notifies the OnDetach handlers
+func (c *ChromeTarget) targetDetached(reason string) {
+	c.targetGone()
+	c.failPending(&ErrTargetDetached{Reason: reason})
+
+	c.lifecycleLock.Lock()
+	handlers := append([]func(string){}, c.detachHandlers...)
+	c.lifecycleLock.Unlock()
+	for _, fn := range handlers {
+		go fn(reason)
+	}
+}
+
+// closed notifies the OnClose handlers, called once from shutdown
+func (c *ChromeTarget) closed(err error) {
+	c.lifecycleLock.Lock()
+	handlers := c.closeHandlers
+	c.closeHandlers = nil
+	c.lifecycleLock.Unlock()
+	for _, fn := range handlers {
+		go fn(err)
+	}
+}
+
+// failPending fails the calls waiting on a response with err, they will never get one
+func (c *ChromeTarget) failPending(err error) {
+	c.replyLock.Lock()
+	pending := c.replyDispatcher
+	c.replyDispatcher = make(map[int64]chan *gcdmessage.Message)
+	c.replyLock.Unlock()
+
+	for id, replyCh := range pending {
+		failReply(replyCh, id, err)
+	}
+}
+
+// failReply fails a single call waiting on replyCh with err
+func failReply(replyCh chan *gcdmessage.Message, id int64, err error) {
+	select {
+	case replyCh <- &gcdmessage.Message{Id: id, Err: err}:
+	default:
+		close(replyCh)
+	}
+}
+
+// sessionDetached shuts down the flattened session reported by a Target.detachedFromTarget event
+func (c *ChromeTarget) sessionDetached(event *gcdapi.TargetDetachedFromTargetEvent) {
+	session := c.connOwner().lookupSession(event.Params.SessionId)
+	if session == nil {
+		return
+	}
+
+	targetId := event.Params.TargetId
+	if session.Target != nil && session.Target.Id != "" {
+		targetId = session.Target.Id
+	}
+
+	reason := "session " + event.Params.SessionId
+	if targetId != "" {
+		reason += " of target " + targetId
+	}
+
+	detached := &ErrTargetDetached{Reason: reason}
+	session.targetDetached(detached.Reason)
+	session.stop(detached)
+}
@@ -8,6 +8,16 @@ type MessageObserver interface {
 	Event(method string, data []byte)
 }
 
+// FrameObserver may be implemented by a MessageObserver to see the raw messages on a target's
+// connection rather than calls. Sent is called with each message before it is written and
+// Received from the read loop with each message in the order it arrived, including replies
+// nobody waits for and events without a subscriber. target is the id of the target which owns
+// the connection, flattened sessions share their owner's.
+type FrameObserver interface {
+	Sent(target string, data []byte)
+	Received(target string, data []byte)
+}
+
 // DigResponseData returns the response data if there is any
 func DigResponseData(response *gcdmessage.Message) []byte {
 	if response == nil {
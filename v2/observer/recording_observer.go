@@ -0,0 +1,149 @@
+package observer
+
+import (
+	"bufio"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// Record types written by the RecordingObserver
+const (
+	RecordRequest  = "request"
+	RecordResponse = "response"
+	RecordEvent    = "event"
+)
+
+// Record is a single line of a recorded transcript
+type Record struct {
+	Time      time.Time       `json:"time"`
+	Type      string          `json:"type"`             // RecordRequest, RecordResponse or RecordEvent
+	Target    string          `json:"target,omitempty"` // the id of the target which owns the connection
+	SessionId string          `json:"sessionId,omitempty"`
+	Id        int64           `json:"id,omitempty"`
+	Method    string          `json:"method"`          // for responses, the method of the request
+	Data      json.RawMessage `json:"data,omitempty"`  // the raw protocol message
+	Error     string          `json:"error,omitempty"` // chrome's error message for a response, if any
+}
+
+// recordKey identifies a request on a connection, sessions have their own ids
+type recordKey struct {
+	target    string
+	sessionId string
+	id        int64
+}
+
+// recordHeader is the part of a protocol message a Record is made from
+type recordHeader struct {
+	Id        int64  `json:"id"`
+	Method    string `json:"method"`
+	SessionId string `json:"sessionId"`
+	Error     *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// RecordingObserver writes every message sent and received to a JSONL transcript, one Record
+// per line, in the order they were sent and arrived. Records are written from the target's
+// read and write loops, as a FrameObserver, so a slow writer slows the connection.
+type RecordingObserver struct {
+	lock    sync.Mutex
+	enc     *json.Encoder
+	err     error
+	pending map[recordKey]string // methods of requests waiting on a response
+}
+
+func NewRecordingObserver(w io.Writer) *RecordingObserver {
+	return &RecordingObserver{enc: json.NewEncoder(w), pending: make(map[recordKey]string)}
+}
+
+// Request does nothing, requests are recorded by Sent
+func (observer *RecordingObserver) Request(ID int64, method string, jsonData []byte) {}
+
+// Response does nothing, responses are recorded by Received
+func (observer *RecordingObserver) Response(ID int64, method string, jsonData []byte, err error) {}
+
+// Event does nothing, events are recorded by Received
+func (observer *RecordingObserver) Event(method string, jsonData []byte) {}
+
+// Sent records a request
+func (observer *RecordingObserver) Sent(target string, data []byte) {
+	header := &recordHeader{}
+	json.Unmarshal(data, header)
+
+	record := &Record{Type: RecordRequest, Target: target, SessionId: header.SessionId, Id: header.Id, Method: header.Method, Data: rawData(data)}
+
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	observer.pending[recordKey{target: target, sessionId: header.SessionId, id: header.Id}] = header.Method
+	observer.write(record)
+}
+
+// Received records a response, with the method of its request, or an event
+func (observer *RecordingObserver) Received(target string, data []byte) {
+	header := &recordHeader{}
+	json.Unmarshal(data, header)
+
+	record := &Record{Type: RecordEvent, Target: target, SessionId: header.SessionId, Method: header.Method, Data: rawData(data)}
+
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	if header.Method == "" {
+		key := recordKey{target: target, sessionId: header.SessionId, id: header.Id}
+		record.Type = RecordResponse
+		record.Id = header.Id
+		record.Method = observer.pending[key]
+		delete(observer.pending, key)
+
+		if header.Error != nil {
+			record.Error = header.Error.Message
+		}
+	}
+	observer.write(record)
+}
+
+// Err returns the first error writing the transcript
+func (observer *RecordingObserver) Err() error {
+	observer.lock.Lock()
+	defer observer.lock.Unlock()
+	return observer.err
+}
+
+// write the record, must be called with the lock held
+func (observer *RecordingObserver) write(record *Record) {
+	record.Time = time.Now()
+	if err := observer.enc.Encode(record); err != nil && observer.err == nil {
+		observer.err = err
+	}
+}
+
+// rawData copies the message, which may be modified after it is observed, and drops invalid JSON
+func rawData(data []byte) json.RawMessage {
+	if len(data) == 0 || !json.Valid(data) {
+		return nil
+	}
+	return append(json.RawMessage(nil), data...)
+}
+
+// ReadRecords reads a transcript written by a RecordingObserver
+func ReadRecords(r io.Reader) ([]*Record, error) {
+	records := make([]*Record, 0)
+	scanner := bufio.NewScanner(r)
+	// protocol messages such as DOM.getDocument can be very large
+	scanner.Buffer(make([]byte, 0, 64*1024), 256*1024*1024)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		record := &Record{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,70 @@
+package gcd
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"sync"
+)
+
+// pipeConn talks to chrome started with --remote-debugging-pipe. Chrome reads messages
+// from its fd 3 and writes them to its fd 4, each message is terminated by a NUL byte.
+type pipeConn struct {
+	writeLock sync.Mutex
+	w         io.WriteCloser // our end of chrome's fd 3
+	r         *bufio.Reader  // our end of chrome's fd 4
+	rc        io.Closer
+	closeOnce sync.Once
+}
+
+// newPipes creates the pipes for a chrome process, the returned files must be
+// set as the process's ExtraFiles and closed once the process is started.
+func newPipes() (*pipeConn, []*os.File, error) {
+	chromeIn, ourOut, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ourIn, chromeOut, err := os.Pipe()
+	if err != nil {
+		chromeIn.Close()
+		ourOut.Close()
+		return nil, nil, err
+	}
+
+	conn := &pipeConn{w: ourOut, r: bufio.NewReader(ourIn), rc: ourIn}
+	return conn, []*os.File{chromeIn, chromeOut}, nil
+}
+
+// Send a message to the browser
+func (p *pipeConn) Send(msg []byte) error {
+	p.writeLock.Lock()
+	defer p.writeLock.Unlock()
+
+	if _, err := p.w.Write(msg); err != nil {
+		return err
+	}
+	_, err := p.w.Write([]byte{0})
+	return err
+}
+
+// Read a message from the browser
+func (p *pipeConn) Read() ([]byte, error) {
+	msg, err := p.r.ReadBytes(0)
+	if err != nil {
+		return nil, err
+	}
+	return msg[:len(msg)-1], nil
+}
+
+// Close both ends of the pipe
+func (p *pipeConn) Close() error {
+	var err error
+	p.closeOnce.Do(func() {
+		err = p.w.Close()
+		if rerr := p.rc.Close(); err == nil {
+			err = rerr
+		}
+	})
+	return err
+}
@@ -0,0 +1,480 @@
+package gcd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wirepair/gcd/v2/gcdapi"
+)
+
+var (
+	ErrPoolClosed = errors.New("pool is closed")
+	ErrNotLeased  = errors.New("target is not leased from this pool")
+)
+
+// PoolConfig for NewPool, zero values are replaced with the defaults
+type PoolConfig struct {
+	Browsers       int           // browsers to run, defaults to 1
+	TabsPerBrowser int           // tabs each browser may have leased at once, defaults to 4
+	MaxUses        int           // leases before a tab is closed and a new one opened, 0 for no limit
+	ResetTimeout   time.Duration // how long Reset may take, defaults to 10 seconds
+	// Launch starts a browser, by default chrome is found with FindChrome and started headless
+	// with NewAutomationLauncher and a temporary profile which is deleted on exit.
+	Launch func(ctx context.Context) (*Gcd, error)
+	// Reset prepares a released tab for its next lease, by default it navigates to about:blank.
+	// The tab is closed if it fails.
+	Reset func(ctx context.Context, target *ChromeTarget) error
+}
+
+// PoolStats is a snapshot of the pool
+type PoolStats struct {
+	Browsers int    // browsers running
+	Tabs     int    // tabs open, leased, idle or being reset
+	Leased   int    // tabs currently leased
+	Idle     int    // tabs ready to be leased
+	Waiting  int    // Acquire calls which haven't returned yet
+	Acquired uint64 // leases handed out
+	Recycled uint64 // tabs closed after crashing, detaching, failing to reset or reaching MaxUses
+	Replaced uint64 // browsers replaced after crashing
+}
+
+type poolBrowser struct {
+	debugger *Gcd
+	tabs     map[*poolTab]struct{} // open tabs, leased, idle or being reset
+	dead     bool
+}
+
+type poolTab struct {
+	target    *ChromeTarget
+	browser   *poolBrowser
+	uses      int
+	unhealthy bool // the tab crashed or was detached, it is closed instead of leased again
+}
+
+// Pool leases tabs of a number of browsers to concurrent workers. Released tabs are reset and
+// handed out again, browsers which crash are replaced.
+type Pool struct {
+	config  PoolConfig
+	slots   chan struct{} // holds a value for each tab which is leased or being reset
+	ctx     context.Context
+	cancel  context.CancelFunc
+	closeCh chan struct{}
+	waiting int32
+	wg      sync.WaitGroup // resets and replacements in flight
+
+	lock     sync.Mutex
+	closed   bool
+	browsers []*poolBrowser
+	idle     []*poolTab
+	leased   map[*ChromeTarget]*poolTab
+	changed  chan struct{} // closed and replaced when a browser was replaced
+	stats    PoolStats
+}
+
+// NewPool starts config.Browsers browsers, giving up once ctx is done. Tabs are opened as
+// they are acquired.
+func NewPool(ctx context.Context, config PoolConfig) (*Pool, error) {
+	if config.Browsers <= 0 {
+		config.Browsers = 1
+	}
+
+	if config.TabsPerBrowser <= 0 {
+		config.TabsPerBrowser = 4
+	}
+
+	if config.ResetTimeout <= 0 {
+		config.ResetTimeout = time.Second * 10
+	}
+
+	if config.Launch == nil {
+		config.Launch = launchPoolBrowser
+	}
+
+	if config.Reset == nil {
+		config.Reset = resetPoolTab
+	}
+
+	p := &Pool{
+		config:  config,
+		slots:   make(chan struct{}, config.Browsers*config.TabsPerBrowser),
+		closeCh: make(chan struct{}),
+		leased:  make(map[*ChromeTarget]*poolTab),
+		changed: make(chan struct{}),
+	}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+
+	for i := 0; i < config.Browsers; i++ {
+		browser, err := p.launch(ctx)
+		if err != nil {
+			p.Close(ctx)
+			return nil, err
+		}
+
+		p.lock.Lock()
+		p.browsers = append(p.browsers, browser)
+		p.lock.Unlock()
+	}
+	return p, nil
+}
+
+// launchPoolBrowser is the default PoolConfig.Launch, chrome is killed if ctx is done before
+// its debugger port is ready.
+func launchPoolBrowser(ctx context.Context) (*Gcd, error) {
+	profileDir, err := os.MkdirTemp("", "gcd-pool-")
+	if err != nil {
+		return nil, err
+	}
+
+	debugger := NewChromeDebugger(WithLauncher(NewAutomationLauncher()), WithDeleteProfileOnExit())
+	if err := debugger.StartProcessCtx(ctx, "", profileDir, ""); err != nil {
+		debugger.ExitProcess()
+		os.RemoveAll(profileDir)
+		return nil, err
+	}
+	return debugger, nil
+}
+
+// resetPoolTab is the default PoolConfig.Reset
+func resetPoolTab(ctx context.Context, target *ChromeTarget) error {
+	_, _, _, _, err := target.Page.NavigateWithParams(ctx, &gcdapi.PageNavigateParams{Url: "about:blank"})
+	return err
+}
+
+// launch starts a browser and watches its browser target to replace it if it goes away
+func (p *Pool) launch(ctx context.Context) (*poolBrowser, error) {
+	debugger, err := p.config.Launch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	browserTarget, err := debugger.browser(ctx)
+	if err != nil {
+		p.stopBrowser(ctx, &poolBrowser{debugger: debugger})
+		return nil, err
+	}
+
+	browser := &poolBrowser{debugger: debugger, tabs: make(map[*poolTab]struct{})}
+	go p.watch(browser, browserTarget)
+	return browser, nil
+}
+
+// watch for the browser's connection to go away, such as when the browser crashed
+func (p *Pool) watch(browser *poolBrowser, browserTarget *ChromeTarget) {
+	select {
+	case <-browserTarget.Done():
+	case <-p.closeCh:
+		return
+	}
+
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return
+	}
+
+	browser.dead = true
+	for i, b := range p.browsers {
+		if b == browser {
+			p.browsers = append(p.browsers[:i], p.browsers[i+1:]...)
+			break
+		}
+	}
+
+	idle := p.idle[:0]
+	for _, tab := range p.idle {
+		if tab.browser != browser {
+			idle = append(idle, tab)
+		}
+	}
+	p.idle = idle
+	p.wg.Add(1)
+	p.lock.Unlock()
+
+	go func() {
+		defer p.wg.Done()
+		ctx, cancel := context.WithTimeout(p.ctx, p.config.ResetTimeout)
+		defer cancel()
+		p.stopBrowser(ctx, browser)
+		p.replace()
+	}()
+}
+
+// replace a browser which went away, retrying until one is launched or the pool is closed
+func (p *Pool) replace() {
+	for {
+		browser, err := p.launch(p.ctx)
+		if err == nil {
+			p.lock.Lock()
+			if p.closed {
+				p.lock.Unlock()
+				p.stopBrowser(context.Background(), browser)
+				return
+			}
+			p.browsers = append(p.browsers, browser)
+			p.stats.Replaced++
+			close(p.changed)
+			p.changed = make(chan struct{})
+			p.lock.Unlock()
+			return
+		}
+
+		select {
+		case <-time.After(time.Second):
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// stopBrowser shuts down a started browser, the tabs of a browser which was connected to
+// are closed instead.
+func (p *Pool) stopBrowser(ctx context.Context, browser *poolBrowser) error {
+	err := browser.debugger.Shutdown(ctx)
+	if err != ErrNoProcess {
+		return err
+	}
+
+	p.lock.Lock()
+	tabs := make([]*poolTab, 0, len(browser.tabs))
+	for tab := range browser.tabs {
+		tabs = append(tabs, tab)
+	}
+	p.lock.Unlock()
+
+	for _, tab := range tabs {
+		browser.debugger.CloseTabCtx(ctx, tab.target)
+	}
+	return nil
+}
+
+// Acquire leases a tab, waiting for one to be released if all are leased. The tab must be
+// returned with Release.
+func (p *Pool) Acquire(ctx context.Context) (*ChromeTarget, error) {
+	atomic.AddInt32(&p.waiting, 1)
+	defer atomic.AddInt32(&p.waiting, -1)
+
+	select {
+	case p.slots <- struct{}{}:
+	case <-p.closeCh:
+		return nil, ErrPoolClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	target, err := p.take(ctx)
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+	return target, nil
+}
+
+// take an idle tab or open a new one in the browser with the fewest tabs
+func (p *Pool) take(ctx context.Context) (*ChromeTarget, error) {
+	for {
+		p.lock.Lock()
+		if p.closed {
+			p.lock.Unlock()
+			return nil, ErrPoolClosed
+		}
+
+		for len(p.idle) > 0 {
+			tab := p.idle[len(p.idle)-1]
+			p.idle = p.idle[:len(p.idle)-1]
+
+			if tab.unhealthy || tab.target.Err() != nil {
+				delete(tab.browser.tabs, tab)
+				p.stats.Recycled++
+				go p.closeTab(tab)
+				continue
+			}
+			p.lease(tab)
+			p.lock.Unlock()
+			return tab.target, nil
+		}
+
+		var browser *poolBrowser
+		for _, b := range p.browsers {
+			if len(b.tabs) < p.config.TabsPerBrowser && (browser == nil || len(b.tabs) < len(browser.tabs)) {
+				browser = b
+			}
+		}
+
+		if browser == nil {
+			// every browser is being replaced
+			changed := p.changed
+			p.lock.Unlock()
+
+			select {
+			case <-changed:
+				continue
+			case <-p.closeCh:
+				return nil, ErrPoolClosed
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		// hold the browser's room while the tab is opened
+		tab := &poolTab{browser: browser}
+		browser.tabs[tab] = struct{}{}
+		p.lock.Unlock()
+
+		target, err := browser.debugger.NewTabCtx(ctx, "about:blank")
+
+		p.lock.Lock()
+		if err != nil {
+			delete(browser.tabs, tab)
+			dead := browser.dead
+			p.lock.Unlock()
+
+			if dead && ctx.Err() == nil {
+				continue
+			}
+			return nil, err
+		}
+
+		tab.target = target
+		if p.closed {
+			delete(browser.tabs, tab)
+			p.lock.Unlock()
+			p.closeTab(tab)
+			return nil, ErrPoolClosed
+		}
+		p.lease(tab)
+		p.lock.Unlock()
+
+		p.watchTab(ctx, tab)
+		return target, nil
+	}
+}
+
+// watchTab marks the tab unhealthy when it crashes or is detached, Inspector.targetCrashed
+// needs the Inspector domain enabled.
+func (p *Pool) watchTab(ctx context.Context, tab *poolTab) {
+	unhealthy := func() {
+		p.lock.Lock()
+		tab.unhealthy = true
+		p.lock.Unlock()
+	}
+	tab.target.OnCrash(unhealthy)
+	tab.target.OnDetach(func(reason string) { unhealthy() })
+
+	// called by name as the Inspector domain is experimental, see gcd_stable
+	if err := tab.target.CallInto(ctx, "Inspector.enable", nil, nil); err != nil {
+		tab.browser.debugger.logger.Println("unable to enable Inspector for pooled tab, crashes won't be noticed", err)
+	}
+}
+
+// lease the tab, must be called with the lock held
+func (p *Pool) lease(tab *poolTab) {
+	tab.uses++
+	p.leased[tab.target] = tab
+	p.stats.Acquired++
+}
+
+// Release returns a tab leased with Acquire to the pool. It is reset in the background and
+// closed if it crashed, was detached, failed to reset or reached MaxUses.
+func (p *Pool) Release(target *ChromeTarget) error {
+	p.lock.Lock()
+	tab, ok := p.leased[target]
+	if !ok {
+		p.lock.Unlock()
+		return ErrNotLeased
+	}
+	delete(p.leased, target)
+
+	if p.closed {
+		p.lock.Unlock()
+		<-p.slots
+		return nil
+	}
+	p.wg.Add(1)
+	p.lock.Unlock()
+
+	go func() {
+		defer p.wg.Done()
+		p.recycle(tab)
+		<-p.slots
+	}()
+	return nil
+}
+
+// recycle resets the tab and makes it idle, or closes it
+func (p *Pool) recycle(tab *poolTab) {
+	p.lock.Lock()
+	reuse := !tab.browser.dead && !tab.unhealthy && (p.config.MaxUses == 0 || tab.uses < p.config.MaxUses)
+	p.lock.Unlock()
+
+	if reuse && tab.target.Err() == nil {
+		ctx, cancel := context.WithTimeout(p.ctx, p.config.ResetTimeout)
+		reuse = p.config.Reset(ctx, tab.target) == nil
+		cancel()
+	}
+
+	p.lock.Lock()
+	if reuse && !p.closed && !tab.browser.dead && !tab.unhealthy && tab.target.Err() == nil {
+		p.idle = append(p.idle, tab)
+		p.lock.Unlock()
+		return
+	}
+	delete(tab.browser.tabs, tab)
+	p.stats.Recycled++
+	p.lock.Unlock()
+
+	p.closeTab(tab)
+}
+
+// closeTab closes a tab which won't be leased again
+func (p *Pool) closeTab(tab *poolTab) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.ResetTimeout)
+	defer cancel()
+	tab.browser.debugger.CloseTabCtx(ctx, tab.target)
+}
+
+// Stats returns a snapshot of the pool's browsers, tabs and counters
+func (p *Pool) Stats() PoolStats {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	stats := p.stats
+	stats.Browsers = len(p.browsers)
+	for _, browser := range p.browsers {
+		stats.Tabs += len(browser.tabs)
+	}
+	stats.Leased = len(p.leased)
+	stats.Idle = len(p.idle)
+	stats.Waiting = int(atomic.LoadInt32(&p.waiting))
+	return stats
+}
+
+// Close shuts down the browsers, tabs which are still leased stop working. Waiting Acquire
+// calls return ErrPoolClosed.
+func (p *Pool) Close(ctx context.Context) error {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.closeCh)
+	browsers := p.browsers
+	p.browsers = nil
+	p.idle = nil
+	p.lock.Unlock()
+
+	p.cancel()
+	p.wg.Wait()
+
+	var err error
+	for _, browser := range browsers {
+		if stopErr := p.stopBrowser(ctx, browser); stopErr != nil && err == nil {
+			err = stopErr
+		}
+	}
+	return err
+}
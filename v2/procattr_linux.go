@@ -0,0 +1,9 @@
+package gcd
+
+import "syscall"
+
+// newSysProcAttr starts the browser in its own process group, so renderer, zygote and gpu
+// processes can be signalled with it, and has it killed if we go away without shutting it down.
+func newSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true, Pdeathsig: syscall.SIGKILL}
+}
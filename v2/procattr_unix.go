@@ -0,0 +1,11 @@
+//go:build unix && !linux
+
+package gcd
+
+import "syscall"
+
+// newSysProcAttr starts the browser in its own process group, so renderer and gpu
+// processes can be signalled with it.
+func newSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
@@ -0,0 +1,22 @@
+//go:build !unix
+
+package gcd
+
+import (
+	"os"
+	"syscall"
+)
+
+func newSysProcAttr() *syscall.SysProcAttr {
+	return nil
+}
+
+// terminateProcess kills the process, there is no SIGTERM to send
+func terminateProcess(process *os.Process, group bool) error {
+	return process.Kill()
+}
+
+// killProcess kills the process
+func killProcess(process *os.Process, group bool) error {
+	return process.Kill()
+}
@@ -0,0 +1,27 @@
+//go:build unix
+
+package gcd
+
+import (
+	"os"
+	"syscall"
+)
+
+// terminateProcess asks the process, or its whole process group, to exit
+func terminateProcess(process *os.Process, group bool) error {
+	return signalProcess(process, group, syscall.SIGTERM)
+}
+
+// killProcess kills the process, or its whole process group
+func killProcess(process *os.Process, group bool) error {
+	return signalProcess(process, group, syscall.SIGKILL)
+}
+
+// signalProcess signals the group led by process when it was started with newSysProcAttr. The
+// group outlives its leader, so this also reaches children left behind after the browser exited.
+func signalProcess(process *os.Process, group bool, sig syscall.Signal) error {
+	if group {
+		return syscall.Kill(-process.Pid, sig)
+	}
+	return process.Signal(sig)
+}
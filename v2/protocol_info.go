@@ -0,0 +1,67 @@
+package gcd
+
+// ProtocolInfo is the protocol definition returned by /json/protocol, see Gcd.Protocol.
+type ProtocolInfo struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []*ProtocolDomain `json:"domains"`
+}
+
+// ProtocolDomain such as Page or Network
+type ProtocolDomain struct {
+	Domain       string             `json:"domain"`
+	Description  string             `json:"description,omitempty"`
+	Experimental bool               `json:"experimental,omitempty"`
+	Deprecated   bool               `json:"deprecated,omitempty"`
+	Dependencies []string           `json:"dependencies,omitempty"`
+	Types        []*ProtocolType    `json:"types,omitempty"`
+	Commands     []*ProtocolCommand `json:"commands,omitempty"`
+	Events       []*ProtocolEvent   `json:"events,omitempty"`
+}
+
+// ProtocolType declared by a domain
+type ProtocolType struct {
+	Id           string              `json:"id"`
+	Description  string              `json:"description,omitempty"`
+	Type         string              `json:"type"`
+	Experimental bool                `json:"experimental,omitempty"`
+	Deprecated   bool                `json:"deprecated,omitempty"`
+	Enum         []string            `json:"enum,omitempty"`
+	Properties   []*ProtocolProperty `json:"properties,omitempty"`
+	Items        *ProtocolProperty   `json:"items,omitempty"`
+}
+
+// ProtocolCommand of a domain, such as navigate
+type ProtocolCommand struct {
+	Name         string              `json:"name"`
+	Description  string              `json:"description,omitempty"`
+	Experimental bool                `json:"experimental,omitempty"`
+	Deprecated   bool                `json:"deprecated,omitempty"`
+	Redirect     string              `json:"redirect,omitempty"`
+	Parameters   []*ProtocolProperty `json:"parameters,omitempty"`
+	Returns      []*ProtocolProperty `json:"returns,omitempty"`
+}
+
+// ProtocolEvent of a domain, such as loadEventFired
+type ProtocolEvent struct {
+	Name         string              `json:"name"`
+	Description  string              `json:"description,omitempty"`
+	Experimental bool                `json:"experimental,omitempty"`
+	Deprecated   bool                `json:"deprecated,omitempty"`
+	Parameters   []*ProtocolProperty `json:"parameters,omitempty"`
+}
+
+// ProtocolProperty is a parameter, return value, type property or array item
+type ProtocolProperty struct {
+	Name         string            `json:"name,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Type         string            `json:"type,omitempty"`
+	Ref          string            `json:"$ref,omitempty"`
+	Optional     bool              `json:"optional,omitempty"`
+	Experimental bool              `json:"experimental,omitempty"`
+	Deprecated   bool              `json:"deprecated,omitempty"`
+	Enum         []string          `json:"enum,omitempty"`
+	Items        *ProtocolProperty `json:"items,omitempty"`
+}
@@ -0,0 +1,279 @@
+package gcd
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrUnsupportedProxy is returned from NewProxyDialer for schemes other than http, https and socks5
+var ErrUnsupportedProxy = errors.New("unsupported proxy scheme, expected http, https or socks5")
+
+// NewProxyDialer returns a Dialer which tunnels WebSocket connections through the proxy, either
+// an HTTP proxy (http:// or https://) using CONNECT or a SOCKS5 proxy (socks5://). Credentials
+// in the URL's user info are sent as Proxy-Authorization or SOCKS5 username/password. The
+// connection to the proxy is made with forward, or a net.Dialer if nil.
+func NewProxyDialer(proxyURL *url.URL, forward Dialer) (Dialer, error) {
+	return NewProxyDialerTLS(proxyURL, forward, nil)
+}
+
+// NewProxyDialerTLS is NewProxyDialer which connects to an https:// proxy with a clone of config,
+// such as one with client certificates or a custom CA, if not nil.
+func NewProxyDialerTLS(proxyURL *url.URL, forward Dialer, config *tls.Config) (Dialer, error) {
+	if forward == nil {
+		forward = &net.Dialer{}
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https":
+		return &httpProxyDialer{proxyURL: proxyURL, forward: forward, tlsConfig: config}, nil
+	case "socks5", "socks5h":
+		return &socks5Dialer{proxyURL: proxyURL, forward: forward}, nil
+	}
+	return nil, ErrUnsupportedProxy
+}
+
+// proxyAddr returns the proxy's host:port, defaulting the port for its scheme
+func proxyAddr(proxyURL *url.URL) string {
+	if proxyURL.Port() != "" {
+		return proxyURL.Host
+	}
+
+	switch proxyURL.Scheme {
+	case "https":
+		return net.JoinHostPort(proxyURL.Hostname(), "443")
+	case "socks5", "socks5h":
+		return net.JoinHostPort(proxyURL.Hostname(), "1080")
+	}
+	return net.JoinHostPort(proxyURL.Hostname(), "80")
+}
+
+// httpProxyDialer opens a tunnel with the CONNECT method
+type httpProxyDialer struct {
+	proxyURL  *url.URL
+	forward   Dialer
+	tlsConfig *tls.Config
+}
+
+func (d *httpProxyDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := d.forward.DialContext(ctx, network, proxyAddr(d.proxyURL))
+	if err != nil {
+		return nil, err
+	}
+
+	if d.proxyURL.Scheme == "https" {
+		config := &tls.Config{}
+		if d.tlsConfig != nil {
+			config = d.tlsConfig.Clone()
+		}
+
+		if config.ServerName == "" {
+			config.ServerName = d.proxyURL.Hostname()
+		}
+
+		tlsConn := tls.Client(conn, config)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	req := (&http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: http.Header{},
+	}).WithContext(ctx)
+
+	if user := d.proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy CONNECT to %s failed: %s", address, resp.Status)
+	}
+
+	// the proxy must not send anything before the tunneled connection's data
+	if r.Buffered() > 0 {
+		conn.Close()
+		return nil, errors.New("proxy sent unexpected data after CONNECT")
+	}
+	return conn, nil
+}
+
+// socks5 protocol values
+// Ref: https://tools.ietf.org/html/rfc1928
+const (
+	socks5Version      = 0x05
+	socks5AuthNone     = 0x00
+	socks5AuthPassword = 0x02
+	socks5AuthNoAccept = 0xff
+	socks5Connect      = 0x01
+	socks5AddrIPv4     = 0x01
+	socks5AddrDomain   = 0x03
+	socks5AddrIPv6     = 0x04
+)
+
+// socks5Dialer connects through a SOCKS5 proxy, hostnames are resolved by the proxy
+type socks5Dialer struct {
+	proxyURL *url.URL
+	forward  Dialer
+}
+
+func (d *socks5Dialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	conn, err := d.forward.DialContext(ctx, network, proxyAddr(d.proxyURL))
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	if err := d.connect(conn, address); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (d *socks5Dialer) connect(conn net.Conn, address string) error {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return err
+	}
+
+	method := byte(socks5AuthNone)
+	if d.proxyURL.User != nil {
+		method = socks5AuthPassword
+	}
+
+	if _, err := conn.Write([]byte{socks5Version, 1, method}); err != nil {
+		return err
+	}
+
+	reply := make([]byte, 2)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return err
+	}
+
+	if reply[0] != socks5Version || reply[1] == socks5AuthNoAccept || reply[1] != method {
+		return errors.New("socks5 proxy refused the authentication method")
+	}
+
+	if method == socks5AuthPassword {
+		if err := d.authenticate(conn); err != nil {
+			return err
+		}
+	}
+
+	req := []byte{socks5Version, socks5Connect, 0}
+	if ip := net.ParseIP(host); ip != nil && ip.To4() != nil {
+		req = append(append(req, socks5AddrIPv4), ip.To4()...)
+	} else if ip != nil {
+		req = append(append(req, socks5AddrIPv6), ip.To16()...)
+	} else {
+		if len(host) > 255 {
+			return errors.New("socks5 host name too long: " + host)
+		}
+		req = append(append(req, socks5AddrDomain, byte(len(host))), host...)
+	}
+	req = binary.BigEndian.AppendUint16(req, uint16(port))
+
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+
+	// version, status, reserved, address type
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return err
+	}
+
+	if header[1] != 0 {
+		return fmt.Errorf("socks5 proxy failed to connect to %s: status %d", address, header[1])
+	}
+
+	// skip the bound address and port
+	var skip int
+	switch header[3] {
+	case socks5AddrIPv4:
+		skip = net.IPv4len + 2
+	case socks5AddrIPv6:
+		skip = net.IPv6len + 2
+	case socks5AddrDomain:
+		size := make([]byte, 1)
+		if _, err := io.ReadFull(conn, size); err != nil {
+			return err
+		}
+		skip = int(size[0]) + 2
+	default:
+		return errors.New("socks5 proxy replied with an unknown address type")
+	}
+	_, err = io.ReadFull(conn, make([]byte, skip))
+	return err
+}
+
+// authenticate with the username and password from the proxy url
+// Ref: https://tools.ietf.org/html/rfc1929
+func (d *socks5Dialer) authenticate(conn net.Conn) error {
+	username := d.proxyURL.User.Username()
+	password, _ := d.proxyURL.User.Password()
+	if len(username) > 255 || len(password) > 255 {
+		return errors.New("socks5 credentials too long")
+	}
+
+	req := append([]byte{1, byte(len(username))}, username...)
+	req = append(append(req, byte(len(password))), password...)
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+
+	reply := make([]byte, 2)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return err
+	}
+
+	if reply[1] != 0 {
+		return errors.New("socks5 proxy rejected the credentials")
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package gcd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/wirepair/gcd/v2/gcdmessage"
+)
+
+// defaultReconnectAttempts is used when ReconnectPolicy.MaxAttempts is 0
+const defaultReconnectAttempts = 10
+
+// ReconnectPolicy for targets whose connection drops, see WithReconnect.
+type ReconnectPolicy struct {
+	MaxAttempts    int           // attempts before giving up and shutting the target down, 0 for 10, negative for no limit
+	InitialBackoff time.Duration // wait before the first attempt, defaults to 250ms
+	MaxBackoff     time.Duration // longest wait between attempts, defaults to 30s
+	Multiplier     float64       // backoff growth per attempt, defaults to 2
+}
+
+// maxAttempts returns the number of attempts to make, negative for no limit
+func (p *ReconnectPolicy) maxAttempts() int {
+	if p.MaxAttempts == 0 {
+		return defaultReconnectAttempts
+	}
+	return p.MaxAttempts
+}
+
+// backoff returns how long to wait before the attempt, starting from 0
+func (p *ReconnectPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	if delay <= 0 {
+		delay = 250 * time.Millisecond
+	}
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 30 * time.Second
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	for i := 0; i < attempt && delay < maxBackoff; i++ {
+		delay = time.Duration(float64(delay) * multiplier)
+	}
+
+	if delay > maxBackoff {
+		return maxBackoff
+	}
+	return delay
+}
+
+// WithReconnect has targets re-dial their WebSocketDebuggerUrl when the connection drops instead of
+// shutting down. Targets which were closed or detached, or whose url is no longer found, are not
+// reconnected to. Once reconnected, domains which were enabled are enabled again, event subscriptions
+// are kept. Calls pending when the connection dropped fail, flattened sessions over the connection are
+// shut down. See ChromeTarget.OnDisconnected and ChromeTarget.OnReconnected.
+func WithReconnect(policy ReconnectPolicy) func(*Gcd) {
+	return func(g *Gcd) {
+		g.reconnect = &policy
+	}
+}
+
+// enabledDomain is a successful <Domain>.enable call to replay after reconnecting
+type enabledDomain struct {
+	method string
+	params interface{}
+}
+
+// OnDisconnected calls fn on its own goroutine with the read error when the target's connection
+// drops and it is about to reconnect, see WithReconnect.
+func (c *ChromeTarget) OnDisconnected(fn func(err error)) {
+	c.lifecycleLock.Lock()
+	c.disconnectedHandlers = append(c.disconnectedHandlers, fn)
+	c.lifecycleLock.Unlock()
+}
+
+// OnReconnected calls fn on its own goroutine once the target has reconnected and re-enabled its
+// domains, see WithReconnect.
+func (c *ChromeTarget) OnReconnected(fn func()) {
+	c.lifecycleLock.Lock()
+	c.reconnectedHandlers = append(c.reconnectedHandlers, fn)
+	c.lifecycleLock.Unlock()
+}
+
+// canReconnect returns true if the target owns a connection which can be re-dialed
+func (c *ChromeTarget) canReconnect() bool {
+	if c.debugger.reconnect == nil || c.parent != nil || c.debugger.pipe || c.Target.WebSocketDebuggerUrl == "" {
+		return false
+	}
+
+	c.lifecycleLock.Lock()
+	defer c.lifecycleLock.Unlock()
+	return !c.gone
+}
+
+// targetGone records that the target was closed or detached, so it isn't reconnected to
+func (c *ChromeTarget) targetGone() {
+	c.lifecycleLock.Lock()
+	c.gone = true
+	c.lifecycleLock.Unlock()
+}
+
+// isTargetNotFound returns true if dialing failed because the target no longer exists
+func isTargetNotFound(err error) bool {
+	var handshakeErr *ErrBadHandshake
+	if !errors.As(err, &handshakeErr) {
+		return false
+	}
+	return handshakeErr.StatusCode == http.StatusNotFound || handshakeErr.StatusCode == http.StatusGone
+}
+
+// trackDomain records successful <Domain>.enable and <Domain>.disable calls when reconnecting is enabled,
+// response is checked for a protocol error if not nil.
+func (c *ChromeTarget) trackDomain(method string, params interface{}, response []byte) {
+	if c.debugger.reconnect == nil || c.parent != nil {
+		return
+	}
+
+	domain, command, ok := strings.Cut(method, ".")
+	if !ok || command != "enable" && command != "disable" {
+		return
+	}
+
+	if response != nil {
+		cerr := &gcdmessage.ChromeErrorResponse{}
+		if err := json.Unmarshal(response, cerr); err != nil || cerr.Error != nil {
+			return
+		}
+	}
+
+	c.lifecycleLock.Lock()
+	defer c.lifecycleLock.Unlock()
+
+	enabled := make([]*enabledDomain, 0, len(c.enabledDomains)+1)
+	for _, e := range c.enabledDomains {
+		if !strings.HasPrefix(e.method, domain+".") {
+			enabled = append(enabled, e)
+		}
+	}
+
+	if command == "enable" {
+		enabled = append(enabled, &enabledDomain{method: method, params: params})
+	}
+	c.enabledDomains = enabled
+}
+
+// reconnect re-dials the target after its connection failed with err, backing off between attempts.
+// Runs on the read goroutine, which is restarted once connected.
+func (c *ChromeTarget) reconnect(err error) {
+	policy := c.debugger.reconnect
+
+	c.getConn().Close()
+	c.failPending(&ErrConnectionClosed{Cause: err})
+	c.closeSessions(err)
+
+	c.lifecycleLock.Lock()
+	disconnected := append([]func(error){}, c.disconnectedHandlers...)
+	c.lifecycleLock.Unlock()
+	for _, fn := range disconnected {
+		go fn(err)
+	}
+
+	maxAttempts := policy.maxAttempts()
+	for attempt := 0; maxAttempts < 0 || attempt < maxAttempts; attempt++ {
+		timer := time.NewTimer(policy.backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-c.doneCh:
+			timer.Stop()
+			return
+		case <-c.ctx.Done():
+			timer.Stop()
+			c.stop(c.ctx.Err())
+			return
+		}
+
+		conn, dialErr := c.debugger.dialer(c.ctx, c.Target.WebSocketDebuggerUrl)
+		if isTargetNotFound(dialErr) {
+			c.logger.Println("target", c.Target.Id, "no longer exists, not reconnecting")
+			c.stop(dialErr)
+			return
+		}
+
+		if dialErr != nil {
+			c.logDebug("reconnect attempt ", attempt+1, " failed: ", dialErr)
+			continue
+		}
+
+		c.setConn(conn)
+		if c.isStopped() {
+			conn.Close()
+			return
+		}
+
+		go c.listenRead()
+		c.restoreDomains()
+
+		c.lifecycleLock.Lock()
+		reconnected := append([]func(){}, c.reconnectedHandlers...)
+		c.lifecycleLock.Unlock()
+		for _, fn := range reconnected {
+			go fn()
+		}
+		return
+	}
+
+	c.logger.Println("giving up reconnecting to target", c.Target.Id)
+	c.stop(err)
+}
+
+// restoreDomains enables the domains that were enabled before the connection dropped
+func (c *ChromeTarget) restoreDomains() {
+	c.lifecycleLock.Lock()
+	enabled := append([]*enabledDomain{}, c.enabledDomains...)
+	c.lifecycleLock.Unlock()
+
+	for _, e := range enabled {
+		ctx, cancel := context.WithTimeout(c.ctx, c.GetApiTimeout())
+		_, err := c.SendCustomReturn(ctx, &gcdmessage.ParamRequest{Id: c.GetId(), Method: e.method, Params: e.params})
+		cancel()
+		if err != nil {
+			c.logger.Println("error re-enabling", e.method, "after reconnecting:", err)
+		}
+	}
+}
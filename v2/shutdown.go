@@ -0,0 +1,85 @@
+package gcd
+
+import (
+	"context"
+	"time"
+)
+
+// Shutdown closes the browser started by StartProcess, StartProcessPipe or StartProcessCustom
+// and waits for it to exit. Browser.close is sent first so the profile is flushed, if the process
+// is still running after the shutdown grace period (see WithShutdownGracePeriod) its process
+// group is sent SIGTERM and, after another grace period, SIGKILL. Once ctx is done the
+// remaining steps are taken without waiting. Returns after the process has exited, the
+// OnChromeExitHandler was called and, with WithDeleteProfileOnExit, the profile was removed.
+func (c *Gcd) Shutdown(ctx context.Context) error {
+	c.processLock.RLock()
+	process, group, exited, done := c.chromeProcess, c.processGroup, c.processExited, c.processDone
+	c.processLock.RUnlock()
+
+	if process == nil {
+		return ErrNoProcess
+	}
+
+	select {
+	case <-exited:
+	default:
+		c.closeBrowser(ctx)
+	}
+
+	if !c.waitExited(ctx, exited) {
+		c.logger.Println("browser did not exit after Browser.close, terminating")
+		terminateProcess(process, group)
+
+		if !c.waitExited(ctx, exited) {
+			c.logger.Println("browser did not exit after SIGTERM, killing")
+			if err := killProcess(process, group); err != nil {
+				select {
+				case <-exited:
+				default:
+					return err
+				}
+			}
+		}
+	}
+
+	<-done
+	return nil
+}
+
+// closeBrowser asks the browser to exit with Browser.close, the browser may exit before it replies
+func (c *Gcd) closeBrowser(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.shutdownGrace)
+	defer cancel()
+
+	browser, err := c.browser(ctx)
+	if err != nil {
+		c.logger.Println("unable to connect to browser to close it", err)
+		return
+	}
+
+	if _, err := browser.Browser.Close(ctx); err != nil {
+		c.logger.Println("Browser.close failed", err)
+	}
+}
+
+// waitExited waits up to the shutdown grace period for the process to exit, returns false
+// right away once ctx is done.
+func (c *Gcd) waitExited(ctx context.Context, exited chan struct{}) bool {
+	timer := time.NewTimer(c.shutdownGrace)
+	defer timer.Stop()
+
+	select {
+	case <-exited:
+		return true
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+
+	// the process may have exited as we gave up
+	select {
+	case <-exited:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,58 @@
+package gcd
+
+import (
+	"context"
+	"strings"
+)
+
+// Transport is a connection to the chrome debugger service, each Send and Read
+// carries a single JSON protocol message. The default is a WebSocket, custom transports
+// (in memory fakes, recorders, proxied or authenticated connections) can be used
+// by passing a TransportDialer to WithTransportDialer.
+type Transport interface {
+	Send(msg []byte) error
+	Read() ([]byte, error)
+	Close() error
+}
+
+// TransportDialer opens a Transport to a target's debugger url, such as the
+// webSocketDebuggerUrl returned by /json.
+type TransportDialer func(ctx context.Context, url string) (Transport, error)
+
+// DialWebSocket is the default TransportDialer, it connects to the tab/process for
+// sending/recv'ing debug events.
+func DialWebSocket(ctx context.Context, url string) (Transport, error) {
+	client := &WebSocket{}
+	if err := client.Connect(ctx, url, nil); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// dialWebSocket is the Gcd's default TransportDialer, DialWebSocket configured by the Gcd's options
+func (c *Gcd) dialWebSocket(ctx context.Context, url string) (Transport, error) {
+	// chrome behind a TLS terminating proxy still advertises ws:// urls
+	if c.scheme == "https" && strings.HasPrefix(url, "ws://") {
+		url = "wss://" + strings.TrimPrefix(url, "ws://")
+	}
+
+	client := &WebSocket{Compression: c.compression, TLSConfig: c.tlsConfig}
+	if c.proxyURL != nil {
+		dialer, err := NewProxyDialerTLS(c.proxyURL, nil, c.tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		client.Dialer = dialer
+	}
+
+	if err := client.Connect(ctx, url, c.headers); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// OpenTarget creates a ChromeTarget which speaks the debugger protocol over an already
+// established Transport, such as a pipe to a custom launched process.
+func (c *Gcd) OpenTarget(target *TargetInfo, conn Transport) *ChromeTarget {
+	return newChromeTarget(c, target, conn, c.messageObserver)
+}
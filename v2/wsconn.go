@@ -2,14 +2,23 @@ package gcd
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha1"
 	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
 // Use WebSocket from https://github.com/go-rod/rod/blob/master/lib/cdp/websocket.go
@@ -19,18 +28,60 @@ type Dialer interface {
 	DialContext(ctx context.Context, network, address string) (net.Conn, error)
 }
 
-// WebSocket client for chromium. It only implements a subset of WebSocket protocol.
+// websocket opcodes
+const (
+	wsOpContinuation = 0x0
+	wsOpText         = 0x1
+	wsOpBinary       = 0x2
+	wsOpClose        = 0x8
+	wsOpPing         = 0x9
+	wsOpPong         = 0xA
+)
+
+const (
+	wsGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+	// WebSocketCloseNormal is the close status sent when we close the connection
+	WebSocketCloseNormal = 1000
+	// WebSocketCloseNoStatus is reported when a close frame carries no status code
+	WebSocketCloseNoStatus = 1005
+)
+
+// ErrWebSocketProtocol is returned when the browser sends an invalid frame
+var ErrWebSocketProtocol = errors.New("websocket protocol error")
+
+// ErrWebSocketClosed is returned from Read when the browser sends a close frame
+type ErrWebSocketClosed struct {
+	Code   int
+	Reason string
+}
+
+func (e *ErrWebSocketClosed) Error() string {
+	return fmt.Sprintf("websocket closed: %d %s", e.Code, e.Reason)
+}
+
+// WebSocket client for chromium. It implements the parts of the WebSocket protocol chrome uses:
+// text and binary messages, fragmentation, ping/pong and close frames.
 // Limitation: https://bugs.chromium.org/p/chromium/issues/detail?id=1069431
 // Ref: https://tools.ietf.org/html/rfc6455
 type WebSocket struct {
-	// Dialer is usually used for proxy
+	// Dialer is usually used for proxy, see NewProxyDialer
 	Dialer Dialer
-
-	close  func()
-	conn   net.Conn
-	r      *bufio.Reader
-	header [18]byte // Send is thread-safe, so we can safely share a header for all frames
-	mask   []byte
+	// TLSConfig for wss:// connections, such as client certificates or a custom CA
+	TLSConfig *tls.Config
+	// MaxFrameSize splits messages larger than it into continuation frames, 0 sends every message as a single frame.
+	MaxFrameSize int
+	// Compression offers permessage-deflate in the handshake, if the browser accepts messages are
+	// transparently compressed and decompressed.
+	Compression bool
+
+	close     func()
+	conn      net.Conn
+	r         *bufio.Reader
+	writeLock sync.Mutex // Send, pongs and close frames are written from different goroutines
+	header    [14]byte   // protected by writeLock
+	closeOnce sync.Once
+	deflate   *wsDeflate // set if permessage-deflate was negotiated
 }
 
 // Connect to browser
@@ -54,47 +105,119 @@ func (ws *WebSocket) Connect(ctx context.Context, wsURL string, header http.Head
 		return err
 	}
 
+	if u.Scheme == "wss" {
+		if conn, err = ws.tlsHandshake(ctx, conn, u); err != nil {
+			return err
+		}
+	}
+
 	go func() {
 		<-ctx.Done()
 		_ = conn.Close()
 	}()
 
-	ws.initConstants()
-
 	ws.conn = conn
 	ws.r = bufio.NewReader(conn)
 	return ws.handshake(ctx, u, header)
 }
 
 func (ws *WebSocket) initDialer(u *url.URL) {
-	if ws.Dialer != nil {
-		return
-	}
-
-	if u.Scheme == "wss" {
-		ws.Dialer = &tlsDialer{}
-		if u.Port() == "" {
+	if u.Port() == "" {
+		if u.Scheme == "wss" {
 			u.Host += ":443"
+		} else {
+			u.Host += ":80"
 		}
-	} else {
+	}
+
+	if ws.Dialer == nil {
 		ws.Dialer = &net.Dialer{}
 	}
 }
 
-func (ws *WebSocket) initConstants() {
-	// FIN is alway true, Opcode is always text frame.
-	ws.header = [18]byte{0b1000_0001}
+// tlsHandshake wraps the (possibly proxied) connection with TLS
+func (ws *WebSocket) tlsHandshake(ctx context.Context, conn net.Conn, u *url.URL) (net.Conn, error) {
+	config := &tls.Config{}
+	if ws.TLSConfig != nil {
+		config = ws.TLSConfig.Clone()
+	}
+
+	if config.ServerName == "" {
+		config.ServerName = u.Hostname()
+	}
+
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+// Close the connection to the browser, a close frame is sent first if the connection is still open.
+func (ws *WebSocket) Close() error {
+	if ws.conn != nil {
+		ws.sendClose(WebSocketCloseNormal, "")
+	}
+	if ws.close != nil {
+		ws.close()
+	}
+	return nil
+}
 
-	ws.mask = []byte{0, 1, 2, 3}
+// sendClose writes a close frame once, it's skipped if a Send is blocked on the connection
+// so closing never hangs.
+func (ws *WebSocket) sendClose(code int, reason string) {
+	ws.closeOnce.Do(func() {
+		if !ws.writeLock.TryLock() {
+			return
+		}
+		defer ws.writeLock.Unlock()
+
+		payload := binary.BigEndian.AppendUint16(nil, uint16(code))
+		_ = ws.conn.SetWriteDeadline(time.Now().Add(time.Second))
+		_ = ws.writeFrame(true, wsOpClose, append(payload, reason...))
+	})
 }
 
 // Send a message to browser.
 // Because we use zero-copy design, it will modify the content of the msg.
 // It won't allocate new memory.
 func (ws *WebSocket) Send(msg []byte) error {
+	ws.writeLock.Lock()
+	defer ws.writeLock.Unlock()
+
+	opcode := byte(wsOpText)
+	if ws.deflate != nil && len(msg) >= wsCompressThreshold {
+		compressed, err := ws.deflate.compress(msg)
+		if err != nil {
+			return err
+		}
+		msg = compressed
+		opcode |= wsRSV1
+	}
+
+	for ws.MaxFrameSize > 0 && len(msg) > ws.MaxFrameSize {
+		if err := ws.writeFrame(false, opcode, msg[:ws.MaxFrameSize]); err != nil {
+			return ws.checkClose(err)
+		}
+		msg = msg[ws.MaxFrameSize:]
+		opcode = wsOpContinuation
+	}
+	return ws.checkClose(ws.writeFrame(true, opcode, msg))
+}
+
+// writeFrame masks payload in place and writes it as a single frame, opcode may include the
+// RSV bits. writeLock must be held.
+func (ws *WebSocket) writeFrame(fin bool, opcode byte, payload []byte) error {
+	ws.header[0] = opcode
+	if fin {
+		ws.header[0] |= 0b1000_0000
+	}
+	// clients must always mask
 	ws.header[1] = 0b1000_0000
 
-	size := len(msg)
+	size := len(payload)
 	fieldLen := 0
 	switch {
 	case size <= 125:
@@ -113,28 +236,115 @@ func (ws *WebSocket) Send(msg []byte) error {
 		ws.header[i+2] = byte((size >> digit) & 0xff)
 	}
 
-	copy(ws.header[i+2:], ws.mask)
+	mask := ws.header[i+2 : i+6]
+	if _, err := rand.Read(mask); err != nil {
+		return err
+	}
+
 	_, err := ws.conn.Write(ws.header[:i+6])
 	if err != nil {
-		return ws.checkClose(err)
+		return err
 	}
 
-	for i := range msg {
-		msg[i] = msg[i] ^ ws.mask[i%4]
+	for i := range payload {
+		payload[i] = payload[i] ^ mask[i%4]
 	}
 
-	_, err = ws.conn.Write(msg)
-	return ws.checkClose(err)
+	_, err = ws.conn.Write(payload)
+	return err
 }
 
-// Read a message from browser
+// Read a message from browser. Fragmented messages are reassembled and decompressed and pings
+// are answered, a close frame is answered and returned as an *ErrWebSocketClosed.
 func (ws *WebSocket) Read() ([]byte, error) {
-	_, _ = ws.r.ReadByte()
+	var message []byte
+	fragmented := false
+	compressed := false
+
+	for {
+		fin, opcode, payload, err := ws.readFrame()
+		if err != nil {
+			return nil, ws.checkClose(err)
+		}
+
+		rsv1 := opcode&wsRSV1 != 0
+		opcode &^= wsRSV1
+		// RSV1 is only valid on the first frame of a message, and only when compressing
+		if rsv1 && (ws.deflate == nil || fragmented || opcode != wsOpText && opcode != wsOpBinary) {
+			return nil, ws.checkClose(ErrWebSocketProtocol)
+		}
+
+		switch opcode {
+		case wsOpPing:
+			ws.writeLock.Lock()
+			err = ws.writeFrame(true, wsOpPong, payload)
+			ws.writeLock.Unlock()
+			if err != nil {
+				return nil, ws.checkClose(err)
+			}
+			continue
+		case wsOpPong:
+			continue
+		case wsOpClose:
+			closeErr := &ErrWebSocketClosed{Code: WebSocketCloseNoStatus}
+			if len(payload) >= 2 {
+				closeErr.Code = int(binary.BigEndian.Uint16(payload))
+				closeErr.Reason = string(payload[2:])
+			}
+			code := closeErr.Code
+			if code == WebSocketCloseNoStatus {
+				code = WebSocketCloseNormal
+			}
+			ws.sendClose(code, "")
+			return nil, ws.checkClose(closeErr)
+		case wsOpText, wsOpBinary:
+			if fragmented {
+				return nil, ws.checkClose(ErrWebSocketProtocol)
+			}
+			message = payload
+			compressed = rsv1
+		case wsOpContinuation:
+			if !fragmented {
+				return nil, ws.checkClose(ErrWebSocketProtocol)
+			}
+			message = append(message, payload...)
+		default:
+			return nil, ws.checkClose(ErrWebSocketProtocol)
+		}
+
+		if fin && compressed {
+			message, err = ws.deflate.decompress(message)
+			return message, ws.checkClose(err)
+		}
+
+		if fin {
+			return message, nil
+		}
+		fragmented = true
+	}
+}
+
+// readFrame reads a single frame, unmasking the payload if needed. The returned opcode
+// includes the RSV1 bit, RSV2 and RSV3 must not be set.
+func (ws *WebSocket) readFrame() (bool, byte, []byte, error) {
 	b, err := ws.r.ReadByte()
 	if err != nil {
-		return nil, ws.checkClose(err)
+		return false, 0, nil, err
 	}
 
+	fin := b&0b1000_0000 != 0
+	opcode := b & 0x0f
+	rsv1 := b & wsRSV1
+	if b&0b0011_0000 != 0 {
+		return false, 0, nil, ErrWebSocketProtocol
+	}
+
+	b, err = ws.r.ReadByte()
+	if err != nil {
+		return false, 0, nil, err
+	}
+
+	masked := b&0b1000_0000 != 0
 	size := 0
 	fieldLen := 0
 
@@ -151,15 +361,35 @@ func (ws *WebSocket) Read() ([]byte, error) {
 	for i := 0; i < fieldLen; i++ {
 		b, err := ws.r.ReadByte()
 		if err != nil {
-			return nil, ws.checkClose(err)
+			return false, 0, nil, err
 		}
 
 		size = size<<8 + int(b)
 	}
 
+	// control frames can't be fragmented or larger than 125 bytes
+	if opcode >= wsOpClose && (!fin || size > 125) || size < 0 {
+		return false, 0, nil, ErrWebSocketProtocol
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(ws.r, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
 	data := make([]byte, size)
-	_, err = io.ReadFull(ws.r, data)
-	return data, ws.checkClose(err)
+	if _, err := io.ReadFull(ws.r, data); err != nil {
+		return false, 0, nil, err
+	}
+
+	if masked {
+		for i := range data {
+			data[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode | rsv1, data, nil
 }
 
 // ErrBadHandshake type
@@ -175,14 +405,31 @@ func (e *ErrBadHandshake) Error() string {
 	)
 }
 
+// webSocketAccept computes the expected Sec-WebSocket-Accept for a Sec-WebSocket-Key
+func webSocketAccept(key string) string {
+	h := sha1.New()
+	h.Write([]byte(key + wsGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
 func (ws *WebSocket) handshake(ctx context.Context, u *url.URL, header http.Header) error {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return ws.checkClose(err)
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+
 	req := (&http.Request{Method: http.MethodGet, URL: u, Header: http.Header{
 		"Upgrade":               {"websocket"},
 		"Connection":            {"Upgrade"},
-		"Sec-WebSocket-Key":     {"nil"},
+		"Sec-WebSocket-Key":     {key},
 		"Sec-WebSocket-Version": {"13"},
 	}}).WithContext(ctx)
 
+	if ws.Compression {
+		req.Header.Set("Sec-WebSocket-Extensions", wsDeflateOffer)
+	}
+
 	for k, vs := range header {
 		if k == "Host" && len(vs) > 0 {
 			req.Host = vs[0]
@@ -202,11 +449,22 @@ func (ws *WebSocket) handshake(ctx context.Context, u *url.URL, header http.Head
 	}
 
 	if res.StatusCode != http.StatusSwitchingProtocols ||
-		res.Header.Get("Sec-Websocket-Accept") != "Q67D9eATKx531lK8F7u2rqQNnNI=" {
+		res.Header.Get("Sec-Websocket-Accept") != webSocketAccept(key) {
+		// keep the body for the error, the connection is closed
+		body, _ := ioutil.ReadAll(io.LimitReader(res.Body, 4096))
+		res.Body = ioutil.NopCloser(bytes.NewReader(body))
+		ws.close()
 		return &ErrBadHandshake{res}
 	}
 
-	return nil
+	// a server must not accept extensions we didn't offer
+	extensions := strings.Join(res.Header.Values("Sec-WebSocket-Extensions"), ",")
+	if !ws.Compression && extensions != "" {
+		return ws.checkClose(errors.New("websocket server accepted an extension that wasn't offered: " + extensions))
+	}
+
+	ws.deflate, err = negotiateDeflate(extensions)
+	return ws.checkClose(err)
 }
 
 func (ws *WebSocket) checkClose(err error) error {
@@ -215,10 +473,3 @@ func (ws *WebSocket) checkClose(err error) error {
 	}
 	return err
 }
-
-// TODO: replace it with tls.Dialer once golang v1.15 is widely used.
-type tlsDialer struct{}
-
-func (d *tlsDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
-	return tls.Dial(network, address, nil)
-}
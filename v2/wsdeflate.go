@@ -0,0 +1,113 @@
+package gcd
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"io"
+	"strings"
+)
+
+// permessage-deflate WebSocket compression
+// Ref: https://tools.ietf.org/html/rfc7692
+const (
+	wsRSV1              = 0b0100_0000 // set on the first frame of a compressed message
+	wsDeflateExtension  = "permessage-deflate"
+	wsDeflateOffer      = wsDeflateExtension + "; client_no_context_takeover"
+	wsDeflateWindow     = 32768 // the largest window a deflate stream can refer back to
+	wsCompressThreshold = 256   // smaller messages are sent uncompressed
+)
+
+var (
+	// every compressed message ends with an empty stored block which is stripped before sending
+	wsDeflateTail = []byte{0x00, 0x00, 0xff, 0xff}
+	// final empty stored block so the inflater sees the end of the stream instead of an unexpected EOF
+	wsDeflateFinal = []byte{0x01, 0x00, 0x00, 0xff, 0xff}
+)
+
+// wsDeflate holds the compression state of a connection which negotiated permessage-deflate.
+// We never keep our compression context between messages, the browser may keep its own.
+type wsDeflate struct {
+	serverNoContextTakeover bool
+	dict                    []byte // the last window of decompressed output, if the server keeps its context
+	reader                  io.ReadCloser
+	writer                  *flate.Writer
+	buf                     bytes.Buffer
+}
+
+// negotiateDeflate parses the Sec-WebSocket-Extensions response header, returns nil if the
+// server declined compression or an error if it accepted with parameters we didn't offer.
+func negotiateDeflate(header string) (*wsDeflate, error) {
+	if header == "" {
+		return nil, nil
+	}
+
+	for _, extension := range strings.Split(header, ",") {
+		params := strings.Split(extension, ";")
+		if strings.TrimSpace(params[0]) != wsDeflateExtension {
+			return nil, errors.New("websocket server accepted an extension that wasn't offered: " + extension)
+		}
+
+		d := &wsDeflate{}
+		for _, param := range params[1:] {
+			name, _, _ := strings.Cut(strings.TrimSpace(param), "=")
+			switch name {
+			case "server_no_context_takeover":
+				d.serverNoContextTakeover = true
+			case "client_no_context_takeover", "server_max_window_bits":
+				// we already don't keep context and can inflate any window size
+			default:
+				return nil, errors.New("websocket server sent an unsupported " + wsDeflateExtension + " parameter: " + param)
+			}
+		}
+		return d, nil
+	}
+	return nil, nil
+}
+
+// compress a message, the result is only valid until the next call.
+func (d *wsDeflate) compress(msg []byte) ([]byte, error) {
+	d.buf.Reset()
+	if d.writer == nil {
+		writer, err := flate.NewWriter(&d.buf, flate.BestSpeed)
+		if err != nil {
+			return nil, err
+		}
+		d.writer = writer
+	} else {
+		d.writer.Reset(&d.buf)
+	}
+
+	if _, err := d.writer.Write(msg); err != nil {
+		return nil, err
+	}
+
+	if err := d.writer.Flush(); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(d.buf.Bytes(), wsDeflateTail), nil
+}
+
+// decompress a message, keeping the output as the dictionary for the next message unless
+// the server resets its context.
+func (d *wsDeflate) decompress(msg []byte) ([]byte, error) {
+	src := io.MultiReader(bytes.NewReader(msg), bytes.NewReader(wsDeflateTail), bytes.NewReader(wsDeflateFinal))
+	if d.reader == nil {
+		d.reader = flate.NewReaderDict(src, d.dict)
+	} else if err := d.reader.(flate.Resetter).Reset(src, d.dict); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(d.reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if !d.serverNoContextTakeover {
+		d.dict = append(d.dict, data...)
+		if len(d.dict) > wsDeflateWindow {
+			d.dict = append([]byte(nil), d.dict[len(d.dict)-wsDeflateWindow:]...)
+		}
+	}
+	return data, nil
+}